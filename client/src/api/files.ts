@@ -183,7 +183,7 @@ export function createFileServiceClient(handler: RequestHandler): FileService {
         const path = `files/meals/v1alpha1/${request.name}/image:generate`;
         return handler({
           path: path,
-          method: "GET",
+          method: "POST",
           body: null,
           responseType: "blob",
           signal,
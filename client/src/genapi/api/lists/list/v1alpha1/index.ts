@@ -1013,6 +1013,10 @@ export type CreateListItemCompletionRequest = {
   //
   // Behaviors: REQUIRED
   listItemCompletion: ListItemCompletion | undefined;
+  // the pantry to restock with the list item, if any
+  //
+  // Behaviors: OPTIONAL
+  pantry: string | undefined;
 };
 
 // the request to listItemCompletion listItemCompletions
@@ -1096,6 +1100,9 @@ export function createListItemCompletionServiceClient(
       const path = `lists/v1alpha1/${request.parent}/listItemCompletions`; // eslint-disable-line quotes
       const body = JSON.stringify(request?.listItemCompletion ?? {});
       const queryParams: string[] = [];
+      if (request.pantry) {
+        queryParams.push(`pantry=${encodeURIComponent(request.pantry.toString())}`)
+      }
       let uri = path;
       if (queryParams.length > 0) {
         uri += `?${queryParams.join("&")}`
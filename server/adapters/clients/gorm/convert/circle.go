@@ -14,6 +14,7 @@ func CircleFromCoreModel(m cmodel.Circle) (gmodel.Circle, error) {
 		Description:     m.Description,
 		Handle:          m.Handle,
 		ImageURI:        m.ImageURI,
+		ImageSet:        ImageSetFromCoreModel(m.ImageSet),
 		VisibilityLevel: m.VisibilityLevel,
 	}, nil
 }
@@ -30,6 +31,7 @@ func CircleToCoreModel(g gmodel.Circle) (cmodel.Circle, error) {
 		Description:     g.Description,
 		Handle:          g.Handle,
 		ImageURI:        g.ImageURI,
+		ImageSet:        ImageSetToCoreModel(g.ImageSet),
 		VisibilityLevel: g.VisibilityLevel,
 		Favorited:       g.CircleFavoriteId != 0,
 		CircleAccess: cmodel.CircleAccess{
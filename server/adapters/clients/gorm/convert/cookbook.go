@@ -0,0 +1,53 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+// CookbookFromCoreModel converts a core model to a gorm model.
+func CookbookFromCoreModel(m cmodel.Cookbook) gmodel.Cookbook {
+	return gmodel.Cookbook{
+		CookbookId:      m.Id.CookbookId,
+		Title:           m.Title,
+		Description:     m.Description,
+		VisibilityLevel: m.VisibilityLevel,
+		CreateTime:      m.CreateTime,
+		UpdateTime:      m.UpdateTime,
+	}
+}
+
+// CookbookToCoreModel converts a gorm model to a core model.
+func CookbookToCoreModel(m gmodel.Cookbook) cmodel.Cookbook {
+	permissionLevel := m.PermissionLevel
+	if m.VisibilityLevel == types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC && m.PermissionLevel == types.PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED {
+		permissionLevel = types.PermissionLevel_PERMISSION_LEVEL_PUBLIC
+	}
+
+	cookbook := cmodel.Cookbook{
+		Id: cmodel.CookbookId{
+			CookbookId: m.CookbookId,
+		},
+		Title:           m.Title,
+		Description:     m.Description,
+		VisibilityLevel: m.VisibilityLevel,
+		CreateTime:      m.CreateTime,
+		UpdateTime:      m.UpdateTime,
+	}
+
+	// Populate CookbookAccess if permission or state is set (i.e., join succeeded)
+	if m.PermissionLevel != 0 || m.State != 0 {
+		cookbook.CookbookAccess = cmodel.CookbookAccess{
+			CookbookAccessParent: cmodel.CookbookAccessParent{
+				CookbookId: cmodel.CookbookId{CookbookId: m.CookbookId},
+			},
+			CookbookAccessId: cmodel.CookbookAccessId{CookbookAccessId: m.CookbookAccessId},
+			PermissionLevel:  permissionLevel,
+			State:            m.State,
+			AcceptTarget:     m.AcceptTarget,
+		}
+	}
+
+	return cookbook
+}
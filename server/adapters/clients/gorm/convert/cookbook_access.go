@@ -0,0 +1,49 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// CookbookAccessFromCoreModel converts a core CookbookAccess to a GORM CookbookAccess
+func CookbookAccessFromCoreModel(cookbookAccess cmodel.CookbookAccess) gmodel.CookbookAccess {
+	return gmodel.CookbookAccess{
+		CookbookAccessId:  cookbookAccess.CookbookAccessId.CookbookAccessId,
+		CookbookId:        cookbookAccess.CookbookAccessParent.CookbookId.CookbookId,
+		RequesterUserId:   cookbookAccess.Requester.UserId,
+		RequesterCircleId: cookbookAccess.Requester.CircleId,
+		RecipientUserId:   cookbookAccess.Recipient.UserId,
+		RecipientCircleId: cookbookAccess.Recipient.CircleId,
+		PermissionLevel:   cookbookAccess.PermissionLevel,
+		State:             cookbookAccess.State,
+		AcceptTarget:      cookbookAccess.AcceptTarget,
+	}
+}
+
+// CookbookAccessToCoreModel converts a GORM CookbookAccess to a core CookbookAccess
+func CookbookAccessToCoreModel(gormCookbookAccess gmodel.CookbookAccess) cmodel.CookbookAccess {
+	cookbookAccess := cmodel.CookbookAccess{
+		CookbookAccessParent: cmodel.CookbookAccessParent{
+			CookbookId: cmodel.CookbookId{CookbookId: gormCookbookAccess.CookbookId},
+		},
+		CookbookAccessId: cmodel.CookbookAccessId{CookbookAccessId: gormCookbookAccess.CookbookAccessId},
+		PermissionLevel:  gormCookbookAccess.PermissionLevel,
+		State:            gormCookbookAccess.State,
+		AcceptTarget:     gormCookbookAccess.AcceptTarget,
+		Requester: cmodel.CookbookRecipientOrRequester{
+			UserId:   gormCookbookAccess.RequesterUserId,
+			CircleId: gormCookbookAccess.RequesterCircleId,
+		},
+		Recipient: cmodel.CookbookRecipientOrRequester{
+			UserId:   gormCookbookAccess.RecipientUserId,
+			CircleId: gormCookbookAccess.RecipientCircleId,
+		},
+		RecipientUsername:     gormCookbookAccess.RecipientUsername,
+		RecipientGivenName:    gormCookbookAccess.RecipientGivenName,
+		RecipientFamilyName:   gormCookbookAccess.RecipientFamilyName,
+		RecipientCircleTitle:  gormCookbookAccess.RecipientCircleTitle,
+		RecipientCircleHandle: gormCookbookAccess.RecipientCircleHandle,
+	}
+
+	return cookbookAccess
+}
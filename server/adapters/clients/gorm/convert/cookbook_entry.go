@@ -0,0 +1,34 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// CookbookEntryFromCoreModel converts a core model to a gorm model.
+func CookbookEntryFromCoreModel(m cmodel.CookbookEntry) gmodel.CookbookEntry {
+	return gmodel.CookbookEntry{
+		CookbookEntryId: m.Id.CookbookEntryId,
+		CookbookId:      m.Parent.CookbookId.CookbookId,
+		RecipeId:        m.RecipeId.RecipeId,
+		Note:            m.Note,
+		Position:        m.Position,
+		CreateTime:      m.CreateTime,
+	}
+}
+
+// CookbookEntryToCoreModel converts a gorm model to a core model.
+func CookbookEntryToCoreModel(m gmodel.CookbookEntry) cmodel.CookbookEntry {
+	return cmodel.CookbookEntry{
+		Parent: cmodel.CookbookEntryParent{
+			CookbookId: cmodel.CookbookId{CookbookId: m.CookbookId},
+		},
+		Id: cmodel.CookbookEntryId{
+			CookbookEntryId: m.CookbookEntryId,
+		},
+		RecipeId:   cmodel.RecipeId{RecipeId: m.RecipeId},
+		Note:       m.Note,
+		Position:   m.Position,
+		CreateTime: m.CreateTime,
+	}
+}
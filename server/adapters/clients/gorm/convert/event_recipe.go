@@ -12,6 +12,7 @@ func EventRecipeFromCoreModel(eventRecipe cmodel.EventRecipe) (gmodel.EventRecip
 		RecipeId:      eventRecipe.RecipeId.RecipeId,
 		EventId:       eventRecipe.Parent.EventId,
 		CreateTime:    eventRecipe.CreateTime,
+		DoneTime:      eventRecipe.DoneTime,
 	}, nil
 }
 
@@ -24,6 +25,7 @@ func EventRecipeToCoreModel(gormEventRecipe gmodel.EventRecipe) (cmodel.EventRec
 			EventId: gormEventRecipe.EventId,
 		},
 		CreateTime: gormEventRecipe.CreateTime,
+		DoneTime:   gormEventRecipe.DoneTime,
 	}
 
 	return eventRecipe, nil
@@ -0,0 +1,44 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// ImageSetFromCoreModel converts a core model to a gorm model.
+func ImageSetFromCoreModel(m cmodel.ImageSet) gmodel.ImageSet {
+	gm := gmodel.ImageSet{
+		Placeholder: m.Placeholder,
+		Width:       m.Width,
+		Height:      m.Height,
+	}
+	for _, variant := range m.Variants {
+		gm.Variants = append(gm.Variants, gmodel.ImageVariant{
+			Size:        string(variant.Size),
+			ContentType: variant.ContentType,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			URI:         variant.URI,
+		})
+	}
+	return gm
+}
+
+// ImageSetToCoreModel converts a gorm model to a core model.
+func ImageSetToCoreModel(m gmodel.ImageSet) cmodel.ImageSet {
+	cm := cmodel.ImageSet{
+		Placeholder: m.Placeholder,
+		Width:       m.Width,
+		Height:      m.Height,
+	}
+	for _, variant := range m.Variants {
+		cm.Variants = append(cm.Variants, cmodel.ImageVariant{
+			Size:        cmodel.ImageSize(variant.Size),
+			ContentType: variant.ContentType,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			URI:         variant.URI,
+		})
+	}
+	return cm
+}
@@ -0,0 +1,54 @@
+package convert
+
+import (
+	"encoding/json"
+
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// IngredientFromCoreModel converts a core model to a gorm model.
+func IngredientFromCoreModel(m cmodel.Ingredient) (gmodel.Ingredient, error) {
+	var err error
+	ingredient := gmodel.Ingredient{
+		IngredientId:  m.Id.IngredientId,
+		Title:         m.Title,
+		Category:      m.Category,
+		Density:       m.Density,
+		CreatorUserId: m.CreatorId.UserId,
+		CreateTime:    m.CreateTime,
+		UpdateTime:    m.UpdateTime,
+	}
+
+	if m.Aliases != nil {
+		ingredient.Aliases, err = json.Marshal(m.Aliases)
+		if err != nil {
+			return gmodel.Ingredient{}, err
+		}
+	}
+
+	return ingredient, nil
+}
+
+// IngredientToCoreModel converts a gorm model to a core model.
+func IngredientToCoreModel(m gmodel.Ingredient) (cmodel.Ingredient, error) {
+	ingredient := cmodel.Ingredient{
+		Id: cmodel.IngredientId{
+			IngredientId: m.IngredientId,
+		},
+		Title:      m.Title,
+		Category:   m.Category,
+		Density:    m.Density,
+		CreatorId:  cmodel.UserId{UserId: m.CreatorUserId},
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+	}
+
+	if len(m.Aliases) > 0 {
+		if err := json.Unmarshal(m.Aliases, &ingredient.Aliases); err != nil {
+			return cmodel.Ingredient{}, err
+		}
+	}
+
+	return ingredient, nil
+}
@@ -1,6 +1,9 @@
 package convert
 
 import (
+	"encoding/json"
+	"time"
+
 	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
 	cmodel "github.com/jcfug8/daylear/server/core/model"
 )
@@ -8,14 +11,41 @@ import (
 // ListItemFromCoreModel converts a core model to a gorm model.
 func ListItemFromCoreModel(m cmodel.ListItem) (gmodel.ListItem, error) {
 	listItem := gmodel.ListItem{
-		ListItemId:     m.Id.ListItemId,
-		ListId:         m.Parent.ListId.ListId,
-		Title:          m.Title,
-		Points:         m.Points,
-		RecurrenceRule: m.RecurrenceRule,
-		ListSectionId:  m.ListSectionId,
-		CreateTime:     m.CreateTime,
-		UpdateTime:     m.UpdateTime,
+		ListItemId:              m.Id.ListItemId,
+		ListId:                  m.Parent.ListId.ListId,
+		Title:                   m.Title,
+		Points:                  m.Points,
+		RecurrenceRule:          m.RecurrenceRule,
+		ListSectionId:           m.ListSectionId,
+		CreateTime:              m.CreateTime,
+		UpdateTime:              m.UpdateTime,
+		CompletionWindowSeconds: int64(m.CompletionWindow.Seconds()),
+		NextDueTime:             m.NextDueTime,
+		ClaimedByUserId:         m.ClaimedById.UserId,
+	}
+
+	if len(m.SourceRecipeIds) > 0 {
+		recipeIds := make([]int64, 0, len(m.SourceRecipeIds))
+		for _, recipeId := range m.SourceRecipeIds {
+			recipeIds = append(recipeIds, recipeId.RecipeId)
+		}
+		var err error
+		listItem.SourceRecipeIds, err = json.Marshal(recipeIds)
+		if err != nil {
+			return gmodel.ListItem{}, err
+		}
+	}
+
+	if len(m.AssigneeIds) > 0 {
+		userIds := make([]int64, 0, len(m.AssigneeIds))
+		for _, userId := range m.AssigneeIds {
+			userIds = append(userIds, userId.UserId)
+		}
+		var err error
+		listItem.AssigneeIds, err = json.Marshal(userIds)
+		if err != nil {
+			return gmodel.ListItem{}, err
+		}
 	}
 
 	return listItem, nil
@@ -32,12 +62,35 @@ func ListItemToCoreModel(m gmodel.ListItem) (cmodel.ListItem, error) {
 				ListId: m.ListId,
 			},
 		},
-		Title:          m.Title,
-		Points:         m.Points,
-		RecurrenceRule: m.RecurrenceRule,
-		ListSectionId:  m.ListSectionId,
-		CreateTime:     m.CreateTime,
-		UpdateTime:     m.UpdateTime,
+		Title:            m.Title,
+		Points:           m.Points,
+		RecurrenceRule:   m.RecurrenceRule,
+		ListSectionId:    m.ListSectionId,
+		CreateTime:       m.CreateTime,
+		UpdateTime:       m.UpdateTime,
+		CompletionWindow: time.Duration(m.CompletionWindowSeconds) * time.Second,
+		NextDueTime:      m.NextDueTime,
+		ClaimedById:      cmodel.UserId{UserId: m.ClaimedByUserId},
+	}
+
+	if len(m.SourceRecipeIds) > 0 {
+		var recipeIds []int64
+		if err := json.Unmarshal(m.SourceRecipeIds, &recipeIds); err != nil {
+			return cmodel.ListItem{}, err
+		}
+		for _, recipeId := range recipeIds {
+			listItem.SourceRecipeIds = append(listItem.SourceRecipeIds, cmodel.RecipeId{RecipeId: recipeId})
+		}
+	}
+
+	if len(m.AssigneeIds) > 0 {
+		var userIds []int64
+		if err := json.Unmarshal(m.AssigneeIds, &userIds); err != nil {
+			return cmodel.ListItem{}, err
+		}
+		for _, userId := range userIds {
+			listItem.AssigneeIds = append(listItem.AssigneeIds, cmodel.UserId{UserId: userId})
+		}
 	}
 
 	return listItem, nil
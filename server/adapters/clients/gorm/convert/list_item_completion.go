@@ -0,0 +1,46 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// ListItemCompletionFromCoreModel converts a core model to a gorm model.
+func ListItemCompletionFromCoreModel(m cmodel.ListItemCompletion) gmodel.ListItemCompletion {
+	return gmodel.ListItemCompletion{
+		ListItemCompletionId: m.Id.ListItemCompletionId,
+		ListId:               m.Parent.ListId.ListId,
+		ListItemId:           m.Parent.ListItemId.ListItemId,
+		Title:                m.Title,
+		Points:               m.Points,
+		ListSectionId:        m.ListSectionId,
+		UserId:               m.UserId.UserId,
+		CircleId:             m.CircleId.CircleId,
+		CompleteTime:         m.CompleteTime,
+		CompletedByAssignee:  m.CompletedByAssignee,
+		CreateTime:           m.CreateTime,
+		UpdateTime:           m.UpdateTime,
+	}
+}
+
+// ListItemCompletionToCoreModel converts a gorm model to a core model.
+func ListItemCompletionToCoreModel(m gmodel.ListItemCompletion) cmodel.ListItemCompletion {
+	return cmodel.ListItemCompletion{
+		Parent: cmodel.ListItemCompletionParent{
+			ListId:     cmodel.ListId{ListId: m.ListId},
+			ListItemId: cmodel.ListItemId{ListItemId: m.ListItemId},
+		},
+		Id: cmodel.ListItemCompletionId{
+			ListItemCompletionId: m.ListItemCompletionId,
+		},
+		Title:               m.Title,
+		Points:              m.Points,
+		ListSectionId:       m.ListSectionId,
+		UserId:              cmodel.UserId{UserId: m.UserId},
+		CircleId:            cmodel.CircleId{CircleId: m.CircleId},
+		CompleteTime:        m.CompleteTime,
+		CompletedByAssignee: m.CompletedByAssignee,
+		CreateTime:          m.CreateTime,
+		UpdateTime:          m.UpdateTime,
+	}
+}
@@ -0,0 +1,78 @@
+package convert
+
+import (
+	"encoding/json"
+
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// OperationFromCoreModel converts a core model to a gorm model.
+func OperationFromCoreModel(m cmodel.Operation) (gmodel.Operation, error) {
+	operation := gmodel.Operation{
+		OperationId: m.Id.OperationId,
+		UserId:      m.Parent.UserId,
+		Kind:        string(m.Kind),
+		State:       int(m.State),
+		Error:       m.Error,
+		CreateTime:  m.CreateTime,
+		UpdateTime:  m.UpdateTime,
+		EndTime:     m.EndTime,
+		ExpireTime:  m.ExpireTime,
+
+		LeaseOwner:      m.LeaseOwner,
+		LeaseExpireTime: m.LeaseExpireTime,
+	}
+
+	var err error
+	operation.Request, err = json.Marshal(m.Request)
+	if err != nil {
+		return gmodel.Operation{}, err
+	}
+
+	if m.Result != nil {
+		operation.Result, err = json.Marshal(m.Result)
+		if err != nil {
+			return gmodel.Operation{}, err
+		}
+	}
+
+	return operation, nil
+}
+
+// OperationToCoreModel converts a gorm model to a core model.
+func OperationToCoreModel(m gmodel.Operation) (cmodel.Operation, error) {
+	operation := cmodel.Operation{
+		Parent: cmodel.OperationParent{
+			UserId: m.UserId,
+		},
+		Id: cmodel.OperationId{
+			OperationId: m.OperationId,
+		},
+		Kind:       cmodel.OperationKind(m.Kind),
+		State:      cmodel.OperationState(m.State),
+		Error:      m.Error,
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+		EndTime:    m.EndTime,
+		ExpireTime: m.ExpireTime,
+
+		LeaseOwner:      m.LeaseOwner,
+		LeaseExpireTime: m.LeaseExpireTime,
+	}
+
+	if len(m.Request) > 0 {
+		if err := json.Unmarshal(m.Request, &operation.Request); err != nil {
+			return cmodel.Operation{}, err
+		}
+	}
+
+	if len(m.Result) > 0 && string(m.Result) != "null" {
+		operation.Result = &cmodel.OperationResult{}
+		if err := json.Unmarshal(m.Result, operation.Result); err != nil {
+			return cmodel.Operation{}, err
+		}
+	}
+
+	return operation, nil
+}
@@ -0,0 +1,53 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+// PantryFromCoreModel converts a core model to a gorm model.
+func PantryFromCoreModel(m cmodel.Pantry) gmodel.Pantry {
+	return gmodel.Pantry{
+		PantryId:        m.Id.PantryId,
+		Title:           m.Title,
+		Description:     m.Description,
+		VisibilityLevel: m.VisibilityLevel,
+		CreateTime:      m.CreateTime,
+		UpdateTime:      m.UpdateTime,
+	}
+}
+
+// PantryToCoreModel converts a gorm model to a core model.
+func PantryToCoreModel(m gmodel.Pantry) cmodel.Pantry {
+	permissionLevel := m.PermissionLevel
+	if m.VisibilityLevel == types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC && m.PermissionLevel == types.PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED {
+		permissionLevel = types.PermissionLevel_PERMISSION_LEVEL_PUBLIC
+	}
+
+	pantry := cmodel.Pantry{
+		Id: cmodel.PantryId{
+			PantryId: m.PantryId,
+		},
+		Title:           m.Title,
+		Description:     m.Description,
+		VisibilityLevel: m.VisibilityLevel,
+		CreateTime:      m.CreateTime,
+		UpdateTime:      m.UpdateTime,
+	}
+
+	// Populate PantryAccess if permission or state is set (i.e., join succeeded)
+	if m.PermissionLevel != 0 || m.State != 0 {
+		pantry.PantryAccess = cmodel.PantryAccess{
+			PantryAccessParent: cmodel.PantryAccessParent{
+				PantryId: cmodel.PantryId{PantryId: m.PantryId},
+			},
+			PantryAccessId:  cmodel.PantryAccessId{PantryAccessId: m.PantryAccessId},
+			PermissionLevel: permissionLevel,
+			State:           m.State,
+			AcceptTarget:    m.AcceptTarget,
+		}
+	}
+
+	return pantry
+}
@@ -0,0 +1,49 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// PantryAccessFromCoreModel converts a core PantryAccess to a GORM PantryAccess
+func PantryAccessFromCoreModel(pantryAccess cmodel.PantryAccess) gmodel.PantryAccess {
+	return gmodel.PantryAccess{
+		PantryAccessId:    pantryAccess.PantryAccessId.PantryAccessId,
+		PantryId:          pantryAccess.PantryAccessParent.PantryId.PantryId,
+		RequesterUserId:   pantryAccess.Requester.UserId,
+		RequesterCircleId: pantryAccess.Requester.CircleId,
+		RecipientUserId:   pantryAccess.Recipient.UserId,
+		RecipientCircleId: pantryAccess.Recipient.CircleId,
+		PermissionLevel:   pantryAccess.PermissionLevel,
+		State:             pantryAccess.State,
+		AcceptTarget:      pantryAccess.AcceptTarget,
+	}
+}
+
+// PantryAccessToCoreModel converts a GORM PantryAccess to a core PantryAccess
+func PantryAccessToCoreModel(gormPantryAccess gmodel.PantryAccess) cmodel.PantryAccess {
+	pantryAccess := cmodel.PantryAccess{
+		PantryAccessParent: cmodel.PantryAccessParent{
+			PantryId: cmodel.PantryId{PantryId: gormPantryAccess.PantryId},
+		},
+		PantryAccessId:  cmodel.PantryAccessId{PantryAccessId: gormPantryAccess.PantryAccessId},
+		PermissionLevel: gormPantryAccess.PermissionLevel,
+		State:           gormPantryAccess.State,
+		AcceptTarget:    gormPantryAccess.AcceptTarget,
+		Requester: cmodel.PantryRecipientOrRequester{
+			UserId:   gormPantryAccess.RequesterUserId,
+			CircleId: gormPantryAccess.RequesterCircleId,
+		},
+		Recipient: cmodel.PantryRecipientOrRequester{
+			UserId:   gormPantryAccess.RecipientUserId,
+			CircleId: gormPantryAccess.RecipientCircleId,
+		},
+		RecipientUsername:     gormPantryAccess.RecipientUsername,
+		RecipientGivenName:    gormPantryAccess.RecipientGivenName,
+		RecipientFamilyName:   gormPantryAccess.RecipientFamilyName,
+		RecipientCircleTitle:  gormPantryAccess.RecipientCircleTitle,
+		RecipientCircleHandle: gormPantryAccess.RecipientCircleHandle,
+	}
+
+	return pantryAccess
+}
@@ -0,0 +1,61 @@
+package convert
+
+import (
+	"time"
+
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// PantryItemFromCoreModel converts a core model to a gorm model.
+func PantryItemFromCoreModel(m cmodel.PantryItem) gmodel.PantryItem {
+	return gmodel.PantryItem{
+		PantryItemId: m.Id.PantryItemId,
+		PantryId:     m.Parent.PantryId.PantryId,
+		Title:        m.Title,
+		Quantity:     m.Quantity,
+		Unit:         m.Unit,
+		Location:     m.Location,
+		PurchaseTime: timeToNullable(m.PurchaseTime),
+		ExpireTime:   timeToNullable(m.ExpireTime),
+		CreateTime:   m.CreateTime,
+		UpdateTime:   m.UpdateTime,
+	}
+}
+
+// PantryItemToCoreModel converts a gorm model to a core model.
+func PantryItemToCoreModel(m gmodel.PantryItem) cmodel.PantryItem {
+	return cmodel.PantryItem{
+		Id: cmodel.PantryItemId{
+			PantryItemId: m.PantryItemId,
+		},
+		Parent: cmodel.PantryItemParent{
+			PantryId: cmodel.PantryId{
+				PantryId: m.PantryId,
+			},
+		},
+		Title:        m.Title,
+		Quantity:     m.Quantity,
+		Unit:         m.Unit,
+		Location:     m.Location,
+		PurchaseTime: timeFromNullable(m.PurchaseTime),
+		ExpireTime:   timeFromNullable(m.ExpireTime),
+		CreateTime:   m.CreateTime,
+		UpdateTime:   m.UpdateTime,
+	}
+}
+
+// timeToNullable stores an unknown (zero) time as NULL.
+func timeToNullable(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeFromNullable(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
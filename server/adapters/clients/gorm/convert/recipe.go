@@ -17,6 +17,7 @@ func RecipeFromCoreModel(m cmodel.Recipe) (gmodel.Recipe, error) {
 		Title:                m.Title,
 		Description:          m.Description,
 		ImageURI:             m.ImageURI,
+		ImageSet:             ImageSetFromCoreModel(m.ImageSet),
 		VisibilityLevel:      m.VisibilityLevel,
 		Citation:             m.Citation,
 		PrepDurationSeconds:  int64(m.PrepDuration.Seconds()),
@@ -28,6 +29,10 @@ func RecipeFromCoreModel(m cmodel.Recipe) (gmodel.Recipe, error) {
 		UpdateTime:           m.UpdateTime,
 	}
 
+	if m.ForkedFrom.RecipeId != 0 {
+		recipe.ForkedFromRecipeId = &m.ForkedFrom.RecipeId
+	}
+
 	recipe.Directions, err = json.Marshal(m.Directions)
 	if err != nil {
 		return gmodel.Recipe{}, err
@@ -52,6 +57,29 @@ func RecipeFromCoreModel(m cmodel.Recipe) (gmodel.Recipe, error) {
 		}
 	}
 
+	recipe.Nutrition, err = json.Marshal(m.Nutrition)
+	if err != nil {
+		return gmodel.Recipe{}, err
+	}
+
+	if m.Diets != nil {
+		recipe.Diets, err = json.Marshal(m.Diets)
+		if err != nil {
+			return gmodel.Recipe{}, err
+		}
+	}
+	if m.Allergens != nil {
+		recipe.Allergens, err = json.Marshal(m.Allergens)
+		if err != nil {
+			return gmodel.Recipe{}, err
+		}
+	}
+
+	recipe.DietaryOverrides, err = json.Marshal(m.DietaryOverrides)
+	if err != nil {
+		return gmodel.Recipe{}, err
+	}
+
 	return recipe, nil
 }
 
@@ -70,6 +98,7 @@ func RecipeToCoreModel(m gmodel.Recipe) (cmodel.Recipe, error) {
 		Title:           m.Title,
 		Description:     m.Description,
 		ImageURI:        m.ImageURI,
+		ImageSet:        ImageSetToCoreModel(m.ImageSet),
 		VisibilityLevel: m.VisibilityLevel,
 		Citation:        m.Citation,
 		PrepDuration:    time.Duration(m.PrepDurationSeconds) * time.Second,
@@ -85,6 +114,15 @@ func RecipeToCoreModel(m gmodel.Recipe) (cmodel.Recipe, error) {
 		recipe.Favorited = true
 	}
 
+	if m.ForkedFromRecipeId != nil {
+		recipe.ForkedFrom = cmodel.RecipeId{RecipeId: *m.ForkedFromRecipeId}
+	}
+	recipe.ForkCount = m.ForkCount
+	recipe.AverageRating = m.AverageRating
+	recipe.RatingCount = m.RatingCount
+	recipe.LastCookedTime = m.LastCookedTime
+	recipe.TimesCooked = m.TimesCooked
+
 	// Populate RecipeAccess if permission or state is set (i.e., join succeeded)
 	if m.PermissionLevel != 0 || m.State != 0 {
 		recipe.RecipeAccess = cmodel.RecipeAccess{
@@ -124,6 +162,30 @@ func RecipeToCoreModel(m gmodel.Recipe) (cmodel.Recipe, error) {
 			return cmodel.Recipe{}, err
 		}
 	}
+	if m.Nutrition != nil {
+		err = json.Unmarshal(m.Nutrition, &recipe.Nutrition)
+		if err != nil {
+			return cmodel.Recipe{}, err
+		}
+	}
+	if m.Diets != nil {
+		err = json.Unmarshal(m.Diets, &recipe.Diets)
+		if err != nil {
+			return cmodel.Recipe{}, err
+		}
+	}
+	if m.Allergens != nil {
+		err = json.Unmarshal(m.Allergens, &recipe.Allergens)
+		if err != nil {
+			return cmodel.Recipe{}, err
+		}
+	}
+	if m.DietaryOverrides != nil {
+		err = json.Unmarshal(m.DietaryOverrides, &recipe.DietaryOverrides)
+		if err != nil {
+			return cmodel.Recipe{}, err
+		}
+	}
 
 	return recipe, nil
 }
@@ -0,0 +1,73 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// RecipeCookLogFromCoreModel converts a core model to a gorm model.
+func RecipeCookLogFromCoreModel(m cmodel.RecipeCookLog) gmodel.RecipeCookLog {
+	gm := gmodel.RecipeCookLog{
+		RecipeCookLogId: m.Id.RecipeCookLogId,
+		RecipeId:        m.Parent.RecipeId.RecipeId,
+		CookUserId:      m.CookId.UserId,
+		CookTime:        m.CookTime,
+		Rating:          m.Rating,
+		Notes:           m.Notes,
+		Photos:          make([]gmodel.RecipeCookLogPhoto, 0, len(m.Photos)),
+		CreateTime:      m.CreateTime,
+		UpdateTime:      m.UpdateTime,
+	}
+
+	for _, photo := range m.Photos {
+		gm.Photos = append(gm.Photos, gmodel.RecipeCookLogPhoto{
+			ImageURI: photo.ImageURI,
+			ImageSet: ImageSetFromCoreModel(photo.ImageSet),
+		})
+	}
+
+	if m.EventRecipe != nil {
+		gm.EventRecipeCalendarId = &m.EventRecipe.Parent.CalendarId
+		gm.EventRecipeEventId = &m.EventRecipe.Parent.EventId
+		gm.EventRecipeId = &m.EventRecipe.Id.EventRecipeId
+	}
+
+	return gm
+}
+
+// RecipeCookLogToCoreModel converts a gorm model to a core model.
+func RecipeCookLogToCoreModel(m gmodel.RecipeCookLog) cmodel.RecipeCookLog {
+	cookLog := cmodel.RecipeCookLog{
+		Parent: cmodel.RecipeCookLogParent{
+			RecipeId: cmodel.RecipeId{RecipeId: m.RecipeId},
+		},
+		Id: cmodel.RecipeCookLogId{
+			RecipeCookLogId: m.RecipeCookLogId,
+		},
+		CookId:     cmodel.UserId{UserId: m.CookUserId},
+		CookTime:   m.CookTime,
+		Rating:     m.Rating,
+		Notes:      m.Notes,
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+	}
+
+	for _, photo := range m.Photos {
+		cookLog.Photos = append(cookLog.Photos, cmodel.RecipeCookLogPhoto{
+			ImageURI: photo.ImageURI,
+			ImageSet: ImageSetToCoreModel(photo.ImageSet),
+		})
+	}
+
+	if m.EventRecipeCalendarId != nil && m.EventRecipeEventId != nil && m.EventRecipeId != nil {
+		cookLog.EventRecipe = &cmodel.RecipeCookLogEventRecipe{
+			Parent: cmodel.EventRecipeParent{
+				CalendarId: *m.EventRecipeCalendarId,
+				EventId:    *m.EventRecipeEventId,
+			},
+			Id: cmodel.EventRecipeId{EventRecipeId: *m.EventRecipeId},
+		}
+	}
+
+	return cookLog
+}
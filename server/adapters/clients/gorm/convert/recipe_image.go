@@ -0,0 +1,46 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// RecipeImageFromCoreModel converts a core model to a gorm model.
+func RecipeImageFromCoreModel(m cmodel.RecipeImage) gmodel.RecipeImage {
+	gm := gmodel.RecipeImage{
+		RecipeImageId: m.Id.RecipeImageId,
+		RecipeId:      m.Parent.RecipeId.RecipeId,
+		ImageURI:      m.ImageURI,
+		ImageSet:      ImageSetFromCoreModel(m.ImageSet),
+		Position:      m.Position,
+		CreateTime:    m.CreateTime,
+	}
+	if m.Step != nil {
+		gm.DirectionIndex = &m.Step.DirectionIndex
+		gm.StepIndex = &m.Step.StepIndex
+	}
+	return gm
+}
+
+// RecipeImageToCoreModel converts a gorm model to a core model.
+func RecipeImageToCoreModel(m gmodel.RecipeImage) cmodel.RecipeImage {
+	cm := cmodel.RecipeImage{
+		Parent: cmodel.RecipeImageParent{
+			RecipeId: cmodel.RecipeId{RecipeId: m.RecipeId},
+		},
+		Id: cmodel.RecipeImageId{
+			RecipeImageId: m.RecipeImageId,
+		},
+		ImageURI:   m.ImageURI,
+		ImageSet:   ImageSetToCoreModel(m.ImageSet),
+		Position:   m.Position,
+		CreateTime: m.CreateTime,
+	}
+	if m.DirectionIndex != nil && m.StepIndex != nil {
+		cm.Step = &cmodel.RecipeDirectionStep{
+			DirectionIndex: *m.DirectionIndex,
+			StepIndex:      *m.StepIndex,
+		}
+	}
+	return cm
+}
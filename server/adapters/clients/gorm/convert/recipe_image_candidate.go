@@ -0,0 +1,56 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// RecipeImageCandidateFromCoreModel converts a core model to a gorm model.
+func RecipeImageCandidateFromCoreModel(m cmodel.RecipeImageCandidate) gmodel.RecipeImageCandidate {
+	return gmodel.RecipeImageCandidate{
+		RecipeImageCandidateId: m.Id.RecipeImageCandidateId,
+		RecipeId:               m.Parent.RecipeId.RecipeId,
+		CreatorUserId:          m.CreatorId.UserId,
+		ImageURI:               m.ImageURI,
+		CreateTime:             m.CreateTime,
+	}
+}
+
+// RecipeImageCandidateToCoreModel converts a gorm model to a core model.
+func RecipeImageCandidateToCoreModel(m gmodel.RecipeImageCandidate) cmodel.RecipeImageCandidate {
+	return cmodel.RecipeImageCandidate{
+		Parent: cmodel.RecipeImageCandidateParent{
+			RecipeId: cmodel.RecipeId{RecipeId: m.RecipeId},
+		},
+		Id: cmodel.RecipeImageCandidateId{
+			RecipeImageCandidateId: m.RecipeImageCandidateId,
+		},
+		CreatorId:  cmodel.UserId{UserId: m.CreatorUserId},
+		ImageURI:   m.ImageURI,
+		CreateTime: m.CreateTime,
+	}
+}
+
+// RecipeImageGenerationFromCoreModel converts a core model to a gorm model.
+func RecipeImageGenerationFromCoreModel(m cmodel.RecipeImageGeneration) gmodel.RecipeImageGeneration {
+	return gmodel.RecipeImageGeneration{
+		RecipeImageGenerationId: m.Id.RecipeImageGenerationId,
+		UserId:                  m.UserId.UserId,
+		RecipeId:                m.RecipeId.RecipeId,
+		Count:                   m.Count,
+		CreateTime:              m.CreateTime,
+	}
+}
+
+// RecipeImageGenerationToCoreModel converts a gorm model to a core model.
+func RecipeImageGenerationToCoreModel(m gmodel.RecipeImageGeneration) cmodel.RecipeImageGeneration {
+	return cmodel.RecipeImageGeneration{
+		Id: cmodel.RecipeImageGenerationId{
+			RecipeImageGenerationId: m.RecipeImageGenerationId,
+		},
+		UserId:     cmodel.UserId{UserId: m.UserId},
+		RecipeId:   cmodel.RecipeId{RecipeId: m.RecipeId},
+		Count:      m.Count,
+		CreateTime: m.CreateTime,
+	}
+}
@@ -0,0 +1,56 @@
+package convert
+
+import (
+	"encoding/json"
+
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// RecipeImportFromCoreModel converts a core model to a gorm model.
+func RecipeImportFromCoreModel(m cmodel.RecipeImport) (gmodel.RecipeImport, error) {
+	recipeImport := gmodel.RecipeImport{
+		RecipeImportId: m.Id.RecipeImportId,
+		UserId:         m.Parent.UserId,
+		Source:         m.Source,
+		State:          int(m.State),
+		Error:          m.Error,
+		CreateTime:     m.CreateTime,
+		CompleteTime:   m.CompleteTime,
+	}
+
+	if m.Results != nil {
+		var err error
+		recipeImport.Results, err = json.Marshal(m.Results)
+		if err != nil {
+			return gmodel.RecipeImport{}, err
+		}
+	}
+
+	return recipeImport, nil
+}
+
+// RecipeImportToCoreModel converts a gorm model to a core model.
+func RecipeImportToCoreModel(m gmodel.RecipeImport) (cmodel.RecipeImport, error) {
+	recipeImport := cmodel.RecipeImport{
+		Parent: cmodel.RecipeImportParent{
+			UserId: m.UserId,
+		},
+		Id: cmodel.RecipeImportId{
+			RecipeImportId: m.RecipeImportId,
+		},
+		Source:       m.Source,
+		State:        cmodel.RecipeImportState(m.State),
+		Error:        m.Error,
+		CreateTime:   m.CreateTime,
+		CompleteTime: m.CompleteTime,
+	}
+
+	if len(m.Results) > 0 {
+		if err := json.Unmarshal(m.Results, &recipeImport.Results); err != nil {
+			return cmodel.RecipeImport{}, err
+		}
+	}
+
+	return recipeImport, nil
+}
@@ -0,0 +1,62 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// RecipeNoteFromCoreModel converts a core model to a gorm model.
+func RecipeNoteFromCoreModel(m cmodel.RecipeNote) gmodel.RecipeNote {
+	gm := gmodel.RecipeNote{
+		RecipeNoteId: m.Id.RecipeNoteId,
+		RecipeId:     m.Parent.RecipeId.RecipeId,
+		AuthorUserId: m.AuthorId.UserId,
+		Text:         m.Text,
+		CreateTime:   m.CreateTime,
+		UpdateTime:   m.UpdateTime,
+	}
+
+	if m.Ingredient != nil {
+		gm.GroupIndex = &m.Ingredient.GroupIndex
+		gm.IngredientIndex = &m.Ingredient.IngredientIndex
+	}
+
+	if m.Step != nil {
+		gm.DirectionIndex = &m.Step.DirectionIndex
+		gm.StepIndex = &m.Step.StepIndex
+	}
+
+	return gm
+}
+
+// RecipeNoteToCoreModel converts a gorm model to a core model.
+func RecipeNoteToCoreModel(m gmodel.RecipeNote) cmodel.RecipeNote {
+	note := cmodel.RecipeNote{
+		Parent: cmodel.RecipeNoteParent{
+			RecipeId: cmodel.RecipeId{RecipeId: m.RecipeId},
+		},
+		Id: cmodel.RecipeNoteId{
+			RecipeNoteId: m.RecipeNoteId,
+		},
+		AuthorId:   cmodel.UserId{UserId: m.AuthorUserId},
+		Text:       m.Text,
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+	}
+
+	if m.GroupIndex != nil && m.IngredientIndex != nil {
+		note.Ingredient = &cmodel.RecipeStepIngredient{
+			GroupIndex:      *m.GroupIndex,
+			IngredientIndex: *m.IngredientIndex,
+		}
+	}
+
+	if m.DirectionIndex != nil && m.StepIndex != nil {
+		note.Step = &cmodel.RecipeDirectionStep{
+			DirectionIndex: *m.DirectionIndex,
+			StepIndex:      *m.StepIndex,
+		}
+	}
+
+	return note
+}
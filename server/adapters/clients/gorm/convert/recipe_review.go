@@ -0,0 +1,36 @@
+package convert
+
+import (
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// RecipeReviewFromCoreModel converts a core model to a gorm model.
+func RecipeReviewFromCoreModel(m cmodel.RecipeReview) gmodel.RecipeReview {
+	return gmodel.RecipeReview{
+		RecipeReviewId: m.Id.RecipeReviewId,
+		RecipeId:       m.Parent.RecipeId.RecipeId,
+		AuthorUserId:   m.AuthorId.UserId,
+		Rating:         m.Rating,
+		Text:           m.Text,
+		CreateTime:     m.CreateTime,
+		UpdateTime:     m.UpdateTime,
+	}
+}
+
+// RecipeReviewToCoreModel converts a gorm model to a core model.
+func RecipeReviewToCoreModel(m gmodel.RecipeReview) cmodel.RecipeReview {
+	return cmodel.RecipeReview{
+		Parent: cmodel.RecipeReviewParent{
+			RecipeId: cmodel.RecipeId{RecipeId: m.RecipeId},
+		},
+		Id: cmodel.RecipeReviewId{
+			RecipeReviewId: m.RecipeReviewId,
+		},
+		AuthorId:   cmodel.UserId{UserId: m.AuthorUserId},
+		Rating:     m.Rating,
+		Text:       m.Text,
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+	}
+}
@@ -0,0 +1,71 @@
+package convert
+
+import (
+	"encoding/json"
+
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+// RecipeRevisionFromCoreModel converts a core model to a gorm model.
+func RecipeRevisionFromCoreModel(m cmodel.RecipeRevision) (gmodel.RecipeRevision, error) {
+	var err error
+	revision := gmodel.RecipeRevision{
+		RecipeRevisionId: m.Id.RecipeRevisionId,
+		RecipeId:         m.Parent.RecipeId.RecipeId,
+		EditorUserId:     m.EditorId.UserId,
+		CreateTime:       m.CreateTime,
+	}
+
+	if m.UpdateMask != nil {
+		revision.UpdateMask, err = json.Marshal(m.UpdateMask)
+		if err != nil {
+			return gmodel.RecipeRevision{}, err
+		}
+	}
+
+	// the snapshot only holds the recipe itself, not the caller specific data
+	snapshot := m.Recipe
+	snapshot.Parent = cmodel.RecipeParent{}
+	snapshot.RecipeAccess = cmodel.RecipeAccess{}
+	snapshot.Favorited = false
+	snapshot.ForkCount = 0
+	snapshot.AverageRating = 0
+	snapshot.RatingCount = 0
+	snapshot.LastCookedTime = nil
+	snapshot.TimesCooked = 0
+	snapshot.PrivateNotes = nil
+	revision.Snapshot, err = json.Marshal(snapshot)
+	if err != nil {
+		return gmodel.RecipeRevision{}, err
+	}
+
+	return revision, nil
+}
+
+// RecipeRevisionToCoreModel converts a gorm model to a core model.
+func RecipeRevisionToCoreModel(m gmodel.RecipeRevision) (cmodel.RecipeRevision, error) {
+	revision := cmodel.RecipeRevision{
+		Parent: cmodel.RecipeRevisionParent{
+			RecipeId: cmodel.RecipeId{RecipeId: m.RecipeId},
+		},
+		Id: cmodel.RecipeRevisionId{
+			RecipeRevisionId: m.RecipeRevisionId,
+		},
+		EditorId:   cmodel.UserId{UserId: m.EditorUserId},
+		CreateTime: m.CreateTime,
+	}
+
+	if len(m.UpdateMask) > 0 {
+		if err := json.Unmarshal(m.UpdateMask, &revision.UpdateMask); err != nil {
+			return cmodel.RecipeRevision{}, err
+		}
+	}
+	if len(m.Snapshot) > 0 {
+		if err := json.Unmarshal(m.Snapshot, &revision.Recipe); err != nil {
+			return cmodel.RecipeRevision{}, err
+		}
+	}
+
+	return revision, nil
+}
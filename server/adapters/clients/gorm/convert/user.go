@@ -1,6 +1,8 @@
 package convert
 
 import (
+	"encoding/json"
+
 	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
 	cmodel "github.com/jcfug8/daylear/server/core/model"
 )
@@ -13,6 +15,7 @@ func UserFromCoreModel(m cmodel.User) (gmodel.User, error) {
 		GivenName:  m.GivenName,
 		FamilyName: m.FamilyName,
 		ImageUri:   m.ImageUri,
+		ImageSet:   ImageSetFromCoreModel(m.ImageSet),
 		Bio:        m.Bio,
 
 		Email: m.Email,
@@ -30,6 +33,12 @@ func UserFromCoreModel(m cmodel.User) (gmodel.User, error) {
 		u.GoogleId = &m.GoogleId
 	}
 
+	dietaryRestrictions, err := json.Marshal(m.DietaryRestrictions)
+	if err != nil {
+		return gmodel.User{}, err
+	}
+	u.DietaryRestrictions = dietaryRestrictions
+
 	return u, nil
 }
 
@@ -43,6 +52,7 @@ func UserToCoreModel(m gmodel.User) (cmodel.User, error) {
 		GivenName:  m.GivenName,
 		FamilyName: m.FamilyName,
 		ImageUri:   m.ImageUri,
+		ImageSet:   ImageSetToCoreModel(m.ImageSet),
 		Bio:        m.Bio,
 		Favorited:  m.UserFavoriteId != 0,
 
@@ -77,6 +87,13 @@ func UserToCoreModel(m gmodel.User) (cmodel.User, error) {
 		u.GoogleId = *m.GoogleId
 	}
 
+	if m.DietaryRestrictions != nil {
+		err := json.Unmarshal(m.DietaryRestrictions, &u.DietaryRestrictions)
+		if err != nil {
+			return cmodel.User{}, err
+		}
+	}
+
 	return u, nil
 }
 
@@ -0,0 +1,172 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// ListCookbooks lists cookbooks.
+func (repo *Client) ListCookbooks(ctx context.Context, authAccount cmodel.AuthAccount, pageSize int32, pageOffset int32, filter string, fields []string) ([]cmodel.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Str("filter", filter).
+		Strs("fields", fields).
+		Int("pageSize", int(pageSize)).
+		Int("pageOffset", int(pageOffset)).
+		Logger()
+
+	if authAccount.PermissionLevel < types.PermissionLevel_PERMISSION_LEVEL_PUBLIC {
+		return nil, repository.ErrInvalidArgument{Msg: "invalid permission level"}
+	}
+
+	dbCookbooks := []gmodel.Cookbook{}
+
+	orders := []clause.OrderByColumn{{
+		Column: clause.Column{Name: "cookbook.cookbook_id"},
+		Desc:   true,
+	}}
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookFieldMasker.Convert(fields)).
+		Order(clause.OrderBy{Columns: orders}).
+		Limit(int(pageSize)).
+		Offset(int(pageOffset))
+
+	if authAccount.CircleId != 0 {
+		maxVisibilityLevel := types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC
+		if authAccount.PermissionLevel > types.PermissionLevel_PERMISSION_LEVEL_PUBLIC {
+			maxVisibilityLevel = types.VisibilityLevel_VISIBILITY_LEVEL_PRIVATE
+		}
+		tx = tx.Joins("LEFT JOIN cookbook_access ON cookbook.cookbook_id = cookbook_access.cookbook_id AND cookbook_access.recipient_circle_id = ? AND (cookbook.visibility_level != ? OR cookbook_access.permission_level = ?)", authAccount.CircleId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Joins("LEFT JOIN cookbook_access as ca ON cookbook.cookbook_id = ca.cookbook_id AND ca.recipient_user_id = ? AND (cookbook.visibility_level != ? OR ca.permission_level = ?)", authAccount.AuthUserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Where("(cookbook.visibility_level <= ? OR (cookbook_access.recipient_circle_id = ? AND ca.state = ?))",
+				maxVisibilityLevel, authAccount.CircleId, types.AccessState_ACCESS_STATE_ACCEPTED)
+	} else if authAccount.UserId != 0 && authAccount.UserId != authAccount.AuthUserId {
+		maxVisibilityLevel := types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC
+		if authAccount.PermissionLevel > types.PermissionLevel_PERMISSION_LEVEL_PUBLIC {
+			maxVisibilityLevel = types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED
+		}
+		tx = tx.Joins("LEFT JOIN cookbook_access ON cookbook.cookbook_id = cookbook_access.cookbook_id AND cookbook_access.recipient_user_id = ? AND (cookbook.visibility_level != ? OR cookbook_access.permission_level = ?)", authAccount.UserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Joins("LEFT JOIN cookbook_access as ca ON cookbook.cookbook_id = ca.cookbook_id AND ca.recipient_user_id = ? AND (cookbook.visibility_level != ? OR ca.permission_level = ?)", authAccount.AuthUserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Where("(cookbook.visibility_level <= ? OR (cookbook_access.recipient_user_id = ? AND ca.state = ?))",
+				maxVisibilityLevel, authAccount.UserId, types.AccessState_ACCESS_STATE_ACCEPTED)
+	} else {
+		tx = tx.Joins("LEFT JOIN cookbook_access ON cookbook.cookbook_id = cookbook_access.cookbook_id AND cookbook_access.recipient_user_id = ? AND (cookbook.visibility_level != ? OR cookbook_access.permission_level = ?)", authAccount.AuthUserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Where("(cookbook.visibility_level = ? OR cookbook_access.recipient_user_id = ?)", types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, authAccount.AuthUserId)
+	}
+
+	conversion, err := gmodel.CookbookSQLConverter.Convert(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid filter string when listing cookbook rows")
+		return nil, repository.ErrInvalidArgument{Msg: "invalid filter"}
+	}
+
+	if conversion.WhereClause != "" {
+		tx = tx.Where(conversion.WhereClause, conversion.Params...)
+	}
+
+	err = tx.Find(&dbCookbooks).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list cookbook rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.Cookbook, len(dbCookbooks))
+	for i, m := range dbCookbooks {
+		res[i] = convert.CookbookToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// CreateCookbook creates a new cookbook.
+func (repo *Client) CreateCookbook(ctx context.Context, authAccount cmodel.AuthAccount, m cmodel.Cookbook) (cmodel.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx)
+
+	gm := convert.CookbookFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookFieldMasker.Convert([]string{})).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create cookbook row")
+		return cmodel.Cookbook{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookToCoreModel(gm), nil
+}
+
+// DeleteCookbook deletes a cookbook.
+func (repo *Client) DeleteCookbook(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.CookbookId) (cmodel.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", id.CookbookId).
+		Logger()
+
+	gm := gmodel.Cookbook{CookbookId: id.CookbookId}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookFieldMasker.Get()).
+		Clauses(clause.Returning{}).
+		Delete(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete cookbook row")
+		return cmodel.Cookbook{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookToCoreModel(gm), nil
+}
+
+// GetCookbook gets a cookbook.
+func (repo *Client) GetCookbook(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.CookbookId, fields []string) (cmodel.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", id.CookbookId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.Cookbook{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookFieldMasker.Convert(
+			fields,
+			fieldmask.ExcludeKeys(
+				cmodel.CookbookField_CookbookAccess,
+			),
+		)).
+		Where("cookbook.cookbook_id = ?", id.CookbookId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get cookbook row")
+		return cmodel.Cookbook{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookToCoreModel(gm), nil
+}
+
+// UpdateCookbook updates a cookbook.
+func (repo *Client) UpdateCookbook(ctx context.Context, authAccount cmodel.AuthAccount, m cmodel.Cookbook, fields []string) (cmodel.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", m.Id.CookbookId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.CookbookFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(&clause.Returning{}).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update cookbook row")
+		return cmodel.Cookbook{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookToCoreModel(gm), nil
+}
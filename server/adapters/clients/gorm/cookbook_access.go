@@ -0,0 +1,309 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	dbModel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (repo *Client) CreateCookbookAccess(ctx context.Context, access cmodel.CookbookAccess, fields []string) (cmodel.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", access.CookbookId.CookbookId).
+		Int64("recipientUserId", access.Recipient.UserId).
+		Int64("recipientCircleId", access.Recipient.CircleId).
+		Logger()
+
+	// Validate that exactly one recipient type is set
+	if (access.Recipient.UserId != 0) == (access.Recipient.CircleId != 0) {
+		log.Error().Msg("exactly one recipient (user or circle) is required to create cookbook access row")
+		return cmodel.CookbookAccess{}, repository.ErrInvalidArgument{Msg: "exactly one recipient (user or circle) is required"}
+	}
+
+	cookbookAccess := convert.CookbookAccessFromCoreModel(access)
+	res := repo.db.WithContext(ctx).
+		Select(dbModel.CookbookAccessFieldMasker.Convert(fields)).
+		Clauses(clause.Returning{}).
+		Create(&cookbookAccess)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrDuplicatedKey) {
+			log.Error().Err(res.Error).Msg("unable to create cookbook access row: already exists")
+			return cmodel.CookbookAccess{}, repository.ErrNewAlreadyExists{}
+		}
+		log.Error().Err(res.Error).Msg("unable to create cookbook access row")
+		return cmodel.CookbookAccess{}, ConvertGormError(res.Error)
+	}
+
+	access.CookbookAccessId.CookbookAccessId = cookbookAccess.CookbookAccessId
+	return access, nil
+}
+
+func (repo *Client) DeleteCookbookAccess(ctx context.Context, parent cmodel.CookbookAccessParent, id cmodel.CookbookAccessId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Int64("cookbookAccessId", id.CookbookAccessId).
+		Logger()
+
+	if parent.CookbookId.CookbookId == 0 {
+		log.Error().Msg("cookbook id is required to delete cookbook access row")
+		return repository.ErrInvalidArgument{Msg: "cookbook id is required"}
+	}
+
+	if id.CookbookAccessId == 0 {
+		log.Error().Msg("cookbook access id is required to delete cookbook access row")
+		return repository.ErrInvalidArgument{Msg: "cookbook access id is required"}
+	}
+
+	res := repo.db.WithContext(ctx).
+		Where("cookbook_id = ? AND cookbook_access_id = ?", parent.CookbookId.CookbookId, id.CookbookAccessId).
+		Delete(&dbModel.CookbookAccess{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to delete cookbook access row")
+		return ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("no cookbook access row deleted")
+		return repository.ErrNotFound{}
+	}
+
+	return nil
+}
+
+func (repo *Client) BulkDeleteCookbookAccess(ctx context.Context, parent cmodel.CookbookAccessParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Logger()
+
+	if parent.CookbookId.CookbookId == 0 {
+		log.Error().Msg("cookbook id is required to bulk delete cookbook access rows")
+		return repository.ErrInvalidArgument{Msg: "cookbook id is required"}
+	}
+
+	res := repo.db.WithContext(ctx).
+		Where("cookbook_id = ?", parent.CookbookId.CookbookId).
+		Delete(&dbModel.CookbookAccess{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to bulk delete cookbook access rows")
+		return ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("no cookbook access rows deleted")
+		return repository.ErrNotFound{}
+	}
+
+	return nil
+}
+
+func (repo *Client) GetCookbookAccess(ctx context.Context, parent cmodel.CookbookAccessParent, id cmodel.CookbookAccessId, fields []string) (cmodel.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Int64("cookbookAccessId", id.CookbookAccessId).
+		Logger()
+
+	dbFields := dbModel.CookbookAccessFieldMasker.Convert(fields)
+
+	var cookbookAccess dbModel.CookbookAccess
+	tx := repo.db.WithContext(ctx).
+		Select(dbFields).
+		Where("cookbook_access.cookbook_id = ? AND cookbook_access.cookbook_access_id = ?", parent.CookbookId.CookbookId, id.CookbookAccessId)
+
+	if fieldmask.ContainsAny(dbFields, dbModel.CookbookAccessFields_RecipientUserId, dbModel.CookbookAccessFields_RecipientCircleId) {
+		tx = tx.Joins(`LEFT JOIN daylear_user ON cookbook_access.recipient_user_id = daylear_user.user_id`).
+			Joins(`LEFT JOIN circle ON cookbook_access.recipient_circle_id = circle.circle_id`)
+	}
+
+	res := tx.First(&cookbookAccess)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("cookbook access row not found")
+			return cmodel.CookbookAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to get cookbook access row")
+		return cmodel.CookbookAccess{}, ConvertGormError(res.Error)
+	}
+
+	return convert.CookbookAccessToCoreModel(cookbookAccess), nil
+}
+
+func (repo *Client) ListCookbookAccesses(ctx context.Context, authAccount cmodel.AuthAccount, parent cmodel.CookbookAccessParent, pageSize int32, pageOffset int64, filterStr string, fields []string) ([]cmodel.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Str("filter", filterStr).
+		Int("pageSize", int(pageSize)).
+		Int64("pageOffset", pageOffset).
+		Logger()
+
+	if authAccount.AuthUserId == 0 && authAccount.CircleId == 0 {
+		log.Error().Msg("user id or circle id is required to list cookbook access rows")
+		return nil, repository.ErrInvalidArgument{Msg: "user id or circle id is required"}
+	}
+
+	orders := []clause.OrderByColumn{{
+		Column: clause.Column{Name: "cookbook_access.cookbook_access_id"},
+		Desc:   true,
+	}}
+
+	dbFields := dbModel.CookbookAccessFieldMasker.Convert(fields)
+
+	var cookbookAccesses []dbModel.CookbookAccess
+	// Start building the query
+	tx := repo.db.WithContext(ctx).
+		Select(dbFields).
+		Order(clause.OrderBy{Columns: orders}).
+		Limit(int(pageSize)).
+		Offset(int(pageOffset))
+
+	if fieldmask.ContainsAny(dbFields, dbModel.CookbookAccessFields_RecipientUserId, dbModel.CookbookAccessFields_RecipientCircleId) {
+		tx = tx.Joins(`LEFT JOIN daylear_user ON cookbook_access.recipient_user_id = daylear_user.user_id`).
+			Joins(`LEFT JOIN circle ON cookbook_access.recipient_circle_id = circle.circle_id`)
+	}
+
+	// Filter by cookbook ID if provided
+	if parent.CookbookId.CookbookId != 0 {
+		tx = tx.Where("cookbook_access.cookbook_id = ?", parent.CookbookId.CookbookId)
+	}
+
+	if authAccount.CircleId != 0 {
+		tx = tx.Where(
+			"cookbook_access.recipient_circle_id = ? OR cookbook_access.cookbook_id IN (SELECT cookbook_id FROM cookbook_access WHERE recipient_circle_id = ? AND permission_level >= ?)",
+			authAccount.CircleId, authAccount.CircleId, types.PermissionLevel_PERMISSION_LEVEL_WRITE,
+		)
+	} else if authAccount.AuthUserId != 0 {
+		tx = tx.Where(
+			"cookbook_access.recipient_user_id = ? OR cookbook_access.cookbook_id IN (SELECT cookbook_id FROM cookbook_access WHERE recipient_user_id = ? AND permission_level >= ?)",
+			authAccount.AuthUserId, authAccount.AuthUserId, types.PermissionLevel_PERMISSION_LEVEL_WRITE,
+		)
+	}
+
+	conversion, err := dbModel.CookbookAccessSQLConverter.Convert(filterStr)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid filter string when listing cookbook access rows")
+		return nil, repository.ErrInvalidArgument{Msg: "invalid filter: " + err.Error()}
+	}
+
+	if conversion.WhereClause != "" {
+		tx = tx.Where(conversion.WhereClause, conversion.Params...)
+	}
+
+	res := tx.Limit(int(pageSize)).
+		Offset(int(pageOffset)).
+		Find(&cookbookAccesses)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to list cookbook access rows")
+		return nil, ConvertGormError(res.Error)
+	}
+
+	accesses := make([]cmodel.CookbookAccess, len(cookbookAccesses))
+	for i, access := range cookbookAccesses {
+		accesses[i] = convert.CookbookAccessToCoreModel(access)
+	}
+
+	return accesses, nil
+}
+
+func (repo *Client) UpdateCookbookAccess(ctx context.Context, access cmodel.CookbookAccess, fields []string) (cmodel.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookAccessId", access.CookbookAccessId.CookbookAccessId).
+		Strs("fields", fields).
+		Logger()
+
+	dbAccess := convert.CookbookAccessFromCoreModel(access)
+
+	res := repo.db.WithContext(ctx).
+		Select(dbModel.CookbookAccessFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(&clause.Returning{}).
+		Where("cookbook_access_id = ?", access.CookbookAccessId.CookbookAccessId).
+		Updates(&dbAccess)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to update cookbook access row")
+		return cmodel.CookbookAccess{}, ConvertGormError(res.Error)
+	}
+
+	return convert.CookbookAccessToCoreModel(dbAccess), nil
+}
+
+func (repo *Client) FindStandardUserCookbookAccess(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.CookbookId) (cmodel.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", id.CookbookId).
+		Int64("authUserId", authAccount.AuthUserId).
+		Logger()
+
+	var cookbookAccess dbModel.CookbookAccess
+	res := repo.db.WithContext(ctx).
+		Where("cookbook_id = ? AND recipient_user_id = ?", id.CookbookId, authAccount.AuthUserId).
+		First(&cookbookAccess)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("standard user cookbook access not found")
+			return cmodel.CookbookAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to find standard user cookbook access")
+		return cmodel.CookbookAccess{}, ConvertGormError(res.Error)
+	}
+	return convert.CookbookAccessToCoreModel(cookbookAccess), nil
+}
+
+func (repo *Client) FindDelegatedCircleCookbookAccess(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.CookbookId) (cmodel.CookbookAccess, cmodel.CircleAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", id.CookbookId).
+		Int64("authUserId", authAccount.AuthUserId).
+		Logger()
+
+	type Result struct {
+		dbModel.CookbookAccess
+		dbModel.CircleAccess
+	}
+	var result Result
+	res := repo.db.WithContext(ctx).
+		Select("cookbook_access.*, circle_access.*").
+		Table("cookbook_access").
+		Joins("JOIN circle_access ON circle_access.circle_id = cookbook_access.recipient_circle_id").
+		Where("cookbook_access.cookbook_id = ? AND circle_access.recipient_user_id = ? AND cookbook_access.state = ?", id.CookbookId, authAccount.AuthUserId, types.AccessState_ACCESS_STATE_ACCEPTED).
+		First(&result)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("delegated circle cookbook access not found")
+			return cmodel.CookbookAccess{}, cmodel.CircleAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to find delegated circle cookbook access")
+		return cmodel.CookbookAccess{}, cmodel.CircleAccess{}, ConvertGormError(res.Error)
+	}
+	return convert.CookbookAccessToCoreModel(result.CookbookAccess), convert.CircleAccessToCoreCircleAccess(result.CircleAccess), nil
+}
+
+func (repo *Client) FindDelegatedUserCookbookAccess(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.CookbookId) (cmodel.CookbookAccess, cmodel.UserAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", id.CookbookId).
+		Int64("authUserId", authAccount.AuthUserId).
+		Logger()
+
+	type Result struct {
+		dbModel.CookbookAccess
+		dbModel.UserAccess
+	}
+	var result Result
+
+	res := repo.db.WithContext(ctx).
+		Select("cookbook_access.*, user_access.*").
+		Table("cookbook_access").
+		Joins("JOIN user_access ON user_access.user_id = cookbook_access.recipient_user_id").
+		Where("cookbook_access.cookbook_id = ? AND user_access.recipient_user_id = ? AND cookbook_access.state = ?", id.CookbookId, authAccount.AuthUserId, types.AccessState_ACCESS_STATE_ACCEPTED).
+		First(&result)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("delegated user cookbook access not found")
+			return cmodel.CookbookAccess{}, cmodel.UserAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to find delegated user cookbook access")
+		return cmodel.CookbookAccess{}, cmodel.UserAccess{}, ConvertGormError(res.Error)
+	}
+	return convert.CookbookAccessToCoreModel(result.CookbookAccess), convert.UserAccessToCoreUserAccess(result.UserAccess), nil
+}
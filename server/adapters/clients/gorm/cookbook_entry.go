@@ -0,0 +1,151 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"gorm.io/gorm/clause"
+)
+
+// CreateCookbookEntry creates a new cookbook entry.
+func (repo *Client) CreateCookbookEntry(ctx context.Context, m cmodel.CookbookEntry) (cmodel.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", m.Parent.CookbookId.CookbookId).
+		Int64("recipeId", m.RecipeId.RecipeId).
+		Logger()
+
+	gm := convert.CookbookEntryFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create cookbook entry row")
+		return cmodel.CookbookEntry{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookEntryToCoreModel(gm), nil
+}
+
+// GetCookbookEntry gets a cookbook entry.
+func (repo *Client) GetCookbookEntry(ctx context.Context, parent cmodel.CookbookEntryParent, id cmodel.CookbookEntryId, fields []string) (cmodel.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Int64("cookbookEntryId", id.CookbookEntryId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.CookbookEntry{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookEntryFieldMasker.Convert(fields)).
+		Where("cookbook_entry.cookbook_id = ? AND cookbook_entry.cookbook_entry_id = ?", parent.CookbookId.CookbookId, id.CookbookEntryId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get cookbook entry row")
+		return cmodel.CookbookEntry{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookEntryToCoreModel(gm), nil
+}
+
+// ListCookbookEntries lists the entries of a cookbook, in order.
+func (repo *Client) ListCookbookEntries(ctx context.Context, parent cmodel.CookbookEntryParent, pageSize int32, offset int64, fields []string) ([]cmodel.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookEntryFieldMasker.Convert(fields)).
+		Where("cookbook_entry.cookbook_id = ?", parent.CookbookId.CookbookId).
+		Order("cookbook_entry.position, cookbook_entry.cookbook_entry_id")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbEntries := []gmodel.CookbookEntry{}
+	err := tx.Find(&dbEntries).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list cookbook entry rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.CookbookEntry, len(dbEntries))
+	for i, m := range dbEntries {
+		res[i] = convert.CookbookEntryToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// UpdateCookbookEntry updates a cookbook entry.
+func (repo *Client) UpdateCookbookEntry(ctx context.Context, m cmodel.CookbookEntry, fields []string) (cmodel.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", m.Parent.CookbookId.CookbookId).
+		Int64("cookbookEntryId", m.Id.CookbookEntryId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.CookbookEntryFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.CookbookEntryFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("cookbook_entry.cookbook_id = ? AND cookbook_entry.cookbook_entry_id = ?", m.Parent.CookbookId.CookbookId, m.Id.CookbookEntryId).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update cookbook entry row")
+		return cmodel.CookbookEntry{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookEntryToCoreModel(gm), nil
+}
+
+// DeleteCookbookEntry deletes a cookbook entry.
+func (repo *Client) DeleteCookbookEntry(ctx context.Context, parent cmodel.CookbookEntryParent, id cmodel.CookbookEntryId) (cmodel.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Int64("cookbookEntryId", id.CookbookEntryId).
+		Logger()
+
+	gm := gmodel.CookbookEntry{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("cookbook_entry.cookbook_id = ? AND cookbook_entry.cookbook_entry_id = ?", parent.CookbookId.CookbookId, id.CookbookEntryId).
+		Delete(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete cookbook entry row")
+		return cmodel.CookbookEntry{}, ConvertGormError(err)
+	}
+
+	return convert.CookbookEntryToCoreModel(gm), nil
+}
+
+// BulkDeleteCookbookEntries deletes all entries of a cookbook.
+func (repo *Client) BulkDeleteCookbookEntries(ctx context.Context, parent cmodel.CookbookEntryParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("cookbookId", parent.CookbookId.CookbookId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Where("cookbook_id = ?", parent.CookbookId.CookbookId).
+		Delete(&gmodel.CookbookEntry{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete cookbook entry rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
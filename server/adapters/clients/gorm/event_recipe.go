@@ -5,6 +5,7 @@ import (
 
 	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
 	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
 	"github.com/jcfug8/daylear/server/core/logutil"
 	cmodel "github.com/jcfug8/daylear/server/core/model"
 	"github.com/jcfug8/daylear/server/ports/repository"
@@ -85,6 +86,62 @@ func (repo *Client) BulkDeleteEventRecipes(ctx context.Context, eventId cmodel.E
 	return nil
 }
 
+// MoveEventRecipes links the events of one recipe to another recipe.
+func (repo *Client) MoveEventRecipes(ctx context.Context, from cmodel.RecipeId, to cmodel.RecipeId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("fromRecipeId", from.RecipeId).
+		Int64("toRecipeId", to.RecipeId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.EventRecipe{}).
+		Where("recipe_id = ?", from.RecipeId).
+		Where("NOT EXISTS (SELECT 1 FROM event_recipe AS existing WHERE existing.recipe_id = ? AND existing.event_id = event_recipe.event_id)", to.RecipeId).
+		Update("recipe_id", to.RecipeId).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to move event recipes")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// UpdateEventRecipe updates an event recipe
+func (repo *Client) UpdateEventRecipe(ctx context.Context, m cmodel.EventRecipe, fields []string) (cmodel.EventRecipe, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("eventRecipeId", m.EventRecipeId.EventRecipeId).
+		Strs("fields", fields).
+		Logger()
+
+	gm, err := convert.EventRecipeFromCoreModel(m)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid event recipe when updating event recipe row")
+		return cmodel.EventRecipe{}, repository.ErrInvalidArgument{Msg: "invalid event recipe"}
+	}
+
+	res := repo.db.WithContext(ctx).
+		Select(gmodel.EventRecipeFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("event_recipe.event_recipe_id = ? AND event_recipe.event_id = ?", m.EventRecipeId.EventRecipeId, m.Parent.EventId).
+		Updates(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to update event recipe row")
+		return cmodel.EventRecipe{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("no event recipe row updated")
+		return cmodel.EventRecipe{}, repository.ErrNotFound{Msg: "event recipe not found"}
+	}
+
+	m, err = convert.EventRecipeToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid event recipe row when updating event recipe")
+		return cmodel.EventRecipe{}, repository.ErrInternal{Msg: "invalid event recipe row when updating event recipe"}
+	}
+
+	return m, nil
+}
+
 // GetEventRecipe retrieves an event recipe
 func (repo *Client) GetEventRecipe(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.EventRecipeId, fields []string) (cmodel.EventRecipe, error) {
 	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
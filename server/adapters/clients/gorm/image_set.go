@@ -0,0 +1,111 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// imageSetTable describes where a kind of resource stores its image.
+type imageSetTable struct {
+	table    string
+	idColumn string
+	// parentIdColumn is the recipe of a recipe image
+	parentIdColumn string
+}
+
+var imageSetTables = map[cmodel.ImageSetOwnerKind]imageSetTable{
+	cmodel.ImageSetOwnerKind_Recipe:      {table: gmodel.RecipeTable, idColumn: gmodel.RecipeFields_RecipeId},
+	cmodel.ImageSetOwnerKind_RecipeImage: {table: gmodel.RecipeImageTable, idColumn: gmodel.RecipeImageFields_RecipeImageId, parentIdColumn: gmodel.RecipeImageFields_RecipeId},
+	cmodel.ImageSetOwnerKind_User:        {table: gmodel.UserTable, idColumn: "user_id"},
+	cmodel.ImageSetOwnerKind_Circle:      {table: gmodel.CircleTable, idColumn: "circle_id"},
+}
+
+// imageSetRow is a row read from any of the image set tables.
+type imageSetRow struct {
+	Id       int64
+	ParentId int64
+	ImageURI string
+	ImageSet gmodel.ImageSet `gorm:"serializer:json"`
+}
+
+// ListImageSetOwners lists the resources of a kind that have an image but no
+// image set.
+func (repo *Client) ListImageSetOwners(ctx context.Context, kind cmodel.ImageSetOwnerKind, afterId int64, pageSize int32) ([]cmodel.ImageSetOwner, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Str("kind", string(kind)).
+		Int64("afterId", afterId).
+		Int32("pageSize", pageSize).
+		Logger()
+
+	t, ok := imageSetTables[kind]
+	if !ok {
+		log.Error().Msg("unknown image set owner kind")
+		return nil, repository.ErrInvalidArgument{Msg: fmt.Sprintf("unknown image set owner kind: %s", kind)}
+	}
+
+	parentIdColumn := "0"
+	if t.parentIdColumn != "" {
+		parentIdColumn = t.parentIdColumn
+	}
+
+	rows := []imageSetRow{}
+	err := repo.db.WithContext(ctx).
+		Table(t.table).
+		Select(fmt.Sprintf("%s AS id, %s AS parent_id, image_uri, image_set", t.idColumn, parentIdColumn)).
+		Where(fmt.Sprintf("%s > ?", t.idColumn), afterId).
+		Where("image_uri <> ''").
+		Where("(image_set IS NULL OR image_set -> 'variants' IS NULL)").
+		Order(t.idColumn + " ASC").
+		Limit(int(pageSize)).
+		Find(&rows).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list image set rows")
+		return nil, ConvertGormError(err)
+	}
+
+	owners := make([]cmodel.ImageSetOwner, 0, len(rows))
+	for _, row := range rows {
+		owners = append(owners, cmodel.ImageSetOwner{
+			Kind:     kind,
+			Id:       row.Id,
+			ParentId: row.ParentId,
+			ImageURI: row.ImageURI,
+			ImageSet: convert.ImageSetToCoreModel(row.ImageSet),
+		})
+	}
+
+	return owners, nil
+}
+
+// UpdateImageSet sets the image set of a resource.
+func (repo *Client) UpdateImageSet(ctx context.Context, owner cmodel.ImageSetOwner) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Str("kind", string(owner.Kind)).
+		Int64("id", owner.Id).
+		Logger()
+
+	t, ok := imageSetTables[owner.Kind]
+	if !ok {
+		log.Error().Msg("unknown image set owner kind")
+		return repository.ErrInvalidArgument{Msg: fmt.Sprintf("unknown image set owner kind: %s", owner.Kind)}
+	}
+
+	row := imageSetRow{ImageSet: convert.ImageSetFromCoreModel(owner.ImageSet)}
+	err := repo.db.WithContext(ctx).
+		Table(t.table).
+		Where(fmt.Sprintf("%s = ?", t.idColumn), owner.Id).
+		Select("image_set").
+		Updates(&row).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update image set")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateIngredient creates a new catalog ingredient.
+func (repo *Client) CreateIngredient(ctx context.Context, m cmodel.Ingredient, fields []string) (cmodel.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Str("title", m.Title).
+		Strs("fields", fields).
+		Logger()
+
+	gm, err := convert.IngredientFromCoreModel(m)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid ingredient when creating ingredient row")
+		return cmodel.Ingredient{}, repository.ErrInvalidArgument{Msg: fmt.Sprintf("invalid ingredient: %v", err)}
+	}
+
+	err = repo.db.WithContext(ctx).
+		Select(gmodel.IngredientFieldMasker.Convert(fields)).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create ingredient row")
+		return cmodel.Ingredient{}, ConvertGormError(err)
+	}
+
+	m, err = convert.IngredientToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid ingredient row when creating ingredient")
+		return cmodel.Ingredient{}, repository.ErrInternal{Msg: "invalid ingredient row when creating ingredient"}
+	}
+
+	return m, nil
+}
+
+// GetIngredient gets a catalog ingredient.
+func (repo *Client) GetIngredient(ctx context.Context, id cmodel.IngredientId, fields []string) (cmodel.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("ingredientId", id.IngredientId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.Ingredient{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.IngredientFieldMasker.Convert(fields)).
+		Where("ingredient.ingredient_id = ?", id.IngredientId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get ingredient row")
+		return cmodel.Ingredient{}, ConvertGormError(err)
+	}
+
+	m, err := convert.IngredientToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid ingredient row when getting ingredient")
+		return cmodel.Ingredient{}, repository.ErrInternal{Msg: "invalid ingredient row when getting ingredient"}
+	}
+
+	return m, nil
+}
+
+// ListIngredients lists the catalog ingredients visible to a user.
+func (repo *Client) ListIngredients(ctx context.Context, viewerId cmodel.UserId, pageSize int32, offset int64, filter string, fields []string) ([]cmodel.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("viewerUserId", viewerId.UserId).
+		Str("filter", filter).
+		Strs("fields", fields).
+		Int("pageSize", int(pageSize)).
+		Int64("offset", offset).
+		Logger()
+
+	orders := []clause.OrderByColumn{{
+		Column: clause.Column{Name: "ingredient.title"},
+	}}
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.IngredientFieldMasker.Convert(fields)).
+		Where(visibleIngredientsClause, viewerId.UserId).
+		Order(clause.OrderBy{Columns: orders}).
+		Limit(int(pageSize)).
+		Offset(int(offset))
+
+	conversion, err := gmodel.IngredientSQLConverter.Convert(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid filter string when listing ingredient rows")
+		return nil, repository.ErrInvalidArgument{Msg: "invalid filter"}
+	}
+
+	if conversion.WhereClause != "" {
+		tx = tx.Where(conversion.WhereClause, conversion.Params...)
+	}
+
+	return repo.findIngredients(ctx, tx)
+}
+
+// FindIngredientCandidates returns the catalog ingredients visible to a user
+// that share a word with the given normalized title.
+func (repo *Client) FindIngredientCandidates(ctx context.Context, viewerId cmodel.UserId, normalizedTitle string) ([]cmodel.Ingredient, error) {
+	words := strings.Fields(normalizedTitle)
+	if len(words) == 0 {
+		return []cmodel.Ingredient{}, nil
+	}
+
+	conditions := make([]string, 0, len(words))
+	params := make([]interface{}, 0, len(words)*2)
+	for _, word := range words {
+		conditions = append(conditions, `ingredient.title LIKE ? ESCAPE '\' OR ingredient.aliases::text ILIKE ? ESCAPE '\'`)
+		params = append(params, "%"+escapeLike(word)+"%", "%"+escapeLike(word)+"%")
+	}
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.IngredientFieldMasker.Get()).
+		Where(visibleIngredientsClause, viewerId.UserId).
+		Where(strings.Join(conditions, " OR "), params...)
+
+	return repo.findIngredients(ctx, tx)
+}
+
+// AutocompleteIngredients returns the catalog ingredients visible to a user
+// whose title or aliases start with the given query, shortest titles first.
+func (repo *Client) AutocompleteIngredients(ctx context.Context, viewerId cmodel.UserId, query string, limit int32) ([]cmodel.Ingredient, error) {
+	prefix := escapeLike(strings.ToLower(strings.TrimSpace(query))) + "%"
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.IngredientFieldMasker.Get()).
+		Where(visibleIngredientsClause, viewerId.UserId).
+		Where(`ingredient.title LIKE ? ESCAPE '\' OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(ingredient.aliases) AS alias WHERE lower(alias) LIKE ? ESCAPE '\')`, prefix, prefix).
+		Order(clause.Expr{SQL: `ingredient.title LIKE ? ESCAPE '\' DESC, length(ingredient.title), ingredient.title`, Vars: []interface{}{prefix}, WithoutParentheses: true}).
+		Limit(int(limit))
+
+	return repo.findIngredients(ctx, tx)
+}
+
+// SetRecipeIngredients replaces the catalog ingredients linked to a recipe.
+func (repo *Client) SetRecipeIngredients(ctx context.Context, id cmodel.RecipeId, ingredientIds []cmodel.IngredientId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", id.RecipeId).
+		Int("ingredientCount", len(ingredientIds)).
+		Logger()
+
+	err := repo.BulkDeleteRecipeIngredients(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(ingredientIds) == 0 {
+		return nil
+	}
+
+	seen := map[int64]struct{}{}
+	links := make([]gmodel.RecipeIngredient, 0, len(ingredientIds))
+	for _, ingredientId := range ingredientIds {
+		if _, ok := seen[ingredientId.IngredientId]; ok || ingredientId.IngredientId == 0 {
+			continue
+		}
+		seen[ingredientId.IngredientId] = struct{}{}
+		links = append(links, gmodel.RecipeIngredient{
+			RecipeId:     id.RecipeId,
+			IngredientId: ingredientId.IngredientId,
+		})
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	err = repo.db.WithContext(ctx).Create(&links).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe ingredient rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// BulkDeleteRecipeIngredients removes all catalog ingredient links for a recipe.
+func (repo *Client) BulkDeleteRecipeIngredients(ctx context.Context, id cmodel.RecipeId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", id.RecipeId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Where("recipe_id = ?", id.RecipeId).
+		Delete(&gmodel.RecipeIngredient{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete recipe ingredient rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// visibleIngredientsClause limits ingredients to the curated catalog and the
+// ones created by the given user.
+const visibleIngredientsClause = "ingredient.creator_user_id = 0 OR ingredient.creator_user_id = ?"
+
+// likeEscaper escapes the LIKE wildcards with a backslash.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike escapes user input so it is matched literally by a LIKE pattern
+// with ESCAPE '\'.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// findIngredients runs the given ingredient query and converts the rows.
+func (repo *Client) findIngredients(ctx context.Context, tx *gorm.DB) ([]cmodel.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx)
+
+	dbIngredients := []gmodel.Ingredient{}
+	err := tx.Find(&dbIngredients).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list ingredient rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.Ingredient, len(dbIngredients))
+	for i, m := range dbIngredients {
+		res[i], err = convert.IngredientToCoreModel(m)
+		if err != nil {
+			log.Error().Err(err).Msg("invalid ingredient row when listing ingredients")
+			return nil, repository.ErrInternal{Msg: "invalid ingredient row when listing ingredients"}
+		}
+	}
+
+	return res, nil
+}
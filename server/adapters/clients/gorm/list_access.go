@@ -307,3 +307,30 @@ func (repo *Client) FindDelegatedUserListAccess(ctx context.Context, authAccount
 	}
 	return convert.ListAccessToCoreModel(result.ListAccess), convert.UserAccessToCoreUserAccess(result.UserAccess), nil
 }
+
+// ListListCircleMemberIds lists the users who are members of a circle with
+// accepted access to a list.
+func (repo *Client) ListListCircleMemberIds(ctx context.Context, id cmodel.ListId) ([]cmodel.UserId, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", id.ListId).
+		Logger()
+
+	userIds := []int64{}
+	err := repo.db.WithContext(ctx).
+		Table("list_access").
+		Joins("JOIN circle_access ON circle_access.circle_id = list_access.recipient_circle_id").
+		Where("list_access.list_id = ? AND list_access.state = ? AND circle_access.state = ?", id.ListId, types.AccessState_ACCESS_STATE_ACCEPTED, types.AccessState_ACCESS_STATE_ACCEPTED).
+		Distinct("circle_access.recipient_user_id").
+		Pluck("circle_access.recipient_user_id", &userIds).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list list circle members")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.UserId, len(userIds))
+	for i, userId := range userIds {
+		res[i] = cmodel.UserId{UserId: userId}
+	}
+
+	return res, nil
+}
@@ -2,6 +2,7 @@ package gorm
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
 	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
@@ -221,3 +222,29 @@ func (repo *Client) BulkDeleteListItems(ctx context.Context, parent cmodel.ListI
 
 	return nil
 }
+
+// ListAssignedListIds lists the lists with items assigned to or claimed by a
+// user.
+func (repo *Client) ListAssignedListIds(ctx context.Context, userId cmodel.UserId) ([]cmodel.ListId, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", userId.UserId).
+		Logger()
+
+	listIds := []int64{}
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.ListItem{}).
+		Distinct("list_id").
+		Where("COALESCE(assignee_ids, '[]'::jsonb) @> ?::jsonb OR claimed_by_user_id = ?", fmt.Sprintf("[%d]", userId.UserId), userId.UserId).
+		Pluck("list_id", &listIds).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list assigned lists")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.ListId, len(listIds))
+	for i, listId := range listIds {
+		res[i] = cmodel.ListId{ListId: listId}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,226 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// CreateListItemCompletion creates a new list item completion.
+func (repo *Client) CreateListItemCompletion(ctx context.Context, m cmodel.ListItemCompletion) (cmodel.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", m.Parent.ListId.ListId).
+		Int64("listItemId", m.Parent.ListItemId.ListItemId).
+		Int64("userId", m.UserId.UserId).
+		Logger()
+
+	gm := convert.ListItemCompletionFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create list item completion row")
+		return cmodel.ListItemCompletion{}, ConvertGormError(err)
+	}
+
+	return convert.ListItemCompletionToCoreModel(gm), nil
+}
+
+// GetListItemCompletion gets a list item completion.
+func (repo *Client) GetListItemCompletion(ctx context.Context, parent cmodel.ListItemCompletionParent, id cmodel.ListItemCompletionId, fields []string) (cmodel.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", parent.ListId.ListId).
+		Int64("listItemId", parent.ListItemId.ListItemId).
+		Int64("listItemCompletionId", id.ListItemCompletionId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.ListItemCompletion{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.ListItemCompletionFieldMasker.Convert(fields)).
+		Where("list_item_completion.list_id = ? AND list_item_completion.list_item_id = ? AND list_item_completion.list_item_completion_id = ?", parent.ListId.ListId, parent.ListItemId.ListItemId, id.ListItemCompletionId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get list item completion row")
+		return cmodel.ListItemCompletion{}, ConvertGormError(err)
+	}
+
+	return convert.ListItemCompletionToCoreModel(gm), nil
+}
+
+// ListListItemCompletions lists the completions of a list item, most recently
+// completed first.
+func (repo *Client) ListListItemCompletions(ctx context.Context, parent cmodel.ListItemCompletionParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]cmodel.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", parent.ListId.ListId).
+		Int64("listItemId", parent.ListItemId.ListItemId).
+		Int32("pageSize", pageSize).
+		Int32("pageOffset", pageOffset).
+		Str("filter", filter).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.ListItemCompletionFieldMasker.Convert(fields)).
+		Where("list_item_completion.list_id = ? AND list_item_completion.list_item_id = ?", parent.ListId.ListId, parent.ListItemId.ListItemId).
+		Order("list_item_completion.complete_time DESC, list_item_completion.list_item_completion_id DESC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if pageOffset > 0 {
+		tx = tx.Offset(int(pageOffset))
+	}
+
+	if filter != "" {
+		conversion, err := gmodel.ListItemCompletionSQLConverter.Convert(filter)
+		if err != nil {
+			log.Error().Err(err).Msg("invalid filter string when listing list item completion rows")
+			return nil, repository.ErrInvalidArgument{Msg: "invalid filter"}
+		}
+
+		if conversion.WhereClause != "" {
+			tx = tx.Where(conversion.WhereClause, conversion.Params...)
+		}
+	}
+
+	gms := []gmodel.ListItemCompletion{}
+	err := tx.Find(&gms).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list list item completion rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.ListItemCompletion, len(gms))
+	for i, gm := range gms {
+		res[i] = convert.ListItemCompletionToCoreModel(gm)
+	}
+
+	return res, nil
+}
+
+// UpdateListItemCompletion updates a list item completion.
+func (repo *Client) UpdateListItemCompletion(ctx context.Context, m cmodel.ListItemCompletion, fields []string) (cmodel.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", m.Parent.ListId.ListId).
+		Int64("listItemId", m.Parent.ListItemId.ListItemId).
+		Int64("listItemCompletionId", m.Id.ListItemCompletionId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.ListItemCompletionFromCoreModel(m)
+
+	res := repo.db.WithContext(ctx).
+		Select(gmodel.ListItemCompletionFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("list_item_completion.list_id = ? AND list_item_completion.list_item_id = ? AND list_item_completion.list_item_completion_id = ?", m.Parent.ListId.ListId, m.Parent.ListItemId.ListItemId, m.Id.ListItemCompletionId).
+		Updates(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to update list item completion row")
+		return cmodel.ListItemCompletion{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("list item completion not found")
+		return cmodel.ListItemCompletion{}, repository.ErrNotFound{Msg: "list item completion not found"}
+	}
+
+	return convert.ListItemCompletionToCoreModel(gm), nil
+}
+
+// DeleteListItemCompletion deletes a list item completion.
+func (repo *Client) DeleteListItemCompletion(ctx context.Context, parent cmodel.ListItemCompletionParent, id cmodel.ListItemCompletionId) (cmodel.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", parent.ListId.ListId).
+		Int64("listItemId", parent.ListItemId.ListItemId).
+		Int64("listItemCompletionId", id.ListItemCompletionId).
+		Logger()
+
+	gm := gmodel.ListItemCompletion{}
+
+	res := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("list_item_completion.list_id = ? AND list_item_completion.list_item_id = ? AND list_item_completion.list_item_completion_id = ?", parent.ListId.ListId, parent.ListItemId.ListItemId, id.ListItemCompletionId).
+		Delete(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to delete list item completion row")
+		return cmodel.ListItemCompletion{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("list item completion not found")
+		return cmodel.ListItemCompletion{}, repository.ErrNotFound{Msg: "list item completion not found"}
+	}
+
+	return convert.ListItemCompletionToCoreModel(gm), nil
+}
+
+// BulkDeleteListItemCompletions deletes the completions of a list item, or of
+// every item of the list when the list item id of the parent is not set.
+func (repo *Client) BulkDeleteListItemCompletions(ctx context.Context, parent cmodel.ListItemCompletionParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", parent.ListId.ListId).
+		Int64("listItemId", parent.ListItemId.ListItemId).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Where("list_id = ?", parent.ListId.ListId)
+	if parent.ListItemId.ListItemId != 0 {
+		tx = tx.Where("list_item_id = ?", parent.ListItemId.ListItemId)
+	}
+
+	err := tx.Delete(&gmodel.ListItemCompletion{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete list item completion rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// GetLastListItemCompleteTimes gets the time each of the given items of a list
+// was last completed. Items that were never completed are left out.
+func (repo *Client) GetLastListItemCompleteTimes(ctx context.Context, parent cmodel.ListItemParent, ids []cmodel.ListItemId) (map[cmodel.ListItemId]time.Time, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("listId", parent.ListId.ListId).
+		Int("listItemCount", len(ids)).
+		Logger()
+
+	res := map[cmodel.ListItemId]time.Time{}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	listItemIds := make([]int64, len(ids))
+	for i, id := range ids {
+		listItemIds[i] = id.ListItemId
+	}
+
+	rows := []struct {
+		ListItemId   int64
+		CompleteTime time.Time
+	}{}
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.ListItemCompletion{}).
+		Select("list_item_id, MAX(complete_time) AS complete_time").
+		Where("list_id = ? AND list_item_id IN ?", parent.ListId.ListId, listItemIds).
+		Group("list_item_id").
+		Scan(&rows).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get last list item complete times")
+		return nil, ConvertGormError(err)
+	}
+
+	for _, row := range rows {
+		res[cmodel.ListItemId{ListItemId: row.ListItemId}] = row.CompleteTime
+	}
+
+	return res, nil
+}
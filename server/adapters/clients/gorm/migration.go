@@ -2,14 +2,21 @@ package gorm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
 
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
 	"github.com/jcfug8/daylear/server/adapters/clients/gorm/migrations"
 	model "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/dietary"
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/nutrition"
 	"github.com/jcfug8/daylear/server/genapi/api/types"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var manualMigrations = []migrations.Migration{
@@ -109,6 +116,254 @@ var manualMigrations = []migrations.Migration{
 				return fmt.Errorf("failed to create self-access records: %w", err)
 			}
 
+			return nil
+		},
+	},
+	{
+		Key:         "4",
+		Description: "Create ingredient catalog and backfill recipe ingredient links by fuzzy matching titles",
+		Run: func(ctx context.Context, tx *gorm.DB) error {
+			if !tx.Migrator().HasTable(&model.Recipe{}) {
+				return nil
+			}
+
+			if err := tx.Migrator().AutoMigrate(&model.Ingredient{}, &model.RecipeIngredient{}); err != nil {
+				return fmt.Errorf("failed to create ingredient tables: %w", err)
+			}
+
+			var catalog []cmodel.Ingredient
+			var dbIngredients []model.Ingredient
+			if err := tx.Find(&dbIngredients).Error; err != nil {
+				return fmt.Errorf("failed to fetch ingredients: %w", err)
+			}
+			for _, dbIngredient := range dbIngredients {
+				ingredient, err := convert.IngredientToCoreModel(dbIngredient)
+				if err != nil {
+					return fmt.Errorf("failed to read ingredient %d: %w", dbIngredient.IngredientId, err)
+				}
+				catalog = append(catalog, ingredient)
+			}
+
+			type recipeRow struct {
+				RecipeId         int64
+				IngredientGroups []byte
+			}
+			var recipes []recipeRow
+			if err := tx.Model(&model.Recipe{}).Select("recipe_id, ingredient_groups").Find(&recipes).Error; err != nil {
+				return fmt.Errorf("failed to fetch recipes: %w", err)
+			}
+
+			for _, r := range recipes {
+				if len(r.IngredientGroups) == 0 {
+					continue
+				}
+
+				var groups []cmodel.IngredientGroup
+				if err := json.Unmarshal(r.IngredientGroups, &groups); err != nil {
+					return fmt.Errorf("failed to read ingredient groups for recipe %d: %w", r.RecipeId, err)
+				}
+
+				links := map[int64]struct{}{}
+				for i := range groups {
+					for j := range groups[i].RecipeIngredients {
+						recipeIngredient := &groups[i].RecipeIngredients[j]
+						normalized := ingredientcatalog.Normalize(recipeIngredient.Title)
+						if normalized == "" {
+							continue
+						}
+
+						ingredient, found := ingredientcatalog.Match(recipeIngredient.Title, catalog)
+						if !found {
+							dbIngredient := model.Ingredient{Title: normalized}
+							if err := tx.Clauses(clause.Returning{}).Create(&dbIngredient).Error; err != nil {
+								return fmt.Errorf("failed to create ingredient %q: %w", normalized, err)
+							}
+							ingredient = cmodel.Ingredient{Id: cmodel.IngredientId{IngredientId: dbIngredient.IngredientId}, Title: normalized}
+							catalog = append(catalog, ingredient)
+						}
+
+						recipeIngredient.IngredientId = ingredient.Id
+						links[ingredient.Id.IngredientId] = struct{}{}
+					}
+				}
+
+				ingredientGroups, err := json.Marshal(groups)
+				if err != nil {
+					return fmt.Errorf("failed to write ingredient groups for recipe %d: %w", r.RecipeId, err)
+				}
+				if err := tx.Model(&model.Recipe{}).Where("recipe_id = ?", r.RecipeId).UpdateColumn("ingredient_groups", ingredientGroups).Error; err != nil {
+					return fmt.Errorf("failed to update ingredient groups for recipe %d: %w", r.RecipeId, err)
+				}
+
+				for ingredientId := range links {
+					link := model.RecipeIngredient{RecipeId: r.RecipeId, IngredientId: ingredientId}
+					if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&link).Error; err != nil {
+						return fmt.Errorf("failed to link ingredient %d to recipe %d: %w", ingredientId, r.RecipeId, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	},
+	{
+		Key:         "5",
+		Description: "Backfill recipe nutrition facts from the embedded nutrient table",
+		Run: func(ctx context.Context, tx *gorm.DB) error {
+			if !tx.Migrator().HasTable(&model.Recipe{}) {
+				return nil
+			}
+
+			if err := tx.Migrator().AutoMigrate(&model.Recipe{}, &model.Ingredient{}); err != nil {
+				return fmt.Errorf("failed to add nutrition column: %w", err)
+			}
+
+			catalog := map[int64]cmodel.Ingredient{}
+			var dbIngredients []model.Ingredient
+			if err := tx.Find(&dbIngredients).Error; err != nil {
+				return fmt.Errorf("failed to fetch ingredients: %w", err)
+			}
+			for _, dbIngredient := range dbIngredients {
+				ingredient, err := convert.IngredientToCoreModel(dbIngredient)
+				if err != nil {
+					return fmt.Errorf("failed to read ingredient %d: %w", dbIngredient.IngredientId, err)
+				}
+				catalog[ingredient.Id.IngredientId] = ingredient
+			}
+
+			type recipeRow struct {
+				RecipeId         int64
+				IngredientGroups []byte
+				YieldAmount      string
+			}
+			var recipes []recipeRow
+			if err := tx.Model(&model.Recipe{}).Select("recipe_id, ingredient_groups, yield_amount").Find(&recipes).Error; err != nil {
+				return fmt.Errorf("failed to fetch recipes: %w", err)
+			}
+
+			for _, r := range recipes {
+				recipe := cmodel.Recipe{YieldAmount: r.YieldAmount}
+				if len(r.IngredientGroups) > 0 {
+					if err := json.Unmarshal(r.IngredientGroups, &recipe.IngredientGroups); err != nil {
+						return fmt.Errorf("failed to read ingredient groups for recipe %d: %w", r.RecipeId, err)
+					}
+				}
+
+				nutritionFacts, err := json.Marshal(nutrition.Calculate(recipe, catalog))
+				if err != nil {
+					return fmt.Errorf("failed to write nutrition for recipe %d: %w", r.RecipeId, err)
+				}
+				if err := tx.Model(&model.Recipe{}).Where("recipe_id = ?", r.RecipeId).UpdateColumn("nutrition", nutritionFacts).Error; err != nil {
+					return fmt.Errorf("failed to update nutrition for recipe %d: %w", r.RecipeId, err)
+				}
+			}
+
+			return nil
+		},
+	},
+	{
+		Key:         "6",
+		Description: "Backfill recipe diets and allergens from the embedded dietary rules",
+		Run: func(ctx context.Context, tx *gorm.DB) error {
+			if !tx.Migrator().HasTable(&model.Recipe{}) {
+				return nil
+			}
+
+			if err := tx.Migrator().AutoMigrate(&model.Recipe{}); err != nil {
+				return fmt.Errorf("failed to add dietary columns: %w", err)
+			}
+
+			type recipeRow struct {
+				RecipeId         int64
+				IngredientGroups []byte
+				DietaryOverrides []byte
+			}
+			var recipes []recipeRow
+			if err := tx.Model(&model.Recipe{}).Select("recipe_id, ingredient_groups, dietary_overrides").Find(&recipes).Error; err != nil {
+				return fmt.Errorf("failed to fetch recipes: %w", err)
+			}
+
+			for _, r := range recipes {
+				recipe := cmodel.Recipe{}
+				if len(r.IngredientGroups) > 0 {
+					if err := json.Unmarshal(r.IngredientGroups, &recipe.IngredientGroups); err != nil {
+						return fmt.Errorf("failed to read ingredient groups for recipe %d: %w", r.RecipeId, err)
+					}
+				}
+				if len(r.DietaryOverrides) > 0 {
+					if err := json.Unmarshal(r.DietaryOverrides, &recipe.DietaryOverrides); err != nil {
+						return fmt.Errorf("failed to read dietary overrides for recipe %d: %w", r.RecipeId, err)
+					}
+				}
+
+				diets, allergens := dietary.Tag(recipe.IngredientGroups, recipe.DietaryOverrides)
+				dietsJSON, err := json.Marshal(diets)
+				if err != nil {
+					return fmt.Errorf("failed to write diets for recipe %d: %w", r.RecipeId, err)
+				}
+				allergensJSON, err := json.Marshal(allergens)
+				if err != nil {
+					return fmt.Errorf("failed to write allergens for recipe %d: %w", r.RecipeId, err)
+				}
+				if err := tx.Model(&model.Recipe{}).Where("recipe_id = ?", r.RecipeId).UpdateColumns(map[string]any{
+					"diets":     dietsJSON,
+					"allergens": allergensJSON,
+				}).Error; err != nil {
+					return fmt.Errorf("failed to update diets and allergens for recipe %d: %w", r.RecipeId, err)
+				}
+			}
+
+			return nil
+		},
+	},
+	{
+		Key:         "7",
+		Description: "Scope uncurated ingredients to the user whose recipe added them",
+		Run: func(ctx context.Context, tx *gorm.DB) error {
+			if !tx.Migrator().HasTable(&model.Ingredient{}) {
+				return nil
+			}
+
+			if tx.Migrator().HasIndex(&model.Ingredient{}, "idx_ingredient_title") {
+				if err := tx.Migrator().DropIndex(&model.Ingredient{}, "idx_ingredient_title"); err != nil {
+					return fmt.Errorf("failed to drop ingredient title index: %w", err)
+				}
+			}
+
+			if err := tx.Migrator().AutoMigrate(&model.Ingredient{}); err != nil {
+				return fmt.Errorf("failed to add ingredient creator column: %w", err)
+			}
+
+			// ingredients without aliases, category or density were added by
+			// recipes rather than curated, so they belong to the user who
+			// created the first recipe linking them
+			uncurated := `(ingredient.aliases IS NULL OR ingredient.aliases = '[]'::jsonb)
+				AND COALESCE(ingredient.category, '') = ''
+				AND ingredient.density IS NULL
+				AND ingredient.creator_user_id = 0`
+
+			query := `
+				UPDATE ingredient SET creator_user_id = owner.requester_user_id
+				FROM (
+					SELECT DISTINCT ON (ri.ingredient_id) ri.ingredient_id, ra.requester_user_id
+					FROM recipe_ingredient ri
+					JOIN recipe_access ra ON ra.recipe_id = ri.recipe_id
+					WHERE ra.permission_level = ? AND ra.requester_user_id <> 0
+					ORDER BY ri.ingredient_id, ra.recipe_access_id
+				) owner
+				WHERE ingredient.ingredient_id = owner.ingredient_id AND ` + uncurated
+			if err := tx.Exec(query, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).Error; err != nil {
+				return fmt.Errorf("failed to assign ingredient creators: %w", err)
+			}
+
+			query = `
+				DELETE FROM ingredient
+				WHERE NOT EXISTS (SELECT 1 FROM recipe_ingredient ri WHERE ri.ingredient_id = ingredient.ingredient_id)
+				AND ` + uncurated
+			if err := tx.Exec(query).Error; err != nil {
+				return fmt.Errorf("failed to delete unlinked ingredients: %w", err)
+			}
+
 			return nil
 		},
 	},
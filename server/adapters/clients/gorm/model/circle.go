@@ -17,6 +17,7 @@ const (
 	CircleColumn_Description = "description"
 	CircleColumn_Handle      = "handle"
 	CircleColumn_ImageURI    = "image_uri"
+	CircleColumn_ImageSet    = "image_set"
 	CircleColumn_Visibility  = "visibility_level"
 )
 
@@ -26,6 +27,7 @@ var CircleFieldMasker = fieldmask.NewSQLFieldMasker(Circle{}, map[string][]field
 	cmodel.CircleField_Description: {{Name: CircleColumn_Description, Table: CircleTable, Updatable: true}},
 	cmodel.CircleField_Handle:      {{Name: CircleColumn_Handle, Table: CircleTable, Updatable: true}},
 	cmodel.CircleField_ImageURI:    {{Name: CircleColumn_ImageURI, Table: CircleTable, Updatable: true}},
+	cmodel.CircleField_ImageSet:    {{Name: CircleColumn_ImageSet, Table: CircleTable, Updatable: true}},
 	cmodel.CircleField_Visibility:  {{Name: CircleColumn_Visibility, Table: CircleTable, Updatable: true}},
 
 	cmodel.CircleField_Favorited: {{Name: CircleFavoriteFields_CircleFavoriteId, Table: CircleFavoriteTable}},
@@ -52,6 +54,7 @@ type Circle struct {
 	Description     string                `gorm:"column:description"`
 	Handle          string                `gorm:"column:handle;unique;not null"`
 	ImageURI        string                `gorm:"column:image_uri"`
+	ImageSet        ImageSet              `gorm:"column:image_set;type:jsonb;serializer:json"`
 	VisibilityLevel types.VisibilityLevel `gorm:"column:visibility_level;not null;default:1"`
 
 	// CircleAccess data
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+const (
+	CookbookTable = "cookbook"
+)
+
+const (
+	CookbookFields_CookbookId      = "cookbook_id"
+	CookbookFields_Title           = "title"
+	CookbookFields_Description     = "description"
+	CookbookFields_VisibilityLevel = "visibility_level"
+	CookbookFields_CreateTime      = "create_time"
+	CookbookFields_UpdateTime      = "update_time"
+)
+
+var CookbookFieldMasker = fieldmask.NewSQLFieldMasker(Cookbook{}, map[string][]fieldmask.Field{
+	model.CookbookField_Id:              {{Name: CookbookFields_CookbookId, Table: CookbookTable}},
+	model.CookbookField_Title:           {{Name: CookbookFields_Title, Table: CookbookTable, Updatable: true}},
+	model.CookbookField_Description:     {{Name: CookbookFields_Description, Table: CookbookTable, Updatable: true}},
+	model.CookbookField_VisibilityLevel: {{Name: CookbookFields_VisibilityLevel, Table: CookbookTable, Updatable: true}},
+	model.CookbookField_CreateTime:      {{Name: CookbookFields_CreateTime, Table: CookbookTable}},
+	model.CookbookField_UpdateTime:      {{Name: CookbookFields_UpdateTime, Table: CookbookTable}},
+	model.CookbookField_CookbookAccess: {
+		{Name: CookbookAccessFields_CookbookAccessId, Table: CookbookAccessTable},
+		{Name: CookbookAccessFields_PermissionLevel, Table: CookbookAccessTable},
+		{Name: CookbookAccessFields_State, Table: CookbookAccessTable},
+		{Name: CookbookAccessFields_AcceptTarget, Table: CookbookAccessTable},
+	},
+})
+
+var CookbookSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
+	"visibility": {Name: CookbookFields_VisibilityLevel, Table: CookbookTable},
+	"permission": {Name: CookbookAccessFields_PermissionLevel, Table: CookbookAccessTable},
+	"state":      {Name: CookbookAccessFields_State, Table: CookbookAccessTable},
+}, true)
+
+// Cookbook -
+type Cookbook struct {
+	CookbookId      int64 `gorm:"primaryKey;bigint;not null;<-:false"`
+	Title           string
+	Description     string
+	VisibilityLevel types.VisibilityLevel `gorm:"not null;default:1"`
+	CreateTime      time.Time             `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime      time.Time             `gorm:"column:update_time;autoUpdateTime"`
+
+	// CookbookAccess data
+	CookbookAccessId int64                 `gorm:"->;-:migration"` // only used for read from a join
+	PermissionLevel  types.PermissionLevel `gorm:"->;-:migration"` // only used for read from a join
+	State            types.AccessState     `gorm:"->;-:migration"` // only used for read from a join
+	AcceptTarget     types.AcceptTarget    `gorm:"->;-:migration"` // only used for read from a join
+}
+
+// TableName -
+func (Cookbook) TableName() string {
+	return CookbookTable
+}
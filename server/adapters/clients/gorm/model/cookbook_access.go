@@ -0,0 +1,76 @@
+package model
+
+import (
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+const (
+	CookbookAccessTable = "cookbook_access"
+)
+
+const (
+	CookbookAccessFields_CookbookAccessId  = "cookbook_access_id"
+	CookbookAccessFields_CookbookId        = "cookbook_id"
+	CookbookAccessFields_RequesterUserId   = "requester_user_id"
+	CookbookAccessFields_RequesterCircleId = "requester_circle_id"
+	CookbookAccessFields_RecipientUserId   = "recipient_user_id"
+	CookbookAccessFields_RecipientCircleId = "recipient_circle_id"
+	CookbookAccessFields_PermissionLevel   = "permission_level"
+	CookbookAccessFields_State             = "state"
+	CookbookAccessFields_AcceptTarget      = "accept_target"
+)
+
+var CookbookAccessFieldMasker = fieldmask.NewSQLFieldMasker(CookbookAccess{}, map[string][]fieldmask.Field{
+	model.CookbookAccessField_Parent:          {{Name: CookbookAccessFields_CookbookId}},
+	model.CookbookAccessField_Id:              {{Name: CookbookAccessFields_CookbookAccessId}},
+	model.CookbookAccessField_PermissionLevel: {{Name: CookbookAccessFields_PermissionLevel, Updatable: true}},
+	model.CookbookAccessField_State:           {{Name: CookbookAccessFields_State, Updatable: true}},
+	model.CookbookAccessField_AcceptTarget:    {{Name: CookbookAccessFields_AcceptTarget}},
+	model.CookbookAccessField_Requester: {
+		{Name: CookbookAccessFields_RequesterUserId, Table: CookbookAccessTable},
+		{Name: CookbookAccessFields_RequesterCircleId, Table: CookbookAccessTable},
+	},
+	model.CookbookAccessField_Recipient: {
+		{Name: CookbookAccessFields_RecipientUserId, Table: CookbookAccessTable},
+		{Name: CookbookAccessFields_RecipientCircleId, Table: CookbookAccessTable},
+		{Name: UserColumn_Username, Table: UserTable, Alias: "recipient_username"},
+		{Name: UserColumn_GivenName, Table: UserTable, Alias: "recipient_given_name"},
+		{Name: UserColumn_FamilyName, Table: UserTable, Alias: "recipient_family_name"},
+		{Name: CircleColumn_Title, Table: CircleTable, Alias: "recipient_circle_title"},
+		{Name: CircleColumn_Handle, Table: CircleTable, Alias: "recipient_circle_handle"},
+	},
+})
+
+var CookbookAccessSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
+	model.CookbookAccessField_PermissionLevel: {Name: CookbookAccessFields_PermissionLevel, Table: CookbookAccessTable},
+	model.CookbookAccessField_State:           {Name: CookbookAccessFields_State, Table: CookbookAccessTable},
+	model.CookbookAccessField_Requester:       {Name: CookbookAccessFields_RequesterUserId, Table: CookbookAccessTable},
+	model.CookbookAccessField_Recipient:       {Name: CookbookAccessFields_RecipientCircleId, Table: CookbookAccessTable},
+}, true)
+
+// CookbookAccess -
+type CookbookAccess struct {
+	CookbookAccessId  int64                 `gorm:"primaryKey;bigint;not null;<-:false"`
+	CookbookId        int64                 `gorm:"not null;index;uniqueIndex:idx_cookbook_id_recipient_user_id;uniqueIndex:idx_cookbook_id_recipient_circle_id;"`
+	RequesterUserId   int64                 `gorm:"index"`
+	RequesterCircleId int64                 `gorm:"index"`
+	RecipientUserId   int64                 `gorm:"index;uniqueIndex:idx_cookbook_id_recipient_user_id,where:recipient_user_id <> null"`
+	RecipientCircleId int64                 `gorm:"index;uniqueIndex:idx_cookbook_id_recipient_circle_id,where:recipient_circle_id <> null"`
+	PermissionLevel   types.PermissionLevel `gorm:"not null"`
+	State             types.AccessState     `gorm:"not null"`
+	AcceptTarget      types.AcceptTarget    `gorm:"not null;default:0"`
+
+	RecipientUsername     string `gorm:"->;-:migration"` // read only from join
+	RecipientGivenName    string `gorm:"->;-:migration"` // read only from join
+	RecipientFamilyName   string `gorm:"->;-:migration"` // read only from join
+	RecipientCircleTitle  string `gorm:"->;-:migration"` // read only from join
+	RecipientCircleHandle string `gorm:"->;-:migration"` // read only from join
+}
+
+// TableName -
+func (CookbookAccess) TableName() string {
+	return CookbookAccessTable
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	CookbookEntryTable = "cookbook_entry"
+)
+
+const (
+	CookbookEntryFields_CookbookEntryId = "cookbook_entry_id"
+	CookbookEntryFields_CookbookId      = "cookbook_id"
+	CookbookEntryFields_RecipeId        = "recipe_id"
+	CookbookEntryFields_Note            = "note"
+	CookbookEntryFields_Position        = "position"
+	CookbookEntryFields_CreateTime      = "create_time"
+)
+
+var CookbookEntryFieldMasker = fieldmask.NewSQLFieldMasker(CookbookEntry{}, map[string][]fieldmask.Field{
+	model.CookbookEntryField_Id:         {{Name: CookbookEntryFields_CookbookEntryId, Table: CookbookEntryTable}},
+	model.CookbookEntryField_Parent:     {{Name: CookbookEntryFields_CookbookId, Table: CookbookEntryTable}},
+	model.CookbookEntryField_RecipeId:   {{Name: CookbookEntryFields_RecipeId, Table: CookbookEntryTable}},
+	model.CookbookEntryField_Note:       {{Name: CookbookEntryFields_Note, Table: CookbookEntryTable, Updatable: true}},
+	model.CookbookEntryField_Position:   {{Name: CookbookEntryFields_Position, Table: CookbookEntryTable, Updatable: true}},
+	model.CookbookEntryField_CreateTime: {{Name: CookbookEntryFields_CreateTime, Table: CookbookEntryTable}},
+})
+
+// CookbookEntry is a recipe in a cookbook. A recipe can be in a cookbook once.
+type CookbookEntry struct {
+	CookbookEntryId int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	CookbookId      int64     `gorm:"not null;uniqueIndex:idx_cookbook_entry_cookbook_recipe"`
+	RecipeId        int64     `gorm:"not null;uniqueIndex:idx_cookbook_entry_cookbook_recipe;index"`
+	Note            string    `gorm:"type:text"`
+	Position        int32     `gorm:"not null;default:0"`
+	CreateTime      time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+// TableName -
+func (CookbookEntry) TableName() string {
+	return CookbookEntryTable
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+func TestCookbookEntryFieldMasker_Updatable(t *testing.T) {
+	got := CookbookEntryFieldMasker.Convert([]string{
+		model.CookbookEntryField_Parent,
+		model.CookbookEntryField_RecipeId,
+		model.CookbookEntryField_Note,
+		model.CookbookEntryField_Position,
+	}, fieldmask.OnlyUpdatable())
+	slices.Sort(got)
+
+	want := []string{"cookbook_entry.note", "cookbook_entry.position"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Convert(OnlyUpdatable) = %v, want %v", got, want)
+	}
+}
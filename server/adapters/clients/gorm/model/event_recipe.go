@@ -17,6 +17,7 @@ const (
 	EventRecipeColumn_RecipeId      = "recipe_id"
 	EventRecipeColumn_EventId       = "event_id"
 	EventRecipeColumn_CreateTime    = "create_time"
+	EventRecipeColumn_DoneTime      = "done_time"
 )
 
 var EventRecipeFieldMasker = fieldmask.NewSQLFieldMasker(EventRecipe{}, map[string][]fieldmask.Field{
@@ -24,6 +25,7 @@ var EventRecipeFieldMasker = fieldmask.NewSQLFieldMasker(EventRecipe{}, map[stri
 	cmodel.EventRecipeField_EventRecipeId: {{Name: EventRecipeColumn_EventRecipeId, Table: EventRecipeTable}},
 	cmodel.EventRecipeField_RecipeId:      {{Name: EventRecipeColumn_RecipeId, Table: EventRecipeTable}},
 	cmodel.EventRecipeField_CreateTime:    {{Name: EventRecipeColumn_CreateTime, Table: EventRecipeTable}},
+	cmodel.EventRecipeField_DoneTime:      {{Name: EventRecipeColumn_DoneTime, Table: EventRecipeTable, Updatable: true}},
 })
 
 var EventRecipeSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
@@ -33,10 +35,11 @@ var EventRecipeSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
 
 // EventRecipe is the GORM model for an event recipe.
 type EventRecipe struct {
-	EventRecipeId int64     `gorm:"primaryKey;column:event_recipe_id;autoIncrement;<-:false"`
-	RecipeId      int64     `gorm:"column:recipe_id;not null"`
-	EventId       int64     `gorm:"column:event_id;not null"`
-	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime"`
+	EventRecipeId int64      `gorm:"primaryKey;column:event_recipe_id;autoIncrement;<-:false"`
+	RecipeId      int64      `gorm:"column:recipe_id;not null"`
+	EventId       int64      `gorm:"column:event_id;not null"`
+	CreateTime    time.Time  `gorm:"column:create_time;autoCreateTime"`
+	DoneTime      *time.Time `gorm:"column:done_time"`
 }
 
 // TableName sets the table name for the EventRecipe model.
@@ -0,0 +1,19 @@
+package model
+
+// ImageSet is the stored form of the variants of an uploaded image. It is
+// kept in a jsonb column of the table of the resource the image belongs to.
+type ImageSet struct {
+	Placeholder string         `json:"placeholder,omitempty"`
+	Width       int32          `json:"width,omitempty"`
+	Height      int32          `json:"height,omitempty"`
+	Variants    []ImageVariant `json:"variants,omitempty"`
+}
+
+// ImageVariant is the stored form of one variant of an image.
+type ImageVariant struct {
+	Size        string `json:"size"`
+	ContentType string `json:"content_type"`
+	Width       int32  `json:"width"`
+	Height      int32  `json:"height"`
+	URI         string `json:"uri"`
+}
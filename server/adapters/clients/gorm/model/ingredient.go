@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+)
+
+const (
+	IngredientTable = "ingredient"
+)
+
+const (
+	IngredientFields_IngredientId = "ingredient_id"
+	IngredientFields_Title        = "title"
+	IngredientFields_Aliases      = "aliases"
+	IngredientFields_Category     = "category"
+	IngredientFields_Density      = "density"
+	IngredientFields_CreatorId    = "creator_user_id"
+	IngredientFields_CreateTime   = "create_time"
+	IngredientFields_UpdateTime   = "update_time"
+)
+
+var IngredientFieldMasker = fieldmask.NewSQLFieldMasker(Ingredient{}, map[string][]fieldmask.Field{
+	model.IngredientField_Id:         {{Name: IngredientFields_IngredientId, Table: IngredientTable}},
+	model.IngredientField_Title:      {{Name: IngredientFields_Title, Table: IngredientTable, Updatable: true}},
+	model.IngredientField_Aliases:    {{Name: IngredientFields_Aliases, Table: IngredientTable, Updatable: true}},
+	model.IngredientField_Category:   {{Name: IngredientFields_Category, Table: IngredientTable, Updatable: true}},
+	model.IngredientField_Density:    {{Name: IngredientFields_Density, Table: IngredientTable, Updatable: true}},
+	model.IngredientField_CreatorId:  {{Name: IngredientFields_CreatorId, Table: IngredientTable}},
+	model.IngredientField_CreateTime: {{Name: IngredientFields_CreateTime, Table: IngredientTable}},
+	model.IngredientField_UpdateTime: {{Name: IngredientFields_UpdateTime, Table: IngredientTable}},
+})
+
+var IngredientSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
+	"title":    {Name: IngredientFields_Title, Table: IngredientTable},
+	"category": {Name: IngredientFields_Category, Table: IngredientTable},
+}, true)
+
+// Ingredient is a canonical ingredient in the ingredient catalog.
+type Ingredient struct {
+	IngredientId  int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	Title         string    `gorm:"type:varchar(128);not null;uniqueIndex:idx_ingredient_title_creator_user_id"`
+	Aliases       []byte    `gorm:"type:jsonb"`
+	Category      string    `gorm:"type:varchar(64)"`
+	Density       *float64  `gorm:"type:double precision"` // grams per milliliter
+	CreatorUserId int64     `gorm:"bigint;not null;default:0;uniqueIndex:idx_ingredient_title_creator_user_id"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime    time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// TableName -
+func (Ingredient) TableName() string {
+	return IngredientTable
+}
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jcfug8/daylear/server/core/fieldmask"
@@ -13,42 +16,136 @@ const (
 )
 
 const (
-	ListItemFields_ListItemId     = "list_item_id"
-	ListItemFields_ListId         = "list_id"
-	ListItemFields_Title          = "title"
-	ListItemFields_Points         = "points"
-	ListItemFields_RecurrenceRule = "recurrence_rule"
-	ListItemFields_ListSectionId  = "list_section_id"
-	ListItemFields_CreateTime     = "create_time"
-	ListItemFields_UpdateTime     = "update_time"
+	ListItemFields_ListItemId              = "list_item_id"
+	ListItemFields_ListId                  = "list_id"
+	ListItemFields_Title                   = "title"
+	ListItemFields_Points                  = "points"
+	ListItemFields_RecurrenceRule          = "recurrence_rule"
+	ListItemFields_ListSectionId           = "list_section_id"
+	ListItemFields_SourceRecipeIds         = "source_recipe_ids"
+	ListItemFields_CreateTime              = "create_time"
+	ListItemFields_UpdateTime              = "update_time"
+	ListItemFields_CompletionWindowSeconds = "completion_window_seconds"
+	ListItemFields_NextDueTime             = "next_due_time"
+	ListItemFields_AssigneeIds             = "assignee_ids"
+	ListItemFields_ClaimedByUserId         = "claimed_by_user_id"
 )
 
 var ListItemFieldMasker = fieldmask.NewSQLFieldMasker(ListItem{}, map[string][]fieldmask.Field{
-	model.ListItemField_Id:             {{Name: ListItemFields_ListItemId, Table: ListItemTable}},
-	model.ListItemField_Title:          {{Name: ListItemFields_Title, Table: ListItemTable}},
-	model.ListItemField_Points:         {{Name: ListItemFields_Points, Table: ListItemTable}},
-	model.ListItemField_RecurrenceRule: {{Name: ListItemFields_RecurrenceRule, Table: ListItemTable}},
-	model.ListItemField_ListSectionId:  {{Name: ListItemFields_ListSectionId, Table: ListItemTable}},
-	model.ListItemField_CreateTime:     {{Name: ListItemFields_CreateTime, Table: ListItemTable}},
-	model.ListItemField_UpdateTime:     {{Name: ListItemFields_UpdateTime, Table: ListItemTable}},
-	model.ListItemField_Parent:         {{Name: ListItemFields_ListId, Table: ListItemTable}},
+	model.ListItemField_Id:               {{Name: ListItemFields_ListItemId, Table: ListItemTable}},
+	model.ListItemField_Title:            {{Name: ListItemFields_Title, Table: ListItemTable}},
+	model.ListItemField_Points:           {{Name: ListItemFields_Points, Table: ListItemTable}},
+	model.ListItemField_RecurrenceRule:   {{Name: ListItemFields_RecurrenceRule, Table: ListItemTable}},
+	model.ListItemField_ListSectionId:    {{Name: ListItemFields_ListSectionId, Table: ListItemTable}},
+	model.ListItemField_SourceRecipes:    {{Name: ListItemFields_SourceRecipeIds, Table: ListItemTable}},
+	model.ListItemField_CreateTime:       {{Name: ListItemFields_CreateTime, Table: ListItemTable}},
+	model.ListItemField_UpdateTime:       {{Name: ListItemFields_UpdateTime, Table: ListItemTable}},
+	model.ListItemField_Parent:           {{Name: ListItemFields_ListId, Table: ListItemTable}},
+	model.ListItemField_CompletionWindow: {{Name: ListItemFields_CompletionWindowSeconds, Table: ListItemTable}},
+	model.ListItemField_NextDueTime:      {{Name: ListItemFields_NextDueTime, Table: ListItemTable}},
+	model.ListItemField_Assignees:        {{Name: ListItemFields_AssigneeIds, Table: ListItemTable}},
+	model.ListItemField_ClaimedBy:        {{Name: ListItemFields_ClaimedByUserId, Table: ListItemTable}},
 })
 
 var ListItemSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
-	"points": {Name: ListItemFields_Points, Table: ListItemTable},
-	"title":  {Name: ListItemFields_Title, Table: ListItemTable},
+	"points":        {Name: ListItemFields_Points, Table: ListItemTable},
+	"title":         {Name: ListItemFields_Title, Table: ListItemTable},
+	"next_due_time": {Name: ListItemFields_NextDueTime, Table: ListItemTable},
+	"is_overdue":    {Name: ListItemFields_NextDueTime, Table: ListItemTable, CustomConverter: overdueSQLFilterConverter},
+	"assignee":      {Name: ListItemFields_AssigneeIds, Table: ListItemTable, CustomConverter: assigneeSQLFilterConverter},
+	"unassigned":    {Name: ListItemFields_AssigneeIds, Table: ListItemTable, CustomConverter: unassignedSQLFilterConverter},
+	"claimed_by":    {Name: ListItemFields_ClaimedByUserId, Table: ListItemTable, CustomConverter: userSQLFilterConverter},
 }, true)
 
+// Custom converter for is_overdue field. An item is overdue when its next due
+// time has passed.
+func overdueSQLFilterConverter(ctx *filter.Conversion, field string, operator string, value interface{}) (string, bool) {
+	if operator != "=" {
+		return "", false
+	}
+	overdue, ok := value.(bool)
+	if !ok {
+		return "", false
+	}
+	if overdue {
+		return fmt.Sprintf("%s < %s", field, ctx.AddParam(time.Now())), true
+	}
+	return fmt.Sprintf("(%s IS NULL OR %s >= %s)", field, field, ctx.AddParam(time.Now())), true
+}
+
+// Custom converter for the assignee field. Matches items assigned to the user
+// with the given name, like users/123.
+func assigneeSQLFilterConverter(ctx *filter.Conversion, field string, operator string, value interface{}) (string, bool) {
+	if operator != ":" && operator != "=" {
+		return "", false
+	}
+	userId, ok := parseUserName(value)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("COALESCE(%s, '[]'::jsonb) @> %s::jsonb", field, ctx.AddParam(fmt.Sprintf("[%d]", userId))), true
+}
+
+// Custom converter for the unassigned field. An item is unassigned when it
+// has no assignees.
+func unassignedSQLFilterConverter(ctx *filter.Conversion, field string, operator string, value interface{}) (string, bool) {
+	if operator != "=" {
+		return "", false
+	}
+	unassigned, ok := value.(bool)
+	if !ok {
+		return "", false
+	}
+	if unassigned {
+		return fmt.Sprintf("COALESCE(%s, '[]'::jsonb) = '[]'::jsonb", field), true
+	}
+	return fmt.Sprintf("COALESCE(%s, '[]'::jsonb) != '[]'::jsonb", field), true
+}
+
+// Custom converter for user id fields. Accepts user names like users/123.
+func userSQLFilterConverter(ctx *filter.Conversion, field string, operator string, value interface{}) (string, bool) {
+	if operator != "=" && operator != "!=" {
+		return "", false
+	}
+	userId, ok := parseUserName(value)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("%s %s %s", field, operator, ctx.AddParam(userId)), true
+}
+
+// parseUserName gets the user id from a user name filter value.
+func parseUserName(value interface{}) (int64, bool) {
+	name, ok := value.(string)
+	if !ok {
+		return 0, false
+	}
+	id, ok := strings.CutPrefix(name, "users/")
+	if !ok {
+		return 0, false
+	}
+	userId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return userId, true
+}
+
 // ListItem represents a list item in the database
 type ListItem struct {
-	ListItemId     int64  `gorm:"primaryKey;bigint;not null;<-:false"`
-	ListId         int64  `gorm:"bigint;not null;index"`
-	Title          string `gorm:"not null"`
-	Points         int32  `gorm:"not null;default:0"`
-	RecurrenceRule string
-	ListSectionId  int64     `gorm:"bigint"`
-	CreateTime     time.Time `gorm:"column:create_time;autoCreateTime"`
-	UpdateTime     time.Time `gorm:"column:update_time;autoUpdateTime"`
+	ListItemId              int64  `gorm:"primaryKey;bigint;not null;<-:false"`
+	ListId                  int64  `gorm:"bigint;not null;index"`
+	Title                   string `gorm:"not null"`
+	Points                  int32  `gorm:"not null;default:0"`
+	RecurrenceRule          string
+	ListSectionId           int64      `gorm:"bigint"`
+	SourceRecipeIds         []byte     `gorm:"type:jsonb"`
+	CreateTime              time.Time  `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime              time.Time  `gorm:"column:update_time;autoUpdateTime"`
+	CompletionWindowSeconds int64      `gorm:"not null;default:0"`
+	NextDueTime             *time.Time `gorm:"index"`
+	AssigneeIds             []byte     `gorm:"type:jsonb"`
+	ClaimedByUserId         int64      `gorm:"bigint;index"`
 }
 
 // TableName returns the table name for the ListItem model
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+)
+
+const (
+	ListItemCompletionTable = "list_item_completion"
+)
+
+const (
+	ListItemCompletionFields_ListItemCompletionId = "list_item_completion_id"
+	ListItemCompletionFields_ListId               = "list_id"
+	ListItemCompletionFields_ListItemId           = "list_item_id"
+	ListItemCompletionFields_Title                = "title"
+	ListItemCompletionFields_Points               = "points"
+	ListItemCompletionFields_ListSectionId        = "list_section_id"
+	ListItemCompletionFields_UserId               = "user_id"
+	ListItemCompletionFields_CircleId             = "circle_id"
+	ListItemCompletionFields_CompleteTime         = "complete_time"
+	ListItemCompletionFields_CompletedByAssignee  = "completed_by_assignee"
+	ListItemCompletionFields_CreateTime           = "create_time"
+	ListItemCompletionFields_UpdateTime           = "update_time"
+)
+
+var ListItemCompletionFieldMasker = fieldmask.NewSQLFieldMasker(ListItemCompletion{}, map[string][]fieldmask.Field{
+	model.ListItemCompletionField_Id: {{Name: ListItemCompletionFields_ListItemCompletionId, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_Parent: {
+		{Name: ListItemCompletionFields_ListId, Table: ListItemCompletionTable},
+		{Name: ListItemCompletionFields_ListItemId, Table: ListItemCompletionTable},
+	},
+	model.ListItemCompletionField_Title:               {{Name: ListItemCompletionFields_Title, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_Points:              {{Name: ListItemCompletionFields_Points, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_ListSectionId:       {{Name: ListItemCompletionFields_ListSectionId, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_UserId:              {{Name: ListItemCompletionFields_UserId, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_CircleId:            {{Name: ListItemCompletionFields_CircleId, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_CompleteTime:        {{Name: ListItemCompletionFields_CompleteTime, Table: ListItemCompletionTable, Updatable: true}},
+	model.ListItemCompletionField_CompletedByAssignee: {{Name: ListItemCompletionFields_CompletedByAssignee, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_CreateTime:          {{Name: ListItemCompletionFields_CreateTime, Table: ListItemCompletionTable}},
+	model.ListItemCompletionField_UpdateTime:          {{Name: ListItemCompletionFields_UpdateTime, Table: ListItemCompletionTable}},
+})
+
+var ListItemCompletionSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
+	"points":                {Name: ListItemCompletionFields_Points, Table: ListItemCompletionTable},
+	"title":                 {Name: ListItemCompletionFields_Title, Table: ListItemCompletionTable},
+	"completed_by_assignee": {Name: ListItemCompletionFields_CompletedByAssignee, Table: ListItemCompletionTable},
+}, true)
+
+// ListItemCompletion represents a list item being checked off in the database.
+type ListItemCompletion struct {
+	ListItemCompletionId int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	ListId               int64     `gorm:"bigint;not null;index"`
+	ListItemId           int64     `gorm:"bigint;not null;index:idx_list_item_completion_item_time,priority:1"`
+	Title                string    `gorm:"not null"`
+	Points               int32     `gorm:"not null;default:0"`
+	ListSectionId        int64     `gorm:"bigint"`
+	UserId               int64     `gorm:"bigint;not null"`
+	CircleId             int64     `gorm:"bigint"`
+	CompleteTime         time.Time `gorm:"not null;index:idx_list_item_completion_item_time,priority:2"`
+	CompletedByAssignee  bool      `gorm:"not null;default:false"`
+	CreateTime           time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime           time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// TableName returns the table name for the ListItemCompletion model
+func (ListItemCompletion) TableName() string {
+	return ListItemCompletionTable
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestListItemSQLConverter_Assignments(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+		params []any
+	}{
+		{filter: `assignee = "users/7"`, want: "COALESCE(list_item.assignee_ids, '[]'::jsonb) @> ?::jsonb", params: []any{"[7]"}},
+		{filter: "unassigned = true", want: "COALESCE(list_item.assignee_ids, '[]'::jsonb) = '[]'::jsonb", params: []any{}},
+		{filter: "unassigned = false", want: "COALESCE(list_item.assignee_ids, '[]'::jsonb) != '[]'::jsonb", params: []any{}},
+		{filter: `claimed_by = "users/7"`, want: "list_item.claimed_by_user_id = ?", params: []any{int64(7)}},
+		{filter: `claimed_by != "users/7"`, want: "list_item.claimed_by_user_id != ?", params: []any{int64(7)}},
+	}
+
+	for _, tt := range tests {
+		got, err := ListItemSQLConverter.Convert(tt.filter)
+		if err != nil {
+			t.Fatalf("Convert(%q) error = %v", tt.filter, err)
+		}
+		if got.WhereClause != tt.want {
+			t.Errorf("Convert(%q) = %q, want %q", tt.filter, got.WhereClause, tt.want)
+		}
+		if fmt.Sprint(got.Params) != fmt.Sprint(tt.params) {
+			t.Errorf("Convert(%q) params = %v, want %v", tt.filter, got.Params, tt.params)
+		}
+	}
+}
@@ -6,6 +6,17 @@ func AllModels() []interface{} {
 		&Recipe{},
 		&RecipeAccess{},
 		&RecipeFavorite{},
+		&Ingredient{},
+		&RecipeIngredient{},
+		&RecipeRevision{},
+		&RecipeReview{},
+		&RecipeCookLog{},
+		&RecipeNote{},
+		&RecipeImageCandidate{},
+		&RecipeImageGeneration{},
+		&RecipeImport{},
+		&Operation{},
+		&RecipeImage{},
 		&User{},
 		&UserAccess{},
 		&AccessKey{},
@@ -23,5 +34,12 @@ func AllModels() []interface{} {
 		&ListAccess{},
 		&ListFavorite{},
 		&ListItem{},
+		&ListItemCompletion{},
+		&Pantry{},
+		&PantryAccess{},
+		&PantryItem{},
+		&Cookbook{},
+		&CookbookAccess{},
+		&CookbookEntry{},
 	}
 }
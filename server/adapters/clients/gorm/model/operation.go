@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	OperationTable = "operation"
+)
+
+const (
+	OperationFields_OperationId = "operation_id"
+	OperationFields_UserId      = "user_id"
+	OperationFields_Kind        = "kind"
+	OperationFields_State       = "state"
+	OperationFields_Request     = "request"
+	OperationFields_Result      = "result"
+	OperationFields_Error       = "error"
+	OperationFields_CreateTime  = "create_time"
+	OperationFields_UpdateTime  = "update_time"
+	OperationFields_EndTime     = "end_time"
+	OperationFields_ExpireTime  = "expire_time"
+
+	OperationFields_LeaseOwner      = "lease_owner"
+	OperationFields_LeaseExpireTime = "lease_expire_time"
+)
+
+var OperationFieldMasker = fieldmask.NewSQLFieldMasker(Operation{}, map[string][]fieldmask.Field{
+	model.OperationField_Id:         {{Name: OperationFields_OperationId, Table: OperationTable}},
+	model.OperationField_Parent:     {{Name: OperationFields_UserId, Table: OperationTable}},
+	model.OperationField_Kind:       {{Name: OperationFields_Kind, Table: OperationTable}},
+	model.OperationField_State:      {{Name: OperationFields_State, Table: OperationTable, Updatable: true}},
+	model.OperationField_Request:    {{Name: OperationFields_Request, Table: OperationTable}},
+	model.OperationField_Result:     {{Name: OperationFields_Result, Table: OperationTable, Updatable: true}},
+	model.OperationField_Error:      {{Name: OperationFields_Error, Table: OperationTable, Updatable: true}},
+	model.OperationField_CreateTime: {{Name: OperationFields_CreateTime, Table: OperationTable}},
+	model.OperationField_UpdateTime: {{Name: OperationFields_UpdateTime, Table: OperationTable}},
+	model.OperationField_EndTime:    {{Name: OperationFields_EndTime, Table: OperationTable, Updatable: true}},
+	model.OperationField_ExpireTime: {{Name: OperationFields_ExpireTime, Table: OperationTable, Updatable: true}},
+
+	model.OperationField_LeaseOwner:      {{Name: OperationFields_LeaseOwner, Table: OperationTable}},
+	model.OperationField_LeaseExpireTime: {{Name: OperationFields_LeaseExpireTime, Table: OperationTable}},
+})
+
+// Operation is a slow piece of work that runs in the background on behalf of
+// a user.
+type Operation struct {
+	OperationId int64      `gorm:"primaryKey;bigint;not null;<-:false"`
+	UserId      int64      `gorm:"not null;index"`
+	Kind        string     `gorm:"not null"`
+	State       int        `gorm:"not null;index"`
+	Request     []byte     `gorm:"type:jsonb"`
+	Result      []byte     `gorm:"type:jsonb"`
+	Error       string     `gorm:""`
+	CreateTime  time.Time  `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime  time.Time  `gorm:"column:update_time;autoUpdateTime"`
+	EndTime     *time.Time `gorm:"column:end_time"`
+	ExpireTime  *time.Time `gorm:"column:expire_time;index"`
+
+	LeaseOwner      string     `gorm:"type:varchar(64);not null;default:''"`
+	LeaseExpireTime *time.Time `gorm:"column:lease_expire_time;index"`
+}
+
+// TableName -
+func (Operation) TableName() string {
+	return OperationTable
+}
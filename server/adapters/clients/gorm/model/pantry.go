@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+const (
+	PantryTable = "pantry"
+)
+
+const (
+	PantryFields_PantryId        = "pantry_id"
+	PantryFields_Title           = "title"
+	PantryFields_Description     = "description"
+	PantryFields_VisibilityLevel = "visibility_level"
+	PantryFields_CreateTime      = "create_time"
+	PantryFields_UpdateTime      = "update_time"
+)
+
+var PantryFieldMasker = fieldmask.NewSQLFieldMasker(Pantry{}, map[string][]fieldmask.Field{
+	model.PantryField_Id:              {{Name: PantryFields_PantryId, Table: PantryTable}},
+	model.PantryField_Title:           {{Name: PantryFields_Title, Table: PantryTable, Updatable: true}},
+	model.PantryField_Description:     {{Name: PantryFields_Description, Table: PantryTable, Updatable: true}},
+	model.PantryField_VisibilityLevel: {{Name: PantryFields_VisibilityLevel, Table: PantryTable, Updatable: true}},
+	model.PantryField_CreateTime:      {{Name: PantryFields_CreateTime, Table: PantryTable}},
+	model.PantryField_UpdateTime:      {{Name: PantryFields_UpdateTime, Table: PantryTable}},
+	model.PantryField_PantryAccess: {
+		{Name: PantryAccessFields_PantryAccessId, Table: PantryAccessTable},
+		{Name: PantryAccessFields_PermissionLevel, Table: PantryAccessTable},
+		{Name: PantryAccessFields_State, Table: PantryAccessTable},
+		{Name: PantryAccessFields_AcceptTarget, Table: PantryAccessTable},
+	},
+})
+
+var PantrySQLConverter = filter.NewSQLConverter(map[string]filter.Field{
+	"visibility": {Name: PantryFields_VisibilityLevel, Table: PantryTable},
+	"permission": {Name: PantryAccessFields_PermissionLevel, Table: PantryAccessTable},
+	"state":      {Name: PantryAccessFields_State, Table: PantryAccessTable},
+}, true)
+
+// Pantry -
+type Pantry struct {
+	PantryId        int64 `gorm:"primaryKey;bigint;not null;<-:false"`
+	Title           string
+	Description     string
+	VisibilityLevel types.VisibilityLevel `gorm:"not null;default:1"`
+	CreateTime      time.Time             `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime      time.Time             `gorm:"column:update_time;autoUpdateTime"`
+
+	// PantryAccess data
+	PantryAccessId  int64                 `gorm:"->;-:migration"` // only used for read from a join
+	PermissionLevel types.PermissionLevel `gorm:"->;-:migration"` // only used for read from a join
+	State           types.AccessState     `gorm:"->;-:migration"` // only used for read from a join
+	AcceptTarget    types.AcceptTarget    `gorm:"->;-:migration"` // only used for read from a join
+}
+
+// TableName -
+func (Pantry) TableName() string {
+	return PantryTable
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+const (
+	PantryAccessTable = "pantry_access"
+)
+
+const (
+	PantryAccessFields_PantryAccessId    = "pantry_access_id"
+	PantryAccessFields_PantryId          = "pantry_id"
+	PantryAccessFields_RequesterUserId   = "requester_user_id"
+	PantryAccessFields_RequesterCircleId = "requester_circle_id"
+	PantryAccessFields_RecipientUserId   = "recipient_user_id"
+	PantryAccessFields_RecipientCircleId = "recipient_circle_id"
+	PantryAccessFields_PermissionLevel   = "permission_level"
+	PantryAccessFields_State             = "state"
+	PantryAccessFields_AcceptTarget      = "accept_target"
+)
+
+var PantryAccessFieldMasker = fieldmask.NewSQLFieldMasker(PantryAccess{}, map[string][]fieldmask.Field{
+	model.PantryAccessField_Parent:          {{Name: PantryAccessFields_PantryId}},
+	model.PantryAccessField_Id:              {{Name: PantryAccessFields_PantryAccessId}},
+	model.PantryAccessField_PermissionLevel: {{Name: PantryAccessFields_PermissionLevel, Updatable: true}},
+	model.PantryAccessField_State:           {{Name: PantryAccessFields_State, Updatable: true}},
+	model.PantryAccessField_AcceptTarget:    {{Name: PantryAccessFields_AcceptTarget}},
+	model.PantryAccessField_Requester: {
+		{Name: PantryAccessFields_RequesterUserId, Table: PantryAccessTable},
+		{Name: PantryAccessFields_RequesterCircleId, Table: PantryAccessTable},
+	},
+	model.PantryAccessField_Recipient: {
+		{Name: PantryAccessFields_RecipientUserId, Table: PantryAccessTable},
+		{Name: PantryAccessFields_RecipientCircleId, Table: PantryAccessTable},
+		{Name: UserColumn_Username, Table: UserTable, Alias: "recipient_username"},
+		{Name: UserColumn_GivenName, Table: UserTable, Alias: "recipient_given_name"},
+		{Name: UserColumn_FamilyName, Table: UserTable, Alias: "recipient_family_name"},
+		{Name: CircleColumn_Title, Table: CircleTable, Alias: "recipient_circle_title"},
+		{Name: CircleColumn_Handle, Table: CircleTable, Alias: "recipient_circle_handle"},
+	},
+})
+
+var PantryAccessSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
+	model.PantryAccessField_PermissionLevel: {Name: PantryAccessFields_PermissionLevel, Table: PantryAccessTable},
+	model.PantryAccessField_State:           {Name: PantryAccessFields_State, Table: PantryAccessTable},
+	model.PantryAccessField_Requester:       {Name: PantryAccessFields_RequesterUserId, Table: PantryAccessTable},
+	model.PantryAccessField_Recipient:       {Name: PantryAccessFields_RecipientCircleId, Table: PantryAccessTable},
+}, true)
+
+// PantryAccess -
+type PantryAccess struct {
+	PantryAccessId    int64                 `gorm:"primaryKey;bigint;not null;<-:false"`
+	PantryId          int64                 `gorm:"not null;index;uniqueIndex:idx_pantry_id_recipient_user_id;uniqueIndex:idx_pantry_id_recipient_circle_id;"`
+	RequesterUserId   int64                 `gorm:"index"`
+	RequesterCircleId int64                 `gorm:"index"`
+	RecipientUserId   int64                 `gorm:"index;uniqueIndex:idx_pantry_id_recipient_user_id,where:recipient_user_id <> null"`
+	RecipientCircleId int64                 `gorm:"index;uniqueIndex:idx_pantry_id_recipient_circle_id,where:recipient_circle_id <> null"`
+	PermissionLevel   types.PermissionLevel `gorm:"not null"`
+	State             types.AccessState     `gorm:"not null"`
+	AcceptTarget      types.AcceptTarget    `gorm:"not null;default:0"`
+
+	RecipientUsername     string `gorm:"->;-:migration"` // read only from join
+	RecipientGivenName    string `gorm:"->;-:migration"` // read only from join
+	RecipientFamilyName   string `gorm:"->;-:migration"` // read only from join
+	RecipientCircleTitle  string `gorm:"->;-:migration"` // read only from join
+	RecipientCircleHandle string `gorm:"->;-:migration"` // read only from join
+}
+
+// TableName -
+func (PantryAccess) TableName() string {
+	return PantryAccessTable
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	pantryPb "github.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1"
+)
+
+const (
+	PantryItemTable = "pantry_item"
+)
+
+const (
+	PantryItemFields_PantryItemId = "pantry_item_id"
+	PantryItemFields_PantryId     = "pantry_id"
+	PantryItemFields_Title        = "title"
+	PantryItemFields_Quantity     = "quantity"
+	PantryItemFields_Unit         = "unit"
+	PantryItemFields_Location     = "location"
+	PantryItemFields_PurchaseTime = "purchase_time"
+	PantryItemFields_ExpireTime   = "expire_time"
+	PantryItemFields_CreateTime   = "create_time"
+	PantryItemFields_UpdateTime   = "update_time"
+)
+
+var PantryItemFieldMasker = fieldmask.NewSQLFieldMasker(PantryItem{}, map[string][]fieldmask.Field{
+	model.PantryItemField_Id:           {{Name: PantryItemFields_PantryItemId, Table: PantryItemTable}},
+	model.PantryItemField_Parent:       {{Name: PantryItemFields_PantryId, Table: PantryItemTable}},
+	model.PantryItemField_Title:        {{Name: PantryItemFields_Title, Table: PantryItemTable, Updatable: true}},
+	model.PantryItemField_Quantity:     {{Name: PantryItemFields_Quantity, Table: PantryItemTable, Updatable: true}},
+	model.PantryItemField_Unit:         {{Name: PantryItemFields_Unit, Table: PantryItemTable, Updatable: true}},
+	model.PantryItemField_Location:     {{Name: PantryItemFields_Location, Table: PantryItemTable, Updatable: true}},
+	model.PantryItemField_PurchaseTime: {{Name: PantryItemFields_PurchaseTime, Table: PantryItemTable, Updatable: true}},
+	model.PantryItemField_ExpireTime:   {{Name: PantryItemFields_ExpireTime, Table: PantryItemTable, Updatable: true}},
+	model.PantryItemField_CreateTime:   {{Name: PantryItemFields_CreateTime, Table: PantryItemTable}},
+	model.PantryItemField_UpdateTime:   {{Name: PantryItemFields_UpdateTime, Table: PantryItemTable}},
+})
+
+var PantryItemSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
+	"title":    {Name: PantryItemFields_Title, Table: PantryItemTable},
+	"quantity": {Name: PantryItemFields_Quantity, Table: PantryItemTable},
+	"location": {Name: PantryItemFields_Location, Table: PantryItemTable},
+}, true)
+
+// PantryItem represents a pantry item in the database
+type PantryItem struct {
+	PantryItemId int64                        `gorm:"primaryKey;bigint;not null;<-:false"`
+	PantryId     int64                        `gorm:"bigint;not null;index"`
+	Title        string                       `gorm:"not null"`
+	Quantity     float64                      `gorm:"not null;default:0"`
+	Unit         pb.Recipe_MeasurementType    `gorm:"not null;default:0"`
+	Location     pantryPb.PantryItem_Location `gorm:"not null;default:0"`
+	PurchaseTime *time.Time
+	ExpireTime   *time.Time `gorm:"index"`
+	CreateTime   time.Time  `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime   time.Time  `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// TableName returns the table name for the PantryItem model
+func (PantryItem) TableName() string {
+	return PantryItemTable
+}
@@ -2,9 +2,11 @@ package model
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
 	"github.com/jcfug8/daylear/server/core/model"
 	"github.com/jcfug8/daylear/server/filter"
 	"github.com/jcfug8/daylear/server/genapi/api/types"
@@ -12,6 +14,9 @@ import (
 
 const (
 	RecipeTable = "recipe"
+	// RecipeCookStatsTable is the alias of the cook history of the current
+	// user joined by RecipeCookStatsJoin.
+	RecipeCookStatsTable = "recipe_cook_stats"
 )
 
 const (
@@ -20,6 +25,7 @@ const (
 	RecipeFields_Description          = "description"
 	RecipeFields_Directions           = "directions"
 	RecipeFields_ImageURI             = "image_uri"
+	RecipeFields_ImageSet             = "image_set"
 	RecipeFields_IngredientGroups     = "ingredient_groups"
 	RecipeFields_VisibilityLevel      = "visibility_level"
 	RecipeFields_Citation             = "citation"
@@ -30,6 +36,16 @@ const (
 	RecipeFields_Categories           = "categories"
 	RecipeFields_YieldAmount          = "yield_amount"
 	RecipeFields_Cuisines             = "cuisines"
+	RecipeFields_Nutrition            = "nutrition"
+	RecipeFields_ForkedFromRecipeId   = "forked_from_recipe_id"
+	RecipeFields_ForkCount            = "fork_count"
+	RecipeFields_AverageRating        = "average_rating"
+	RecipeFields_RatingCount          = "rating_count"
+	RecipeFields_LastCookedTime       = "last_cooked_time"
+	RecipeFields_TimesCooked          = "times_cooked"
+	RecipeFields_Diets                = "diets"
+	RecipeFields_Allergens            = "allergens"
+	RecipeFields_DietaryOverrides     = "dietary_overrides"
 	RecipeFields_CreateTime           = "create_time"
 	RecipeFields_UpdateTime           = "update_time"
 )
@@ -40,6 +56,7 @@ var RecipeFieldMasker = fieldmask.NewSQLFieldMasker(Recipe{}, map[string][]field
 	model.RecipeField_Description:          {{Name: RecipeFields_Description, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_Directions:           {{Name: RecipeFields_Directions, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_ImageURI:             {{Name: RecipeFields_ImageURI, Table: RecipeTable, Updatable: true}},
+	model.RecipeField_ImageSet:             {{Name: RecipeFields_ImageSet, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_IngredientGroups:     {{Name: RecipeFields_IngredientGroups, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_VisibilityLevel:      {{Name: RecipeFields_VisibilityLevel, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_Citation:             {{Name: RecipeFields_Citation, Table: RecipeTable, Updatable: true}},
@@ -50,9 +67,19 @@ var RecipeFieldMasker = fieldmask.NewSQLFieldMasker(Recipe{}, map[string][]field
 	model.RecipeField_Categories:           {{Name: RecipeFields_Categories, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_YieldAmount:          {{Name: RecipeFields_YieldAmount, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_Cuisines:             {{Name: RecipeFields_Cuisines, Table: RecipeTable, Updatable: true}},
+	model.RecipeField_Nutrition:            {{Name: RecipeFields_Nutrition, Table: RecipeTable, Updatable: true}},
+	model.RecipeField_Diets:                {{Name: RecipeFields_Diets, Table: RecipeTable, Updatable: true}},
+	model.RecipeField_Allergens:            {{Name: RecipeFields_Allergens, Table: RecipeTable, Updatable: true}},
+	model.RecipeField_DietaryOverrides:     {{Name: RecipeFields_DietaryOverrides, Table: RecipeTable, Updatable: true}},
 	model.RecipeField_CreateTime:           {{Name: RecipeFields_CreateTime, Table: RecipeTable}},
 	model.RecipeField_UpdateTime:           {{Name: RecipeFields_UpdateTime, Table: RecipeTable}},
 	model.RecipeField_Favorited:            {{Name: RecipeFavoriteFields_RecipeFavoriteId, Table: RecipeFavoriteTable}},
+	model.RecipeField_ForkedFrom:           {{Name: RecipeFields_ForkedFromRecipeId, Table: RecipeTable}},
+	model.RecipeField_ForkCount:            {{Name: recipeForkCountSubquery, Alias: RecipeFields_ForkCount}},
+	model.RecipeField_AverageRating:        {{Name: recipeAverageRatingSubquery, Alias: RecipeFields_AverageRating}},
+	model.RecipeField_RatingCount:          {{Name: recipeRatingCountSubquery, Alias: RecipeFields_RatingCount}},
+	model.RecipeField_LastCookedTime:       {{Name: RecipeFields_LastCookedTime, Table: RecipeCookStatsTable}},
+	model.RecipeField_TimesCooked:          {{Name: recipeTimesCookedColumn, Alias: RecipeFields_TimesCooked}},
 
 	model.RecipeField_RecipeAccess: {
 		{Name: RecipeAccessFields_RecipeAccessId, Table: RecipeAccessTable},
@@ -60,11 +87,45 @@ var RecipeFieldMasker = fieldmask.NewSQLFieldMasker(Recipe{}, map[string][]field
 		{Name: RecipeAccessFields_State, Table: RecipeAccessTable},
 	},
 })
+
+// recipeForkCountSubquery counts the recipes forked from a recipe.
+var recipeForkCountSubquery = fmt.Sprintf("(SELECT COUNT(*) FROM %s AS fork WHERE fork.%s = %s.%s)",
+	RecipeTable, RecipeFields_ForkedFromRecipeId, RecipeTable, RecipeFields_RecipeId)
+
+// recipeAverageRatingSubquery averages the ratings of the reviews of a recipe.
+var recipeAverageRatingSubquery = fmt.Sprintf("(SELECT COALESCE(AVG(%s.%s), 0) FROM %s WHERE %s.%s = %s.%s)",
+	RecipeReviewTable, RecipeReviewFields_Rating, RecipeReviewTable, RecipeReviewTable, RecipeReviewFields_RecipeId, RecipeTable, RecipeFields_RecipeId)
+
+// recipeRatingCountSubquery counts the reviews of a recipe.
+var recipeRatingCountSubquery = fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE %s.%s = %s.%s)",
+	RecipeReviewTable, RecipeReviewTable, RecipeReviewFields_RecipeId, RecipeTable, RecipeFields_RecipeId)
+
+// RecipeCookStatsJoin joins the cook history of a user to recipes. It takes
+// the id of the user as its parameter.
+var RecipeCookStatsJoin = fmt.Sprintf("LEFT JOIN (SELECT %s, MAX(%s) AS %s, COUNT(*) AS %s FROM %s WHERE %s = ? GROUP BY %s) AS %s ON %s.%s = %s.%s",
+	RecipeCookLogFields_RecipeId, RecipeCookLogFields_CookTime, RecipeFields_LastCookedTime, RecipeFields_TimesCooked,
+	RecipeCookLogTable, RecipeCookLogFields_CookUserId, RecipeCookLogFields_RecipeId,
+	RecipeCookStatsTable, RecipeTable, RecipeFields_RecipeId, RecipeCookStatsTable, RecipeCookLogFields_RecipeId)
+
+// recipeTimesCookedColumn counts the times the user of RecipeCookStatsJoin
+// cooked a recipe.
+var recipeTimesCookedColumn = fmt.Sprintf("COALESCE(%s.%s, 0)", RecipeCookStatsTable, RecipeFields_TimesCooked)
+
 var RecipeSQLConverter = filter.NewSQLConverter(map[string]filter.Field{
-	"visibility": {Name: RecipeFields_VisibilityLevel, Table: RecipeTable},
-	"permission": {Name: RecipeAccessFields_PermissionLevel, Table: RecipeAccessTable},
-	"state":      {Name: RecipeAccessFields_State, Table: RecipeAccessTable},
-	"favorited":  {Name: RecipeFavoriteFields_RecipeFavoriteId, Table: RecipeFavoriteTable, CustomConverter: favoritedSQLFilterConverter},
+	"visibility":     {Name: RecipeFields_VisibilityLevel, Table: RecipeTable},
+	"permission":     {Name: RecipeAccessFields_PermissionLevel, Table: RecipeAccessTable},
+	"state":          {Name: RecipeAccessFields_State, Table: RecipeAccessTable},
+	"favorited":      {Name: RecipeFavoriteFields_RecipeFavoriteId, Table: RecipeFavoriteTable, CustomConverter: favoritedSQLFilterConverter},
+	"ingredients":    {Name: RecipeFields_RecipeId, Table: RecipeTable, CustomConverter: ingredientsSQLFilterConverter},
+	"cuisines":       {Name: RecipeFields_Cuisines, Table: RecipeTable, CustomConverter: jsonbStringsSQLFilterConverter},
+	"categories":     {Name: RecipeFields_Categories, Table: RecipeTable, CustomConverter: jsonbStringsSQLFilterConverter},
+	"diets":          {Name: RecipeFields_Diets, Table: RecipeTable, CustomConverter: jsonbStringsSQLFilterConverter},
+	"allergens":      {Name: RecipeFields_Allergens, Table: RecipeTable, CustomConverter: jsonbStringsSQLFilterConverter},
+	"total_duration": {Name: RecipeFields_TotalDurationSeconds, Table: RecipeTable, CustomConverter: durationSecondsSQLFilterConverter},
+	"rating":         {Name: recipeAverageRatingSubquery},
+	// the cook history is the one of the caller
+	"last_cooked_time": {Name: RecipeFields_LastCookedTime, Table: RecipeCookStatsTable},
+	"times_cooked":     {Name: recipeTimesCookedColumn},
 }, true)
 
 // Custom converter for favorited field
@@ -82,6 +143,72 @@ func favoritedSQLFilterConverter(ctx *filter.Conversion, field string, operator
 	return "", false
 }
 
+// Custom converter for ingredients field. Matches recipes linked to a catalog
+// ingredient whose title or one of its aliases matches the value.
+func ingredientsSQLFilterConverter(ctx *filter.Conversion, field string, operator string, value interface{}) (string, bool) {
+	if operator != ":" && operator != "=" {
+		return "", false
+	}
+	title, ok := value.(string)
+	if !ok {
+		return "", false
+	}
+	normalized := ingredientcatalog.Normalize(title)
+	return fmt.Sprintf(
+		"%s IN (SELECT %s.%s FROM %s JOIN %s ON %s.%s = %s.%s WHERE %s.%s = %s OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(%s.%s) AS alias WHERE lower(alias) = %s))",
+		field,
+		RecipeIngredientTable, RecipeIngredientFields_RecipeId,
+		RecipeIngredientTable, IngredientTable,
+		IngredientTable, IngredientFields_IngredientId, RecipeIngredientTable, RecipeIngredientFields_IngredientId,
+		IngredientTable, IngredientFields_Title, ctx.AddParam(normalized),
+		IngredientTable, IngredientFields_Aliases, ctx.AddParam(strings.ToLower(title)),
+	), true
+}
+
+// Custom converter for jsonb string array fields. Matches rows with an
+// element equal to the value, ignoring case.
+func jsonbStringsSQLFilterConverter(ctx *filter.Conversion, field string, operator string, value interface{}) (string, bool) {
+	if operator != ":" && operator != "=" {
+		return "", false
+	}
+	element, ok := value.(string)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM jsonb_array_elements_text(COALESCE(%s, '[]'::jsonb)) AS element WHERE lower(element) = %s)",
+		field, ctx.AddParam(strings.ToLower(element)),
+	), true
+}
+
+// Custom converter for duration fields stored in seconds. Accepts durations
+// like 30m or 1h30m, and plain numbers of seconds.
+func durationSecondsSQLFilterConverter(ctx *filter.Conversion, field string, operator string, value interface{}) (string, bool) {
+	switch operator {
+	case "=", "!=", "<", "<=", ">", ">=":
+	default:
+		return "", false
+	}
+
+	var seconds int64
+	switch v := value.(type) {
+	case int64:
+		seconds = v
+	case float64:
+		seconds = int64(v)
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return "", false
+		}
+		seconds = int64(duration.Seconds())
+	default:
+		return "", false
+	}
+
+	return fmt.Sprintf("%s %s %s", field, operator, ctx.AddParam(seconds)), true
+}
+
 // Recipe -
 type Recipe struct {
 	RecipeId             int64 `gorm:"primaryKey;bigint;not null;<-:false"`
@@ -89,6 +216,7 @@ type Recipe struct {
 	Description          string
 	Directions           []byte `gorm:"type:jsonb"`
 	ImageURI             string
+	ImageSet             ImageSet              `gorm:"column:image_set;type:jsonb;serializer:json"`
 	IngredientGroups     []byte                `gorm:"type:jsonb"`
 	VisibilityLevel      types.VisibilityLevel `gorm:"not null;default:1"`
 	Citation             string                `gorm:"type:varchar(512)"`
@@ -97,11 +225,25 @@ type Recipe struct {
 	Categories           []byte                `gorm:"type:jsonb"`
 	YieldAmount          string                `gorm:"type:varchar(64)"`
 	Cuisines             []byte                `gorm:"type:jsonb"`
+	Nutrition            []byte                `gorm:"type:jsonb"`
+	Diets                []byte                `gorm:"type:jsonb"`
+	Allergens            []byte                `gorm:"type:jsonb"`
+	DietaryOverrides     []byte                `gorm:"type:jsonb"`
 	CreateTime           time.Time             `gorm:"column:create_time;autoCreateTime"`
 	UpdateTime           time.Time             `gorm:"column:update_time;autoUpdateTime"`
 	PrepDurationSeconds  int64                 `gorm:"column:prep_duration_seconds;type:bigint"`
 	CookDurationSeconds  int64                 `gorm:"column:cook_duration_seconds;type:bigint"`
 	TotalDurationSeconds int64                 `gorm:"column:total_duration_seconds;type:bigint"`
+	ForkedFromRecipeId   *int64                `gorm:"index"`
+
+	// ForkCount is only used for read from a subquery
+	ForkCount int64 `gorm:"->;-:migration"`
+	// AverageRating and RatingCount are only used for read from a subquery
+	AverageRating float64 `gorm:"->;-:migration"`
+	RatingCount   int64   `gorm:"->;-:migration"`
+	// LastCookedTime and TimesCooked are only used for read from a join
+	LastCookedTime *time.Time `gorm:"->;-:migration"`
+	TimesCooked    int64      `gorm:"->;-:migration"`
 
 	// RecipeAccess data
 	RecipeAccessId  int64                 `gorm:"->;-:migration"` // only used for read from a join
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	RecipeCookLogTable = "recipe_cook_log"
+)
+
+const (
+	RecipeCookLogFields_RecipeCookLogId       = "recipe_cook_log_id"
+	RecipeCookLogFields_RecipeId              = "recipe_id"
+	RecipeCookLogFields_CookUserId            = "cook_user_id"
+	RecipeCookLogFields_CookTime              = "cook_time"
+	RecipeCookLogFields_Rating                = "rating"
+	RecipeCookLogFields_Notes                 = "notes"
+	RecipeCookLogFields_Photos                = "photos"
+	RecipeCookLogFields_EventRecipeCalendarId = "event_recipe_calendar_id"
+	RecipeCookLogFields_EventRecipeEventId    = "event_recipe_event_id"
+	RecipeCookLogFields_EventRecipeId         = "event_recipe_id"
+	RecipeCookLogFields_CreateTime            = "create_time"
+	RecipeCookLogFields_UpdateTime            = "update_time"
+)
+
+var RecipeCookLogFieldMasker = fieldmask.NewSQLFieldMasker(RecipeCookLog{}, map[string][]fieldmask.Field{
+	model.RecipeCookLogField_Id:       {{Name: RecipeCookLogFields_RecipeCookLogId, Table: RecipeCookLogTable}},
+	model.RecipeCookLogField_Parent:   {{Name: RecipeCookLogFields_RecipeId, Table: RecipeCookLogTable}},
+	model.RecipeCookLogField_CookId:   {{Name: RecipeCookLogFields_CookUserId, Table: RecipeCookLogTable}},
+	model.RecipeCookLogField_CookTime: {{Name: RecipeCookLogFields_CookTime, Table: RecipeCookLogTable, Updatable: true}},
+	model.RecipeCookLogField_Rating:   {{Name: RecipeCookLogFields_Rating, Table: RecipeCookLogTable, Updatable: true}},
+	model.RecipeCookLogField_Notes:    {{Name: RecipeCookLogFields_Notes, Table: RecipeCookLogTable, Updatable: true}},
+	model.RecipeCookLogField_Photos:   {{Name: RecipeCookLogFields_Photos, Table: RecipeCookLogTable, Updatable: true}},
+	model.RecipeCookLogField_EventRecipe: {
+		{Name: RecipeCookLogFields_EventRecipeCalendarId, Table: RecipeCookLogTable},
+		{Name: RecipeCookLogFields_EventRecipeEventId, Table: RecipeCookLogTable},
+		{Name: RecipeCookLogFields_EventRecipeId, Table: RecipeCookLogTable},
+	},
+	model.RecipeCookLogField_CreateTime: {{Name: RecipeCookLogFields_CreateTime, Table: RecipeCookLogTable}},
+	model.RecipeCookLogField_UpdateTime: {{Name: RecipeCookLogFields_UpdateTime, Table: RecipeCookLogTable}},
+})
+
+// RecipeCookLog is a record of a user cooking a recipe.
+type RecipeCookLog struct {
+	RecipeCookLogId       int64                `gorm:"primaryKey;bigint;not null;<-:false"`
+	RecipeId              int64                `gorm:"not null;index;index:idx_recipe_cook_log_cook_recipe,priority:2"`
+	CookUserId            int64                `gorm:"not null;index:idx_recipe_cook_log_cook_recipe,priority:1"`
+	CookTime              time.Time            `gorm:"not null"`
+	Rating                int32                `gorm:"not null;default:0"`
+	Notes                 string               `gorm:"type:text"`
+	Photos                []RecipeCookLogPhoto `gorm:"type:jsonb;serializer:json"`
+	EventRecipeCalendarId *int64
+	EventRecipeEventId    *int64
+	EventRecipeId         *int64
+	CreateTime            time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime            time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// RecipeCookLogPhoto is the stored form of a photo of a cooked recipe.
+type RecipeCookLogPhoto struct {
+	ImageURI string   `json:"image_uri"`
+	ImageSet ImageSet `json:"image_set"`
+}
+
+// TableName -
+func (RecipeCookLog) TableName() string {
+	return RecipeCookLogTable
+}
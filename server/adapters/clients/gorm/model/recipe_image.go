@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	RecipeImageTable = "recipe_image"
+)
+
+const (
+	RecipeImageFields_RecipeImageId  = "recipe_image_id"
+	RecipeImageFields_RecipeId       = "recipe_id"
+	RecipeImageFields_ImageURI       = "image_uri"
+	RecipeImageFields_ImageSet       = "image_set"
+	RecipeImageFields_Position       = "position"
+	RecipeImageFields_DirectionIndex = "direction_index"
+	RecipeImageFields_StepIndex      = "step_index"
+	RecipeImageFields_CreateTime     = "create_time"
+)
+
+var RecipeImageFieldMasker = fieldmask.NewSQLFieldMasker(RecipeImage{}, map[string][]fieldmask.Field{
+	model.RecipeImageField_Id:       {{Name: RecipeImageFields_RecipeImageId, Table: RecipeImageTable}},
+	model.RecipeImageField_Parent:   {{Name: RecipeImageFields_RecipeId, Table: RecipeImageTable}},
+	model.RecipeImageField_ImageURI: {{Name: RecipeImageFields_ImageURI, Table: RecipeImageTable}},
+	model.RecipeImageField_ImageSet: {{Name: RecipeImageFields_ImageSet, Table: RecipeImageTable}},
+	model.RecipeImageField_Position: {{Name: RecipeImageFields_Position, Table: RecipeImageTable, Updatable: true}},
+	model.RecipeImageField_Step: {
+		{Name: RecipeImageFields_DirectionIndex, Table: RecipeImageTable, Updatable: true},
+		{Name: RecipeImageFields_StepIndex, Table: RecipeImageTable, Updatable: true},
+	},
+	model.RecipeImageField_CreateTime: {{Name: RecipeImageFields_CreateTime, Table: RecipeImageTable}},
+})
+
+// RecipeImage is an image in the gallery of a recipe, or attached to one of
+// its direction steps when the direction and step indexes are set.
+type RecipeImage struct {
+	RecipeImageId  int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	RecipeId       int64     `gorm:"not null;index"`
+	ImageURI       string    `gorm:"not null"`
+	ImageSet       ImageSet  `gorm:"column:image_set;type:jsonb;serializer:json"`
+	Position       int32     `gorm:"not null;default:0"`
+	DirectionIndex *int32    `gorm:""`
+	StepIndex      *int32    `gorm:""`
+	CreateTime     time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+// TableName -
+func (RecipeImage) TableName() string {
+	return RecipeImageTable
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	RecipeImageCandidateTable  = "recipe_image_candidate"
+	RecipeImageGenerationTable = "recipe_image_generation"
+)
+
+const (
+	RecipeImageCandidateFields_RecipeImageCandidateId = "recipe_image_candidate_id"
+	RecipeImageCandidateFields_RecipeId               = "recipe_id"
+	RecipeImageCandidateFields_CreatorUserId          = "creator_user_id"
+	RecipeImageCandidateFields_ImageURI               = "image_uri"
+	RecipeImageCandidateFields_CreateTime             = "create_time"
+)
+
+var RecipeImageCandidateFieldMasker = fieldmask.NewSQLFieldMasker(RecipeImageCandidate{}, map[string][]fieldmask.Field{
+	model.RecipeImageCandidateField_Id:         {{Name: RecipeImageCandidateFields_RecipeImageCandidateId, Table: RecipeImageCandidateTable}},
+	model.RecipeImageCandidateField_Parent:     {{Name: RecipeImageCandidateFields_RecipeId, Table: RecipeImageCandidateTable}},
+	model.RecipeImageCandidateField_CreatorId:  {{Name: RecipeImageCandidateFields_CreatorUserId, Table: RecipeImageCandidateTable}},
+	model.RecipeImageCandidateField_ImageURI:   {{Name: RecipeImageCandidateFields_ImageURI, Table: RecipeImageCandidateTable}},
+	model.RecipeImageCandidateField_CreateTime: {{Name: RecipeImageCandidateFields_CreateTime, Table: RecipeImageCandidateTable}},
+})
+
+// RecipeImageCandidate is a generated image waiting to be selected as the
+// cover of a recipe.
+type RecipeImageCandidate struct {
+	RecipeImageCandidateId int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	RecipeId               int64     `gorm:"not null;index"`
+	CreatorUserId          int64     `gorm:"not null"`
+	ImageURI               string    `gorm:"not null"`
+	CreateTime             time.Time `gorm:"column:create_time;autoCreateTime;index"`
+}
+
+// TableName -
+func (RecipeImageCandidate) TableName() string {
+	return RecipeImageCandidateTable
+}
+
+// RecipeImageGeneration records the images a user had generated for a
+// recipe, for the daily generation quota.
+type RecipeImageGeneration struct {
+	RecipeImageGenerationId int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	UserId                  int64     `gorm:"not null;index:idx_recipe_image_generation_user_time,priority:1"`
+	RecipeId                int64     `gorm:"not null"`
+	Count                   int32     `gorm:"not null"`
+	CreateTime              time.Time `gorm:"column:create_time;autoCreateTime;index:idx_recipe_image_generation_user_time,priority:2"`
+}
+
+// TableName -
+func (RecipeImageGeneration) TableName() string {
+	return RecipeImageGenerationTable
+}
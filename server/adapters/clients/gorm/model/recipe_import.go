@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	RecipeImportTable = "recipe_import"
+)
+
+const (
+	RecipeImportFields_RecipeImportId = "recipe_import_id"
+	RecipeImportFields_UserId         = "user_id"
+	RecipeImportFields_Source         = "source"
+	RecipeImportFields_State          = "state"
+	RecipeImportFields_Error          = "error"
+	RecipeImportFields_Results        = "results"
+	RecipeImportFields_CreateTime     = "create_time"
+	RecipeImportFields_CompleteTime   = "complete_time"
+)
+
+var RecipeImportFieldMasker = fieldmask.NewSQLFieldMasker(RecipeImport{}, map[string][]fieldmask.Field{
+	model.RecipeImportField_Id:           {{Name: RecipeImportFields_RecipeImportId, Table: RecipeImportTable}},
+	model.RecipeImportField_Parent:       {{Name: RecipeImportFields_UserId, Table: RecipeImportTable}},
+	model.RecipeImportField_Source:       {{Name: RecipeImportFields_Source, Table: RecipeImportTable}},
+	model.RecipeImportField_State:        {{Name: RecipeImportFields_State, Table: RecipeImportTable}},
+	model.RecipeImportField_Error:        {{Name: RecipeImportFields_Error, Table: RecipeImportTable}},
+	model.RecipeImportField_Results:      {{Name: RecipeImportFields_Results, Table: RecipeImportTable}},
+	model.RecipeImportField_CreateTime:   {{Name: RecipeImportFields_CreateTime, Table: RecipeImportTable}},
+	model.RecipeImportField_CompleteTime: {{Name: RecipeImportFields_CompleteTime, Table: RecipeImportTable}},
+})
+
+// RecipeImport is an import of recipes from the export file of another
+// recipe manager.
+type RecipeImport struct {
+	RecipeImportId int64      `gorm:"primaryKey;bigint;not null;<-:false"`
+	UserId         int64      `gorm:"not null;index"`
+	Source         string     `gorm:"not null"`
+	State          int        `gorm:"not null"`
+	Error          string     `gorm:""`
+	Results        []byte     `gorm:"type:jsonb"`
+	CreateTime     time.Time  `gorm:"column:create_time;autoCreateTime"`
+	CompleteTime   *time.Time `gorm:"column:complete_time"`
+}
+
+// TableName -
+func (RecipeImport) TableName() string {
+	return RecipeImportTable
+}
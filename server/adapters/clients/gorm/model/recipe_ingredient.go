@@ -0,0 +1,23 @@
+package model
+
+const (
+	RecipeIngredientTable = "recipe_ingredient"
+)
+
+const (
+	RecipeIngredientFields_RecipeIngredientId = "recipe_ingredient_id"
+	RecipeIngredientFields_RecipeId           = "recipe_id"
+	RecipeIngredientFields_IngredientId       = "ingredient_id"
+)
+
+// RecipeIngredient links a recipe to the catalog ingredients it uses.
+type RecipeIngredient struct {
+	RecipeIngredientId int64 `gorm:"primaryKey;bigint;not null;<-:false"`
+	RecipeId           int64 `gorm:"not null;index;uniqueIndex:idx_recipe_ingredient_recipe_id_ingredient_id"`
+	IngredientId       int64 `gorm:"not null;index;uniqueIndex:idx_recipe_ingredient_recipe_id_ingredient_id"`
+}
+
+// TableName -
+func (RecipeIngredient) TableName() string {
+	return RecipeIngredientTable
+}
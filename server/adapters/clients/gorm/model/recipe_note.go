@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	RecipeNoteTable = "recipe_note"
+)
+
+const (
+	RecipeNoteFields_RecipeNoteId    = "recipe_note_id"
+	RecipeNoteFields_RecipeId        = "recipe_id"
+	RecipeNoteFields_AuthorUserId    = "author_user_id"
+	RecipeNoteFields_Text            = "text"
+	RecipeNoteFields_GroupIndex      = "group_index"
+	RecipeNoteFields_IngredientIndex = "ingredient_index"
+	RecipeNoteFields_DirectionIndex  = "direction_index"
+	RecipeNoteFields_StepIndex       = "step_index"
+	RecipeNoteFields_CreateTime      = "create_time"
+	RecipeNoteFields_UpdateTime      = "update_time"
+)
+
+var RecipeNoteFieldMasker = fieldmask.NewSQLFieldMasker(RecipeNote{}, map[string][]fieldmask.Field{
+	model.RecipeNoteField_Id:       {{Name: RecipeNoteFields_RecipeNoteId, Table: RecipeNoteTable}},
+	model.RecipeNoteField_Parent:   {{Name: RecipeNoteFields_RecipeId, Table: RecipeNoteTable}},
+	model.RecipeNoteField_AuthorId: {{Name: RecipeNoteFields_AuthorUserId, Table: RecipeNoteTable}},
+	model.RecipeNoteField_Text:     {{Name: RecipeNoteFields_Text, Table: RecipeNoteTable, Updatable: true}},
+	model.RecipeNoteField_Ingredient: {
+		{Name: RecipeNoteFields_GroupIndex, Table: RecipeNoteTable, Updatable: true},
+		{Name: RecipeNoteFields_IngredientIndex, Table: RecipeNoteTable, Updatable: true},
+	},
+	model.RecipeNoteField_Step: {
+		{Name: RecipeNoteFields_DirectionIndex, Table: RecipeNoteTable, Updatable: true},
+		{Name: RecipeNoteFields_StepIndex, Table: RecipeNoteTable, Updatable: true},
+	},
+	model.RecipeNoteField_CreateTime: {{Name: RecipeNoteFields_CreateTime, Table: RecipeNoteTable}},
+	model.RecipeNoteField_UpdateTime: {{Name: RecipeNoteFields_UpdateTime, Table: RecipeNoteTable}},
+})
+
+// RecipeNote is a private note of a user on a recipe, attached to an
+// ingredient when the group and ingredient indexes are set, or to a direction
+// step when the direction and step indexes are set.
+type RecipeNote struct {
+	RecipeNoteId    int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	RecipeId        int64     `gorm:"not null;index;index:idx_recipe_note_author_recipe,priority:2"`
+	AuthorUserId    int64     `gorm:"not null;index:idx_recipe_note_author_recipe,priority:1"`
+	Text            string    `gorm:"type:text;not null"`
+	GroupIndex      *int32    `gorm:""`
+	IngredientIndex *int32    `gorm:""`
+	DirectionIndex  *int32    `gorm:""`
+	StepIndex       *int32    `gorm:""`
+	CreateTime      time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime      time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// TableName -
+func (RecipeNote) TableName() string {
+	return RecipeNoteTable
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	RecipeReviewTable = "recipe_review"
+)
+
+const (
+	RecipeReviewFields_RecipeReviewId = "recipe_review_id"
+	RecipeReviewFields_RecipeId       = "recipe_id"
+	RecipeReviewFields_AuthorUserId   = "author_user_id"
+	RecipeReviewFields_Rating         = "rating"
+	RecipeReviewFields_Text           = "text"
+	RecipeReviewFields_CreateTime     = "create_time"
+	RecipeReviewFields_UpdateTime     = "update_time"
+)
+
+var RecipeReviewFieldMasker = fieldmask.NewSQLFieldMasker(RecipeReview{}, map[string][]fieldmask.Field{
+	model.RecipeReviewField_Id:         {{Name: RecipeReviewFields_RecipeReviewId, Table: RecipeReviewTable}},
+	model.RecipeReviewField_Parent:     {{Name: RecipeReviewFields_RecipeId, Table: RecipeReviewTable}},
+	model.RecipeReviewField_AuthorId:   {{Name: RecipeReviewFields_AuthorUserId, Table: RecipeReviewTable}},
+	model.RecipeReviewField_Rating:     {{Name: RecipeReviewFields_Rating, Table: RecipeReviewTable, Updatable: true}},
+	model.RecipeReviewField_Text:       {{Name: RecipeReviewFields_Text, Table: RecipeReviewTable, Updatable: true}},
+	model.RecipeReviewField_CreateTime: {{Name: RecipeReviewFields_CreateTime, Table: RecipeReviewTable}},
+	model.RecipeReviewField_UpdateTime: {{Name: RecipeReviewFields_UpdateTime, Table: RecipeReviewTable}},
+})
+
+// RecipeReview is a user's rating and review of a recipe. A user can review a
+// recipe once.
+type RecipeReview struct {
+	RecipeReviewId int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	RecipeId       int64     `gorm:"not null;uniqueIndex:idx_recipe_review_recipe_author"`
+	AuthorUserId   int64     `gorm:"not null;uniqueIndex:idx_recipe_review_recipe_author"`
+	Rating         int32     `gorm:"not null"`
+	Text           string    `gorm:"type:text"`
+	CreateTime     time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime     time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// TableName -
+func (RecipeReview) TableName() string {
+	return RecipeReviewTable
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	RecipeRevisionTable = "recipe_revision"
+)
+
+const (
+	RecipeRevisionFields_RecipeRevisionId = "recipe_revision_id"
+	RecipeRevisionFields_RecipeId         = "recipe_id"
+	RecipeRevisionFields_EditorUserId     = "editor_user_id"
+	RecipeRevisionFields_UpdateMask       = "update_mask"
+	RecipeRevisionFields_Snapshot         = "snapshot"
+	RecipeRevisionFields_CreateTime       = "create_time"
+)
+
+var RecipeRevisionFieldMasker = fieldmask.NewSQLFieldMasker(RecipeRevision{}, map[string][]fieldmask.Field{
+	model.RecipeRevisionField_Id:         {{Name: RecipeRevisionFields_RecipeRevisionId, Table: RecipeRevisionTable}},
+	model.RecipeRevisionField_Parent:     {{Name: RecipeRevisionFields_RecipeId, Table: RecipeRevisionTable}},
+	model.RecipeRevisionField_EditorId:   {{Name: RecipeRevisionFields_EditorUserId, Table: RecipeRevisionTable}},
+	model.RecipeRevisionField_UpdateMask: {{Name: RecipeRevisionFields_UpdateMask, Table: RecipeRevisionTable}},
+	model.RecipeRevisionField_Recipe:     {{Name: RecipeRevisionFields_Snapshot, Table: RecipeRevisionTable}},
+	model.RecipeRevisionField_CreateTime: {{Name: RecipeRevisionFields_CreateTime, Table: RecipeRevisionTable}},
+})
+
+// RecipeRevision is an immutable snapshot of a recipe.
+type RecipeRevision struct {
+	RecipeRevisionId int64     `gorm:"primaryKey;bigint;not null;<-:false"`
+	RecipeId         int64     `gorm:"not null;index"`
+	EditorUserId     int64     `gorm:"not null"`
+	UpdateMask       []byte    `gorm:"type:jsonb"`
+	Snapshot         []byte    `gorm:"type:jsonb;not null"`
+	CreateTime       time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+// TableName -
+func (RecipeRevision) TableName() string {
+	return RecipeRevisionTable
+}
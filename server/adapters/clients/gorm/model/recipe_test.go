@@ -0,0 +1,69 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/filter"
+)
+
+func TestRecipeFieldMasker_ForkCount(t *testing.T) {
+	got := RecipeFieldMasker.Convert([]string{model.RecipeField_ForkCount})
+	want := "(SELECT COUNT(*) FROM recipe AS fork WHERE fork.forked_from_recipe_id = recipe.recipe_id) as fork_count"
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("Convert(fork_count) = %v, want [%s]", got, want)
+	}
+}
+
+func TestRecipeSQLConverter_CookHistory(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+		params []any
+	}{
+		{filter: "times_cooked >= 2", want: "COALESCE(recipe_cook_stats.times_cooked, 0) >= ?", params: []any{int64(2)}},
+		// recipes never cooked have no cook stats row
+		{filter: "times_cooked = 0", want: "COALESCE(recipe_cook_stats.times_cooked, 0) = ?", params: []any{int64(0)}},
+		{filter: `last_cooked_time < "2024-01-01T00:00:00Z"`, want: "recipe_cook_stats.last_cooked_time < ?", params: []any{"2024-01-01T00:00:00Z"}},
+	}
+
+	for _, tt := range tests {
+		got, err := RecipeSQLConverter.Convert(tt.filter)
+		if err != nil {
+			t.Fatalf("Convert(%q) error = %v", tt.filter, err)
+		}
+		if got.WhereClause != tt.want {
+			t.Errorf("Convert(%q) = %q, want %q", tt.filter, got.WhereClause, tt.want)
+		}
+		if fmt.Sprint(got.Params) != fmt.Sprint(tt.params) {
+			t.Errorf("Convert(%q) params = %v, want %v", tt.filter, got.Params, tt.params)
+		}
+	}
+}
+
+func TestRecipeSQLConverter_CookHistoryOrder(t *testing.T) {
+	got, err := RecipeSQLConverter.ConvertOrderBy("last_cooked_time desc, times_cooked")
+	if err != nil {
+		t.Fatalf("ConvertOrderBy() error = %v", err)
+	}
+
+	want := []filter.OrderByColumn{
+		{Column: "recipe_cook_stats.last_cooked_time", Desc: true},
+		{Column: "COALESCE(recipe_cook_stats.times_cooked, 0)"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("ConvertOrderBy() = %v, want %v", got, want)
+	}
+}
+
+func TestRecipeCookStatsJoin_OnlyOneCook(t *testing.T) {
+	// the cook history is the one of the user given to the join, never the
+	// logs of other cooks
+	want := "FROM recipe_cook_log WHERE cook_user_id = ? GROUP BY recipe_id"
+	if !strings.Contains(RecipeCookStatsJoin, want) {
+		t.Errorf("RecipeCookStatsJoin = %s, want it to contain %s", RecipeCookStatsJoin, want)
+	}
+}
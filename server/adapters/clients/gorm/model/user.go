@@ -17,11 +17,14 @@ const (
 	UserColumn_GivenName  = "given_name"
 	UserColumn_FamilyName = "family_name"
 	UserColumn_ImageUri   = "image_uri"
+	UserColumn_ImageSet   = "image_set"
 	UserColumn_Bio        = "bio"
 	UserColumn_Email      = "email"
 	UserColumn_AmazonId   = "amazon_id"
 	UserColumn_FacebookId = "facebook_id"
 	UserColumn_GoogleId   = "google_id"
+
+	UserColumn_DietaryRestrictions = "dietary_restrictions"
 )
 
 var UserFieldMasker = fieldmask.NewSQLFieldMasker(User{}, map[string][]fieldmask.Field{
@@ -30,12 +33,15 @@ var UserFieldMasker = fieldmask.NewSQLFieldMasker(User{}, map[string][]fieldmask
 	cmodel.UserField_GivenName:  {{Name: UserColumn_GivenName, Table: "daylear_user", Updatable: true}},
 	cmodel.UserField_FamilyName: {{Name: UserColumn_FamilyName, Table: "daylear_user", Updatable: true}},
 	cmodel.UserField_ImageUri:   {{Name: UserColumn_ImageUri, Table: "daylear_user", Updatable: true}},
+	cmodel.UserField_ImageSet:   {{Name: UserColumn_ImageSet, Table: "daylear_user", Updatable: true}},
 	cmodel.UserField_Bio:        {{Name: UserColumn_Bio, Table: "daylear_user", Updatable: true}},
 	cmodel.UserField_Email:      {{Name: UserColumn_Email, Table: "daylear_user", Updatable: true}},
 	cmodel.UserField_AmazonId:   {{Name: UserColumn_AmazonId, Table: "daylear_user"}},
 	cmodel.UserField_FacebookId: {{Name: UserColumn_FacebookId, Table: "daylear_user"}},
 	cmodel.UserField_GoogleId:   {{Name: UserColumn_GoogleId, Table: "daylear_user"}},
 
+	cmodel.UserField_DietaryRestrictions: {{Name: UserColumn_DietaryRestrictions, Table: "daylear_user", Updatable: true}},
+
 	cmodel.UserField_AccessName: {
 		{Name: CircleAccessColumn_CircleAccessId, Table: "circle_access"},
 		{Name: UserAccessColumn_UserAccessId, Table: "user_access"},
@@ -84,8 +90,11 @@ type User struct {
 	FacebookId *string `gorm:"size:255;->:false;<-:create;uniqueIndex"`
 	GoogleId   *string `gorm:"size:255;->:false;<-:create;uniqueIndex"`
 
-	ImageUri string `gorm:"size:255"`
-	Bio      string `gorm:"size:1024"`
+	ImageUri string   `gorm:"size:255"`
+	ImageSet ImageSet `gorm:"column:image_set;type:jsonb;serializer:json"`
+	Bio      string   `gorm:"size:1024"`
+
+	DietaryRestrictions []byte `gorm:"type:jsonb"`
 
 	UserAccessId    int64                 `gorm:"->;-:migration"` // only used for read from a join
 	CircleAccessId  int64                 `gorm:"->;-:migration"` // only used for read from a join
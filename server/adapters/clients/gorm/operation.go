@@ -0,0 +1,358 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// CreateOperation creates a new operation.
+func (repo *Client) CreateOperation(ctx context.Context, m cmodel.Operation) (cmodel.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", m.Parent.UserId).
+		Str("kind", string(m.Kind)).
+		Logger()
+
+	gm, err := convert.OperationFromCoreModel(m)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid operation when creating operation row")
+		return cmodel.Operation{}, repository.ErrInvalidArgument{Msg: fmt.Sprintf("invalid operation: %v", err)}
+	}
+
+	err = repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create operation row")
+		return cmodel.Operation{}, ConvertGormError(err)
+	}
+
+	m, err = convert.OperationToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid operation row when creating operation")
+		return cmodel.Operation{}, repository.ErrInternal{Msg: "invalid operation row when creating operation"}
+	}
+
+	return m, nil
+}
+
+// GetOperation gets an operation.
+func (repo *Client) GetOperation(ctx context.Context, parent cmodel.OperationParent, id cmodel.OperationId, fields []string) (cmodel.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", parent.UserId).
+		Int64("operationId", id.OperationId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.Operation{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.OperationFieldMasker.Convert(fields)).
+		Where("operation.user_id = ? AND operation.operation_id = ?", parent.UserId, id.OperationId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get operation row")
+		return cmodel.Operation{}, ConvertGormError(err)
+	}
+
+	m, err := convert.OperationToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid operation row when getting operation")
+		return cmodel.Operation{}, repository.ErrInternal{Msg: "invalid operation row when getting operation"}
+	}
+
+	return m, nil
+}
+
+// ListOperations lists the operations of a user, newest first.
+func (repo *Client) ListOperations(ctx context.Context, parent cmodel.OperationParent, pageSize int32, offset int64, fields []string) ([]cmodel.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", parent.UserId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.OperationFieldMasker.Convert(fields)).
+		Where("operation.user_id = ?", parent.UserId).
+		Order("operation.operation_id DESC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbOperations := []gmodel.Operation{}
+	err := tx.Find(&dbOperations).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list operation rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.Operation, len(dbOperations))
+	for i, m := range dbOperations {
+		res[i], err = convert.OperationToCoreModel(m)
+		if err != nil {
+			log.Error().Err(err).Msg("invalid operation row when listing operations")
+			return nil, repository.ErrInternal{Msg: "invalid operation row when listing operations"}
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateOperation updates an operation that has not finished yet.
+func (repo *Client) UpdateOperation(ctx context.Context, m cmodel.Operation, fields []string) (cmodel.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", m.Parent.UserId).
+		Int64("operationId", m.Id.OperationId).
+		Strs("fields", fields).
+		Logger()
+
+	gm, err := convert.OperationFromCoreModel(m)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid operation when updating operation row")
+		return cmodel.Operation{}, repository.ErrInvalidArgument{Msg: fmt.Sprintf("invalid operation: %v", err)}
+	}
+	gm.UpdateTime = time.Now()
+
+	columns := append(gmodel.OperationFieldMasker.Convert(fields, fieldmask.OnlyUpdatable()), gmodel.OperationFields_UpdateTime)
+
+	tx := repo.db.WithContext(ctx).
+		Select(columns).
+		Clauses(clause.Returning{}).
+		Where("operation.user_id = ? AND operation.operation_id = ? AND operation.state IN ?",
+			m.Parent.UserId, m.Id.OperationId, []cmodel.OperationState{cmodel.OperationState_Pending, cmodel.OperationState_Running})
+	if m.LeaseOwner != "" {
+		tx = tx.Where("operation.lease_owner = ?", m.LeaseOwner)
+	}
+
+	res := tx.Updates(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to update operation row")
+		return cmodel.Operation{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("no unfinished operation row updated")
+		return cmodel.Operation{}, repository.ErrNotFound{Msg: "operation not found or already finished"}
+	}
+
+	m, err = convert.OperationToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid operation row when updating operation")
+		return cmodel.Operation{}, repository.ErrInternal{Msg: "invalid operation row when updating operation"}
+	}
+
+	return m, nil
+}
+
+// DeleteOperation deletes an operation.
+func (repo *Client) DeleteOperation(ctx context.Context, parent cmodel.OperationParent, id cmodel.OperationId) (cmodel.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", parent.UserId).
+		Int64("operationId", id.OperationId).
+		Logger()
+
+	gm := gmodel.Operation{}
+
+	res := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("operation.user_id = ? AND operation.operation_id = ?", parent.UserId, id.OperationId).
+		Delete(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to delete operation row")
+		return cmodel.Operation{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("no operation row deleted")
+		return cmodel.Operation{}, repository.ErrNotFound{Msg: "operation not found"}
+	}
+
+	m, err := convert.OperationToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid operation row when deleting operation")
+		return cmodel.Operation{}, repository.ErrInternal{Msg: "invalid operation row when deleting operation"}
+	}
+
+	return m, nil
+}
+
+// LockOperations locks the operations of a user until the transaction ends,
+// so only one caller at a time checks and starts them.
+func (repo *Client) LockOperations(ctx context.Context, parent cmodel.OperationParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", parent.UserId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", fmt.Sprintf("%s:%d", gmodel.OperationTable, parent.UserId)).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to lock operations")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// CountActiveOperations counts the operations of a kind of a user that are
+// pending or running.
+func (repo *Client) CountActiveOperations(ctx context.Context, parent cmodel.OperationParent, kind cmodel.OperationKind) (int64, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", parent.UserId).
+		Str("kind", string(kind)).
+		Logger()
+
+	var count int64
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.Operation{}).
+		Where("operation.user_id = ? AND operation.kind = ?", parent.UserId, string(kind)).
+		Where("operation.state IN ?", []cmodel.OperationState{cmodel.OperationState_Pending, cmodel.OperationState_Running}).
+		Count(&count).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to count active operation rows")
+		return 0, ConvertGormError(err)
+	}
+
+	return count, nil
+}
+
+// ClaimOperation marks the oldest pending operation as running under a lease
+// held by owner and returns it. Rows claimed by another process are skipped.
+func (repo *Client) ClaimOperation(ctx context.Context, owner string, leaseExpireTime time.Time) (cmodel.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Str("owner", owner).
+		Logger()
+
+	next := repo.db.
+		Model(&gmodel.Operation{}).
+		Select(gmodel.OperationFields_OperationId).
+		Where("operation.state = ?", cmodel.OperationState_Pending).
+		Order("operation.operation_id").
+		Limit(1).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked})
+
+	gm := gmodel.Operation{}
+
+	res := repo.db.WithContext(ctx).
+		Model(&gm).
+		Clauses(clause.Returning{}).
+		Where("operation.operation_id = (?)", next).
+		Updates(map[string]interface{}{
+			gmodel.OperationFields_State:           cmodel.OperationState_Running,
+			gmodel.OperationFields_LeaseOwner:      owner,
+			gmodel.OperationFields_LeaseExpireTime: leaseExpireTime,
+			gmodel.OperationFields_UpdateTime:      time.Now(),
+		})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to claim operation row")
+		return cmodel.Operation{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return cmodel.Operation{}, repository.ErrNotFound{Msg: "no pending operation"}
+	}
+
+	m, err := convert.OperationToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid operation row when claiming operation")
+		return cmodel.Operation{}, repository.ErrInternal{Msg: "invalid operation row when claiming operation"}
+	}
+
+	return m, nil
+}
+
+// RenewOperationLease extends the lease of owner on a running operation.
+func (repo *Client) RenewOperationLease(ctx context.Context, id cmodel.OperationId, owner string, leaseExpireTime time.Time) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("operationId", id.OperationId).
+		Str("owner", owner).
+		Logger()
+
+	res := repo.db.WithContext(ctx).
+		Model(&gmodel.Operation{}).
+		Where("operation.operation_id = ? AND operation.state = ? AND operation.lease_owner = ?",
+			id.OperationId, cmodel.OperationState_Running, owner).
+		Updates(map[string]interface{}{
+			gmodel.OperationFields_LeaseExpireTime: leaseExpireTime,
+		})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to renew operation lease")
+		return ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("operation lease lost")
+		return repository.ErrNotFound{Msg: "operation not running under this lease"}
+	}
+
+	return nil
+}
+
+// RequeueExpiredOperations marks the running operations whose lease expired
+// before the given time as pending again. Operations running without a lease
+// were started before leases were recorded and are requeued too.
+func (repo *Client) RequeueExpiredOperations(ctx context.Context, before time.Time) (int64, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Time("before", before).
+		Logger()
+
+	res := repo.db.WithContext(ctx).
+		Model(&gmodel.Operation{}).
+		Where("operation.state = ?", cmodel.OperationState_Running).
+		Where("operation.lease_expire_time IS NULL OR operation.lease_expire_time < ?", before).
+		Updates(map[string]interface{}{
+			gmodel.OperationFields_State:           cmodel.OperationState_Pending,
+			gmodel.OperationFields_LeaseOwner:      "",
+			gmodel.OperationFields_LeaseExpireTime: nil,
+			gmodel.OperationFields_UpdateTime:      time.Now(),
+		})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to requeue operation rows")
+		return 0, ConvertGormError(res.Error)
+	}
+
+	if res.RowsAffected > 0 {
+		log.Info().Int64("operations", res.RowsAffected).Msg("requeued operations with expired leases")
+	}
+
+	return res.RowsAffected, nil
+}
+
+// DeleteExpiredOperations deletes the operations that expired before the
+// given time and returns them.
+func (repo *Client) DeleteExpiredOperations(ctx context.Context, before time.Time) ([]cmodel.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Time("before", before).
+		Logger()
+
+	dbOperations := []gmodel.Operation{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("operation.expire_time < ?", before).
+		Delete(&dbOperations).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete expired operation rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.Operation, len(dbOperations))
+	for i, m := range dbOperations {
+		res[i], err = convert.OperationToCoreModel(m)
+		if err != nil {
+			log.Error().Err(err).Msg("invalid operation row when deleting expired operations")
+			return nil, repository.ErrInternal{Msg: "invalid operation row when deleting expired operations"}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,161 @@
+package gorm
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/rs/zerolog"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// sqlRecorder is a gorm logger that keeps the statements it traces.
+type sqlRecorder struct {
+	logger.Interface
+	statements []string
+}
+
+func (r *sqlRecorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+// newDryRunClient creates a client that builds its statements without
+// running them, and the recorder of those statements.
+func newDryRunClient(t *testing.T) (*Client, *sqlRecorder) {
+	t.Helper()
+
+	recorder := &sqlRecorder{Interface: logger.Discard}
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 recorder,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	return &Client{db: db, log: zerolog.Nop()}, recorder
+}
+
+func (r *sqlRecorder) last(t *testing.T) string {
+	t.Helper()
+	if len(r.statements) == 0 {
+		t.Fatal("no statement was built")
+	}
+	return r.statements[len(r.statements)-1]
+}
+
+func TestClaimOperation_SetsLease(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	// nothing is claimed in a dry run
+	_, _ = repo.ClaimOperation(context.Background(), "owner-a", time.Now().Add(time.Minute))
+
+	sql := recorder.last(t)
+	for _, want := range []string{`"state"=2`, `"lease_owner"='owner-a'`, `"lease_expire_time"=`, "FOR UPDATE SKIP LOCKED"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("ClaimOperation() sql = %s, want it to contain %s", sql, want)
+		}
+	}
+}
+
+func TestRenewOperationLease_RequiresOwner(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	_ = repo.RenewOperationLease(context.Background(), cmodel.OperationId{OperationId: 7}, "owner-a", time.Now().Add(time.Minute))
+
+	sql := recorder.last(t)
+	for _, want := range []string{"operation.operation_id = 7", "operation.state = 2", "operation.lease_owner = 'owner-a'"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("RenewOperationLease() sql = %s, want it to contain %s", sql, want)
+		}
+	}
+}
+
+func TestRequeueExpiredOperations_OnlyExpiredLeases(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	_, err := repo.RequeueExpiredOperations(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("RequeueExpiredOperations() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	for _, want := range []string{
+		`"state"=1`,
+		`"lease_owner"=''`,
+		`"lease_expire_time"=NULL`,
+		"operation.state = 2",
+		"(operation.lease_expire_time IS NULL OR operation.lease_expire_time <",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("RequeueExpiredOperations() sql = %s, want it to contain %s", sql, want)
+		}
+	}
+}
+
+func TestUpdateOperation_LeaseOwner(t *testing.T) {
+	tests := []struct {
+		name       string
+		leaseOwner string
+		wantOwner  bool
+	}{
+		{name: "with owner", leaseOwner: "owner-a", wantOwner: true},
+		{name: "without owner"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, recorder := newDryRunClient(t)
+
+			_, _ = repo.UpdateOperation(context.Background(), cmodel.Operation{
+				Parent:     cmodel.OperationParent{UserId: 1},
+				Id:         cmodel.OperationId{OperationId: 7},
+				State:      cmodel.OperationState_Succeeded,
+				LeaseOwner: tt.leaseOwner,
+			}, []string{cmodel.OperationField_State})
+
+			sql := recorder.last(t)
+			if got := strings.Contains(sql, "operation.lease_owner = 'owner-a'"); got != tt.wantOwner {
+				t.Errorf("UpdateOperation() sql = %s, lease owner condition = %v, want %v", sql, got, tt.wantOwner)
+			}
+		})
+	}
+}
+
+func TestLockOperations_PerUser(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	err := repo.LockOperations(context.Background(), cmodel.OperationParent{UserId: 7})
+	if err != nil {
+		t.Fatalf("LockOperations() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	want := "SELECT pg_advisory_xact_lock(hashtextextended('operation:7', 0))"
+	if !strings.Contains(sql, want) {
+		t.Errorf("LockOperations() sql = %s, want it to contain %s", sql, want)
+	}
+}
+
+func TestCountActiveOperations_PendingOrRunning(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	_, err := repo.CountActiveOperations(context.Background(), cmodel.OperationParent{UserId: 7}, cmodel.OperationKind_ScrapeRecipe)
+	if err != nil {
+		t.Fatalf("CountActiveOperations() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	for _, want := range []string{"operation.user_id = 7", "operation.kind = 'scrape_recipe'", "operation.state IN (1,2)"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("CountActiveOperations() sql = %s, want it to contain %s", sql, want)
+		}
+	}
+}
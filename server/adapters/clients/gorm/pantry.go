@@ -0,0 +1,172 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// ListPantries lists pantries.
+func (repo *Client) ListPantries(ctx context.Context, authAccount cmodel.AuthAccount, pageSize int32, pageOffset int32, filter string, fields []string) ([]cmodel.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Str("filter", filter).
+		Strs("fields", fields).
+		Int("pageSize", int(pageSize)).
+		Int("pageOffset", int(pageOffset)).
+		Logger()
+
+	if authAccount.PermissionLevel < types.PermissionLevel_PERMISSION_LEVEL_PUBLIC {
+		return nil, repository.ErrInvalidArgument{Msg: "invalid permission level"}
+	}
+
+	dbPantries := []gmodel.Pantry{}
+
+	orders := []clause.OrderByColumn{{
+		Column: clause.Column{Name: "pantry.pantry_id"},
+		Desc:   true,
+	}}
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.PantryFieldMasker.Convert(fields)).
+		Order(clause.OrderBy{Columns: orders}).
+		Limit(int(pageSize)).
+		Offset(int(pageOffset))
+
+	if authAccount.CircleId != 0 {
+		maxVisibilityLevel := types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC
+		if authAccount.PermissionLevel > types.PermissionLevel_PERMISSION_LEVEL_PUBLIC {
+			maxVisibilityLevel = types.VisibilityLevel_VISIBILITY_LEVEL_PRIVATE
+		}
+		tx = tx.Joins("LEFT JOIN pantry_access ON pantry.pantry_id = pantry_access.pantry_id AND pantry_access.recipient_circle_id = ? AND (pantry.visibility_level != ? OR pantry_access.permission_level = ?)", authAccount.CircleId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Joins("LEFT JOIN pantry_access as pa ON pantry.pantry_id = pa.pantry_id AND pa.recipient_user_id = ? AND (pantry.visibility_level != ? OR pa.permission_level = ?)", authAccount.AuthUserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Where("(pantry.visibility_level <= ? OR (pantry_access.recipient_circle_id = ? AND pa.state = ?))",
+				maxVisibilityLevel, authAccount.CircleId, types.AccessState_ACCESS_STATE_ACCEPTED)
+	} else if authAccount.UserId != 0 && authAccount.UserId != authAccount.AuthUserId {
+		maxVisibilityLevel := types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC
+		if authAccount.PermissionLevel > types.PermissionLevel_PERMISSION_LEVEL_PUBLIC {
+			maxVisibilityLevel = types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED
+		}
+		tx = tx.Joins("LEFT JOIN pantry_access ON pantry.pantry_id = pantry_access.pantry_id AND pantry_access.recipient_user_id = ? AND (pantry.visibility_level != ? OR pantry_access.permission_level = ?)", authAccount.UserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Joins("LEFT JOIN pantry_access as pa ON pantry.pantry_id = pa.pantry_id AND pa.recipient_user_id = ? AND (pantry.visibility_level != ? OR pa.permission_level = ?)", authAccount.AuthUserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Where("(pantry.visibility_level <= ? OR (pantry_access.recipient_user_id = ? AND pa.state = ?))",
+				maxVisibilityLevel, authAccount.UserId, types.AccessState_ACCESS_STATE_ACCEPTED)
+	} else {
+		tx = tx.Joins("LEFT JOIN pantry_access ON pantry.pantry_id = pantry_access.pantry_id AND pantry_access.recipient_user_id = ? AND (pantry.visibility_level != ? OR pantry_access.permission_level = ?)", authAccount.AuthUserId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, types.PermissionLevel_PERMISSION_LEVEL_ADMIN).
+			Where("(pantry.visibility_level = ? OR pantry_access.recipient_user_id = ?)", types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, authAccount.AuthUserId)
+	}
+
+	conversion, err := gmodel.PantrySQLConverter.Convert(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid filter string when listing pantry rows")
+		return nil, repository.ErrInvalidArgument{Msg: "invalid filter"}
+	}
+
+	if conversion.WhereClause != "" {
+		tx = tx.Where(conversion.WhereClause, conversion.Params...)
+	}
+
+	err = tx.Find(&dbPantries).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list pantry rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.Pantry, len(dbPantries))
+	for i, m := range dbPantries {
+		res[i] = convert.PantryToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// CreatePantry creates a new pantry.
+func (repo *Client) CreatePantry(ctx context.Context, authAccount cmodel.AuthAccount, m cmodel.Pantry) (cmodel.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx)
+
+	gm := convert.PantryFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.PantryFieldMasker.Convert([]string{})).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create pantry row")
+		return cmodel.Pantry{}, ConvertGormError(err)
+	}
+
+	return convert.PantryToCoreModel(gm), nil
+}
+
+// DeletePantry deletes a pantry.
+func (repo *Client) DeletePantry(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.PantryId) (cmodel.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", id.PantryId).
+		Logger()
+
+	gm := gmodel.Pantry{PantryId: id.PantryId}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.PantryFieldMasker.Get()).
+		Clauses(clause.Returning{}).
+		Delete(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete pantry row")
+		return cmodel.Pantry{}, ConvertGormError(err)
+	}
+
+	return convert.PantryToCoreModel(gm), nil
+}
+
+// GetPantry gets a pantry.
+func (repo *Client) GetPantry(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.PantryId, fields []string) (cmodel.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", id.PantryId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.Pantry{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.PantryFieldMasker.Convert(
+			fields,
+			fieldmask.ExcludeKeys(
+				cmodel.PantryField_PantryAccess,
+			),
+		)).
+		Where("pantry.pantry_id = ?", id.PantryId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry row")
+		return cmodel.Pantry{}, ConvertGormError(err)
+	}
+
+	return convert.PantryToCoreModel(gm), nil
+}
+
+// UpdatePantry updates a pantry.
+func (repo *Client) UpdatePantry(ctx context.Context, authAccount cmodel.AuthAccount, m cmodel.Pantry, fields []string) (cmodel.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", m.Id.PantryId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.PantryFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.PantryFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(&clause.Returning{}).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update pantry row")
+		return cmodel.Pantry{}, ConvertGormError(err)
+	}
+
+	return convert.PantryToCoreModel(gm), nil
+}
@@ -0,0 +1,309 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	dbModel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (repo *Client) CreatePantryAccess(ctx context.Context, access cmodel.PantryAccess, fields []string) (cmodel.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", access.PantryId.PantryId).
+		Int64("recipientUserId", access.Recipient.UserId).
+		Int64("recipientCircleId", access.Recipient.CircleId).
+		Logger()
+
+	// Validate that exactly one recipient type is set
+	if (access.Recipient.UserId != 0) == (access.Recipient.CircleId != 0) {
+		log.Error().Msg("exactly one recipient (user or circle) is required to create pantry access row")
+		return cmodel.PantryAccess{}, repository.ErrInvalidArgument{Msg: "exactly one recipient (user or circle) is required"}
+	}
+
+	pantryAccess := convert.PantryAccessFromCoreModel(access)
+	res := repo.db.WithContext(ctx).
+		Select(dbModel.PantryAccessFieldMasker.Convert(fields)).
+		Clauses(clause.Returning{}).
+		Create(&pantryAccess)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrDuplicatedKey) {
+			log.Error().Err(res.Error).Msg("unable to create pantry access row: already exists")
+			return cmodel.PantryAccess{}, repository.ErrNewAlreadyExists{}
+		}
+		log.Error().Err(res.Error).Msg("unable to create pantry access row")
+		return cmodel.PantryAccess{}, ConvertGormError(res.Error)
+	}
+
+	access.PantryAccessId.PantryAccessId = pantryAccess.PantryAccessId
+	return access, nil
+}
+
+func (repo *Client) DeletePantryAccess(ctx context.Context, parent cmodel.PantryAccessParent, id cmodel.PantryAccessId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", parent.PantryId.PantryId).
+		Int64("pantryAccessId", id.PantryAccessId).
+		Logger()
+
+	if parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required to delete pantry access row")
+		return repository.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	if id.PantryAccessId == 0 {
+		log.Error().Msg("pantry access id is required to delete pantry access row")
+		return repository.ErrInvalidArgument{Msg: "pantry access id is required"}
+	}
+
+	res := repo.db.WithContext(ctx).
+		Where("pantry_id = ? AND pantry_access_id = ?", parent.PantryId.PantryId, id.PantryAccessId).
+		Delete(&dbModel.PantryAccess{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to delete pantry access row")
+		return ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("no pantry access row deleted")
+		return repository.ErrNotFound{}
+	}
+
+	return nil
+}
+
+func (repo *Client) BulkDeletePantryAccess(ctx context.Context, parent cmodel.PantryAccessParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", parent.PantryId.PantryId).
+		Logger()
+
+	if parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required to bulk delete pantry access rows")
+		return repository.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	res := repo.db.WithContext(ctx).
+		Where("pantry_id = ?", parent.PantryId.PantryId).
+		Delete(&dbModel.PantryAccess{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to bulk delete pantry access rows")
+		return ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("no pantry access rows deleted")
+		return repository.ErrNotFound{}
+	}
+
+	return nil
+}
+
+func (repo *Client) GetPantryAccess(ctx context.Context, parent cmodel.PantryAccessParent, id cmodel.PantryAccessId, fields []string) (cmodel.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", parent.PantryId.PantryId).
+		Int64("pantryAccessId", id.PantryAccessId).
+		Logger()
+
+	dbFields := dbModel.PantryAccessFieldMasker.Convert(fields)
+
+	var pantryAccess dbModel.PantryAccess
+	tx := repo.db.WithContext(ctx).
+		Select(dbFields).
+		Where("pantry_access.pantry_id = ? AND pantry_access.pantry_access_id = ?", parent.PantryId.PantryId, id.PantryAccessId)
+
+	if fieldmask.ContainsAny(dbFields, dbModel.PantryAccessFields_RecipientUserId, dbModel.PantryAccessFields_RecipientCircleId) {
+		tx = tx.Joins(`LEFT JOIN daylear_user ON pantry_access.recipient_user_id = daylear_user.user_id`).
+			Joins(`LEFT JOIN circle ON pantry_access.recipient_circle_id = circle.circle_id`)
+	}
+
+	res := tx.First(&pantryAccess)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("pantry access row not found")
+			return cmodel.PantryAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to get pantry access row")
+		return cmodel.PantryAccess{}, ConvertGormError(res.Error)
+	}
+
+	return convert.PantryAccessToCoreModel(pantryAccess), nil
+}
+
+func (repo *Client) ListPantryAccesses(ctx context.Context, authAccount cmodel.AuthAccount, parent cmodel.PantryAccessParent, pageSize int32, pageOffset int64, filterStr string, fields []string) ([]cmodel.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", parent.PantryId.PantryId).
+		Str("filter", filterStr).
+		Int("pageSize", int(pageSize)).
+		Int64("pageOffset", pageOffset).
+		Logger()
+
+	if authAccount.AuthUserId == 0 && authAccount.CircleId == 0 {
+		log.Error().Msg("user id or circle id is required to list pantry access rows")
+		return nil, repository.ErrInvalidArgument{Msg: "user id or circle id is required"}
+	}
+
+	orders := []clause.OrderByColumn{{
+		Column: clause.Column{Name: "pantry_access.pantry_access_id"},
+		Desc:   true,
+	}}
+
+	dbFields := dbModel.PantryAccessFieldMasker.Convert(fields)
+
+	var pantryAccesses []dbModel.PantryAccess
+	// Start building the query
+	tx := repo.db.WithContext(ctx).
+		Select(dbFields).
+		Order(clause.OrderBy{Columns: orders}).
+		Limit(int(pageSize)).
+		Offset(int(pageOffset))
+
+	if fieldmask.ContainsAny(dbFields, dbModel.PantryAccessFields_RecipientUserId, dbModel.PantryAccessFields_RecipientCircleId) {
+		tx = tx.Joins(`LEFT JOIN daylear_user ON pantry_access.recipient_user_id = daylear_user.user_id`).
+			Joins(`LEFT JOIN circle ON pantry_access.recipient_circle_id = circle.circle_id`)
+	}
+
+	// Filter by pantry ID if provided
+	if parent.PantryId.PantryId != 0 {
+		tx = tx.Where("pantry_access.pantry_id = ?", parent.PantryId.PantryId)
+	}
+
+	if authAccount.CircleId != 0 {
+		tx = tx.Where(
+			"pantry_access.recipient_circle_id = ? OR pantry_access.pantry_id IN (SELECT pantry_id FROM pantry_access WHERE recipient_circle_id = ? AND permission_level >= ?)",
+			authAccount.CircleId, authAccount.CircleId, types.PermissionLevel_PERMISSION_LEVEL_WRITE,
+		)
+	} else if authAccount.AuthUserId != 0 {
+		tx = tx.Where(
+			"pantry_access.recipient_user_id = ? OR pantry_access.pantry_id IN (SELECT pantry_id FROM pantry_access WHERE recipient_user_id = ? AND permission_level >= ?)",
+			authAccount.AuthUserId, authAccount.AuthUserId, types.PermissionLevel_PERMISSION_LEVEL_WRITE,
+		)
+	}
+
+	conversion, err := dbModel.PantryAccessSQLConverter.Convert(filterStr)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid filter string when listing pantry access rows")
+		return nil, repository.ErrInvalidArgument{Msg: "invalid filter: " + err.Error()}
+	}
+
+	if conversion.WhereClause != "" {
+		tx = tx.Where(conversion.WhereClause, conversion.Params...)
+	}
+
+	res := tx.Limit(int(pageSize)).
+		Offset(int(pageOffset)).
+		Find(&pantryAccesses)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to list pantry access rows")
+		return nil, ConvertGormError(res.Error)
+	}
+
+	accesses := make([]cmodel.PantryAccess, len(pantryAccesses))
+	for i, access := range pantryAccesses {
+		accesses[i] = convert.PantryAccessToCoreModel(access)
+	}
+
+	return accesses, nil
+}
+
+func (repo *Client) UpdatePantryAccess(ctx context.Context, access cmodel.PantryAccess, fields []string) (cmodel.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryAccessId", access.PantryAccessId.PantryAccessId).
+		Strs("fields", fields).
+		Logger()
+
+	dbAccess := convert.PantryAccessFromCoreModel(access)
+
+	res := repo.db.WithContext(ctx).
+		Select(dbModel.PantryAccessFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(&clause.Returning{}).
+		Where("pantry_access_id = ?", access.PantryAccessId.PantryAccessId).
+		Updates(&dbAccess)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to update pantry access row")
+		return cmodel.PantryAccess{}, ConvertGormError(res.Error)
+	}
+
+	return convert.PantryAccessToCoreModel(dbAccess), nil
+}
+
+func (repo *Client) FindStandardUserPantryAccess(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.PantryId) (cmodel.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", id.PantryId).
+		Int64("authUserId", authAccount.AuthUserId).
+		Logger()
+
+	var pantryAccess dbModel.PantryAccess
+	res := repo.db.WithContext(ctx).
+		Where("pantry_id = ? AND recipient_user_id = ?", id.PantryId, authAccount.AuthUserId).
+		First(&pantryAccess)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("standard user pantry access not found")
+			return cmodel.PantryAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to find standard user pantry access")
+		return cmodel.PantryAccess{}, ConvertGormError(res.Error)
+	}
+	return convert.PantryAccessToCoreModel(pantryAccess), nil
+}
+
+func (repo *Client) FindDelegatedCirclePantryAccess(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.PantryId) (cmodel.PantryAccess, cmodel.CircleAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", id.PantryId).
+		Int64("authUserId", authAccount.AuthUserId).
+		Logger()
+
+	type Result struct {
+		dbModel.PantryAccess
+		dbModel.CircleAccess
+	}
+	var result Result
+	res := repo.db.WithContext(ctx).
+		Select("pantry_access.*, circle_access.*").
+		Table("pantry_access").
+		Joins("JOIN circle_access ON circle_access.circle_id = pantry_access.recipient_circle_id").
+		Where("pantry_access.pantry_id = ? AND circle_access.recipient_user_id = ? AND pantry_access.state = ?", id.PantryId, authAccount.AuthUserId, types.AccessState_ACCESS_STATE_ACCEPTED).
+		First(&result)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("delegated circle pantry access not found")
+			return cmodel.PantryAccess{}, cmodel.CircleAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to find delegated circle pantry access")
+		return cmodel.PantryAccess{}, cmodel.CircleAccess{}, ConvertGormError(res.Error)
+	}
+	return convert.PantryAccessToCoreModel(result.PantryAccess), convert.CircleAccessToCoreCircleAccess(result.CircleAccess), nil
+}
+
+func (repo *Client) FindDelegatedUserPantryAccess(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.PantryId) (cmodel.PantryAccess, cmodel.UserAccess, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", id.PantryId).
+		Int64("authUserId", authAccount.AuthUserId).
+		Logger()
+
+	type Result struct {
+		dbModel.PantryAccess
+		dbModel.UserAccess
+	}
+	var result Result
+
+	res := repo.db.WithContext(ctx).
+		Select("pantry_access.*, user_access.*").
+		Table("pantry_access").
+		Joins("JOIN user_access ON user_access.user_id = pantry_access.recipient_user_id").
+		Where("pantry_access.pantry_id = ? AND user_access.recipient_user_id = ? AND pantry_access.state = ?", id.PantryId, authAccount.AuthUserId, types.AccessState_ACCESS_STATE_ACCEPTED).
+		First(&result)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Warn().Err(res.Error).Msg("delegated user pantry access not found")
+			return cmodel.PantryAccess{}, cmodel.UserAccess{}, repository.ErrNotFound{}
+		}
+		log.Error().Err(res.Error).Msg("unable to find delegated user pantry access")
+		return cmodel.PantryAccess{}, cmodel.UserAccess{}, ConvertGormError(res.Error)
+	}
+	return convert.PantryAccessToCoreModel(result.PantryAccess), convert.UserAccessToCoreUserAccess(result.UserAccess), nil
+}
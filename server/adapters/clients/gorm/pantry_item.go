@@ -0,0 +1,212 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// CreatePantryItem creates a new pantry item
+func (repo *Client) CreatePantryItem(ctx context.Context, authAccount cmodel.AuthAccount, m cmodel.PantryItem) (cmodel.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", m.Parent.PantryId.PantryId).
+		Str("title", m.Title).
+		Logger()
+
+	gm := convert.PantryItemFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create pantry item row")
+		return cmodel.PantryItem{}, ConvertGormError(err)
+	}
+
+	return convert.PantryItemToCoreModel(gm), nil
+}
+
+// DeletePantryItem deletes a pantry item
+func (repo *Client) DeletePantryItem(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.PantryItemId) (cmodel.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryItemId", id.PantryItemId).
+		Logger()
+
+	gm := gmodel.PantryItem{PantryItemId: id.PantryItemId}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Delete(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete pantry item row")
+		return cmodel.PantryItem{}, ConvertGormError(err)
+	}
+
+	return convert.PantryItemToCoreModel(gm), nil
+}
+
+// GetPantryItem retrieves a pantry item
+func (repo *Client) GetPantryItem(ctx context.Context, authAccount cmodel.AuthAccount, id cmodel.PantryItemId, fields []string) (cmodel.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryItemId", id.PantryItemId).
+		Strs("fields", fields).
+		Logger()
+
+	var gm gmodel.PantryItem
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.PantryItemFieldMasker.Convert(fields)).
+		Where("pantry_item.pantry_item_id = ?", id.PantryItemId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry item row")
+		return cmodel.PantryItem{}, ConvertGormError(err)
+	}
+
+	return convert.PantryItemToCoreModel(gm), nil
+}
+
+// ListPantryItems lists pantry items with pagination and filtering
+func (repo *Client) ListPantryItems(ctx context.Context, authAccount cmodel.AuthAccount, parent cmodel.PantryItemParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]cmodel.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", parent.PantryId.PantryId).
+		Int32("pageSize", pageSize).
+		Int32("pageOffset", pageOffset).
+		Str("filter", filter).
+		Strs("fields", fields).
+		Logger()
+
+	var gms []gmodel.PantryItem
+
+	orders := []clause.OrderByColumn{{
+		Column: clause.Column{Name: "pantry_item.pantry_item_id"},
+		Desc:   false,
+	}}
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.PantryItemFieldMasker.Convert(fields)).
+		Where("pantry_item.pantry_id = ?", parent.PantryId.PantryId).
+		Order(clause.OrderBy{Columns: orders})
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if pageOffset > 0 {
+		tx = tx.Offset(int(pageOffset))
+	}
+
+	conversion, err := gmodel.PantryItemSQLConverter.Convert(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid filter string when listing pantry item rows")
+		return nil, repository.ErrInvalidArgument{Msg: "invalid filter"}
+	}
+
+	if conversion.WhereClause != "" {
+		tx = tx.Where(conversion.WhereClause, conversion.Params...)
+	}
+
+	err = tx.Find(&gms).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list pantry item rows")
+		return nil, ConvertGormError(err)
+	}
+
+	ms := make([]cmodel.PantryItem, len(gms))
+	for i, gm := range gms {
+		ms[i] = convert.PantryItemToCoreModel(gm)
+	}
+
+	return ms, nil
+}
+
+// ListExpiringPantryItems lists the pantry items that expire at or before the
+// given time, soonest first. Items without an expire time are never included.
+func (repo *Client) ListExpiringPantryItems(ctx context.Context, authAccount cmodel.AuthAccount, parent cmodel.PantryItemParent, before time.Time, pageSize int32, pageOffset int32, fields []string) ([]cmodel.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", parent.PantryId.PantryId).
+		Time("before", before).
+		Int32("pageSize", pageSize).
+		Int32("pageOffset", pageOffset).
+		Strs("fields", fields).
+		Logger()
+
+	var gms []gmodel.PantryItem
+
+	orders := []clause.OrderByColumn{{
+		Column: clause.Column{Name: "pantry_item.expire_time"},
+	}, {
+		Column: clause.Column{Name: "pantry_item.pantry_item_id"},
+	}}
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.PantryItemFieldMasker.Convert(fields)).
+		Where("pantry_item.pantry_id = ?", parent.PantryId.PantryId).
+		Where("pantry_item.expire_time IS NOT NULL AND pantry_item.expire_time <= ?", before).
+		Order(clause.OrderBy{Columns: orders})
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if pageOffset > 0 {
+		tx = tx.Offset(int(pageOffset))
+	}
+
+	err := tx.Find(&gms).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list expiring pantry item rows")
+		return nil, ConvertGormError(err)
+	}
+
+	ms := make([]cmodel.PantryItem, len(gms))
+	for i, gm := range gms {
+		ms[i] = convert.PantryItemToCoreModel(gm)
+	}
+
+	return ms, nil
+}
+
+// UpdatePantryItem updates an existing pantry item
+func (repo *Client) UpdatePantryItem(ctx context.Context, authAccount cmodel.AuthAccount, m cmodel.PantryItem, fields []string) (cmodel.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryItemId", m.Id.PantryItemId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.PantryItemFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.PantryItemFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("pantry_item_id = ?", m.Id.PantryItemId).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update pantry item row")
+		return cmodel.PantryItem{}, ConvertGormError(err)
+	}
+
+	return convert.PantryItemToCoreModel(gm), nil
+}
+
+// BulkDeletePantryItems deletes all pantry items for a given parent pantry
+func (repo *Client) BulkDeletePantryItems(ctx context.Context, parent cmodel.PantryItemParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("pantryId", parent.PantryId.PantryId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Where("pantry_id = ?", parent.PantryId.PantryId).
+		Delete(&gmodel.PantryItem{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete pantry item rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
@@ -15,9 +15,10 @@ import (
 )
 
 // ListRecipes lists recipes.
-func (repo *Client) ListRecipes(ctx context.Context, authAccount cmodel.AuthAccount, pageSize int32, offset int64, filter string, fields []string) ([]cmodel.Recipe, error) {
+func (repo *Client) ListRecipes(ctx context.Context, authAccount cmodel.AuthAccount, pageSize int32, offset int64, filter string, orderBy string, fields []string) ([]cmodel.Recipe, error) {
 	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
 		Str("filter", filter).
+		Str("orderBy", orderBy).
 		Strs("fields", fields).
 		Int("pageSize", int(pageSize)).
 		Int64("offset", offset).
@@ -29,10 +30,24 @@ func (repo *Client) ListRecipes(ctx context.Context, authAccount cmodel.AuthAcco
 
 	dbRecipes := []gmodel.Recipe{}
 
-	orders := []clause.OrderByColumn{{
+	orderByColumns, err := gmodel.RecipeSQLConverter.ConvertOrderBy(orderBy)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid order by string when listing recipe rows")
+		return nil, repository.ErrInvalidArgument{Msg: "invalid order by"}
+	}
+
+	// the recipe id keeps the order stable between pages
+	orders := make([]clause.OrderByColumn, 0, len(orderByColumns)+1)
+	for _, column := range orderByColumns {
+		orders = append(orders, clause.OrderByColumn{
+			Column: clause.Column{Name: column.Column, Raw: true},
+			Desc:   column.Desc,
+		})
+	}
+	orders = append(orders, clause.OrderByColumn{
 		Column: clause.Column{Name: "recipe.recipe_id"},
 		Desc:   true,
-	}}
+	})
 
 	tx := repo.db.WithContext(ctx).
 		Select(gmodel.RecipeFieldMasker.Convert(fields)).
@@ -65,6 +80,7 @@ func (repo *Client) ListRecipes(ctx context.Context, authAccount cmodel.AuthAcco
 			Joins("LEFT JOIN recipe_favorite ON recipe.recipe_id = recipe_favorite.recipe_id AND recipe_favorite.user_id = ?", authAccount.AuthUserId).
 			Where("(recipe.visibility_level = ? OR recipe_access.recipient_user_id = ?)", types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, authAccount.AuthUserId)
 	}
+	tx = tx.Joins(gmodel.RecipeCookStatsJoin, authAccount.AuthUserId)
 
 	conversion, err := gmodel.RecipeSQLConverter.Convert(filter)
 	if err != nil {
@@ -167,6 +183,7 @@ func (repo *Client) GetRecipe(ctx context.Context, authAccount cmodel.AuthAccoun
 			),
 		)).
 		Joins("LEFT JOIN recipe_favorite ON recipe.recipe_id = recipe_favorite.recipe_id AND recipe_favorite.user_id = ?", authAccount.AuthUserId).
+		Joins(gmodel.RecipeCookStatsJoin, authAccount.AuthUserId).
 		Where("recipe.recipe_id = ?", id.RecipeId)
 
 	err := tx.First(&gm).Error
@@ -288,3 +305,58 @@ func (repo *Client) BulkDeleteRecipeFavorites(ctx context.Context, id cmodel.Rec
 	log.Info().Msg("recipe favorites deleted successfully")
 	return nil
 }
+
+// MoveRecipeFavorites moves the favorites of one recipe to another. Favorites
+// of users and circles that already favorited the other recipe are left in
+// place.
+func (repo *Client) MoveRecipeFavorites(ctx context.Context, from cmodel.RecipeId, to cmodel.RecipeId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("fromRecipeId", from.RecipeId).
+		Int64("toRecipeId", to.RecipeId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.RecipeFavorite{}).
+		Where("recipe_id = ?", from.RecipeId).
+		Where("NOT EXISTS (SELECT 1 FROM recipe_favorite AS existing WHERE existing.recipe_id = ? AND existing.user_id = recipe_favorite.user_id AND existing.circle_id = recipe_favorite.circle_id)", to.RecipeId).
+		Update("recipe_id", to.RecipeId).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to move recipe favorites")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// MoveRecipeAccesses moves the accesses of one recipe to another. When a
+// recipient already has access to the other recipe, its access is raised to
+// the accepted permission level of the moved access instead.
+func (repo *Client) MoveRecipeAccesses(ctx context.Context, from cmodel.RecipeId, to cmodel.RecipeId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("fromRecipeId", from.RecipeId).
+		Int64("toRecipeId", to.RecipeId).
+		Logger()
+
+	sameRecipient := "((kept.recipient_user_id <> 0 AND kept.recipient_user_id = moved.recipient_user_id) OR (kept.recipient_circle_id <> 0 AND kept.recipient_circle_id = moved.recipient_circle_id))"
+
+	err := repo.db.WithContext(ctx).Exec(`UPDATE recipe_access AS kept
+		SET permission_level = GREATEST(kept.permission_level, moved.permission_level), state = moved.state
+		FROM recipe_access AS moved
+		WHERE kept.recipe_id = ? AND moved.recipe_id = ? AND moved.state = ? AND `+sameRecipient,
+		to.RecipeId, from.RecipeId, types.AccessState_ACCESS_STATE_ACCEPTED).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to raise kept recipe accesses")
+		return ConvertGormError(err)
+	}
+
+	err = repo.db.WithContext(ctx).Exec(`UPDATE recipe_access AS moved
+		SET recipe_id = ?
+		WHERE moved.recipe_id = ? AND NOT EXISTS (SELECT 1 FROM recipe_access AS kept WHERE kept.recipe_id = ? AND `+sameRecipient+`)`,
+		to.RecipeId, from.RecipeId, to.RecipeId).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to move recipe accesses")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
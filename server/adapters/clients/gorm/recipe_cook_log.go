@@ -0,0 +1,180 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRecipeCookLog creates a new recipe cook log.
+func (repo *Client) CreateRecipeCookLog(ctx context.Context, m cmodel.RecipeCookLog) (cmodel.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("cookUserId", m.CookId.UserId).
+		Logger()
+
+	gm := convert.RecipeCookLogFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe cook log row")
+		return cmodel.RecipeCookLog{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeCookLogToCoreModel(gm), nil
+}
+
+// GetRecipeCookLog gets a recipe cook log.
+func (repo *Client) GetRecipeCookLog(ctx context.Context, parent cmodel.RecipeCookLogParent, id cmodel.RecipeCookLogId, fields []string) (cmodel.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeCookLogId", id.RecipeCookLogId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeCookLog{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeCookLogFieldMasker.Convert(fields)).
+		Where("recipe_cook_log.recipe_id = ? AND recipe_cook_log.recipe_cook_log_id = ?", parent.RecipeId.RecipeId, id.RecipeCookLogId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe cook log row")
+		return cmodel.RecipeCookLog{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeCookLogToCoreModel(gm), nil
+}
+
+// ListRecipeCookLogs lists the cook logs of a recipe, most recently cooked
+// first.
+func (repo *Client) ListRecipeCookLogs(ctx context.Context, parent cmodel.RecipeCookLogParent, pageSize int32, offset int64, fields []string) ([]cmodel.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeCookLogFieldMasker.Convert(fields)).
+		Where("recipe_cook_log.recipe_id = ?", parent.RecipeId.RecipeId).
+		Order("recipe_cook_log.cook_time DESC, recipe_cook_log.recipe_cook_log_id DESC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbCookLogs := []gmodel.RecipeCookLog{}
+	err := tx.Find(&dbCookLogs).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe cook log rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeCookLog, len(dbCookLogs))
+	for i, m := range dbCookLogs {
+		res[i] = convert.RecipeCookLogToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// UpdateRecipeCookLog updates a recipe cook log.
+func (repo *Client) UpdateRecipeCookLog(ctx context.Context, m cmodel.RecipeCookLog, fields []string) (cmodel.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("recipeCookLogId", m.Id.RecipeCookLogId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.RecipeCookLogFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeCookLogFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("recipe_cook_log.recipe_id = ? AND recipe_cook_log.recipe_cook_log_id = ?", m.Parent.RecipeId.RecipeId, m.Id.RecipeCookLogId).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update recipe cook log row")
+		return cmodel.RecipeCookLog{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeCookLogToCoreModel(gm), nil
+}
+
+// DeleteRecipeCookLog deletes a recipe cook log.
+func (repo *Client) DeleteRecipeCookLog(ctx context.Context, parent cmodel.RecipeCookLogParent, id cmodel.RecipeCookLogId) (cmodel.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeCookLogId", id.RecipeCookLogId).
+		Logger()
+
+	gm := gmodel.RecipeCookLog{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_cook_log.recipe_id = ? AND recipe_cook_log.recipe_cook_log_id = ?", parent.RecipeId.RecipeId, id.RecipeCookLogId).
+		Delete(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete recipe cook log row")
+		return cmodel.RecipeCookLog{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeCookLogToCoreModel(gm), nil
+}
+
+// BulkDeleteRecipeCookLogs deletes all cook logs of a recipe and returns
+// them.
+func (repo *Client) BulkDeleteRecipeCookLogs(ctx context.Context, parent cmodel.RecipeCookLogParent) ([]cmodel.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Logger()
+
+	dbCookLogs := []gmodel.RecipeCookLog{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_id = ?", parent.RecipeId.RecipeId).
+		Delete(&dbCookLogs).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete recipe cook log rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeCookLog, len(dbCookLogs))
+	for i, m := range dbCookLogs {
+		res[i] = convert.RecipeCookLogToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// MoveRecipeCookLogs moves the cook logs of one recipe to another.
+func (repo *Client) MoveRecipeCookLogs(ctx context.Context, from cmodel.RecipeId, to cmodel.RecipeId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("fromRecipeId", from.RecipeId).
+		Int64("toRecipeId", to.RecipeId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.RecipeCookLog{}).
+		Where("recipe_id = ?", from.RecipeId).
+		Update("recipe_id", to.RecipeId).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to move recipe cook logs")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRecipeImage creates a new recipe image.
+func (repo *Client) CreateRecipeImage(ctx context.Context, m cmodel.RecipeImage) (cmodel.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Logger()
+
+	gm := convert.RecipeImageFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe image row")
+		return cmodel.RecipeImage{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeImageToCoreModel(gm), nil
+}
+
+// GetRecipeImage gets a recipe image.
+func (repo *Client) GetRecipeImage(ctx context.Context, parent cmodel.RecipeImageParent, id cmodel.RecipeImageId, fields []string) (cmodel.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeImageId", id.RecipeImageId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeImage{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImageFieldMasker.Convert(fields)).
+		Where("recipe_image.recipe_id = ? AND recipe_image.recipe_image_id = ?", parent.RecipeId.RecipeId, id.RecipeImageId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe image row")
+		return cmodel.RecipeImage{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeImageToCoreModel(gm), nil
+}
+
+// ListRecipeImages lists the images of a recipe in their display order.
+func (repo *Client) ListRecipeImages(ctx context.Context, parent cmodel.RecipeImageParent, pageSize int32, offset int64, fields []string) ([]cmodel.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImageFieldMasker.Convert(fields)).
+		Where("recipe_image.recipe_id = ?", parent.RecipeId.RecipeId).
+		Order("recipe_image.position ASC, recipe_image.recipe_image_id ASC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbImages := []gmodel.RecipeImage{}
+	err := tx.Find(&dbImages).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe image rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeImage, len(dbImages))
+	for i, m := range dbImages {
+		res[i] = convert.RecipeImageToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// UpdateRecipeImage updates a recipe image.
+func (repo *Client) UpdateRecipeImage(ctx context.Context, m cmodel.RecipeImage, fields []string) (cmodel.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("recipeImageId", m.Id.RecipeImageId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.RecipeImageFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImageFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("recipe_image.recipe_id = ? AND recipe_image.recipe_image_id = ?", m.Parent.RecipeId.RecipeId, m.Id.RecipeImageId).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update recipe image row")
+		return cmodel.RecipeImage{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeImageToCoreModel(gm), nil
+}
+
+// DeleteRecipeImage deletes a recipe image.
+func (repo *Client) DeleteRecipeImage(ctx context.Context, parent cmodel.RecipeImageParent, id cmodel.RecipeImageId) (cmodel.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeImageId", id.RecipeImageId).
+		Logger()
+
+	gm := gmodel.RecipeImage{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_image.recipe_id = ? AND recipe_image.recipe_image_id = ?", parent.RecipeId.RecipeId, id.RecipeImageId).
+		Delete(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete recipe image row")
+		return cmodel.RecipeImage{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeImageToCoreModel(gm), nil
+}
+
+// BulkDeleteRecipeImages deletes all images of a recipe and returns them.
+func (repo *Client) BulkDeleteRecipeImages(ctx context.Context, parent cmodel.RecipeImageParent) ([]cmodel.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Logger()
+
+	dbImages := []gmodel.RecipeImage{}
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_id = ?", parent.RecipeId.RecipeId).
+		Delete(&dbImages).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete recipe image rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeImage, len(dbImages))
+	for i, m := range dbImages {
+		res[i] = convert.RecipeImageToCoreModel(m)
+	}
+
+	return res, nil
+}
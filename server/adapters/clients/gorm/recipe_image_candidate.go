@@ -0,0 +1,264 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRecipeImageCandidate creates a new recipe image candidate.
+func (repo *Client) CreateRecipeImageCandidate(ctx context.Context, m cmodel.RecipeImageCandidate) (cmodel.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("creatorUserId", m.CreatorId.UserId).
+		Logger()
+
+	gm := convert.RecipeImageCandidateFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe image candidate row")
+		return cmodel.RecipeImageCandidate{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeImageCandidateToCoreModel(gm), nil
+}
+
+// GetRecipeImageCandidate gets a recipe image candidate.
+func (repo *Client) GetRecipeImageCandidate(ctx context.Context, parent cmodel.RecipeImageCandidateParent, id cmodel.RecipeImageCandidateId, fields []string) (cmodel.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeImageCandidateId", id.RecipeImageCandidateId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeImageCandidate{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImageCandidateFieldMasker.Convert(fields)).
+		Where("recipe_image_candidate.recipe_id = ? AND recipe_image_candidate.recipe_image_candidate_id = ?", parent.RecipeId.RecipeId, id.RecipeImageCandidateId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe image candidate row")
+		return cmodel.RecipeImageCandidate{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeImageCandidateToCoreModel(gm), nil
+}
+
+// ListRecipeImageCandidates lists the candidates of a recipe, newest first.
+func (repo *Client) ListRecipeImageCandidates(ctx context.Context, parent cmodel.RecipeImageCandidateParent, pageSize int32, offset int64, fields []string) ([]cmodel.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImageCandidateFieldMasker.Convert(fields)).
+		Where("recipe_image_candidate.recipe_id = ?", parent.RecipeId.RecipeId).
+		Order("recipe_image_candidate.create_time DESC, recipe_image_candidate.recipe_image_candidate_id DESC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbCandidates := []gmodel.RecipeImageCandidate{}
+	err := tx.Find(&dbCandidates).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe image candidate rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeImageCandidate, len(dbCandidates))
+	for i, m := range dbCandidates {
+		res[i] = convert.RecipeImageCandidateToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// DeleteRecipeImageCandidate deletes a recipe image candidate.
+func (repo *Client) DeleteRecipeImageCandidate(ctx context.Context, parent cmodel.RecipeImageCandidateParent, id cmodel.RecipeImageCandidateId) (cmodel.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeImageCandidateId", id.RecipeImageCandidateId).
+		Logger()
+
+	gm := gmodel.RecipeImageCandidate{}
+
+	res := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_image_candidate.recipe_id = ? AND recipe_image_candidate.recipe_image_candidate_id = ?", parent.RecipeId.RecipeId, id.RecipeImageCandidateId).
+		Delete(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to delete recipe image candidate row")
+		return cmodel.RecipeImageCandidate{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("recipe image candidate not found")
+		return cmodel.RecipeImageCandidate{}, repository.ErrNotFound{Msg: "recipe image candidate not found"}
+	}
+
+	return convert.RecipeImageCandidateToCoreModel(gm), nil
+}
+
+// BulkDeleteRecipeImageCandidates deletes the candidates of a recipe.
+func (repo *Client) BulkDeleteRecipeImageCandidates(ctx context.Context, parent cmodel.RecipeImageCandidateParent) ([]cmodel.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Logger()
+
+	dbCandidates := []gmodel.RecipeImageCandidate{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_image_candidate.recipe_id = ?", parent.RecipeId.RecipeId).
+		Delete(&dbCandidates).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete recipe image candidate rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeImageCandidate, len(dbCandidates))
+	for i, m := range dbCandidates {
+		res[i] = convert.RecipeImageCandidateToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// DeleteExpiredRecipeImageCandidates deletes the candidates created before the
+// given time.
+func (repo *Client) DeleteExpiredRecipeImageCandidates(ctx context.Context, before time.Time) ([]cmodel.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Time("before", before).
+		Logger()
+
+	dbCandidates := []gmodel.RecipeImageCandidate{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_image_candidate.create_time < ?", before).
+		Delete(&dbCandidates).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete expired recipe image candidate rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeImageCandidate, len(dbCandidates))
+	for i, m := range dbCandidates {
+		res[i] = convert.RecipeImageCandidateToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// LockRecipeImageGenerations locks the image generations of a user until the
+// transaction ends, so only one caller at a time checks and charges the
+// quota.
+func (repo *Client) LockRecipeImageGenerations(ctx context.Context, userId cmodel.UserId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", userId.UserId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", fmt.Sprintf("%s:%d", gmodel.RecipeImageGenerationTable, userId.UserId)).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to lock recipe image generations")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// CreateRecipeImageGeneration records images generated for a user.
+func (repo *Client) CreateRecipeImageGeneration(ctx context.Context, m cmodel.RecipeImageGeneration) (cmodel.RecipeImageGeneration, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", m.UserId.UserId).
+		Int64("recipeId", m.RecipeId.RecipeId).
+		Int32("count", m.Count).
+		Logger()
+
+	gm := convert.RecipeImageGenerationFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe image generation row")
+		return cmodel.RecipeImageGeneration{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeImageGenerationToCoreModel(gm), nil
+}
+
+// DeleteRecipeImageGeneration deletes a generation, refunding its images.
+func (repo *Client) DeleteRecipeImageGeneration(ctx context.Context, id cmodel.RecipeImageGenerationId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeImageGenerationId", id.RecipeImageGenerationId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Where("recipe_image_generation_id = ?", id.RecipeImageGenerationId).
+		Delete(&gmodel.RecipeImageGeneration{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete recipe image generation row")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// CountRecipeImageGenerations counts the images generated for a user since
+// the given time.
+func (repo *Client) CountRecipeImageGenerations(ctx context.Context, userId cmodel.UserId, since time.Time) (int64, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", userId.UserId).
+		Time("since", since).
+		Logger()
+
+	var count int64
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.RecipeImageGeneration{}).
+		Select("COALESCE(SUM(count), 0)").
+		Where("user_id = ? AND create_time >= ?", userId.UserId, since).
+		Scan(&count).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to count recipe image generations")
+		return 0, ConvertGormError(err)
+	}
+
+	return count, nil
+}
+
+// DeleteRecipeImageGenerations deletes the generations recorded before the
+// given time.
+func (repo *Client) DeleteRecipeImageGenerations(ctx context.Context, before time.Time) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Time("before", before).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Where("create_time < ?", before).
+		Delete(&gmodel.RecipeImageGeneration{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete recipe image generation rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
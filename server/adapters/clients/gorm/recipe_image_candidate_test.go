@@ -0,0 +1,39 @@
+package gorm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+func TestLockRecipeImageGenerations_PerUser(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	err := repo.LockRecipeImageGenerations(context.Background(), cmodel.UserId{UserId: 7})
+	if err != nil {
+		t.Fatalf("LockRecipeImageGenerations() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	want := "SELECT pg_advisory_xact_lock(hashtextextended('recipe_image_generation:7', 0))"
+	if !strings.Contains(sql, want) {
+		t.Errorf("LockRecipeImageGenerations() sql = %s, want it to contain %s", sql, want)
+	}
+}
+
+func TestDeleteRecipeImageGeneration_OnlyOne(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	err := repo.DeleteRecipeImageGeneration(context.Background(), cmodel.RecipeImageGenerationId{RecipeImageGenerationId: 4})
+	if err != nil {
+		t.Fatalf("DeleteRecipeImageGeneration() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	want := "WHERE recipe_image_generation_id = 4"
+	if !strings.Contains(sql, want) {
+		t.Errorf("DeleteRecipeImageGeneration() sql = %s, want it to contain %s", sql, want)
+	}
+}
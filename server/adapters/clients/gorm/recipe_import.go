@@ -0,0 +1,144 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRecipeImport creates a new recipe import.
+func (repo *Client) CreateRecipeImport(ctx context.Context, m cmodel.RecipeImport) (cmodel.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", m.Parent.UserId).
+		Logger()
+
+	gm, err := convert.RecipeImportFromCoreModel(m)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe import when creating recipe import row")
+		return cmodel.RecipeImport{}, repository.ErrInvalidArgument{Msg: fmt.Sprintf("invalid recipe import: %v", err)}
+	}
+
+	err = repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe import row")
+		return cmodel.RecipeImport{}, ConvertGormError(err)
+	}
+
+	m, err = convert.RecipeImportToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe import row when creating recipe import")
+		return cmodel.RecipeImport{}, repository.ErrInternal{Msg: "invalid recipe import row when creating recipe import"}
+	}
+
+	return m, nil
+}
+
+// GetRecipeImport gets a recipe import.
+func (repo *Client) GetRecipeImport(ctx context.Context, parent cmodel.RecipeImportParent, id cmodel.RecipeImportId, fields []string) (cmodel.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", parent.UserId).
+		Int64("recipeImportId", id.RecipeImportId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeImport{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImportFieldMasker.Convert(fields)).
+		Where("recipe_import.user_id = ? AND recipe_import.recipe_import_id = ?", parent.UserId, id.RecipeImportId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe import row")
+		return cmodel.RecipeImport{}, ConvertGormError(err)
+	}
+
+	m, err := convert.RecipeImportToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe import row when getting recipe import")
+		return cmodel.RecipeImport{}, repository.ErrInternal{Msg: "invalid recipe import row when getting recipe import"}
+	}
+
+	return m, nil
+}
+
+// ListRecipeImports lists the recipe imports of a user, newest first.
+func (repo *Client) ListRecipeImports(ctx context.Context, parent cmodel.RecipeImportParent, pageSize int32, offset int64, fields []string) ([]cmodel.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", parent.UserId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImportFieldMasker.Convert(fields)).
+		Where("recipe_import.user_id = ?", parent.UserId).
+		Order("recipe_import.recipe_import_id DESC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbImports := []gmodel.RecipeImport{}
+	err := tx.Find(&dbImports).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe import rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeImport, len(dbImports))
+	for i, m := range dbImports {
+		res[i], err = convert.RecipeImportToCoreModel(m)
+		if err != nil {
+			log.Error().Err(err).Msg("invalid recipe import row when listing recipe imports")
+			return nil, repository.ErrInternal{Msg: "invalid recipe import row when listing recipe imports"}
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateRecipeImport updates a recipe import.
+func (repo *Client) UpdateRecipeImport(ctx context.Context, m cmodel.RecipeImport, fields []string) (cmodel.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("userId", m.Parent.UserId).
+		Int64("recipeImportId", m.Id.RecipeImportId).
+		Strs("fields", fields).
+		Logger()
+
+	gm, err := convert.RecipeImportFromCoreModel(m)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe import when updating recipe import row")
+		return cmodel.RecipeImport{}, repository.ErrInvalidArgument{Msg: fmt.Sprintf("invalid recipe import: %v", err)}
+	}
+
+	err = repo.db.WithContext(ctx).
+		Select(gmodel.RecipeImportFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("recipe_import.user_id = ? AND recipe_import.recipe_import_id = ?", m.Parent.UserId, m.Id.RecipeImportId).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update recipe import row")
+		return cmodel.RecipeImport{}, ConvertGormError(err)
+	}
+
+	m, err = convert.RecipeImportToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe import row when updating recipe import")
+		return cmodel.RecipeImport{}, repository.ErrInternal{Msg: "invalid recipe import row when updating recipe import"}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,247 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRecipeNote creates a new recipe note.
+func (repo *Client) CreateRecipeNote(ctx context.Context, m cmodel.RecipeNote) (cmodel.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("authorUserId", m.AuthorId.UserId).
+		Logger()
+
+	gm := convert.RecipeNoteFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe note row")
+		return cmodel.RecipeNote{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeNoteToCoreModel(gm), nil
+}
+
+// GetRecipeNote gets a recipe note of an author.
+func (repo *Client) GetRecipeNote(ctx context.Context, authorId cmodel.UserId, parent cmodel.RecipeNoteParent, id cmodel.RecipeNoteId, fields []string) (cmodel.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("authorUserId", authorId.UserId).
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeNoteId", id.RecipeNoteId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeNote{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeNoteFieldMasker.Convert(fields)).
+		Where("recipe_note.author_user_id = ? AND recipe_note.recipe_id = ? AND recipe_note.recipe_note_id = ?", authorId.UserId, parent.RecipeId.RecipeId, id.RecipeNoteId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe note row")
+		return cmodel.RecipeNote{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeNoteToCoreModel(gm), nil
+}
+
+// ListRecipeNotes lists the notes of an author on a recipe, oldest first.
+func (repo *Client) ListRecipeNotes(ctx context.Context, authorId cmodel.UserId, parent cmodel.RecipeNoteParent, pageSize int32, offset int64, fields []string) ([]cmodel.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("authorUserId", authorId.UserId).
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeNoteFieldMasker.Convert(fields)).
+		Where("recipe_note.author_user_id = ? AND recipe_note.recipe_id = ?", authorId.UserId, parent.RecipeId.RecipeId).
+		Order("recipe_note.create_time, recipe_note.recipe_note_id")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbNotes := []gmodel.RecipeNote{}
+	err := tx.Find(&dbNotes).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe note rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeNote, len(dbNotes))
+	for i, m := range dbNotes {
+		res[i] = convert.RecipeNoteToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// UpdateRecipeNote updates a recipe note of its author.
+func (repo *Client) UpdateRecipeNote(ctx context.Context, m cmodel.RecipeNote, fields []string) (cmodel.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("authorUserId", m.AuthorId.UserId).
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("recipeNoteId", m.Id.RecipeNoteId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.RecipeNoteFromCoreModel(m)
+
+	res := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeNoteFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("recipe_note.author_user_id = ? AND recipe_note.recipe_id = ? AND recipe_note.recipe_note_id = ?", m.AuthorId.UserId, m.Parent.RecipeId.RecipeId, m.Id.RecipeNoteId).
+		Updates(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to update recipe note row")
+		return cmodel.RecipeNote{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("recipe note not found")
+		return cmodel.RecipeNote{}, repository.ErrNotFound{Msg: "recipe note not found"}
+	}
+
+	return convert.RecipeNoteToCoreModel(gm), nil
+}
+
+// DeleteRecipeNote deletes a recipe note of an author.
+func (repo *Client) DeleteRecipeNote(ctx context.Context, authorId cmodel.UserId, parent cmodel.RecipeNoteParent, id cmodel.RecipeNoteId) (cmodel.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("authorUserId", authorId.UserId).
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeNoteId", id.RecipeNoteId).
+		Logger()
+
+	gm := gmodel.RecipeNote{}
+
+	res := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_note.author_user_id = ? AND recipe_note.recipe_id = ? AND recipe_note.recipe_note_id = ?", authorId.UserId, parent.RecipeId.RecipeId, id.RecipeNoteId).
+		Delete(&gm)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("unable to delete recipe note row")
+		return cmodel.RecipeNote{}, ConvertGormError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		log.Warn().Msg("recipe note not found")
+		return cmodel.RecipeNote{}, repository.ErrNotFound{Msg: "recipe note not found"}
+	}
+
+	return convert.RecipeNoteToCoreModel(gm), nil
+}
+
+// BulkDeleteRecipeNotes deletes the notes on a recipe. When authorId is set
+// only the notes of that author are deleted.
+func (repo *Client) BulkDeleteRecipeNotes(ctx context.Context, parent cmodel.RecipeNoteParent, authorId cmodel.UserId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("authorUserId", authorId.UserId).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Where("recipe_id = ?", parent.RecipeId.RecipeId)
+	if authorId.UserId != 0 {
+		tx = tx.Where("author_user_id = ?", authorId.UserId)
+	}
+
+	err := tx.Delete(&gmodel.RecipeNote{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete recipe note rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
+
+// ListRecipeNoteAuthors lists the users who have notes on a recipe.
+func (repo *Client) ListRecipeNoteAuthors(ctx context.Context, parent cmodel.RecipeNoteParent) ([]cmodel.UserId, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Logger()
+
+	authorIds := []int64{}
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.RecipeNote{}).
+		Distinct("author_user_id").
+		Where("recipe_id = ?", parent.RecipeId.RecipeId).
+		Pluck("author_user_id", &authorIds).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe note authors")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.UserId, len(authorIds))
+	for i, authorId := range authorIds {
+		res[i] = cmodel.UserId{UserId: authorId}
+	}
+
+	return res, nil
+}
+
+// ListRecipeNoteRecipes lists the recipes an author has notes on.
+func (repo *Client) ListRecipeNoteRecipes(ctx context.Context, authorId cmodel.UserId) ([]cmodel.RecipeId, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("authorUserId", authorId.UserId).
+		Logger()
+
+	recipeIds := []int64{}
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.RecipeNote{}).
+		Distinct("recipe_id").
+		Where("author_user_id = ?", authorId.UserId).
+		Pluck("recipe_id", &recipeIds).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe note recipes")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeId, len(recipeIds))
+	for i, recipeId := range recipeIds {
+		res[i] = cmodel.RecipeId{RecipeId: recipeId}
+	}
+
+	return res, nil
+}
+
+// MoveRecipeNotes moves the notes on one recipe to another. The notes are
+// detached from their ingredients and steps since those belong to the old
+// recipe.
+func (repo *Client) MoveRecipeNotes(ctx context.Context, from cmodel.RecipeId, to cmodel.RecipeId) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("fromRecipeId", from.RecipeId).
+		Int64("toRecipeId", to.RecipeId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Model(&gmodel.RecipeNote{}).
+		Where("recipe_id = ?", from.RecipeId).
+		Updates(map[string]any{
+			gmodel.RecipeNoteFields_RecipeId:        to.RecipeId,
+			gmodel.RecipeNoteFields_GroupIndex:      nil,
+			gmodel.RecipeNoteFields_IngredientIndex: nil,
+			gmodel.RecipeNoteFields_DirectionIndex:  nil,
+			gmodel.RecipeNoteFields_StepIndex:       nil,
+		}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to move recipe notes")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
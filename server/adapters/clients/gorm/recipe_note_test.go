@@ -0,0 +1,46 @@
+package gorm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+)
+
+func TestMoveRecipeNotes_Detaches(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	err := repo.MoveRecipeNotes(context.Background(), cmodel.RecipeId{RecipeId: 2}, cmodel.RecipeId{RecipeId: 1})
+	if err != nil {
+		t.Fatalf("MoveRecipeNotes() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	for _, want := range []string{
+		`"recipe_id"=1`,
+		`"group_index"=NULL`,
+		`"ingredient_index"=NULL`,
+		`"direction_index"=NULL`,
+		`"step_index"=NULL`,
+		"WHERE recipe_id = 2",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("MoveRecipeNotes() sql = %s, want it to contain %s", sql, want)
+		}
+	}
+}
+
+func TestListRecipeNotes_OnlyAuthor(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	_, err := repo.ListRecipeNotes(context.Background(), cmodel.UserId{UserId: 5}, cmodel.RecipeNoteParent{RecipeId: cmodel.RecipeId{RecipeId: 1}}, 10, 0, nil)
+	if err != nil {
+		t.Fatalf("ListRecipeNotes() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	if !strings.Contains(sql, "recipe_note.author_user_id = 5 AND recipe_note.recipe_id = 1") {
+		t.Errorf("ListRecipeNotes() sql = %s, want the notes of the author only", sql)
+	}
+}
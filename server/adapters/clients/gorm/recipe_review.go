@@ -0,0 +1,151 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRecipeReview creates a new recipe review.
+func (repo *Client) CreateRecipeReview(ctx context.Context, m cmodel.RecipeReview) (cmodel.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("authorUserId", m.AuthorId.UserId).
+		Logger()
+
+	gm := convert.RecipeReviewFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe review row")
+		return cmodel.RecipeReview{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeReviewToCoreModel(gm), nil
+}
+
+// GetRecipeReview gets a recipe review.
+func (repo *Client) GetRecipeReview(ctx context.Context, parent cmodel.RecipeReviewParent, id cmodel.RecipeReviewId, fields []string) (cmodel.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeReviewId", id.RecipeReviewId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeReview{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeReviewFieldMasker.Convert(fields)).
+		Where("recipe_review.recipe_id = ? AND recipe_review.recipe_review_id = ?", parent.RecipeId.RecipeId, id.RecipeReviewId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe review row")
+		return cmodel.RecipeReview{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeReviewToCoreModel(gm), nil
+}
+
+// ListRecipeReviews lists the reviews of a recipe, newest first.
+func (repo *Client) ListRecipeReviews(ctx context.Context, parent cmodel.RecipeReviewParent, pageSize int32, offset int64, fields []string) ([]cmodel.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeReviewFieldMasker.Convert(fields)).
+		Where("recipe_review.recipe_id = ?", parent.RecipeId.RecipeId).
+		Order("recipe_review.recipe_review_id DESC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbReviews := []gmodel.RecipeReview{}
+	err := tx.Find(&dbReviews).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe review rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeReview, len(dbReviews))
+	for i, m := range dbReviews {
+		res[i] = convert.RecipeReviewToCoreModel(m)
+	}
+
+	return res, nil
+}
+
+// UpdateRecipeReview updates a recipe review.
+func (repo *Client) UpdateRecipeReview(ctx context.Context, m cmodel.RecipeReview, fields []string) (cmodel.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Int64("recipeReviewId", m.Id.RecipeReviewId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := convert.RecipeReviewFromCoreModel(m)
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeReviewFieldMasker.Convert(fields, fieldmask.OnlyUpdatable())).
+		Clauses(clause.Returning{}).
+		Where("recipe_review.recipe_id = ? AND recipe_review.recipe_review_id = ?", m.Parent.RecipeId.RecipeId, m.Id.RecipeReviewId).
+		Updates(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update recipe review row")
+		return cmodel.RecipeReview{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeReviewToCoreModel(gm), nil
+}
+
+// DeleteRecipeReview deletes a recipe review.
+func (repo *Client) DeleteRecipeReview(ctx context.Context, parent cmodel.RecipeReviewParent, id cmodel.RecipeReviewId) (cmodel.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeReviewId", id.RecipeReviewId).
+		Logger()
+
+	gm := gmodel.RecipeReview{}
+
+	err := repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("recipe_review.recipe_id = ? AND recipe_review.recipe_review_id = ?", parent.RecipeId.RecipeId, id.RecipeReviewId).
+		Delete(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete recipe review row")
+		return cmodel.RecipeReview{}, ConvertGormError(err)
+	}
+
+	return convert.RecipeReviewToCoreModel(gm), nil
+}
+
+// BulkDeleteRecipeReviews deletes all reviews of a recipe.
+func (repo *Client) BulkDeleteRecipeReviews(ctx context.Context, parent cmodel.RecipeReviewParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Where("recipe_id = ?", parent.RecipeId.RecipeId).
+		Delete(&gmodel.RecipeReview{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete recipe review rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
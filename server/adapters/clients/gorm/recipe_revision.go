@@ -0,0 +1,156 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/convert"
+	gmodel "github.com/jcfug8/daylear/server/adapters/clients/gorm/model"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRecipeRevision creates a new recipe revision.
+func (repo *Client) CreateRecipeRevision(ctx context.Context, m cmodel.RecipeRevision) (cmodel.RecipeRevision, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", m.Parent.RecipeId.RecipeId).
+		Logger()
+
+	gm, err := convert.RecipeRevisionFromCoreModel(m)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe revision when creating recipe revision row")
+		return cmodel.RecipeRevision{}, repository.ErrInvalidArgument{Msg: fmt.Sprintf("invalid recipe revision: %v", err)}
+	}
+
+	err = repo.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe revision row")
+		return cmodel.RecipeRevision{}, ConvertGormError(err)
+	}
+
+	m, err = convert.RecipeRevisionToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe revision row when creating recipe revision")
+		return cmodel.RecipeRevision{}, repository.ErrInternal{Msg: "invalid recipe revision row when creating recipe revision"}
+	}
+
+	return m, nil
+}
+
+// GetRecipeRevision gets a recipe revision.
+func (repo *Client) GetRecipeRevision(ctx context.Context, parent cmodel.RecipeRevisionParent, id cmodel.RecipeRevisionId, fields []string) (cmodel.RecipeRevision, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeRevisionId", id.RecipeRevisionId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeRevision{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeRevisionFieldMasker.Convert(fields)).
+		Where("recipe_revision.recipe_id = ? AND recipe_revision.recipe_revision_id = ?", parent.RecipeId.RecipeId, id.RecipeRevisionId).
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe revision row")
+		return cmodel.RecipeRevision{}, ConvertGormError(err)
+	}
+
+	m, err := convert.RecipeRevisionToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe revision row when getting recipe revision")
+		return cmodel.RecipeRevision{}, repository.ErrInternal{Msg: "invalid recipe revision row when getting recipe revision"}
+	}
+
+	return m, nil
+}
+
+// GetPreviousRecipeRevision gets the revision created before the given one.
+func (repo *Client) GetPreviousRecipeRevision(ctx context.Context, parent cmodel.RecipeRevisionParent, id cmodel.RecipeRevisionId, fields []string) (cmodel.RecipeRevision, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int64("recipeRevisionId", id.RecipeRevisionId).
+		Strs("fields", fields).
+		Logger()
+
+	gm := gmodel.RecipeRevision{}
+
+	err := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeRevisionFieldMasker.Convert(fields)).
+		Where("recipe_revision.recipe_id = ? AND recipe_revision.recipe_revision_id < ?", parent.RecipeId.RecipeId, id.RecipeRevisionId).
+		Order("recipe_revision.recipe_revision_id DESC").
+		First(&gm).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get previous recipe revision row")
+		return cmodel.RecipeRevision{}, ConvertGormError(err)
+	}
+
+	m, err := convert.RecipeRevisionToCoreModel(gm)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid recipe revision row when getting previous recipe revision")
+		return cmodel.RecipeRevision{}, repository.ErrInternal{Msg: "invalid recipe revision row when getting previous recipe revision"}
+	}
+
+	return m, nil
+}
+
+// ListRecipeRevisions lists the revisions of a recipe, newest first.
+func (repo *Client) ListRecipeRevisions(ctx context.Context, parent cmodel.RecipeRevisionParent, pageSize int32, offset int64, fields []string) ([]cmodel.RecipeRevision, error) {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Int32("pageSize", pageSize).
+		Int64("offset", offset).
+		Strs("fields", fields).
+		Logger()
+
+	tx := repo.db.WithContext(ctx).
+		Select(gmodel.RecipeRevisionFieldMasker.Convert(fields)).
+		Where("recipe_revision.recipe_id = ?", parent.RecipeId.RecipeId).
+		Order("recipe_revision.recipe_revision_id DESC")
+
+	if pageSize > 0 {
+		tx = tx.Limit(int(pageSize))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	dbRevisions := []gmodel.RecipeRevision{}
+	err := tx.Find(&dbRevisions).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list recipe revision rows")
+		return nil, ConvertGormError(err)
+	}
+
+	res := make([]cmodel.RecipeRevision, len(dbRevisions))
+	for i, m := range dbRevisions {
+		res[i], err = convert.RecipeRevisionToCoreModel(m)
+		if err != nil {
+			log.Error().Err(err).Msg("invalid recipe revision row when listing recipe revisions")
+			return nil, repository.ErrInternal{Msg: "invalid recipe revision row when listing recipe revisions"}
+		}
+	}
+
+	return res, nil
+}
+
+// BulkDeleteRecipeRevisions deletes all revisions of a recipe.
+func (repo *Client) BulkDeleteRecipeRevisions(ctx context.Context, parent cmodel.RecipeRevisionParent) error {
+	log := logutil.EnrichLoggerWithContext(repo.log, ctx).With().
+		Int64("recipeId", parent.RecipeId.RecipeId).
+		Logger()
+
+	err := repo.db.WithContext(ctx).
+		Where("recipe_id = ?", parent.RecipeId.RecipeId).
+		Delete(&gmodel.RecipeRevision{}).Error
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete recipe revision rows")
+		return ConvertGormError(err)
+	}
+
+	return nil
+}
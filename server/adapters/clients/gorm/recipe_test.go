@@ -0,0 +1,50 @@
+package gorm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	cmodel "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+// recipeViewer looks at the recipes of user 9 as user 5.
+var recipeViewer = cmodel.AuthAccount{AuthUserId: 5, UserId: 9, PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_READ}
+
+func TestListRecipes_CookHistoryOfCaller(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	_, err := repo.ListRecipes(context.Background(), recipeViewer, 10, 0, "times_cooked > 0", "last_cooked_time desc",
+		[]string{cmodel.RecipeField_LastCookedTime, cmodel.RecipeField_TimesCooked})
+	if err != nil {
+		t.Fatalf("ListRecipes() error = %v", err)
+	}
+
+	sql := recorder.last(t)
+	for _, want := range []string{
+		"recipe_cook_log WHERE cook_user_id = 5",
+		"COALESCE(recipe_cook_stats.times_cooked, 0) > 0",
+		"ORDER BY recipe_cook_stats.last_cooked_time DESC",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("ListRecipes() sql = %s, want it to contain %s", sql, want)
+		}
+	}
+	if strings.Contains(sql, "cook_user_id = 9") {
+		t.Errorf("ListRecipes() sql = %s, want the cook history of the caller, not of the owner of the recipes", sql)
+	}
+}
+
+func TestGetRecipe_CookHistoryOfCaller(t *testing.T) {
+	repo, recorder := newDryRunClient(t)
+
+	// nothing is found in a dry run
+	_, _ = repo.GetRecipe(context.Background(), recipeViewer, cmodel.RecipeId{RecipeId: 1},
+		[]string{cmodel.RecipeField_LastCookedTime, cmodel.RecipeField_TimesCooked})
+
+	sql := recorder.last(t)
+	if !strings.Contains(sql, "recipe_cook_log WHERE cook_user_id = 5") {
+		t.Errorf("GetRecipe() sql = %s, want the cook history of the caller", sql)
+	}
+}
@@ -89,7 +89,34 @@ func (m *magickImage) Convert(ctx context.Context, format string) error {
 		return fmt.Errorf("failed to convert image: %s: %s", err, string(out))
 	}
 
-	m.Format = "." + format
+	os.Remove(m.path)
+	m.Format = format
 	m.path = outPath
 	return nil
 }
+
+func (m *magickImage) GetFormat() string {
+	return m.Format
+}
+
+func (m *magickImage) Clone(ctx context.Context) (image.Image, error) {
+	src, err := os.Open(m.path)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp("", "magick-img-*.img")
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		os.Remove(dst.Name())
+		return nil, err
+	}
+
+	return &magickImage{path: dst.Name(), Format: m.Format}, nil
+}
@@ -0,0 +1,52 @@
+package llm
+
+import (
+	"strings"
+
+	"github.com/jcfug8/daylear/server/ports/config"
+)
+
+// Settings returns a config section, or an empty one when it is not set.
+func Settings(configClient config.Client, section string) map[string]interface{} {
+	settings, ok := configClient.GetConfig()[section].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}
+	}
+	return settings
+}
+
+// String returns a string setting, or the fallback when it is not set.
+func String(settings map[string]interface{}, key string, fallback string) string {
+	value, ok := settings[key].(string)
+	if !ok || strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return strings.TrimSpace(value)
+}
+
+// List returns a comma separated list setting, or the fallback when it is not
+// set. A list in a config file is also accepted.
+func List(settings map[string]interface{}, key string, fallback []string) []string {
+	var values []string
+	switch value := settings[key].(type) {
+	case string:
+		values = strings.Split(value, ",")
+	case []interface{}:
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	var list []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+	if len(list) == 0 {
+		return fallback
+	}
+	return list
+}
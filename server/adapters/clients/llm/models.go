@@ -0,0 +1,53 @@
+package llm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// Models rotates through a list of model names so that load is spread across
+// them and a failing model is followed by the next one.
+type Models struct {
+	lock    sync.Mutex
+	names   []string
+	current int
+}
+
+// NewModels creates a rotation over the model names.
+func NewModels(names []string) *Models {
+	return &Models{names: names}
+}
+
+// Len returns the number of models in the rotation.
+func (m *Models) Len() int {
+	return len(m.names)
+}
+
+// Next returns the next model in the rotation.
+func (m *Models) Next() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	name := m.names[m.current]
+	m.current = (m.current + 1) % len(m.names)
+	return name
+}
+
+// Try calls attempt with each model in turn, starting at the next model in
+// the rotation, until one succeeds. It returns the model that succeeded.
+func (m *Models) Try(log zerolog.Logger, action string, attempt func(name string) error) (string, error) {
+	for tryCount := 0; tryCount < m.Len(); tryCount++ {
+		name := m.Next()
+		log.Info().Str("model", name).Msgf("attempting to %s", action)
+		err := attempt(name)
+		if err != nil {
+			log.Info().Err(err).Str("model", name).Msgf("failed to %s", action)
+			continue
+		}
+		return name, nil
+	}
+	log.Error().Msgf("failed to %s after trying all models", action)
+	return "", fmt.Errorf("failed to %s after %d tries", action, m.Len())
+}
@@ -0,0 +1,87 @@
+// Package llm holds what the LLM backed recipe scraper and image generator
+// adapters share: the schema.org prompt contract, response parsing, model
+// rotation and config helpers.
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/schemaorgrecipe"
+)
+
+var recipePromptTemplate = `
+		  - Do not make up any content that is not there, but correct any typos or errors that seem useful.
+		  - schema.org @type fields must included in the output.
+		  - Use the full name of the unit, not the abbreviation. i.e. "1 cup" not "1 c" or "1 tablespoon" not "1 tbsp".
+		  - the basic format of an ingredient should be '{amount} {unit} {ingredient}'.
+		  - If there are clearly multiple sets of ingredients for different parts of the recipe, then the ingredients must be output as a itemList. The @type for each group shold be 'IngredientSection', the @type for each ingredient should be 'Ingredient', and the text of the ingredient should be keyed by 'text'. This is not in the schema.org/Recipe but that is how i want to format it.
+		  - If an igredient item list an alternative ingredient append '[{alternative name}]' to the first ingredient name. The input may be formatted like, but not limited to, "1 cup honey or brown sugar" or "1 cup honey (brown sugar)"
+		  - If the ingredient has two measurements that are to be treated as a range, then the output ingredient should be formatted like. "1 -- 2 cups sugar". The input may be formatted like, but not limited to, "1 - 2 cups sugar" or "1 to 2 cups sugar".
+		  - If the ingredient has two measurements that should be combined or added together and are using two different units, then the output ingredient should be formatted like "1 cup && 1 tablespoon sugar". The input may be formatted like, but not limited to, "1 cup + 1 tablespoon sugar" or "1 cup and 1 tablespoon sugar".
+		  - If the ingredient has two measurements but only one should be used, e.i one is volume and the other is weight, then the output ingredient should be formatted like. "1 cup || 100 grams sugar". The input may be formatted like, but not limited to, "1 cup or 100 grams sugar" or "1 cup (100 grams) sugar" or "1 cup sugar - 100 grams".
+		  - Steps can, but don't have to, be grouped using a itemList if the steps are related to a specific section of the recipe. Do this if it would help clarify the recipe directions.
+		  - If the recipe is in a foreign language, translate the recipe to English.
+		  - If the ingredient is optional, end the ingredient with '*'.
+`
+
+// RecipeFromImagePrompt asks for the schema.org recipe on the images sent
+// with it.
+var RecipeFromImagePrompt = `Please convert the recipe on the image(s) to the schema.org/Recipe format as JSON. Only output the JSON. Do not include any other text or comments. If no recipe is found, return an empty JSON object and do not make up a recipe.
+		When formatting a recipe:
+		  - The images may be out of order so please first figure out the order of the images, then parse the recipe from the images in the correct order` + recipePromptTemplate
+
+// RecipeFromDataPrompt asks for the schema.org recipe in the page data sent
+// with it, see RecipeData.
+var RecipeFromDataPrompt = `Please use the scraped web page data and convert it into the schema.org/Recipe format as JSON. Only output the JSON. Do not include any other text or comments. If no recipe is found, return an empty JSON object and do not make up a recipe.
+		When formatting a recipe:` + recipePromptTemplate
+
+// RecipeData wraps scraped page data for RecipeFromDataPrompt.
+func RecipeData(data []byte) string {
+	return fmt.Sprintf("`Here is the scraped web page data: \n%s`", string(data))
+}
+
+// RecipeImagePrompt asks for a photograph of the finished recipe.
+func RecipeImagePrompt(recipe model.Recipe) (string, error) {
+	schemaRecipe := schemaorgrecipe.ToSchemaOrgRecipe(recipe)
+	schemaRecipeJson, err := json.Marshal(schemaRecipe)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal schema.org recipe: %w", err)
+	}
+
+	return fmt.Sprintf(`Please generate a high-quality, realistic image of the finished dish described by the following schema.org/Recipe JSON. The image should look like a professional food photograph suitable for use as the main image on a recipe website.
+
+- The dish should be fully prepared and attractively presented, with attention to the key ingredients and typical serving style.
+- Use natural lighting and a clean, appetizing background.
+- Do not include any text, watermarks, or extraneous objects in the image.
+- Focus on making the food look delicious and visually appealing.
+- Pay attention to the ingredients and the recipe steps to ensure the image is accurate and realistic.
+
+Here is the recipe:
+				%s`,
+		string(schemaRecipeJson),
+	), nil
+}
+
+// ParseRecipe parses the schema.org recipe JSON a model answered with. The
+// JSON may be wrapped in a markdown code block.
+func ParseRecipe(text string) (model.Recipe, error) {
+	text = strings.TrimSpace(text)
+	text = strings.TrimPrefix(text, "```json")
+	text = strings.TrimPrefix(text, "```")
+	text = strings.TrimSuffix(text, "```")
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return model.Recipe{}, fmt.Errorf("no text response")
+	}
+
+	var schemaRecipe schemaorgrecipe.SchemaOrgRecipe
+	err := json.Unmarshal([]byte(text), &schemaRecipe)
+	if err != nil {
+		return model.Recipe{}, fmt.Errorf("failed to unmarshal schema.org recipe: %w", err)
+	}
+
+	return schemaorgrecipe.ToModelRecipe(schemaRecipe), nil
+}
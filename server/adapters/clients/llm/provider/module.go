@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"llm",
+	fx.Provide(
+		NewClients,
+	),
+)
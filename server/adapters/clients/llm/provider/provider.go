@@ -0,0 +1,106 @@
+// Package provider selects the LLM backends of the recipe scraper and the
+// image generator from the llm config section.
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/gemini"
+	"github.com/jcfug8/daylear/server/adapters/clients/llm"
+	"github.com/jcfug8/daylear/server/adapters/clients/ollama"
+	"github.com/jcfug8/daylear/server/adapters/clients/openai"
+	"github.com/jcfug8/daylear/server/ports/config"
+	"github.com/jcfug8/daylear/server/ports/imagegenerator"
+	"github.com/jcfug8/daylear/server/ports/recipescraper"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+// The supported providers.
+const (
+	Gemini = "gemini"
+	OpenAI = "openai"
+	Ollama = "ollama"
+)
+
+// imageProviders are the providers that can generate images.
+var imageProviders = []string{Gemini, OpenAI}
+
+type client interface {
+	recipescraper.Client
+	imagegenerator.Client
+}
+
+type NewClientsParams struct {
+	fx.In
+
+	Config config.Client
+	Logger zerolog.Logger
+}
+
+type NewClientsResult struct {
+	fx.Out
+
+	RecipeScraper  recipescraper.Client
+	ImageGenerator imagegenerator.Client
+}
+
+// NewClients creates the recipe scraper of the llm.provider setting and the
+// image generator of the llm.imageprovider setting, which defaults to the
+// same provider. Gemini is used when neither is set. It fails when the image
+// provider cannot generate images, so a provider like ollama needs
+// llm.imageprovider set to one that can.
+func NewClients(params NewClientsParams) (NewClientsResult, error) {
+	settings := llm.Settings(params.Config, "llm")
+	scraperProvider := strings.ToLower(llm.String(settings, "provider", Gemini))
+	imageProvider := strings.ToLower(llm.String(settings, "imageprovider", scraperProvider))
+	if !slices.Contains(imageProviders, imageProvider) {
+		return NewClientsResult{}, fmt.Errorf("llm provider %q cannot generate images, set llm.imageprovider to one of %s", imageProvider, strings.Join(imageProviders, ", "))
+	}
+
+	clients := map[string]client{}
+	get := func(name string) (client, error) {
+		if c, ok := clients[name]; ok {
+			return c, nil
+		}
+		c, err := newClient(params, name)
+		if err != nil {
+			return nil, err
+		}
+		clients[name] = c
+		return c, nil
+	}
+
+	scraper, err := get(scraperProvider)
+	if err != nil {
+		return NewClientsResult{}, err
+	}
+	imageGenerator, err := get(imageProvider)
+	if err != nil {
+		return NewClientsResult{}, err
+	}
+
+	params.Logger.Info().
+		Str("provider", scraperProvider).
+		Str("imageProvider", imageProvider).
+		Msg("llm providers selected")
+
+	return NewClientsResult{
+		RecipeScraper:  scraper,
+		ImageGenerator: imageGenerator,
+	}, nil
+}
+
+func newClient(params NewClientsParams, name string) (client, error) {
+	switch name {
+	case Gemini:
+		return gemini.NewRecipeGeminiClient(gemini.RecipeGeminiClientParams{Config: params.Config, Logger: params.Logger})
+	case OpenAI:
+		return openai.NewClient(openai.NewClientParams{Config: params.Config, Logger: params.Logger})
+	case Ollama:
+		return ollama.NewClient(ollama.NewClientParams{Config: params.Config, Logger: params.Logger})
+	}
+	return nil, fmt.Errorf("unknown llm provider: %q", name)
+}
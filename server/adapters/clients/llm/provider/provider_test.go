@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+type testConfig map[string]any
+
+func (c testConfig) GetConfig() map[string]any {
+	return c
+}
+
+func TestNewClients_ImageProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		llm     map[string]interface{}
+		wantErr bool
+	}{
+		{name: "defaults to the scraper provider", llm: map[string]interface{}{"provider": "openai"}},
+		{name: "ollama without an image provider", llm: map[string]interface{}{"provider": "ollama"}, wantErr: true},
+		{name: "ollama with an image provider", llm: map[string]interface{}{"provider": "ollama", "imageprovider": "openai"}},
+		{name: "ollama as the image provider", llm: map[string]interface{}{"provider": "openai", "imageprovider": "ollama"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClients(NewClientsParams{
+				Config: testConfig{"llm": tt.llm},
+				Logger: zerolog.Nop(),
+			})
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "cannot generate images") {
+					t.Errorf("NewClients() error = %v, want an image provider error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("NewClients() error = %v", err)
+			}
+		})
+	}
+}
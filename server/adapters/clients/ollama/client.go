@@ -0,0 +1,177 @@
+package ollama
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/llm"
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/config"
+	"github.com/jcfug8/daylear/server/ports/imagegenerator"
+	"github.com/jcfug8/daylear/server/ports/recipescraper"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+var _ recipescraper.Client = &Client{}
+var _ imagegenerator.Client = &Client{}
+
+// ErrImageGenerationUnsupported is returned when an image is requested from
+// Ollama, which has no image generation API.
+var ErrImageGenerationUnsupported = fmt.Errorf("image generation is not supported by ollama")
+
+const (
+	defaultBaseURL = "http://localhost:11434"
+	defaultModel   = "llama3.2-vision"
+
+	requestTimeout = 10 * time.Minute
+)
+
+// Client implements the recipe scraper against a local Ollama server.
+type Client struct {
+	logger     zerolog.Logger
+	httpClient *http.Client
+	baseURL    string
+	models     *llm.Models
+}
+
+type NewClientParams struct {
+	fx.In
+
+	Config config.Client
+	Logger zerolog.Logger
+}
+
+// NewClient creates a client from the ollama config section: baseurl and the
+// comma separated models. Models reading recipes from images must support
+// vision.
+func NewClient(params NewClientParams) (*Client, error) {
+	settings := llm.Settings(params.Config, "ollama")
+
+	return &Client{
+		logger:     params.Logger,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		baseURL:    strings.TrimSuffix(llm.String(settings, "baseurl", defaultBaseURL), "/"),
+		models:     llm.NewModels(llm.List(settings, "models", []string{defaultModel})),
+	}, nil
+}
+
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+	Format   string        `json:"format"`
+}
+
+type chatMessage struct {
+	Role    string   `json:"role"`
+	Content string   `json:"content"`
+	Images  []string `json:"images,omitempty"`
+}
+
+type chatResponse struct {
+	Message struct {
+		Content string `json:"content"`
+	} `json:"message"`
+}
+
+func (c *Client) RecipeFromImage(ctx context.Context, files []file.File) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(c.logger, ctx)
+
+	message := chatMessage{Role: "user", Content: llm.RecipeFromImagePrompt}
+	for _, f := range files {
+		imgBytes, err := io.ReadAll(f)
+		if err != nil {
+			return model.Recipe{}, fmt.Errorf("failed to read image: %w", err)
+		}
+		message.Images = append(message.Images, base64.StdEncoding.EncodeToString(imgBytes))
+	}
+
+	recipe, err := c.recipeFromChat(ctx, log, "get recipe from image", message)
+	if err != nil {
+		return model.Recipe{}, err
+	}
+	return recipe, nil
+}
+
+func (c *Client) RecipeFromData(ctx context.Context, data []byte) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(c.logger, ctx)
+
+	recipe, err := c.recipeFromChat(ctx, log, "get recipe from data", chatMessage{
+		Role:    "user",
+		Content: llm.RecipeFromDataPrompt + "\n" + llm.RecipeData(data),
+	})
+	if err != nil {
+		return model.Recipe{}, err
+	}
+	return recipe, nil
+}
+
+func (c *Client) GenerateRecipeImage(ctx context.Context, recipe model.Recipe) (file.File, error) {
+	return file.File{}, ErrImageGenerationUnsupported
+}
+
+// recipeFromChat sends the message and parses the schema.org recipe in the
+// answer. The answer is constrained to JSON.
+func (c *Client) recipeFromChat(ctx context.Context, log zerolog.Logger, action string, message chatMessage) (model.Recipe, error) {
+	var recipe model.Recipe
+	modelName, err := c.models.Try(log, action, func(modelName string) error {
+		var resp chatResponse
+		err := c.post(ctx, "/api/chat", chatRequest{
+			Model:    modelName,
+			Messages: []chatMessage{message},
+			Stream:   false,
+			Format:   "json",
+		}, &resp)
+		if err != nil {
+			return err
+		}
+		recipe, err = llm.ParseRecipe(resp.Message.Content)
+		return err
+	})
+	if err != nil {
+		return model.Recipe{}, err
+	}
+
+	log.Info().Str("model", modelName).Interface("recipe", recipe).Msgf("ran %s", action)
+	return recipe, nil
+}
+
+// post sends a JSON request to the server and decodes the JSON response.
+func (c *Client) post(ctx context.Context, path string, body interface{}, out interface{}) error {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("non-200 response: %d %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
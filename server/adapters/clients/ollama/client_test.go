@@ -0,0 +1,163 @@
+package ollama
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/rs/zerolog"
+)
+
+type testConfig map[string]any
+
+func (c testConfig) GetConfig() map[string]any {
+	return c
+}
+
+const recipeJSON = `{
+	"@context": "https://schema.org",
+	"@type": "Recipe",
+	"name": "Pancakes",
+	"recipeIngredient": ["2 cups flour", "2 eggs"],
+	"recipeInstructions": "Mix.\nFry."
+}`
+
+// standIn is a local stand-in for an Ollama server. Requests for the failing
+// models are answered like a model that is not pulled.
+type standIn struct {
+	t       *testing.T
+	failing map[string]bool
+
+	lock     sync.Mutex
+	requests []chatRequest
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/chat" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var body chatRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.t.Errorf("invalid request body: %v", err)
+	}
+
+	s.lock.Lock()
+	s.requests = append(s.requests, body)
+	s.lock.Unlock()
+
+	if s.failing[body.Model] {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": "model not found, try pulling it first"})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"model":   body.Model,
+		"message": map[string]string{"role": "assistant", "content": recipeJSON},
+		"done":    true,
+	})
+}
+
+func newTestClient(t *testing.T, server *standIn, settings map[string]interface{}) *Client {
+	t.Helper()
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	settings["baseurl"] = ts.URL
+	client, err := NewClient(NewClientParams{
+		Config: testConfig{"ollama": settings},
+		Logger: zerolog.Nop(),
+	})
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	return client
+}
+
+func checkRecipe(t *testing.T, recipe model.Recipe) {
+	t.Helper()
+	if recipe.Title != "Pancakes" {
+		t.Errorf("unexpected title: %q", recipe.Title)
+	}
+	if len(recipe.IngredientGroups) != 1 || len(recipe.IngredientGroups[0].RecipeIngredients) != 2 {
+		t.Errorf("unexpected ingredients: %+v", recipe.IngredientGroups)
+	}
+	if len(recipe.Directions) != 1 || len(recipe.Directions[0].Steps) != 2 {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+}
+
+func TestRecipeFromData(t *testing.T) {
+	server := &standIn{t: t, failing: map[string]bool{"missing": true}}
+	client := newTestClient(t, server, map[string]interface{}{"models": "missing,llama3.2"})
+
+	recipe, err := client.RecipeFromData(context.Background(), []byte("Pancakes: 2 cups flour, 2 eggs. Mix. Fry."))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkRecipe(t, recipe)
+
+	if len(server.requests) != 2 || server.requests[1].Model != "llama3.2" {
+		t.Fatalf("expected the failing model to be followed by the next, got %+v", server.requests)
+	}
+	req := server.requests[1]
+	if req.Stream || req.Format != "json" {
+		t.Errorf("expected a non-streaming JSON request, got %+v", req)
+	}
+	if !strings.Contains(req.Messages[0].Content, "Pancakes: 2 cups flour") {
+		t.Errorf("expected the page data in the message, got %q", req.Messages[0].Content)
+	}
+}
+
+func TestRecipeFromImage(t *testing.T) {
+	server := &standIn{t: t}
+	client := newTestClient(t, server, map[string]interface{}{})
+
+	recipe, err := client.RecipeFromImage(context.Background(), []file.File{{
+		ContentType:    "image/jpeg",
+		ReadSeekCloser: file.NewReadSeekCloser([]byte("jpeg")),
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkRecipe(t, recipe)
+
+	req := server.requests[0]
+	if req.Model != defaultModel {
+		t.Errorf("expected the default model, got %q", req.Model)
+	}
+	if len(req.Messages[0].Images) != 1 || req.Messages[0].Images[0] != base64.StdEncoding.EncodeToString([]byte("jpeg")) {
+		t.Errorf("unexpected images: %v", req.Messages[0].Images)
+	}
+}
+
+func TestRecipeFromDataAllModelsFail(t *testing.T) {
+	server := &standIn{t: t, failing: map[string]bool{"a": true, "b": true}}
+	client := newTestClient(t, server, map[string]interface{}{"models": []interface{}{"a", "b"}})
+
+	if _, err := client.RecipeFromData(context.Background(), []byte("data")); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(server.requests) != 2 {
+		t.Errorf("expected every model to be tried once, got %d requests", len(server.requests))
+	}
+}
+
+func TestGenerateRecipeImage(t *testing.T) {
+	client := newTestClient(t, &standIn{t: t}, map[string]interface{}{})
+
+	_, err := client.GenerateRecipeImage(context.Background(), model.Recipe{Title: "Pancakes"})
+	if !errors.Is(err, ErrImageGenerationUnsupported) {
+		t.Errorf("expected ErrImageGenerationUnsupported, got %v", err)
+	}
+}
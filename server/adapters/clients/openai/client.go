@@ -0,0 +1,271 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/clients/llm"
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/config"
+	"github.com/jcfug8/daylear/server/ports/imagegenerator"
+	"github.com/jcfug8/daylear/server/ports/recipescraper"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+var _ recipescraper.Client = &Client{}
+var _ imagegenerator.Client = &Client{}
+
+const (
+	defaultBaseURL    = "https://api.openai.com/v1"
+	defaultModel      = "gpt-4o-mini"
+	defaultImageModel = "dall-e-3"
+
+	requestTimeout = 5 * time.Minute
+)
+
+// Client implements the recipe scraper and image generator against an OpenAI
+// compatible API, which includes llama.cpp server, vLLM and LocalAI.
+type Client struct {
+	logger      zerolog.Logger
+	httpClient  *http.Client
+	baseURL     string
+	apiKey      string
+	models      *llm.Models
+	imageModels *llm.Models
+}
+
+type NewClientParams struct {
+	fx.In
+
+	Config config.Client
+	Logger zerolog.Logger
+}
+
+// NewClient creates a client from the openai config section: baseurl, apikey
+// and the comma separated models and imagemodels.
+func NewClient(params NewClientParams) (*Client, error) {
+	settings := llm.Settings(params.Config, "openai")
+
+	return &Client{
+		logger:      params.Logger,
+		httpClient:  &http.Client{Timeout: requestTimeout},
+		baseURL:     strings.TrimSuffix(llm.String(settings, "baseurl", defaultBaseURL), "/"),
+		apiKey:      llm.String(settings, "apikey", ""),
+		models:      llm.NewModels(llm.List(settings, "models", []string{defaultModel})),
+		imageModels: llm.NewModels(llm.List(settings, "imagemodels", []string{defaultImageModel})),
+	}, nil
+}
+
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+}
+
+type chatMessage struct {
+	Role    string        `json:"role"`
+	Content []contentPart `json:"content"`
+}
+
+type contentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *imageURL `json:"image_url,omitempty"`
+}
+
+type imageURL struct {
+	URL string `json:"url"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+	} `json:"choices"`
+}
+
+type imageRequest struct {
+	Model          string `json:"model"`
+	Prompt         string `json:"prompt"`
+	N              int    `json:"n"`
+	ResponseFormat string `json:"response_format"`
+}
+
+type imageResponse struct {
+	Data []struct {
+		B64JSON string `json:"b64_json"`
+		URL     string `json:"url"`
+	} `json:"data"`
+}
+
+func (c *Client) RecipeFromImage(ctx context.Context, files []file.File) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(c.logger, ctx)
+
+	parts := []contentPart{{Type: "text", Text: llm.RecipeFromImagePrompt}}
+	for _, f := range files {
+		imgBytes, err := io.ReadAll(f)
+		if err != nil {
+			return model.Recipe{}, fmt.Errorf("failed to read image: %w", err)
+		}
+		parts = append(parts, contentPart{
+			Type:     "image_url",
+			ImageURL: &imageURL{URL: "data:" + f.ContentType + ";base64," + base64.StdEncoding.EncodeToString(imgBytes)},
+		})
+	}
+
+	recipe, err := c.recipeFromChat(ctx, log, "get recipe from image", parts)
+	if err != nil {
+		return model.Recipe{}, err
+	}
+	return recipe, nil
+}
+
+func (c *Client) RecipeFromData(ctx context.Context, data []byte) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(c.logger, ctx)
+
+	recipe, err := c.recipeFromChat(ctx, log, "get recipe from data", []contentPart{
+		{Type: "text", Text: llm.RecipeFromDataPrompt},
+		{Type: "text", Text: llm.RecipeData(data)},
+	})
+	if err != nil {
+		return model.Recipe{}, err
+	}
+	return recipe, nil
+}
+
+func (c *Client) GenerateRecipeImage(ctx context.Context, recipe model.Recipe) (file.File, error) {
+	log := logutil.EnrichLoggerWithContext(c.logger, ctx)
+
+	prompt, err := llm.RecipeImagePrompt(recipe)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to build recipe image prompt")
+		return file.File{}, err
+	}
+
+	var data []byte
+	_, err = c.imageModels.Try(log, "generate recipe image", func(modelName string) error {
+		var resp imageResponse
+		err := c.post(ctx, "/images/generations", imageRequest{
+			Model:          modelName,
+			Prompt:         prompt,
+			N:              1,
+			ResponseFormat: "b64_json",
+		}, &resp)
+		if err != nil {
+			return err
+		}
+		if len(resp.Data) == 0 {
+			return fmt.Errorf("no image in response")
+		}
+		if resp.Data[0].B64JSON != "" {
+			data, err = base64.StdEncoding.DecodeString(resp.Data[0].B64JSON)
+			return err
+		}
+		data, err = c.download(ctx, resp.Data[0].URL)
+		return err
+	})
+	if err != nil {
+		return file.File{}, err
+	}
+
+	contentType := http.DetectContentType(data)
+	return file.File{
+		Extension:      "." + strings.TrimPrefix(contentType, "image/"),
+		ContentType:    contentType,
+		ReadSeekCloser: file.NewReadSeekCloser(data),
+		ContentLength:  int64(len(data)),
+	}, nil
+}
+
+// recipeFromChat sends the parts as a user message and parses the schema.org
+// recipe in the answer.
+func (c *Client) recipeFromChat(ctx context.Context, log zerolog.Logger, action string, parts []contentPart) (model.Recipe, error) {
+	var recipe model.Recipe
+	modelName, err := c.models.Try(log, action, func(modelName string) error {
+		var resp chatResponse
+		err := c.post(ctx, "/chat/completions", chatRequest{
+			Model:    modelName,
+			Messages: []chatMessage{{Role: "user", Content: parts}},
+		}, &resp)
+		if err != nil {
+			return err
+		}
+		if len(resp.Choices) == 0 {
+			return fmt.Errorf("no choices in response")
+		}
+		recipe, err = llm.ParseRecipe(resp.Choices[0].Message.Content)
+		return err
+	})
+	if err != nil {
+		return model.Recipe{}, err
+	}
+
+	log.Info().Str("model", modelName).Interface("recipe", recipe).Msgf("ran %s", action)
+	return recipe, nil
+}
+
+// post sends a JSON request to the API and decodes the JSON response.
+func (c *Client) post(ctx context.Context, path string, body interface{}, out interface{}) error {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("non-2xx response: %d %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// download fetches an image the API answered with a link to.
+func (c *Client) download(ctx context.Context, uri string) ([]byte, error) {
+	if uri == "" {
+		return nil, fmt.Errorf("no image in response")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("non-200 response downloading image: %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
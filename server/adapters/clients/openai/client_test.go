@@ -0,0 +1,210 @@
+package openai
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/rs/zerolog"
+)
+
+type testConfig map[string]any
+
+func (c testConfig) GetConfig() map[string]any {
+	return c
+}
+
+const recipeJSON = "```json\n" + `{
+	"@context": "https://schema.org",
+	"@type": "Recipe",
+	"name": "Pancakes",
+	"recipeIngredient": ["2 cups flour", "2 eggs"],
+	"recipeInstructions": [{"@type": "HowToStep", "text": "Mix and fry."}]
+}` + "\n```"
+
+// pngHeader is enough of a PNG for content type detection.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n0000")
+
+// standIn is a local stand-in for an OpenAI compatible API. Requests for
+// the failing models are answered with a server error.
+type standIn struct {
+	t       *testing.T
+	failing map[string]bool
+
+	lock     sync.Mutex
+	models   []string
+	requests []map[string]interface{}
+	auth     []string
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.t.Errorf("invalid request body: %v", err)
+	}
+	modelName, _ := body["model"].(string)
+
+	s.lock.Lock()
+	s.models = append(s.models, modelName)
+	s.requests = append(s.requests, body)
+	s.auth = append(s.auth, r.Header.Get("Authorization"))
+	s.lock.Unlock()
+
+	if s.failing[modelName] {
+		http.Error(w, `{"error": {"message": "overloaded"}}`, http.StatusServiceUnavailable)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v1/chat/completions":
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []interface{}{
+				map[string]interface{}{"message": map[string]interface{}{"role": "assistant", "content": recipeJSON}},
+			},
+		})
+	case "/v1/images/generations":
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []interface{}{
+				map[string]interface{}{"b64_json": base64.StdEncoding.EncodeToString(pngHeader)},
+			},
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestClient(t *testing.T, server *standIn, settings map[string]interface{}) *Client {
+	t.Helper()
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	settings["baseurl"] = ts.URL + "/v1/"
+	client, err := NewClient(NewClientParams{
+		Config: testConfig{"openai": settings},
+		Logger: zerolog.Nop(),
+	})
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	return client
+}
+
+func checkRecipe(t *testing.T, recipe model.Recipe) {
+	t.Helper()
+	if recipe.Title != "Pancakes" {
+		t.Errorf("unexpected title: %q", recipe.Title)
+	}
+	if len(recipe.IngredientGroups) != 1 || len(recipe.IngredientGroups[0].RecipeIngredients) != 2 {
+		t.Errorf("unexpected ingredients: %+v", recipe.IngredientGroups)
+	}
+	if len(recipe.Directions) != 1 || len(recipe.Directions[0].Steps) != 1 {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+}
+
+func TestRecipeFromData(t *testing.T) {
+	server := &standIn{t: t, failing: map[string]bool{"big": true}}
+	client := newTestClient(t, server, map[string]interface{}{
+		"apikey": "secret",
+		"models": "big, small",
+	})
+
+	recipe, err := client.RecipeFromData(context.Background(), []byte("Pancakes: 2 cups flour, 2 eggs. Mix and fry."))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkRecipe(t, recipe)
+
+	if strings.Join(server.models, ",") != "big,small" {
+		t.Errorf("expected the failing model to be followed by the next, got %v", server.models)
+	}
+	if server.auth[0] != "Bearer secret" {
+		t.Errorf("unexpected authorization header: %q", server.auth[0])
+	}
+	messages := server.requests[1]["messages"].([]interface{})
+	content := messages[0].(map[string]interface{})["content"].([]interface{})
+	if len(content) != 2 || !strings.Contains(content[1].(map[string]interface{})["text"].(string), "Pancakes: 2 cups flour") {
+		t.Errorf("unexpected message content: %v", content)
+	}
+}
+
+func TestRecipeFromImage(t *testing.T) {
+	server := &standIn{t: t}
+	client := newTestClient(t, server, map[string]interface{}{})
+
+	recipe, err := client.RecipeFromImage(context.Background(), []file.File{{
+		ContentType:    "image/png",
+		ReadSeekCloser: file.NewReadSeekCloser(pngHeader),
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkRecipe(t, recipe)
+
+	if server.models[0] != defaultModel {
+		t.Errorf("expected the default model, got %q", server.models[0])
+	}
+	if server.auth[0] != "" {
+		t.Errorf("expected no authorization header without an api key, got %q", server.auth[0])
+	}
+	messages := server.requests[0]["messages"].([]interface{})
+	content := messages[0].(map[string]interface{})["content"].([]interface{})
+	image := content[1].(map[string]interface{})["image_url"].(map[string]interface{})["url"].(string)
+	if image != "data:image/png;base64,"+base64.StdEncoding.EncodeToString(pngHeader) {
+		t.Errorf("unexpected image url: %q", image)
+	}
+}
+
+func TestRecipeFromDataAllModelsFail(t *testing.T) {
+	server := &standIn{t: t, failing: map[string]bool{"a": true, "b": true}}
+	client := newTestClient(t, server, map[string]interface{}{"models": "a,b"})
+
+	if _, err := client.RecipeFromData(context.Background(), []byte("data")); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(server.models) != 2 {
+		t.Errorf("expected every model to be tried once, got %v", server.models)
+	}
+}
+
+func TestModelRotation(t *testing.T) {
+	server := &standIn{t: t}
+	client := newTestClient(t, server, map[string]interface{}{"models": "a,b"})
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.RecipeFromData(context.Background(), []byte("data")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if strings.Join(server.models, ",") != "a,b,a" {
+		t.Errorf("expected requests to rotate through the models, got %v", server.models)
+	}
+}
+
+func TestGenerateRecipeImage(t *testing.T) {
+	server := &standIn{t: t, failing: map[string]bool{"first": true}}
+	client := newTestClient(t, server, map[string]interface{}{"imagemodels": "first,second"})
+
+	f, err := client.GenerateRecipeImage(context.Background(), model.Recipe{Title: "Pancakes"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.ContentType != "image/png" || f.Extension != ".png" {
+		t.Errorf("unexpected image type: %q %q", f.ContentType, f.Extension)
+	}
+	data, _ := io.ReadAll(f)
+	if string(data) != string(pngHeader) {
+		t.Errorf("unexpected image data: %q", data)
+	}
+	if got := server.requests[1]; got["response_format"] != "b64_json" || !strings.Contains(got["prompt"].(string), "Pancakes") {
+		t.Errorf("unexpected image request: %v", got)
+	}
+}
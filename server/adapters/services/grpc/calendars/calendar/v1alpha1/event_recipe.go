@@ -23,6 +23,7 @@ var eventRecipeFieldMap = map[string][]string{
 	"name":        {model.EventRecipeField_Parent, model.EventRecipeField_EventRecipeId},
 	"recipe":      {model.EventRecipeField_RecipeId},
 	"create_time": {model.EventRecipeField_CreateTime},
+	"done_time":   {model.EventRecipeField_DoneTime},
 }
 
 // CreateEventRecipe creates a new eventRecipe
@@ -211,6 +212,62 @@ func (s *CalendarService) ListEventRecipes(ctx context.Context, request *pb.List
 	return response, nil
 }
 
+// MarkEventRecipeDone marks the recipe of an event as done
+func (s *CalendarService) MarkEventRecipeDone(ctx context.Context, request *pb.MarkEventRecipeDoneRequest) (*pb.MarkEventRecipeDoneResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC MarkEventRecipeDone called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	var mEventRecipe model.EventRecipe
+	_, err = s.eventRecipeNamer.Parse(request.GetName(), &mEventRecipe)
+	if err != nil {
+		log.Warn().Err(err).Str("name", request.GetName()).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	var mCookLog *model.RecipeCookLog
+	if request.GetCreateCookLog() {
+		mCookLog = &model.RecipeCookLog{
+			Rating: request.GetRating(),
+			Notes:  request.GetNotes(),
+		}
+	}
+
+	mEventRecipe, mCookLog, err = s.domain.MarkEventRecipeDone(ctx, authAccount, mEventRecipe.Parent, mEventRecipe.EventRecipeId, mCookLog)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.MarkEventRecipeDone failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	eventRecipeProto, err := s.EventRecipeToProto(mEventRecipe)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	grpc.ProcessResponseFieldBehavior(eventRecipeProto)
+
+	response := &pb.MarkEventRecipeDoneResponse{
+		EventRecipe: eventRecipeProto,
+	}
+
+	if mCookLog != nil {
+		response.CookLog, err = s.recipeCookLogNamer.Format(*mCookLog)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+	}
+
+	log.Info().Msg("gRPC MarkEventRecipeDone returning successfully")
+	return response, nil
+}
+
 // ProtoToEventRecipe converts a proto EventRecipe to a model EventRecipe
 func (s *CalendarService) ProtoToEventRecipe(proto *pb.EventRecipe) (nameIndex int, eventRecipe model.EventRecipe, err error) {
 	eventRecipe = model.EventRecipe{
@@ -249,6 +306,10 @@ func (s *CalendarService) EventRecipeToProto(eventRecipe model.EventRecipe, opti
 		proto.Recipe = name
 	}
 
+	if eventRecipe.DoneTime != nil {
+		proto.DoneTime = timestamppb.New(*eventRecipe.DoneTime)
+	}
+
 	// Generate name
 	if eventRecipe.EventRecipeId.EventRecipeId != 0 {
 		name, err := s.eventRecipeNamer.Format(eventRecipe, options...)
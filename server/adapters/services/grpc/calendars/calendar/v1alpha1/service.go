@@ -27,6 +27,7 @@ type NewCalendarServiceParams struct {
 	EventNamer                namer.ReflectNamer    `name:"v1alpha1EventNamer"`
 	EventRecipeNamer          namer.ReflectNamer    `name:"v1alpha1EventRecipeNamer"`
 	RecipeNamer               namer.ReflectNamer    `name:"v1alpha1RecipeNamer"`
+	RecipeCookLogNamer        namer.ReflectNamer    `name:"v1alpha1RecipeCookLogNamer"`
 	EventRecipeFieldMasker    fieldmask.FieldMasker `name:"v1alpha1EventRecipeFieldMasker"`
 }
 
@@ -45,6 +46,7 @@ func NewCalendarService(params NewCalendarServiceParams) (*CalendarService, erro
 		eventNamer:                params.EventNamer,
 		eventRecipeNamer:          params.EventRecipeNamer,
 		recipeNamer:               params.RecipeNamer,
+		recipeCookLogNamer:        params.RecipeCookLogNamer,
 		eventRecipeFieldMasker:    params.EventRecipeFieldMasker,
 	}, nil
 }
@@ -67,6 +69,7 @@ type CalendarService struct {
 	eventNamer                namer.ReflectNamer
 	eventRecipeNamer          namer.ReflectNamer
 	recipeNamer               namer.ReflectNamer
+	recipeCookLogNamer        namer.ReflectNamer
 	eventRecipeFieldMasker    fieldmask.FieldMasker
 }
 
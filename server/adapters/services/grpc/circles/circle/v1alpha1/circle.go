@@ -24,6 +24,7 @@ var circleFieldMap = map[string][]string{
 	"description": {model.CircleField_Description},
 	"handle":      {model.CircleField_Handle},
 	"image_uri":   {model.CircleField_ImageURI},
+	"images":      {model.CircleField_ImageSet},
 	"visibility":  {model.CircleField_Visibility},
 
 	"circle_access": {model.CircleField_CircleAccess},
@@ -287,6 +288,7 @@ func (s *CircleService) CircleToProto(circle model.Circle, nameIndex ...namer.Fo
 	proto.Description = circle.Description
 	proto.Handle = circle.Handle
 	proto.ImageUri = circle.ImageURI
+	proto.Images = grpc.ImageSetToProto(circle.ImageSet)
 	proto.Visibility = circle.VisibilityLevel
 	proto.Favorited = circle.Favorited
 
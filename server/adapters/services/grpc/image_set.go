@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+var imageSizeToProto = map[model.ImageSize]types.ImageSet_Size{
+	model.ImageSize_Thumbnail: types.ImageSet_SIZE_THUMBNAIL,
+	model.ImageSize_Medium:    types.ImageSet_SIZE_MEDIUM,
+	model.ImageSize_Large:     types.ImageSet_SIZE_LARGE,
+}
+
+// ImageSetToProto converts the variants of an image to their proto form. It
+// returns nil when the image has no variants.
+func ImageSetToProto(imageSet model.ImageSet) *types.ImageSet {
+	if len(imageSet.Variants) == 0 {
+		return nil
+	}
+
+	proto := &types.ImageSet{
+		Placeholder: imageSet.Placeholder,
+		Width:       imageSet.Width,
+		Height:      imageSet.Height,
+		Variants:    make([]*types.ImageSet_Variant, 0, len(imageSet.Variants)),
+	}
+	for _, variant := range imageSet.Variants {
+		proto.Variants = append(proto.Variants, &types.ImageSet_Variant{
+			Size:        imageSizeToProto[variant.Size],
+			ContentType: variant.ContentType,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			Uri:         variant.URI,
+		})
+	}
+	return proto
+}
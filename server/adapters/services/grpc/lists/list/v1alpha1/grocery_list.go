@@ -0,0 +1,120 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/lists/list/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GenerateGroceryList -
+func (s *ListService) GenerateGroceryList(ctx context.Context, request *pb.GenerateGroceryListRequest) (*pb.GenerateGroceryListResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GenerateGroceryList called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	mRequest := model.GroceryListRequest{
+		IncludeOptional: request.GetIncludeOptional(),
+	}
+
+	if request.GetList() != "" {
+		mList := model.List{}
+		_, err = s.listNamer.Parse(request.GetList(), &mList)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid list")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid list: %v", request.GetList())
+		}
+		mRequest.ListId = mList.Id
+	} else {
+		if request.GetNewList() != nil {
+			pbList := request.GetNewList()
+			pbList.Name = ""
+			_, mRequest.NewList, err = s.ProtoToList(pbList)
+			if err != nil {
+				log.Warn().Err(err).Msg("unable to convert proto to model")
+				return nil, status.Error(codes.InvalidArgument, "invalid new list")
+			}
+		}
+
+		_, err = s.listNamer.ParseParent(request.GetParent(), &mRequest.NewList.Parent)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid parent")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+		}
+	}
+
+	for _, source := range request.GetRecipes() {
+		mRecipe := model.Recipe{}
+		_, err = s.recipeNamer.Parse(source.GetRecipe(), &mRecipe)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid recipe")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipe: %v", source.GetRecipe())
+		}
+		mRequest.Recipes = append(mRequest.Recipes, model.GroceryListRecipe{
+			RecipeId: mRecipe.Id,
+			Servings: source.GetServings(),
+		})
+	}
+
+	if request.GetCalendar() != "" {
+		mCalendar := model.Calendar{}
+		_, err = s.calendarNamer.Parse(request.GetCalendar(), &mCalendar)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid calendar")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid calendar: %v", request.GetCalendar())
+		}
+		if request.GetStartTime() == nil || request.GetEndTime() == nil {
+			log.Warn().Msg("start and end time required with calendar")
+			return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required with calendar")
+		}
+		mRequest.CalendarId = mCalendar.CalendarId
+		mRequest.StartTime = request.GetStartTime().AsTime()
+		mRequest.EndTime = request.GetEndTime().AsTime()
+	}
+
+	mList, mListItems, err := s.domain.GenerateGroceryList(ctx, authAccount, mRequest)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GenerateGroceryList failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// convert model to proto
+	response := &pb.GenerateGroceryListResponse{
+		ListItems: make([]*pb.ListItem, 0, len(mListItems)),
+	}
+	response.List, err = s.ListToProto(mList)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+	grpc.ProcessResponseFieldBehavior(response.List)
+
+	for _, mListItem := range mListItems {
+		pbListItem, err := s.ListItemToProto(mListItem)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbListItem)
+		response.ListItems = append(response.ListItems, pbListItem)
+	}
+
+	log.Info().Msg("gRPC GenerateGroceryList success")
+	return response, nil
+}
@@ -12,6 +12,7 @@ import (
 	pb "github.com/jcfug8/daylear/server/genapi/api/lists/list/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -21,13 +22,20 @@ var (
 )
 
 var listItemFieldMap = map[string][]string{
-	"name":            {model.ListItemField_Parent, model.ListItemField_Id},
-	"title":           {model.ListItemField_Title},
-	"points":          {model.ListItemField_Points},
-	"recurrence_rule": {model.ListItemField_RecurrenceRule},
-	"list_section":    {model.ListItemField_ListSectionId},
-	"create_time":     {model.ListItemField_CreateTime},
-	"update_time":     {model.ListItemField_UpdateTime},
+	"name":              {model.ListItemField_Parent, model.ListItemField_Id},
+	"title":             {model.ListItemField_Title},
+	"points":            {model.ListItemField_Points},
+	"recurrence_rule":   {model.ListItemField_RecurrenceRule},
+	"list_section":      {model.ListItemField_ListSectionId},
+	"source_recipes":    {model.ListItemField_SourceRecipes},
+	"create_time":       {model.ListItemField_CreateTime},
+	"update_time":       {model.ListItemField_UpdateTime},
+	"completed":         {model.ListItemField_Completed},
+	"completion_window": {model.ListItemField_CompletionWindow},
+	"next_due_time":     {model.ListItemField_NextDueTime},
+	"is_overdue":        {model.ListItemField_IsOverdue},
+	"assignees":         {model.ListItemField_Assignees},
+	"claimed_by":        {model.ListItemField_ClaimedBy},
 }
 
 // CreateListItem creates a new list item
@@ -296,6 +304,16 @@ func (s *ListService) ListItemToProto(mListItem model.ListItem) (*pb.ListItem, e
 		RecurrenceRule: mListItem.RecurrenceRule,
 		CreateTime:     timestamppb.New(mListItem.CreateTime),
 		UpdateTime:     timestamppb.New(mListItem.UpdateTime),
+		Completed:      mListItem.Completed,
+		IsOverdue:      mListItem.IsOverdue,
+	}
+
+	if mListItem.CompletionWindow > 0 {
+		pbListItem.CompletionWindow = durationpb.New(mListItem.CompletionWindow)
+	}
+
+	if mListItem.NextDueTime != nil {
+		pbListItem.NextDueTime = timestamppb.New(*mListItem.NextDueTime)
 	}
 
 	// Generate the name using the namer if ID is set
@@ -315,18 +333,43 @@ func (s *ListService) ListItemToProto(mListItem model.ListItem) (*pb.ListItem, e
 		pbListItem.ListSection = name
 	}
 
+	for _, recipeId := range mListItem.SourceRecipeIds {
+		name, err := s.recipeNamer.Format(model.Recipe{Id: recipeId})
+		if err != nil {
+			return nil, err
+		}
+		pbListItem.SourceRecipes = append(pbListItem.SourceRecipes, name)
+	}
+
+	for _, assigneeId := range mListItem.AssigneeIds {
+		name, err := s.userNamer.Format(assigneeId)
+		if err != nil {
+			return nil, err
+		}
+		pbListItem.Assignees = append(pbListItem.Assignees, name)
+	}
+
+	if mListItem.ClaimedById.UserId != 0 {
+		name, err := s.userNamer.Format(mListItem.ClaimedById)
+		if err != nil {
+			return nil, err
+		}
+		pbListItem.ClaimedBy = name
+	}
+
 	return pbListItem, nil
 }
 
 // ListItemFromProto converts a proto message to a domain model
 func (s *ListService) ListItemFromProto(pbListItem *pb.ListItem, parent model.ListItemParent) (model.ListItem, error) {
 	mListItem := model.ListItem{
-		Parent:         parent,
-		Title:          pbListItem.GetTitle(),
-		Points:         pbListItem.GetPoints(),
-		RecurrenceRule: pbListItem.GetRecurrenceRule(),
-		CreateTime:     time.Now(), // Will be set by database
-		UpdateTime:     time.Now(), // Will be set by database
+		Parent:           parent,
+		Title:            pbListItem.GetTitle(),
+		Points:           pbListItem.GetPoints(),
+		RecurrenceRule:   pbListItem.GetRecurrenceRule(),
+		CompletionWindow: pbListItem.GetCompletionWindow().AsDuration(),
+		CreateTime:       time.Now(), // Will be set by database
+		UpdateTime:       time.Now(), // Will be set by database
 	}
 
 	// If this is an update operation, parse the ID from the name
@@ -344,6 +387,31 @@ func (s *ListService) ListItemFromProto(pbListItem *pb.ListItem, parent model.Li
 		}
 	}
 
+	for _, name := range pbListItem.GetSourceRecipes() {
+		mRecipe := model.Recipe{}
+		_, err := s.recipeNamer.Parse(name, &mRecipe)
+		if err != nil {
+			return model.ListItem{}, err
+		}
+		mListItem.SourceRecipeIds = append(mListItem.SourceRecipeIds, mRecipe.Id)
+	}
+
+	for _, name := range pbListItem.GetAssignees() {
+		assigneeId := model.UserId{}
+		_, err := s.userNamer.Parse(name, &assigneeId)
+		if err != nil {
+			return model.ListItem{}, err
+		}
+		mListItem.AssigneeIds = append(mListItem.AssigneeIds, assigneeId)
+	}
+
+	if pbListItem.GetClaimedBy() != "" {
+		_, err := s.userNamer.Parse(pbListItem.GetClaimedBy(), &mListItem.ClaimedById)
+		if err != nil {
+			return model.ListItem{}, err
+		}
+	}
+
 	mListItem.Parent = parent
 
 	return mListItem, nil
@@ -0,0 +1,369 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/lists/list/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	listItemCompletionMaxPageSize     int32 = 1000
+	listItemCompletionDefaultPageSize int32 = 100
+)
+
+var listItemCompletionFieldMap = map[string][]string{
+	"name":                  {model.ListItemCompletionField_Parent, model.ListItemCompletionField_Id},
+	"title":                 {model.ListItemCompletionField_Title},
+	"list_section":          {model.ListItemCompletionField_ListSectionId},
+	"points":                {model.ListItemCompletionField_Points},
+	"create_time":           {model.ListItemCompletionField_CreateTime},
+	"update_time":           {model.ListItemCompletionField_UpdateTime},
+	"user":                  {model.ListItemCompletionField_UserId},
+	"circle":                {model.ListItemCompletionField_CircleId},
+	"complete_time":         {model.ListItemCompletionField_CompleteTime},
+	"completed_by_assignee": {model.ListItemCompletionField_CompletedByAssignee},
+}
+
+// CreateListItemCompletion checks off a list item
+func (s *ListService) CreateListItemCompletion(ctx context.Context, request *pb.CreateListItemCompletionRequest) (*pb.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreateListItemCompletion called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	// Parse parent
+	var mCompletion model.ListItemCompletion
+	_, err = s.listItemCompletionNamer.ParseParent(request.GetParent(), &mCompletion.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	// Convert proto to model
+	mCompletion, err = s.ListItemCompletionFromProto(request.GetListItemCompletion(), mCompletion.Parent)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to convert proto to model")
+		return nil, status.Error(codes.InvalidArgument, "invalid list item completion data")
+	}
+
+	// Parse the pantry to restock, if any
+	var mPantry model.Pantry
+	if request.GetPantry() != "" {
+		_, err = s.pantryNamer.Parse(request.GetPantry(), &mPantry)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid pantry")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pantry: %v", request.GetPantry())
+		}
+	}
+
+	// Create list item completion
+	dbCompletion, err := s.domain.CreateListItemCompletion(ctx, authAccount, mCompletion, mPantry.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreateListItemCompletion failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Convert model to proto
+	pbCompletion, err := s.ListItemCompletionToProto(dbCompletion)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbCompletion)
+
+	log.Info().Msg("gRPC CreateListItemCompletion success")
+	return pbCompletion, nil
+}
+
+// GetListItemCompletion retrieves a list item completion
+func (s *ListService) GetListItemCompletion(ctx context.Context, request *pb.GetListItemCompletionRequest) (*pb.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetListItemCompletion called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Parse name to get parent and ID
+	var mCompletion model.ListItemCompletion
+	_, err = s.listItemCompletionNamer.Parse(request.GetName(), &mCompletion)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	// Get list item completion
+	dbCompletion, err := s.domain.GetListItemCompletion(ctx, authAccount, mCompletion.Parent, mCompletion.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetListItemCompletion failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Convert model to proto
+	pbCompletion, err := s.ListItemCompletionToProto(dbCompletion)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbCompletion)
+
+	log.Info().Msg("gRPC GetListItemCompletion success")
+	return pbCompletion, nil
+}
+
+// ListListItemCompletions lists the completions of a list item
+func (s *ListService) ListListItemCompletions(ctx context.Context, request *pb.ListListItemCompletionsRequest) (*pb.ListListItemCompletionsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListListItemCompletions called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Parse parent
+	var mCompletion model.ListItemCompletion
+	_, err = s.listItemCompletionNamer.ParseParent(request.GetParent(), &mCompletion.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	// Setup pagination
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: listItemCompletionDefaultPageSize,
+		MaxPageSize:     listItemCompletionMaxPageSize,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("pagination setup failed")
+		return nil, err
+	}
+
+	// List list item completions
+	dbCompletions, err := s.domain.ListListItemCompletions(ctx, authAccount, mCompletion.Parent, pageSize, int32(pageToken.Offset), request.GetFilter(), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListListItemCompletions failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Convert models to protos
+	completionProtos := make([]*pb.ListItemCompletion, len(dbCompletions))
+	for i, dbCompletion := range dbCompletions {
+		completionProto, err := s.ListItemCompletionToProto(dbCompletion)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		completionProtos[i] = completionProto
+	}
+
+	// Check field behavior
+	for _, completionProto := range completionProtos {
+		grpc.ProcessResponseFieldBehavior(completionProto)
+	}
+
+	// Create response
+	response := &pb.ListListItemCompletionsResponse{
+		ListItemCompletions: completionProtos,
+	}
+
+	// Add next page token if there are more results
+	if len(dbCompletions) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListListItemCompletions success")
+	return response, nil
+}
+
+// UpdateListItemCompletion updates an existing list item completion
+func (s *ListService) UpdateListItemCompletion(ctx context.Context, request *pb.UpdateListItemCompletionRequest) (*pb.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateListItemCompletion called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	// Parse name to get parent and ID
+	var mCompletion model.ListItemCompletion
+	_, err = s.listItemCompletionNamer.Parse(request.GetListItemCompletion().GetName(), &mCompletion)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetListItemCompletion().GetName())
+	}
+
+	// Convert proto to model
+	mCompletion, err = s.ListItemCompletionFromProto(request.GetListItemCompletion(), mCompletion.Parent)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to convert proto to model")
+		return nil, status.Error(codes.InvalidArgument, "invalid list item completion data")
+	}
+
+	// Process field mask
+	fieldMask := request.GetUpdateMask()
+	fields := s.listItemCompletionFieldMasker.Convert(fieldMask.GetPaths())
+
+	// Update list item completion
+	dbCompletion, err := s.domain.UpdateListItemCompletion(ctx, authAccount, mCompletion, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateListItemCompletion failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Convert model to proto
+	pbCompletion, err := s.ListItemCompletionToProto(dbCompletion)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbCompletion)
+
+	log.Info().Msg("gRPC UpdateListItemCompletion success")
+	return pbCompletion, nil
+}
+
+// DeleteListItemCompletion deletes a list item completion
+func (s *ListService) DeleteListItemCompletion(ctx context.Context, request *pb.DeleteListItemCompletionRequest) (*pb.ListItemCompletion, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteListItemCompletion called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Parse name to get parent and ID
+	var mCompletion model.ListItemCompletion
+	_, err = s.listItemCompletionNamer.Parse(request.GetName(), &mCompletion)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	// Delete list item completion
+	dbCompletion, err := s.domain.DeleteListItemCompletion(ctx, authAccount, mCompletion.Parent, mCompletion.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteListItemCompletion failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Convert model to proto
+	pbCompletion, err := s.ListItemCompletionToProto(dbCompletion)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbCompletion)
+
+	log.Info().Msg("gRPC DeleteListItemCompletion success")
+	return pbCompletion, nil
+}
+
+// ListItemCompletionToProto converts a domain model to a proto message
+func (s *ListService) ListItemCompletionToProto(mCompletion model.ListItemCompletion) (*pb.ListItemCompletion, error) {
+	pbCompletion := &pb.ListItemCompletion{
+		Title:               mCompletion.Title,
+		Points:              mCompletion.Points,
+		CompleteTime:        timestamppb.New(mCompletion.CompleteTime),
+		CreateTime:          timestamppb.New(mCompletion.CreateTime),
+		UpdateTime:          timestamppb.New(mCompletion.UpdateTime),
+		CompletedByAssignee: mCompletion.CompletedByAssignee,
+	}
+
+	// Generate the name using the namer if ID is set
+	if mCompletion.Id.ListItemCompletionId != 0 {
+		name, err := s.listItemCompletionNamer.Format(mCompletion)
+		if err != nil {
+			return nil, err
+		}
+		pbCompletion.Name = name
+	}
+
+	if mCompletion.ListSectionId != 0 {
+		name, err := s.listSectionNamer.Format(model.ListItem{Parent: model.ListItemParent{ListId: mCompletion.Parent.ListId}, ListSectionId: mCompletion.ListSectionId}, namer.AsPatternIndex(-1))
+		if err != nil {
+			return nil, err
+		}
+		pbCompletion.ListSection = name
+	}
+
+	if mCompletion.UserId.UserId != 0 {
+		name, err := s.userNamer.Format(mCompletion.UserId)
+		if err != nil {
+			return nil, err
+		}
+		pbCompletion.User = name
+	}
+
+	if mCompletion.CircleId.CircleId != 0 {
+		name, err := s.circleNamer.Format(mCompletion.CircleId)
+		if err != nil {
+			return nil, err
+		}
+		pbCompletion.Circle = name
+	}
+
+	return pbCompletion, nil
+}
+
+// ListItemCompletionFromProto converts a proto message to a domain model
+func (s *ListService) ListItemCompletionFromProto(pbCompletion *pb.ListItemCompletion, parent model.ListItemCompletionParent) (model.ListItemCompletion, error) {
+	mCompletion := model.ListItemCompletion{}
+
+	// If this is an update operation, parse the ID from the name
+	if pbCompletion.GetName() != "" {
+		_, err := s.listItemCompletionNamer.Parse(pbCompletion.GetName(), &mCompletion)
+		if err != nil {
+			return model.ListItemCompletion{}, err
+		}
+	}
+
+	if pbCompletion.GetCompleteTime() != nil {
+		mCompletion.CompleteTime = pbCompletion.GetCompleteTime().AsTime()
+	}
+
+	mCompletion.Parent = parent
+
+	return mCompletion, nil
+}
@@ -15,6 +15,7 @@ var Module = fx.Module(
 		func(s *ListService) pb.ListServiceServer { return s },
 		func(s *ListService) pb.ListAccessServiceServer { return s },
 		func(s *ListService) pb.ListItemServiceServer { return s },
+		func(s *ListService) pb.ListItemCompletionServiceServer { return s },
 		fx.Annotate(
 			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Access]() },
 			fx.ResultTags(`name:"v1alpha1ListAccessNamer"`),
@@ -33,6 +34,10 @@ var Module = fx.Module(
 			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.ListItem]() },
 			fx.ResultTags(`name:"v1alpha1ListItemNamer"`),
 		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.ListItemCompletion]() },
+			fx.ResultTags(`name:"v1alpha1ListItemCompletionNamer"`),
+		),
 		fx.Annotate(
 			func() (fieldmask.FieldMasker, error) {
 				return fieldmask.NewProtoFieldMasker(&pb.List{}, listFieldMap)
@@ -53,5 +58,12 @@ var Module = fx.Module(
 			},
 			fx.ResultTags(`name:"v1alpha1ListItemFieldMasker"`),
 		),
+
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.ListItemCompletion{}, listItemCompletionFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1ListItemCompletionFieldMasker"`),
+		),
 	),
 )
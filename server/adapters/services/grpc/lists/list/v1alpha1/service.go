@@ -14,33 +14,43 @@ import (
 type NewListServiceParams struct {
 	fx.In
 
-	Domain              domain.Domain
-	Log                 zerolog.Logger
-	ListFieldMasker     fieldmask.FieldMasker `name:"v1alpha1ListFieldMasker"`
-	AccessFieldMasker   fieldmask.FieldMasker `name:"v1alpha1ListAccessFieldMasker"`
-	ListItemFieldMasker fieldmask.FieldMasker `name:"v1alpha1ListItemFieldMasker"`
-	ListNamer           namer.ReflectNamer    `name:"v1alpha1ListNamer"`
-	ListSectionNamer    namer.ReflectNamer    `name:"v1alpha1ListSectionNamer"`
-	ListItemNamer       namer.ReflectNamer    `name:"v1alpha1ListItemNamer"`
-	AccessNamer         namer.ReflectNamer    `name:"v1alpha1ListAccessNamer"`
-	UserNamer           namer.ReflectNamer    `name:"v1alpha1UserNamer"`
-	CircleNamer         namer.ReflectNamer    `name:"v1alpha1CircleNamer"`
+	Domain                        domain.Domain
+	Log                           zerolog.Logger
+	ListFieldMasker               fieldmask.FieldMasker `name:"v1alpha1ListFieldMasker"`
+	AccessFieldMasker             fieldmask.FieldMasker `name:"v1alpha1ListAccessFieldMasker"`
+	ListItemFieldMasker           fieldmask.FieldMasker `name:"v1alpha1ListItemFieldMasker"`
+	ListItemCompletionFieldMasker fieldmask.FieldMasker `name:"v1alpha1ListItemCompletionFieldMasker"`
+	ListNamer                     namer.ReflectNamer    `name:"v1alpha1ListNamer"`
+	ListSectionNamer              namer.ReflectNamer    `name:"v1alpha1ListSectionNamer"`
+	ListItemNamer                 namer.ReflectNamer    `name:"v1alpha1ListItemNamer"`
+	ListItemCompletionNamer       namer.ReflectNamer    `name:"v1alpha1ListItemCompletionNamer"`
+	AccessNamer                   namer.ReflectNamer    `name:"v1alpha1ListAccessNamer"`
+	UserNamer                     namer.ReflectNamer    `name:"v1alpha1UserNamer"`
+	CircleNamer                   namer.ReflectNamer    `name:"v1alpha1CircleNamer"`
+	RecipeNamer                   namer.ReflectNamer    `name:"v1alpha1RecipeNamer"`
+	CalendarNamer                 namer.ReflectNamer    `name:"v1alpha1CalendarNamer"`
+	PantryNamer                   namer.ReflectNamer    `name:"v1alpha1PantryNamer"`
 }
 
 // NewListService creates a new ListService.
 func NewListService(params NewListServiceParams) (*ListService, error) {
 	return &ListService{
-		domain:              params.Domain,
-		log:                 params.Log,
-		listFieldMasker:     params.ListFieldMasker,
-		accessFieldMasker:   params.AccessFieldMasker,
-		listItemFieldMasker: params.ListItemFieldMasker,
-		listNamer:           params.ListNamer,
-		listSectionNamer:    params.ListSectionNamer,
-		listItemNamer:       params.ListItemNamer,
-		userNamer:           params.UserNamer,
-		accessNamer:         params.AccessNamer,
-		circleNamer:         params.CircleNamer,
+		domain:                        params.Domain,
+		log:                           params.Log,
+		listFieldMasker:               params.ListFieldMasker,
+		accessFieldMasker:             params.AccessFieldMasker,
+		listItemFieldMasker:           params.ListItemFieldMasker,
+		listItemCompletionFieldMasker: params.ListItemCompletionFieldMasker,
+		listNamer:                     params.ListNamer,
+		listSectionNamer:              params.ListSectionNamer,
+		listItemNamer:                 params.ListItemNamer,
+		listItemCompletionNamer:       params.ListItemCompletionNamer,
+		userNamer:                     params.UserNamer,
+		accessNamer:                   params.AccessNamer,
+		circleNamer:                   params.CircleNamer,
+		recipeNamer:                   params.RecipeNamer,
+		calendarNamer:                 params.CalendarNamer,
+		pantryNamer:                   params.PantryNamer,
 	}, nil
 }
 
@@ -49,15 +59,21 @@ type ListService struct {
 	pb.UnimplementedListServiceServer
 	pb.UnimplementedListAccessServiceServer
 	pb.UnimplementedListItemServiceServer
-	domain              domain.Domain
-	log                 zerolog.Logger
-	listFieldMasker     fieldmask.FieldMasker
-	accessFieldMasker   fieldmask.FieldMasker
-	listItemFieldMasker fieldmask.FieldMasker
-	listNamer           namer.ReflectNamer
-	listSectionNamer    namer.ReflectNamer
-	listItemNamer       namer.ReflectNamer
-	userNamer           namer.ReflectNamer
-	circleNamer         namer.ReflectNamer
-	accessNamer         namer.ReflectNamer
+	pb.UnimplementedListItemCompletionServiceServer
+	domain                        domain.Domain
+	log                           zerolog.Logger
+	listFieldMasker               fieldmask.FieldMasker
+	accessFieldMasker             fieldmask.FieldMasker
+	listItemFieldMasker           fieldmask.FieldMasker
+	listItemCompletionFieldMasker fieldmask.FieldMasker
+	listNamer                     namer.ReflectNamer
+	listSectionNamer              namer.ReflectNamer
+	listItemNamer                 namer.ReflectNamer
+	listItemCompletionNamer       namer.ReflectNamer
+	userNamer                     namer.ReflectNamer
+	circleNamer                   namer.ReflectNamer
+	accessNamer                   namer.ReflectNamer
+	recipeNamer                   namer.ReflectNamer
+	calendarNamer                 namer.ReflectNamer
+	pantryNamer                   namer.ReflectNamer
 }
@@ -0,0 +1,293 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	cookbookMaxPageSize     int32 = 1000
+	cookbookDefaultPageSize int32 = 100
+)
+
+var cookbookFieldMap = map[string][]string{
+	"name":        {model.CookbookField_Parent, model.CookbookField_Id},
+	"title":       {model.CookbookField_Title},
+	"description": {model.CookbookField_Description},
+	"visibility":  {model.CookbookField_VisibilityLevel},
+	"create_time": {model.CookbookField_CreateTime},
+	"update_time": {model.CookbookField_UpdateTime},
+
+	"cookbook_access": {model.CookbookField_CookbookAccess},
+}
+
+// CreateCookbook -
+func (s *CookbookService) CreateCookbook(ctx context.Context, request *pb.CreateCookbookRequest) (response *pb.Cookbook, err error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreateCookbook called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	// convert proto to model
+	pbCookbook := request.GetCookbook()
+	pbCookbook.Name = ""
+	mCookbook, err := s.ProtoToCookbook(pbCookbook)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to convert proto to model")
+		return nil, status.Error(codes.InvalidArgument, "invalid request data")
+	}
+
+	_, err = s.cookbookNamer.ParseParent(request.GetParent(), &mCookbook.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	// create cookbook
+	mCookbook, err = s.domain.CreateCookbook(ctx, authAccount, mCookbook)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreateCookbook failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// convert model to proto
+	pbCookbook, err = s.CookbookToProto(mCookbook)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	grpc.ProcessResponseFieldBehavior(pbCookbook)
+	log.Info().Msg("gRPC CreateCookbook success")
+	return pbCookbook, nil
+}
+
+// DeleteCookbook -
+func (s *CookbookService) DeleteCookbook(ctx context.Context, request *pb.DeleteCookbookRequest) (*pb.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteCookbook called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCookbook := model.Cookbook{}
+	_, err = s.cookbookNamer.Parse(request.GetName(), &mCookbook)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mCookbook, err = s.domain.DeleteCookbook(ctx, authAccount, mCookbook.Parent, mCookbook.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteCookbook failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCookbook, err := s.CookbookToProto(mCookbook)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCookbook)
+	log.Info().Msg("gRPC DeleteCookbook success")
+	return pbCookbook, nil
+}
+
+// GetCookbook -
+func (s *CookbookService) GetCookbook(ctx context.Context, request *pb.GetCookbookRequest) (*pb.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetCookbook called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCookbook := model.Cookbook{}
+	_, err = s.cookbookNamer.Parse(request.GetName(), &mCookbook)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mCookbook, err = s.domain.GetCookbook(ctx, authAccount, mCookbook.Parent, mCookbook.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetCookbook failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCookbook, err := s.CookbookToProto(mCookbook)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCookbook)
+	log.Info().Msg("gRPC GetCookbook success")
+	return pbCookbook, nil
+}
+
+// UpdateCookbook -
+func (s *CookbookService) UpdateCookbook(ctx context.Context, request *pb.UpdateCookbookRequest) (*pb.Cookbook, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateCookbook called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	fieldMask := request.GetUpdateMask()
+	updateMask := s.cookbookFieldMasker.Convert(fieldMask.GetPaths())
+
+	pbCookbook := request.GetCookbook()
+	mCookbook, err := s.ProtoToCookbook(pbCookbook)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to convert proto to model")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", pbCookbook.GetName())
+	}
+
+	mCookbook, err = s.domain.UpdateCookbook(ctx, authAccount, mCookbook, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateCookbook failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCookbook, err = s.CookbookToProto(mCookbook)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCookbook)
+	log.Info().Msg("gRPC UpdateCookbook success")
+	return pbCookbook, nil
+}
+
+// ListCookbooks -
+func (s *CookbookService) ListCookbooks(ctx context.Context, request *pb.ListCookbooksRequest) (*pb.ListCookbooksResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListCookbooks called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCookbookParent := model.CookbookParent{}
+	_, err = s.cookbookNamer.ParseParent(request.GetParent(), &mCookbookParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: cookbookDefaultPageSize,
+		MaxPageSize:     cookbookMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListCookbooks(ctx, authAccount, mCookbookParent, request.GetPageSize(), int32(pageToken.Offset), request.GetFilter(), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListCookbooks failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cookbooks := make([]*pb.Cookbook, len(res))
+	for i, cookbook := range res {
+		pbCookbook, err := s.CookbookToProto(cookbook)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbCookbook)
+		cookbooks[i] = pbCookbook
+	}
+
+	response := &pb.ListCookbooksResponse{
+		Cookbooks: cookbooks,
+	}
+
+	if len(cookbooks) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListCookbooks success")
+	return response, nil
+}
+
+// ProtoToCookbook converts a protobuf Cookbook to a model Cookbook
+func (s *CookbookService) ProtoToCookbook(proto *pb.Cookbook) (model.Cookbook, error) {
+	cookbook := model.Cookbook{}
+	if proto.GetName() != "" {
+		_, err := s.cookbookNamer.Parse(proto.GetName(), &cookbook)
+		if err != nil {
+			return cookbook, err
+		}
+	}
+
+	cookbook.Title = proto.GetTitle()
+	cookbook.Description = proto.GetDescription()
+	cookbook.VisibilityLevel = proto.GetVisibility()
+
+	return cookbook, nil
+}
+
+// CookbookToProto converts a model Cookbook to a protobuf Cookbook
+func (s *CookbookService) CookbookToProto(cookbook model.Cookbook) (*pb.Cookbook, error) {
+	proto := &pb.Cookbook{
+		Title:       cookbook.Title,
+		Description: cookbook.Description,
+		Visibility:  cookbook.VisibilityLevel,
+		CreateTime:  timestamppb.New(cookbook.CreateTime),
+		UpdateTime:  timestamppb.New(cookbook.UpdateTime),
+	}
+
+	if cookbook.Id.CookbookId != 0 {
+		name, err := s.cookbookNamer.Format(cookbook)
+		if err != nil {
+			return proto, err
+		}
+		proto.Name = name
+	}
+
+	if (cookbook.CookbookAccess != model.CookbookAccess{}) {
+		name, err := s.accessNamer.Format(cookbook.CookbookAccess)
+		if err == nil {
+			proto.CookbookAccess = &pb.Cookbook_CookbookAccess{
+				Name:            name,
+				PermissionLevel: cookbook.CookbookAccess.PermissionLevel,
+				State:           cookbook.CookbookAccess.State,
+				AcceptTarget:    cookbook.CookbookAccess.AcceptTarget,
+			}
+		}
+	}
+
+	return proto, nil
+}
@@ -0,0 +1,387 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	accessMaxPageSize     int32 = 1000
+	accessDefaultPageSize int32 = 100
+)
+
+var cookbookAccessFieldMap = map[string][]string{
+	"name":          {model.CookbookAccessField_Parent, model.CookbookAccessField_Id},
+	"level":         {model.CookbookAccessField_PermissionLevel},
+	"state":         {model.CookbookAccessField_State},
+	"accept_target": {model.CookbookAccessField_AcceptTarget},
+	"requester":     {model.CookbookAccessField_Requester},
+	"recipient":     {model.CookbookAccessField_Recipient},
+}
+
+func (s *CookbookService) CreateAccess(ctx context.Context, request *pb.CreateAccessRequest) (*pb.Access, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreateAccess called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid access proto")
+		return nil, err
+	}
+
+	// convert proto to model
+	pbAccess := request.GetAccess()
+	pbAccess.Name = ""
+	modelAccess, err := s.ProtoToCookbookAccess(pbAccess)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to convert proto to model")
+		return nil, status.Error(codes.InvalidArgument, "invalid request data")
+	}
+
+	_, err = s.accessNamer.ParseParent(request.GetParent(), &modelAccess.CookbookAccessParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	// create access
+	modelAccess, err = s.domain.CreateCookbookAccess(ctx, authAccount, modelAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreateCookbookAccess failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// convert model to proto
+	pbAccess, err = s.CookbookAccessToProto(modelAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	grpc.ProcessResponseFieldBehavior(pbAccess)
+	log.Info().Msg("gRPC CreateAccess success")
+	return pbAccess, nil
+}
+
+func (s *CookbookService) DeleteAccess(ctx context.Context, request *pb.DeleteAccessRequest) (*emptypb.Empty, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteAccess called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	modelAccess := model.CookbookAccess{}
+	_, err = s.accessNamer.Parse(request.GetName(), &modelAccess)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	err = s.domain.DeleteCookbookAccess(ctx, authAccount, modelAccess.CookbookAccessParent, modelAccess.CookbookAccessId)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteCookbookAccess failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.Info().Msg("gRPC DeleteAccess success")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CookbookService) GetAccess(ctx context.Context, request *pb.GetAccessRequest) (*pb.Access, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetAccess called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	modelAccess := model.CookbookAccess{}
+	_, err = s.accessNamer.Parse(request.GetName(), &modelAccess)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	modelAccess, err = s.domain.GetCookbookAccess(ctx, authAccount, modelAccess.CookbookAccessParent, modelAccess.CookbookAccessId, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetCookbookAccess failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbAccess, err := s.CookbookAccessToProto(modelAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC GetAccess success")
+	return pbAccess, nil
+}
+
+func (s *CookbookService) ListAccesses(ctx context.Context, request *pb.ListAccessesRequest) (*pb.ListAccessesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListAccesses called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	modelAccessParent := model.CookbookAccessParent{}
+	_, err = s.accessNamer.ParseParent(request.GetParent(), &modelAccessParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: accessDefaultPageSize,
+		MaxPageSize:     accessMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListCookbookAccesses(ctx, authAccount, modelAccessParent, request.GetPageSize(), pageToken.Offset, request.GetFilter(), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListCookbookAccesses failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	accesses := make([]*pb.Access, len(res))
+	for i, access := range res {
+		accessProto, err := s.CookbookAccessToProto(access)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		accesses[i] = accessProto
+	}
+
+	// check field behavior
+	for _, accessProto := range accesses {
+		grpc.ProcessResponseFieldBehavior(accessProto)
+	}
+
+	response := &pb.ListAccessesResponse{
+		Accesses: accesses,
+	}
+
+	if len(accesses) > 0 {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListAccesses success")
+	return response, nil
+}
+
+func (s *CookbookService) UpdateAccess(ctx context.Context, request *pb.UpdateAccessRequest) (*pb.Access, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateAccess called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	fieldMask := request.GetUpdateMask()
+	updateMask := s.accessFieldMasker.Convert(fieldMask.GetPaths())
+
+	accessProto := request.GetAccess()
+	modelAccess, err := s.ProtoToCookbookAccess(accessProto)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to convert proto to model")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	modelAccess, err = s.domain.UpdateCookbookAccess(ctx, authAccount, modelAccess, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateCookbookAccess failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	accessProto, err = s.CookbookAccessToProto(modelAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC UpdateAccess success")
+	return accessProto, nil
+}
+
+func (s *CookbookService) AcceptCookbookAccess(ctx context.Context, request *pb.AcceptCookbookAccessRequest) (*pb.AcceptCookbookAccessResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC AcceptAccess called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	modelAccess := model.CookbookAccess{}
+	_, err = s.accessNamer.Parse(request.GetName(), &modelAccess)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	modelAccess, err = s.domain.AcceptCookbookAccess(ctx, authAccount, modelAccess.CookbookAccessParent, modelAccess.CookbookAccessId)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.AcceptCookbookAccess failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.Info().Msg("gRPC AcceptCookbookAccess success")
+	return &pb.AcceptCookbookAccessResponse{}, nil
+}
+
+func (s *CookbookService) ProtoToCookbookAccess(pbAccess *pb.Access) (model.CookbookAccess, error) {
+	modelAccess := model.CookbookAccess{
+		PermissionLevel: pbAccess.GetLevel(),
+		State:           pbAccess.GetState(),
+		AcceptTarget:    pbAccess.GetAcceptTarget(),
+	}
+
+	if pbAccess.GetName() != "" {
+		_, err := s.accessNamer.Parse(pbAccess.GetName(), &modelAccess)
+		if err != nil {
+			return model.CookbookAccess{}, status.Errorf(codes.InvalidArgument, "invalid name: %v", err)
+		}
+	}
+
+	switch pbrequester := pbAccess.GetRequester().GetName().(type) {
+	case *pb.Access_RequesterOrRecipient_User:
+		modelAccess.Requester = model.CookbookRecipientOrRequester{}
+		if pbrequester.User != nil {
+			_, err := s.userNamer.Parse(pbrequester.User.Name, &modelAccess.Requester)
+			if err != nil {
+				return model.CookbookAccess{}, status.Errorf(codes.InvalidArgument, "invalid requester: %v", err)
+			}
+		}
+	case *pb.Access_RequesterOrRecipient_Circle:
+		modelAccess.Requester = model.CookbookRecipientOrRequester{}
+		if pbrequester.Circle != nil {
+			_, err := s.circleNamer.Parse(pbrequester.Circle.Name, &modelAccess.Requester)
+			if err != nil {
+				return model.CookbookAccess{}, status.Errorf(codes.InvalidArgument, "invalid requester: %v", err)
+			}
+		}
+	}
+
+	switch pbRecipient := pbAccess.GetRecipient().GetName().(type) {
+	case *pb.Access_RequesterOrRecipient_User:
+		modelAccess.Recipient = model.CookbookRecipientOrRequester{}
+		if pbRecipient.User != nil {
+			_, err := s.userNamer.Parse(pbRecipient.User.Name, &modelAccess.Recipient)
+			if err != nil {
+				return model.CookbookAccess{}, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+			}
+		}
+	case *pb.Access_RequesterOrRecipient_Circle:
+		modelAccess.Recipient = model.CookbookRecipientOrRequester{}
+		if pbRecipient.Circle != nil {
+			_, err := s.circleNamer.Parse(pbRecipient.Circle.Name, &modelAccess.Recipient)
+			if err != nil {
+				return model.CookbookAccess{}, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+			}
+		}
+	}
+
+	return modelAccess, nil
+}
+
+func (s *CookbookService) CookbookAccessToProto(modelAccess model.CookbookAccess) (*pb.Access, error) {
+	pbAccess := &pb.Access{
+		Level:        modelAccess.PermissionLevel,
+		State:        modelAccess.State,
+		AcceptTarget: modelAccess.AcceptTarget,
+	}
+
+	if modelAccess.CookbookId.CookbookId != 0 && modelAccess.CookbookAccessId.CookbookAccessId != 0 {
+		name, err := s.accessNamer.Format(modelAccess)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to format access: %v", err)
+		}
+		pbAccess.Name = name
+	}
+
+	if modelAccess.Requester.UserId != 0 {
+		userName, err := s.userNamer.Format(modelAccess.Requester)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to format requester: %v", err)
+		}
+		pbAccess.Requester = &pb.Access_RequesterOrRecipient{
+			Name: &pb.Access_RequesterOrRecipient_User{
+				User: &pb.Access_User{
+					Name: userName,
+				},
+			},
+		}
+	} else if modelAccess.Requester.CircleId != 0 {
+		circleName, err := s.circleNamer.Format(modelAccess.Requester)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to format requester: %v", err)
+		}
+		pbAccess.Requester = &pb.Access_RequesterOrRecipient{
+			Name: &pb.Access_RequesterOrRecipient_Circle{
+				Circle: &pb.Access_Circle{
+					Name: circleName,
+				},
+			},
+		}
+	}
+
+	if modelAccess.Recipient.UserId != 0 {
+		userName, err := s.userNamer.Format(modelAccess.Recipient)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to format recipient: %v", err)
+		}
+		pbAccess.Recipient = &pb.Access_RequesterOrRecipient{
+			Name: &pb.Access_RequesterOrRecipient_User{
+				User: &pb.Access_User{
+					Name:       userName,
+					Username:   modelAccess.RecipientUsername,
+					GivenName:  modelAccess.RecipientGivenName,
+					FamilyName: modelAccess.RecipientFamilyName,
+				},
+			},
+		}
+	} else if modelAccess.Recipient.CircleId != 0 {
+		circleName, err := s.circleNamer.Format(modelAccess.Recipient)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to format recipient: %v", err)
+		}
+		pbAccess.Recipient = &pb.Access_RequesterOrRecipient{
+			Name: &pb.Access_RequesterOrRecipient_Circle{
+				Circle: &pb.Access_Circle{
+					Name:   circleName,
+					Title:  modelAccess.RecipientCircleTitle,
+					Handle: modelAccess.RecipientCircleHandle,
+				},
+			},
+		}
+	}
+
+	return pbAccess, nil
+}
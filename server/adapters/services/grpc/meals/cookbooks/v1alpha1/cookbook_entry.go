@@ -0,0 +1,358 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	cookbookEntryMaxPageSize     int32 = 100
+	cookbookEntryDefaultPageSize int32 = 50
+)
+
+var cookbookEntryFieldMap = map[string][]string{
+	"name":        {model.CookbookEntryField_Parent, model.CookbookEntryField_Id},
+	"recipe":      {model.CookbookEntryField_RecipeId},
+	"note":        {model.CookbookEntryField_Note},
+	"position":    {model.CookbookEntryField_Position},
+	"create_time": {model.CookbookEntryField_CreateTime},
+}
+
+// AddCookbookEntry -
+func (s *CookbookService) AddCookbookEntry(ctx context.Context, request *pb.AddCookbookEntryRequest) (*pb.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC AddCookbookEntry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	pbEntry := request.GetCookbookEntry()
+	pbEntry.Name = ""
+	mEntry, err := s.ProtoToCookbookEntry(pbEntry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid cookbook entry")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cookbook entry: %v", err)
+	}
+
+	_, err = s.cookbookEntryNamer.ParseParent(request.GetParent(), &mEntry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	mEntry, err = s.domain.AddCookbookEntry(ctx, authAccount, mEntry)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.AddCookbookEntry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbEntry, err = s.CookbookEntryToProto(mEntry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbEntry)
+
+	log.Info().Msg("gRPC AddCookbookEntry success")
+	return pbEntry, nil
+}
+
+// ListCookbookEntries -
+func (s *CookbookService) ListCookbookEntries(ctx context.Context, request *pb.ListCookbookEntriesRequest) (*pb.ListCookbookEntriesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListCookbookEntries called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.CookbookEntryParent{}
+	_, err = s.cookbookEntryNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: cookbookEntryDefaultPageSize,
+		MaxPageSize:     cookbookEntryMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListCookbookEntries(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListCookbookEntries failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbEntries, err := s.CookbookEntriesToProto(res)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	response := &pb.ListCookbookEntriesResponse{
+		CookbookEntries: pbEntries,
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListCookbookEntries success")
+	return response, nil
+}
+
+// GetCookbookEntry -
+func (s *CookbookService) GetCookbookEntry(ctx context.Context, request *pb.GetCookbookEntryRequest) (*pb.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetCookbookEntry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mEntry := model.CookbookEntry{}
+	_, err = s.cookbookEntryNamer.Parse(request.GetName(), &mEntry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mEntry, err = s.domain.GetCookbookEntry(ctx, authAccount, mEntry.Parent, mEntry.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetCookbookEntry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbEntry, err := s.CookbookEntryToProto(mEntry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbEntry)
+
+	log.Info().Msg("gRPC GetCookbookEntry success")
+	return pbEntry, nil
+}
+
+// UpdateCookbookEntry -
+func (s *CookbookService) UpdateCookbookEntry(ctx context.Context, request *pb.UpdateCookbookEntryRequest) (*pb.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateCookbookEntry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessUpdateRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mEntry, err := s.ProtoToCookbookEntry(request.GetCookbookEntry())
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid cookbook entry")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cookbook entry: %v", err)
+	}
+
+	updateMask := s.cookbookEntryFieldMasker.Convert(request.GetUpdateMask().GetPaths())
+
+	mEntry, err = s.domain.UpdateCookbookEntry(ctx, authAccount, mEntry, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateCookbookEntry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbEntry, err := s.CookbookEntryToProto(mEntry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbEntry)
+
+	log.Info().Msg("gRPC UpdateCookbookEntry success")
+	return pbEntry, nil
+}
+
+// RemoveCookbookEntry -
+func (s *CookbookService) RemoveCookbookEntry(ctx context.Context, request *pb.RemoveCookbookEntryRequest) (*pb.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC RemoveCookbookEntry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mEntry := model.CookbookEntry{}
+	_, err = s.cookbookEntryNamer.Parse(request.GetName(), &mEntry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mEntry, err = s.domain.RemoveCookbookEntry(ctx, authAccount, mEntry.Parent, mEntry.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.RemoveCookbookEntry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbEntry, err := s.CookbookEntryToProto(mEntry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbEntry)
+
+	log.Info().Msg("gRPC RemoveCookbookEntry success")
+	return pbEntry, nil
+}
+
+// ReorderCookbookEntries -
+func (s *CookbookService) ReorderCookbookEntries(ctx context.Context, request *pb.ReorderCookbookEntriesRequest) (*pb.ReorderCookbookEntriesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ReorderCookbookEntries called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mParent := model.CookbookEntryParent{}
+	_, err = s.cookbookEntryNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	ids := make([]model.CookbookEntryId, len(request.GetCookbookEntries()))
+	for i, name := range request.GetCookbookEntries() {
+		mEntry := model.CookbookEntry{}
+		_, err = s.cookbookEntryNamer.Parse(name, &mEntry)
+		if err != nil || mEntry.Parent != mParent {
+			log.Warn().Err(err).Str("name", name).Msg("invalid cookbook entry")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cookbook entry: %v", name)
+		}
+		ids[i] = mEntry.Id
+	}
+
+	res, err := s.domain.ReorderCookbookEntries(ctx, authAccount, mParent, ids)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ReorderCookbookEntries failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbEntries, err := s.CookbookEntriesToProto(res)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC ReorderCookbookEntries success")
+	return &pb.ReorderCookbookEntriesResponse{
+		CookbookEntries: pbEntries,
+	}, nil
+}
+
+// ProtoToCookbookEntry converts a protobuf CookbookEntry to a model CookbookEntry
+func (s *CookbookService) ProtoToCookbookEntry(proto *pb.CookbookEntry) (model.CookbookEntry, error) {
+	entry := model.CookbookEntry{}
+	if proto.GetName() != "" {
+		_, err := s.cookbookEntryNamer.Parse(proto.GetName(), &entry)
+		if err != nil {
+			return entry, err
+		}
+	}
+
+	if proto.GetRecipe() != "" {
+		mRecipe := model.Recipe{}
+		_, err := s.recipeNamer.Parse(proto.GetRecipe(), &mRecipe)
+		if err != nil {
+			return entry, err
+		}
+		entry.RecipeId = mRecipe.Id
+	}
+
+	entry.Note = proto.GetNote()
+
+	return entry, nil
+}
+
+// CookbookEntryToProto converts a model CookbookEntry to a protobuf CookbookEntry.
+// The recipe details are only set when the recipe is accessible.
+func (s *CookbookService) CookbookEntryToProto(entry model.CookbookEntry) (*pb.CookbookEntry, error) {
+	name, err := s.cookbookEntryNamer.Format(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, err := s.recipeNamer.Format(model.Recipe{Id: entry.RecipeId})
+	if err != nil {
+		return nil, err
+	}
+
+	proto := &pb.CookbookEntry{
+		Name:       name,
+		Recipe:     recipe,
+		Note:       entry.Note,
+		Position:   entry.Position,
+		Accessible: entry.Accessible,
+		CreateTime: timestamppb.New(entry.CreateTime),
+	}
+
+	if entry.Accessible {
+		proto.RecipeTitle = entry.Recipe.Title
+		proto.RecipeImageUri = entry.Recipe.ImageURI
+	}
+
+	return proto, nil
+}
+
+// CookbookEntriesToProto converts model CookbookEntries to protobuf CookbookEntries
+func (s *CookbookService) CookbookEntriesToProto(entries []model.CookbookEntry) ([]*pb.CookbookEntry, error) {
+	pbEntries := make([]*pb.CookbookEntry, len(entries))
+	for i, entry := range entries {
+		pbEntry, err := s.CookbookEntryToProto(entry)
+		if err != nil {
+			return nil, err
+		}
+		grpc.ProcessResponseFieldBehavior(pbEntry)
+		pbEntries[i] = pbEntry
+	}
+	return pbEntries, nil
+}
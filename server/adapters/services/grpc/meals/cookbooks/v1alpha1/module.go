@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1"
+)
+
+var Module = fx.Module(
+	"cookbookGrpcAdapter",
+	fx.Provide(
+		NewCookbookService,
+		func(s *CookbookService) pb.CookbookServiceServer { return s },
+		func(s *CookbookService) pb.CookbookAccessServiceServer { return s },
+		func(s *CookbookService) pb.CookbookEntryServiceServer { return s },
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Access]() },
+			fx.ResultTags(`name:"v1alpha1CookbookAccessNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Cookbook]() },
+			fx.ResultTags(`name:"v1alpha1CookbookNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.CookbookEntry]() },
+			fx.ResultTags(`name:"v1alpha1CookbookEntryNamer"`),
+		),
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.Cookbook{}, cookbookFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1CookbookFieldMasker"`),
+		),
+
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.Access{}, cookbookAccessFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1CookbookAccessFieldMasker"`),
+		),
+
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.CookbookEntry{}, cookbookEntryFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1CookbookEntryFieldMasker"`),
+		),
+	),
+)
@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	fieldmask "github.com/jcfug8/daylear/server/core/fieldmask"
+	namer "github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+// NewCookbookServiceParams defines the dependencies for the CookbookService.
+type NewCookbookServiceParams struct {
+	fx.In
+
+	Domain                   domain.Domain
+	Log                      zerolog.Logger
+	CookbookFieldMasker      fieldmask.FieldMasker `name:"v1alpha1CookbookFieldMasker"`
+	AccessFieldMasker        fieldmask.FieldMasker `name:"v1alpha1CookbookAccessFieldMasker"`
+	CookbookEntryFieldMasker fieldmask.FieldMasker `name:"v1alpha1CookbookEntryFieldMasker"`
+	CookbookNamer            namer.ReflectNamer    `name:"v1alpha1CookbookNamer"`
+	CookbookEntryNamer       namer.ReflectNamer    `name:"v1alpha1CookbookEntryNamer"`
+	AccessNamer              namer.ReflectNamer    `name:"v1alpha1CookbookAccessNamer"`
+	UserNamer                namer.ReflectNamer    `name:"v1alpha1UserNamer"`
+	CircleNamer              namer.ReflectNamer    `name:"v1alpha1CircleNamer"`
+	RecipeNamer              namer.ReflectNamer    `name:"v1alpha1RecipeNamer"`
+}
+
+// NewCookbookService creates a new CookbookService.
+func NewCookbookService(params NewCookbookServiceParams) (*CookbookService, error) {
+	return &CookbookService{
+		domain:                   params.Domain,
+		log:                      params.Log,
+		cookbookFieldMasker:      params.CookbookFieldMasker,
+		accessFieldMasker:        params.AccessFieldMasker,
+		cookbookEntryFieldMasker: params.CookbookEntryFieldMasker,
+		cookbookNamer:            params.CookbookNamer,
+		cookbookEntryNamer:       params.CookbookEntryNamer,
+		accessNamer:              params.AccessNamer,
+		userNamer:                params.UserNamer,
+		circleNamer:              params.CircleNamer,
+		recipeNamer:              params.RecipeNamer,
+	}, nil
+}
+
+// CookbookService defines the grpc handlers for the CookbookService.
+type CookbookService struct {
+	pb.UnimplementedCookbookServiceServer
+	pb.UnimplementedCookbookAccessServiceServer
+	pb.UnimplementedCookbookEntryServiceServer
+	domain                   domain.Domain
+	log                      zerolog.Logger
+	cookbookFieldMasker      fieldmask.FieldMasker
+	accessFieldMasker        fieldmask.FieldMasker
+	cookbookEntryFieldMasker fieldmask.FieldMasker
+	cookbookNamer            namer.ReflectNamer
+	cookbookEntryNamer       namer.ReflectNamer
+	accessNamer              namer.ReflectNamer
+	userNamer                namer.ReflectNamer
+	circleNamer              namer.ReflectNamer
+	recipeNamer              namer.ReflectNamer
+}
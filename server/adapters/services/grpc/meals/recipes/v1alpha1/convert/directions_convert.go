@@ -3,6 +3,7 @@ package convert
 import (
 	model "github.com/jcfug8/daylear/server/core/model"
 	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // ProtoToDirection converts a proto Directions to a domain Directions.
@@ -10,15 +11,72 @@ func ProtoToDirection(proto *pb.Recipe_Direction) model.RecipeDirection {
 	direction := model.RecipeDirection{}
 	direction.Steps = proto.Steps
 	direction.Title = proto.Title
+	for _, stepDetail := range proto.StepDetails {
+		direction.StepDetails = append(direction.StepDetails, ProtoToStepDetail(stepDetail))
+	}
 	return direction
 }
 
 // DirectionToProto converts a domain Directions to a proto Directions.
 func DirectionToProto(direction model.RecipeDirection) *pb.Recipe_Direction {
-	return &pb.Recipe_Direction{
+	proto := &pb.Recipe_Direction{
 		Steps: direction.Steps,
 		Title: direction.Title,
 	}
+	for _, stepDetail := range direction.StepDetails {
+		proto.StepDetails = append(proto.StepDetails, StepDetailToProto(stepDetail))
+	}
+	return proto
+}
+
+// ProtoToStepDetail converts a proto StepDetail to a domain RecipeStepDetail.
+func ProtoToStepDetail(proto *pb.Recipe_StepDetail) model.RecipeStepDetail {
+	stepDetail := model.RecipeStepDetail{}
+	for _, timer := range proto.GetTimers() {
+		stepDetail.Timers = append(stepDetail.Timers, model.RecipeStepTimer{
+			Text:     timer.Text,
+			Duration: timer.Duration.AsDuration(),
+		})
+	}
+	for _, temperature := range proto.GetTemperatures() {
+		stepDetail.Temperatures = append(stepDetail.Temperatures, model.RecipeStepTemperature{
+			Text:  temperature.Text,
+			Value: temperature.Value,
+			Unit:  temperature.Unit,
+		})
+	}
+	for _, ingredient := range proto.GetIngredients() {
+		stepDetail.Ingredients = append(stepDetail.Ingredients, model.RecipeStepIngredient{
+			GroupIndex:      ingredient.GroupIndex,
+			IngredientIndex: ingredient.IngredientIndex,
+		})
+	}
+	return stepDetail
+}
+
+// StepDetailToProto converts a domain RecipeStepDetail to a proto StepDetail.
+func StepDetailToProto(stepDetail model.RecipeStepDetail) *pb.Recipe_StepDetail {
+	proto := &pb.Recipe_StepDetail{}
+	for _, timer := range stepDetail.Timers {
+		proto.Timers = append(proto.Timers, &pb.Recipe_StepDetail_Timer{
+			Text:     timer.Text,
+			Duration: durationpb.New(timer.Duration),
+		})
+	}
+	for _, temperature := range stepDetail.Temperatures {
+		proto.Temperatures = append(proto.Temperatures, &pb.Recipe_StepDetail_Temperature{
+			Text:  temperature.Text,
+			Value: temperature.Value,
+			Unit:  temperature.Unit,
+		})
+	}
+	for _, ingredient := range stepDetail.Ingredients {
+		proto.Ingredients = append(proto.Ingredients, &pb.Recipe_StepDetail_IngredientReference{
+			GroupIndex:      ingredient.GroupIndex,
+			IngredientIndex: ingredient.IngredientIndex,
+		})
+	}
+	return proto
 }
 
 // ProtosToDirections converts a slice of proto Directions to a slice of domain Directions.
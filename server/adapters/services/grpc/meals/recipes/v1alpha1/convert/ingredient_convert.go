@@ -0,0 +1,43 @@
+package convert
+
+import (
+	model "github.com/jcfug8/daylear/server/core/model"
+	namer "github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// IngredientToProto converts a model Ingredient to a protobuf Ingredient
+func IngredientToProto(IngredientNamer namer.ReflectNamer, ingredient model.Ingredient) (*pb.Ingredient, error) {
+	proto := &pb.Ingredient{}
+
+	if ingredient.Id.IngredientId != 0 {
+		name, err := IngredientNamer.Format(ingredient)
+		if err != nil {
+			return proto, err
+		}
+		proto.Name = name
+	}
+
+	proto.Title = ingredient.Title
+	proto.Aliases = ingredient.Aliases
+	proto.Category = ingredient.Category
+	proto.Density = ingredient.Density
+	proto.CreateTime = timestamppb.New(ingredient.CreateTime)
+	proto.UpdateTime = timestamppb.New(ingredient.UpdateTime)
+
+	return proto, nil
+}
+
+// IngredientListToProto converts a slice of model Ingredients to a slice of protobuf Ingredients
+func IngredientListToProto(IngredientNamer namer.ReflectNamer, ingredients []model.Ingredient) ([]*pb.Ingredient, error) {
+	protos := make([]*pb.Ingredient, len(ingredients))
+	for i, ingredient := range ingredients {
+		proto, err := IngredientToProto(IngredientNamer, ingredient)
+		if err != nil {
+			return nil, err
+		}
+		protos[i] = proto
+	}
+	return protos, nil
+}
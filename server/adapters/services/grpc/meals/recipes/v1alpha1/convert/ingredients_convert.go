@@ -2,6 +2,7 @@ package convert
 
 import (
 	model "github.com/jcfug8/daylear/server/core/model"
+	namer "github.com/jcfug8/daylear/server/core/namer"
 	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
 )
 
@@ -19,8 +20,8 @@ func ProtoToRecipeIngredients(proto *pb.Recipe_Ingredient) model.RecipeIngredien
 }
 
 // RecipeIngredientsToProto converts a domain Ingredients to a proto Ingredients.
-func RecipeIngredientsToProto(ingredient model.RecipeIngredient) *pb.Recipe_Ingredient {
-	return &pb.Recipe_Ingredient{
+func RecipeIngredientsToProto(IngredientNamer namer.ReflectNamer, ingredient model.RecipeIngredient) *pb.Recipe_Ingredient {
+	proto := &pb.Recipe_Ingredient{
 		Title:                   ingredient.Title,
 		Optional:                ingredient.Optional,
 		MeasurementAmount:       ingredient.MeasurementAmount,
@@ -29,6 +30,15 @@ func RecipeIngredientsToProto(ingredient model.RecipeIngredient) *pb.Recipe_Ingr
 		SecondMeasurementAmount: ingredient.SecondMeasurementAmount,
 		SecondMeasurementType:   ingredient.SecondMeasurementType,
 	}
+
+	if ingredient.IngredientId.IngredientId != 0 {
+		name, err := IngredientNamer.Format(ingredient.IngredientId)
+		if err == nil {
+			proto.Ingredient = name
+		}
+	}
+
+	return proto
 }
 
 // ProtosToRecipeIngredients converts a slice of proto Ingredients to a slice of domain Ingredients.
@@ -42,10 +52,10 @@ func ProtosToRecipeIngredients(protos []*pb.Recipe_Ingredient) []model.RecipeIng
 }
 
 // RecipeIngredientsToProtos converts a slice of domain Ingredients to a slice of proto Ingredients.
-func RecipeIngredientsToProtos(ingredients []model.RecipeIngredient) []*pb.Recipe_Ingredient {
+func RecipeIngredientsToProtos(IngredientNamer namer.ReflectNamer, ingredients []model.RecipeIngredient) []*pb.Recipe_Ingredient {
 	protos := make([]*pb.Recipe_Ingredient, len(ingredients))
 	for i, ingredient := range ingredients {
-		proto := RecipeIngredientsToProto(ingredient)
+		proto := RecipeIngredientsToProto(IngredientNamer, ingredient)
 		protos[i] = proto
 	}
 	return protos
@@ -60,10 +70,10 @@ func ProtoToIngredientGroup(proto *pb.Recipe_IngredientGroup) model.IngredientGr
 }
 
 // IngredientGroupToProto converts a domain IngredientGroup to a proto IngredientGroup.
-func IngredientGroupToProto(ingredientGroup model.IngredientGroup) *pb.Recipe_IngredientGroup {
+func IngredientGroupToProto(IngredientNamer namer.ReflectNamer, ingredientGroup model.IngredientGroup) *pb.Recipe_IngredientGroup {
 	return &pb.Recipe_IngredientGroup{
 		Title:       ingredientGroup.Title,
-		Ingredients: RecipeIngredientsToProtos(ingredientGroup.RecipeIngredients),
+		Ingredients: RecipeIngredientsToProtos(IngredientNamer, ingredientGroup.RecipeIngredients),
 	}
 }
 
@@ -78,10 +88,10 @@ func ProtosToIngredientGroups(protos []*pb.Recipe_IngredientGroup) []model.Ingre
 }
 
 // IngredientGroupToProtos converts a slice of domain IngredientGroup to a slice of proto IngredientGroup.
-func IngredientGroupsToProtos(ingredientGroups []model.IngredientGroup) []*pb.Recipe_IngredientGroup {
+func IngredientGroupsToProtos(IngredientNamer namer.ReflectNamer, ingredientGroups []model.IngredientGroup) []*pb.Recipe_IngredientGroup {
 	protos := make([]*pb.Recipe_IngredientGroup, len(ingredientGroups))
 	for i, ingredientGroup := range ingredientGroups {
-		proto := IngredientGroupToProto(ingredientGroup)
+		proto := IngredientGroupToProto(IngredientNamer, ingredientGroup)
 		protos[i] = proto
 	}
 	return protos
@@ -1,6 +1,7 @@
 package convert
 
 import (
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
 	model "github.com/jcfug8/daylear/server/core/model"
 	namer "github.com/jcfug8/daylear/server/core/namer"
 	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
@@ -40,6 +41,12 @@ func ProtoToRecipe(RecipeNamer namer.ReflectNamer, proto *pb.Recipe) (int, model
 	recipe.Categories = proto.Categories
 	recipe.YieldAmount = proto.YieldAmount
 	recipe.Cuisines = proto.Cuisines
+	recipe.DietaryOverrides = model.DietaryOverrides{
+		AddedDiets:       proto.GetDietaryOverrides().GetAddedDiets(),
+		RemovedDiets:     proto.GetDietaryOverrides().GetRemovedDiets(),
+		AddedAllergens:   proto.GetDietaryOverrides().GetAddedAllergens(),
+		RemovedAllergens: proto.GetDietaryOverrides().GetRemovedAllergens(),
+	}
 	if proto.CreateTime != nil {
 		recipe.CreateTime = proto.CreateTime.AsTime()
 	}
@@ -51,7 +58,7 @@ func ProtoToRecipe(RecipeNamer namer.ReflectNamer, proto *pb.Recipe) (int, model
 }
 
 // RecipeToProto converts a model Recipe to a protobuf Recipe
-func RecipeToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, recipe model.Recipe, options ...namer.FormatReflectNamerOption) (*pb.Recipe, error) {
+func RecipeToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, IngredientNamer namer.ReflectNamer, recipe model.Recipe, options ...namer.FormatReflectNamerOption) (*pb.Recipe, error) {
 	proto := &pb.Recipe{}
 
 	if recipe.Id.RecipeId != 0 {
@@ -65,8 +72,9 @@ func RecipeToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectName
 	proto.Title = recipe.Title
 	proto.Description = recipe.Description
 	proto.Directions = DirectionsToProtos(recipe.Directions)
-	proto.IngredientGroups = IngredientGroupsToProtos(recipe.IngredientGroups)
+	proto.IngredientGroups = IngredientGroupsToProtos(IngredientNamer, recipe.IngredientGroups)
 	proto.ImageUri = recipe.ImageURI
+	proto.Images = grpc.ImageSetToProto(recipe.ImageSet)
 	proto.Visibility = recipe.VisibilityLevel
 	proto.Citation = recipe.Citation
 	proto.CookDuration = durationpb.New(recipe.CookDuration)
@@ -79,6 +87,40 @@ func RecipeToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectName
 	proto.CreateTime = timestamppb.New(recipe.CreateTime)
 	proto.UpdateTime = timestamppb.New(recipe.UpdateTime)
 	proto.Favorited = recipe.Favorited
+	proto.Nutrition = NutritionToProto(recipe.Nutrition)
+	proto.ForkCount = recipe.ForkCount
+	proto.AverageRating = recipe.AverageRating
+	proto.RatingCount = recipe.RatingCount
+	proto.Diets = recipe.Diets
+	proto.Allergens = recipe.Allergens
+	proto.DietaryOverrides = &pb.Recipe_DietaryOverrides{
+		AddedDiets:       recipe.DietaryOverrides.AddedDiets,
+		RemovedDiets:     recipe.DietaryOverrides.RemovedDiets,
+		AddedAllergens:   recipe.DietaryOverrides.AddedAllergens,
+		RemovedAllergens: recipe.DietaryOverrides.RemovedAllergens,
+	}
+	proto.RestrictionConflicts = recipe.RestrictionConflicts
+	proto.TimesCooked = recipe.TimesCooked
+	if recipe.LastCookedTime != nil {
+		proto.LastCookedTime = timestamppb.New(*recipe.LastCookedTime)
+	}
+
+	if recipe.ForkedFrom.RecipeId != 0 {
+		name, err := RecipeNamer.Format(model.Recipe{Id: recipe.ForkedFrom})
+		if err != nil {
+			return proto, err
+		}
+		proto.ForkedFrom = name
+	}
+
+	// possible duplicates are in the same collection as the recipe
+	for _, id := range recipe.PossibleDuplicates {
+		name, err := RecipeNamer.Format(model.Recipe{Id: id, Parent: recipe.Parent}, options...)
+		if err != nil {
+			return proto, err
+		}
+		proto.PossibleDuplicates = append(proto.PossibleDuplicates, name)
+	}
 
 	// Handle recipe_access field if present
 	if (recipe.RecipeAccess != model.RecipeAccess{}) {
@@ -96,13 +138,77 @@ func RecipeToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectName
 	return proto, nil
 }
 
+// RecipeDuplicateToProto converts a model RecipeDuplicate to a protobuf
+// RecipeDuplicate
+func RecipeDuplicateToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, IngredientNamer namer.ReflectNamer, duplicate model.RecipeDuplicate) (*pb.RecipeDuplicate, error) {
+	recipe, err := RecipeToProto(RecipeNamer, AccessNamer, IngredientNamer, duplicate.Recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	proto := &pb.RecipeDuplicate{
+		Recipe:     recipe,
+		Similarity: duplicate.Similarity,
+	}
+	for _, reason := range duplicate.Reasons {
+		proto.Reasons = append(proto.Reasons, recipeDuplicateReasons[reason])
+	}
+	return proto, nil
+}
+
+// ScrapeRecipeResponseToProto converts a scraped recipe and its likely
+// duplicates to a protobuf ScrapeRecipeResponse
+func ScrapeRecipeResponseToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, IngredientNamer namer.ReflectNamer, recipe model.Recipe, duplicates []model.RecipeDuplicate) (*pb.ScrapeRecipeResponse, error) {
+	recipeProto, err := RecipeToProto(RecipeNamer, AccessNamer, IngredientNamer, recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	proto := &pb.ScrapeRecipeResponse{
+		Recipe:     recipeProto,
+		Duplicates: make([]*pb.RecipeDuplicate, 0, len(duplicates)),
+	}
+	for _, duplicate := range duplicates {
+		duplicateProto, err := RecipeDuplicateToProto(RecipeNamer, AccessNamer, IngredientNamer, duplicate)
+		if err != nil {
+			return nil, err
+		}
+		proto.Duplicates = append(proto.Duplicates, duplicateProto)
+	}
+	return proto, nil
+}
+
+var recipeDuplicateReasons = map[string]pb.RecipeDuplicate_Reason{
+	model.RecipeDuplicateReason_Citation:    pb.RecipeDuplicate_REASON_CITATION,
+	model.RecipeDuplicateReason_Title:       pb.RecipeDuplicate_REASON_TITLE,
+	model.RecipeDuplicateReason_Ingredients: pb.RecipeDuplicate_REASON_INGREDIENTS,
+}
+
+// NutritionToProto converts model Nutrition to protobuf Nutrition
+func NutritionToProto(nutrition model.Nutrition) *pb.Recipe_Nutrition {
+	if nutrition.Servings == 0 {
+		return nil
+	}
+	return &pb.Recipe_Nutrition{
+		Servings:             nutrition.Servings,
+		Calories:             nutrition.Calories,
+		ProteinGrams:         nutrition.ProteinGrams,
+		FatGrams:             nutrition.FatGrams,
+		CarbohydrateGrams:    nutrition.CarbohydrateGrams,
+		FiberGrams:           nutrition.FiberGrams,
+		SugarGrams:           nutrition.SugarGrams,
+		SodiumMilligrams:     nutrition.SodiumMilligrams,
+		UnmatchedIngredients: nutrition.UnmatchedIngredients,
+	}
+}
+
 // RecipeListToProto converts a slice of model Recipes to a slice of protobuf OmniRecipes
-func RecipeListToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, recipes []model.Recipe) ([]*pb.Recipe, error) {
+func RecipeListToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, IngredientNamer namer.ReflectNamer, recipes []model.Recipe) ([]*pb.Recipe, error) {
 	protos := make([]*pb.Recipe, len(recipes))
 	for i, recipe := range recipes {
 		proto := &pb.Recipe{}
 		var err error
-		if proto, err = RecipeToProto(RecipeNamer, AccessNamer, recipe); err != nil {
+		if proto, err = RecipeToProto(RecipeNamer, AccessNamer, IngredientNamer, recipe); err != nil {
 			return nil, err
 		}
 		protos[i] = proto
@@ -0,0 +1,48 @@
+package convert
+
+import (
+	model "github.com/jcfug8/daylear/server/core/model"
+	namer "github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RecipeImageCandidateToProto converts a model RecipeImageCandidate to a
+// protobuf RecipeImageCandidate
+func RecipeImageCandidateToProto(CandidateNamer namer.ReflectNamer, UserNamer namer.ReflectNamer, candidate model.RecipeImageCandidate) (*pb.RecipeImageCandidate, error) {
+	name, err := CandidateNamer.Format(candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	proto := &pb.RecipeImageCandidate{
+		Name:       name,
+		ImageUri:   candidate.ImageURI,
+		CreateTime: timestamppb.New(candidate.CreateTime),
+	}
+
+	if candidate.CreatorId.UserId != 0 {
+		proto.Creator, err = UserNamer.Format(model.User{Id: candidate.CreatorId})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return proto, nil
+}
+
+// GenerateRecipeImageResponseToProto converts generated candidates to a
+// protobuf GenerateRecipeImageResponse
+func GenerateRecipeImageResponseToProto(CandidateNamer namer.ReflectNamer, UserNamer namer.ReflectNamer, candidates []model.RecipeImageCandidate) (*pb.GenerateRecipeImageResponse, error) {
+	proto := &pb.GenerateRecipeImageResponse{
+		Candidates: make([]*pb.RecipeImageCandidate, 0, len(candidates)),
+	}
+	for _, candidate := range candidates {
+		candidateProto, err := RecipeImageCandidateToProto(CandidateNamer, UserNamer, candidate)
+		if err != nil {
+			return nil, err
+		}
+		proto.Candidates = append(proto.Candidates, candidateProto)
+	}
+	return proto, nil
+}
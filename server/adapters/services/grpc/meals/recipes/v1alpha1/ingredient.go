@@ -0,0 +1,160 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/grpc/meals/recipes/v1alpha1/convert"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ingredientMaxPageSize             int32 = 1000
+	ingredientDefaultPageSize         int32 = 100
+	ingredientAutocompleteMaxPageSize int32 = 50
+	ingredientAutocompleteDefaultSize int32 = 10
+)
+
+var ingredientFieldMap = map[string][]string{
+	"name":        {model.IngredientField_Id},
+	"title":       {model.IngredientField_Title},
+	"aliases":     {model.IngredientField_Aliases},
+	"category":    {model.IngredientField_Category},
+	"density":     {model.IngredientField_Density},
+	"create_time": {model.IngredientField_CreateTime},
+	"update_time": {model.IngredientField_UpdateTime},
+}
+
+// GetIngredient -
+func (s *RecipeService) GetIngredient(ctx context.Context, request *pb.GetIngredientRequest) (*pb.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetIngredient called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mIngredient := model.Ingredient{}
+	_, err = s.ingredientNamer.Parse(request.GetName(), &mIngredient)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mIngredient, err = s.domain.GetIngredient(ctx, authAccount, mIngredient.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetIngredient failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbIngredient, err := convert.IngredientToProto(s.ingredientNamer, mIngredient)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbIngredient)
+
+	log.Info().Msg("gRPC GetIngredient success")
+	return pbIngredient, nil
+}
+
+// ListIngredients -
+func (s *RecipeService) ListIngredients(ctx context.Context, request *pb.ListIngredientsRequest) (*pb.ListIngredientsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListIngredients called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: ingredientDefaultPageSize,
+		MaxPageSize:     ingredientMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListIngredients(ctx, authAccount, request.GetPageSize(), pageToken.Offset, request.GetFilter(), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListIngredients failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ingredients, err := convert.IngredientListToProto(s.ingredientNamer, res)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	for _, ingredientProto := range ingredients {
+		grpc.ProcessResponseFieldBehavior(ingredientProto)
+	}
+
+	response := &pb.ListIngredientsResponse{
+		Ingredients: ingredients,
+	}
+
+	if len(ingredients) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListIngredients success")
+	return response, nil
+}
+
+// AutocompleteIngredients -
+func (s *RecipeService) AutocompleteIngredients(ctx context.Context, request *pb.AutocompleteIngredientsRequest) (*pb.AutocompleteIngredientsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC AutocompleteIngredients called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = ingredientAutocompleteDefaultSize
+	} else if pageSize > ingredientAutocompleteMaxPageSize {
+		pageSize = ingredientAutocompleteMaxPageSize
+	}
+
+	res, err := s.domain.AutocompleteIngredients(ctx, authAccount, request.GetQuery(), pageSize)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.AutocompleteIngredients failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ingredients, err := convert.IngredientListToProto(s.ingredientNamer, res)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	for _, ingredientProto := range ingredients {
+		grpc.ProcessResponseFieldBehavior(ingredientProto)
+	}
+
+	log.Info().Msg("gRPC AutocompleteIngredients success")
+	return &pb.AutocompleteIngredientsResponse{Ingredients: ingredients}, nil
+}
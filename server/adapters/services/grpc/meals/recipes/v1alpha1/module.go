@@ -14,6 +14,14 @@ var Module = fx.Module(
 		NewRecipeService,
 		func(s *RecipeService) pb.RecipeServiceServer { return s },
 		func(s *RecipeService) pb.RecipeAccessServiceServer { return s },
+		func(s *RecipeService) pb.IngredientServiceServer { return s },
+		func(s *RecipeService) pb.RecipeRevisionServiceServer { return s },
+		func(s *RecipeService) pb.RecipeReviewServiceServer { return s },
+		func(s *RecipeService) pb.RecipeCookLogServiceServer { return s },
+		func(s *RecipeService) pb.RecipeNoteServiceServer { return s },
+		func(s *RecipeService) pb.RecipeImportServiceServer { return s },
+		func(s *RecipeService) pb.RecipeImageServiceServer { return s },
+		func(s *RecipeService) pb.RecipeImageCandidateServiceServer { return s },
 		fx.Annotate(
 			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Access]() },
 			fx.ResultTags(`name:"v1alpha1RecipeAccessNamer"`),
@@ -22,6 +30,38 @@ var Module = fx.Module(
 			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Recipe]() },
 			fx.ResultTags(`name:"v1alpha1RecipeNamer"`),
 		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Ingredient]() },
+			fx.ResultTags(`name:"v1alpha1IngredientNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.RecipeRevision]() },
+			fx.ResultTags(`name:"v1alpha1RecipeRevisionNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.RecipeReview]() },
+			fx.ResultTags(`name:"v1alpha1RecipeReviewNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.RecipeCookLog]() },
+			fx.ResultTags(`name:"v1alpha1RecipeCookLogNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.RecipeNote]() },
+			fx.ResultTags(`name:"v1alpha1RecipeNoteNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.RecipeImport]() },
+			fx.ResultTags(`name:"v1alpha1RecipeImportNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.RecipeImage]() },
+			fx.ResultTags(`name:"v1alpha1RecipeImageNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.RecipeImageCandidate]() },
+			fx.ResultTags(`name:"v1alpha1RecipeImageCandidateNamer"`),
+		),
 		fx.Annotate(
 			func() (fieldmask.FieldMasker, error) {
 				return fieldmask.NewProtoFieldMasker(&pb.Recipe{}, recipeFieldMap)
@@ -34,5 +74,29 @@ var Module = fx.Module(
 			},
 			fx.ResultTags(`name:"v1alpha1RecipeAccessFieldMasker"`),
 		),
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.RecipeReview{}, recipeReviewFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1RecipeReviewFieldMasker"`),
+		),
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.RecipeCookLog{}, recipeCookLogFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1RecipeCookLogFieldMasker"`),
+		),
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.RecipeNote{}, recipeNoteFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1RecipeNoteFieldMasker"`),
+		),
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.RecipeImage{}, recipeImageFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1RecipeImageFieldMasker"`),
+		),
 	),
 )
@@ -2,13 +2,17 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jcfug8/daylear/server/adapters/services/grpc"
 	"github.com/jcfug8/daylear/server/adapters/services/grpc/meals/recipes/v1alpha1/convert"
+	operationConvert "github.com/jcfug8/daylear/server/adapters/services/grpc/operations/operation/v1alpha1/convert"
 	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
 	"github.com/jcfug8/daylear/server/core/logutil"
 	"github.com/jcfug8/daylear/server/core/model"
 	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	operationPb "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,6 +29,7 @@ var recipeFieldMap = map[string][]string{
 	"directions":        {model.RecipeField_Directions},
 	"ingredient_groups": {model.RecipeField_IngredientGroups},
 	"image_uri":         {model.RecipeField_ImageURI},
+	"images":            {model.RecipeField_ImageSet},
 	"visibility":        {model.RecipeField_VisibilityLevel},
 	"citation":          {model.RecipeField_Citation},
 	"cook_duration":     {model.RecipeField_CookDurationSeconds},
@@ -34,10 +39,22 @@ var recipeFieldMap = map[string][]string{
 	"categories":        {model.RecipeField_Categories},
 	"yield_amount":      {model.RecipeField_YieldAmount},
 	"cuisines":          {model.RecipeField_Cuisines},
+	"nutrition":         {model.RecipeField_Nutrition},
+	"forked_from":       {model.RecipeField_ForkedFrom},
+	"fork_count":        {model.RecipeField_ForkCount},
+	"average_rating":    {model.RecipeField_AverageRating},
+	"rating_count":      {model.RecipeField_RatingCount},
+	"diets":             {model.RecipeField_Diets},
+	"allergens":         {model.RecipeField_Allergens},
+	"dietary_overrides": {model.RecipeField_DietaryOverrides},
+	"last_cooked_time":  {model.RecipeField_LastCookedTime},
+	"times_cooked":      {model.RecipeField_TimesCooked},
 	"create_time":       {model.RecipeField_CreateTime},
 	"update_time":       {model.RecipeField_UpdateTime},
 
-	"recipe_access": {model.RecipeField_RecipeAccess},
+	"recipe_access":         {model.RecipeField_RecipeAccess},
+	"restriction_conflicts": {model.RecipeField_RestrictionConflicts},
+	"private_notes":         {model.RecipeField_PrivateNotes},
 }
 
 // CreateRecipe -
@@ -80,7 +97,7 @@ func (s *RecipeService) CreateRecipe(ctx context.Context, request *pb.CreateReci
 	}
 
 	// convert model to proto
-	pbRecipe, err = convert.RecipeToProto(s.recipeNamer, s.accessNamer, mRecipe)
+	pbRecipe, err = convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, mRecipe)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to prepare response")
 		return nil, status.Error(codes.Internal, "unable to prepare response")
@@ -115,7 +132,7 @@ func (s *RecipeService) DeleteRecipe(ctx context.Context, request *pb.DeleteReci
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, mRecipe)
+	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, mRecipe)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to prepare response")
 		return nil, status.Error(codes.Internal, "unable to prepare response")
@@ -148,12 +165,21 @@ func (s *RecipeService) GetRecipe(ctx context.Context, request *pb.GetRecipeRequ
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, mRecipe)
+	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, mRecipe)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to prepare response")
 		return nil, status.Error(codes.Internal, "unable to prepare response")
 	}
 
+	for _, mNote := range mRecipe.PrivateNotes {
+		pbNote, err := s.RecipeNoteToProto(mNote)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		pbRecipe.PrivateNotes = append(pbRecipe.PrivateNotes, pbNote)
+	}
+
 	log.Info().Msg("gRPC GetRecipe success")
 	return pbRecipe, nil
 }
@@ -184,7 +210,7 @@ func (s *RecipeService) UpdateRecipe(ctx context.Context, request *pb.UpdateReci
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	recipeProto, err = convert.RecipeToProto(s.recipeNamer, s.accessNamer, mRecipe)
+	recipeProto, err = convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, mRecipe)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to prepare response")
 		return nil, status.Error(codes.Internal, err.Error())
@@ -221,7 +247,7 @@ func (s *RecipeService) ListRecipes(ctx context.Context, request *pb.ListRecipes
 	}
 	request.PageSize = pageSize
 
-	res, err := s.domain.ListRecipes(ctx, authAccount, mRecipeParent, request.GetPageSize(), pageToken.Offset, request.GetFilter(), nil)
+	res, err := s.domain.ListRecipes(ctx, authAccount, mRecipeParent, request.GetPageSize(), pageToken.Offset, request.GetFilter(), request.GetOrderBy(), nil)
 	if err != nil {
 		log.Error().Err(err).Msg("domain.ListRecipes failed")
 		return nil, status.Error(codes.Internal, err.Error())
@@ -229,7 +255,7 @@ func (s *RecipeService) ListRecipes(ctx context.Context, request *pb.ListRecipes
 
 	recipes := make([]*pb.Recipe, len(res))
 	for i, recipe := range res {
-		recipeProto, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, recipe)
+		recipeProto, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, recipe)
 		if err != nil {
 			log.Error().Err(err).Msg("unable to prepare response")
 			return nil, status.Error(codes.Internal, "unable to prepare response")
@@ -271,24 +297,110 @@ func (s *RecipeService) ScrapeRecipe(ctx context.Context, request *pb.ScrapeReci
 		return nil, err
 	}
 
+	mParent := model.RecipeParent{}
+	if request.GetParent() != "" {
+		_, err = s.recipeNamer.ParseParent(request.GetParent(), &mParent)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid parent")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+		}
+	}
+
 	// scrape the recipe
-	recipe, err := s.domain.ScrapeRecipe(ctx, authAccount, request.GetUri())
+	recipe, duplicates, err := s.domain.ScrapeRecipe(ctx, authAccount, mParent, request.GetUri())
 	if err != nil {
 		log.Error().Err(err).Msg("domain.ScrapeRecipe failed")
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// convert the recipe to a proto
-	recipeProto, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, recipe)
+	response, err := convert.ScrapeRecipeResponseToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, recipe, duplicates)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to prepare response")
 		return nil, status.Error(codes.Internal, "unable to prepare response")
 	}
+	for _, duplicate := range response.Duplicates {
+		grpc.ProcessResponseFieldBehavior(duplicate.Recipe)
+	}
 
 	log.Info().Msg("gRPC ScrapeRecipe success")
-	return &pb.ScrapeRecipeResponse{
-		Recipe: recipeProto,
-	}, nil
+	return response, nil
+}
+
+// StartScrapeRecipe -
+func (s *RecipeService) StartScrapeRecipe(ctx context.Context, request *pb.ScrapeRecipeRequest) (*operationPb.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC StartScrapeRecipe called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	mParent := model.RecipeParent{}
+	if request.GetParent() != "" {
+		_, err = s.recipeNamer.ParseParent(request.GetParent(), &mParent)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid parent")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+		}
+	}
+
+	operation, err := s.domain.StartScrapeRecipe(ctx, authAccount, mParent, request.GetUri())
+	if err != nil {
+		log.Error().Err(err).Msg("domain.StartScrapeRecipe failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbOperation, err := operationConvert.OperationToProto(s.operationNamer, s.recipeNamer, s.accessNamer, s.ingredientNamer, s.recipeImageCandidateNamer, s.userNamer, operation)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC StartScrapeRecipe success")
+	return pbOperation, nil
+}
+
+// StartGenerateRecipeImage -
+func (s *RecipeService) StartGenerateRecipeImage(ctx context.Context, request *pb.StartGenerateRecipeImageRequest) (*operationPb.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC StartGenerateRecipeImage called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mRecipe := model.Recipe{}
+	_, err = s.recipeNamer.Parse(request.GetName(), &mRecipe)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	operation, err := s.domain.StartGenerateRecipeImage(ctx, authAccount, mRecipe.Parent, mRecipe.Id, request.GetCandidateCount())
+	if errors.As(err, &domain.ErrResourceExhausted{}) {
+		log.Warn().Err(err).Msg("image generation quota exceeded")
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	} else if err != nil {
+		log.Error().Err(err).Msg("domain.StartGenerateRecipeImage failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbOperation, err := operationConvert.OperationToProto(s.operationNamer, s.recipeNamer, s.accessNamer, s.ingredientNamer, s.recipeImageCandidateNamer, s.userNamer, operation)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC StartGenerateRecipeImage success")
+	return pbOperation, nil
 }
 
 // FavoriteRecipe favorites a recipe for the authenticated user.
@@ -350,3 +462,259 @@ func (s *RecipeService) UnfavoriteRecipe(ctx context.Context, request *pb.Unfavo
 	log.Info().Msg("gRPC UnfavoriteRecipe success")
 	return &pb.UnfavoriteRecipeResponse{}, nil
 }
+
+// ForkRecipe copies a recipe into a user or circle collection.
+func (s *RecipeService) ForkRecipe(ctx context.Context, request *pb.ForkRecipeRequest) (*pb.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ForkRecipe called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	recipe := model.Recipe{}
+	_, err = s.recipeNamer.Parse(request.GetName(), &recipe)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid recipe name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe name: %v", request.GetName())
+	}
+
+	mParent := model.RecipeParent{}
+	if request.GetParent() != "" {
+		_, err = s.recipeNamer.ParseParent(request.GetParent(), &mParent)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid parent")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+		}
+	}
+
+	mRecipe, err := s.domain.ForkRecipe(ctx, authAccount, mParent, recipe.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ForkRecipe failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, mRecipe)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	grpc.ProcessResponseFieldBehavior(pbRecipe)
+	log.Info().Msg("gRPC ForkRecipe success")
+	return pbRecipe, nil
+}
+
+// MatchRecipes ranks recipes by the ingredients on hand.
+func (s *RecipeService) MatchRecipes(ctx context.Context, request *pb.MatchRecipesRequest) (*pb.MatchRecipesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC MatchRecipes called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	mRecipeParent := model.RecipeParent{}
+	if request.GetParent() != "" {
+		_, err = s.recipeNamer.ParseParent(request.GetParent(), &mRecipeParent)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid parent")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+		}
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeDefaultPageSize,
+		MaxPageSize:     recipeMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	mRequest := model.RecipeMatchRequest{
+		Ingredients:     request.GetIngredients(),
+		Filter:          request.GetFilter(),
+		MinimumCoverage: request.GetMinimumCoverage(),
+	}
+
+	res, err := s.domain.MatchRecipes(ctx, authAccount, mRecipeParent, mRequest, request.GetPageSize(), pageToken.Offset)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.MatchRecipes failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.MatchRecipesResponse{
+		RecipeMatches: make([]*pb.MatchRecipesResponse_RecipeMatch, 0, len(res)),
+	}
+	for _, match := range res {
+		recipeProto, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, match.Recipe)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(recipeProto)
+
+		response.RecipeMatches = append(response.RecipeMatches, &pb.MatchRecipesResponse_RecipeMatch{
+			Recipe:               recipeProto,
+			Coverage:             match.Coverage,
+			AvailableIngredients: match.AvailableIngredients,
+			MissingIngredients:   match.MissingIngredients,
+		})
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC MatchRecipes success")
+	return response, nil
+}
+
+// FindDuplicateRecipes groups the recipes of a collection that are likely
+// copies of each other.
+func (s *RecipeService) FindDuplicateRecipes(ctx context.Context, request *pb.FindDuplicateRecipesRequest) (*pb.FindDuplicateRecipesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC FindDuplicateRecipes called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	mRecipeParent := model.RecipeParent{}
+	_, err = s.recipeNamer.ParseParent(request.GetParent(), &mRecipeParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeDefaultPageSize,
+		MaxPageSize:     recipeMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.FindDuplicateRecipes(ctx, authAccount, mRecipeParent, request.GetPageSize(), pageToken.Offset)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.FindDuplicateRecipes failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.FindDuplicateRecipesResponse{
+		DuplicateGroups: make([]*pb.FindDuplicateRecipesResponse_DuplicateGroup, 0, len(res)),
+	}
+	for _, group := range res {
+		recipeProto, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, group.Recipe)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(recipeProto)
+
+		groupProto := &pb.FindDuplicateRecipesResponse_DuplicateGroup{
+			Recipe:     recipeProto,
+			Duplicates: make([]*pb.RecipeDuplicate, 0, len(group.Duplicates)),
+		}
+		for _, duplicate := range group.Duplicates {
+			duplicateProto, err := convert.RecipeDuplicateToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, duplicate)
+			if err != nil {
+				log.Error().Err(err).Msg("unable to prepare response")
+				return nil, status.Error(codes.Internal, "unable to prepare response")
+			}
+			grpc.ProcessResponseFieldBehavior(duplicateProto.Recipe)
+			groupProto.Duplicates = append(groupProto.Duplicates, duplicateProto)
+		}
+		response.DuplicateGroups = append(response.DuplicateGroups, groupProto)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC FindDuplicateRecipes success")
+	return response, nil
+}
+
+// MergeRecipes merges duplicate recipes into the recipe to keep.
+func (s *RecipeService) MergeRecipes(ctx context.Context, request *pb.MergeRecipesRequest) (*pb.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC MergeRecipes called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	recipe := model.Recipe{}
+	_, err = s.recipeNamer.Parse(request.GetName(), &recipe)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid recipe name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe name: %v", request.GetName())
+	}
+
+	mergedIds := make([]model.RecipeId, 0, len(request.GetRecipes()))
+	for _, name := range request.GetRecipes() {
+		merged := model.Recipe{}
+		_, err = s.recipeNamer.Parse(name, &merged)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid merged recipe name")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipe name: %v", name)
+		}
+		mergedIds = append(mergedIds, merged.Id)
+	}
+
+	mRecipe, err := s.domain.MergeRecipes(ctx, authAccount, recipe.Parent, recipe.Id, mergedIds)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.MergeRecipes failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, mRecipe)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	grpc.ProcessResponseFieldBehavior(pbRecipe)
+	log.Info().Msg("gRPC MergeRecipes success")
+	return pbRecipe, nil
+}
@@ -0,0 +1,309 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	recipeCookLogMaxPageSize     int32 = 100
+	recipeCookLogDefaultPageSize int32 = 25
+)
+
+var recipeCookLogFieldMap = map[string][]string{
+	"name":         {model.RecipeCookLogField_Parent, model.RecipeCookLogField_Id},
+	"cook":         {model.RecipeCookLogField_CookId},
+	"cook_time":    {model.RecipeCookLogField_CookTime},
+	"rating":       {model.RecipeCookLogField_Rating},
+	"notes":        {model.RecipeCookLogField_Notes},
+	"photos":       {model.RecipeCookLogField_Photos},
+	"event_recipe": {model.RecipeCookLogField_EventRecipe},
+	"create_time":  {model.RecipeCookLogField_CreateTime},
+	"update_time":  {model.RecipeCookLogField_UpdateTime},
+}
+
+// CreateRecipeCookLog -
+func (s *RecipeService) CreateRecipeCookLog(ctx context.Context, request *pb.CreateRecipeCookLogRequest) (*pb.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreateRecipeCookLog called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mCookLog := ProtoToRecipeCookLog(request.GetRecipeCookLog())
+	_, err = s.recipeCookLogNamer.ParseParent(request.GetParent(), &mCookLog)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	mPantry := model.Pantry{}
+	if request.GetPantry() != "" {
+		_, err = s.pantryNamer.Parse(request.GetPantry(), &mPantry)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid pantry")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pantry: %v", request.GetPantry())
+		}
+	}
+
+	mCookLog, err = s.domain.CreateRecipeCookLog(ctx, authAccount, mCookLog, mPantry.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreateRecipeCookLog failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCookLog, err := s.RecipeCookLogToProto(mCookLog)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCookLog)
+
+	log.Info().Msg("gRPC CreateRecipeCookLog success")
+	return pbCookLog, nil
+}
+
+// GetRecipeCookLog -
+func (s *RecipeService) GetRecipeCookLog(ctx context.Context, request *pb.GetRecipeCookLogRequest) (*pb.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetRecipeCookLog called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCookLog := model.RecipeCookLog{}
+	_, err = s.recipeCookLogNamer.Parse(request.GetName(), &mCookLog)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mCookLog, err = s.domain.GetRecipeCookLog(ctx, authAccount, mCookLog.Parent, mCookLog.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetRecipeCookLog failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCookLog, err := s.RecipeCookLogToProto(mCookLog)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCookLog)
+
+	log.Info().Msg("gRPC GetRecipeCookLog success")
+	return pbCookLog, nil
+}
+
+// ListRecipeCookLogs -
+func (s *RecipeService) ListRecipeCookLogs(ctx context.Context, request *pb.ListRecipeCookLogsRequest) (*pb.ListRecipeCookLogsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListRecipeCookLogs called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeCookLogParent{}
+	_, err = s.recipeCookLogNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeCookLogDefaultPageSize,
+		MaxPageSize:     recipeCookLogMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListRecipeCookLogs(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListRecipeCookLogs failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListRecipeCookLogsResponse{
+		RecipeCookLogs: make([]*pb.RecipeCookLog, 0, len(res)),
+	}
+	for _, mCookLog := range res {
+		pbCookLog, err := s.RecipeCookLogToProto(mCookLog)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbCookLog)
+		response.RecipeCookLogs = append(response.RecipeCookLogs, pbCookLog)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListRecipeCookLogs success")
+	return response, nil
+}
+
+// UpdateRecipeCookLog -
+func (s *RecipeService) UpdateRecipeCookLog(ctx context.Context, request *pb.UpdateRecipeCookLogRequest) (*pb.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateRecipeCookLog called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessUpdateRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mCookLog := ProtoToRecipeCookLog(request.GetRecipeCookLog())
+	_, err = s.recipeCookLogNamer.Parse(request.GetRecipeCookLog().GetName(), &mCookLog)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetRecipeCookLog().GetName())
+	}
+
+	updateMask := s.recipeCookLogFieldMasker.Convert(request.GetUpdateMask().GetPaths())
+
+	mCookLog, err = s.domain.UpdateRecipeCookLog(ctx, authAccount, mCookLog, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateRecipeCookLog failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCookLog, err := s.RecipeCookLogToProto(mCookLog)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCookLog)
+
+	log.Info().Msg("gRPC UpdateRecipeCookLog success")
+	return pbCookLog, nil
+}
+
+// DeleteRecipeCookLog -
+func (s *RecipeService) DeleteRecipeCookLog(ctx context.Context, request *pb.DeleteRecipeCookLogRequest) (*pb.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteRecipeCookLog called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCookLog := model.RecipeCookLog{}
+	_, err = s.recipeCookLogNamer.Parse(request.GetName(), &mCookLog)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mCookLog, err = s.domain.DeleteRecipeCookLog(ctx, authAccount, mCookLog.Parent, mCookLog.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteRecipeCookLog failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCookLog, err := s.RecipeCookLogToProto(mCookLog)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCookLog)
+
+	log.Info().Msg("gRPC DeleteRecipeCookLog success")
+	return pbCookLog, nil
+}
+
+// RecipeCookLogToProto converts a model recipe cook log to a proto recipe cook log.
+func (s *RecipeService) RecipeCookLogToProto(mCookLog model.RecipeCookLog) (*pb.RecipeCookLog, error) {
+	name, err := s.recipeCookLogNamer.Format(mCookLog)
+	if err != nil {
+		return nil, err
+	}
+
+	pbCookLog := &pb.RecipeCookLog{
+		Name:       name,
+		CookTime:   timestamppb.New(mCookLog.CookTime),
+		Rating:     mCookLog.Rating,
+		Notes:      mCookLog.Notes,
+		Photos:     make([]*pb.RecipeCookLog_Photo, 0, len(mCookLog.Photos)),
+		CreateTime: timestamppb.New(mCookLog.CreateTime),
+		UpdateTime: timestamppb.New(mCookLog.UpdateTime),
+	}
+
+	for _, photo := range mCookLog.Photos {
+		pbCookLog.Photos = append(pbCookLog.Photos, &pb.RecipeCookLog_Photo{
+			ImageUri: photo.ImageURI,
+			Images:   grpc.ImageSetToProto(photo.ImageSet),
+		})
+	}
+
+	if mCookLog.CookId.UserId != 0 {
+		pbCookLog.Cook, err = s.userNamer.Format(model.User{Id: mCookLog.CookId})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if mCookLog.EventRecipe != nil {
+		pbCookLog.EventRecipe, err = s.eventRecipeNamer.Format(*mCookLog.EventRecipe)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pbCookLog, nil
+}
+
+// ProtoToRecipeCookLog converts a proto recipe cook log to a model recipe cook log.
+func ProtoToRecipeCookLog(pbCookLog *pb.RecipeCookLog) model.RecipeCookLog {
+	mCookLog := model.RecipeCookLog{
+		Rating: pbCookLog.GetRating(),
+		Notes:  pbCookLog.GetNotes(),
+	}
+
+	if pbCookLog.GetCookTime() != nil {
+		mCookLog.CookTime = pbCookLog.GetCookTime().AsTime()
+	}
+
+	for _, photo := range pbCookLog.GetPhotos() {
+		mCookLog.Photos = append(mCookLog.Photos, model.RecipeCookLogPhoto{
+			ImageURI: photo.GetImageUri(),
+		})
+	}
+
+	return mCookLog
+}
@@ -0,0 +1,317 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	recipeImageMaxPageSize     int32 = 100
+	recipeImageDefaultPageSize int32 = 50
+)
+
+var recipeImageFieldMap = map[string][]string{
+	"name":        {model.RecipeImageField_Parent, model.RecipeImageField_Id},
+	"image_uri":   {model.RecipeImageField_ImageURI},
+	"cover":       {model.RecipeImageField_ImageURI},
+	"images":      {model.RecipeImageField_ImageSet},
+	"step":        {model.RecipeImageField_Step},
+	"create_time": {model.RecipeImageField_CreateTime},
+}
+
+// ListRecipeImages -
+func (s *RecipeService) ListRecipeImages(ctx context.Context, request *pb.ListRecipeImagesRequest) (*pb.ListRecipeImagesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListRecipeImages called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeImageParent{}
+	_, err = s.recipeImageNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeImageDefaultPageSize,
+		MaxPageSize:     recipeImageMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListRecipeImages(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListRecipeImages failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListRecipeImagesResponse{
+		RecipeImages: make([]*pb.RecipeImage, 0, len(res)),
+	}
+	for _, mImage := range res {
+		pbImage, err := s.RecipeImageToProto(mImage)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbImage)
+		response.RecipeImages = append(response.RecipeImages, pbImage)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListRecipeImages success")
+	return response, nil
+}
+
+// GetRecipeImage -
+func (s *RecipeService) GetRecipeImage(ctx context.Context, request *pb.GetRecipeImageRequest) (*pb.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetRecipeImage called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mImage := model.RecipeImage{}
+	_, err = s.recipeImageNamer.Parse(request.GetName(), &mImage)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mImage, err = s.domain.GetRecipeImage(ctx, authAccount, mImage.Parent, mImage.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetRecipeImage failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbImage, err := s.RecipeImageToProto(mImage)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbImage)
+
+	log.Info().Msg("gRPC GetRecipeImage success")
+	return pbImage, nil
+}
+
+// UpdateRecipeImage -
+func (s *RecipeService) UpdateRecipeImage(ctx context.Context, request *pb.UpdateRecipeImageRequest) (*pb.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateRecipeImage called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessUpdateRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mImage := ProtoToRecipeImage(request.GetRecipeImage())
+	_, err = s.recipeImageNamer.Parse(request.GetRecipeImage().GetName(), &mImage)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetRecipeImage().GetName())
+	}
+
+	updateMask := s.recipeImageFieldMasker.Convert(request.GetUpdateMask().GetPaths())
+
+	mImage, err = s.domain.UpdateRecipeImage(ctx, authAccount, mImage, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateRecipeImage failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbImage, err := s.RecipeImageToProto(mImage)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbImage)
+
+	log.Info().Msg("gRPC UpdateRecipeImage success")
+	return pbImage, nil
+}
+
+// DeleteRecipeImage -
+func (s *RecipeService) DeleteRecipeImage(ctx context.Context, request *pb.DeleteRecipeImageRequest) (*pb.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteRecipeImage called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mImage := model.RecipeImage{}
+	_, err = s.recipeImageNamer.Parse(request.GetName(), &mImage)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mImage, err = s.domain.DeleteRecipeImage(ctx, authAccount, mImage.Parent, mImage.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteRecipeImage failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbImage, err := s.RecipeImageToProto(mImage)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbImage)
+
+	log.Info().Msg("gRPC DeleteRecipeImage success")
+	return pbImage, nil
+}
+
+// ReorderRecipeImages -
+func (s *RecipeService) ReorderRecipeImages(ctx context.Context, request *pb.ReorderRecipeImagesRequest) (*pb.ReorderRecipeImagesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ReorderRecipeImages called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeImageParent{}
+	_, err = s.recipeImageNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	ids := make([]model.RecipeImageId, 0, len(request.GetRecipeImages()))
+	for _, name := range request.GetRecipeImages() {
+		mImage := model.RecipeImage{}
+		_, err = s.recipeImageNamer.Parse(name, &mImage)
+		if err != nil || mImage.Parent != mParent {
+			log.Warn().Err(err).Str("name", name).Msg("invalid recipe image name")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipe image name: %v", name)
+		}
+		ids = append(ids, mImage.Id)
+	}
+
+	res, err := s.domain.ReorderRecipeImages(ctx, authAccount, mParent, ids)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ReorderRecipeImages failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ReorderRecipeImagesResponse{
+		RecipeImages: make([]*pb.RecipeImage, 0, len(res)),
+	}
+	for _, mImage := range res {
+		pbImage, err := s.RecipeImageToProto(mImage)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbImage)
+		response.RecipeImages = append(response.RecipeImages, pbImage)
+	}
+
+	log.Info().Msg("gRPC ReorderRecipeImages success")
+	return response, nil
+}
+
+// SetRecipeCoverImage -
+func (s *RecipeService) SetRecipeCoverImage(ctx context.Context, request *pb.SetRecipeCoverImageRequest) (*pb.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC SetRecipeCoverImage called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mImage := model.RecipeImage{}
+	_, err = s.recipeImageNamer.Parse(request.GetName(), &mImage)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mImage, err = s.domain.SetRecipeCoverImage(ctx, authAccount, mImage.Parent, mImage.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.SetRecipeCoverImage failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbImage, err := s.RecipeImageToProto(mImage)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbImage)
+
+	log.Info().Msg("gRPC SetRecipeCoverImage success")
+	return pbImage, nil
+}
+
+// RecipeImageToProto converts a model recipe image to a proto recipe image.
+func (s *RecipeService) RecipeImageToProto(mImage model.RecipeImage) (*pb.RecipeImage, error) {
+	name, err := s.recipeImageNamer.Format(mImage)
+	if err != nil {
+		return nil, err
+	}
+
+	pbImage := &pb.RecipeImage{
+		Name:       name,
+		ImageUri:   mImage.ImageURI,
+		Cover:      mImage.Cover,
+		Images:     grpc.ImageSetToProto(mImage.ImageSet),
+		CreateTime: timestamppb.New(mImage.CreateTime),
+	}
+	if mImage.Step != nil {
+		pbImage.Step = &pb.RecipeImage_DirectionStep{
+			DirectionIndex: mImage.Step.DirectionIndex,
+			StepIndex:      mImage.Step.StepIndex,
+		}
+	}
+
+	return pbImage, nil
+}
+
+// ProtoToRecipeImage converts a proto recipe image to a model recipe image.
+func ProtoToRecipeImage(pbImage *pb.RecipeImage) model.RecipeImage {
+	mImage := model.RecipeImage{}
+	if pbImage.GetStep() != nil {
+		mImage.Step = &model.RecipeDirectionStep{
+			DirectionIndex: pbImage.GetStep().GetDirectionIndex(),
+			StepIndex:      pbImage.GetStep().GetStepIndex(),
+		}
+	}
+	return mImage
+}
@@ -0,0 +1,179 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/grpc/meals/recipes/v1alpha1/convert"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	recipeImageCandidateMaxPageSize     int32 = 100
+	recipeImageCandidateDefaultPageSize int32 = 25
+)
+
+// GetRecipeImageCandidate -
+func (s *RecipeService) GetRecipeImageCandidate(ctx context.Context, request *pb.GetRecipeImageCandidateRequest) (*pb.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetRecipeImageCandidate called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCandidate := model.RecipeImageCandidate{}
+	_, err = s.recipeImageCandidateNamer.Parse(request.GetName(), &mCandidate)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mCandidate, err = s.domain.GetRecipeImageCandidate(ctx, authAccount, mCandidate.Parent, mCandidate.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetRecipeImageCandidate failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCandidate, err := s.RecipeImageCandidateToProto(mCandidate)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCandidate)
+
+	log.Info().Msg("gRPC GetRecipeImageCandidate success")
+	return pbCandidate, nil
+}
+
+// ListRecipeImageCandidates -
+func (s *RecipeService) ListRecipeImageCandidates(ctx context.Context, request *pb.ListRecipeImageCandidatesRequest) (*pb.ListRecipeImageCandidatesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListRecipeImageCandidates called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeImageCandidateParent{}
+	_, err = s.recipeImageCandidateNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeImageCandidateDefaultPageSize,
+		MaxPageSize:     recipeImageCandidateMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListRecipeImageCandidates(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListRecipeImageCandidates failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListRecipeImageCandidatesResponse{
+		RecipeImageCandidates: make([]*pb.RecipeImageCandidate, 0, len(res)),
+	}
+	for _, mCandidate := range res {
+		pbCandidate, err := s.RecipeImageCandidateToProto(mCandidate)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbCandidate)
+		response.RecipeImageCandidates = append(response.RecipeImageCandidates, pbCandidate)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListRecipeImageCandidates success")
+	return response, nil
+}
+
+// DeleteRecipeImageCandidate -
+func (s *RecipeService) DeleteRecipeImageCandidate(ctx context.Context, request *pb.DeleteRecipeImageCandidateRequest) (*pb.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteRecipeImageCandidate called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCandidate := model.RecipeImageCandidate{}
+	_, err = s.recipeImageCandidateNamer.Parse(request.GetName(), &mCandidate)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mCandidate, err = s.domain.DeleteRecipeImageCandidate(ctx, authAccount, mCandidate.Parent, mCandidate.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteRecipeImageCandidate failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCandidate, err := s.RecipeImageCandidateToProto(mCandidate)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbCandidate)
+
+	log.Info().Msg("gRPC DeleteRecipeImageCandidate success")
+	return pbCandidate, nil
+}
+
+// SelectRecipeImageCandidate -
+func (s *RecipeService) SelectRecipeImageCandidate(ctx context.Context, request *pb.SelectRecipeImageCandidateRequest) (*pb.SelectRecipeImageCandidateResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC SelectRecipeImageCandidate called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mCandidate := model.RecipeImageCandidate{}
+	_, err = s.recipeImageCandidateNamer.Parse(request.GetName(), &mCandidate)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mRecipe, err := s.domain.SelectRecipeImageCandidate(ctx, authAccount, mCandidate.Parent, mCandidate.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.SelectRecipeImageCandidate failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.Info().Msg("gRPC SelectRecipeImageCandidate success")
+	return &pb.SelectRecipeImageCandidateResponse{
+		ImageUri: mRecipe.ImageURI,
+		Images:   grpc.ImageSetToProto(mRecipe.ImageSet),
+	}, nil
+}
+
+// RecipeImageCandidateToProto converts a model recipe image candidate to a
+// proto recipe image candidate.
+func (s *RecipeService) RecipeImageCandidateToProto(mCandidate model.RecipeImageCandidate) (*pb.RecipeImageCandidate, error) {
+	return convert.RecipeImageCandidateToProto(s.recipeImageCandidateNamer, s.userNamer, mCandidate)
+}
@@ -0,0 +1,163 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeimport"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	recipeImportMaxPageSize     int32 = 100
+	recipeImportDefaultPageSize int32 = 25
+)
+
+// recipeImportListFields leaves the results out of list responses.
+var recipeImportListFields = []string{
+	model.RecipeImportField_Parent,
+	model.RecipeImportField_Id,
+	model.RecipeImportField_Source,
+	model.RecipeImportField_State,
+	model.RecipeImportField_Error,
+	model.RecipeImportField_CreateTime,
+	model.RecipeImportField_CompleteTime,
+}
+
+var recipeImportSources = map[string]pb.RecipeImport_Source{
+	string(recipeimport.SourcePaprika):    pb.RecipeImport_SOURCE_PAPRIKA,
+	string(recipeimport.SourceMealie):     pb.RecipeImport_SOURCE_MEALIE,
+	string(recipeimport.SourceMealMaster): pb.RecipeImport_SOURCE_MEALMASTER,
+	string(recipeimport.SourceCSV):        pb.RecipeImport_SOURCE_CSV,
+}
+
+// GetRecipeImport -
+func (s *RecipeService) GetRecipeImport(ctx context.Context, request *pb.GetRecipeImportRequest) (*pb.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetRecipeImport called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mImport := model.RecipeImport{}
+	_, err = s.recipeImportNamer.Parse(request.GetName(), &mImport)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mImport, err = s.domain.GetRecipeImport(ctx, authAccount, mImport.Parent, mImport.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetRecipeImport failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbImport, err := s.RecipeImportToProto(mImport)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbImport)
+
+	log.Info().Msg("gRPC GetRecipeImport success")
+	return pbImport, nil
+}
+
+// ListRecipeImports -
+func (s *RecipeService) ListRecipeImports(ctx context.Context, request *pb.ListRecipeImportsRequest) (*pb.ListRecipeImportsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListRecipeImports called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeImportParent{}
+	_, err = s.recipeImportNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeImportDefaultPageSize,
+		MaxPageSize:     recipeImportMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListRecipeImports(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset, recipeImportListFields)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListRecipeImports failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListRecipeImportsResponse{
+		RecipeImports: make([]*pb.RecipeImport, 0, len(res)),
+	}
+	for _, mImport := range res {
+		pbImport, err := s.RecipeImportToProto(mImport)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbImport)
+		response.RecipeImports = append(response.RecipeImports, pbImport)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListRecipeImports success")
+	return response, nil
+}
+
+// RecipeImportToProto converts a recipe import model to its proto.
+func (s *RecipeService) RecipeImportToProto(mImport model.RecipeImport) (*pb.RecipeImport, error) {
+	name, err := s.recipeImportNamer.Format(mImport)
+	if err != nil {
+		return nil, err
+	}
+
+	pbImport := &pb.RecipeImport{
+		Name:       name,
+		Source:     recipeImportSources[mImport.Source],
+		State:      pb.RecipeImport_State(mImport.State),
+		Error:      mImport.Error,
+		CreateTime: timestamppb.New(mImport.CreateTime),
+	}
+	if mImport.CompleteTime != nil {
+		pbImport.CompleteTime = timestamppb.New(*mImport.CompleteTime)
+	}
+
+	for _, result := range mImport.Results {
+		pbResult := &pb.RecipeImport_Result{
+			Title:   result.Title,
+			Outcome: pb.RecipeImport_Outcome(result.Outcome),
+			Error:   result.Error,
+		}
+		if result.RecipeId.RecipeId != 0 {
+			pbResult.Recipe, err = s.recipeNamer.Format(model.Recipe{Id: result.RecipeId})
+			if err != nil {
+				return nil, err
+			}
+		}
+		pbImport.Results = append(pbImport.Results, pbResult)
+	}
+
+	return pbImport, nil
+}
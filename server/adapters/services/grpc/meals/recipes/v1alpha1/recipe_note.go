@@ -0,0 +1,290 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	recipeNoteMaxPageSize     int32 = 100
+	recipeNoteDefaultPageSize int32 = 25
+)
+
+var recipeNoteFieldMap = map[string][]string{
+	"name":        {model.RecipeNoteField_Parent, model.RecipeNoteField_Id},
+	"text":        {model.RecipeNoteField_Text},
+	"ingredient":  {model.RecipeNoteField_Ingredient},
+	"step":        {model.RecipeNoteField_Step},
+	"create_time": {model.RecipeNoteField_CreateTime},
+	"update_time": {model.RecipeNoteField_UpdateTime},
+}
+
+// CreateRecipeNote -
+func (s *RecipeService) CreateRecipeNote(ctx context.Context, request *pb.CreateRecipeNoteRequest) (*pb.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreateRecipeNote called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mNote := ProtoToRecipeNote(request.GetRecipeNote())
+	_, err = s.recipeNoteNamer.ParseParent(request.GetParent(), &mNote)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	mNote, err = s.domain.CreateRecipeNote(ctx, authAccount, mNote)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreateRecipeNote failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbNote, err := s.RecipeNoteToProto(mNote)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbNote)
+
+	log.Info().Msg("gRPC CreateRecipeNote success")
+	return pbNote, nil
+}
+
+// GetRecipeNote -
+func (s *RecipeService) GetRecipeNote(ctx context.Context, request *pb.GetRecipeNoteRequest) (*pb.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetRecipeNote called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mNote := model.RecipeNote{}
+	_, err = s.recipeNoteNamer.Parse(request.GetName(), &mNote)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mNote, err = s.domain.GetRecipeNote(ctx, authAccount, mNote.Parent, mNote.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetRecipeNote failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbNote, err := s.RecipeNoteToProto(mNote)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbNote)
+
+	log.Info().Msg("gRPC GetRecipeNote success")
+	return pbNote, nil
+}
+
+// ListRecipeNotes -
+func (s *RecipeService) ListRecipeNotes(ctx context.Context, request *pb.ListRecipeNotesRequest) (*pb.ListRecipeNotesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListRecipeNotes called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeNoteParent{}
+	_, err = s.recipeNoteNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeNoteDefaultPageSize,
+		MaxPageSize:     recipeNoteMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListRecipeNotes(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListRecipeNotes failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListRecipeNotesResponse{
+		RecipeNotes: make([]*pb.RecipeNote, 0, len(res)),
+	}
+	for _, mNote := range res {
+		pbNote, err := s.RecipeNoteToProto(mNote)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbNote)
+		response.RecipeNotes = append(response.RecipeNotes, pbNote)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListRecipeNotes success")
+	return response, nil
+}
+
+// UpdateRecipeNote -
+func (s *RecipeService) UpdateRecipeNote(ctx context.Context, request *pb.UpdateRecipeNoteRequest) (*pb.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateRecipeNote called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessUpdateRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mNote := ProtoToRecipeNote(request.GetRecipeNote())
+	_, err = s.recipeNoteNamer.Parse(request.GetRecipeNote().GetName(), &mNote)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetRecipeNote().GetName())
+	}
+
+	updateMask := s.recipeNoteFieldMasker.Convert(request.GetUpdateMask().GetPaths())
+
+	mNote, err = s.domain.UpdateRecipeNote(ctx, authAccount, mNote, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateRecipeNote failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbNote, err := s.RecipeNoteToProto(mNote)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbNote)
+
+	log.Info().Msg("gRPC UpdateRecipeNote success")
+	return pbNote, nil
+}
+
+// DeleteRecipeNote -
+func (s *RecipeService) DeleteRecipeNote(ctx context.Context, request *pb.DeleteRecipeNoteRequest) (*pb.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteRecipeNote called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mNote := model.RecipeNote{}
+	_, err = s.recipeNoteNamer.Parse(request.GetName(), &mNote)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mNote, err = s.domain.DeleteRecipeNote(ctx, authAccount, mNote.Parent, mNote.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteRecipeNote failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbNote, err := s.RecipeNoteToProto(mNote)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbNote)
+
+	log.Info().Msg("gRPC DeleteRecipeNote success")
+	return pbNote, nil
+}
+
+// RecipeNoteToProto converts a model recipe note to a proto recipe note.
+func (s *RecipeService) RecipeNoteToProto(mNote model.RecipeNote) (*pb.RecipeNote, error) {
+	name, err := s.recipeNoteNamer.Format(mNote)
+	if err != nil {
+		return nil, err
+	}
+
+	pbNote := &pb.RecipeNote{
+		Name:       name,
+		Text:       mNote.Text,
+		CreateTime: timestamppb.New(mNote.CreateTime),
+		UpdateTime: timestamppb.New(mNote.UpdateTime),
+	}
+
+	if mNote.Ingredient != nil {
+		pbNote.Ingredient = &pb.RecipeNote_Ingredient{
+			GroupIndex:      mNote.Ingredient.GroupIndex,
+			IngredientIndex: mNote.Ingredient.IngredientIndex,
+		}
+	}
+
+	if mNote.Step != nil {
+		pbNote.Step = &pb.RecipeNote_DirectionStep{
+			DirectionIndex: mNote.Step.DirectionIndex,
+			StepIndex:      mNote.Step.StepIndex,
+		}
+	}
+
+	return pbNote, nil
+}
+
+// ProtoToRecipeNote converts a proto recipe note to a model recipe note.
+func ProtoToRecipeNote(pbNote *pb.RecipeNote) model.RecipeNote {
+	mNote := model.RecipeNote{
+		Text: pbNote.GetText(),
+	}
+
+	if pbNote.GetIngredient() != nil {
+		mNote.Ingredient = &model.RecipeStepIngredient{
+			GroupIndex:      pbNote.GetIngredient().GetGroupIndex(),
+			IngredientIndex: pbNote.GetIngredient().GetIngredientIndex(),
+		}
+	}
+
+	if pbNote.GetStep() != nil {
+		mNote.Step = &model.RecipeDirectionStep{
+			DirectionIndex: pbNote.GetStep().GetDirectionIndex(),
+			StepIndex:      pbNote.GetStep().GetStepIndex(),
+		}
+	}
+
+	return mNote
+}
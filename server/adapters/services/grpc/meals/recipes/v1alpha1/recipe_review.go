@@ -0,0 +1,269 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	recipeReviewMaxPageSize     int32 = 100
+	recipeReviewDefaultPageSize int32 = 25
+)
+
+var recipeReviewFieldMap = map[string][]string{
+	"name":        {model.RecipeReviewField_Parent, model.RecipeReviewField_Id},
+	"author":      {model.RecipeReviewField_AuthorId},
+	"rating":      {model.RecipeReviewField_Rating},
+	"text":        {model.RecipeReviewField_Text},
+	"create_time": {model.RecipeReviewField_CreateTime},
+	"update_time": {model.RecipeReviewField_UpdateTime},
+}
+
+// CreateRecipeReview -
+func (s *RecipeService) CreateRecipeReview(ctx context.Context, request *pb.CreateRecipeReviewRequest) (*pb.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreateRecipeReview called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mReview := ProtoToRecipeReview(request.GetRecipeReview())
+	_, err = s.recipeReviewNamer.ParseParent(request.GetParent(), &mReview)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	mReview, err = s.domain.CreateRecipeReview(ctx, authAccount, mReview)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreateRecipeReview failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbReview, err := s.RecipeReviewToProto(mReview)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbReview)
+
+	log.Info().Msg("gRPC CreateRecipeReview success")
+	return pbReview, nil
+}
+
+// GetRecipeReview -
+func (s *RecipeService) GetRecipeReview(ctx context.Context, request *pb.GetRecipeReviewRequest) (*pb.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetRecipeReview called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mReview := model.RecipeReview{}
+	_, err = s.recipeReviewNamer.Parse(request.GetName(), &mReview)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mReview, err = s.domain.GetRecipeReview(ctx, authAccount, mReview.Parent, mReview.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetRecipeReview failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbReview, err := s.RecipeReviewToProto(mReview)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbReview)
+
+	log.Info().Msg("gRPC GetRecipeReview success")
+	return pbReview, nil
+}
+
+// ListRecipeReviews -
+func (s *RecipeService) ListRecipeReviews(ctx context.Context, request *pb.ListRecipeReviewsRequest) (*pb.ListRecipeReviewsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListRecipeReviews called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeReviewParent{}
+	_, err = s.recipeReviewNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeReviewDefaultPageSize,
+		MaxPageSize:     recipeReviewMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListRecipeReviews(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListRecipeReviews failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListRecipeReviewsResponse{
+		RecipeReviews: make([]*pb.RecipeReview, 0, len(res)),
+	}
+	for _, mReview := range res {
+		pbReview, err := s.RecipeReviewToProto(mReview)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbReview)
+		response.RecipeReviews = append(response.RecipeReviews, pbReview)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListRecipeReviews success")
+	return response, nil
+}
+
+// UpdateRecipeReview -
+func (s *RecipeService) UpdateRecipeReview(ctx context.Context, request *pb.UpdateRecipeReviewRequest) (*pb.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdateRecipeReview called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessUpdateRequestFieldBehavior(request)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid request data")
+		return nil, err
+	}
+
+	mReview := ProtoToRecipeReview(request.GetRecipeReview())
+	_, err = s.recipeReviewNamer.Parse(request.GetRecipeReview().GetName(), &mReview)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetRecipeReview().GetName())
+	}
+
+	updateMask := s.recipeReviewFieldMasker.Convert(request.GetUpdateMask().GetPaths())
+
+	mReview, err = s.domain.UpdateRecipeReview(ctx, authAccount, mReview, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdateRecipeReview failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbReview, err := s.RecipeReviewToProto(mReview)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbReview)
+
+	log.Info().Msg("gRPC UpdateRecipeReview success")
+	return pbReview, nil
+}
+
+// DeleteRecipeReview -
+func (s *RecipeService) DeleteRecipeReview(ctx context.Context, request *pb.DeleteRecipeReviewRequest) (*pb.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteRecipeReview called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mReview := model.RecipeReview{}
+	_, err = s.recipeReviewNamer.Parse(request.GetName(), &mReview)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mReview, err = s.domain.DeleteRecipeReview(ctx, authAccount, mReview.Parent, mReview.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteRecipeReview failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbReview, err := s.RecipeReviewToProto(mReview)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbReview)
+
+	log.Info().Msg("gRPC DeleteRecipeReview success")
+	return pbReview, nil
+}
+
+// RecipeReviewToProto converts a model recipe review to a proto recipe review.
+func (s *RecipeService) RecipeReviewToProto(mReview model.RecipeReview) (*pb.RecipeReview, error) {
+	name, err := s.recipeReviewNamer.Format(mReview)
+	if err != nil {
+		return nil, err
+	}
+
+	pbReview := &pb.RecipeReview{
+		Name:       name,
+		Rating:     mReview.Rating,
+		Text:       mReview.Text,
+		CreateTime: timestamppb.New(mReview.CreateTime),
+		UpdateTime: timestamppb.New(mReview.UpdateTime),
+	}
+
+	if mReview.AuthorId.UserId != 0 {
+		pbReview.Author, err = s.userNamer.Format(model.User{Id: mReview.AuthorId})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pbReview, nil
+}
+
+// ProtoToRecipeReview converts a proto recipe review to a model recipe review.
+func ProtoToRecipeReview(pbReview *pb.RecipeReview) model.RecipeReview {
+	return model.RecipeReview{
+		Rating: pbReview.GetRating(),
+		Text:   pbReview.GetText(),
+	}
+}
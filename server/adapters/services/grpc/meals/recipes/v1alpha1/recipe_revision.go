@@ -0,0 +1,304 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/grpc/meals/recipes/v1alpha1/convert"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	recipeRevisionMaxPageSize     int32 = 100
+	recipeRevisionDefaultPageSize int32 = 25
+)
+
+// recipeRevisionListFields leaves the snapshot out of list responses.
+var recipeRevisionListFields = []string{
+	model.RecipeRevisionField_Parent,
+	model.RecipeRevisionField_Id,
+	model.RecipeRevisionField_EditorId,
+	model.RecipeRevisionField_UpdateMask,
+	model.RecipeRevisionField_CreateTime,
+}
+
+// recipeProtoPaths maps the recipe model fields back to the proto paths.
+var recipeProtoPaths = func() map[string]string {
+	paths := map[string]string{}
+	for path, fields := range recipeFieldMap {
+		if len(fields) == 1 {
+			paths[fields[0]] = path
+		}
+	}
+	return paths
+}()
+
+// GetRecipeRevision -
+func (s *RecipeService) GetRecipeRevision(ctx context.Context, request *pb.GetRecipeRevisionRequest) (*pb.RecipeRevision, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetRecipeRevision called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mRevision := model.RecipeRevision{}
+	_, err = s.recipeRevisionNamer.Parse(request.GetName(), &mRevision)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mRevision, err = s.domain.GetRecipeRevision(ctx, authAccount, mRevision.Parent, mRevision.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetRecipeRevision failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbRevision, err := s.RecipeRevisionToProto(mRevision)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbRevision)
+
+	log.Info().Msg("gRPC GetRecipeRevision success")
+	return pbRevision, nil
+}
+
+// ListRecipeRevisions -
+func (s *RecipeService) ListRecipeRevisions(ctx context.Context, request *pb.ListRecipeRevisionsRequest) (*pb.ListRecipeRevisionsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListRecipeRevisions called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.RecipeRevisionParent{}
+	_, err = s.recipeRevisionNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: recipeRevisionDefaultPageSize,
+		MaxPageSize:     recipeRevisionMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListRecipeRevisions(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset, recipeRevisionListFields)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListRecipeRevisions failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListRecipeRevisionsResponse{
+		RecipeRevisions: make([]*pb.RecipeRevision, 0, len(res)),
+	}
+	for _, mRevision := range res {
+		pbRevision, err := s.RecipeRevisionToProto(mRevision)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbRevision)
+		response.RecipeRevisions = append(response.RecipeRevisions, pbRevision)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListRecipeRevisions success")
+	return response, nil
+}
+
+// DiffRecipeRevisions -
+func (s *RecipeService) DiffRecipeRevisions(ctx context.Context, request *pb.DiffRecipeRevisionsRequest) (*pb.RecipeRevisionDiff, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DiffRecipeRevisions called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mRevision := model.RecipeRevision{}
+	_, err = s.recipeRevisionNamer.Parse(request.GetName(), &mRevision)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mBaseRevision := model.RecipeRevision{}
+	if request.GetBaseRevision() != "" {
+		_, err = s.recipeRevisionNamer.Parse(request.GetBaseRevision(), &mBaseRevision)
+		if err != nil || mBaseRevision.Parent != mRevision.Parent {
+			log.Warn().Err(err).Msg("invalid base revision")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid base revision: %v", request.GetBaseRevision())
+		}
+	}
+
+	mBase, mTarget, mDiff, err := s.domain.DiffRecipeRevisions(ctx, authAccount, mRevision.Parent, mRevision.Id, mBaseRevision.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DiffRecipeRevisions failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbDiff := &pb.RecipeRevisionDiff{
+		ChangedFields:     make([]string, 0, len(mDiff.ChangedFields)),
+		IngredientChanges: make([]*pb.RecipeRevisionDiff_IngredientChange, 0, len(mDiff.IngredientChanges)),
+		DirectionChanges:  make([]*pb.RecipeRevisionDiff_DirectionChange, 0, len(mDiff.DirectionChanges)),
+	}
+
+	pbDiff.TargetRevision, err = s.recipeRevisionNamer.Format(mTarget)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+	// the first revision is compared with an empty recipe
+	if mBase.Id.RecipeRevisionId != 0 {
+		pbDiff.BaseRevision, err = s.recipeRevisionNamer.Format(mBase)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+	}
+
+	for _, field := range mDiff.ChangedFields {
+		pbDiff.ChangedFields = append(pbDiff.ChangedFields, recipeProtoPaths[field])
+	}
+
+	for _, change := range mDiff.IngredientChanges {
+		pbChange := &pb.RecipeRevisionDiff_IngredientChange{
+			ChangeType: pb.RecipeRevisionDiff_ChangeType(change.ChangeType),
+			GroupTitle: change.GroupTitle,
+		}
+		if change.Before != nil {
+			pbChange.Before = convert.RecipeIngredientsToProto(s.ingredientNamer, *change.Before)
+		}
+		if change.After != nil {
+			pbChange.After = convert.RecipeIngredientsToProto(s.ingredientNamer, *change.After)
+		}
+		pbDiff.IngredientChanges = append(pbDiff.IngredientChanges, pbChange)
+	}
+
+	for _, change := range mDiff.DirectionChanges {
+		pbDiff.DirectionChanges = append(pbDiff.DirectionChanges, &pb.RecipeRevisionDiff_DirectionChange{
+			ChangeType:     pb.RecipeRevisionDiff_ChangeType(change.ChangeType),
+			DirectionTitle: change.DirectionTitle,
+			Before:         change.Before,
+			After:          change.After,
+		})
+	}
+
+	log.Info().Msg("gRPC DiffRecipeRevisions success")
+	return pbDiff, nil
+}
+
+// RevertRecipe -
+func (s *RecipeService) RevertRecipe(ctx context.Context, request *pb.RevertRecipeRequest) (*pb.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC RevertRecipe called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	mRecipe := model.Recipe{}
+	_, err = s.recipeNamer.Parse(request.GetName(), &mRecipe)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mRevision := model.RecipeRevision{}
+	_, err = s.recipeRevisionNamer.Parse(request.GetRevision(), &mRevision)
+	if err != nil || mRevision.Parent.RecipeId != mRecipe.Id {
+		log.Warn().Err(err).Msg("invalid revision")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid revision: %v", request.GetRevision())
+	}
+
+	dbRecipe, err := s.domain.RevertRecipe(ctx, authAccount, mRecipe.Id, mRevision.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.RevertRecipe failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	dbRecipe.Parent = mRecipe.Parent
+
+	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, dbRecipe)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbRecipe)
+
+	log.Info().Msg("gRPC RevertRecipe success")
+	return pbRecipe, nil
+}
+
+// RecipeRevisionToProto converts a model recipe revision to a proto recipe
+// revision. The recipe is only set when the snapshot was read.
+func (s *RecipeService) RecipeRevisionToProto(mRevision model.RecipeRevision) (*pb.RecipeRevision, error) {
+	name, err := s.recipeRevisionNamer.Format(mRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	pbRevision := &pb.RecipeRevision{
+		Name:       name,
+		CreateTime: timestamppb.New(mRevision.CreateTime),
+	}
+
+	if mRevision.EditorId.UserId != 0 {
+		pbRevision.Editor, err = s.userNamer.Format(model.User{Id: mRevision.EditorId})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(mRevision.UpdateMask) > 0 {
+		pbRevision.UpdateMask = &fieldmaskpb.FieldMask{}
+		for _, field := range mRevision.UpdateMask {
+			if path, ok := recipeProtoPaths[field]; ok {
+				pbRevision.UpdateMask.Paths = append(pbRevision.UpdateMask.Paths, path)
+			}
+		}
+	}
+
+	if mRevision.Recipe.Id.RecipeId != 0 {
+		recipe := mRevision.Recipe
+		recipe.Id = mRevision.Parent.RecipeId
+		pbRevision.Recipe, err = convert.RecipeToProto(s.recipeNamer, s.accessNamer, s.ingredientNamer, recipe)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pbRevision, nil
+}
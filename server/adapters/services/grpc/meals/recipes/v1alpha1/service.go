@@ -14,27 +14,57 @@ import (
 type NewRecipeServiceParams struct {
 	fx.In
 
-	Domain            domain.Domain
-	Log               zerolog.Logger
-	RecipeFieldMasker fieldmask.FieldMasker `name:"v1alpha1RecipeFieldMasker"`
-	AccessFieldMasker fieldmask.FieldMasker `name:"v1alpha1RecipeAccessFieldMasker"`
-	RecipeNamer       namer.ReflectNamer    `name:"v1alpha1RecipeNamer"`
-	AccessNamer       namer.ReflectNamer    `name:"v1alpha1RecipeAccessNamer"`
-	UserNamer         namer.ReflectNamer    `name:"v1alpha1UserNamer"`
-	CircleNamer       namer.ReflectNamer    `name:"v1alpha1CircleNamer"`
+	Domain             domain.Domain
+	Log                zerolog.Logger
+	RecipeFieldMasker  fieldmask.FieldMasker `name:"v1alpha1RecipeFieldMasker"`
+	AccessFieldMasker  fieldmask.FieldMasker `name:"v1alpha1RecipeAccessFieldMasker"`
+	ReviewFieldMasker  fieldmask.FieldMasker `name:"v1alpha1RecipeReviewFieldMasker"`
+	CookLogFieldMasker fieldmask.FieldMasker `name:"v1alpha1RecipeCookLogFieldMasker"`
+	NoteFieldMasker    fieldmask.FieldMasker `name:"v1alpha1RecipeNoteFieldMasker"`
+	ImageFieldMasker   fieldmask.FieldMasker `name:"v1alpha1RecipeImageFieldMasker"`
+	RecipeNamer        namer.ReflectNamer    `name:"v1alpha1RecipeNamer"`
+	AccessNamer        namer.ReflectNamer    `name:"v1alpha1RecipeAccessNamer"`
+	IngredientNamer    namer.ReflectNamer    `name:"v1alpha1IngredientNamer"`
+	RevisionNamer      namer.ReflectNamer    `name:"v1alpha1RecipeRevisionNamer"`
+	ReviewNamer        namer.ReflectNamer    `name:"v1alpha1RecipeReviewNamer"`
+	CookLogNamer       namer.ReflectNamer    `name:"v1alpha1RecipeCookLogNamer"`
+	NoteNamer          namer.ReflectNamer    `name:"v1alpha1RecipeNoteNamer"`
+	EventRecipeNamer   namer.ReflectNamer    `name:"v1alpha1EventRecipeNamer"`
+	ImportNamer        namer.ReflectNamer    `name:"v1alpha1RecipeImportNamer"`
+	ImageNamer         namer.ReflectNamer    `name:"v1alpha1RecipeImageNamer"`
+	CandidateNamer     namer.ReflectNamer    `name:"v1alpha1RecipeImageCandidateNamer"`
+	UserNamer          namer.ReflectNamer    `name:"v1alpha1UserNamer"`
+	CircleNamer        namer.ReflectNamer    `name:"v1alpha1CircleNamer"`
+	OperationNamer     namer.ReflectNamer    `name:"v1alpha1OperationNamer"`
+	PantryNamer        namer.ReflectNamer    `name:"v1alpha1PantryNamer"`
 }
 
 // NewRecipeService creates a new RecipeService.
 func NewRecipeService(params NewRecipeServiceParams) (*RecipeService, error) {
 	return &RecipeService{
-		domain:            params.Domain,
-		log:               params.Log,
-		recipeFieldMasker: params.RecipeFieldMasker,
-		accessFieldMasker: params.AccessFieldMasker,
-		recipeNamer:       params.RecipeNamer,
-		userNamer:         params.UserNamer,
-		accessNamer:       params.AccessNamer,
-		circleNamer:       params.CircleNamer,
+		domain:                    params.Domain,
+		log:                       params.Log,
+		recipeFieldMasker:         params.RecipeFieldMasker,
+		accessFieldMasker:         params.AccessFieldMasker,
+		recipeNamer:               params.RecipeNamer,
+		userNamer:                 params.UserNamer,
+		accessNamer:               params.AccessNamer,
+		ingredientNamer:           params.IngredientNamer,
+		recipeRevisionNamer:       params.RevisionNamer,
+		recipeReviewNamer:         params.ReviewNamer,
+		recipeReviewFieldMasker:   params.ReviewFieldMasker,
+		recipeCookLogNamer:        params.CookLogNamer,
+		recipeCookLogFieldMasker:  params.CookLogFieldMasker,
+		recipeNoteNamer:           params.NoteNamer,
+		recipeNoteFieldMasker:     params.NoteFieldMasker,
+		eventRecipeNamer:          params.EventRecipeNamer,
+		recipeImportNamer:         params.ImportNamer,
+		recipeImageNamer:          params.ImageNamer,
+		recipeImageFieldMasker:    params.ImageFieldMasker,
+		recipeImageCandidateNamer: params.CandidateNamer,
+		circleNamer:               params.CircleNamer,
+		operationNamer:            params.OperationNamer,
+		pantryNamer:               params.PantryNamer,
 	}, nil
 }
 
@@ -42,12 +72,35 @@ func NewRecipeService(params NewRecipeServiceParams) (*RecipeService, error) {
 type RecipeService struct {
 	pb.UnimplementedRecipeServiceServer
 	pb.UnimplementedRecipeAccessServiceServer
-	domain            domain.Domain
-	log               zerolog.Logger
-	recipeFieldMasker fieldmask.FieldMasker
-	accessFieldMasker fieldmask.FieldMasker
-	recipeNamer       namer.ReflectNamer
-	userNamer         namer.ReflectNamer
-	circleNamer       namer.ReflectNamer
-	accessNamer       namer.ReflectNamer
+	pb.UnimplementedIngredientServiceServer
+	pb.UnimplementedRecipeRevisionServiceServer
+	pb.UnimplementedRecipeReviewServiceServer
+	pb.UnimplementedRecipeCookLogServiceServer
+	pb.UnimplementedRecipeNoteServiceServer
+	pb.UnimplementedRecipeImportServiceServer
+	pb.UnimplementedRecipeImageServiceServer
+	pb.UnimplementedRecipeImageCandidateServiceServer
+	domain                    domain.Domain
+	log                       zerolog.Logger
+	recipeFieldMasker         fieldmask.FieldMasker
+	accessFieldMasker         fieldmask.FieldMasker
+	recipeNamer               namer.ReflectNamer
+	userNamer                 namer.ReflectNamer
+	circleNamer               namer.ReflectNamer
+	accessNamer               namer.ReflectNamer
+	ingredientNamer           namer.ReflectNamer
+	recipeRevisionNamer       namer.ReflectNamer
+	recipeReviewNamer         namer.ReflectNamer
+	recipeReviewFieldMasker   fieldmask.FieldMasker
+	recipeCookLogNamer        namer.ReflectNamer
+	recipeCookLogFieldMasker  fieldmask.FieldMasker
+	recipeNoteNamer           namer.ReflectNamer
+	recipeNoteFieldMasker     fieldmask.FieldMasker
+	eventRecipeNamer          namer.ReflectNamer
+	recipeImportNamer         namer.ReflectNamer
+	recipeImageNamer          namer.ReflectNamer
+	recipeImageFieldMasker    fieldmask.FieldMasker
+	recipeImageCandidateNamer namer.ReflectNamer
+	operationNamer            namer.ReflectNamer
+	pantryNamer               namer.ReflectNamer
 }
@@ -0,0 +1,110 @@
+package convert
+
+import (
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	recipeConvert "github.com/jcfug8/daylear/server/adapters/services/grpc/meals/recipes/v1alpha1/convert"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+)
+
+var operationKinds = map[model.OperationKind]pb.OperationMetadata_Kind{
+	model.OperationKind_ScrapeRecipe:        pb.OperationMetadata_KIND_SCRAPE_RECIPE,
+	model.OperationKind_OCRRecipe:           pb.OperationMetadata_KIND_OCR_RECIPE,
+	model.OperationKind_GenerateRecipeImage: pb.OperationMetadata_KIND_GENERATE_RECIPE_IMAGE,
+	model.OperationKind_ImportRecipes:       pb.OperationMetadata_KIND_IMPORT_RECIPES,
+}
+
+// OperationToProto converts a model Operation to a protobuf Operation. The
+// recipe, candidate and user namers are used to format the response.
+func OperationToProto(OperationNamer namer.ReflectNamer, RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, IngredientNamer namer.ReflectNamer, CandidateNamer namer.ReflectNamer, UserNamer namer.ReflectNamer, operation model.Operation) (*pb.Operation, error) {
+	name, err := OperationNamer.Format(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &pb.OperationMetadata{
+		Kind:       operationKinds[operation.Kind],
+		State:      pb.OperationMetadata_State(operation.State),
+		CreateTime: timestamppb.New(operation.CreateTime),
+		UpdateTime: timestamppb.New(operation.UpdateTime),
+	}
+	if operation.EndTime != nil {
+		metadata.EndTime = timestamppb.New(*operation.EndTime)
+	}
+	if operation.ExpireTime != nil {
+		metadata.ExpireTime = timestamppb.New(*operation.ExpireTime)
+	}
+
+	switch operation.Kind {
+	case model.OperationKind_ScrapeRecipe:
+		metadata.Target = operation.Request.URI
+	case model.OperationKind_GenerateRecipeImage:
+		metadata.Target, err = RecipeNamer.Format(model.Recipe{Parent: operation.Request.RecipeParent, Id: operation.Request.RecipeId})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proto := &pb.Operation{
+		Name:     name,
+		Metadata: metadata,
+		Done:     operation.State.Done(),
+	}
+
+	switch operation.State {
+	case model.OperationState_Failed:
+		proto.Result = &pb.Operation_Error{Error: &spb.Status{Code: int32(codes.Unknown), Message: operation.Error}}
+	case model.OperationState_Cancelled:
+		proto.Result = &pb.Operation_Error{Error: &spb.Status{Code: int32(codes.Canceled), Message: operation.Error}}
+	case model.OperationState_Succeeded:
+		response, err := operationResponseToProto(RecipeNamer, AccessNamer, IngredientNamer, CandidateNamer, UserNamer, operation)
+		if err != nil {
+			return nil, err
+		}
+		if response != nil {
+			packed, err := anypb.New(response)
+			if err != nil {
+				return nil, err
+			}
+			proto.Result = &pb.Operation_Response{Response: packed}
+		}
+	}
+
+	return proto, nil
+}
+
+// operationResponseToProto converts the result of an operation to the
+// response message of its kind.
+func operationResponseToProto(RecipeNamer namer.ReflectNamer, AccessNamer namer.ReflectNamer, IngredientNamer namer.ReflectNamer, CandidateNamer namer.ReflectNamer, UserNamer namer.ReflectNamer, operation model.Operation) (proto.Message, error) {
+	result := operation.Result
+	if result == nil {
+		return nil, nil
+	}
+
+	switch operation.Kind {
+	case model.OperationKind_ScrapeRecipe, model.OperationKind_OCRRecipe:
+		if result.Recipe == nil {
+			return nil, nil
+		}
+		response, err := recipeConvert.ScrapeRecipeResponseToProto(RecipeNamer, AccessNamer, IngredientNamer, *result.Recipe, result.Duplicates)
+		if err != nil {
+			return nil, err
+		}
+		grpc.ProcessResponseFieldBehavior(response.Recipe)
+		for _, duplicate := range response.Duplicates {
+			grpc.ProcessResponseFieldBehavior(duplicate.Recipe)
+		}
+		return response, nil
+	case model.OperationKind_GenerateRecipeImage:
+		return recipeConvert.GenerateRecipeImageResponseToProto(CandidateNamer, UserNamer, result.Candidates)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,22 @@
+package v1alpha1
+
+import (
+	"go.uber.org/fx"
+
+	namer "github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
+)
+
+var Module = fx.Module(
+	"operationGrpcAdapter",
+	fx.Provide(
+		fx.Annotate(
+			NewOperationService,
+			fx.As(new(pb.OperationServiceServer)),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Operation]() },
+			fx.ResultTags(`name:"v1alpha1OperationNamer"`),
+		),
+	),
+)
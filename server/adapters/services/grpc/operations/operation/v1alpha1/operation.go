@@ -0,0 +1,223 @@
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/grpc/operations/operation/v1alpha1/convert"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	operationMaxPageSize     int32 = 100
+	operationDefaultPageSize int32 = 25
+)
+
+// maxOperationWaitTimeout is the longest WaitOperation waits, and how long it
+// waits when no timeout is given.
+const maxOperationWaitTimeout = time.Minute
+
+// GetOperation -
+func (s *OperationService) GetOperation(ctx context.Context, request *pb.GetOperationRequest) (*pb.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetOperation called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mOperation := model.Operation{}
+	_, err = s.operationNamer.Parse(request.GetName(), &mOperation)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mOperation, err = s.domain.GetOperation(ctx, authAccount, mOperation.Parent, mOperation.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetOperation failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbOperation, err := s.OperationToProto(mOperation)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC GetOperation success")
+	return pbOperation, nil
+}
+
+// ListOperations -
+func (s *OperationService) ListOperations(ctx context.Context, request *pb.ListOperationsRequest) (*pb.ListOperationsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListOperations called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mParent := model.OperationParent{}
+	_, err = s.operationNamer.ParseParent(request.GetParent(), &mParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: operationDefaultPageSize,
+		MaxPageSize:     operationMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListOperations(ctx, authAccount, mParent, request.GetPageSize(), pageToken.Offset)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListOperations failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ListOperationsResponse{
+		Operations: make([]*pb.Operation, 0, len(res)),
+	}
+	for _, mOperation := range res {
+		pbOperation, err := s.OperationToProto(mOperation)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		response.Operations = append(response.Operations, pbOperation)
+	}
+
+	if len(res) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListOperations success")
+	return response, nil
+}
+
+// CancelOperation -
+func (s *OperationService) CancelOperation(ctx context.Context, request *pb.CancelOperationRequest) (*pb.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CancelOperation called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mOperation := model.Operation{}
+	_, err = s.operationNamer.Parse(request.GetName(), &mOperation)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mOperation, err = s.domain.CancelOperation(ctx, authAccount, mOperation.Parent, mOperation.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CancelOperation failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbOperation, err := s.OperationToProto(mOperation)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC CancelOperation success")
+	return pbOperation, nil
+}
+
+// DeleteOperation -
+func (s *OperationService) DeleteOperation(ctx context.Context, request *pb.DeleteOperationRequest) (*emptypb.Empty, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeleteOperation called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mOperation := model.Operation{}
+	_, err = s.operationNamer.Parse(request.GetName(), &mOperation)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	err = s.domain.DeleteOperation(ctx, authAccount, mOperation.Parent, mOperation.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeleteOperation failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.Info().Msg("gRPC DeleteOperation success")
+	return &emptypb.Empty{}, nil
+}
+
+// WaitOperation -
+func (s *OperationService) WaitOperation(ctx context.Context, request *pb.WaitOperationRequest) (*pb.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC WaitOperation called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mOperation := model.Operation{}
+	_, err = s.operationNamer.Parse(request.GetName(), &mOperation)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	timeout := maxOperationWaitTimeout
+	if request.GetTimeout() != nil {
+		if err := request.GetTimeout().CheckValid(); err != nil || request.GetTimeout().AsDuration() < 0 {
+			log.Warn().Msg("invalid timeout")
+			return nil, status.Error(codes.InvalidArgument, "invalid timeout")
+		}
+		timeout = min(request.GetTimeout().AsDuration(), maxOperationWaitTimeout)
+	}
+
+	mOperation, err = s.domain.WaitOperation(ctx, authAccount, mOperation.Parent, mOperation.Id, timeout)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.WaitOperation failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbOperation, err := s.OperationToProto(mOperation)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC WaitOperation success")
+	return pbOperation, nil
+}
+
+// OperationToProto converts an operation model to its proto.
+func (s *OperationService) OperationToProto(mOperation model.Operation) (*pb.Operation, error) {
+	pbOperation, err := convert.OperationToProto(s.operationNamer, s.recipeNamer, s.accessNamer, s.ingredientNamer, s.candidateNamer, s.userNamer, mOperation)
+	if err != nil {
+		return nil, err
+	}
+	grpc.ProcessResponseFieldBehavior(pbOperation)
+	return pbOperation, nil
+}
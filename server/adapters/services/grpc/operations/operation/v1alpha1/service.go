@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	namer "github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+// NewOperationServiceParams defines the dependencies for the OperationService.
+type NewOperationServiceParams struct {
+	fx.In
+
+	Domain          domain.Domain
+	Log             zerolog.Logger
+	OperationNamer  namer.ReflectNamer `name:"v1alpha1OperationNamer"`
+	RecipeNamer     namer.ReflectNamer `name:"v1alpha1RecipeNamer"`
+	AccessNamer     namer.ReflectNamer `name:"v1alpha1RecipeAccessNamer"`
+	IngredientNamer namer.ReflectNamer `name:"v1alpha1IngredientNamer"`
+	CandidateNamer  namer.ReflectNamer `name:"v1alpha1RecipeImageCandidateNamer"`
+	UserNamer       namer.ReflectNamer `name:"v1alpha1UserNamer"`
+}
+
+// NewOperationService creates a new OperationService.
+func NewOperationService(params NewOperationServiceParams) (*OperationService, error) {
+	return &OperationService{
+		domain:          params.Domain,
+		log:             params.Log,
+		operationNamer:  params.OperationNamer,
+		recipeNamer:     params.RecipeNamer,
+		accessNamer:     params.AccessNamer,
+		ingredientNamer: params.IngredientNamer,
+		candidateNamer:  params.CandidateNamer,
+		userNamer:       params.UserNamer,
+	}, nil
+}
+
+// OperationService defines the grpc handlers for the OperationService.
+type OperationService struct {
+	pb.UnimplementedOperationServiceServer
+	domain          domain.Domain
+	log             zerolog.Logger
+	operationNamer  namer.ReflectNamer
+	recipeNamer     namer.ReflectNamer
+	accessNamer     namer.ReflectNamer
+	ingredientNamer namer.ReflectNamer
+	candidateNamer  namer.ReflectNamer
+	userNamer       namer.ReflectNamer
+}
@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/jcfug8/daylear/server/core/fieldmask"
+	"github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1"
+)
+
+var Module = fx.Module(
+	"pantryGrpcAdapter",
+	fx.Provide(
+		NewPantryService,
+		func(s *PantryService) pb.PantryServiceServer { return s },
+		func(s *PantryService) pb.PantryAccessServiceServer { return s },
+		func(s *PantryService) pb.PantryItemServiceServer { return s },
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Access]() },
+			fx.ResultTags(`name:"v1alpha1PantryAccessNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.Pantry]() },
+			fx.ResultTags(`name:"v1alpha1PantryNamer"`),
+		),
+		fx.Annotate(
+			func() (namer.ReflectNamer, error) { return namer.NewReflectNamer[*pb.PantryItem]() },
+			fx.ResultTags(`name:"v1alpha1PantryItemNamer"`),
+		),
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.Pantry{}, pantryFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1PantryFieldMasker"`),
+		),
+
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.Access{}, pantryAccessFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1PantryAccessFieldMasker"`),
+		),
+
+		fx.Annotate(
+			func() (fieldmask.FieldMasker, error) {
+				return fieldmask.NewProtoFieldMasker(&pb.PantryItem{}, pantryItemFieldMap)
+			},
+			fx.ResultTags(`name:"v1alpha1PantryItemFieldMasker"`),
+		),
+	),
+)
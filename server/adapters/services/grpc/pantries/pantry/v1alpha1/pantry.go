@@ -0,0 +1,392 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	pantryMaxPageSize     int32 = 1000
+	pantryDefaultPageSize int32 = 100
+)
+
+var pantryFieldMap = map[string][]string{
+	"name":        {model.PantryField_Parent, model.PantryField_Id},
+	"title":       {model.PantryField_Title},
+	"description": {model.PantryField_Description},
+	"visibility":  {model.PantryField_VisibilityLevel},
+	"create_time": {model.PantryField_CreateTime},
+	"update_time": {model.PantryField_UpdateTime},
+
+	"pantry_access": {model.PantryField_PantryAccess},
+}
+
+// CreatePantry -
+func (s *PantryService) CreatePantry(ctx context.Context, request *pb.CreatePantryRequest) (response *pb.Pantry, err error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreatePantry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	// convert proto to model
+	pbPantry := request.GetPantry()
+	pbPantry.Name = ""
+	mPantry, err := s.ProtoToPantry(pbPantry)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to convert proto to model")
+		return nil, status.Error(codes.InvalidArgument, "invalid request data")
+	}
+
+	_, err = s.pantryNamer.ParseParent(request.GetParent(), &mPantry.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	// create pantry
+	mPantry, err = s.domain.CreatePantry(ctx, authAccount, mPantry)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreatePantry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// convert model to proto
+	pbPantry, err = s.PantryToProto(mPantry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// check field behavior
+	grpc.ProcessResponseFieldBehavior(pbPantry)
+	log.Info().Msg("gRPC CreatePantry success")
+	return pbPantry, nil
+}
+
+// DeletePantry -
+func (s *PantryService) DeletePantry(ctx context.Context, request *pb.DeletePantryRequest) (*pb.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeletePantry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mPantry := model.Pantry{}
+	_, err = s.pantryNamer.Parse(request.GetName(), &mPantry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mPantry, err = s.domain.DeletePantry(ctx, authAccount, mPantry.Parent, mPantry.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeletePantry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantry, err := s.PantryToProto(mPantry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbPantry)
+	log.Info().Msg("gRPC DeletePantry success")
+	return pbPantry, nil
+}
+
+// GetPantry -
+func (s *PantryService) GetPantry(ctx context.Context, request *pb.GetPantryRequest) (*pb.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetPantry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mPantry := model.Pantry{}
+	_, err = s.pantryNamer.Parse(request.GetName(), &mPantry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mPantry, err = s.domain.GetPantry(ctx, authAccount, mPantry.Parent, mPantry.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetPantry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantry, err := s.PantryToProto(mPantry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbPantry)
+	log.Info().Msg("gRPC GetPantry success")
+	return pbPantry, nil
+}
+
+// UpdatePantry -
+func (s *PantryService) UpdatePantry(ctx context.Context, request *pb.UpdatePantryRequest) (*pb.Pantry, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdatePantry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	fieldMask := request.GetUpdateMask()
+	updateMask := s.pantryFieldMasker.Convert(fieldMask.GetPaths())
+
+	pbPantry := request.GetPantry()
+	mPantry, err := s.ProtoToPantry(pbPantry)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to convert proto to model")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", pbPantry.GetName())
+	}
+
+	mPantry, err = s.domain.UpdatePantry(ctx, authAccount, mPantry, updateMask)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdatePantry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantry, err = s.PantryToProto(mPantry)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	grpc.ProcessResponseFieldBehavior(pbPantry)
+	log.Info().Msg("gRPC UpdatePantry success")
+	return pbPantry, nil
+}
+
+// ListPantries -
+func (s *PantryService) ListPantries(ctx context.Context, request *pb.ListPantriesRequest) (*pb.ListPantriesResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListPantries called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	mPantryParent := model.PantryParent{}
+	_, err = s.pantryNamer.ParseParent(request.GetParent(), &mPantryParent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: pantryDefaultPageSize,
+		MaxPageSize:     pantryMaxPageSize,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to setup pagination")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	res, err := s.domain.ListPantries(ctx, authAccount, mPantryParent, request.GetPageSize(), int32(pageToken.Offset), request.GetFilter(), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListPantries failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pantries := make([]*pb.Pantry, len(res))
+	for i, pantry := range res {
+		pbPantry, err := s.PantryToProto(pantry)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare response")
+			return nil, status.Error(codes.Internal, "unable to prepare response")
+		}
+		grpc.ProcessResponseFieldBehavior(pbPantry)
+		pantries[i] = pbPantry
+	}
+
+	response := &pb.ListPantriesResponse{
+		Pantries: pantries,
+	}
+
+	if len(pantries) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListPantries success")
+	return response, nil
+}
+
+// ConsumeRecipe decrements the pantry items used by a cooked recipe.
+func (s *PantryService) ConsumeRecipe(ctx context.Context, request *pb.ConsumeRecipeRequest) (*pb.ConsumeRecipeResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ConsumeRecipe called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	mPantry := model.Pantry{}
+	_, err = s.pantryNamer.Parse(request.GetName(), &mPantry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mRecipe := model.Recipe{}
+	_, err = s.recipeNamer.Parse(request.GetRecipe(), &mRecipe)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid recipe")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe: %v", request.GetRecipe())
+	}
+
+	consumption, err := s.domain.ConsumeRecipe(ctx, authAccount, mPantry.Id, mRecipe.Id, request.GetServings())
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ConsumeRecipe failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItems, err := s.PantryItemsToProto(consumption.PantryItems)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC ConsumeRecipe success")
+	return &pb.ConsumeRecipeResponse{
+		PantryItems:        pbPantryItems,
+		MissingIngredients: consumption.MissingIngredients,
+	}, nil
+}
+
+// RestockPantry increments the pantry items matching bought grocery list items.
+func (s *PantryService) RestockPantry(ctx context.Context, request *pb.RestockPantryRequest) (*pb.RestockPantryResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC RestockPantry called")
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	mPantry := model.Pantry{}
+	_, err = s.pantryNamer.Parse(request.GetName(), &mPantry)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	mListItems := make([]model.ListItem, len(request.GetListItems()))
+	for i, name := range request.GetListItems() {
+		_, err = s.listItemNamer.Parse(name, &mListItems[i])
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid list item")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid list item: %v", name)
+		}
+	}
+
+	mPantryItems, err := s.domain.RestockPantry(ctx, authAccount, mPantry.Id, mListItems)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.RestockPantry failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItems, err := s.PantryItemsToProto(mPantryItems)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	log.Info().Msg("gRPC RestockPantry success")
+	return &pb.RestockPantryResponse{
+		PantryItems: pbPantryItems,
+	}, nil
+}
+
+// ProtoToPantry converts a protobuf Pantry to a model Pantry
+func (s *PantryService) ProtoToPantry(proto *pb.Pantry) (model.Pantry, error) {
+	pantry := model.Pantry{}
+	if proto.GetName() != "" {
+		_, err := s.pantryNamer.Parse(proto.GetName(), &pantry)
+		if err != nil {
+			return pantry, err
+		}
+	}
+
+	pantry.Title = proto.GetTitle()
+	pantry.Description = proto.GetDescription()
+	pantry.VisibilityLevel = proto.GetVisibility()
+
+	return pantry, nil
+}
+
+// PantryToProto converts a model Pantry to a protobuf Pantry
+func (s *PantryService) PantryToProto(pantry model.Pantry) (*pb.Pantry, error) {
+	proto := &pb.Pantry{
+		Title:       pantry.Title,
+		Description: pantry.Description,
+		Visibility:  pantry.VisibilityLevel,
+		CreateTime:  timestamppb.New(pantry.CreateTime),
+		UpdateTime:  timestamppb.New(pantry.UpdateTime),
+	}
+
+	if pantry.Id.PantryId != 0 {
+		name, err := s.pantryNamer.Format(pantry)
+		if err != nil {
+			return proto, err
+		}
+		proto.Name = name
+	}
+
+	if (pantry.PantryAccess != model.PantryAccess{}) {
+		name, err := s.accessNamer.Format(pantry.PantryAccess)
+		if err == nil {
+			proto.PantryAccess = &pb.Pantry_PantryAccess{
+				Name:            name,
+				PermissionLevel: pantry.PantryAccess.PermissionLevel,
+				State:           pantry.PantryAccess.State,
+				AcceptTarget:    pantry.PantryAccess.AcceptTarget,
+			}
+		}
+	}
+
+	return proto, nil
+}
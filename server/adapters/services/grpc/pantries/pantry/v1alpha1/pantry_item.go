@@ -0,0 +1,415 @@
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	pantryItemMaxPageSize     int32 = 1000
+	pantryItemDefaultPageSize int32 = 100
+
+	// defaultExpireWithin is how far ahead ListExpiringPantryItems looks when
+	// the request does not say.
+	defaultExpireWithin = 3 * 24 * time.Hour
+)
+
+var pantryItemFieldMap = map[string][]string{
+	"name":          {model.PantryItemField_Parent, model.PantryItemField_Id},
+	"title":         {model.PantryItemField_Title},
+	"quantity":      {model.PantryItemField_Quantity},
+	"unit":          {model.PantryItemField_Unit},
+	"location":      {model.PantryItemField_Location},
+	"purchase_time": {model.PantryItemField_PurchaseTime},
+	"expire_time":   {model.PantryItemField_ExpireTime},
+	"create_time":   {model.PantryItemField_CreateTime},
+	"update_time":   {model.PantryItemField_UpdateTime},
+}
+
+// CreatePantryItem creates a new pantry item
+func (s *PantryService) CreatePantryItem(ctx context.Context, request *pb.CreatePantryItemRequest) (*pb.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC CreatePantryItem called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	// Parse parent
+	var mPantryItem model.PantryItem
+	_, err = s.pantryItemNamer.ParseParent(request.GetParent(), &mPantryItem.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	// Convert proto to model
+	pbPantryItem := request.GetPantryItem()
+	pbPantryItem.Name = ""
+	mPantryItem, err = s.PantryItemFromProto(pbPantryItem, mPantryItem.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to convert proto to model")
+		return nil, status.Error(codes.InvalidArgument, "invalid pantry item data")
+	}
+
+	dbPantryItem, err := s.domain.CreatePantryItem(ctx, authAccount, mPantryItem)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.CreatePantryItem failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItem, err = s.PantryItemToProto(dbPantryItem)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbPantryItem)
+
+	log.Info().Msg("gRPC CreatePantryItem success")
+	return pbPantryItem, nil
+}
+
+// GetPantryItem retrieves a pantry item
+func (s *PantryService) GetPantryItem(ctx context.Context, request *pb.GetPantryItemRequest) (*pb.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC GetPantryItem called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Parse name to get parent and ID
+	var mPantryItem model.PantryItem
+	_, err = s.pantryItemNamer.Parse(request.GetName(), &mPantryItem)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	dbPantryItem, err := s.domain.GetPantryItem(ctx, authAccount, mPantryItem.Parent, mPantryItem.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.GetPantryItem failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItem, err := s.PantryItemToProto(dbPantryItem)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbPantryItem)
+
+	log.Info().Msg("gRPC GetPantryItem success")
+	return pbPantryItem, nil
+}
+
+// ListPantryItems lists pantry items with pagination and filtering
+func (s *PantryService) ListPantryItems(ctx context.Context, request *pb.ListPantryItemsRequest) (*pb.ListPantryItemsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListPantryItems called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Parse parent
+	var mPantryItem model.PantryItem
+	_, err = s.pantryItemNamer.ParseParent(request.GetParent(), &mPantryItem.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	// Setup pagination
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: pantryItemDefaultPageSize,
+		MaxPageSize:     pantryItemMaxPageSize,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("pagination setup failed")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	dbPantryItems, err := s.domain.ListPantryItems(ctx, authAccount, mPantryItem.Parent, pageSize, int32(pageToken.Offset), request.GetFilter(), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListPantryItems failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItems, err := s.PantryItemsToProto(dbPantryItems)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	response := &pb.ListPantryItemsResponse{
+		PantryItems: pbPantryItems,
+	}
+
+	// Add next page token if there are more results
+	if len(dbPantryItems) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListPantryItems success")
+	return response, nil
+}
+
+// ListExpiringPantryItems lists the pantry items that expire soon
+func (s *PantryService) ListExpiringPantryItems(ctx context.Context, request *pb.ListExpiringPantryItemsRequest) (*pb.ListExpiringPantryItemsResponse, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC ListExpiringPantryItems called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Parse parent
+	var mPantryItem model.PantryItem
+	_, err = s.pantryItemNamer.ParseParent(request.GetParent(), &mPantryItem.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid parent")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", request.GetParent())
+	}
+
+	expireWithin := defaultExpireWithin
+	if request.GetExpireWithin() != nil {
+		err = request.GetExpireWithin().CheckValid()
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid expire within")
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire within: %v", err)
+		}
+		expireWithin = request.GetExpireWithin().AsDuration()
+	}
+
+	// Setup pagination
+	pageToken, pageSize, err := grpc.SetupPagination(request, grpc.PaginationConfig{
+		DefaultPageSize: pantryItemDefaultPageSize,
+		MaxPageSize:     pantryItemMaxPageSize,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("pagination setup failed")
+		return nil, err
+	}
+	request.PageSize = pageSize
+
+	dbPantryItems, err := s.domain.ListExpiringPantryItems(ctx, authAccount, mPantryItem.Parent, expireWithin, pageSize, int32(pageToken.Offset), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.ListExpiringPantryItems failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItems, err := s.PantryItemsToProto(dbPantryItems)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	response := &pb.ListExpiringPantryItemsResponse{
+		PantryItems: pbPantryItems,
+	}
+
+	// Add next page token if there are more results
+	if len(dbPantryItems) == int(pageSize) {
+		response.NextPageToken = pageToken.Next(request).String()
+	}
+
+	log.Info().Msg("gRPC ListExpiringPantryItems success")
+	return response, nil
+}
+
+// UpdatePantryItem updates an existing pantry item
+func (s *PantryService) UpdatePantryItem(ctx context.Context, request *pb.UpdatePantryItemRequest) (*pb.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC UpdatePantryItem called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Check field behavior
+	err = grpc.ProcessRequestFieldBehavior(request)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to process request field behavior")
+		return nil, err
+	}
+
+	// Parse name to get parent and ID
+	var mPantryItem model.PantryItem
+	_, err = s.pantryItemNamer.Parse(request.GetPantryItem().GetName(), &mPantryItem)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetPantryItem().GetName())
+	}
+
+	// Convert proto to model
+	mPantryItem, err = s.PantryItemFromProto(request.GetPantryItem(), mPantryItem.Parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to convert proto to model")
+		return nil, status.Error(codes.InvalidArgument, "invalid pantry item data")
+	}
+
+	// Process field mask
+	fieldMask := request.GetUpdateMask()
+	fields := s.pantryItemFieldMasker.Convert(fieldMask.GetPaths())
+
+	dbPantryItem, err := s.domain.UpdatePantryItem(ctx, authAccount, mPantryItem, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.UpdatePantryItem failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItem, err := s.PantryItemToProto(dbPantryItem)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbPantryItem)
+
+	log.Info().Msg("gRPC UpdatePantryItem success")
+	return pbPantryItem, nil
+}
+
+// DeletePantryItem deletes a pantry item
+func (s *PantryService) DeletePantryItem(ctx context.Context, request *pb.DeletePantryItemRequest) (*pb.PantryItem, error) {
+	log := logutil.EnrichLoggerWithContext(s.log, ctx)
+	log.Info().Msg("gRPC DeletePantryItem called")
+
+	authAccount, err := headers.ParseAuthData(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse auth data")
+		return nil, err
+	}
+
+	// Parse name to get parent and ID
+	var mPantryItem model.PantryItem
+	_, err = s.pantryItemNamer.Parse(request.GetName(), &mPantryItem)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid name")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", request.GetName())
+	}
+
+	dbPantryItem, err := s.domain.DeletePantryItem(ctx, authAccount, mPantryItem.Parent, mPantryItem.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("domain.DeletePantryItem failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPantryItem, err := s.PantryItemToProto(dbPantryItem)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prepare response")
+		return nil, status.Error(codes.Internal, "unable to prepare response")
+	}
+
+	// Check field behavior
+	grpc.ProcessResponseFieldBehavior(pbPantryItem)
+
+	log.Info().Msg("gRPC DeletePantryItem success")
+	return pbPantryItem, nil
+}
+
+// PantryItemToProto converts a domain model to a proto message
+func (s *PantryService) PantryItemToProto(mPantryItem model.PantryItem) (*pb.PantryItem, error) {
+	pbPantryItem := &pb.PantryItem{
+		Title:      mPantryItem.Title,
+		Quantity:   mPantryItem.Quantity,
+		Unit:       mPantryItem.Unit,
+		Location:   mPantryItem.Location,
+		CreateTime: timestamppb.New(mPantryItem.CreateTime),
+		UpdateTime: timestamppb.New(mPantryItem.UpdateTime),
+	}
+
+	if !mPantryItem.PurchaseTime.IsZero() {
+		pbPantryItem.PurchaseTime = timestamppb.New(mPantryItem.PurchaseTime)
+	}
+	if !mPantryItem.ExpireTime.IsZero() {
+		pbPantryItem.ExpireTime = timestamppb.New(mPantryItem.ExpireTime)
+	}
+
+	// Generate the name using the namer if ID is set
+	if mPantryItem.Id.PantryItemId != 0 {
+		name, err := s.pantryItemNamer.Format(mPantryItem)
+		if err != nil {
+			return nil, err
+		}
+		pbPantryItem.Name = name
+	}
+
+	return pbPantryItem, nil
+}
+
+// PantryItemsToProto converts domain models to proto messages and applies the
+// response field behavior.
+func (s *PantryService) PantryItemsToProto(mPantryItems []model.PantryItem) ([]*pb.PantryItem, error) {
+	pbPantryItems := make([]*pb.PantryItem, len(mPantryItems))
+	for i, mPantryItem := range mPantryItems {
+		pbPantryItem, err := s.PantryItemToProto(mPantryItem)
+		if err != nil {
+			return nil, err
+		}
+		grpc.ProcessResponseFieldBehavior(pbPantryItem)
+		pbPantryItems[i] = pbPantryItem
+	}
+	return pbPantryItems, nil
+}
+
+// PantryItemFromProto converts a proto message to a domain model
+func (s *PantryService) PantryItemFromProto(pbPantryItem *pb.PantryItem, parent model.PantryItemParent) (model.PantryItem, error) {
+	mPantryItem := model.PantryItem{
+		Parent:   parent,
+		Title:    pbPantryItem.GetTitle(),
+		Quantity: pbPantryItem.GetQuantity(),
+		Unit:     pbPantryItem.GetUnit(),
+		Location: pbPantryItem.GetLocation(),
+	}
+
+	if pbPantryItem.GetPurchaseTime() != nil {
+		mPantryItem.PurchaseTime = pbPantryItem.GetPurchaseTime().AsTime()
+	}
+	if pbPantryItem.GetExpireTime() != nil {
+		mPantryItem.ExpireTime = pbPantryItem.GetExpireTime().AsTime()
+	}
+
+	// If this is an update operation, parse the ID from the name
+	if pbPantryItem.GetName() != "" {
+		_, err := s.pantryItemNamer.Parse(pbPantryItem.GetName(), &mPantryItem)
+		if err != nil {
+			return model.PantryItem{}, err
+		}
+	}
+
+	return mPantryItem, nil
+}
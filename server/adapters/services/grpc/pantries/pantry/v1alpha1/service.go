@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	fieldmask "github.com/jcfug8/daylear/server/core/fieldmask"
+	namer "github.com/jcfug8/daylear/server/core/namer"
+	pb "github.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+// NewPantryServiceParams defines the dependencies for the PantryService.
+type NewPantryServiceParams struct {
+	fx.In
+
+	Domain                domain.Domain
+	Log                   zerolog.Logger
+	PantryFieldMasker     fieldmask.FieldMasker `name:"v1alpha1PantryFieldMasker"`
+	AccessFieldMasker     fieldmask.FieldMasker `name:"v1alpha1PantryAccessFieldMasker"`
+	PantryItemFieldMasker fieldmask.FieldMasker `name:"v1alpha1PantryItemFieldMasker"`
+	PantryNamer           namer.ReflectNamer    `name:"v1alpha1PantryNamer"`
+	PantryItemNamer       namer.ReflectNamer    `name:"v1alpha1PantryItemNamer"`
+	AccessNamer           namer.ReflectNamer    `name:"v1alpha1PantryAccessNamer"`
+	UserNamer             namer.ReflectNamer    `name:"v1alpha1UserNamer"`
+	CircleNamer           namer.ReflectNamer    `name:"v1alpha1CircleNamer"`
+	RecipeNamer           namer.ReflectNamer    `name:"v1alpha1RecipeNamer"`
+	ListItemNamer         namer.ReflectNamer    `name:"v1alpha1ListItemNamer"`
+}
+
+// NewPantryService creates a new PantryService.
+func NewPantryService(params NewPantryServiceParams) (*PantryService, error) {
+	return &PantryService{
+		domain:                params.Domain,
+		log:                   params.Log,
+		pantryFieldMasker:     params.PantryFieldMasker,
+		accessFieldMasker:     params.AccessFieldMasker,
+		pantryItemFieldMasker: params.PantryItemFieldMasker,
+		pantryNamer:           params.PantryNamer,
+		pantryItemNamer:       params.PantryItemNamer,
+		accessNamer:           params.AccessNamer,
+		userNamer:             params.UserNamer,
+		circleNamer:           params.CircleNamer,
+		recipeNamer:           params.RecipeNamer,
+		listItemNamer:         params.ListItemNamer,
+	}, nil
+}
+
+// PantryService defines the grpc handlers for the PantryService.
+type PantryService struct {
+	pb.UnimplementedPantryServiceServer
+	pb.UnimplementedPantryAccessServiceServer
+	pb.UnimplementedPantryItemServiceServer
+	domain                domain.Domain
+	log                   zerolog.Logger
+	pantryFieldMasker     fieldmask.FieldMasker
+	accessFieldMasker     fieldmask.FieldMasker
+	pantryItemFieldMasker fieldmask.FieldMasker
+	pantryNamer           namer.ReflectNamer
+	pantryItemNamer       namer.ReflectNamer
+	accessNamer           namer.ReflectNamer
+	userNamer             namer.ReflectNamer
+	circleNamer           namer.ReflectNamer
+	recipeNamer           namer.ReflectNamer
+	listItemNamer         namer.ReflectNamer
+}
@@ -1,6 +1,7 @@
 package convert
 
 import (
+	"github.com/jcfug8/daylear/server/adapters/services/grpc"
 	"github.com/jcfug8/daylear/server/core/model"
 	"github.com/jcfug8/daylear/server/core/namer"
 	pb "github.com/jcfug8/daylear/server/genapi/api/users/user/v1alpha1"
@@ -23,6 +24,10 @@ func ProtoToUser(userNamer namer.ReflectNamer, accessNamer namer.ReflectNamer, p
 	user.FamilyName = proto.FamilyName
 	user.ImageUri = proto.ImageUri
 	user.Bio = proto.Bio
+	user.DietaryRestrictions = model.DietaryRestrictions{
+		Diets:     proto.GetDietaryRestrictions().GetDiets(),
+		Allergens: proto.GetDietaryRestrictions().GetAllergens(),
+	}
 
 	return user, nil
 }
@@ -40,8 +45,15 @@ func UserToProto(userNamer namer.ReflectNamer, accessNamer namer.ReflectNamer, u
 	proto.GivenName = user.GivenName
 	proto.FamilyName = user.FamilyName
 	proto.ImageUri = user.ImageUri
+	proto.Images = grpc.ImageSetToProto(user.ImageSet)
 	proto.Bio = user.Bio
 	proto.Favorited = user.Favorited
+	if len(user.DietaryRestrictions.Diets) > 0 || len(user.DietaryRestrictions.Allergens) > 0 {
+		proto.DietaryRestrictions = &pb.User_DietaryRestrictions{
+			Diets:     user.DietaryRestrictions.Diets,
+			Allergens: user.DietaryRestrictions.Allergens,
+		}
+	}
 
 	proto.Access = &pb.User_Access{
 		PermissionLevel: user.UserAccess.PermissionLevel,
@@ -25,8 +25,11 @@ var userFieldMap = map[string][]string{
 	"given_name":  {model.UserField_GivenName},
 	"family_name": {model.UserField_FamilyName},
 	"image_uri":   {model.UserField_ImageUri},
+	"images":      {model.UserField_ImageSet},
 	"bio":         {model.UserField_Bio},
 
+	"dietary_restrictions": {model.UserField_DietaryRestrictions},
+
 	"access": {model.UserField_AccessName, model.UserField_AccessPermissionLevel, model.UserField_AccessState},
 }
 
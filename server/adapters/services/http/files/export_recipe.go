@@ -0,0 +1,112 @@
+package files
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeexport"
+	"github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// ExportRecipe downloads a single recipe as JSON-LD, Markdown or printable
+// HTML, selected by the format query parameter.
+func (s *Service) ExportRecipe(w http.ResponseWriter, r *http.Request) {
+	authAccount, err := headers.ParseAuthData(r.Context())
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	name, ok := mux.Vars(r)["name"]
+	if !ok {
+		http.Error(w, "No recipe name", http.StatusBadRequest)
+		return
+	}
+
+	mRecipe := model.Recipe{}
+	_, err = s.recipeNamer.Parse(name, &mRecipe)
+	if err != nil {
+		http.Error(w, "Invalid recipe name", http.StatusBadRequest)
+		return
+	}
+
+	format, err := recipeexport.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.log.Info().Msgf("Exporting recipe %d as %s", mRecipe.Id.RecipeId, format)
+	f, err := s.domain.ExportRecipe(r.Context(), authAccount, mRecipe.Parent, mRecipe.Id, format)
+	if err != nil {
+		http.Error(w, "Failed to export recipe", exportErrorStatus(err))
+		return
+	}
+	defer f.Close()
+
+	writeExport(w, f, fmt.Sprintf("recipe-%d.%s", mRecipe.Id.RecipeId, f.Extension))
+}
+
+// ExportRecipes downloads every recipe the caller owns as a zip archive of
+// files in the format given by the format query parameter.
+func (s *Service) ExportRecipes(w http.ResponseWriter, r *http.Request) {
+	authAccount, err := headers.ParseAuthData(r.Context())
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	format, err := recipeexport.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.log.Info().Msgf("Exporting recipes of user %d as %s", authAccount.AuthUserId, format)
+	f, err := s.domain.ExportRecipes(r.Context(), authAccount, format)
+	if err != nil {
+		http.Error(w, "Failed to export recipes", exportErrorStatus(err))
+		return
+	}
+	defer f.Close()
+
+	writeExport(w, f, fmt.Sprintf("recipes-%s.zip", time.Now().Format("2006-01-02")))
+}
+
+func writeExport(w http.ResponseWriter, f file.File, fileName string) {
+	w.Header().Set("Content-Type", f.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(f.ContentLength, 10))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, f)
+}
+
+func exportErrorStatus(err error) int {
+	var (
+		permissionDenied domain.ErrPermissionDenied
+		notFound         domain.ErrNotFound
+		repoNotFound     repository.ErrNotFound
+		invalidArgument  domain.ErrInvalidArgument
+		exhausted        domain.ErrResourceExhausted
+	)
+	switch {
+	case errors.As(err, &permissionDenied):
+		return http.StatusForbidden
+	case errors.As(err, &notFound), errors.As(err, &repoNotFound):
+		return http.StatusNotFound
+	case errors.As(err, &invalidArgument):
+		return http.StatusBadRequest
+	case errors.As(err, &exhausted):
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
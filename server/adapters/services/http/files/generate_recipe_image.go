@@ -1,6 +1,7 @@
 package files
 
 import (
+	"errors"
 	"io"
 	"net/http"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
 	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/domain"
 	"github.com/rs/zerolog/log"
 )
 
@@ -33,7 +35,10 @@ func (s *Service) GenerateRecipeImage(w http.ResponseWriter, r *http.Request) {
 
 	log.Info().Msgf("Generating recipe image for %d", mRecipe.Id)
 	file, err := s.domain.GenerateRecipeImage(r.Context(), authAccount, mRecipe.Parent, mRecipe.Id)
-	if err != nil {
+	if errors.As(err, &domain.ErrResourceExhausted{}) {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	} else if err != nil {
 		http.Error(w, "Failed to generate recipe image", http.StatusInternalServerError)
 		return
 	}
@@ -0,0 +1,76 @@
+package files
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/recipeimport"
+)
+
+const maxImportSize = 100 * 1024 * 1024 // 100 MB
+
+// ImportRecipes starts importing the recipes of an export file from another
+// recipe manager, selected by the source query parameter. The import runs in
+// the background; the response names the recipe import to check on it.
+func (s *Service) ImportRecipes(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	authAccount, err := headers.ParseAuthData(r.Context())
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	source, err := recipeimport.ParseSource(r.URL.Query().Get("source"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		err := r.ParseMultipartForm(maxInmemoryUploadSize)
+		if err != nil {
+			http.Error(w, "File too large", http.StatusBadRequest)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "Error reading file", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, "File too large", http.StatusBadRequest)
+		return
+	}
+
+	recipeImport, err := s.domain.StartRecipeImport(r.Context(), authAccount, source, data)
+	if err != nil {
+		s.log.Error().Err(err).Msg("unable to start recipe import")
+		http.Error(w, "Failed to start recipe import", exportErrorStatus(err))
+		return
+	}
+
+	name, err := s.recipeImportNamer.Format(recipeImport)
+	if err != nil {
+		s.log.Error().Err(err).Msg("unable to format recipe import name")
+		http.Error(w, "Interal Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	res, _ := json.Marshal(struct {
+		Name string `json:"name"`
+	}{Name: name})
+	w.Write(res)
+}
@@ -2,42 +2,23 @@ package files
 
 import (
 	"io"
+	"mime/multipart"
 	"net/http"
 	"strings"
 
 	"github.com/jcfug8/daylear/server/adapters/services/grpc/meals/recipes/v1alpha1/convert"
+	operationConvert "github.com/jcfug8/daylear/server/adapters/services/grpc/operations/operation/v1alpha1/convert"
 	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
 	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func (s *Service) OCRRecipe(w http.ResponseWriter, r *http.Request) {
-	// Limit the size of the request body
-	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
-	var files []io.Reader
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
-		// Parse the multipart form
-		err := r.ParseMultipartForm(maxInmemoryUploadSize)
-		if err != nil {
-			http.Error(w, "unable to parse multipart form", http.StatusBadRequest)
-			return
-		}
-
-		// Get the file from the form
-		fileHeaders := r.MultipartForm.File["files"]
-
-		for _, fileHeader := range fileHeaders {
-			file, err := fileHeader.Open()
-			if err != nil {
-				http.Error(w, "Error reading file", http.StatusBadRequest)
-				return
-			}
-			files = append(files, file)
-			defer file.Close()
-		}
-	} else {
-		files = append(files, r.Body)
+	files, ok := readOCRFiles(w, r)
+	if !ok {
+		return
 	}
+	defer closeOCRFiles(files)
 
 	authAccount, err := headers.ParseAuthData(r.Context())
 	if err != nil {
@@ -52,7 +33,7 @@ func (s *Service) OCRRecipe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.recipeAccessNamer, recipe)
+	pbRecipe, err := convert.RecipeToProto(s.recipeNamer, s.recipeAccessNamer, s.ingredientNamer, recipe)
 	if err != nil {
 		s.log.Error().Err(err).Msg("unable to convert recipe to proto")
 		http.Error(w, "Interal Error", http.StatusInternalServerError)
@@ -68,3 +49,80 @@ func (s *Service) OCRRecipe(w http.ResponseWriter, r *http.Request) {
 	jsonRecipe, _ := protojson.Marshal(scrapeRecipeResponse)
 	w.Write(jsonRecipe)
 }
+
+// StartOCRRecipe starts reading a recipe from the uploaded photos in the
+// background and responds with the operation.
+func (s *Service) StartOCRRecipe(w http.ResponseWriter, r *http.Request) {
+	files, ok := readOCRFiles(w, r)
+	if !ok {
+		return
+	}
+	defer closeOCRFiles(files)
+
+	authAccount, err := headers.ParseAuthData(r.Context())
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	operation, err := s.domain.StartOCRRecipe(r.Context(), authAccount, files)
+	if err != nil {
+		s.log.Error().Err(err).Msg("unable to start recipe ocr")
+		http.Error(w, "Interal Error", http.StatusInternalServerError)
+		return
+	}
+
+	pbOperation, err := operationConvert.OperationToProto(s.operationNamer, s.recipeNamer, s.recipeAccessNamer, s.ingredientNamer, s.recipeImageCandidateNamer, s.userNamer, operation)
+	if err != nil {
+		s.log.Error().Err(err).Msg("unable to convert operation to proto")
+		http.Error(w, "Interal Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	jsonOperation, _ := protojson.Marshal(pbOperation)
+	w.Write(jsonOperation)
+}
+
+// readOCRFiles reads the photos of a recipe from the "files" of a multipart
+// form, or the body itself. It writes the error response and returns false
+// when the photos can't be read.
+func readOCRFiles(w http.ResponseWriter, r *http.Request) ([]io.Reader, bool) {
+	// Limit the size of the request body
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		return []io.Reader{r.Body}, true
+	}
+
+	// Parse the multipart form
+	err := r.ParseMultipartForm(maxInmemoryUploadSize)
+	if err != nil {
+		http.Error(w, "unable to parse multipart form", http.StatusBadRequest)
+		return nil, false
+	}
+
+	// Get the file from the form
+	fileHeaders := r.MultipartForm.File["files"]
+
+	var files []io.Reader
+	for _, fileHeader := range fileHeaders {
+		file, err := fileHeader.Open()
+		if err != nil {
+			closeOCRFiles(files)
+			http.Error(w, "Error reading file", http.StatusBadRequest)
+			return nil, false
+		}
+		files = append(files, file)
+	}
+	return files, true
+}
+
+// closeOCRFiles closes the photos opened from a multipart form.
+func closeOCRFiles(files []io.Reader) {
+	for _, file := range files {
+		if f, ok := file.(multipart.File); ok {
+			f.Close()
+		}
+	}
+}
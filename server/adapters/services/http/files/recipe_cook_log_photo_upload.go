@@ -0,0 +1,70 @@
+package files
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// AddRecipeCookLogPhoto uploads a photo to a recipe cook log.
+func (s *Service) AddRecipeCookLogPhoto(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		err := r.ParseMultipartForm(maxInmemoryUploadSize)
+		if err != nil {
+			http.Error(w, "File too large", http.StatusBadRequest)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "Error reading file", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	authAccount, err := headers.ParseAuthData(r.Context())
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	name, ok := mux.Vars(r)["name"]
+	if !ok {
+		http.Error(w, "No cook log name", http.StatusBadRequest)
+		return
+	}
+
+	mCookLog := model.RecipeCookLog{}
+	_, err = s.recipeCookLogNamer.Parse(name, &mCookLog)
+	if err != nil {
+		http.Error(w, "Invalid cook log name", http.StatusBadRequest)
+		return
+	}
+
+	mCookLog, err = s.domain.AddRecipeCookLogPhoto(r.Context(), authAccount, mCookLog.Parent, mCookLog.Id, body)
+	if err != nil {
+		s.log.Error().Err(err).Msg("unable to add recipe cook log photo")
+		http.Error(w, "Failed to upload cook log photo", exportErrorStatus(err))
+		return
+	}
+
+	photo := mCookLog.Photos[len(mCookLog.Photos)-1]
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	res, _ := json.Marshal(struct {
+		Name     string `json:"name"`
+		ImageURI string `json:"image_uri"`
+	}{Name: name, ImageURI: photo.ImageURI})
+	w.Write(res)
+}
@@ -0,0 +1,108 @@
+package files
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/jcfug8/daylear/server/adapters/services/http/libs/headers"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// CreateRecipeImage uploads an image to the gallery of a recipe. When the
+// direction_index and step_index query parameters are set the image is
+// attached to that direction step instead.
+func (s *Service) CreateRecipeImage(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		err := r.ParseMultipartForm(maxInmemoryUploadSize)
+		if err != nil {
+			http.Error(w, "File too large", http.StatusBadRequest)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "Error reading file", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	authAccount, err := headers.ParseAuthData(r.Context())
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	parent, ok := mux.Vars(r)["parent"]
+	if !ok {
+		http.Error(w, "No recipe name", http.StatusBadRequest)
+		return
+	}
+
+	mParent := model.RecipeImageParent{}
+	_, err = s.recipeImageNamer.ParseParent(parent, &mParent)
+	if err != nil {
+		http.Error(w, "Invalid recipe name", http.StatusBadRequest)
+		return
+	}
+
+	step, err := parseRecipeDirectionStep(r)
+	if err != nil {
+		http.Error(w, "Invalid direction step", http.StatusBadRequest)
+		return
+	}
+
+	recipeImage, err := s.domain.CreateRecipeImage(r.Context(), authAccount, mParent, step, body)
+	if err != nil {
+		s.log.Error().Err(err).Msg("unable to create recipe image")
+		http.Error(w, "Failed to upload recipe image", exportErrorStatus(err))
+		return
+	}
+
+	name, err := s.recipeImageNamer.Format(recipeImage)
+	if err != nil {
+		s.log.Error().Err(err).Msg("unable to format recipe image name")
+		http.Error(w, "Interal Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	res, _ := json.Marshal(struct {
+		Name     string `json:"name"`
+		ImageURI string `json:"image_uri"`
+		Cover    bool   `json:"cover"`
+	}{Name: name, ImageURI: recipeImage.ImageURI, Cover: recipeImage.Cover})
+	w.Write(res)
+}
+
+// parseRecipeDirectionStep reads the optional direction step of an uploaded
+// recipe image from the query parameters. Both indexes must be set together.
+func parseRecipeDirectionStep(r *http.Request) (*model.RecipeDirectionStep, error) {
+	query := r.URL.Query()
+	if !query.Has("direction_index") && !query.Has("step_index") {
+		return nil, nil
+	}
+
+	directionIndex, err := strconv.ParseInt(query.Get("direction_index"), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	stepIndex, err := strconv.ParseInt(query.Get("step_index"), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RecipeDirectionStep{
+		DirectionIndex: int32(directionIndex),
+		StepIndex:      int32(stepIndex),
+	}, nil
+}
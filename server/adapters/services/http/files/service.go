@@ -13,11 +13,17 @@ import (
 )
 
 type Service struct {
-	log               zerolog.Logger
-	domain            domain.Domain
-	recipeNamer       namer.ReflectNamer
-	circleNamer       namer.ReflectNamer
-	recipeAccessNamer namer.ReflectNamer
+	log                       zerolog.Logger
+	domain                    domain.Domain
+	recipeNamer               namer.ReflectNamer
+	circleNamer               namer.ReflectNamer
+	recipeAccessNamer         namer.ReflectNamer
+	ingredientNamer           namer.ReflectNamer
+	recipeImportNamer         namer.ReflectNamer
+	recipeImageNamer          namer.ReflectNamer
+	operationNamer            namer.ReflectNamer
+	recipeCookLogNamer        namer.ReflectNamer
+	recipeImageCandidateNamer namer.ReflectNamer
 
 	userNamer namer.ReflectNamer
 }
@@ -25,23 +31,35 @@ type Service struct {
 type NewServiceParams struct {
 	fx.In
 
-	Log               zerolog.Logger
-	Domain            domain.Domain
-	RecipeNamer       namer.ReflectNamer `name:"v1alpha1RecipeNamer"`
-	CircleNamer       namer.ReflectNamer `name:"v1alpha1CircleNamer"`
-	RecipeAccessNamer namer.ReflectNamer `name:"v1alpha1RecipeAccessNamer"`
+	Log                       zerolog.Logger
+	Domain                    domain.Domain
+	RecipeNamer               namer.ReflectNamer `name:"v1alpha1RecipeNamer"`
+	CircleNamer               namer.ReflectNamer `name:"v1alpha1CircleNamer"`
+	RecipeAccessNamer         namer.ReflectNamer `name:"v1alpha1RecipeAccessNamer"`
+	IngredientNamer           namer.ReflectNamer `name:"v1alpha1IngredientNamer"`
+	RecipeImportNamer         namer.ReflectNamer `name:"v1alpha1RecipeImportNamer"`
+	RecipeImageNamer          namer.ReflectNamer `name:"v1alpha1RecipeImageNamer"`
+	OperationNamer            namer.ReflectNamer `name:"v1alpha1OperationNamer"`
+	RecipeCookLogNamer        namer.ReflectNamer `name:"v1alpha1RecipeCookLogNamer"`
+	RecipeImageCandidateNamer namer.ReflectNamer `name:"v1alpha1RecipeImageCandidateNamer"`
 
 	UserNamer namer.ReflectNamer `name:"v1alpha1UserNamer"`
 }
 
 func NewService(params NewServiceParams) (*Service, error) {
 	return &Service{
-		log:               params.Log,
-		domain:            params.Domain,
-		recipeNamer:       params.RecipeNamer,
-		circleNamer:       params.CircleNamer,
-		recipeAccessNamer: params.RecipeAccessNamer,
-		userNamer:         params.UserNamer,
+		log:                       params.Log,
+		domain:                    params.Domain,
+		recipeNamer:               params.RecipeNamer,
+		circleNamer:               params.CircleNamer,
+		recipeAccessNamer:         params.RecipeAccessNamer,
+		ingredientNamer:           params.IngredientNamer,
+		recipeImportNamer:         params.RecipeImportNamer,
+		recipeImageNamer:          params.RecipeImageNamer,
+		operationNamer:            params.OperationNamer,
+		recipeCookLogNamer:        params.RecipeCookLogNamer,
+		recipeImageCandidateNamer: params.RecipeImageCandidateNamer,
+		userNamer:                 params.UserNamer,
 	}, nil
 }
 
@@ -51,13 +69,20 @@ func (s *Service) Register(m *http.ServeMux) error {
 	s.log.Info().Msg("Registering files service routes")
 	r.HandleFunc("/meals/v1alpha1/{name:recipes/[0-9]+}/image", s.UploadRecipeImage).Methods(http.MethodPut)
 	r.HandleFunc("/meals/v1alpha1/{name:circles/[0-9]*/recipes/[0-9]+}/image", s.UploadRecipeImage).Methods(http.MethodPut)
-	r.HandleFunc("/meals/v1alpha1/{name:recipes/[0-9]+}/image:generate", s.GenerateRecipeImage).Methods(http.MethodGet)
-	r.HandleFunc("/meals/v1alpha1/{name:circles/[0-9]*/recipes/[0-9]+}/image:generate", s.GenerateRecipeImage).Methods(http.MethodGet)
+	r.HandleFunc("/meals/v1alpha1/{parent:recipes/[0-9]+}/images", s.CreateRecipeImage).Methods(http.MethodPost)
+	r.HandleFunc("/meals/v1alpha1/{name:recipes/[0-9]+/cookLogs/[0-9]+}/photos", s.AddRecipeCookLogPhoto).Methods(http.MethodPost)
+	r.HandleFunc("/meals/v1alpha1/{name:recipes/[0-9]+}/image:generate", s.GenerateRecipeImage).Methods(http.MethodPost)
+	r.HandleFunc("/meals/v1alpha1/{name:circles/[0-9]*/recipes/[0-9]+}/image:generate", s.GenerateRecipeImage).Methods(http.MethodPost)
+	r.HandleFunc("/meals/v1alpha1/{name:recipes/[0-9]+}:export", s.ExportRecipe).Methods(http.MethodGet)
+	r.HandleFunc("/meals/v1alpha1/{name:circles/[0-9]*/recipes/[0-9]+}:export", s.ExportRecipe).Methods(http.MethodGet)
+	r.HandleFunc("/meals/v1alpha1/recipes:export", s.ExportRecipes).Methods(http.MethodGet)
+	r.HandleFunc("/meals/v1alpha1/recipes:import", s.ImportRecipes).Methods(http.MethodPost)
 
 	r.HandleFunc("/circles/v1alpha1/{name:circles/[0-9]+}/image", s.UploadCircleImage).Methods(http.MethodPut)
 	r.HandleFunc("/users/v1alpha1/{name:users/[0-9]+}/image", s.UploadUserImage).Methods(http.MethodPut)
 
 	r.HandleFunc("/meals/v1alpha1/recipes:ocr", s.OCRRecipe).Methods(http.MethodPost)
+	r.HandleFunc("/meals/v1alpha1/recipes:startOcr", s.StartOCRRecipe).Methods(http.MethodPost)
 
 	s.log.Info().Msg("Mounting files service at /files/")
 	m.Handle("/files/", headers.NewAuthTokenMiddleware(s.domain)(http.StripPrefix("/files", r)))
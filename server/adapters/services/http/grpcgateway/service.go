@@ -12,69 +12,120 @@ import (
 	calendarV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/calendars/calendar/v1alpha1"
 	circleV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/circles/circle/v1alpha1"
 	listsV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/lists/list/v1alpha1"
+	cookbooksV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1"
 	recipesV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	operationsV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
+	pantriesV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1"
 	userV1alpha1 "github.com/jcfug8/daylear/server/genapi/api/users/user/v1alpha1"
 	"github.com/jcfug8/daylear/server/ports/domain"
 )
 
 type Service struct {
-	userV1alpha1Service         userV1alpha1.UserServiceServer
-	userSettingsV1alpha1Service userV1alpha1.UserSettingsServiceServer
-	userAccessService           userV1alpha1.UserAccessServiceServer
-	recipesV1alpha1Service      recipesV1alpha1.RecipeServiceServer
-	recipeAccessService         recipesV1alpha1.RecipeAccessServiceServer
-	circleV1alpha1Service       circleV1alpha1.CircleServiceServer
-	circleAccessService         circleV1alpha1.CircleAccessServiceServer
-	calendarV1alpha1Service     calendarV1alpha1.CalendarServiceServer
-	calendarAccessService       calendarV1alpha1.CalendarAccessServiceServer
-	eventV1alpha1Service        calendarV1alpha1.EventServiceServer
-	accessKeyService            userV1alpha1.AccessKeyServiceServer
-	eventRecipeV1alpha1Service  calendarV1alpha1.EventRecipeServiceServer
-	listsV1alpha1Service        listsV1alpha1.ListServiceServer
-	listAccessService           listsV1alpha1.ListAccessServiceServer
-	listItemV1alpha1Service     listsV1alpha1.ListItemServiceServer
-	domain                      domain.Domain
+	userV1alpha1Service          userV1alpha1.UserServiceServer
+	userSettingsV1alpha1Service  userV1alpha1.UserSettingsServiceServer
+	userAccessService            userV1alpha1.UserAccessServiceServer
+	recipesV1alpha1Service       recipesV1alpha1.RecipeServiceServer
+	recipeAccessService          recipesV1alpha1.RecipeAccessServiceServer
+	circleV1alpha1Service        circleV1alpha1.CircleServiceServer
+	circleAccessService          circleV1alpha1.CircleAccessServiceServer
+	calendarV1alpha1Service      calendarV1alpha1.CalendarServiceServer
+	calendarAccessService        calendarV1alpha1.CalendarAccessServiceServer
+	eventV1alpha1Service         calendarV1alpha1.EventServiceServer
+	accessKeyService             userV1alpha1.AccessKeyServiceServer
+	eventRecipeV1alpha1Service   calendarV1alpha1.EventRecipeServiceServer
+	listsV1alpha1Service         listsV1alpha1.ListServiceServer
+	listAccessService            listsV1alpha1.ListAccessServiceServer
+	listItemV1alpha1Service      listsV1alpha1.ListItemServiceServer
+	ingredientService            recipesV1alpha1.IngredientServiceServer
+	recipeRevisionService        recipesV1alpha1.RecipeRevisionServiceServer
+	pantriesV1alpha1Service      pantriesV1alpha1.PantryServiceServer
+	pantryAccessService          pantriesV1alpha1.PantryAccessServiceServer
+	pantryItemV1alpha1Service    pantriesV1alpha1.PantryItemServiceServer
+	recipeReviewService          recipesV1alpha1.RecipeReviewServiceServer
+	cookbookV1alpha1Service      cookbooksV1alpha1.CookbookServiceServer
+	cookbookAccessService        cookbooksV1alpha1.CookbookAccessServiceServer
+	cookbookEntryV1alpha1Service cookbooksV1alpha1.CookbookEntryServiceServer
+	recipeImportService          recipesV1alpha1.RecipeImportServiceServer
+	recipeImageService           recipesV1alpha1.RecipeImageServiceServer
+	operationService             operationsV1alpha1.OperationServiceServer
+	recipeCookLogService         recipesV1alpha1.RecipeCookLogServiceServer
+	recipeNoteService            recipesV1alpha1.RecipeNoteServiceServer
+	recipeImageCandidateService  recipesV1alpha1.RecipeImageCandidateServiceServer
+	listItemCompletionService    listsV1alpha1.ListItemCompletionServiceServer
+	domain                       domain.Domain
 }
 
 type NewServiceParams struct {
 	fx.In
 
-	UserV1alpha1Service         userV1alpha1.UserServiceServer
-	UserSettingsV1alpha1Service userV1alpha1.UserSettingsServiceServer
-	UserAccessService           userV1alpha1.UserAccessServiceServer
-	RecipesV1alpha1Service      recipesV1alpha1.RecipeServiceServer
-	RecipeAccessService         recipesV1alpha1.RecipeAccessServiceServer
-	CircleV1alpha1Service       circleV1alpha1.CircleServiceServer
-	CircleAccessService         circleV1alpha1.CircleAccessServiceServer
-	CalendarV1alpha1Service     calendarV1alpha1.CalendarServiceServer
-	CalendarAccessService       calendarV1alpha1.CalendarAccessServiceServer
-	EventV1alpha1Service        calendarV1alpha1.EventServiceServer
-	AccessKeyService            userV1alpha1.AccessKeyServiceServer
-	EventRecipeV1alpha1Service  calendarV1alpha1.EventRecipeServiceServer
-	ListsV1alpha1Service        listsV1alpha1.ListServiceServer
-	ListAccessService           listsV1alpha1.ListAccessServiceServer
-	ListItemV1alpha1Service     listsV1alpha1.ListItemServiceServer
-	Domain                      domain.Domain
+	UserV1alpha1Service          userV1alpha1.UserServiceServer
+	UserSettingsV1alpha1Service  userV1alpha1.UserSettingsServiceServer
+	UserAccessService            userV1alpha1.UserAccessServiceServer
+	RecipesV1alpha1Service       recipesV1alpha1.RecipeServiceServer
+	RecipeAccessService          recipesV1alpha1.RecipeAccessServiceServer
+	CircleV1alpha1Service        circleV1alpha1.CircleServiceServer
+	CircleAccessService          circleV1alpha1.CircleAccessServiceServer
+	CalendarV1alpha1Service      calendarV1alpha1.CalendarServiceServer
+	CalendarAccessService        calendarV1alpha1.CalendarAccessServiceServer
+	EventV1alpha1Service         calendarV1alpha1.EventServiceServer
+	AccessKeyService             userV1alpha1.AccessKeyServiceServer
+	EventRecipeV1alpha1Service   calendarV1alpha1.EventRecipeServiceServer
+	ListsV1alpha1Service         listsV1alpha1.ListServiceServer
+	ListAccessService            listsV1alpha1.ListAccessServiceServer
+	ListItemV1alpha1Service      listsV1alpha1.ListItemServiceServer
+	IngredientService            recipesV1alpha1.IngredientServiceServer
+	RecipeRevisionService        recipesV1alpha1.RecipeRevisionServiceServer
+	PantriesV1alpha1Service      pantriesV1alpha1.PantryServiceServer
+	PantryAccessService          pantriesV1alpha1.PantryAccessServiceServer
+	PantryItemV1alpha1Service    pantriesV1alpha1.PantryItemServiceServer
+	RecipeReviewService          recipesV1alpha1.RecipeReviewServiceServer
+	CookbookV1alpha1Service      cookbooksV1alpha1.CookbookServiceServer
+	CookbookAccessService        cookbooksV1alpha1.CookbookAccessServiceServer
+	CookbookEntryV1alpha1Service cookbooksV1alpha1.CookbookEntryServiceServer
+	RecipeImportService          recipesV1alpha1.RecipeImportServiceServer
+	RecipeImageService           recipesV1alpha1.RecipeImageServiceServer
+	OperationService             operationsV1alpha1.OperationServiceServer
+	RecipeCookLogService         recipesV1alpha1.RecipeCookLogServiceServer
+	RecipeNoteService            recipesV1alpha1.RecipeNoteServiceServer
+	RecipeImageCandidateService  recipesV1alpha1.RecipeImageCandidateServiceServer
+	ListItemCompletionService    listsV1alpha1.ListItemCompletionServiceServer
+	Domain                       domain.Domain
 }
 
 func NewService(params NewServiceParams) *Service {
 	return &Service{
-		userV1alpha1Service:         params.UserV1alpha1Service,
-		userSettingsV1alpha1Service: params.UserSettingsV1alpha1Service,
-		userAccessService:           params.UserAccessService,
-		recipesV1alpha1Service:      params.RecipesV1alpha1Service,
-		recipeAccessService:         params.RecipeAccessService,
-		circleV1alpha1Service:       params.CircleV1alpha1Service,
-		circleAccessService:         params.CircleAccessService,
-		calendarV1alpha1Service:     params.CalendarV1alpha1Service,
-		calendarAccessService:       params.CalendarAccessService,
-		eventV1alpha1Service:        params.EventV1alpha1Service,
-		accessKeyService:            params.AccessKeyService,
-		eventRecipeV1alpha1Service:  params.EventRecipeV1alpha1Service,
-		listsV1alpha1Service:        params.ListsV1alpha1Service,
-		listAccessService:           params.ListAccessService,
-		listItemV1alpha1Service:     params.ListItemV1alpha1Service,
-		domain:                      params.Domain,
+		userV1alpha1Service:          params.UserV1alpha1Service,
+		userSettingsV1alpha1Service:  params.UserSettingsV1alpha1Service,
+		userAccessService:            params.UserAccessService,
+		recipesV1alpha1Service:       params.RecipesV1alpha1Service,
+		recipeAccessService:          params.RecipeAccessService,
+		circleV1alpha1Service:        params.CircleV1alpha1Service,
+		circleAccessService:          params.CircleAccessService,
+		calendarV1alpha1Service:      params.CalendarV1alpha1Service,
+		calendarAccessService:        params.CalendarAccessService,
+		eventV1alpha1Service:         params.EventV1alpha1Service,
+		accessKeyService:             params.AccessKeyService,
+		eventRecipeV1alpha1Service:   params.EventRecipeV1alpha1Service,
+		listsV1alpha1Service:         params.ListsV1alpha1Service,
+		listAccessService:            params.ListAccessService,
+		listItemV1alpha1Service:      params.ListItemV1alpha1Service,
+		ingredientService:            params.IngredientService,
+		recipeRevisionService:        params.RecipeRevisionService,
+		pantriesV1alpha1Service:      params.PantriesV1alpha1Service,
+		pantryAccessService:          params.PantryAccessService,
+		pantryItemV1alpha1Service:    params.PantryItemV1alpha1Service,
+		recipeReviewService:          params.RecipeReviewService,
+		cookbookV1alpha1Service:      params.CookbookV1alpha1Service,
+		cookbookAccessService:        params.CookbookAccessService,
+		cookbookEntryV1alpha1Service: params.CookbookEntryV1alpha1Service,
+		recipeImportService:          params.RecipeImportService,
+		recipeImageService:           params.RecipeImageService,
+		operationService:             params.OperationService,
+		recipeCookLogService:         params.RecipeCookLogService,
+		recipeNoteService:            params.RecipeNoteService,
+		recipeImageCandidateService:  params.RecipeImageCandidateService,
+		listItemCompletionService:    params.ListItemCompletionService,
+		domain:                       params.Domain,
 	}
 }
 
@@ -159,6 +210,86 @@ func (s *Service) Register(m *http.ServeMux) error {
 		log.Printf("Failed to register gRPC gateway: %v", err)
 		return err
 	}
+	err = recipesV1alpha1.RegisterIngredientServiceHandlerServer(ctx, mux, s.ingredientService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = recipesV1alpha1.RegisterRecipeRevisionServiceHandlerServer(ctx, mux, s.recipeRevisionService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = pantriesV1alpha1.RegisterPantryServiceHandlerServer(ctx, mux, s.pantriesV1alpha1Service)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = pantriesV1alpha1.RegisterPantryAccessServiceHandlerServer(ctx, mux, s.pantryAccessService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = pantriesV1alpha1.RegisterPantryItemServiceHandlerServer(ctx, mux, s.pantryItemV1alpha1Service)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = recipesV1alpha1.RegisterRecipeReviewServiceHandlerServer(ctx, mux, s.recipeReviewService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = cookbooksV1alpha1.RegisterCookbookServiceHandlerServer(ctx, mux, s.cookbookV1alpha1Service)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = cookbooksV1alpha1.RegisterCookbookAccessServiceHandlerServer(ctx, mux, s.cookbookAccessService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = cookbooksV1alpha1.RegisterCookbookEntryServiceHandlerServer(ctx, mux, s.cookbookEntryV1alpha1Service)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = recipesV1alpha1.RegisterRecipeImportServiceHandlerServer(ctx, mux, s.recipeImportService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = recipesV1alpha1.RegisterRecipeImageServiceHandlerServer(ctx, mux, s.recipeImageService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = operationsV1alpha1.RegisterOperationServiceHandlerServer(ctx, mux, s.operationService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = recipesV1alpha1.RegisterRecipeCookLogServiceHandlerServer(ctx, mux, s.recipeCookLogService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = recipesV1alpha1.RegisterRecipeNoteServiceHandlerServer(ctx, mux, s.recipeNoteService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = recipesV1alpha1.RegisterRecipeImageCandidateServiceHandlerServer(ctx, mux, s.recipeImageCandidateService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
+	err = listsV1alpha1.RegisterListItemCompletionServiceHandlerServer(ctx, mux, s.listItemCompletionService)
+	if err != nil {
+		log.Printf("Failed to register gRPC gateway: %v", err)
+		return err
+	}
 	m.Handle("/", headers.NewAuthTokenMiddleware(s.domain)(mux))
 	return nil
 }
@@ -0,0 +1,129 @@
+// Package blurhash encodes images as BlurHash strings, a compact
+// representation of a blurred image that clients decode into a placeholder
+// while the real image loads. See https://blurha.sh for the format.
+package blurhash
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strings"
+)
+
+const characters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// Encode returns the BlurHash of img using xComponents horizontal and
+// yComponents vertical components, each between 1 and 9. The image should be
+// small, a few dozen pixels across, since every pixel is visited per
+// component.
+func Encode(xComponents, yComponents int, img image.Image) (string, error) {
+	if xComponents < 1 || xComponents > 9 || yComponents < 1 || yComponents > 9 {
+		return "", fmt.Errorf("blurhash components must be between 1 and 9, got %dx%d", xComponents, yComponents)
+	}
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return "", fmt.Errorf("blurhash image is empty")
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+	for y := 0; y < yComponents; y++ {
+		for x := 0; x < xComponents; x++ {
+			factors = append(factors, multiplyBasisFunction(x, y, img))
+		}
+	}
+
+	var hash strings.Builder
+	hash.WriteString(encode83((xComponents-1)+(yComponents-1)*9, 1))
+
+	dc, ac := factors[0], factors[1:]
+	maximumValue := 1.0
+	if len(ac) > 0 {
+		actualMaximumValue := 0.0
+		for _, factor := range ac {
+			for _, component := range factor {
+				actualMaximumValue = math.Max(actualMaximumValue, math.Abs(component))
+			}
+		}
+		quantisedMaximumValue := int(math.Max(0, math.Min(82, math.Floor(actualMaximumValue*166-0.5))))
+		maximumValue = float64(quantisedMaximumValue+1) / 166
+		hash.WriteString(encode83(quantisedMaximumValue, 1))
+	} else {
+		hash.WriteString(encode83(0, 1))
+	}
+
+	hash.WriteString(encode83(encodeDC(dc), 4))
+	for _, factor := range ac {
+		hash.WriteString(encode83(encodeAC(factor, maximumValue), 2))
+	}
+
+	return hash.String(), nil
+}
+
+// multiplyBasisFunction returns the linear RGB weight of one cosine component
+// over the whole image.
+func multiplyBasisFunction(xComponent, yComponent int, img image.Image) [3]float64 {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	var factor [3]float64
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			basis := math.Cos(math.Pi*float64(xComponent)*float64(x)/float64(width)) *
+				math.Cos(math.Pi*float64(yComponent)*float64(y)/float64(height))
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			factor[0] += basis * sRGBToLinear(int(r>>8))
+			factor[1] += basis * sRGBToLinear(int(g>>8))
+			factor[2] += basis * sRGBToLinear(int(b>>8))
+		}
+	}
+
+	normalisation := 2.0
+	if xComponent == 0 && yComponent == 0 {
+		normalisation = 1
+	}
+	scale := normalisation / float64(width*height)
+	for i := range factor {
+		factor[i] *= scale
+	}
+	return factor
+}
+
+func encodeDC(value [3]float64) int {
+	return linearToSRGB(value[0])<<16 + linearToSRGB(value[1])<<8 + linearToSRGB(value[2])
+}
+
+func encodeAC(value [3]float64, maximumValue float64) int {
+	quantise := func(v float64) int {
+		return int(math.Max(0, math.Min(18, math.Floor(signPow(v/maximumValue, 0.5)*9+9.5))))
+	}
+	return quantise(value[0])*19*19 + quantise(value[1])*19 + quantise(value[2])
+}
+
+func encode83(value, length int) string {
+	var out strings.Builder
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		out.WriteByte(characters[digit])
+	}
+	return out.String()
+}
+
+func sRGBToLinear(value int) float64 {
+	v := float64(value) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) int {
+	v := math.Max(0, math.Min(1, value))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(value, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), exp), value)
+}
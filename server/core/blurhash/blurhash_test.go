@@ -0,0 +1,76 @@
+package blurhash
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestEncode_Black(t *testing.T) {
+	got, err := Encode(4, 3, solidImage(color.Black))
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if want := "L00000fQfQfQfQfQfQfQfQfQfQfQ"; got != want {
+		t.Errorf("Encode() = %q, want %q", got, want)
+	}
+}
+
+func TestEncode_AverageColor(t *testing.T) {
+	colors := []color.RGBA{
+		{R: 255, G: 255, B: 255, A: 255},
+		{R: 200, G: 120, B: 40, A: 255},
+		{R: 12, G: 80, B: 160, A: 255},
+	}
+
+	for _, c := range colors {
+		got, err := Encode(4, 3, solidImage(c))
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		want := encode83(int(c.R)<<16+int(c.G)<<8+int(c.B), 4)
+		if got[2:6] != want {
+			t.Errorf("Encode(%v) DC = %q, want %q", c, got[2:6], want)
+		}
+	}
+}
+
+func TestEncode_Gradient(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 16), G: uint8(y * 16), B: 128, A: 255})
+		}
+	}
+
+	got, err := Encode(4, 3, img)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	// size flag, maximum value, DC and 11 AC components
+	if len(got) != 1+1+4+2*11 {
+		t.Errorf("Encode() length = %d, want %d", len(got), 1+1+4+2*11)
+	}
+	if got[1] == '0' {
+		t.Errorf("Encode() = %q, want a non-zero maximum AC value", got)
+	}
+}
+
+func TestEncode_InvalidComponents(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for _, components := range [][2]int{{0, 3}, {4, 10}} {
+		if _, err := Encode(components[0], components[1], img); err == nil {
+			t.Errorf("Encode(%d, %d) error = nil, want error", components[0], components[1])
+		}
+	}
+}
+
+func solidImage(c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 6))
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 8; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
@@ -0,0 +1,189 @@
+// Package dietary derives the diets a recipe is suitable for and the
+// allergens it contains from its ingredients, using an embedded rules table.
+package dietary
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+//go:embed rules.csv
+var rulesCSV string
+
+// Rule is a row of the rules table.
+type Rule struct {
+	Keyword   string
+	Allergens []string
+	// Breaks are the diets an ingredient matching the rule is not suitable
+	// for.
+	Breaks []string
+}
+
+var (
+	rules = mustLoadRules()
+	// maxKeywordWords is the number of words of the longest keyword.
+	maxKeywordWords = longestKeyword(rules)
+)
+
+// Tag returns the diets a recipe with the given ingredients is suitable for
+// and the allergens it contains, with the overrides applied. Ingredients
+// that no rule matches are assumed to break no diet and contain no
+// allergen. Optional ingredients count towards the allergens but not the
+// diets.
+func Tag(groups []model.IngredientGroup, overrides model.DietaryOverrides) (diets []string, allergens []string) {
+	broken := map[string]struct{}{}
+	contained := map[string]struct{}{}
+	for _, group := range groups {
+		for _, ingredient := range group.RecipeIngredients {
+			for _, rule := range Match(ingredient.Title) {
+				for _, allergen := range rule.Allergens {
+					contained[allergen] = struct{}{}
+				}
+				if ingredient.Optional {
+					continue
+				}
+				for _, diet := range rule.Breaks {
+					broken[diet] = struct{}{}
+				}
+			}
+		}
+	}
+
+	diets = []string{}
+	for _, diet := range model.Diets {
+		_, isBroken := broken[diet]
+		if (!isBroken || slices.Contains(overrides.AddedDiets, diet)) && !slices.Contains(overrides.RemovedDiets, diet) {
+			diets = append(diets, diet)
+		}
+	}
+
+	allergens = []string{}
+	for _, allergen := range model.Allergens {
+		_, isContained := contained[allergen]
+		if (isContained || slices.Contains(overrides.AddedAllergens, allergen)) && !slices.Contains(overrides.RemovedAllergens, allergen) {
+			allergens = append(allergens, allergen)
+		}
+	}
+
+	return diets, allergens
+}
+
+// Match returns the rules matching an ingredient title. At every word the
+// longest matching keyword is used, so "almond milk" only matches its own
+// rule and not the one for "milk".
+func Match(title string) []Rule {
+	words := strings.Fields(ingredientcatalog.Normalize(title))
+
+	var matched []Rule
+	for i := 0; i < len(words); {
+		found := false
+		for n := min(maxKeywordWords, len(words)-i); n > 0; n-- {
+			if rule, ok := rules[strings.Join(words[i:i+n], " ")]; ok {
+				matched = append(matched, rule)
+				i += n
+				found = true
+				break
+			}
+		}
+		if !found {
+			i++
+		}
+	}
+	return matched
+}
+
+// Conflicts returns the restrictions a recipe does not meet: the diets it is
+// not suitable for and the allergens it contains.
+func Conflicts(diets []string, allergens []string, restrictions model.DietaryRestrictions) []string {
+	conflicts := []string{}
+	for _, diet := range restrictions.Diets {
+		if !slices.Contains(diets, diet) {
+			conflicts = append(conflicts, diet)
+		}
+	}
+	for _, allergen := range restrictions.Allergens {
+		if slices.Contains(allergens, allergen) {
+			conflicts = append(conflicts, allergen)
+		}
+	}
+	return conflicts
+}
+
+// ValidateDiets returns an error for the first value that is not a known diet.
+func ValidateDiets(diets []string) error {
+	for _, diet := range diets {
+		if !slices.Contains(model.Diets, diet) {
+			return fmt.Errorf("unknown diet %q", diet)
+		}
+	}
+	return nil
+}
+
+// ValidateAllergens returns an error for the first value that is not a known
+// allergen.
+func ValidateAllergens(allergens []string) error {
+	for _, allergen := range allergens {
+		if !slices.Contains(model.Allergens, allergen) {
+			return fmt.Errorf("unknown allergen %q", allergen)
+		}
+	}
+	return nil
+}
+
+func longestKeyword(rules map[string]Rule) int {
+	longest := 0
+	for keyword := range rules {
+		longest = max(longest, len(strings.Fields(keyword)))
+	}
+	return longest
+}
+
+func mustLoadRules() map[string]Rule {
+	rules, err := loadRules(rulesCSV)
+	if err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+func loadRules(data string) (map[string]Rule, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read dietary rules: %w", err)
+	}
+
+	rules := make(map[string]Rule, len(records))
+	for _, record := range records {
+		keyword := ingredientcatalog.Normalize(record[0])
+		if keyword == "" {
+			return nil, fmt.Errorf("invalid dietary rule keyword %q", record[0])
+		}
+
+		rule := Rule{Keyword: keyword, Allergens: splitList(record[1]), Breaks: splitList(record[2])}
+		if err := ValidateAllergens(rule.Allergens); err != nil {
+			return nil, fmt.Errorf("invalid dietary rule %q: %w", record[0], err)
+		}
+		if err := ValidateDiets(rule.Breaks); err != nil {
+			return nil, fmt.Errorf("invalid dietary rule %q: %w", record[0], err)
+		}
+		rules[keyword] = rule
+	}
+	return rules, nil
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, "|")
+}
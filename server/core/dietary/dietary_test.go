@@ -0,0 +1,104 @@
+package dietary
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+func ingredients(titles ...string) []model.IngredientGroup {
+	group := model.IngredientGroup{}
+	for _, title := range titles {
+		group.RecipeIngredients = append(group.RecipeIngredients, model.RecipeIngredient{Title: title})
+	}
+	return []model.IngredientGroup{group}
+}
+
+func TestTag(t *testing.T) {
+	tests := []struct {
+		name          string
+		groups        []model.IngredientGroup
+		overrides     model.DietaryOverrides
+		wantDiets     []string
+		wantAllergens []string
+	}{
+		{
+			name:          "vegan",
+			groups:        ingredients("2 cups rice", "1 can coconut milk", "Almond Milk", "tofu, cubed"),
+			wantDiets:     []string{model.Diet_Vegetarian, model.Diet_Vegan, model.Diet_GlutenFree, model.Diet_DairyFree},
+			wantAllergens: []string{model.Allergen_TreeNut, model.Allergen_Soy},
+		},
+		{
+			name:          "cake",
+			groups:        ingredients("all-purpose flour", "unsalted butter, softened", "large eggs", "chopped walnuts"),
+			wantDiets:     []string{model.Diet_Vegetarian},
+			wantAllergens: []string{model.Allergen_TreeNut, model.Allergen_Dairy, model.Allergen_Egg, model.Allergen_Wheat},
+		},
+		{
+			name:          "longest keyword wins",
+			groups:        ingredients("creamy peanut butter", "butternut squash", "eggplant"),
+			wantDiets:     []string{model.Diet_Vegetarian, model.Diet_Vegan, model.Diet_GlutenFree, model.Diet_DairyFree},
+			wantAllergens: []string{model.Allergen_Peanut},
+		},
+		{
+			name:          "meat and shellfish",
+			groups:        ingredients("chicken thighs", "shrimp", "soy sauce"),
+			wantDiets:     []string{model.Diet_DairyFree},
+			wantAllergens: []string{model.Allergen_Soy, model.Allergen_Wheat, model.Allergen_Shellfish},
+		},
+		{
+			name:   "overrides",
+			groups: ingredients("spaghetti", "parmesan"),
+			overrides: model.DietaryOverrides{
+				AddedDiets:       []string{model.Diet_GlutenFree},
+				RemovedDiets:     []string{model.Diet_Vegetarian},
+				AddedAllergens:   []string{model.Allergen_Sesame},
+				RemovedAllergens: []string{model.Allergen_Wheat},
+			},
+			wantDiets:     []string{model.Diet_GlutenFree},
+			wantAllergens: []string{model.Allergen_Dairy, model.Allergen_Sesame},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diets, allergens := Tag(tt.groups, tt.overrides)
+			if !reflect.DeepEqual(diets, tt.wantDiets) {
+				t.Errorf("diets = %v, want %v", diets, tt.wantDiets)
+			}
+			if !reflect.DeepEqual(allergens, tt.wantAllergens) {
+				t.Errorf("allergens = %v, want %v", allergens, tt.wantAllergens)
+			}
+		})
+	}
+}
+
+func TestTag_OptionalIngredients(t *testing.T) {
+	groups := []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{
+		{Title: "lentils"},
+		{Title: "bacon", Optional: true},
+		{Title: "chopped peanuts", Optional: true},
+	}}}
+
+	diets, allergens := Tag(groups, model.DietaryOverrides{})
+	if !reflect.DeepEqual(diets, model.Diets) {
+		t.Errorf("diets = %v, want %v", diets, model.Diets)
+	}
+	if !reflect.DeepEqual(allergens, []string{model.Allergen_Peanut}) {
+		t.Errorf("allergens = %v, want [peanut]", allergens)
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	restrictions := model.DietaryRestrictions{
+		Diets:     []string{model.Diet_Vegetarian},
+		Allergens: []string{model.Allergen_Peanut, model.Allergen_TreeNut},
+	}
+
+	got := Conflicts([]string{model.Diet_DairyFree}, []string{model.Allergen_Peanut, model.Allergen_Soy}, restrictions)
+	want := []string{model.Diet_Vegetarian, model.Allergen_Peanut}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Conflicts() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,214 @@
+// Package grocerylist aggregates the ingredients of recipes into grocery items.
+package grocerylist
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/measurement"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+// Source is a recipe to shop for.
+type Source struct {
+	Recipe model.Recipe
+	// Scale multiplies every amount in the recipe.
+	Scale float64
+}
+
+// Item is a grocery item aggregated from one or more recipe ingredients.
+type Item struct {
+	Title        string
+	IngredientId model.IngredientId
+	// Quantities holds at most one quantity per measurement family, plus any
+	// unitless count.
+	Quantities []Quantity
+	// Optional is true when every contributing recipe ingredient was optional.
+	Optional  bool
+	RecipeIds []model.RecipeId
+}
+
+// Quantity is an amount in a measurement type.
+type Quantity struct {
+	Amount          float64
+	MeasurementType pb.Recipe_MeasurementType
+}
+
+// String formats the item for a list item title, e.g. "6 tbsp butter".
+func (i Item) String() string {
+	parts := make([]string, 0, len(i.Quantities))
+	for _, quantity := range i.Quantities {
+		parts = append(parts, measurement.Format(quantity.Amount, quantity.MeasurementType))
+	}
+
+	title := i.Title
+	if len(parts) > 0 {
+		title = strings.Join(parts, " + ") + " " + title
+	}
+	if i.Optional {
+		title += " (optional)"
+	}
+	return title
+}
+
+// ScaleForServings returns the scale needed to make the given number of
+// servings of a recipe with the given yield. The recipe is used as written
+// when servings is not set or the yield has no number in it.
+func ScaleForServings(servings float64, yieldAmount string) float64 {
+	if servings <= 0 {
+		return 1
+	}
+	yield, ok := measurement.ParseYield(yieldAmount)
+	if !ok {
+		return 1
+	}
+	return servings / yield
+}
+
+type accumulator struct {
+	item Item
+	// base holds the total amount per family in its base unit.
+	base map[measurement.Family]float64
+	// units holds the measurement types seen per family.
+	units map[measurement.Family][]pb.Recipe_MeasurementType
+	count float64
+}
+
+// Aggregate merges the ingredients of the sources into grocery items. Recipe
+// ingredients linked to the same catalog ingredient, or with the same
+// normalized title, are merged and their amounts are added up after unit
+// normalization. Volumes are converted to weights when an ingredient has both
+// and its density is known. Optional ingredients are skipped unless
+// includeOptional is set.
+func Aggregate(sources []Source, densities map[int64]float64, includeOptional bool) []Item {
+	order := []string{}
+	accumulators := map[string]*accumulator{}
+
+	for _, source := range sources {
+		scale := source.Scale
+		if scale <= 0 {
+			scale = 1
+		}
+		for _, group := range source.Recipe.IngredientGroups {
+			for _, ingredient := range group.RecipeIngredients {
+				if ingredient.Optional && !includeOptional {
+					continue
+				}
+
+				key := ingredientcatalog.Normalize(ingredient.Title)
+				if ingredient.IngredientId.IngredientId != 0 {
+					key = strconv.FormatInt(ingredient.IngredientId.IngredientId, 10)
+				}
+				if key == "" {
+					continue
+				}
+
+				acc, ok := accumulators[key]
+				if !ok {
+					acc = &accumulator{
+						item: Item{
+							Title:        strings.TrimSpace(ingredient.Title),
+							IngredientId: ingredient.IngredientId,
+							Optional:     true,
+						},
+						base:  map[measurement.Family]float64{},
+						units: map[measurement.Family][]pb.Recipe_MeasurementType{},
+					}
+					accumulators[key] = acc
+					order = append(order, key)
+				}
+
+				acc.item.Optional = acc.item.Optional && ingredient.Optional
+				if !slices.Contains(acc.item.RecipeIds, source.Recipe.Id) {
+					acc.item.RecipeIds = append(acc.item.RecipeIds, source.Recipe.Id)
+				}
+
+				for _, quantity := range quantitiesOf(ingredient) {
+					acc.add(quantity.Amount*scale, quantity.MeasurementType)
+				}
+			}
+		}
+	}
+
+	items := make([]Item, 0, len(order))
+	for _, key := range order {
+		acc := accumulators[key]
+		if density, ok := densities[acc.item.IngredientId.IngredientId]; ok {
+			acc.convertVolumeToWeight(density)
+		}
+		items = append(items, acc.result())
+	}
+
+	return items
+}
+
+// quantitiesOf returns the amounts to shop for of a recipe ingredient. Both
+// amounts are used for "and", the upper bound for "to" and the first for "or".
+func quantitiesOf(ingredient model.RecipeIngredient) []Quantity {
+	first := Quantity{Amount: ingredient.MeasurementAmount, MeasurementType: ingredient.MeasurementType}
+	second := Quantity{Amount: ingredient.SecondMeasurementAmount, MeasurementType: ingredient.SecondMeasurementType}
+
+	switch ingredient.MeasurementConjunction {
+	case pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_AND:
+		return []Quantity{first, second}
+	case pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_TO:
+		if second.Amount > 0 {
+			if second.MeasurementType == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
+				second.MeasurementType = first.MeasurementType
+			}
+			return []Quantity{second}
+		}
+	}
+	return []Quantity{first}
+}
+
+func (a *accumulator) add(amount float64, measurementType pb.Recipe_MeasurementType) {
+	if amount <= 0 {
+		return
+	}
+	family := measurement.FamilyOf(measurementType)
+	if family == measurement.FamilyNone {
+		a.count += amount
+		return
+	}
+	a.base[family] += measurement.ToBase(amount, measurementType)
+	if !slices.Contains(a.units[family], measurementType) {
+		a.units[family] = append(a.units[family], measurementType)
+	}
+}
+
+func (a *accumulator) convertVolumeToWeight(density float64) {
+	volume, hasVolume := a.base[measurement.FamilyVolume]
+	if !hasVolume || len(a.units[measurement.FamilyWeight]) == 0 {
+		return
+	}
+	grams, ok := measurement.ToGrams(volume, pb.Recipe_MEASUREMENT_TYPE_TEASPOON, &density)
+	if !ok {
+		return
+	}
+	a.base[measurement.FamilyWeight] += grams
+	delete(a.base, measurement.FamilyVolume)
+	delete(a.units, measurement.FamilyVolume)
+}
+
+func (a *accumulator) result() Item {
+	item := a.item
+	for _, family := range []measurement.Family{measurement.FamilyWeight, measurement.FamilyVolume} {
+		base, ok := a.base[family]
+		if !ok {
+			continue
+		}
+		measurementType := measurement.BestUnit(base, a.units[family])
+		item.Quantities = append(item.Quantities, Quantity{
+			Amount:          measurement.FromBase(base, measurementType),
+			MeasurementType: measurementType,
+		})
+	}
+	if a.count > 0 {
+		item.Quantities = append(item.Quantities, Quantity{Amount: a.count})
+	}
+	return item
+}
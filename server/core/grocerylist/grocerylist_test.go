@@ -0,0 +1,75 @@
+package grocerylist
+
+import (
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+func recipe(id int64, ingredients ...model.RecipeIngredient) model.Recipe {
+	return model.Recipe{
+		Id:               model.RecipeId{RecipeId: id},
+		IngredientGroups: []model.IngredientGroup{{RecipeIngredients: ingredients}},
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	butter := model.IngredientId{IngredientId: 1}
+	flour := model.IngredientId{IngredientId: 2}
+
+	sources := []Source{
+		{Recipe: recipe(1,
+			model.RecipeIngredient{Title: "butter", IngredientId: butter, MeasurementAmount: 2, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_TABLESPOON},
+			model.RecipeIngredient{Title: "flour", IngredientId: flour, MeasurementAmount: 1, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+			model.RecipeIngredient{Title: "eggs", MeasurementAmount: 2},
+			model.RecipeIngredient{Title: "parsley", Optional: true, MeasurementAmount: 1, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_TABLESPOON},
+		)},
+		{Recipe: recipe(2,
+			model.RecipeIngredient{Title: "Butter", IngredientId: butter, MeasurementAmount: 0.25, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+			model.RecipeIngredient{Title: "flour", IngredientId: flour, MeasurementAmount: 100, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_GRAM},
+			model.RecipeIngredient{Title: "egg", MeasurementAmount: 1, MeasurementConjunction: pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_TO, SecondMeasurementAmount: 2},
+		)},
+	}
+
+	items := Aggregate(sources, nil, false)
+	want := []string{"6 tbsp butter", "100 g + 1 cup flour", "4 eggs"}
+	if len(items) != len(want) {
+		t.Fatalf("Aggregate() returned %d items, want %d: %v", len(items), len(want), items)
+	}
+	for i, item := range items {
+		if got := item.String(); got != want[i] {
+			t.Errorf("item %d = %q, want %q", i, got, want[i])
+		}
+	}
+	if len(items[0].RecipeIds) != 2 {
+		t.Errorf("butter has %d source recipes, want 2", len(items[0].RecipeIds))
+	}
+
+	items = Aggregate(sources, map[int64]float64{flour.IngredientId: 0.5}, true)
+	if got := items[1].String(); got != "218.29 g flour" {
+		t.Errorf("flour with density = %q, want %q", got, "218.29 g flour")
+	}
+	if got := items[3].String(); got != "1 tbsp parsley (optional)" {
+		t.Errorf("optional item = %q, want %q", got, "1 tbsp parsley (optional)")
+	}
+}
+
+func TestScaleForServings(t *testing.T) {
+	tests := []struct {
+		servings float64
+		yield    string
+		want     float64
+	}{
+		{8, "4 servings", 2},
+		{2, "Serves 4", 0.5},
+		{0, "4 servings", 1},
+		{3, "one loaf", 1},
+	}
+
+	for _, tt := range tests {
+		if got := ScaleForServings(tt.servings, tt.yield); got != tt.want {
+			t.Errorf("ScaleForServings(%v, %q) = %v, want %v", tt.servings, tt.yield, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package ingredientcatalog normalizes free-form recipe ingredient titles and
+// matches them against the canonical ingredient catalog.
+package ingredientcatalog
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MatchThreshold is the minimum similarity for a fuzzy match to be accepted.
+const MatchThreshold = 0.8
+
+var (
+	parentheticalRegex = regexp.MustCompile(`\([^)]*\)`)
+	nonLetterRegex     = regexp.MustCompile(`[^\p{L}]+`)
+)
+
+// descriptors are preparation and size words that do not change which
+// ingredient is being referred to.
+var descriptors = map[string]struct{}{
+	"about": {}, "beaten": {}, "boneless": {}, "chilled": {}, "chopped": {},
+	"coarsely": {}, "cold": {}, "crushed": {}, "cubed": {}, "diced": {},
+	"divided": {}, "drained": {}, "finely": {}, "fresh": {}, "freshly": {},
+	"grated": {}, "halved": {}, "large": {}, "lightly": {}, "medium": {},
+	"melted": {}, "minced": {}, "optional": {}, "packed": {}, "peeled": {},
+	"quartered": {}, "rinsed": {}, "room": {}, "roughly": {}, "shredded": {},
+	"skinless": {}, "sliced": {}, "small": {}, "softened": {}, "taste": {},
+	"temperature": {}, "thinly": {}, "to": {}, "trimmed": {}, "warm": {},
+	"of": {}, "for": {}, "serving": {},
+}
+
+// invariantPlurals are words ending in "s" that must not be singularized.
+var invariantPlurals = map[string]struct{}{
+	"asparagus": {}, "brussels": {}, "couscous": {}, "grits": {},
+	"hummus": {}, "molasses": {}, "swiss": {}, "oats": {}, "citrus": {},
+}
+
+// Normalize reduces an ingredient title to the lowercase, singular,
+// descriptor-free form that the catalog is keyed on. For example
+// "2 Large Eggs, beaten" and "egg" both normalize to "egg". Accents are
+// dropped from Latin letters so "jalapeño" and "jalapeno" normalize alike;
+// letters of other scripts are kept as they are.
+func Normalize(title string) string {
+	title = foldLatinAccents(strings.ToLower(title))
+	title = parentheticalRegex.ReplaceAllString(title, " ")
+	if i := strings.Index(title, ","); i >= 0 {
+		title = title[:i]
+	}
+	title = nonLetterRegex.ReplaceAllString(title, " ")
+
+	tokens := []string{}
+	for _, token := range strings.Fields(title) {
+		if _, ok := descriptors[token]; ok {
+			continue
+		}
+		tokens = append(tokens, singularize(token))
+	}
+
+	return strings.Join(tokens, " ")
+}
+
+// foldLatinAccents removes the combining marks that follow Latin letters.
+// Marks on letters of other scripts are kept since they can change the letter.
+func foldLatinAccents(s string) string {
+	var b strings.Builder
+	latin := false
+	for _, r := range norm.NFD.String(s) {
+		if unicode.Is(unicode.Mn, r) {
+			if !latin {
+				b.WriteRune(r)
+			}
+			continue
+		}
+		latin = unicode.Is(unicode.Latin, r)
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(b.String())
+}
+
+func singularize(word string) string {
+	if _, ok := invariantPlurals[word]; ok {
+		return word
+	}
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return word[:len(word)-3] + "y"
+	case len(word) > 4 && strings.HasSuffix(word, "oes"):
+		return word[:len(word)-2]
+	case len(word) > 4 && (strings.HasSuffix(word, "ches") || strings.HasSuffix(word, "shes")):
+		return word[:len(word)-2]
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// Match returns the catalog ingredient that best matches the given title. An
+// exact match against a normalized title or alias always wins; otherwise the
+// candidate with the highest similarity at or above MatchThreshold is returned.
+func Match(title string, catalog []model.Ingredient) (model.Ingredient, bool) {
+	normalized := Normalize(title)
+	if normalized == "" {
+		return model.Ingredient{}, false
+	}
+
+	var best model.Ingredient
+	bestScore := 0.0
+	for _, ingredient := range catalog {
+		for _, name := range append([]string{ingredient.Title}, ingredient.Aliases...) {
+			candidate := Normalize(name)
+			if candidate == normalized {
+				return ingredient, true
+			}
+			if score := Similarity(normalized, candidate); score > bestScore {
+				best = ingredient
+				bestScore = score
+			}
+		}
+	}
+
+	if bestScore < MatchThreshold {
+		return model.Ingredient{}, false
+	}
+
+	return best, true
+}
+
+// Similarity returns the Sørensen–Dice coefficient of the character bigrams of
+// a and b, from 0 (nothing in common) to 1 (identical).
+func Similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ar, br := []rune(a), []rune(b)
+	if len(ar) < 2 || len(br) < 2 {
+		return 0
+	}
+
+	bigrams := map[[2]rune]int{}
+	for i := 0; i < len(ar)-1; i++ {
+		bigrams[[2]rune{ar[i], ar[i+1]}]++
+	}
+
+	intersection := 0
+	for i := 0; i < len(br)-1; i++ {
+		bigram := [2]rune{br[i], br[i+1]}
+		if bigrams[bigram] > 0 {
+			bigrams[bigram]--
+			intersection++
+		}
+	}
+
+	return 2 * float64(intersection) / float64(len(ar)+len(br)-2)
+}
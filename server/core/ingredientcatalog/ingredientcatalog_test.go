@@ -0,0 +1,106 @@
+package ingredientcatalog
+
+import (
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Buttermilk", "buttermilk"},
+		{"Large Eggs, beaten", "egg"},
+		{"fresh basil (packed)", "basil"},
+		{"Tomatoes", "tomato"},
+		{"cherries", "cherry"},
+		{"peaches", "peach"},
+		{"molasses", "molasses"},
+		{"all-purpose flour", "all purpose flour"},
+		{"salt, to taste", "salt"},
+		{"  ", ""},
+		{"Jalapeños, sliced", "jalapeno"},
+		{"crème fraîche", "creme fraiche"},
+		{"сметана", "сметана"},
+		{"醤油", "醤油"},
+		{"ごま油", "ごま油"},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.input); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	catalog := []model.Ingredient{
+		{Id: model.IngredientId{IngredientId: 1}, Title: "buttermilk"},
+		{Id: model.IngredientId{IngredientId: 2}, Title: "all purpose flour", Aliases: []string{"plain flour", "ap flour"}},
+		{Id: model.IngredientId{IngredientId: 3}, Title: "egg"},
+		{Id: model.IngredientId{IngredientId: 4}, Title: "chicken broth"},
+	}
+
+	tests := []struct {
+		input string
+		want  int64
+		found bool
+	}{
+		{"Buttermilk", 1, true},
+		{"buttermilks", 1, true},
+		{"butermilk", 1, true},
+		{"Plain Flour", 2, true},
+		{"all-purpose flour, sifted", 2, true},
+		{"2 large eggs", 3, true},
+		{"egg yolks", 0, false},
+		{"chicken stock", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, found := Match(tt.input, catalog)
+		if found != tt.found || got.Id.IngredientId != tt.want {
+			t.Errorf("Match(%q) = (%d, %v), want (%d, %v)", tt.input, got.Id.IngredientId, found, tt.want, tt.found)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if got := Similarity("night", "nacht"); got != 0.25 {
+		t.Errorf("Similarity(night, nacht) = %v, want 0.25", got)
+	}
+	if got := Similarity("egg", "egg"); got != 1 {
+		t.Errorf("Similarity(egg, egg) = %v, want 1", got)
+	}
+	if got := Similarity("a", "egg"); got != 0 {
+		t.Errorf("Similarity(a, egg) = %v, want 0", got)
+	}
+	if got := Similarity("соль", "соли"); got != 2.0/3 {
+		t.Errorf("Similarity(соль, соли) = %v, want 2/3", got)
+	}
+	if got := Similarity("醤油", "ごま油"); got != 0 {
+		t.Errorf("Similarity(醤油, ごま油) = %v, want 0", got)
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Scallions", "green onion"},
+		{"spring onions, thinly sliced", "green onion"},
+		{"green onion", "green onion"},
+		{"garbanzo beans", "chickpea"},
+		{"Confectioners' Sugar", "powdered sugar"},
+		{"red onion", "red onion"},
+	}
+
+	for _, tt := range tests {
+		if got := Canonical(tt.input); got != tt.want {
+			t.Errorf("Canonical(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
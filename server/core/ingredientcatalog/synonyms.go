@@ -0,0 +1,52 @@
+package ingredientcatalog
+
+// synonymGroups are ingredients that go by more than one name. The first name
+// of each group is the canonical one.
+var synonymGroups = [][]string{
+	{"green onion", "scallion", "spring onion"},
+	{"cilantro", "coriander leaf", "fresh coriander", "chinese parsley"},
+	{"chickpea", "garbanzo bean", "garbanzo"},
+	{"zucchini", "courgette"},
+	{"eggplant", "aubergine"},
+	{"bell pepper", "capsicum", "sweet pepper"},
+	{"arugula", "rocket"},
+	{"powdered sugar", "confectioners sugar", "icing sugar"},
+	{"baking soda", "bicarbonate of soda", "bicarb soda"},
+	{"heavy cream", "heavy whipping cream", "double cream", "whipping cream"},
+	{"all purpose flour", "plain flour", "ap flour"},
+	{"cornstarch", "cornflour", "corn starch"},
+	{"shrimp", "prawn"},
+	{"ground beef", "minced beef", "beef mince"},
+	{"romaine lettuce", "cos lettuce", "romaine"},
+	{"snow pea", "mangetout"},
+	{"beet", "beetroot"},
+	{"rutabaga", "swede"},
+	{"fava bean", "broad bean"},
+	{"kosher salt", "coarse salt"},
+	{"golden raisin", "sultana"},
+	{"molasses", "treacle"},
+	{"cantaloupe", "rockmelon"},
+	{"green bean", "string bean", "french bean"},
+}
+
+// synonyms maps every normalized name to the normalized canonical name.
+var synonyms = func() map[string]string {
+	names := map[string]string{}
+	for _, group := range synonymGroups {
+		canonical := Normalize(group[0])
+		for _, name := range group {
+			names[Normalize(name)] = canonical
+		}
+	}
+	return names
+}()
+
+// Canonical normalizes an ingredient title and replaces known synonyms with a
+// single name, so "Scallions" and "green onion" both become "green onion".
+func Canonical(title string) string {
+	normalized := Normalize(title)
+	if canonical, ok := synonyms[normalized]; ok {
+		return canonical
+	}
+	return normalized
+}
@@ -0,0 +1,214 @@
+// Package measurement converts between the recipe measurement types.
+package measurement
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+// Family groups measurement types that can be converted into each other.
+type Family int
+
+const (
+	// FamilyNone is used for measurement types that can not be converted.
+	FamilyNone Family = iota
+	// FamilyVolume measurements are converted through teaspoons.
+	FamilyVolume
+	// FamilyWeight measurements are converted through grams.
+	FamilyWeight
+)
+
+var yieldRegex = regexp.MustCompile(`\d+(\.\d+)?`)
+
+// MillilitersPerTeaspoon is the size of a US teaspoon.
+const MillilitersPerTeaspoon = 4.92892
+
+type unit struct {
+	family Family
+	// size is the size of the unit in the base unit of its family.
+	size     float64
+	singular string
+	plural   string
+}
+
+var units = map[pb.Recipe_MeasurementType]unit{
+	pb.Recipe_MEASUREMENT_TYPE_TEASPOON:   {family: FamilyVolume, size: 1, singular: "tsp", plural: "tsp"},
+	pb.Recipe_MEASUREMENT_TYPE_TABLESPOON: {family: FamilyVolume, size: 3, singular: "tbsp", plural: "tbsp"},
+	pb.Recipe_MEASUREMENT_TYPE_CUP:        {family: FamilyVolume, size: 48, singular: "cup", plural: "cups"},
+	pb.Recipe_MEASUREMENT_TYPE_MILLILITER: {family: FamilyVolume, size: 1 / MillilitersPerTeaspoon, singular: "ml", plural: "ml"},
+	pb.Recipe_MEASUREMENT_TYPE_LITER:      {family: FamilyVolume, size: 1000 / MillilitersPerTeaspoon, singular: "l", plural: "l"},
+	pb.Recipe_MEASUREMENT_TYPE_GRAM:       {family: FamilyWeight, size: 1, singular: "g", plural: "g"},
+	pb.Recipe_MEASUREMENT_TYPE_OUNCE:      {family: FamilyWeight, size: 28.3495, singular: "oz", plural: "oz"},
+	pb.Recipe_MEASUREMENT_TYPE_POUND:      {family: FamilyWeight, size: 453.592, singular: "lb", plural: "lb"},
+}
+
+// unitNames maps the names a unit may be written as to its measurement type.
+var unitNames = map[string]pb.Recipe_MeasurementType{
+	"teaspoon": pb.Recipe_MEASUREMENT_TYPE_TEASPOON, "teaspoons": pb.Recipe_MEASUREMENT_TYPE_TEASPOON,
+	"tablespoon": pb.Recipe_MEASUREMENT_TYPE_TABLESPOON, "tablespoons": pb.Recipe_MEASUREMENT_TYPE_TABLESPOON,
+	"gram": pb.Recipe_MEASUREMENT_TYPE_GRAM, "grams": pb.Recipe_MEASUREMENT_TYPE_GRAM,
+	"ounce": pb.Recipe_MEASUREMENT_TYPE_OUNCE, "ounces": pb.Recipe_MEASUREMENT_TYPE_OUNCE,
+	"pound": pb.Recipe_MEASUREMENT_TYPE_POUND, "pounds": pb.Recipe_MEASUREMENT_TYPE_POUND, "lbs": pb.Recipe_MEASUREMENT_TYPE_POUND,
+	"milliliter": pb.Recipe_MEASUREMENT_TYPE_MILLILITER, "milliliters": pb.Recipe_MEASUREMENT_TYPE_MILLILITER,
+	"liter": pb.Recipe_MEASUREMENT_TYPE_LITER, "liters": pb.Recipe_MEASUREMENT_TYPE_LITER,
+}
+
+func init() {
+	for measurementType, u := range units {
+		unitNames[u.singular] = measurementType
+		unitNames[u.plural] = measurementType
+	}
+}
+
+// FamilyOf returns the family of the measurement type.
+func FamilyOf(measurementType pb.Recipe_MeasurementType) Family {
+	return units[measurementType].family
+}
+
+// ToBase converts an amount to the base unit of its family (teaspoons or grams).
+func ToBase(amount float64, measurementType pb.Recipe_MeasurementType) float64 {
+	u, ok := units[measurementType]
+	if !ok {
+		return amount
+	}
+	return amount * u.size
+}
+
+// FromBase converts an amount in the base unit of a family to the measurement type.
+func FromBase(amount float64, measurementType pb.Recipe_MeasurementType) float64 {
+	u, ok := units[measurementType]
+	if !ok {
+		return amount
+	}
+	return amount / u.size
+}
+
+// ToGrams converts an amount to grams. Volumes are converted using the density
+// in grams per milliliter; false is returned when that is not possible.
+func ToGrams(amount float64, measurementType pb.Recipe_MeasurementType, density *float64) (float64, bool) {
+	switch FamilyOf(measurementType) {
+	case FamilyWeight:
+		return ToBase(amount, measurementType), true
+	case FamilyVolume:
+		if density == nil || *density <= 0 {
+			return 0, false
+		}
+		return ToBase(amount, measurementType) * MillilitersPerTeaspoon * *density, true
+	}
+	return 0, false
+}
+
+// BestUnit picks the unit to display a base amount in. It prefers the largest
+// of the candidate units that expresses the amount in whole quarters, and
+// falls back to the smallest candidate. For example 18 tsp with the
+// candidates tablespoon and cup is displayed as 6 tbsp rather than 0.375 cups.
+func BestUnit(baseAmount float64, candidates []pb.Recipe_MeasurementType) pb.Recipe_MeasurementType {
+	best := pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED
+	smallest := pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED
+	for _, candidate := range candidates {
+		u, ok := units[candidate]
+		if !ok {
+			continue
+		}
+		if smallest == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED || u.size < units[smallest].size {
+			smallest = candidate
+		}
+		amount := baseAmount / u.size
+		quarters := amount * 4
+		if amount < 0.25 || math.Abs(quarters-math.Round(quarters)) > 1e-6 {
+			continue
+		}
+		if best == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED || u.size > units[best].size {
+			best = candidate
+		}
+	}
+	if best == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
+		return smallest
+	}
+	return best
+}
+
+// Format formats an amount and measurement type for display, e.g. "1.5 cups".
+func Format(amount float64, measurementType pb.Recipe_MeasurementType) string {
+	formatted := FormatAmount(amount)
+	u, ok := units[measurementType]
+	if !ok {
+		return formatted
+	}
+	if amount > 1 {
+		return formatted + " " + u.plural
+	}
+	return formatted + " " + u.singular
+}
+
+// FormatAmount formats an amount with at most two decimals.
+func FormatAmount(amount float64) string {
+	return strconv.FormatFloat(math.Round(amount*100)/100, 'f', -1, 64)
+}
+
+// ParseYield returns the first number in a recipe yield such as "Serves 4" or
+// "12 cookies". False is returned when there is no positive number.
+func ParseYield(yieldAmount string) (float64, bool) {
+	match := yieldRegex.FindString(yieldAmount)
+	if match == "" {
+		return 0, false
+	}
+	yield, err := strconv.ParseFloat(match, 64)
+	if err != nil || yield <= 0 {
+		return 0, false
+	}
+	return yield, true
+}
+
+// ParseQuantity splits a leading amount and unit off a title such as
+// "1.5 cups flour", the inverse of Format. The measurement type is unspecified
+// when the amount is a count. False is returned when the title does not start
+// with a positive amount.
+func ParseQuantity(title string) (amount float64, measurementType pb.Recipe_MeasurementType, rest string, ok bool) {
+	fields := strings.Fields(title)
+	if len(fields) == 0 {
+		return 0, pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED, title, false
+	}
+
+	amount, ok = parseAmount(fields[0])
+	if !ok || amount <= 0 {
+		return 0, pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED, title, false
+	}
+	fields = fields[1:]
+
+	if len(fields) > 0 {
+		if measurementType, ok = unitNames[strings.ToLower(strings.TrimSuffix(fields[0], "."))]; ok {
+			fields = fields[1:]
+		}
+	}
+
+	return amount, measurementType, strings.Join(fields, " "), true
+}
+
+// parseAmount parses a decimal or a simple fraction such as "1/2".
+func parseAmount(s string) (float64, bool) {
+	// strconv also accepts words such as "nan" and "inf"
+	if s == "" || (s[0] < '0' || s[0] > '9') && s[0] != '.' {
+		return 0, false
+	}
+	if numerator, denominator, isFraction := strings.Cut(s, "/"); isFraction {
+		n, err := strconv.ParseFloat(numerator, 64)
+		if err != nil {
+			return 0, false
+		}
+		d, err := strconv.ParseFloat(denominator, 64)
+		if err != nil || d == 0 {
+			return 0, false
+		}
+		return n / d, true
+	}
+	amount, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return amount, true
+}
@@ -13,6 +13,7 @@ const (
 	CircleField_Description = "description"
 	CircleField_Handle      = "handle"
 	CircleField_ImageURI    = "image_uri"
+	CircleField_ImageSet    = "image_set"
 	CircleField_Visibility  = "visibility"
 	CircleField_Favorited   = "favorited"
 
@@ -27,6 +28,7 @@ type Circle struct {
 	Description     string
 	Handle          string
 	ImageURI        string
+	ImageSet        ImageSet
 	VisibilityLevel types.VisibilityLevel
 	Favorited       bool
 	CircleAccess    CircleAccess
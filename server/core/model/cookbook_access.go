@@ -0,0 +1,87 @@
+package model
+
+import (
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+var _ Access = CookbookAccess{}
+
+const (
+	CookbookAccessField_Parent          = "parent"
+	CookbookAccessField_Id              = "id"
+	CookbookAccessField_PermissionLevel = "permission_level"
+	CookbookAccessField_State           = "state"
+	CookbookAccessField_AcceptTarget    = "accept_target"
+	CookbookAccessField_Requester       = "requester"
+	CookbookAccessField_Recipient       = "recipient"
+)
+
+// CookbookAccess represents a user's or circle's access to a cookbook
+type CookbookAccess struct {
+	CookbookAccessParent
+	CookbookAccessId
+	PermissionLevel types.PermissionLevel
+	State           types.AccessState
+	AcceptTarget    types.AcceptTarget
+
+	Requester CookbookRecipientOrRequester
+
+	Recipient             CookbookRecipientOrRequester
+	RecipientUsername     string // username of the recipient (if user)
+	RecipientGivenName    string // given name of the recipient (if user)
+	RecipientFamilyName   string // family name of the recipient (if user)
+	RecipientCircleTitle  string // title of the recipient (if circle)
+	RecipientCircleHandle string // handle of the recipient (if circle)
+}
+
+// GetAccessId - returns the access id of the cookbook access.
+func (p CookbookAccess) GetAccessId() int64 {
+	return p.CookbookAccessId.CookbookAccessId
+}
+
+// GetPermissionLevel - returns the permission level of the cookbook access.
+func (p CookbookAccess) GetPermissionLevel() types.PermissionLevel {
+	return p.PermissionLevel
+}
+
+// GetAcceptTarget - returns the accept target of the cookbook access.
+func (p CookbookAccess) GetAcceptTarget() types.AcceptTarget {
+	return p.AcceptTarget
+}
+
+// GetAccessState - returns the access state of the cookbook access.
+func (p CookbookAccess) GetAccessState() types.AccessState {
+	return p.State
+}
+
+// GetRecipientCircleId - returns the circle id of the recipient.
+func (p CookbookAccess) GetRecipientCircleId() CircleId {
+	return CircleId{CircleId: p.Recipient.CircleId}
+}
+
+// GetRecipientUserId - returns the user id of the recipient.
+func (p CookbookAccess) GetRecipientUserId() UserId {
+	return UserId{UserId: p.Recipient.UserId}
+}
+
+// SetPermissionLevel - sets the permission level of the cookbook access. Because this method is
+// uses a value receiver, it returns a new instance of the CookbookAccess struct and the caller
+// must assign the result to a variable.
+func (p CookbookAccess) SetPermissionLevel(permissionLevel types.PermissionLevel) Access {
+	p.PermissionLevel = permissionLevel
+	return p
+}
+
+type CookbookRecipientOrRequester struct {
+	UserId   int64 `aip_pattern:"key=user"`
+	CircleId int64 `aip_pattern:"key=circle"`
+}
+
+type CookbookAccessParent struct {
+	CircleId int64 `aip_pattern:"key=circle"`
+	CookbookId
+}
+
+type CookbookAccessId struct {
+	CookbookAccessId int64 `aip_pattern:"key=access"`
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = CookbookEntryId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// CookbookEntryFields defines the cookbook entry fields.
+const (
+	CookbookEntryField_Parent     = "parent"
+	CookbookEntryField_Id         = "id"
+	CookbookEntryField_RecipeId   = "recipe_id"
+	CookbookEntryField_Note       = "note"
+	CookbookEntryField_Position   = "position"
+	CookbookEntryField_CreateTime = "create_time"
+)
+
+// CookbookEntry defines a recipe in a cookbook.
+type CookbookEntry struct {
+	Parent   CookbookEntryParent
+	Id       CookbookEntryId
+	RecipeId RecipeId
+	Note     string
+	// Position is the place of the entry in the cookbook, starting at 0.
+	Position   int32
+	CreateTime time.Time
+
+	// Accessible is whether the caller can read the recipe. Recipe only holds
+	// the recipe details when it is.
+	Accessible bool
+	Recipe     Recipe
+}
+
+// CookbookEntryParent defines the parent of a cookbook entry.
+type CookbookEntryParent struct {
+	CookbookId CookbookId
+}
+
+// CookbookEntryId defines the ID for a cookbook entry.
+type CookbookEntryId struct {
+	CookbookEntryId int64 `aip_pattern:"key=cookbook_entry"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (c CookbookEntryId) isResourceId() {
+}
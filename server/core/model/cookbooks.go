@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+var _ ResourceId = CookbookId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// CookbookFields defines the cookbook fields.
+const (
+	CookbookField_Parent          = "parent"
+	CookbookField_Id              = "id"
+	CookbookField_Title           = "title"
+	CookbookField_Description     = "description"
+	CookbookField_VisibilityLevel = "visibility_level"
+	CookbookField_CreateTime      = "create_time"
+	CookbookField_UpdateTime      = "update_time"
+
+	CookbookField_CookbookAccess = "cookbook_access"
+)
+
+// Cookbook defines the model for a cookbook, a named and ordered collection of
+// recipes kept by a user or circle.
+type Cookbook struct {
+	Id              CookbookId
+	Parent          CookbookParent
+	Title           string
+	Description     string
+	VisibilityLevel types.VisibilityLevel
+	CreateTime      time.Time
+	UpdateTime      time.Time
+
+	// The access details for the current user/circle
+	CookbookAccess CookbookAccess
+}
+
+// CookbookId defines the name for a cookbook.
+type CookbookId struct {
+	CookbookId int64 `aip_pattern:"key=cookbook"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (p CookbookId) isResourceId() {
+}
+
+// CookbookParent defines the name for a cookbook parent.
+// Supports both circle and user parents for cookbooks.
+type CookbookParent struct {
+	CircleId int64 `aip_pattern:"key=circle"`
+	UserId   int64 `aip_pattern:"key=user"`
+}
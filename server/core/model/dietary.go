@@ -0,0 +1,53 @@
+package model
+
+// Diets a recipe can be suitable for.
+const (
+	Diet_Vegetarian = "vegetarian"
+	Diet_Vegan      = "vegan"
+	Diet_GlutenFree = "gluten_free"
+	Diet_DairyFree  = "dairy_free"
+)
+
+// Diets are all the diets a recipe can be suitable for.
+var Diets = []string{Diet_Vegetarian, Diet_Vegan, Diet_GlutenFree, Diet_DairyFree}
+
+// Allergens a recipe can contain, the 14 major food allergens.
+const (
+	Allergen_Peanut    = "peanut"
+	Allergen_TreeNut   = "tree_nut"
+	Allergen_Dairy     = "dairy"
+	Allergen_Egg       = "egg"
+	Allergen_Soy       = "soy"
+	Allergen_Wheat     = "wheat"
+	Allergen_Shellfish = "shellfish"
+	Allergen_Mollusc   = "mollusc"
+	Allergen_Fish      = "fish"
+	Allergen_Sesame    = "sesame"
+	Allergen_Celery    = "celery"
+	Allergen_Mustard   = "mustard"
+	Allergen_Lupin     = "lupin"
+	Allergen_Sulphite  = "sulphite"
+)
+
+// Allergens are all the allergens a recipe can contain.
+var Allergens = []string{
+	Allergen_Peanut, Allergen_TreeNut, Allergen_Dairy, Allergen_Egg, Allergen_Soy,
+	Allergen_Wheat, Allergen_Shellfish, Allergen_Mollusc, Allergen_Fish, Allergen_Sesame,
+	Allergen_Celery, Allergen_Mustard, Allergen_Lupin, Allergen_Sulphite,
+}
+
+// DietaryOverrides are manual corrections to the diets and allergens derived
+// from the ingredients of a recipe.
+type DietaryOverrides struct {
+	AddedDiets       []string
+	RemovedDiets     []string
+	AddedAllergens   []string
+	RemovedAllergens []string
+}
+
+// DietaryRestrictions are the diets a user follows and the allergens they
+// avoid.
+type DietaryRestrictions struct {
+	Diets     []string
+	Allergens []string
+}
@@ -10,6 +10,7 @@ const (
 	EventRecipeField_EventRecipeId = "id"
 	EventRecipeField_RecipeId      = "recipe_id"
 	EventRecipeField_CreateTime    = "create_time"
+	EventRecipeField_DoneTime      = "done_time"
 )
 
 // EventRecipe represents a connection between a recipe and an event.
@@ -22,6 +23,9 @@ type EventRecipe struct {
 	RecipeId RecipeId
 	// CreateTime is the time the event recipe was created
 	CreateTime time.Time
+	// DoneTime is the time the recipe of the event was marked as done, nil
+	// when it is not done
+	DoneTime *time.Time
 }
 
 type EventRecipeId struct {
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+)
+
+// GroceryListRequest defines what to generate a grocery list from.
+type GroceryListRequest struct {
+	// ListId is the list to add the grocery items to. NewList is created
+	// when it is not set.
+	ListId  ListId
+	NewList List
+
+	// Recipes are the recipes to shop for.
+	Recipes []GroceryListRecipe
+
+	// CalendarId, StartTime and EndTime select the meal-plan events whose
+	// recipes are shopped for.
+	CalendarId CalendarId
+	StartTime  time.Time
+	EndTime    time.Time
+
+	// IncludeOptional includes optional recipe ingredients.
+	IncludeOptional bool
+}
+
+// GroceryListRecipe defines a recipe to shop for.
+type GroceryListRecipe struct {
+	RecipeId RecipeId
+	// Servings scales the recipe to the number of servings. The recipe is
+	// used as written when it is not set.
+	Servings float64
+}
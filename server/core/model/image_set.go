@@ -0,0 +1,63 @@
+package model
+
+// ImageSize defines the size of an image variant.
+type ImageSize string
+
+// ImageSizes defines the image variant sizes.
+const (
+	ImageSize_Thumbnail ImageSize = "thumbnail"
+	ImageSize_Medium    ImageSize = "medium"
+	ImageSize_Large     ImageSize = "large"
+)
+
+// ImageSet defines the resized and re-encoded variants of an uploaded image,
+// so clients can download the smallest image that fits.
+type ImageSet struct {
+	// Placeholder is the BlurHash of the image, shown while a variant loads.
+	Placeholder string
+	// Width and Height are the dimensions of the uploaded image.
+	Width    int32
+	Height   int32
+	Variants []ImageVariant
+}
+
+// ImageVariant defines one stored variant of an image.
+type ImageVariant struct {
+	Size        ImageSize
+	ContentType string
+	Width       int32
+	Height      int32
+	URI         string
+}
+
+// URIs returns the URIs of the stored variants of the image set.
+func (s ImageSet) URIs() []string {
+	uris := make([]string, 0, len(s.Variants))
+	for _, variant := range s.Variants {
+		uris = append(uris, variant.URI)
+	}
+	return uris
+}
+
+// ImageSetOwnerKind defines the kind of resource an image set belongs to.
+type ImageSetOwnerKind string
+
+// ImageSetOwnerKinds defines the resources that store image sets.
+const (
+	ImageSetOwnerKind_Recipe      ImageSetOwnerKind = "recipe"
+	ImageSetOwnerKind_RecipeImage ImageSetOwnerKind = "recipe_image"
+	ImageSetOwnerKind_User        ImageSetOwnerKind = "user"
+	ImageSetOwnerKind_Circle      ImageSetOwnerKind = "circle"
+)
+
+// ImageSetOwner defines a stored image and its variants, independent of the
+// resource it belongs to. It is used to backfill the variants of images
+// uploaded before they were generated.
+type ImageSetOwner struct {
+	Kind ImageSetOwnerKind
+	Id   int64
+	// ParentId is the recipe of a recipe image.
+	ParentId int64
+	ImageURI string
+	ImageSet ImageSet
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = IngredientId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// IngredientFields defines the ingredient fields.
+const (
+	IngredientField_Id         = "id"
+	IngredientField_Title      = "title"
+	IngredientField_Aliases    = "aliases"
+	IngredientField_Category   = "category"
+	IngredientField_Density    = "density"
+	IngredientField_CreatorId  = "creator_id"
+	IngredientField_CreateTime = "create_time"
+	IngredientField_UpdateTime = "update_time"
+)
+
+// Ingredient defines the model for a canonical ingredient.
+type Ingredient struct {
+	Id       IngredientId
+	Title    string
+	Aliases  []string
+	Category string
+	// Density is in grams per milliliter. Nil when unknown.
+	Density *float64
+	// CreatorId is the user whose recipe added the ingredient when it matched
+	// nothing in the catalog. Such ingredients are only visible to their
+	// creator until they are curated into the shared catalog by clearing the
+	// creator.
+	CreatorId  UserId
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// IngredientId defines the name for an ingredient.
+type IngredientId struct {
+	IngredientId int64 `aip_pattern:"key=ingredient"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (i IngredientId) isResourceId() {
+}
@@ -1,7 +1,10 @@
 package model
 
 import (
+	"fmt"
 	"time"
+
+	"github.com/teambition/rrule-go"
 )
 
 // ----------------------------------------------------------------------------
@@ -9,14 +12,21 @@ import (
 
 // ListItemFields defines the list item fields.
 const (
-	ListItemField_Parent         = "parent"
-	ListItemField_Id             = "id"
-	ListItemField_Title          = "title"
-	ListItemField_Points         = "points"
-	ListItemField_RecurrenceRule = "recurrence_rule"
-	ListItemField_ListSectionId  = "list_section_id"
-	ListItemField_CreateTime     = "create_time"
-	ListItemField_UpdateTime     = "update_time"
+	ListItemField_Parent           = "parent"
+	ListItemField_Id               = "id"
+	ListItemField_Title            = "title"
+	ListItemField_Points           = "points"
+	ListItemField_RecurrenceRule   = "recurrence_rule"
+	ListItemField_ListSectionId    = "list_section_id"
+	ListItemField_SourceRecipes    = "source_recipes"
+	ListItemField_CreateTime       = "create_time"
+	ListItemField_UpdateTime       = "update_time"
+	ListItemField_Completed        = "completed"
+	ListItemField_CompletionWindow = "completion_window"
+	ListItemField_NextDueTime      = "next_due_time"
+	ListItemField_IsOverdue        = "is_overdue"
+	ListItemField_Assignees        = "assignees"
+	ListItemField_ClaimedBy        = "claimed_by"
 )
 
 // ListItem defines the model for a list item.
@@ -27,8 +37,30 @@ type ListItem struct {
 	Points         int32
 	RecurrenceRule string
 	ListSectionId  int64 `aip_pattern:"key=list_section"`
-	CreateTime     time.Time
-	UpdateTime     time.Time
+	// SourceRecipeIds are the recipes a generated grocery item came from.
+	SourceRecipeIds []RecipeId
+	CreateTime      time.Time
+	UpdateTime      time.Time
+	// CompletionWindow is how long before each due time the item can be
+	// completed. When zero, the window is the whole time since the previous
+	// due time.
+	CompletionWindow time.Duration
+	// NextDueTime is the first due time the item has not been completed for.
+	// It is only set for recurring items and is kept up to date as the item
+	// and its completions change.
+	NextDueTime *time.Time
+	// Completed is whether the item has a completion in its current
+	// recurrence period. It is computed and never stored.
+	Completed bool
+	// IsOverdue is whether the next due time has passed. It is computed and
+	// never stored.
+	IsOverdue bool
+	// AssigneeIds are the members of the circles of the list the item is
+	// assigned to.
+	AssigneeIds []UserId
+	// ClaimedById is the member who has taken the item on, if any. Members
+	// can only claim items for themselves.
+	ClaimedById UserId
 }
 
 // ListItemId defines the ID for a list item.
@@ -39,3 +71,212 @@ type ListItemId struct {
 type ListItemParent struct {
 	ListId ListId
 }
+
+// ValidateRecurrenceRule checks the recurrence rule of the list item can be
+// parsed and does not recur more often than hourly.
+func (l ListItem) ValidateRecurrenceRule() error {
+	rule, err := l.recurrence()
+	if err != nil || rule == nil {
+		return err
+	}
+
+	if rule.GetRRule().OrigOptions.Freq > rrule.HOURLY {
+		return fmt.Errorf("recurrence rule cannot recur more often than hourly")
+	}
+
+	return nil
+}
+
+// GetNextDueTime gets the first due time the list item was not completed for,
+// given the time it was last completed, which is zero if it never was. A
+// completion counts for every due time whose completion window opened before
+// it. It returns nil when the list item does not recur or has no due times
+// left.
+func (l ListItem) GetNextDueTime(lastCompleteTime time.Time) (*time.Time, error) {
+	// the due times are looked up from the first one the last completion may
+	// not count for
+	from := l.CreateTime
+	switch {
+	case lastCompleteTime.IsZero():
+	case l.CompletionWindow > 0:
+		from = laterTime(lastCompleteTime.Add(l.CompletionWindow), l.CreateTime)
+	default:
+		from = lastCompleteTime
+	}
+
+	dueTimes, err := l.dueTimesFrom(from)
+	if err != nil || dueTimes == nil {
+		return nil, err
+	}
+
+	var due time.Time
+	switch {
+	case lastCompleteTime.IsZero():
+		due = dueTimes.after(l.CreateTime, false)
+	case l.CompletionWindow > 0:
+		// the window of a due time opens a fixed time before it
+		due = dueTimes.after(from, from.After(l.CreateTime))
+	default:
+		// the window of a due time opens at the due time before it
+		previous := dueTimes.after(lastCompleteTime, true)
+		if previous.IsZero() {
+			return nil, nil
+		}
+		due = dueTimes.after(laterTime(previous, l.CreateTime), false)
+	}
+
+	if due.IsZero() {
+		return nil, nil
+	}
+	return &due, nil
+}
+
+// IsCompleted reports whether the list item has been completed in its current
+// period, given the time it was last completed. The current period is the one
+// whose completion window is open, or the last one when no window is open.
+// A list item that does not recur is completed once it has any completion.
+func (l ListItem) IsCompleted(lastCompleteTime time.Time, now time.Time) (bool, error) {
+	if lastCompleteTime.IsZero() {
+		return false, nil
+	}
+
+	dueTimes, err := l.dueTimesFrom(laterTime(now, l.CreateTime))
+	if err != nil || dueTimes == nil {
+		return err == nil, err
+	}
+
+	// the due times on either side of now, after the item was created
+	var nextDue time.Time
+	if now.After(l.CreateTime) {
+		nextDue = dueTimes.after(now, true)
+	} else {
+		nextDue = dueTimes.after(l.CreateTime, false)
+	}
+	lastDue := dueTimes.before(now)
+	if !lastDue.After(l.CreateTime) {
+		lastDue = time.Time{}
+	}
+
+	var currentWindowStart time.Time
+	switch {
+	case !nextDue.IsZero() && (lastDue.IsZero() || !dueTimes.completionWindowStart(nextDue).After(now)):
+		currentWindowStart = dueTimes.completionWindowStart(nextDue)
+	case !lastDue.IsZero():
+		currentWindowStart = dueTimes.completionWindowStart(lastDue)
+	default:
+		return false, nil
+	}
+
+	return lastCompleteTime.After(currentWindowStart), nil
+}
+
+// recurrence parses the recurrence rule of the list item, starting on the day
+// it was created (UTC). It returns nil when the list item does not recur.
+func (l ListItem) recurrence() (*rrule.Set, error) {
+	if l.RecurrenceRule == "" {
+		return nil, nil
+	}
+
+	rule, err := rrule.StrToRRuleSet("RRULE:" + l.RecurrenceRule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse recurrence rule: %w", err)
+	}
+
+	rule.DTStart(l.recurrenceStart())
+
+	return rule, nil
+}
+
+// recurrenceStart is the start of the day the list item was created (UTC).
+func (l ListItem) recurrenceStart() time.Time {
+	createTime := l.CreateTime.UTC()
+	return time.Date(createTime.Year(), createTime.Month(), createTime.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// listItemDueTimes looks up the due times of a recurring list item. The rule
+// walks through every due time from its start for each lookup, so lookups
+// use a copy of the rule started shortly before the time they are made
+// around, and only go back to the start of the item for due times before it.
+type listItemDueTimes struct {
+	listItem ListItem
+	near     *rrule.Set
+	all      *rrule.Set
+}
+
+// dueTimesFrom gets the due times of the list item for lookups at or after
+// the given time. It returns nil when the list item does not recur.
+func (l ListItem) dueTimesFrom(from time.Time) (*listItemDueTimes, error) {
+	rule, err := l.recurrence()
+	if err != nil || rule == nil {
+		return nil, err
+	}
+
+	dueTimes := &listItemDueTimes{listItem: l, near: rule, all: rule}
+
+	// The due times are only the same from a later start when it is a whole
+	// number of periods later and the rule does not count its due times.
+	// Rules that recur monthly or yearly have few enough due times to walk.
+	options := rule.GetRRule().OrigOptions
+	var period time.Duration
+	switch options.Freq {
+	case rrule.HOURLY:
+		period = time.Hour
+	case rrule.DAILY:
+		period = 24 * time.Hour
+	case rrule.WEEKLY:
+		period = 7 * 24 * time.Hour
+	}
+	if period == 0 || options.Count > 0 {
+		return dueTimes, nil
+	}
+	period *= time.Duration(max(options.Interval, 1))
+
+	// start at least a period before the time, so the due time before it
+	// is usually found without going back to the start
+	start := l.recurrenceStart()
+	periods := from.Sub(start)/period - 1
+	if periods <= 0 {
+		return dueTimes, nil
+	}
+
+	dueTimes.near, err = l.recurrence()
+	if err != nil {
+		return nil, err
+	}
+	dueTimes.near.DTStart(start.Add(periods * period))
+
+	return dueTimes, nil
+}
+
+// after gets the first due time after t, or at t when inc is set. t must not
+// be before the time the due times were got for.
+func (d *listItemDueTimes) after(t time.Time, inc bool) time.Time {
+	return d.near.After(t, inc)
+}
+
+// before gets the last due time before t, or zero if there is none.
+func (d *listItemDueTimes) before(t time.Time) time.Time {
+	due := d.near.Before(t, false)
+	if due.IsZero() && d.near != d.all {
+		due = d.all.Before(t, false)
+	}
+	return due
+}
+
+// completionWindowStart gets the time the list item can be completed from for
+// a due time. Without a completion window it is the due time before it, or
+// zero for the first due time.
+func (d *listItemDueTimes) completionWindowStart(due time.Time) time.Time {
+	if d.listItem.CompletionWindow > 0 {
+		return due.Add(-d.listItem.CompletionWindow)
+	}
+	return d.before(due)
+}
+
+// laterTime returns the later of two times.
+func laterTime(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
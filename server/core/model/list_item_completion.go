@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = ListItemCompletionId{}
+
+// ----------------------------------------------------------------------------
+// ListItemCompletion Fields
+
+// ListItemCompletionFields defines the list item completion fields.
+const (
+	ListItemCompletionField_Parent              = "parent"
+	ListItemCompletionField_Id                  = "id"
+	ListItemCompletionField_Title               = "title"
+	ListItemCompletionField_Points              = "points"
+	ListItemCompletionField_ListSectionId       = "list_section_id"
+	ListItemCompletionField_UserId              = "user_id"
+	ListItemCompletionField_CircleId            = "circle_id"
+	ListItemCompletionField_CompleteTime        = "complete_time"
+	ListItemCompletionField_CompletedByAssignee = "completed_by_assignee"
+	ListItemCompletionField_CreateTime          = "create_time"
+	ListItemCompletionField_UpdateTime          = "update_time"
+)
+
+// ListItemCompletion defines the model for a list item being checked off. The
+// title, points and section are copied from the list item when it is
+// completed so later edits to the item do not rewrite history.
+type ListItemCompletion struct {
+	Parent        ListItemCompletionParent
+	Id            ListItemCompletionId
+	Title         string
+	Points        int32
+	ListSectionId int64 `aip_pattern:"key=list_section"`
+	// UserId is the user who completed the list item.
+	UserId UserId
+	// CircleId is the circle the user was acting as, if any.
+	CircleId     CircleId
+	CompleteTime time.Time
+	// CompletedByAssignee is whether the user was assigned the list item
+	// when they completed it.
+	CompletedByAssignee bool
+	CreateTime          time.Time
+	UpdateTime          time.Time
+}
+
+// ListItemCompletionParent defines the parent of a list item completion.
+type ListItemCompletionParent struct {
+	ListId     ListId
+	ListItemId ListItemId
+}
+
+// ListItemCompletionId defines the ID for a list item completion.
+type ListItemCompletionId struct {
+	ListItemCompletionId int64 `aip_pattern:"key=list_item_completion"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (l ListItemCompletionId) isResourceId() {
+}
@@ -0,0 +1,160 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// listItemCreateTime is a Wednesday, so a weekly Sunday chore is first due on
+// 2025-08-10.
+var listItemCreateTime = time.Date(2025, time.August, 6, 10, 0, 0, 0, time.UTC)
+
+func TestListItem_GetNextDueTime_NotRecurring(t *testing.T) {
+	listItem := model.ListItem{CreateTime: listItemCreateTime}
+
+	nextDueTime, err := listItem.GetNextDueTime(time.Time{})
+	if err != nil {
+		t.Fatalf("failed to get next due time: %v", err)
+	}
+
+	if nextDueTime != nil {
+		t.Fatalf("expected no next due time, got %v", *nextDueTime)
+	}
+}
+
+func TestListItem_GetNextDueTime_Weekly(t *testing.T) {
+	listItem := model.ListItem{
+		CreateTime:     listItemCreateTime,
+		RecurrenceRule: "FREQ=WEEKLY;BYDAY=SU",
+	}
+
+	tests := []struct {
+		name             string
+		lastCompleteTime time.Time
+		expected         time.Time
+	}{
+		{"never completed", time.Time{}, time.Date(2025, time.August, 10, 0, 0, 0, 0, time.UTC)},
+		{"completed before the first due time", time.Date(2025, time.August, 7, 9, 0, 0, 0, time.UTC), time.Date(2025, time.August, 17, 0, 0, 0, 0, time.UTC)},
+		{"completed after missing a due time", time.Date(2025, time.August, 12, 9, 0, 0, 0, time.UTC), time.Date(2025, time.August, 24, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nextDueTime, err := listItem.GetNextDueTime(test.lastCompleteTime)
+			if err != nil {
+				t.Fatalf("failed to get next due time: %v", err)
+			}
+
+			if nextDueTime == nil || !nextDueTime.Equal(test.expected) {
+				t.Fatalf("expected next due time %v, got %v", test.expected, nextDueTime)
+			}
+		})
+	}
+}
+
+func TestListItem_GetNextDueTime_CompletionWindow(t *testing.T) {
+	listItem := model.ListItem{
+		CreateTime:       listItemCreateTime,
+		RecurrenceRule:   "FREQ=WEEKLY;BYDAY=SU",
+		CompletionWindow: 24 * time.Hour,
+	}
+
+	// completed on Thursday, before the window of the first due time opened
+	nextDueTime, err := listItem.GetNextDueTime(time.Date(2025, time.August, 7, 9, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("failed to get next due time: %v", err)
+	}
+
+	expected := time.Date(2025, time.August, 10, 0, 0, 0, 0, time.UTC)
+	if nextDueTime == nil || !nextDueTime.Equal(expected) {
+		t.Fatalf("expected next due time %v, got %v", expected, nextDueTime)
+	}
+}
+
+func TestListItem_LongRunning(t *testing.T) {
+	// an hourly chore created years ago still has its due times looked up
+	// around the last completion
+	listItem := model.ListItem{
+		CreateTime:     listItemCreateTime.AddDate(-20, 0, 0),
+		RecurrenceRule: "FREQ=HOURLY",
+	}
+	lastCompleteTime := listItemCreateTime.Add(30 * time.Minute)
+
+	nextDueTime, err := listItem.GetNextDueTime(lastCompleteTime)
+	if err != nil {
+		t.Fatalf("failed to get next due time: %v", err)
+	}
+	expected := listItemCreateTime.Add(2 * time.Hour)
+	if nextDueTime == nil || !nextDueTime.Equal(expected) {
+		t.Fatalf("expected next due time %v, got %v", expected, nextDueTime)
+	}
+
+	completed, err := listItem.IsCompleted(lastCompleteTime, listItemCreateTime.Add(45*time.Minute))
+	if err != nil {
+		t.Fatalf("failed to determine completion: %v", err)
+	}
+	if !completed {
+		t.Fatalf("expected completed true, got false")
+	}
+}
+
+func TestListItem_IsCompleted(t *testing.T) {
+	weekly := model.ListItem{
+		CreateTime:     listItemCreateTime,
+		RecurrenceRule: "FREQ=WEEKLY;BYDAY=SU",
+	}
+	windowed := weekly
+	windowed.CompletionWindow = 24 * time.Hour
+
+	tests := []struct {
+		name             string
+		listItem         model.ListItem
+		lastCompleteTime time.Time
+		now              time.Time
+		expected         bool
+	}{
+		{"not recurring and never completed", model.ListItem{CreateTime: listItemCreateTime}, time.Time{}, listItemCreateTime.Add(time.Hour), false},
+		{"not recurring and completed", model.ListItem{CreateTime: listItemCreateTime}, listItemCreateTime.Add(time.Hour), listItemCreateTime.Add(30 * 24 * time.Hour), true},
+		{"completed in the current period", weekly, time.Date(2025, time.August, 7, 9, 0, 0, 0, time.UTC), time.Date(2025, time.August, 8, 9, 0, 0, 0, time.UTC), true},
+		{"completed in the previous period", weekly, time.Date(2025, time.August, 7, 9, 0, 0, 0, time.UTC), time.Date(2025, time.August, 11, 9, 0, 0, 0, time.UTC), false},
+		{"completed in the last window", windowed, time.Date(2025, time.August, 9, 9, 0, 0, 0, time.UTC), time.Date(2025, time.August, 12, 9, 0, 0, 0, time.UTC), true},
+		{"completed before the window opened", windowed, time.Date(2025, time.August, 7, 9, 0, 0, 0, time.UTC), time.Date(2025, time.August, 9, 9, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			completed, err := test.listItem.IsCompleted(test.lastCompleteTime, test.now)
+			if err != nil {
+				t.Fatalf("failed to determine completion: %v", err)
+			}
+
+			if completed != test.expected {
+				t.Fatalf("expected completed %v, got %v", test.expected, completed)
+			}
+		})
+	}
+}
+
+func TestListItem_ValidateRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		recurrenceRule string
+		valid          bool
+	}{
+		{"", true},
+		{"FREQ=DAILY", true},
+		{"FREQ=WEEKLY;BYDAY=MO,TH", true},
+		{"FREQ=MINUTELY", false},
+		{"FREQ=SOMETIMES", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.recurrenceRule, func(t *testing.T) {
+			err := model.ListItem{CreateTime: listItemCreateTime, RecurrenceRule: test.recurrenceRule}.ValidateRecurrenceRule()
+			if (err == nil) != test.valid {
+				t.Fatalf("expected valid %v, got error %v", test.valid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = OperationId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// OperationFields defines the operation fields.
+const (
+	OperationField_Parent     = "parent"
+	OperationField_Id         = "id"
+	OperationField_Kind       = "kind"
+	OperationField_State      = "state"
+	OperationField_Request    = "request"
+	OperationField_Result     = "result"
+	OperationField_Error      = "error"
+	OperationField_CreateTime = "create_time"
+	OperationField_UpdateTime = "update_time"
+	OperationField_EndTime    = "end_time"
+	OperationField_ExpireTime = "expire_time"
+
+	OperationField_LeaseOwner      = "lease_owner"
+	OperationField_LeaseExpireTime = "lease_expire_time"
+)
+
+// OperationKind defines the work an operation does.
+type OperationKind string
+
+const (
+	OperationKind_ScrapeRecipe        OperationKind = "scrape_recipe"
+	OperationKind_OCRRecipe           OperationKind = "ocr_recipe"
+	OperationKind_GenerateRecipeImage OperationKind = "generate_recipe_image"
+	OperationKind_ImportRecipes       OperationKind = "import_recipes"
+)
+
+// OperationState defines the state of an operation.
+type OperationState int
+
+const (
+	OperationState_Pending OperationState = iota + 1
+	OperationState_Running
+	OperationState_Succeeded
+	OperationState_Failed
+	OperationState_Cancelled
+)
+
+// Done reports whether the operation has finished, one way or another.
+func (s OperationState) Done() bool {
+	return s == OperationState_Succeeded || s == OperationState_Failed || s == OperationState_Cancelled
+}
+
+// Operation defines a slow piece of work, like scraping a recipe, that runs
+// in the background on behalf of the user who started it. Operations are
+// stored so they survive restarts and are deleted once they expire.
+type Operation struct {
+	Parent OperationParent
+	Id     OperationId
+	Kind   OperationKind
+	State  OperationState
+	// Request holds everything the operation needs to run, so it can be
+	// picked up again after a restart.
+	Request OperationRequest
+	// Result is set when the operation succeeded.
+	Result *OperationResult
+	// Error is set when the operation failed.
+	Error      string
+	CreateTime time.Time
+	UpdateTime time.Time
+	EndTime    *time.Time
+	// ExpireTime is when a finished operation and its result are deleted.
+	ExpireTime *time.Time
+
+	// LeaseOwner is the process running the operation. The process renews
+	// its lease while it works; once LeaseExpireTime has passed the operation
+	// is requeued for another process.
+	LeaseOwner      string
+	LeaseExpireTime *time.Time
+}
+
+// OperationRequest defines the inputs of an operation.
+type OperationRequest struct {
+	// AuthAccount is the account the operation was started with.
+	AuthAccount AuthAccount
+	// RecipeParent is the collection duplicates are looked for in, or the
+	// parent of the recipe to generate images for.
+	RecipeParent RecipeParent
+	// RecipeId is the recipe to generate images for.
+	RecipeId RecipeId
+	// CandidateCount is the number of images to generate.
+	CandidateCount int32
+	// URI is the page to scrape.
+	URI string
+	// RecipeImportId is the recipe import whose report is filled in.
+	RecipeImportId RecipeImportId
+	// ImportSource is the recipe manager the import file was exported from,
+	// see recipeimport.Source.
+	ImportSource string
+	// FileURIs are the uploaded photos to read a recipe from, or the file to
+	// import recipes from. They are deleted once the operation has finished.
+	FileURIs []string
+}
+
+// OperationResult defines the outputs of an operation.
+type OperationResult struct {
+	// Recipe is the scraped or read recipe.
+	Recipe *Recipe
+	// Duplicates are the recipes the scraped recipe is likely a copy of.
+	Duplicates []RecipeDuplicate
+	// Candidates are the generated images. They are kept with the recipe
+	// rather than the operation.
+	Candidates []RecipeImageCandidate
+	// Generations are the quota charges of the candidates, refunded when the
+	// result is discarded. They are not stored with the operation.
+	Generations []RecipeImageGeneration `json:"-"`
+}
+
+// OperationParent defines the parent of an operation.
+type OperationParent struct {
+	UserId int64 `aip_pattern:"key=user"`
+}
+
+// OperationId defines the ID for an operation.
+type OperationId struct {
+	OperationId int64 `aip_pattern:"key=operation"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (o OperationId) isResourceId() {
+}
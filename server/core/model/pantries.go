@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+var _ ResourceId = PantryId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// PantryFields defines the pantry fields.
+const (
+	PantryField_Parent          = "parent"
+	PantryField_Id              = "id"
+	PantryField_Title           = "title"
+	PantryField_Description     = "description"
+	PantryField_VisibilityLevel = "visibility_level"
+	PantryField_CreateTime      = "create_time"
+	PantryField_UpdateTime      = "update_time"
+
+	PantryField_PantryAccess = "pantry_access"
+)
+
+// Pantry defines the model for a pantry, the food a user or circle has on hand.
+type Pantry struct {
+	Id              PantryId
+	Parent          PantryParent
+	Title           string
+	Description     string
+	VisibilityLevel types.VisibilityLevel
+	CreateTime      time.Time
+	UpdateTime      time.Time
+
+	// The access details for the current user/circle
+	PantryAccess PantryAccess
+}
+
+// PantryId defines the name for a pantry.
+type PantryId struct {
+	PantryId int64 `aip_pattern:"key=pantry"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (p PantryId) isResourceId() {
+}
+
+// PantryParent defines the name for a pantry parent.
+// Supports both circle and user parents for pantries.
+type PantryParent struct {
+	CircleId int64 `aip_pattern:"key=circle"`
+	UserId   int64 `aip_pattern:"key=user"`
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+)
+
+var _ Access = PantryAccess{}
+
+const (
+	PantryAccessField_Parent          = "parent"
+	PantryAccessField_Id              = "id"
+	PantryAccessField_PermissionLevel = "permission_level"
+	PantryAccessField_State           = "state"
+	PantryAccessField_AcceptTarget    = "accept_target"
+	PantryAccessField_Requester       = "requester"
+	PantryAccessField_Recipient       = "recipient"
+)
+
+// PantryAccess represents a user's or circle's access to a pantry
+type PantryAccess struct {
+	PantryAccessParent
+	PantryAccessId
+	PermissionLevel types.PermissionLevel
+	State           types.AccessState
+	AcceptTarget    types.AcceptTarget
+
+	Requester PantryRecipientOrRequester
+
+	Recipient             PantryRecipientOrRequester
+	RecipientUsername     string // username of the recipient (if user)
+	RecipientGivenName    string // given name of the recipient (if user)
+	RecipientFamilyName   string // family name of the recipient (if user)
+	RecipientCircleTitle  string // title of the recipient (if circle)
+	RecipientCircleHandle string // handle of the recipient (if circle)
+}
+
+// GetAccessId - returns the access id of the pantry access.
+func (p PantryAccess) GetAccessId() int64 {
+	return p.PantryAccessId.PantryAccessId
+}
+
+// GetPermissionLevel - returns the permission level of the pantry access.
+func (p PantryAccess) GetPermissionLevel() types.PermissionLevel {
+	return p.PermissionLevel
+}
+
+// GetAcceptTarget - returns the accept target of the pantry access.
+func (p PantryAccess) GetAcceptTarget() types.AcceptTarget {
+	return p.AcceptTarget
+}
+
+// GetAccessState - returns the access state of the pantry access.
+func (p PantryAccess) GetAccessState() types.AccessState {
+	return p.State
+}
+
+// GetRecipientCircleId - returns the circle id of the recipient.
+func (p PantryAccess) GetRecipientCircleId() CircleId {
+	return CircleId{CircleId: p.Recipient.CircleId}
+}
+
+// GetRecipientUserId - returns the user id of the recipient.
+func (p PantryAccess) GetRecipientUserId() UserId {
+	return UserId{UserId: p.Recipient.UserId}
+}
+
+// SetPermissionLevel - sets the permission level of the pantry access. Because this method is
+// uses a value receiver, it returns a new instance of the PantryAccess struct and the caller
+// must assign the result to a variable.
+func (p PantryAccess) SetPermissionLevel(permissionLevel types.PermissionLevel) Access {
+	p.PermissionLevel = permissionLevel
+	return p
+}
+
+type PantryRecipientOrRequester struct {
+	UserId   int64 `aip_pattern:"key=user"`
+	CircleId int64 `aip_pattern:"key=circle"`
+}
+
+type PantryAccessParent struct {
+	CircleId int64 `aip_pattern:"key=circle"`
+	PantryId
+}
+
+type PantryAccessId struct {
+	PantryAccessId int64 `aip_pattern:"key=access"`
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	pantryPb "github.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1"
+)
+
+// ----------------------------------------------------------------------------
+// PantryItem Fields
+
+// PantryItemFields defines the pantry item fields.
+const (
+	PantryItemField_Parent       = "parent"
+	PantryItemField_Id           = "id"
+	PantryItemField_Title        = "title"
+	PantryItemField_Quantity     = "quantity"
+	PantryItemField_Unit         = "unit"
+	PantryItemField_Location     = "location"
+	PantryItemField_PurchaseTime = "purchase_time"
+	PantryItemField_ExpireTime   = "expire_time"
+	PantryItemField_CreateTime   = "create_time"
+	PantryItemField_UpdateTime   = "update_time"
+)
+
+// PantryItem defines the model for an item stocked in a pantry.
+type PantryItem struct {
+	Parent   PantryItemParent
+	Id       PantryItemId
+	Title    string
+	Quantity float64
+	// Unit is unspecified when the quantity is a count.
+	Unit     pb.Recipe_MeasurementType
+	Location pantryPb.PantryItem_Location
+	// PurchaseTime and ExpireTime are zero when unknown.
+	PurchaseTime time.Time
+	ExpireTime   time.Time
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+// PantryItemId defines the ID for a pantry item.
+type PantryItemId struct {
+	PantryItemId int64 `aip_pattern:"key=pantry_item"`
+}
+
+type PantryItemParent struct {
+	PantryId PantryId
+}
+
+// ----------------------------------------------------------------------------
+// Consume and restock
+
+// PantryConsumption is the result of using up the ingredients of a recipe.
+type PantryConsumption struct {
+	// PantryItems are the items that were decremented.
+	PantryItems []PantryItem
+	// MissingIngredients are the titles of the recipe ingredients that were
+	// not found in the pantry.
+	MissingIngredients []string
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = RecipeCookLogId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// RecipeCookLogFields defines the recipe cook log fields.
+const (
+	RecipeCookLogField_Parent      = "parent"
+	RecipeCookLogField_Id          = "id"
+	RecipeCookLogField_CookId      = "cook_id"
+	RecipeCookLogField_CookTime    = "cook_time"
+	RecipeCookLogField_Rating      = "rating"
+	RecipeCookLogField_Notes       = "notes"
+	RecipeCookLogField_Photos      = "photos"
+	RecipeCookLogField_EventRecipe = "event_recipe"
+	RecipeCookLogField_CreateTime  = "create_time"
+	RecipeCookLogField_UpdateTime  = "update_time"
+)
+
+// RecipeCookLog defines a record of a user cooking a recipe.
+type RecipeCookLog struct {
+	Parent RecipeCookLogParent
+	Id     RecipeCookLogId
+	// CookId is the user who cooked the recipe.
+	CookId UserId
+	// CookTime is when the recipe was cooked.
+	CookTime time.Time
+	// Rating is the rating for this time from 1 to 5, 0 when not rated.
+	Rating int32
+	Notes  string
+	Photos []RecipeCookLogPhoto
+	// EventRecipe is the event recipe the cook log was created from, if any.
+	EventRecipe *RecipeCookLogEventRecipe
+	CreateTime  time.Time
+	UpdateTime  time.Time
+}
+
+// RecipeCookLogPhoto defines a photo of a cooked recipe.
+type RecipeCookLogPhoto struct {
+	ImageURI string
+	ImageSet ImageSet
+}
+
+// RecipeCookLogEventRecipe identifies the event recipe a cook log was created
+// from.
+type RecipeCookLogEventRecipe struct {
+	Parent EventRecipeParent
+	Id     EventRecipeId
+}
+
+// RecipeCookLogParent defines the parent of a recipe cook log.
+type RecipeCookLogParent struct {
+	RecipeId RecipeId
+}
+
+// RecipeCookLogId defines the ID for a recipe cook log.
+type RecipeCookLogId struct {
+	RecipeCookLogId int64 `aip_pattern:"key=cook_log"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (r RecipeCookLogId) isResourceId() {
+}
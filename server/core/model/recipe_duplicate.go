@@ -0,0 +1,30 @@
+package model
+
+// Reasons recipes are considered duplicates of each other.
+const (
+	// RecipeDuplicateReason_Citation means the recipes cite the same url.
+	RecipeDuplicateReason_Citation = "citation"
+	// RecipeDuplicateReason_Title means the recipes have similar titles.
+	RecipeDuplicateReason_Title = "title"
+	// RecipeDuplicateReason_Ingredients means most of the ingredients of the
+	// recipes are the same.
+	RecipeDuplicateReason_Ingredients = "ingredients"
+)
+
+// RecipeDuplicate defines a recipe that is likely a copy of another recipe.
+type RecipeDuplicate struct {
+	Recipe Recipe
+	// Similarity is how alike the recipes are, from 0 to 1.
+	Similarity float64
+	// Reasons are the RecipeDuplicateReason values the recipes matched on.
+	Reasons []string
+}
+
+// RecipeDuplicateGroup defines recipes of a collection that are likely copies
+// of each other.
+type RecipeDuplicateGroup struct {
+	// Recipe is the oldest recipe of the group, the one suggested to keep.
+	Recipe Recipe
+	// Duplicates are the other recipes of the group, most similar first.
+	Duplicates []RecipeDuplicate
+}
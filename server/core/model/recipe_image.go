@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = RecipeImageId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// RecipeImageFields defines the recipe image fields.
+const (
+	RecipeImageField_Parent     = "parent"
+	RecipeImageField_Id         = "id"
+	RecipeImageField_ImageURI   = "image_uri"
+	RecipeImageField_ImageSet   = "image_set"
+	RecipeImageField_Position   = "position"
+	RecipeImageField_Step       = "step"
+	RecipeImageField_CreateTime = "create_time"
+)
+
+// RecipeImage defines an image in the gallery of a recipe, or attached to one
+// of its direction steps. The cover image of a recipe is the image whose URI
+// is the recipe ImageURI.
+type RecipeImage struct {
+	Parent   RecipeImageParent
+	Id       RecipeImageId
+	ImageURI string
+	ImageSet ImageSet
+	// Position is the display order of the image within the recipe.
+	Position int32
+	// Step is the direction step the image is attached to, nil for gallery
+	// images.
+	Step       *RecipeDirectionStep
+	CreateTime time.Time
+
+	// Cover is whether the image is the cover image of the recipe. It is not
+	// stored with the image.
+	Cover bool
+}
+
+// RecipeDirectionStep identifies a step of a recipe by the index of its
+// direction and the index of the step within the direction.
+type RecipeDirectionStep struct {
+	DirectionIndex int32
+	StepIndex      int32
+}
+
+// RecipeImageParent defines the parent of a recipe image.
+type RecipeImageParent struct {
+	RecipeId RecipeId
+}
+
+// RecipeImageId defines the ID for a recipe image.
+type RecipeImageId struct {
+	RecipeImageId int64 `aip_pattern:"key=image"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (r RecipeImageId) isResourceId() {
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = RecipeImageCandidateId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// RecipeImageCandidateFields defines the recipe image candidate fields.
+const (
+	RecipeImageCandidateField_Parent     = "parent"
+	RecipeImageCandidateField_Id         = "id"
+	RecipeImageCandidateField_CreatorId  = "creator_id"
+	RecipeImageCandidateField_ImageURI   = "image_uri"
+	RecipeImageCandidateField_CreateTime = "create_time"
+)
+
+// RecipeImageCandidate defines a generated image waiting to be selected as
+// the cover of a recipe. Candidates are stored as generated and only resized
+// once selected.
+type RecipeImageCandidate struct {
+	Parent RecipeImageCandidateParent
+	Id     RecipeImageCandidateId
+	// CreatorId is the user who generated the candidate.
+	CreatorId  UserId
+	ImageURI   string
+	CreateTime time.Time
+}
+
+// RecipeImageCandidateParent defines the parent of a recipe image candidate.
+type RecipeImageCandidateParent struct {
+	RecipeId RecipeId
+}
+
+// RecipeImageCandidateId defines the ID for a recipe image candidate.
+type RecipeImageCandidateId struct {
+	RecipeImageCandidateId int64 `aip_pattern:"key=candidate"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (r RecipeImageCandidateId) isResourceId() {
+}
+
+// RecipeImageGeneration records that a user had images generated for a
+// recipe. It is counted against the daily generation quota of the user.
+type RecipeImageGeneration struct {
+	Id       RecipeImageGenerationId
+	UserId   UserId
+	RecipeId RecipeId
+	// Count is the number of images generated.
+	Count      int32
+	CreateTime time.Time
+}
+
+// RecipeImageGenerationId defines the ID for a recipe image generation.
+type RecipeImageGenerationId struct {
+	RecipeImageGenerationId int64
+}
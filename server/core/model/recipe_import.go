@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = RecipeImportId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// RecipeImportFields defines the recipe import fields.
+const (
+	RecipeImportField_Parent       = "parent"
+	RecipeImportField_Id           = "id"
+	RecipeImportField_Source       = "source"
+	RecipeImportField_State        = "state"
+	RecipeImportField_Error        = "error"
+	RecipeImportField_Results      = "results"
+	RecipeImportField_CreateTime   = "create_time"
+	RecipeImportField_CompleteTime = "complete_time"
+)
+
+// RecipeImportState defines the state of a recipe import.
+type RecipeImportState int
+
+const (
+	RecipeImportState_Running RecipeImportState = iota + 1
+	RecipeImportState_Succeeded
+	RecipeImportState_Failed
+)
+
+// RecipeImportOutcome defines the outcome of importing a single recipe.
+type RecipeImportOutcome int
+
+const (
+	RecipeImportOutcome_Created RecipeImportOutcome = iota + 1
+	RecipeImportOutcome_Duplicate
+	RecipeImportOutcome_Failed
+)
+
+// RecipeImport defines an import of recipes from the export file of another
+// recipe manager. The import runs in the background and records the outcome
+// of every recipe in the file.
+type RecipeImport struct {
+	Parent RecipeImportParent
+	Id     RecipeImportId
+	// Source is the recipe manager the file was exported from, see
+	// recipeimport.Source.
+	Source string
+	State  RecipeImportState
+	// Error is set when the file as a whole could not be imported.
+	Error        string
+	Results      []RecipeImportResult
+	CreateTime   time.Time
+	CompleteTime *time.Time
+}
+
+// RecipeImportResult defines the outcome of importing a single recipe.
+type RecipeImportResult struct {
+	Title   string
+	Outcome RecipeImportOutcome
+	// RecipeId is the created recipe, or the existing recipe for duplicates.
+	RecipeId RecipeId
+	Error    string
+}
+
+// RecipeImportParent defines the parent of a recipe import.
+type RecipeImportParent struct {
+	UserId int64 `aip_pattern:"key=user"`
+}
+
+// RecipeImportId defines the ID for a recipe import.
+type RecipeImportId struct {
+	RecipeImportId int64 `aip_pattern:"key=recipe_import"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (r RecipeImportId) isResourceId() {
+}
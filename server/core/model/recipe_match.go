@@ -0,0 +1,23 @@
+package model
+
+// RecipeMatchRequest defines what to match recipes against.
+type RecipeMatchRequest struct {
+	// Ingredients are the titles of the ingredients on hand.
+	Ingredients []string
+	// Filter is a recipe filter applied before matching.
+	Filter string
+	// MinimumCoverage leaves out recipes with a lower coverage percentage.
+	MinimumCoverage float64
+}
+
+// RecipeMatch defines how much of a recipe can be made with the ingredients
+// on hand.
+type RecipeMatch struct {
+	Recipe Recipe
+	// Coverage is the percentage of required, non-optional ingredients on hand.
+	Coverage float64
+	// AvailableIngredients and MissingIngredients are the titles of the
+	// required recipe ingredients that are and are not on hand.
+	AvailableIngredients []string
+	MissingIngredients   []string
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = RecipeNoteId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// RecipeNoteFields defines the recipe note fields.
+const (
+	RecipeNoteField_Parent     = "parent"
+	RecipeNoteField_Id         = "id"
+	RecipeNoteField_AuthorId   = "author_id"
+	RecipeNoteField_Text       = "text"
+	RecipeNoteField_Ingredient = "ingredient"
+	RecipeNoteField_Step       = "step"
+	RecipeNoteField_CreateTime = "create_time"
+	RecipeNoteField_UpdateTime = "update_time"
+)
+
+// RecipeNote defines a private note of a user on a recipe. It is only visible
+// to its author.
+type RecipeNote struct {
+	Parent RecipeNoteParent
+	Id     RecipeNoteId
+	// AuthorId is the user who wrote the note.
+	AuthorId UserId
+	Text     string
+	// Ingredient is the ingredient the note is attached to, nil when the note
+	// is not attached to an ingredient.
+	Ingredient *RecipeStepIngredient
+	// Step is the direction step the note is attached to, nil when the note is
+	// not attached to a step.
+	Step       *RecipeDirectionStep
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// RecipeNoteParent defines the parent of a recipe note.
+type RecipeNoteParent struct {
+	RecipeId RecipeId
+}
+
+// RecipeNoteId defines the ID for a recipe note.
+type RecipeNoteId struct {
+	RecipeNoteId int64 `aip_pattern:"key=note"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (r RecipeNoteId) isResourceId() {
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = RecipeReviewId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// RecipeReviewFields defines the recipe review fields.
+const (
+	RecipeReviewField_Parent     = "parent"
+	RecipeReviewField_Id         = "id"
+	RecipeReviewField_AuthorId   = "author_id"
+	RecipeReviewField_Rating     = "rating"
+	RecipeReviewField_Text       = "text"
+	RecipeReviewField_CreateTime = "create_time"
+	RecipeReviewField_UpdateTime = "update_time"
+)
+
+// RecipeReview defines a user's rating and review of a recipe.
+type RecipeReview struct {
+	Parent RecipeReviewParent
+	Id     RecipeReviewId
+	// AuthorId is the user who wrote the review.
+	AuthorId UserId
+	// Rating is the rating from 1 to 5.
+	Rating     int32
+	Text       string
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// RecipeReviewParent defines the parent of a recipe review.
+type RecipeReviewParent struct {
+	RecipeId RecipeId
+}
+
+// RecipeReviewId defines the ID for a recipe review.
+type RecipeReviewId struct {
+	RecipeReviewId int64 `aip_pattern:"key=review"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (r RecipeReviewId) isResourceId() {
+}
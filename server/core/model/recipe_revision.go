@@ -0,0 +1,86 @@
+package model
+
+import (
+	"time"
+)
+
+var _ ResourceId = RecipeRevisionId{}
+
+// ----------------------------------------------------------------------------
+// Fields
+
+// RecipeRevisionFields defines the recipe revision fields.
+const (
+	RecipeRevisionField_Parent     = "parent"
+	RecipeRevisionField_Id         = "id"
+	RecipeRevisionField_EditorId   = "editor_id"
+	RecipeRevisionField_UpdateMask = "update_mask"
+	RecipeRevisionField_Recipe     = "recipe"
+	RecipeRevisionField_CreateTime = "create_time"
+)
+
+// RecipeRevision defines an immutable snapshot of a recipe taken when it was
+// created or updated.
+type RecipeRevision struct {
+	Parent RecipeRevisionParent
+	Id     RecipeRevisionId
+	// EditorId is the user who made the change.
+	EditorId UserId
+	// UpdateMask holds the recipe fields that were changed. It is empty for
+	// the initial revision.
+	UpdateMask []string
+	// Recipe is the recipe as it was after the change.
+	Recipe     Recipe
+	CreateTime time.Time
+}
+
+// RecipeRevisionParent defines the parent of a recipe revision.
+type RecipeRevisionParent struct {
+	RecipeId RecipeId
+}
+
+// RecipeRevisionId defines the ID for a recipe revision.
+type RecipeRevisionId struct {
+	RecipeRevisionId int64 `aip_pattern:"key=revision"`
+}
+
+// isResourceId - implements the ResourceId interface.
+func (r RecipeRevisionId) isResourceId() {
+}
+
+// ----------------------------------------------------------------------------
+// Diff
+
+// RecipeChangeType defines how an item changed between two revisions.
+type RecipeChangeType int
+
+const (
+	RecipeChangeType_Added RecipeChangeType = iota + 1
+	RecipeChangeType_Removed
+	RecipeChangeType_Modified
+)
+
+// RecipeDiff defines the differences between two recipe revisions.
+type RecipeDiff struct {
+	// ChangedFields holds the recipe fields that differ.
+	ChangedFields     []string
+	IngredientChanges []RecipeIngredientChange
+	DirectionChanges  []RecipeDirectionChange
+}
+
+// RecipeIngredientChange defines a change to an ingredient. Before is nil for
+// added ingredients and After is nil for removed ones.
+type RecipeIngredientChange struct {
+	ChangeType RecipeChangeType
+	GroupTitle string
+	Before     *RecipeIngredient
+	After      *RecipeIngredient
+}
+
+// RecipeDirectionChange defines a change to a direction step.
+type RecipeDirectionChange struct {
+	ChangeType     RecipeChangeType
+	DirectionTitle string
+	Before         string
+	After          string
+}
@@ -21,6 +21,7 @@ const (
 	RecipeField_Directions           = "directions"
 	RecipeField_IngredientGroups     = "ingredient_groups"
 	RecipeField_ImageURI             = "image_uri"
+	RecipeField_ImageSet             = "image_set"
 	RecipeField_VisibilityLevel      = "visibility_level"
 	RecipeField_Citation             = "citation"
 	RecipeField_PrepDurationSeconds  = "prep_duration_seconds"
@@ -33,6 +34,18 @@ const (
 	RecipeField_CreateTime           = "create_time"
 	RecipeField_UpdateTime           = "update_time"
 	RecipeField_Favorited            = "favorited"
+	RecipeField_Nutrition            = "nutrition"
+	RecipeField_ForkedFrom           = "forked_from"
+	RecipeField_ForkCount            = "fork_count"
+	RecipeField_AverageRating        = "average_rating"
+	RecipeField_RatingCount          = "rating_count"
+	RecipeField_LastCookedTime       = "last_cooked_time"
+	RecipeField_TimesCooked          = "times_cooked"
+	RecipeField_Diets                = "diets"
+	RecipeField_Allergens            = "allergens"
+	RecipeField_DietaryOverrides     = "dietary_overrides"
+	RecipeField_RestrictionConflicts = "restriction_conflicts"
+	RecipeField_PrivateNotes         = "private_notes"
 
 	RecipeField_RecipeAccess = "recipe_access"
 )
@@ -46,6 +59,7 @@ type Recipe struct {
 	Directions       []RecipeDirection
 	IngredientGroups []IngredientGroup
 	ImageURI         string
+	ImageSet         ImageSet
 	VisibilityLevel  types.VisibilityLevel
 	Citation         string
 	PrepDuration     time.Duration
@@ -58,14 +72,99 @@ type Recipe struct {
 	CreateTime       time.Time
 	UpdateTime       time.Time
 	Favorited        bool
+	Nutrition        Nutrition
+	// ForkedFrom is the recipe this recipe was copied from, if any.
+	ForkedFrom RecipeId
+	// ForkCount is the number of recipes forked from this recipe.
+	ForkCount int64
+	// AverageRating is the average rating of the reviews of this recipe, 0
+	// when there are none.
+	AverageRating float64
+	// RatingCount is the number of reviews of this recipe.
+	RatingCount int64
+	// LastCookedTime and TimesCooked come from the cook logs of the current
+	// user. LastCookedTime is nil when they never cooked the recipe.
+	LastCookedTime *time.Time
+	TimesCooked    int64
+	// Diets and Allergens are derived from the ingredients with the
+	// DietaryOverrides applied.
+	Diets            []string
+	Allergens        []string
+	DietaryOverrides DietaryOverrides
+	// RestrictionConflicts are the dietary restrictions of the current user
+	// that the recipe does not meet. They are not stored with the recipe.
+	RestrictionConflicts []string
+	// PrivateNotes are the notes of the current user on the recipe. They are
+	// not stored with the recipe.
+	PrivateNotes []RecipeNote
+	// Images are the gallery and step images of the recipe. They are not
+	// stored with the recipe; they are loaded for exports, and the step images
+	// of a fork are copied from them when it is created.
+	Images []RecipeImage
+	// PossibleDuplicates are the recipes of the same collection that are
+	// likely copies of this one. They are not stored with the recipe and are
+	// only set when it is created.
+	PossibleDuplicates []RecipeId
 
 	// The access details for the current user/circle
 	RecipeAccess RecipeAccess
 }
 
+// Nutrition defines the estimated nutrition facts per serving of a recipe.
+type Nutrition struct {
+	Servings          float64
+	Calories          float64
+	ProteinGrams      float64
+	FatGrams          float64
+	CarbohydrateGrams float64
+	FiberGrams        float64
+	SugarGrams        float64
+	SodiumMilligrams  float64
+	// UnmatchedIngredients are the titles of the ingredients that were left
+	// out because they could not be matched or converted to grams.
+	UnmatchedIngredients []string
+}
+
 type RecipeDirection struct {
 	Title string
 	Steps []string
+	// StepDetails are the timers, temperatures and ingredients of the steps,
+	// in the same order as Steps.
+	StepDetails []RecipeStepDetail
+}
+
+// RecipeStepDetail defines what a step of the directions calls for.
+type RecipeStepDetail struct {
+	Timers       []RecipeStepTimer
+	Temperatures []RecipeStepTemperature
+	Ingredients  []RecipeStepIngredient
+}
+
+// IsZero reports whether the step detail has nothing in it.
+func (d RecipeStepDetail) IsZero() bool {
+	return len(d.Timers) == 0 && len(d.Temperatures) == 0 && len(d.Ingredients) == 0
+}
+
+// RecipeStepTimer defines a duration mentioned in a step, e.g. the 20
+// minutes of "simmer for 20 minutes". For a range the shortest duration is
+// used.
+type RecipeStepTimer struct {
+	Text     string
+	Duration time.Duration
+}
+
+// RecipeStepTemperature defines a temperature mentioned in a step.
+type RecipeStepTemperature struct {
+	Text  string
+	Value float64
+	Unit  pb.Recipe_TemperatureUnit
+}
+
+// RecipeStepIngredient references an ingredient of the recipe by the index of
+// its group and its index within the group.
+type RecipeStepIngredient struct {
+	GroupIndex      int32
+	IngredientIndex int32
 }
 
 type IngredientGroup struct {
@@ -81,6 +180,8 @@ type RecipeIngredient struct {
 	SecondMeasurementAmount float64
 	SecondMeasurementType   pb.Recipe_MeasurementType
 	Title                   string
+	// IngredientId links the recipe ingredient to the ingredient catalog.
+	IngredientId IngredientId
 }
 
 // RecipeId defines the name for a recipe.
@@ -11,12 +11,15 @@ const (
 	UserField_GivenName  = "given_name"
 	UserField_FamilyName = "family_name"
 	UserField_ImageUri   = "image_uri"
+	UserField_ImageSet   = "image_set"
 	UserField_Bio        = "bio"
 	UserField_Email      = "email"
 	UserField_GoogleId   = "google_id"
 	UserField_FacebookId = "facebook_id"
 	UserField_AmazonId   = "amazon_id"
 
+	UserField_DietaryRestrictions = "dietary_restrictions"
+
 	UserField_AccessName            = "access_id"
 	UserField_AccessPermissionLevel = "permission_level"
 	UserField_AccessState           = "state"
@@ -33,6 +36,8 @@ type User struct {
 
 	// the image url for the user
 	ImageUri string
+	// the variants of the user image
+	ImageSet ImageSet
 
 	// the bio for the user
 	Bio string
@@ -45,6 +50,9 @@ type User struct {
 
 	Favorited bool
 
+	// the dietary restrictions of the user, only visible to the user
+	DietaryRestrictions DietaryRestrictions
+
 	UserAccess   UserAccess
 	CircleAccess CircleAccess
 }
@@ -0,0 +1,239 @@
+// Package nutrition estimates recipe nutrition facts from an embedded nutrient
+// table, so no network access is needed.
+package nutrition
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/measurement"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+//go:embed nutrients.csv
+var nutrientsCSV string
+
+// Food is a row of the nutrient table. Nutrients are per 100 g.
+type Food struct {
+	Name    string
+	Aliases []string
+	// Density is in grams per milliliter. Nil when unknown.
+	Density *float64
+	// UnitGrams is the weight of one item, e.g. one egg. Zero when unknown.
+	UnitGrams float64
+	Per100g   model.Nutrition
+}
+
+var (
+	foods = mustLoadFoods()
+	// foodCatalog holds the foods as ingredients so they can be matched with
+	// the ingredient catalog matcher. The ingredient id is the food index + 1.
+	foodCatalog = toCatalog(foods)
+)
+
+// Foods returns the embedded nutrient table.
+func Foods() []Food {
+	return foods
+}
+
+// Calculate estimates the nutrition facts per serving of a recipe. The catalog
+// holds the ingredients the recipe ingredients are linked to; their titles,
+// aliases and densities are used when the recipe ingredient title alone does
+// not match. Optional ingredients and ingredients without an amount are left
+// out.
+func Calculate(recipe model.Recipe, catalog map[int64]model.Ingredient) model.Nutrition {
+	total := model.Nutrition{}
+
+	for _, group := range recipe.IngredientGroups {
+		for _, ingredient := range group.RecipeIngredients {
+			if ingredient.Optional || ingredient.MeasurementAmount <= 0 {
+				continue
+			}
+
+			catalogIngredient, linked := catalog[ingredient.IngredientId.IngredientId]
+			food, ok := matchFood(ingredient.Title, catalogIngredient, linked)
+			if !ok {
+				total.UnmatchedIngredients = append(total.UnmatchedIngredients, ingredient.Title)
+				continue
+			}
+
+			density := food.Density
+			if linked && catalogIngredient.Density != nil {
+				density = catalogIngredient.Density
+			}
+
+			grams, ok := ingredientGrams(ingredient, food, density)
+			if !ok {
+				total.UnmatchedIngredients = append(total.UnmatchedIngredients, ingredient.Title)
+				continue
+			}
+
+			factor := grams / 100
+			total.Calories += food.Per100g.Calories * factor
+			total.ProteinGrams += food.Per100g.ProteinGrams * factor
+			total.FatGrams += food.Per100g.FatGrams * factor
+			total.CarbohydrateGrams += food.Per100g.CarbohydrateGrams * factor
+			total.FiberGrams += food.Per100g.FiberGrams * factor
+			total.SugarGrams += food.Per100g.SugarGrams * factor
+			total.SodiumMilligrams += food.Per100g.SodiumMilligrams * factor
+		}
+	}
+
+	servings, ok := measurement.ParseYield(recipe.YieldAmount)
+	if !ok {
+		servings = 1
+	}
+
+	return model.Nutrition{
+		Servings:             servings,
+		Calories:             round(total.Calories / servings),
+		ProteinGrams:         round(total.ProteinGrams / servings),
+		FatGrams:             round(total.FatGrams / servings),
+		CarbohydrateGrams:    round(total.CarbohydrateGrams / servings),
+		FiberGrams:           round(total.FiberGrams / servings),
+		SugarGrams:           round(total.SugarGrams / servings),
+		SodiumMilligrams:     math.Round(total.SodiumMilligrams / servings),
+		UnmatchedIngredients: total.UnmatchedIngredients,
+	}
+}
+
+// matchFood finds the food for an ingredient title, falling back to the
+// linked catalog ingredient and then to the title without its leading words,
+// so "unbleached all-purpose flour" still matches "all purpose flour".
+func matchFood(title string, catalogIngredient model.Ingredient, linked bool) (Food, bool) {
+	titles := []string{title}
+	if linked {
+		titles = append(titles, catalogIngredient.Title)
+		titles = append(titles, catalogIngredient.Aliases...)
+	}
+
+	for _, t := range titles {
+		if match, ok := ingredientcatalog.Match(t, foodCatalog); ok {
+			return foods[match.Id.IngredientId-1], true
+		}
+	}
+
+	words := strings.Fields(ingredientcatalog.Normalize(title))
+	for i := 1; i < len(words); i++ {
+		if match, ok := ingredientcatalog.Match(strings.Join(words[i:], " "), foodCatalog); ok {
+			return foods[match.Id.IngredientId-1], true
+		}
+	}
+
+	return Food{}, false
+}
+
+// ingredientGrams converts the amount of an ingredient to grams. Both amounts
+// are used for "and", the middle of the range for "to" and the first for "or".
+func ingredientGrams(ingredient model.RecipeIngredient, food Food, density *float64) (float64, bool) {
+	grams, ok := toGrams(ingredient.MeasurementAmount, ingredient.MeasurementType, food, density)
+	if !ok || ingredient.SecondMeasurementAmount <= 0 {
+		return grams, ok
+	}
+
+	secondType := ingredient.SecondMeasurementType
+	if secondType == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
+		secondType = ingredient.MeasurementType
+	}
+
+	switch ingredient.MeasurementConjunction {
+	case pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_AND:
+		second, ok := toGrams(ingredient.SecondMeasurementAmount, secondType, food, density)
+		return grams + second, ok
+	case pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_TO:
+		second, ok := toGrams(ingredient.SecondMeasurementAmount, secondType, food, density)
+		return (grams + second) / 2, ok
+	}
+	return grams, true
+}
+
+func toGrams(amount float64, measurementType pb.Recipe_MeasurementType, food Food, density *float64) (float64, bool) {
+	if measurement.FamilyOf(measurementType) == measurement.FamilyNone {
+		if food.UnitGrams <= 0 {
+			return 0, false
+		}
+		return amount * food.UnitGrams, true
+	}
+	return measurement.ToGrams(amount, measurementType, density)
+}
+
+func round(value float64) float64 {
+	return math.Round(value*10) / 10
+}
+
+func toCatalog(foods []Food) []model.Ingredient {
+	catalog := make([]model.Ingredient, len(foods))
+	for i, food := range foods {
+		catalog[i] = model.Ingredient{
+			Id:      model.IngredientId{IngredientId: int64(i + 1)},
+			Title:   food.Name,
+			Aliases: food.Aliases,
+		}
+	}
+	return catalog
+}
+
+func mustLoadFoods() []Food {
+	foods, err := loadFoods(nutrientsCSV)
+	if err != nil {
+		panic(err)
+	}
+	return foods
+}
+
+func loadFoods(data string) ([]Food, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 11
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read nutrient table: %w", err)
+	}
+
+	foods := make([]Food, 0, len(records))
+	for _, record := range records {
+		values := make([]float64, 0, 9)
+		for _, field := range record[2:] {
+			if field == "" {
+				values = append(values, 0)
+				continue
+			}
+			value, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q for %q in nutrient table: %w", field, record[0], err)
+			}
+			values = append(values, value)
+		}
+
+		food := Food{
+			Name:      record[0],
+			UnitGrams: values[1],
+			Per100g: model.Nutrition{
+				Calories:          values[2],
+				ProteinGrams:      values[3],
+				FatGrams:          values[4],
+				CarbohydrateGrams: values[5],
+				FiberGrams:        values[6],
+				SugarGrams:        values[7],
+				SodiumMilligrams:  values[8],
+			},
+		}
+		if record[1] != "" {
+			food.Aliases = strings.Split(record[1], "|")
+		}
+		if record[2] != "" {
+			density := values[0]
+			food.Density = &density
+		}
+		foods = append(foods, food)
+	}
+
+	return foods, nil
+}
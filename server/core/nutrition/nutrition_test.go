@@ -0,0 +1,72 @@
+package nutrition
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+func TestFoods(t *testing.T) {
+	if len(Foods()) < 100 {
+		t.Fatalf("Foods() returned %d foods, want at least 100", len(Foods()))
+	}
+	for _, food := range Foods() {
+		if food.Name == "" {
+			t.Errorf("food without name: %+v", food)
+		}
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	recipe := model.Recipe{
+		YieldAmount: "Serves 2",
+		IngredientGroups: []model.IngredientGroup{{
+			RecipeIngredients: []model.RecipeIngredient{
+				{Title: "Unbleached all-purpose flour", MeasurementAmount: 100, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_GRAM},
+				{Title: "large eggs", MeasurementAmount: 2},
+				{Title: "butter, melted", MeasurementAmount: 1, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_TABLESPOON},
+				{Title: "salt", MeasurementAmount: 0},
+				{Title: "dragon fruit", MeasurementAmount: 1},
+				{Title: "walnuts", Optional: true, MeasurementAmount: 1, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+			},
+		}},
+	}
+
+	got := Calculate(recipe, nil)
+
+	// 100 g flour (364) + 100 g egg (143) + 14.78 ml butter at 0.911 g/ml (96.5)
+	if got.Servings != 2 {
+		t.Errorf("Servings = %v, want 2", got.Servings)
+	}
+	if got.Calories < 301 || got.Calories > 302 {
+		t.Errorf("Calories = %v, want about 301.8", got.Calories)
+	}
+	if !slices.Equal(got.UnmatchedIngredients, []string{"dragon fruit"}) {
+		t.Errorf("UnmatchedIngredients = %v, want [dragon fruit]", got.UnmatchedIngredients)
+	}
+}
+
+func TestCalculate_CatalogDensity(t *testing.T) {
+	density := 1.0
+	recipe := model.Recipe{
+		IngredientGroups: []model.IngredientGroup{{
+			RecipeIngredients: []model.RecipeIngredient{
+				{Title: "chicken", IngredientId: model.IngredientId{IngredientId: 7}, MeasurementAmount: 1, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+			},
+		}},
+	}
+	catalog := map[int64]model.Ingredient{
+		7: {Id: model.IngredientId{IngredientId: 7}, Title: "chicken breast", Density: &density},
+	}
+
+	got := Calculate(recipe, catalog)
+	if len(got.UnmatchedIngredients) != 0 {
+		t.Fatalf("UnmatchedIngredients = %v, want none", got.UnmatchedIngredients)
+	}
+	// one cup is 236.6 ml, at 1 g/ml and 120 kcal per 100 g
+	if got.Calories < 283 || got.Calories > 285 {
+		t.Errorf("Calories = %v, want about 284", got.Calories)
+	}
+}
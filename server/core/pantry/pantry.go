@@ -0,0 +1,217 @@
+// Package pantry keeps the quantities of pantry items in step with cooking
+// and shopping.
+package pantry
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/measurement"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+// Stock is the working copy of the items in a pantry.
+type Stock struct {
+	items   []model.PantryItem
+	changed []bool
+}
+
+// NewStock creates a stock from the items in a pantry.
+func NewStock(items []model.PantryItem) *Stock {
+	return &Stock{
+		items:   slices.Clone(items),
+		changed: make([]bool, len(items)),
+	}
+}
+
+// Changed returns the items that were changed, in pantry order. Items that
+// were added by a restock have no id yet.
+func (s *Stock) Changed() []model.PantryItem {
+	changed := []model.PantryItem{}
+	for i, item := range s.items {
+		if s.changed[i] {
+			changed = append(changed, item)
+		}
+	}
+	return changed
+}
+
+// Consume uses up the ingredients of a recipe, scaled by scale. Each amount is
+// taken from the matching items that expire first, after converting it to the
+// unit of the item. Amounts that can not be converted, such as a weight from
+// a count, leave the item untouched. Quantities never drop below zero. The
+// titles of the required ingredients that are not in the pantry are returned.
+func (s *Stock) Consume(recipe model.Recipe, scale float64) (missing []string) {
+	if scale <= 0 {
+		scale = 1
+	}
+
+	missing = []string{}
+	for _, group := range recipe.IngredientGroups {
+		for _, ingredient := range group.RecipeIngredients {
+			if strings.TrimSpace(ingredient.Title) == "" {
+				continue
+			}
+			candidates := s.matching(ingredient.Title)
+			if len(candidates) == 0 {
+				if !ingredient.Optional {
+					missing = append(missing, ingredient.Title)
+				}
+				continue
+			}
+
+			for _, quantity := range quantitiesOf(ingredient) {
+				remaining := quantity.amount * scale
+				for _, i := range candidates {
+					if remaining <= 0 {
+						break
+					}
+					item := &s.items[i]
+					if item.Quantity <= 0 {
+						continue
+					}
+					needed, ok := convert(remaining, quantity.measurementType, item.Unit)
+					if !ok {
+						continue
+					}
+					used := min(needed, item.Quantity)
+					item.Quantity -= used
+					s.changed[i] = true
+					remaining -= remaining * used / needed
+				}
+			}
+		}
+	}
+
+	return missing
+}
+
+// Restock adds a bought grocery item, such as "6 tbsp butter" or
+// "200 g + 2 cups flour" as written by the grocery list generator, to the
+// stock. Each amount is added to a matching item with a compatible unit, or
+// else to a new item. A title without an amount adds a count of one.
+func (s *Stock) Restock(title string, purchaseTime time.Time) {
+	title = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(title), "(optional)"))
+
+	quantities := []quantity{}
+	for {
+		amount, measurementType, rest, ok := measurement.ParseQuantity(title)
+		if !ok {
+			break
+		}
+		quantities = append(quantities, quantity{amount: amount, measurementType: measurementType})
+		title = rest
+		if after, found := strings.CutPrefix(title, "+ "); found {
+			title = after
+			continue
+		}
+		break
+	}
+	if title == "" {
+		return
+	}
+	if len(quantities) == 0 {
+		quantities = append(quantities, quantity{amount: 1})
+	}
+
+	candidates := s.matching(title)
+	for _, q := range quantities {
+		restocked := false
+		for _, i := range candidates {
+			item := &s.items[i]
+			added, ok := convert(q.amount, q.measurementType, item.Unit)
+			if !ok {
+				continue
+			}
+			item.Quantity += added
+			item.PurchaseTime = purchaseTime
+			s.changed[i] = true
+			restocked = true
+			break
+		}
+		if restocked {
+			continue
+		}
+
+		s.items = append(s.items, model.PantryItem{
+			Title:        title,
+			Quantity:     q.amount,
+			Unit:         q.measurementType,
+			PurchaseTime: purchaseTime,
+		})
+		s.changed = append(s.changed, true)
+		candidates = append(candidates, len(s.items)-1)
+	}
+}
+
+// matching returns the indexes of the items with the same ingredient as the
+// title, soonest to expire first. Items without an expiry come last.
+func (s *Stock) matching(title string) []int {
+	name := ingredientcatalog.Canonical(title)
+	if name == "" {
+		return nil
+	}
+
+	indexes := []int{}
+	for i, item := range s.items {
+		itemName := ingredientcatalog.Canonical(item.Title)
+		if itemName == name || ingredientcatalog.Similarity(itemName, name) >= ingredientcatalog.MatchThreshold {
+			indexes = append(indexes, i)
+		}
+	}
+
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		aExpire, bExpire := s.items[a].ExpireTime, s.items[b].ExpireTime
+		if aExpire.IsZero() != bExpire.IsZero() {
+			if aExpire.IsZero() {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(aExpire.UnixNano(), bExpire.UnixNano())
+	})
+
+	return indexes
+}
+
+type quantity struct {
+	amount          float64
+	measurementType pb.Recipe_MeasurementType
+}
+
+// quantitiesOf returns the amounts a recipe ingredient uses. Both amounts are
+// used for "and", the upper bound for "to" and the first for "or".
+func quantitiesOf(ingredient model.RecipeIngredient) []quantity {
+	first := quantity{amount: ingredient.MeasurementAmount, measurementType: ingredient.MeasurementType}
+	second := quantity{amount: ingredient.SecondMeasurementAmount, measurementType: ingredient.SecondMeasurementType}
+
+	switch ingredient.MeasurementConjunction {
+	case pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_AND:
+		return []quantity{first, second}
+	case pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_TO:
+		if second.amount > 0 {
+			if second.measurementType == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
+				second.measurementType = first.measurementType
+			}
+			return []quantity{second}
+		}
+	}
+	return []quantity{first}
+}
+
+// convert converts an amount between measurement types of the same family.
+// Counts only convert to counts.
+func convert(amount float64, from, to pb.Recipe_MeasurementType) (float64, bool) {
+	fromFamily, toFamily := measurement.FamilyOf(from), measurement.FamilyOf(to)
+	if fromFamily != toFamily {
+		return 0, false
+	}
+	if fromFamily == measurement.FamilyNone {
+		return amount, true
+	}
+	return measurement.FromBase(measurement.ToBase(amount, from), to), true
+}
@@ -0,0 +1,106 @@
+package pantry
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+func item(id int64, title string, quantity float64, unit pb.Recipe_MeasurementType) model.PantryItem {
+	return model.PantryItem{
+		Id:       model.PantryItemId{PantryItemId: id},
+		Title:    title,
+		Quantity: quantity,
+		Unit:     unit,
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestStock_Consume(t *testing.T) {
+	now := time.Now()
+	older := item(2, "Butter", 2, pb.Recipe_MEASUREMENT_TYPE_TABLESPOON)
+	older.ExpireTime = now.Add(24 * time.Hour)
+	newer := item(3, "butter", 1, pb.Recipe_MEASUREMENT_TYPE_CUP)
+	newer.ExpireTime = now.Add(72 * time.Hour)
+
+	stock := NewStock([]model.PantryItem{
+		item(1, "eggs", 6, pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED),
+		newer,
+		older,
+		item(4, "flour", 500, pb.Recipe_MEASUREMENT_TYPE_GRAM),
+		item(5, "scallions", 3, pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED),
+	})
+
+	missing := stock.Consume(model.Recipe{IngredientGroups: []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{
+		{Title: "large eggs", MeasurementAmount: 2},
+		{Title: "butter, melted", MeasurementAmount: 0.25, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+		{Title: "flour", MeasurementAmount: 1, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+		{Title: "green onions", MeasurementAmount: 1},
+		{Title: "milk", MeasurementAmount: 1, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+		{Title: "parsley", Optional: true},
+	}}}}, 2)
+
+	if len(missing) != 1 || missing[0] != "milk" {
+		t.Errorf("missing = %v, want [milk]", missing)
+	}
+
+	got := map[int64]float64{}
+	for _, changed := range stock.Changed() {
+		got[changed.Id.PantryItemId] = changed.Quantity
+	}
+	want := map[int64]float64{
+		1: 2,
+		// half a cup is 8 tbsp: the 2 tbsp expiring first are used up, then 6 tbsp of the cup
+		3: 0.625,
+		2: 0,
+		5: 1,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("changed = %v, want %v", got, want)
+	}
+	for id, quantity := range want {
+		if !near(got[id], quantity) {
+			t.Errorf("item %d quantity = %v, want %v", id, got[id], quantity)
+		}
+	}
+}
+
+func TestStock_Restock(t *testing.T) {
+	now := time.Now()
+	stock := NewStock([]model.PantryItem{
+		item(1, "butter", 2, pb.Recipe_MEASUREMENT_TYPE_TABLESPOON),
+		item(2, "flour", 1, pb.Recipe_MEASUREMENT_TYPE_CUP),
+	})
+
+	stock.Restock("6 tbsp butter", now)
+	stock.Restock("100 g + 1 cup flour", now)
+	stock.Restock("milk", now)
+	stock.Restock("1/2 lb parsley (optional)", now)
+
+	changed := stock.Changed()
+	want := []model.PantryItem{
+		item(1, "butter", 8, pb.Recipe_MEASUREMENT_TYPE_TABLESPOON),
+		item(2, "flour", 2, pb.Recipe_MEASUREMENT_TYPE_CUP),
+		item(0, "flour", 100, pb.Recipe_MEASUREMENT_TYPE_GRAM),
+		item(0, "milk", 1, pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED),
+		item(0, "parsley", 0.5, pb.Recipe_MEASUREMENT_TYPE_POUND),
+	}
+	if len(changed) != len(want) {
+		t.Fatalf("Changed() returned %d items, want %d: %v", len(changed), len(want), changed)
+	}
+	for i, w := range want {
+		c := changed[i]
+		if c.Id != w.Id || c.Title != w.Title || !near(c.Quantity, w.Quantity) || c.Unit != w.Unit {
+			t.Errorf("item %d = %+v, want %+v", i, c, w)
+		}
+		if !c.PurchaseTime.Equal(now) {
+			t.Errorf("item %d purchase time = %v, want %v", i, c.PurchaseTime, now)
+		}
+	}
+}
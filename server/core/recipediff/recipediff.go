@@ -0,0 +1,237 @@
+// Package recipediff compares two versions of a recipe at the field,
+// ingredient and direction step level.
+package recipediff
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Diff returns the differences between a base and a target recipe.
+func Diff(base, target model.Recipe) model.RecipeDiff {
+	diff := model.RecipeDiff{
+		ChangedFields:     changedFields(base, target),
+		IngredientChanges: ingredientChanges(base.IngredientGroups, target.IngredientGroups),
+		DirectionChanges:  directionChanges(base.Directions, target.Directions),
+	}
+	return diff
+}
+
+func changedFields(base, target model.Recipe) []string {
+	fields := []string{}
+	add := func(changed bool, field string) {
+		if changed {
+			fields = append(fields, field)
+		}
+	}
+
+	add(base.Title != target.Title, model.RecipeField_Title)
+	add(base.Description != target.Description, model.RecipeField_Description)
+	add(!slices.EqualFunc(base.Directions, target.Directions, func(a, b model.RecipeDirection) bool {
+		return a.Title == b.Title && slices.Equal(a.Steps, b.Steps) && sameStepDetails(a.StepDetails, b.StepDetails)
+	}), model.RecipeField_Directions)
+	add(!slices.EqualFunc(base.IngredientGroups, target.IngredientGroups, func(a, b model.IngredientGroup) bool {
+		return a.Title == b.Title && slices.EqualFunc(a.RecipeIngredients, b.RecipeIngredients, sameIngredient)
+	}), model.RecipeField_IngredientGroups)
+	add(base.ImageURI != target.ImageURI, model.RecipeField_ImageURI)
+	add(base.VisibilityLevel != target.VisibilityLevel, model.RecipeField_VisibilityLevel)
+	add(base.Citation != target.Citation, model.RecipeField_Citation)
+	add(base.PrepDuration != target.PrepDuration, model.RecipeField_PrepDurationSeconds)
+	add(base.CookDuration != target.CookDuration, model.RecipeField_CookDurationSeconds)
+	add(base.TotalDuration != target.TotalDuration, model.RecipeField_TotalDurationSeconds)
+	add(base.CookingMethod != target.CookingMethod, model.RecipeField_CookingMethod)
+	add(!slices.Equal(base.Categories, target.Categories), model.RecipeField_Categories)
+	add(base.YieldAmount != target.YieldAmount, model.RecipeField_YieldAmount)
+	add(!slices.Equal(base.Cuisines, target.Cuisines), model.RecipeField_Cuisines)
+
+	return fields
+}
+
+// sameStepDetails compares the step details of a direction. A missing step
+// detail is the same as an empty one.
+func sameStepDetails(a, b []model.RecipeStepDetail) bool {
+	for i := range max(len(a), len(b)) {
+		var detailA, detailB model.RecipeStepDetail
+		if i < len(a) {
+			detailA = a[i]
+		}
+		if i < len(b) {
+			detailB = b[i]
+		}
+		if detailA.IsZero() && detailB.IsZero() {
+			continue
+		}
+		if !reflect.DeepEqual(detailA, detailB) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameIngredient compares the fields a user can edit, ignoring the catalog link.
+func sameIngredient(a, b model.RecipeIngredient) bool {
+	a.IngredientId = model.IngredientId{}
+	b.IngredientId = model.IngredientId{}
+	return a == b
+}
+
+// ingredientChanges matches the ingredients of groups with the same title by
+// their normalized title. Matched ingredients that differ are reported as
+// modified, the rest as added or removed.
+func ingredientChanges(base, target []model.IngredientGroup) []model.RecipeIngredientChange {
+	changes := []model.RecipeIngredientChange{}
+
+	groupTitles := []string{}
+	baseGroups := map[string][]model.RecipeIngredient{}
+	targetGroups := map[string][]model.RecipeIngredient{}
+	for _, group := range base {
+		if _, ok := baseGroups[group.Title]; !ok {
+			groupTitles = append(groupTitles, group.Title)
+		}
+		baseGroups[group.Title] = append(baseGroups[group.Title], group.RecipeIngredients...)
+	}
+	for _, group := range target {
+		_, inBase := baseGroups[group.Title]
+		_, inTarget := targetGroups[group.Title]
+		if !inBase && !inTarget {
+			groupTitles = append(groupTitles, group.Title)
+		}
+		targetGroups[group.Title] = append(targetGroups[group.Title], group.RecipeIngredients...)
+	}
+
+	for _, groupTitle := range groupTitles {
+		remaining := slices.Clone(targetGroups[groupTitle])
+		matched := make([]bool, len(remaining))
+
+		for _, before := range baseGroups[groupTitle] {
+			index := -1
+			for i, after := range remaining {
+				if !matched[i] && ingredientcatalog.Normalize(after.Title) == ingredientcatalog.Normalize(before.Title) {
+					index = i
+					break
+				}
+			}
+
+			if index == -1 {
+				changes = append(changes, model.RecipeIngredientChange{
+					ChangeType: model.RecipeChangeType_Removed,
+					GroupTitle: groupTitle,
+					Before:     &before,
+				})
+				continue
+			}
+
+			matched[index] = true
+			after := remaining[index]
+			if !sameIngredient(before, after) {
+				changes = append(changes, model.RecipeIngredientChange{
+					ChangeType: model.RecipeChangeType_Modified,
+					GroupTitle: groupTitle,
+					Before:     &before,
+					After:      &after,
+				})
+			}
+		}
+
+		for i, after := range remaining {
+			if matched[i] {
+				continue
+			}
+			changes = append(changes, model.RecipeIngredientChange{
+				ChangeType: model.RecipeChangeType_Added,
+				GroupTitle: groupTitle,
+				After:      &after,
+			})
+		}
+	}
+
+	return changes
+}
+
+// directionChanges compares the steps of directions with the same title.
+// Steps are aligned with their longest common subsequence; a removed step
+// directly replaced by an added step is reported as modified.
+func directionChanges(base, target []model.RecipeDirection) []model.RecipeDirectionChange {
+	changes := []model.RecipeDirectionChange{}
+
+	titles := []string{}
+	baseSteps := map[string][]string{}
+	targetSteps := map[string][]string{}
+	for _, direction := range base {
+		if _, ok := baseSteps[direction.Title]; !ok {
+			titles = append(titles, direction.Title)
+		}
+		baseSteps[direction.Title] = append(baseSteps[direction.Title], direction.Steps...)
+	}
+	for _, direction := range target {
+		_, inBase := baseSteps[direction.Title]
+		_, inTarget := targetSteps[direction.Title]
+		if !inBase && !inTarget {
+			titles = append(titles, direction.Title)
+		}
+		targetSteps[direction.Title] = append(targetSteps[direction.Title], direction.Steps...)
+	}
+
+	for _, title := range titles {
+		changes = append(changes, diffSteps(title, baseSteps[title], targetSteps[title])...)
+	}
+
+	return changes
+}
+
+func diffSteps(title string, base, target []string) []model.RecipeDirectionChange {
+	// lcs[i][j] is the length of the longest common subsequence of base[i:] and target[j:]
+	lcs := make([][]int, len(base)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(target)+1)
+	}
+	for i := len(base) - 1; i >= 0; i-- {
+		for j := len(target) - 1; j >= 0; j-- {
+			if base[i] == target[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	changes := []model.RecipeDirectionChange{}
+	removed := []string{}
+	added := []string{}
+	flush := func() {
+		paired := min(len(removed), len(added))
+		for k := 0; k < paired; k++ {
+			changes = append(changes, model.RecipeDirectionChange{ChangeType: model.RecipeChangeType_Modified, DirectionTitle: title, Before: removed[k], After: added[k]})
+		}
+		for _, step := range removed[paired:] {
+			changes = append(changes, model.RecipeDirectionChange{ChangeType: model.RecipeChangeType_Removed, DirectionTitle: title, Before: step})
+		}
+		for _, step := range added[paired:] {
+			changes = append(changes, model.RecipeDirectionChange{ChangeType: model.RecipeChangeType_Added, DirectionTitle: title, After: step})
+		}
+		removed = removed[:0]
+		added = added[:0]
+	}
+
+	i, j := 0, 0
+	for i < len(base) || j < len(target) {
+		switch {
+		case i < len(base) && j < len(target) && base[i] == target[j]:
+			flush()
+			i++
+			j++
+		case j >= len(target) || (i < len(base) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, base[i])
+			i++
+		default:
+			added = append(added, target[j])
+			j++
+		}
+	}
+	flush()
+
+	return changes
+}
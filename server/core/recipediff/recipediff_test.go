@@ -0,0 +1,78 @@
+package recipediff
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+func TestDiff(t *testing.T) {
+	base := model.Recipe{
+		Title: "Pancakes",
+		IngredientGroups: []model.IngredientGroup{{
+			RecipeIngredients: []model.RecipeIngredient{
+				{Title: "flour", MeasurementAmount: 1},
+				{Title: "eggs", MeasurementAmount: 2},
+				{Title: "sugar", MeasurementAmount: 1},
+			},
+		}},
+		Directions: []model.RecipeDirection{{
+			Steps: []string{"Mix.", "Rest for 10 minutes.", "Cook."},
+		}},
+	}
+	target := model.Recipe{
+		Title: "Fluffy Pancakes",
+		IngredientGroups: []model.IngredientGroup{{
+			RecipeIngredients: []model.RecipeIngredient{
+				{Title: "flour", MeasurementAmount: 1, IngredientId: model.IngredientId{IngredientId: 3}},
+				{Title: "egg", MeasurementAmount: 3},
+				{Title: "buttermilk", MeasurementAmount: 1},
+			},
+		}},
+		Directions: []model.RecipeDirection{{
+			Steps: []string{"Mix.", "Rest for 20 minutes.", "Cook.", "Serve."},
+		}},
+	}
+
+	diff := Diff(base, target)
+
+	wantFields := []string{model.RecipeField_Title, model.RecipeField_Directions, model.RecipeField_IngredientGroups}
+	if !slices.Equal(diff.ChangedFields, wantFields) {
+		t.Errorf("ChangedFields = %v, want %v", diff.ChangedFields, wantFields)
+	}
+
+	if len(diff.IngredientChanges) != 3 {
+		t.Fatalf("got %d ingredient changes, want 3: %+v", len(diff.IngredientChanges), diff.IngredientChanges)
+	}
+	if c := diff.IngredientChanges[0]; c.ChangeType != model.RecipeChangeType_Modified || c.Before.MeasurementAmount != 2 || c.After.MeasurementAmount != 3 {
+		t.Errorf("egg change = %+v, want modified from 2 to 3", c)
+	}
+	if c := diff.IngredientChanges[1]; c.ChangeType != model.RecipeChangeType_Removed || c.Before.Title != "sugar" {
+		t.Errorf("sugar change = %+v, want removed", c)
+	}
+	if c := diff.IngredientChanges[2]; c.ChangeType != model.RecipeChangeType_Added || c.After.Title != "buttermilk" {
+		t.Errorf("buttermilk change = %+v, want added", c)
+	}
+
+	wantSteps := []model.RecipeDirectionChange{
+		{ChangeType: model.RecipeChangeType_Modified, Before: "Rest for 10 minutes.", After: "Rest for 20 minutes."},
+		{ChangeType: model.RecipeChangeType_Added, After: "Serve."},
+	}
+	if !slices.Equal(diff.DirectionChanges, wantSteps) {
+		t.Errorf("DirectionChanges = %+v, want %+v", diff.DirectionChanges, wantSteps)
+	}
+}
+
+func TestDiff_Unchanged(t *testing.T) {
+	recipe := model.Recipe{
+		Title:      "Toast",
+		Categories: []string{"breakfast"},
+		Directions: []model.RecipeDirection{{Steps: []string{"Toast the bread."}}},
+	}
+
+	diff := Diff(recipe, recipe)
+	if len(diff.ChangedFields) != 0 || len(diff.IngredientChanges) != 0 || len(diff.DirectionChanges) != 0 {
+		t.Errorf("Diff() of identical recipes = %+v, want no changes", diff)
+	}
+}
@@ -0,0 +1,237 @@
+// Package recipeduplicate finds recipes that are likely copies of each other,
+// e.g. the same page scraped by several members of a circle.
+package recipeduplicate
+
+import (
+	"cmp"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+const (
+	// TitleThreshold is the minimum similarity of normalized titles for them
+	// to count as the same.
+	TitleThreshold = 0.8
+	// IngredientThreshold is the minimum share of ingredients two recipes have
+	// in common for their ingredients to count as the same.
+	IngredientThreshold = 0.6
+	// looseTitleThreshold is the title similarity that is enough when nearly
+	// all of the ingredients are the same, e.g. "Grandma's Banana Bread" and
+	// "Banana Bread".
+	looseTitleThreshold = 0.5
+	// strictIngredientThreshold is the ingredient overlap that allows the
+	// looser title threshold.
+	strictIngredientThreshold = 0.9
+)
+
+var nonWordRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// fillerWords do not change which dish a title is about.
+var fillerWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "best": {}, "classic": {}, "easy": {},
+	"ever": {}, "homemade": {}, "my": {}, "perfect": {}, "quick": {},
+	"recipe": {}, "simple": {}, "the": {}, "ultimate": {},
+}
+
+// trackingParams are query parameters that do not change the page a url
+// points to.
+var trackingParams = []string{"utm_", "fbclid", "gclid", "mc_cid", "mc_eid", "ref"}
+
+// NormalizeTitle lowercases a title and drops punctuation and filler words.
+func NormalizeTitle(title string) string {
+	words := strings.Fields(nonWordRegex.ReplaceAllString(strings.ToLower(title), " "))
+	words = slices.DeleteFunc(words, func(word string) bool {
+		_, ok := fillerWords[word]
+		return ok
+	})
+	return strings.Join(words, " ")
+}
+
+// NormalizeCitation reduces a citation url to the page it points to, ignoring
+// the scheme, a www. prefix, trailing slashes, fragments and tracking
+// parameters. Citations that are not urls are only trimmed and lowercased.
+func NormalizeCitation(citation string) string {
+	citation = strings.TrimSpace(citation)
+	u, err := url.Parse(citation)
+	if err != nil || u.Host == "" {
+		return strings.ToLower(citation)
+	}
+
+	query := u.Query()
+	for key := range query {
+		for _, param := range trackingParams {
+			if key == param || strings.HasSuffix(param, "_") && strings.HasPrefix(key, param) {
+				query.Del(key)
+			}
+		}
+	}
+
+	normalized := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.") + strings.TrimRight(u.EscapedPath(), "/")
+	if encoded := query.Encode(); encoded != "" {
+		normalized += "?" + encoded
+	}
+	return normalized
+}
+
+// Compare scores how likely b is a copy of a. The returned duplicate is for b
+// and reports whether the recipes are likely duplicates.
+func Compare(a, b model.Recipe) (model.RecipeDuplicate, bool) {
+	duplicate := model.RecipeDuplicate{Recipe: b, Reasons: []string{}}
+
+	if a.Citation != "" && b.Citation != "" && NormalizeCitation(a.Citation) == NormalizeCitation(b.Citation) {
+		duplicate.Similarity = 1
+		duplicate.Reasons = append(duplicate.Reasons, model.RecipeDuplicateReason_Citation)
+	}
+
+	titleSimilarity := ingredientcatalog.Similarity(NormalizeTitle(a.Title), NormalizeTitle(b.Title))
+	if titleSimilarity >= TitleThreshold {
+		duplicate.Reasons = append(duplicate.Reasons, model.RecipeDuplicateReason_Title)
+	}
+
+	aIngredients, bIngredients := ingredientSet(a), ingredientSet(b)
+	overlap := ingredientOverlap(aIngredients, bIngredients)
+	if overlap >= IngredientThreshold {
+		duplicate.Reasons = append(duplicate.Reasons, model.RecipeDuplicateReason_Ingredients)
+	}
+
+	// a recipe without ingredients can only be compared by its title
+	similarity := titleSimilarity
+	if len(aIngredients) > 0 && len(bIngredients) > 0 {
+		similarity = (titleSimilarity + overlap) / 2
+	}
+	duplicate.Similarity = max(duplicate.Similarity, math.Round(similarity*100)/100)
+
+	switch {
+	case slices.Contains(duplicate.Reasons, model.RecipeDuplicateReason_Citation):
+		return duplicate, true
+	case titleSimilarity >= TitleThreshold && (overlap >= IngredientThreshold || len(aIngredients) == 0 || len(bIngredients) == 0):
+		return duplicate, true
+	case titleSimilarity >= looseTitleThreshold && overlap >= strictIngredientThreshold:
+		return duplicate, true
+	}
+	return duplicate, false
+}
+
+// Find returns the candidates that are likely duplicates of the recipe, most
+// similar first. The recipe itself is skipped when it is among the
+// candidates.
+func Find(recipe model.Recipe, candidates []model.Recipe) []model.RecipeDuplicate {
+	duplicates := []model.RecipeDuplicate{}
+	for _, candidate := range candidates {
+		if recipe.Id.RecipeId != 0 && candidate.Id == recipe.Id {
+			continue
+		}
+		if duplicate, ok := Compare(recipe, candidate); ok {
+			duplicates = append(duplicates, duplicate)
+		}
+	}
+	sortDuplicates(duplicates)
+	return duplicates
+}
+
+// Group sorts the recipes of a collection into groups of likely duplicates.
+// Each group is led by its oldest recipe and every other recipe is compared
+// against the leaders only, so a recipe joins at most one group. Recipes
+// without duplicates are left out.
+func Group(recipes []model.Recipe) []model.RecipeDuplicateGroup {
+	recipes = slices.Clone(recipes)
+	slices.SortFunc(recipes, func(a, b model.Recipe) int {
+		return cmp.Compare(a.Id.RecipeId, b.Id.RecipeId)
+	})
+
+	groups := []model.RecipeDuplicateGroup{}
+	for _, recipe := range recipes {
+		best, bestSimilarity := -1, 0.0
+		var bestDuplicate model.RecipeDuplicate
+		for i, group := range groups {
+			duplicate, ok := Compare(group.Recipe, recipe)
+			if ok && duplicate.Similarity > bestSimilarity {
+				best, bestSimilarity, bestDuplicate = i, duplicate.Similarity, duplicate
+			}
+		}
+		if best < 0 {
+			groups = append(groups, model.RecipeDuplicateGroup{Recipe: recipe})
+			continue
+		}
+		groups[best].Duplicates = append(groups[best].Duplicates, bestDuplicate)
+	}
+
+	groups = slices.DeleteFunc(groups, func(group model.RecipeDuplicateGroup) bool {
+		return len(group.Duplicates) == 0
+	})
+	for _, group := range groups {
+		sortDuplicates(group.Duplicates)
+	}
+	return groups
+}
+
+// ingredientSet returns the canonical names of the ingredients of a recipe.
+func ingredientSet(recipe model.Recipe) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, group := range recipe.IngredientGroups {
+		for _, ingredient := range group.RecipeIngredients {
+			if name := ingredientcatalog.Canonical(ingredient.Title); name != "" {
+				set[name] = struct{}{}
+			}
+		}
+	}
+	return set
+}
+
+// ingredientOverlap returns the Jaccard index of two ingredient sets. Names
+// match when they are similar enough or when one ends with the other, so
+// "flour" matches "all purpose flour".
+func ingredientOverlap(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	unmatched := make([]string, 0, len(b))
+	for name := range b {
+		unmatched = append(unmatched, name)
+	}
+	slices.Sort(unmatched)
+
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	shared := 0
+	for _, name := range names {
+		i := slices.IndexFunc(unmatched, func(other string) bool {
+			return sameIngredient(name, other)
+		})
+		if i >= 0 {
+			unmatched = slices.Delete(unmatched, i, i+1)
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// sameIngredient reports whether two canonical ingredient names are likely the
+// same ingredient.
+func sameIngredient(a, b string) bool {
+	if a == b || ingredientcatalog.Similarity(a, b) >= ingredientcatalog.MatchThreshold {
+		return true
+	}
+	return strings.HasSuffix(a, " "+b) || strings.HasSuffix(b, " "+a)
+}
+
+// sortDuplicates sorts duplicates by similarity and then by the oldest
+// recipe.
+func sortDuplicates(duplicates []model.RecipeDuplicate) {
+	slices.SortStableFunc(duplicates, func(a, b model.RecipeDuplicate) int {
+		if c := cmp.Compare(b.Similarity, a.Similarity); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Recipe.Id.RecipeId, b.Recipe.Id.RecipeId)
+	})
+}
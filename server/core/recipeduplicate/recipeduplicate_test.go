@@ -0,0 +1,119 @@
+package recipeduplicate
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+func recipe(id int64, title, citation string, ingredients ...string) model.Recipe {
+	group := model.IngredientGroup{}
+	for _, ingredient := range ingredients {
+		group.RecipeIngredients = append(group.RecipeIngredients, model.RecipeIngredient{Title: ingredient})
+	}
+	return model.Recipe{
+		Id:               model.RecipeId{RecipeId: id},
+		Title:            title,
+		Citation:         citation,
+		IngredientGroups: []model.IngredientGroup{group},
+	}
+}
+
+func TestNormalizeCitation(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"https://www.example.com/banana-bread/", "http://example.com/banana-bread"},
+		{"https://example.com/banana-bread?utm_source=pinterest#recipe", "https://example.com/banana-bread"},
+		{"https://example.com/recipe?id=4&fbclid=abc", "https://EXAMPLE.com/recipe?id=4"},
+	}
+
+	for _, tt := range tests {
+		if a, b := NormalizeCitation(tt.a), NormalizeCitation(tt.b); a != b {
+			t.Errorf("NormalizeCitation(%q) = %q, NormalizeCitation(%q) = %q, want equal", tt.a, a, tt.b, b)
+		}
+	}
+
+	if NormalizeCitation("https://example.com/recipe?id=4") == NormalizeCitation("https://example.com/recipe?id=5") {
+		t.Errorf("NormalizeCitation() ignored a query parameter that selects the page")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	bananaBread := recipe(1, "Banana Bread", "https://example.com/banana-bread", "ripe bananas", "all-purpose flour", "sugar", "butter", "eggs", "baking soda")
+
+	tests := []struct {
+		name    string
+		other   model.Recipe
+		want    bool
+		reasons []string
+	}{
+		{
+			name:    "same citation",
+			other:   recipe(2, "Moist Loaf", "http://www.example.com/banana-bread/?utm_medium=social"),
+			want:    true,
+			reasons: []string{model.RecipeDuplicateReason_Citation},
+		},
+		{
+			name:    "similar title and ingredients",
+			other:   recipe(3, "The Best Banana Bread Recipe", "", "bananas", "flour", "granulated sugar", "unsalted butter", "large eggs", "baking soda", "salt"),
+			want:    true,
+			reasons: []string{model.RecipeDuplicateReason_Title, model.RecipeDuplicateReason_Ingredients},
+		},
+		{
+			name:    "same ingredients, looser title",
+			other:   recipe(4, "Grandma's Banana Bread", "", "ripe bananas", "all-purpose flour", "sugar", "butter", "eggs", "baking soda"),
+			want:    true,
+			reasons: []string{model.RecipeDuplicateReason_Ingredients},
+		},
+		{
+			name:    "similar title, different ingredients",
+			other:   recipe(5, "Banana Bread", "", "bananas", "almond flour", "maple syrup", "coconut oil", "walnuts", "chia seeds"),
+			want:    false,
+			reasons: []string{model.RecipeDuplicateReason_Title},
+		},
+		{
+			name:    "different recipe",
+			other:   recipe(6, "Chocolate Chip Cookies", "", "flour", "sugar", "butter", "eggs", "chocolate chips"),
+			want:    false,
+			reasons: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			duplicate, ok := Compare(bananaBread, tt.other)
+			if ok != tt.want {
+				t.Errorf("Compare() = %v (similarity %v), want %v", ok, duplicate.Similarity, tt.want)
+			}
+			if !slices.Equal(duplicate.Reasons, tt.reasons) {
+				t.Errorf("Compare() reasons = %v, want %v", duplicate.Reasons, tt.reasons)
+			}
+		})
+	}
+}
+
+func TestGroup(t *testing.T) {
+	recipes := []model.Recipe{
+		recipe(3, "Pancakes", "https://example.com/pancakes?utm_source=a"),
+		recipe(1, "Fluffy Pancakes", "https://example.com/pancakes"),
+		recipe(2, "Waffles", "https://example.com/waffles"),
+		recipe(4, "Easy Fluffy Pancakes Recipe", "https://other.example.com/pancakes"),
+	}
+
+	groups := Group(recipes)
+	if len(groups) != 1 {
+		t.Fatalf("Group() returned %d groups, want 1", len(groups))
+	}
+	if groups[0].Recipe.Id.RecipeId != 1 {
+		t.Errorf("Group() leader = %d, want the oldest recipe 1", groups[0].Recipe.Id.RecipeId)
+	}
+	ids := []int64{}
+	for _, duplicate := range groups[0].Duplicates {
+		ids = append(ids, duplicate.Recipe.Id.RecipeId)
+	}
+	if !slices.Equal(ids, []int64{3, 4}) {
+		t.Errorf("Group() duplicates = %v, want [3 4]", ids)
+	}
+}
@@ -0,0 +1,298 @@
+// Package recipeexport renders recipes as schema.org JSON-LD, Markdown or
+// self-contained printable HTML.
+package recipeexport
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/schemaorgrecipe"
+)
+
+// Format is a recipe export format.
+type Format string
+
+const (
+	FormatJSONLD   Format = "jsonld"
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+)
+
+// ParseFormat parses an export format. The empty string defaults to JSON-LD.
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "jsonld", "json-ld", "json":
+		return FormatJSONLD, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "html":
+		return FormatHTML, nil
+	}
+	return "", fmt.Errorf("unsupported export format %q", s)
+}
+
+// ContentType returns the content type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	default:
+		return "application/ld+json"
+	}
+}
+
+// Extension returns the file extension of the format, without the dot.
+func (f Format) Extension() string {
+	switch f {
+	case FormatMarkdown:
+		return "md"
+	case FormatHTML:
+		return "html"
+	default:
+		return "jsonld"
+	}
+}
+
+// Image is an image to inline into an HTML export.
+type Image struct {
+	ContentType string
+	Data        []byte
+}
+
+// Render renders the recipe in the format. The image is only used by the HTML
+// format and may be nil.
+func Render(recipe model.Recipe, format Format, image *Image) ([]byte, error) {
+	switch format {
+	case FormatJSONLD:
+		return JSONLD(recipe)
+	case FormatMarkdown:
+		return Markdown(recipe), nil
+	case FormatHTML:
+		return HTML(recipe, image)
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+// JSONLD renders the recipe as a schema.org Recipe JSON-LD document.
+func JSONLD(recipe model.Recipe) ([]byte, error) {
+	return json.MarshalIndent(schemaorgrecipe.ToSchemaOrgRecipe(recipe), "", "  ")
+}
+
+// Markdown renders the recipe as Markdown.
+func Markdown(recipe model.Recipe) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "# %s\n\n", recipe.Title)
+	if recipe.ImageURI != "" {
+		fmt.Fprintf(&b, "![%s](%s)\n\n", recipe.Title, recipe.ImageURI)
+	}
+	if recipe.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", recipe.Description)
+	}
+	if details := details(recipe); len(details) > 0 {
+		for _, d := range details {
+			fmt.Fprintf(&b, "- **%s:** %s\n", d.Label, d.Value)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(recipe.IngredientGroups) > 0 {
+		b.WriteString("## Ingredients\n\n")
+		for _, group := range recipe.IngredientGroups {
+			if group.Title != "" {
+				fmt.Fprintf(&b, "### %s\n\n", group.Title)
+			}
+			for _, ingredient := range group.RecipeIngredients {
+				fmt.Fprintf(&b, "- %s\n", ingredientText(ingredient))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(recipe.Directions) > 0 {
+		b.WriteString("## Directions\n\n")
+		for _, direction := range recipe.Directions {
+			if direction.Title != "" {
+				fmt.Fprintf(&b, "### %s\n\n", direction.Title)
+			}
+			for i, step := range direction.Steps {
+				fmt.Fprintf(&b, "%d. %s\n", i+1, step)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if recipe.Citation != "" {
+		fmt.Fprintf(&b, "Source: %s\n", recipe.Citation)
+	}
+
+	return bytes.TrimRight(b.Bytes(), "\n")
+}
+
+// HTML renders the recipe as a self-contained printable HTML page. The image,
+// when given, is inlined as a data URI so the page has no external references.
+func HTML(recipe model.Recipe, image *Image) ([]byte, error) {
+	data := htmlData{
+		Recipe:  recipe,
+		Details: details(recipe),
+	}
+	for _, group := range recipe.IngredientGroups {
+		g := htmlIngredientGroup{Title: group.Title}
+		for _, ingredient := range group.RecipeIngredients {
+			g.Ingredients = append(g.Ingredients, ingredientText(ingredient))
+		}
+		data.IngredientGroups = append(data.IngredientGroups, g)
+	}
+	if image != nil && len(image.Data) > 0 {
+		data.ImageURI = template.URL(fmt.Sprintf("data:%s;base64,%s", image.ContentType, base64.StdEncoding.EncodeToString(image.Data)))
+	}
+
+	var b bytes.Buffer
+	if err := htmlTemplate.Execute(&b, data); err != nil {
+		return nil, fmt.Errorf("unable to render recipe html: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+var fileNameRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// FileName returns a file name for the exported recipe, e.g.
+// "12-banana-bread.md".
+func FileName(recipe model.Recipe, format Format) string {
+	slug := strings.Trim(fileNameRegex.ReplaceAllString(strings.ToLower(recipe.Title), "-"), "-")
+	if slug == "" {
+		slug = "recipe"
+	}
+	return fmt.Sprintf("%d-%s.%s", recipe.Id.RecipeId, slug, format.Extension())
+}
+
+type detail struct {
+	Label string
+	Value string
+}
+
+// details returns the labeled summary details of the recipe that are set.
+func details(recipe model.Recipe) []detail {
+	var out []detail
+	add := func(label, value string) {
+		if value != "" {
+			out = append(out, detail{Label: label, Value: value})
+		}
+	}
+	add("Prep time", formatDuration(recipe.PrepDuration))
+	add("Cook time", formatDuration(recipe.CookDuration))
+	add("Total time", formatDuration(recipe.TotalDuration))
+	add("Yield", recipe.YieldAmount)
+	add("Cooking method", recipe.CookingMethod)
+	add("Categories", strings.Join(recipe.Categories, ", "))
+	add("Cuisines", strings.Join(recipe.Cuisines, ", "))
+	return out
+}
+
+// formatDuration formats a duration as e.g. "1 hr 15 min".
+func formatDuration(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%d hr %d min", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%d hr", hours)
+	}
+	return fmt.Sprintf("%d min", minutes)
+}
+
+func ingredientText(ingredient model.RecipeIngredient) string {
+	text := schemaorgrecipe.IngredientText(ingredient)
+	if ingredient.Optional {
+		text += " (optional)"
+	}
+	return text
+}
+
+type htmlIngredientGroup struct {
+	Title       string
+	Ingredients []string
+}
+
+type htmlData struct {
+	Recipe           model.Recipe
+	Details          []detail
+	IngredientGroups []htmlIngredientGroup
+	ImageURI         template.URL
+}
+
+var htmlTemplate = template.Must(template.New("recipe").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Recipe.Title}}</title>
+<style>
+body { font-family: Georgia, serif; max-width: 48rem; margin: 2rem auto; padding: 0 1rem; color: #222; }
+img { max-width: 100%; max-height: 24rem; object-fit: cover; }
+dl { display: grid; grid-template-columns: max-content auto; gap: 0.25rem 1rem; }
+dt { font-weight: bold; }
+dd { margin: 0; }
+li { margin-bottom: 0.25rem; }
+@media print { body { margin: 0; max-width: none; } }
+</style>
+</head>
+<body>
+<h1>{{.Recipe.Title}}</h1>
+{{- if .ImageURI}}
+<img src="{{.ImageURI}}" alt="{{.Recipe.Title}}">
+{{- end}}
+{{- if .Recipe.Description}}
+<p>{{.Recipe.Description}}</p>
+{{- end}}
+{{- if .Details}}
+<dl>
+{{- range .Details}}
+<dt>{{.Label}}</dt><dd>{{.Value}}</dd>
+{{- end}}
+</dl>
+{{- end}}
+{{- if .IngredientGroups}}
+<h2>Ingredients</h2>
+{{- range .IngredientGroups}}
+{{- if .Title}}
+<h3>{{.Title}}</h3>
+{{- end}}
+<ul>
+{{- range .Ingredients}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- end}}
+{{- if .Recipe.Directions}}
+<h2>Directions</h2>
+{{- range .Recipe.Directions}}
+{{- if .Title}}
+<h3>{{.Title}}</h3>
+{{- end}}
+<ol>
+{{- range .Steps}}
+<li>{{.}}</li>
+{{- end}}
+</ol>
+{{- end}}
+{{- end}}
+{{- if .Recipe.Citation}}
+<p>Source: {{.Recipe.Citation}}</p>
+{{- end}}
+</body>
+</html>
+`))
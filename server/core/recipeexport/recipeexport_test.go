@@ -0,0 +1,112 @@
+package recipeexport
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+func testRecipe() model.Recipe {
+	return model.Recipe{
+		Id:           model.RecipeId{RecipeId: 12},
+		Title:        "Banana Bread <Best>",
+		Description:  "Moist and sweet.",
+		ImageURI:     "https://example.com/bread.jpg",
+		PrepDuration: 15 * time.Minute,
+		CookDuration: time.Hour + 5*time.Minute,
+		YieldAmount:  "1 loaf",
+		IngredientGroups: []model.IngredientGroup{{
+			RecipeIngredients: []model.RecipeIngredient{
+				{Title: "flour", MeasurementAmount: 2, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP},
+				{Title: "bananas", MeasurementAmount: 3},
+				{Title: "walnuts", MeasurementAmount: 0.5, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP, Optional: true},
+			},
+		}},
+		Directions: []model.RecipeDirection{{
+			Steps: []string{"Mash the bananas.", "Mix and bake."},
+		}},
+		Citation: "https://example.com/banana-bread",
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for in, want := range map[string]Format{"": FormatJSONLD, "JSON-LD": FormatJSONLD, "md": FormatMarkdown, "html": FormatHTML} {
+		got, err := ParseFormat(in)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("pdf"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
+
+func TestJSONLD(t *testing.T) {
+	out, err := JSONLD(testRecipe())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if doc["@type"] != "Recipe" || doc["name"] != "Banana Bread <Best>" {
+		t.Errorf("unexpected document: %s", out)
+	}
+	ingredients, _ := doc["recipeIngredient"].([]interface{})
+	if len(ingredients) != 3 || ingredients[0] != "2 cups flour" {
+		t.Errorf("unexpected ingredients: %v", doc["recipeIngredient"])
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	out := string(Markdown(testRecipe()))
+
+	for _, want := range []string{
+		"# Banana Bread <Best>\n",
+		"![Banana Bread <Best>](https://example.com/bread.jpg)",
+		"- **Cook time:** 1 hr 5 min\n",
+		"## Ingredients\n\n- 2 cups flour\n- 3 bananas\n- 0.5 cup walnuts (optional)\n",
+		"## Directions\n\n1. Mash the bananas.\n2. Mix and bake.\n",
+		"Source: https://example.com/banana-bread",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestHTML(t *testing.T) {
+	out, err := HTML(testRecipe(), &Image{ContentType: "image/jpeg", Data: []byte("jpeg")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	html := string(out)
+
+	if !strings.Contains(html, `<img src="data:image/jpeg;base64,anBlZw=="`) {
+		t.Errorf("image not inlined:\n%s", html)
+	}
+	if strings.Contains(html, "https://example.com/bread.jpg") {
+		t.Errorf("html references the remote image:\n%s", html)
+	}
+	if !strings.Contains(html, "<h1>Banana Bread &lt;Best&gt;</h1>") {
+		t.Errorf("title not escaped:\n%s", html)
+	}
+	if !strings.Contains(html, "<li>0.5 cup walnuts (optional)</li>") {
+		t.Errorf("ingredient missing:\n%s", html)
+	}
+}
+
+func TestFileName(t *testing.T) {
+	if got := FileName(testRecipe(), FormatMarkdown); got != "12-banana-bread-best.md" {
+		t.Errorf("FileName = %q", got)
+	}
+	if got := FileName(model.Recipe{Id: model.RecipeId{RecipeId: 3}}, FormatJSONLD); got != "3-recipe.jsonld" {
+		t.Errorf("FileName = %q", got)
+	}
+}
@@ -0,0 +1,56 @@
+package recipeextract
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// findJSONLDRecipe finds the first recipe in the JSON-LD scripts of the page.
+// Recipes may be nested in arrays, @graph or mainEntity.
+func findJSONLDRecipe(doc *goquery.Document) map[string]interface{} {
+	var found map[string]interface{}
+	doc.Find(`script[type="application/ld+json"]`).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(cleanJSONLD(s.Text())), &data); err != nil {
+			return true
+		}
+		found = findRecipeObject(data)
+		return found == nil
+	})
+	return found
+}
+
+func findRecipeObject(v interface{}) map[string]interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if isRecipeType(t["@type"]) {
+			return t
+		}
+		for _, key := range []string{"@graph", "mainEntity", "mainEntityOfPage"} {
+			if found := findRecipeObject(t[key]); found != nil {
+				return found
+			}
+		}
+	case []interface{}:
+		for _, item := range t {
+			if found := findRecipeObject(item); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// cleanJSONLD strips the comment and CDATA wrappers some pages put around
+// their JSON-LD.
+func cleanJSONLD(text string) string {
+	text = strings.TrimSpace(text)
+	for _, wrapper := range [][2]string{{"<!--", "-->"}, {"//<![CDATA[", "//]]>"}, {"<![CDATA[", "]]>"}} {
+		if strings.HasPrefix(text, wrapper[0]) && strings.HasSuffix(text, wrapper[1]) {
+			text = strings.TrimSpace(text[len(wrapper[0]) : len(text)-len(wrapper[1])])
+		}
+	}
+	return text
+}
@@ -0,0 +1,137 @@
+package recipeextract
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// markup describes how items and their properties are written in a
+// structured data syntax embedded in HTML attributes.
+type markup struct {
+	// scope is the attribute that starts an item.
+	scope string
+	// typ is the attribute that holds the type of an item.
+	typ string
+	// prop is the attribute that names a property.
+	prop string
+}
+
+var (
+	microdata = markup{scope: "itemscope", typ: "itemtype", prop: "itemprop"}
+	rdfa      = markup{scope: "typeof", typ: "typeof", prop: "property"}
+)
+
+// findMicrodataRecipe finds the first microdata recipe on the page.
+func findMicrodataRecipe(doc *goquery.Document) map[string]interface{} {
+	return microdata.findRecipe(doc)
+}
+
+// findRDFaRecipe finds the first RDFa recipe on the page.
+func findRDFaRecipe(doc *goquery.Document) map[string]interface{} {
+	return rdfa.findRecipe(doc)
+}
+
+func (m markup) findRecipe(doc *goquery.Document) map[string]interface{} {
+	var found map[string]interface{}
+	doc.Find("[" + m.scope + "]").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		if !isRecipeType(s.AttrOr(m.typ, "")) {
+			return true
+		}
+		found = m.item(s)
+		return false
+	})
+	return found
+}
+
+// item collects the properties of the item started by s. Properties of
+// nested items belong to those items.
+func (m markup) item(s *goquery.Selection) map[string]interface{} {
+	item := map[string]interface{}{}
+	if typ := strings.Fields(s.AttrOr(m.typ, "")); len(typ) > 0 {
+		item["@type"] = localName(typ[0])
+	}
+
+	var walk func(int, *goquery.Selection)
+	walk = func(_ int, child *goquery.Selection) {
+		_, isScope := child.Attr(m.scope)
+		props, isProp := child.Attr(m.prop)
+		if isProp {
+			var value interface{}
+			if isScope {
+				value = m.item(child)
+			} else {
+				value = propertyValue(child)
+			}
+			for _, prop := range strings.Fields(props) {
+				addProperty(item, localName(prop), value)
+			}
+		}
+		if isScope {
+			return
+		}
+		child.Children().Each(walk)
+	}
+	s.Children().Each(walk)
+
+	return item
+}
+
+// addProperty adds a value to a property, turning it into a list when the
+// property is repeated.
+func addProperty(item map[string]interface{}, name string, value interface{}) {
+	if text, ok := value.(string); ok && text == "" {
+		return
+	}
+	existing, ok := item[name]
+	if !ok {
+		item[name] = value
+		return
+	}
+	if list, ok := existing.([]interface{}); ok {
+		item[name] = append(list, value)
+		return
+	}
+	item[name] = []interface{}{existing, value}
+}
+
+// propertyValue returns the value of a property element, following the
+// microdata rules for which attribute holds the value. RDFa content
+// attributes take precedence.
+func propertyValue(s *goquery.Selection) string {
+	if content, ok := s.Attr("content"); ok {
+		return strings.TrimSpace(content)
+	}
+	var attr string
+	switch goquery.NodeName(s) {
+	case "img", "audio", "video", "source", "embed", "iframe", "track":
+		attr = "src"
+	case "a", "area", "link":
+		attr = "href"
+	case "object":
+		attr = "data"
+	case "time":
+		attr = "datetime"
+	case "data", "meter":
+		attr = "value"
+	}
+	if attr != "" {
+		if value, ok := s.Attr(attr); ok {
+			return strings.TrimSpace(value)
+		}
+	}
+	return collapseSpace(s.Text())
+}
+
+// collapseSpace collapses the runs of spaces in each line of the text and
+// drops empty lines. Line breaks are kept so a block of instructions can be
+// split into steps.
+func collapseSpace(text string) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,123 @@
+// Package recipeextract extracts schema.org recipes from the structured data
+// of HTML pages: JSON-LD, microdata and RDFa.
+package recipeextract
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/schemaorgrecipe"
+)
+
+// Extract returns the first schema.org recipe found in the structured data of
+// the page. JSON-LD is tried first, then microdata, then RDFa. False is
+// returned when the page has no recipe.
+func Extract(html []byte) (model.Recipe, bool) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(html))
+	if err != nil {
+		return model.Recipe{}, false
+	}
+
+	for _, find := range []func(*goquery.Document) map[string]interface{}{
+		findJSONLDRecipe,
+		findMicrodataRecipe,
+		findRDFaRecipe,
+	} {
+		item := find(doc)
+		if item == nil {
+			continue
+		}
+		recipe, ok := toRecipe(item)
+		if ok {
+			return recipe, true
+		}
+	}
+	return model.Recipe{}, false
+}
+
+// Complete reports whether the recipe has the fields a scraped recipe needs:
+// a title, ingredients and directions.
+func Complete(recipe model.Recipe) bool {
+	if strings.TrimSpace(recipe.Title) == "" {
+		return false
+	}
+	hasIngredients := false
+	for _, group := range recipe.IngredientGroups {
+		if len(group.RecipeIngredients) > 0 {
+			hasIngredients = true
+			break
+		}
+	}
+	hasSteps := false
+	for _, direction := range recipe.Directions {
+		if len(direction.Steps) > 0 {
+			hasSteps = true
+			break
+		}
+	}
+	return hasIngredients && hasSteps
+}
+
+// toRecipe converts a schema.org recipe object to a recipe.
+func toRecipe(item map[string]interface{}) (model.Recipe, bool) {
+	// older markup uses the superseded ingredients property
+	if _, ok := item["recipeIngredient"]; !ok {
+		if ingredients, ok := item["ingredients"]; ok {
+			item["recipeIngredient"] = ingredients
+		}
+	}
+
+	b, err := json.Marshal(item)
+	if err != nil {
+		return model.Recipe{}, false
+	}
+	var schemaRecipe schemaorgrecipe.SchemaOrgRecipe
+	if err := json.Unmarshal(b, &schemaRecipe); err != nil {
+		return model.Recipe{}, false
+	}
+
+	recipe := schemaorgrecipe.ToModelRecipe(schemaRecipe)
+	recipe.Title = strings.Join(strings.Fields(recipe.Title), " ")
+	recipe.Description = strings.Join(strings.Fields(recipe.Description), " ")
+	if recipe.Title == "" {
+		return model.Recipe{}, false
+	}
+	// the citation fallback of ToModelRecipe is the schema.org context
+	if recipe.Citation == schemaorgrecipe.AsString(schemaRecipe.Context) {
+		recipe.Citation = ""
+	}
+	return recipe, true
+}
+
+// isRecipeType reports whether a schema.org type, which may be a list of
+// types or a prefixed or full URL, is Recipe.
+func isRecipeType(v interface{}) bool {
+	switch t := v.(type) {
+	case string:
+		for _, typ := range strings.Fields(t) {
+			if localName(typ) == "Recipe" {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, typ := range t {
+			if isRecipeType(typ) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// localName strips the vocabulary from a schema.org name such as
+// "https://schema.org/Recipe" or "schema:name".
+func localName(name string) string {
+	name = strings.TrimSpace(name)
+	if i := strings.LastIndexAny(name, "/:#"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
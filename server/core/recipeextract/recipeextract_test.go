@@ -0,0 +1,163 @@
+package recipeextract
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("unable to read fixture: %v", err)
+	}
+	return data
+}
+
+func ingredientNames(recipe model.Recipe) []string {
+	var names []string
+	for _, group := range recipe.IngredientGroups {
+		for _, ingredient := range group.RecipeIngredients {
+			names = append(names, ingredient.Title)
+		}
+	}
+	return names
+}
+
+func TestExtractJSONLDGraph(t *testing.T) {
+	recipe, ok := Extract(readFixture(t, "jsonld_graph.html"))
+	if !ok {
+		t.Fatal("expected a recipe")
+	}
+	if recipe.Title != "Lemon Bars" {
+		t.Errorf("unexpected title: %q", recipe.Title)
+	}
+	if recipe.ImageURI != "https://example.com/images/lemon-bars.jpg" {
+		t.Errorf("unexpected image: %q", recipe.ImageURI)
+	}
+	if recipe.PrepDuration != 20*time.Minute || recipe.CookDuration != 45*time.Minute {
+		t.Errorf("unexpected durations: %v %v", recipe.PrepDuration, recipe.CookDuration)
+	}
+	if recipe.YieldAmount != "16" {
+		t.Errorf("unexpected yield: %q", recipe.YieldAmount)
+	}
+	if got := ingredientNames(recipe); !slices.Equal(got, []string{"flour", "butter", "eggs", "lemon juice"}) {
+		t.Errorf("unexpected ingredients: %v", got)
+	}
+	if len(recipe.Directions) != 1 || len(recipe.Directions[0].Steps) != 3 {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+	if recipe.Citation != "" {
+		t.Errorf("unexpected citation: %q", recipe.Citation)
+	}
+	if !Complete(recipe) {
+		t.Error("expected a complete recipe")
+	}
+}
+
+func TestExtractJSONLDSections(t *testing.T) {
+	recipe, ok := Extract(readFixture(t, "jsonld_sections.html"))
+	if !ok {
+		t.Fatal("expected a recipe")
+	}
+	if recipe.Title != "Layer Cake" {
+		t.Errorf("unexpected title: %q", recipe.Title)
+	}
+	if len(recipe.Directions) != 2 {
+		t.Fatalf("expected 2 sections, got %+v", recipe.Directions)
+	}
+	if recipe.Directions[0].Title != "Cake" || len(recipe.Directions[0].Steps) != 2 {
+		t.Errorf("unexpected first section: %+v", recipe.Directions[0])
+	}
+	if recipe.Directions[1].Title != "Frosting" || !slices.Equal(recipe.Directions[1].Steps, []string{"Whip the cream."}) {
+		t.Errorf("unexpected second section: %+v", recipe.Directions[1])
+	}
+	if !Complete(recipe) {
+		t.Error("expected a complete recipe")
+	}
+}
+
+func TestExtractMicrodata(t *testing.T) {
+	recipe, ok := Extract(readFixture(t, "microdata.html"))
+	if !ok {
+		t.Fatal("expected a recipe")
+	}
+	if recipe.Title != "Tomato Soup" {
+		t.Errorf("unexpected title: %q", recipe.Title)
+	}
+	if recipe.Description != "A simple tomato soup." {
+		t.Errorf("unexpected description: %q", recipe.Description)
+	}
+	if recipe.ImageURI != "https://example.com/images/tomato-soup.jpg" {
+		t.Errorf("unexpected image: %q", recipe.ImageURI)
+	}
+	if recipe.PrepDuration != 10*time.Minute || recipe.CookDuration != 30*time.Minute {
+		t.Errorf("unexpected durations: %v %v", recipe.PrepDuration, recipe.CookDuration)
+	}
+	if got := ingredientNames(recipe); len(got) != 3 {
+		t.Errorf("unexpected ingredients: %v", got)
+	}
+	want := []string{"Cook the onion in the oil.", "Add the tomatoes and simmer for 30 minutes."}
+	if len(recipe.Directions) != 1 || !slices.Equal(recipe.Directions[0].Steps, want) {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+	if !Complete(recipe) {
+		t.Error("expected a complete recipe")
+	}
+}
+
+func TestExtractRDFa(t *testing.T) {
+	recipe, ok := Extract(readFixture(t, "rdfa.html"))
+	if !ok {
+		t.Fatal("expected a recipe")
+	}
+	if recipe.Title != "Garlic Bread" {
+		t.Errorf("unexpected title: %q", recipe.Title)
+	}
+	if recipe.TotalDuration != 15*time.Minute {
+		t.Errorf("unexpected total duration: %v", recipe.TotalDuration)
+	}
+	if got := ingredientNames(recipe); !slices.Equal(got, []string{"baguette", "garlic", "butter"}) {
+		t.Errorf("unexpected ingredients: %v", got)
+	}
+	want := []string{"Mix the garlic and butter.", "Spread on the bread and bake for 10 minutes."}
+	if len(recipe.Directions) != 1 || !slices.Equal(recipe.Directions[0].Steps, want) {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+	if !Complete(recipe) {
+		t.Error("expected a complete recipe")
+	}
+}
+
+func TestExtractNoRecipe(t *testing.T) {
+	if _, ok := Extract(readFixture(t, "no_recipe.html")); ok {
+		t.Error("expected no recipe")
+	}
+}
+
+func TestComplete(t *testing.T) {
+	recipe := model.Recipe{
+		Title:            "Toast",
+		IngredientGroups: []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{{Title: "bread"}}}},
+		Directions:       []model.RecipeDirection{{Steps: []string{"Toast the bread."}}},
+	}
+	if !Complete(recipe) {
+		t.Error("expected a complete recipe")
+	}
+
+	missingSteps := recipe
+	missingSteps.Directions = nil
+	if Complete(missingSteps) {
+		t.Error("expected a recipe without directions to be incomplete")
+	}
+
+	missingTitle := recipe
+	missingTitle.Title = " "
+	if Complete(missingTitle) {
+		t.Error("expected a recipe without a title to be incomplete")
+	}
+}
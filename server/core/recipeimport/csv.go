@@ -0,0 +1,109 @@
+package recipeimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// CSV column names. The first row of a CSV import names the columns, in any
+// order; only title is required and unknown columns are ignored.
+//
+//   - title: the recipe title
+//   - description: the recipe description
+//   - ingredients: one ingredient per line, e.g. "1 1/2 cups flour"
+//   - directions: one step per line
+//   - yield: the yield, e.g. "4 servings"
+//   - prep_time, cook_time, total_time: minutes, or e.g. "1 hr 30 min"
+//   - categories, cuisines: comma separated lists
+//   - cooking_method: the cooking method, e.g. "Baking"
+//   - source: the URL or book the recipe is from
+//   - image_url: the URL of the recipe image
+//
+// In the ingredients and directions columns a line ending in a colon, such as
+// "For the glaze:", starts a new section.
+const (
+	CSVColumn_Title         = "title"
+	CSVColumn_Description   = "description"
+	CSVColumn_Ingredients   = "ingredients"
+	CSVColumn_Directions    = "directions"
+	CSVColumn_Yield         = "yield"
+	CSVColumn_PrepTime      = "prep_time"
+	CSVColumn_CookTime      = "cook_time"
+	CSVColumn_TotalTime     = "total_time"
+	CSVColumn_Categories    = "categories"
+	CSVColumn_Cuisines      = "cuisines"
+	CSVColumn_CookingMethod = "cooking_method"
+	CSVColumn_Source        = "source"
+	CSVColumn_ImageURL      = "image_url"
+)
+
+// ParseCSV parses a CSV file laid out as described by the CSVColumn
+// constants, one recipe per row.
+func ParseCSV(data []byte) ([]Entry, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read csv header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns[CSVColumn_Title]; !ok {
+		return nil, fmt.Errorf("csv has no %s column", CSVColumn_Title)
+	}
+
+	var entries []Entry
+	for row := 2; ; row++ {
+		record, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			entries = append(entries, Entry{Title: fmt.Sprintf("row %d", row), Err: fmt.Errorf("invalid csv row: %w", err)})
+			break
+		}
+
+		get := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		title := get(CSVColumn_Title)
+		if title == "" {
+			entries = append(entries, Entry{Title: fmt.Sprintf("row %d", row), Err: fmt.Errorf("row has no title")})
+			continue
+		}
+
+		entries = append(entries, Entry{
+			Title: title,
+			Recipe: model.Recipe{
+				Title:            title,
+				Description:      get(CSVColumn_Description),
+				IngredientGroups: ingredientGroups(splitLines(get(CSVColumn_Ingredients))),
+				Directions:       directions(splitLines(get(CSVColumn_Directions))),
+				YieldAmount:      get(CSVColumn_Yield),
+				PrepDuration:     parseDuration(get(CSVColumn_PrepTime)),
+				CookDuration:     parseDuration(get(CSVColumn_CookTime)),
+				TotalDuration:    parseDuration(get(CSVColumn_TotalTime)),
+				Categories:       splitList(get(CSVColumn_Categories)),
+				Cuisines:         splitList(get(CSVColumn_Cuisines)),
+				CookingMethod:    get(CSVColumn_CookingMethod),
+				Citation:         get(CSVColumn_Source),
+				ImageURI:         get(CSVColumn_ImageURL),
+			},
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,243 @@
+package recipeimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/schemaorgrecipe"
+)
+
+// mealieRecipe is a recipe in a Mealie JSON export.
+type mealieRecipe struct {
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	RecipeYield        string              `json:"recipeYield"`
+	PrepTime           string              `json:"prepTime"`
+	CookTime           string              `json:"cookTime"`
+	PerformTime        string              `json:"performTime"`
+	TotalTime          string              `json:"totalTime"`
+	OrgURL             string              `json:"orgURL"`
+	RecipeIngredient   []mealieIngredient  `json:"recipeIngredient"`
+	RecipeInstructions []mealieInstruction `json:"recipeInstructions"`
+	RecipeCategory     []mealieTag         `json:"recipeCategory"`
+	Tags               []mealieTag         `json:"tags"`
+}
+
+// mealieIngredient is an ingredient of a Mealie recipe. Older exports write
+// ingredients as plain strings.
+type mealieIngredient struct {
+	Title        string     `json:"title"`
+	Note         string     `json:"note"`
+	Display      string     `json:"display"`
+	OriginalText string     `json:"originalText"`
+	Quantity     float64    `json:"quantity"`
+	Unit         *mealieTag `json:"unit"`
+	Food         *mealieTag `json:"food"`
+}
+
+func (i *mealieIngredient) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*i = mealieIngredient{Note: text}
+		return nil
+	}
+	type alias mealieIngredient
+	return json.Unmarshal(data, (*alias)(i))
+}
+
+// text returns the ingredient as a single line.
+func (i mealieIngredient) text() string {
+	if i.OriginalText != "" {
+		return i.OriginalText
+	}
+	if i.Food == nil && i.Unit == nil {
+		if i.Display != "" {
+			return i.Display
+		}
+		return i.Note
+	}
+	var parts []string
+	if i.Quantity > 0 {
+		parts = append(parts, strconv.FormatFloat(i.Quantity, 'f', -1, 64))
+	}
+	if i.Unit != nil && i.Unit.Name != "" {
+		parts = append(parts, i.Unit.Name)
+	}
+	if i.Food != nil && i.Food.Name != "" {
+		parts = append(parts, i.Food.Name)
+	}
+	if i.Note != "" {
+		parts = append(parts, i.Note)
+	}
+	return strings.Join(parts, " ")
+}
+
+// mealieInstruction is a step of a Mealie recipe. A title starts a new
+// section.
+type mealieInstruction struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+type mealieTag struct {
+	Name string `json:"name"`
+}
+
+// ParseMealie parses a Mealie export: a recipe JSON object, an array of
+// recipes, or a zip archive of recipe JSON files with their images stored next
+// to them.
+func ParseMealie(data []byte) ([]Entry, error) {
+	if !isZip(data) {
+		return parseMealieJSON("recipe", data, nil)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read mealie archive: %w", err)
+	}
+
+	// images are stored as <recipe dir>/images/original.<ext>
+	images := map[string]*zip.File{}
+	for _, f := range archive.File {
+		if strings.HasPrefix(path.Base(f.Name), "original.") {
+			images[path.Dir(path.Dir(f.Name))] = f
+		}
+	}
+
+	var entries []Entry
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() || path.Ext(f.Name) != ".json" {
+			continue
+		}
+		name := strings.TrimSuffix(path.Base(f.Name), ".json")
+		content, err := readZipFile(f)
+		if err != nil {
+			entries = append(entries, Entry{Title: name, Err: fmt.Errorf("unable to read recipe: %w", err)})
+			continue
+		}
+		parsed, err := parseMealieJSON(name, content, images[path.Dir(f.Name)])
+		if err != nil {
+			entries = append(entries, Entry{Title: name, Err: err})
+			continue
+		}
+		entries = append(entries, parsed...)
+	}
+	return entries, nil
+}
+
+func parseMealieJSON(name string, data []byte, image *zip.File) ([]Entry, error) {
+	var recipes []mealieRecipe
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("[")) {
+		if err := json.Unmarshal(trimmed, &recipes); err != nil {
+			return nil, fmt.Errorf("invalid mealie json: %w", err)
+		}
+	} else {
+		var recipe mealieRecipe
+		if err := json.Unmarshal(trimmed, &recipe); err != nil {
+			return nil, fmt.Errorf("invalid mealie json: %w", err)
+		}
+		recipes = append(recipes, recipe)
+	}
+
+	entries := make([]Entry, len(recipes))
+	for i, m := range recipes {
+		entries[i] = mealieEntry(name, m)
+		if image != nil && len(recipes) == 1 && entries[i].Err == nil {
+			content, err := readZipFile(image)
+			if err != nil {
+				entries[i].Err = fmt.Errorf("unable to read image: %w", err)
+				continue
+			}
+			entries[i].Image = content
+		}
+	}
+	return entries, nil
+}
+
+func mealieEntry(name string, m mealieRecipe) Entry {
+	if strings.TrimSpace(m.Name) == "" {
+		return Entry{Title: name, Err: fmt.Errorf("recipe has no name")}
+	}
+
+	recipe := model.Recipe{
+		Title:         strings.TrimSpace(m.Name),
+		Description:   strings.TrimSpace(m.Description),
+		YieldAmount:   strings.TrimSpace(m.RecipeYield),
+		PrepDuration:  parseDuration(m.PrepTime),
+		CookDuration:  parseDuration(m.CookTime),
+		TotalDuration: parseDuration(m.TotalTime),
+		Citation:      m.OrgURL,
+	}
+	if recipe.CookDuration == 0 {
+		recipe.CookDuration = parseDuration(m.PerformTime)
+	}
+	for _, category := range m.RecipeCategory {
+		recipe.Categories = append(recipe.Categories, category.Name)
+	}
+	for _, tag := range m.Tags {
+		recipe.Categories = append(recipe.Categories, tag.Name)
+	}
+
+	current := model.IngredientGroup{}
+	for _, ingredient := range m.RecipeIngredient {
+		if ingredient.Title != "" && (len(current.RecipeIngredients) > 0 || current.Title != "") {
+			recipe.IngredientGroups = append(recipe.IngredientGroups, current)
+			current = model.IngredientGroup{}
+		}
+		if ingredient.Title != "" {
+			current.Title = ingredient.Title
+		}
+		if text := strings.TrimSpace(ingredient.text()); text != "" {
+			current.RecipeIngredients = append(current.RecipeIngredients, schemaorgrecipe.ParseRecipeIngredient(text))
+		}
+	}
+	if len(current.RecipeIngredients) > 0 || current.Title != "" {
+		recipe.IngredientGroups = append(recipe.IngredientGroups, current)
+	}
+
+	direction := model.RecipeDirection{}
+	for _, instruction := range m.RecipeInstructions {
+		if instruction.Title != "" && (len(direction.Steps) > 0 || direction.Title != "") {
+			recipe.Directions = append(recipe.Directions, direction)
+			direction = model.RecipeDirection{}
+		}
+		if instruction.Title != "" {
+			direction.Title = instruction.Title
+		}
+		if text := strings.TrimSpace(instruction.Text); text != "" {
+			direction.Steps = append(direction.Steps, text)
+		}
+	}
+	if len(direction.Steps) > 0 || direction.Title != "" {
+		recipe.Directions = append(recipe.Directions, direction)
+	}
+
+	return Entry{Title: recipe.Title, Recipe: recipe}
+}
+
+// readZipFile reads a file of an archive, which may be at most
+// maxRecipeFileSize once decompressed.
+func readZipFile(f *zip.File) ([]byte, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(io.LimitReader(r, maxRecipeFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRecipeFileSize {
+		return nil, fmt.Errorf("%s is larger than %d MB once decompressed", path.Base(f.Name), maxRecipeFileSize/1024/1024)
+	}
+	return data, nil
+}
@@ -0,0 +1,202 @@
+package recipeimport
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/schemaorgrecipe"
+)
+
+var (
+	mealMasterStartRegex     = regexp.MustCompile(`(?i)^(?:MMMMM|-----).*meal-master`)
+	mealMasterEndRegex       = regexp.MustCompile(`^(?:MMMMM|-----)\s*$`)
+	mealMasterSeparatorRegex = regexp.MustCompile(`^(?:MMMMM|-----)-*\s*([^-].*?)\s*-+\s*$`)
+	mealMasterHeaderRegex    = regexp.MustCompile(`(?i)^\s*(title|categories|yield|servings)\s*:\s*(.*)$`)
+	// an ingredient line has a 7 column amount, a 2 column unit and the
+	// ingredient from column 12
+	mealMasterIngredientRegex = regexp.MustCompile(`^([ 0-9./]{7}) ([ a-zA-Z]{2}) (\S.*)$`)
+)
+
+// mealMasterUnits maps the Meal-Master unit codes to unit names.
+var mealMasterUnits = map[string]string{
+	"x":  "",
+	"ea": "",
+	"c":  "cup",
+	"t":  "tsp",
+	"ts": "tsp",
+	"T":  "tbsp",
+	"tb": "tbsp",
+	"oz": "oz",
+	"lb": "lb",
+	"g":  "g",
+	"ml": "ml",
+	"l":  "l",
+	"fl": "fl oz",
+	"pt": "pint",
+	"qt": "quart",
+	"ga": "gallon",
+	"kg": "kg",
+	"mg": "mg",
+	"dl": "dl",
+	"cl": "cl",
+	"sm": "small",
+	"md": "medium",
+	"lg": "large",
+	"cn": "can",
+	"pk": "package",
+	"pn": "pinch",
+	"dr": "drop",
+	"ds": "dash",
+	"ct": "carton",
+	"bn": "bunch",
+	"sl": "slice",
+}
+
+// ParseMealMaster parses a Meal-Master text file, which may hold several
+// recipes.
+func ParseMealMaster(data []byte) ([]Entry, error) {
+	var (
+		entries []Entry
+		block   []string
+		inBlock bool
+	)
+	for _, line := range splitLines(string(data)) {
+		line = strings.TrimRight(line, " \t")
+		switch {
+		case mealMasterStartRegex.MatchString(line):
+			if inBlock {
+				entries = append(entries, parseMealMasterRecipe(block))
+			}
+			block, inBlock = nil, true
+		case inBlock && mealMasterEndRegex.MatchString(line):
+			entries = append(entries, parseMealMasterRecipe(block))
+			block, inBlock = nil, false
+		case inBlock:
+			block = append(block, line)
+		}
+	}
+	if inBlock {
+		entries = append(entries, parseMealMasterRecipe(block))
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no meal-master recipes found")
+	}
+	return entries, nil
+}
+
+func parseMealMasterRecipe(lines []string) Entry {
+	recipe := model.Recipe{}
+
+	i := 0
+	for ; i < len(lines); i++ {
+		m := mealMasterHeaderRegex.FindStringSubmatch(lines[i])
+		if m == nil {
+			if strings.TrimSpace(lines[i]) == "" && recipe.Title == "" {
+				continue
+			}
+			break
+		}
+		value := strings.TrimSpace(m[2])
+		switch strings.ToLower(m[1]) {
+		case "title":
+			recipe.Title = value
+		case "categories":
+			recipe.Categories = splitList(value)
+		case "yield", "servings":
+			recipe.YieldAmount = value
+		}
+	}
+	if recipe.Title == "" {
+		return Entry{Title: "recipe", Err: fmt.Errorf("recipe has no title")}
+	}
+
+	// ingredients follow the header until the first line that is not an
+	// ingredient, the rest are the directions
+	group := model.IngredientGroup{}
+	var ingredients []string
+	flush := func() {
+		for _, ingredient := range ingredients {
+			group.RecipeIngredients = append(group.RecipeIngredients, schemaorgrecipe.ParseRecipeIngredient(ingredient))
+		}
+		ingredients = nil
+		if len(group.RecipeIngredients) > 0 || group.Title != "" {
+			recipe.IngredientGroups = append(recipe.IngredientGroups, group)
+		}
+	}
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if m := mealMasterSeparatorRegex.FindStringSubmatch(line); m != nil {
+			flush()
+			group = model.IngredientGroup{Title: strings.TrimSpace(m[1])}
+			continue
+		}
+		parsed, continuation, ok := mealMasterIngredients(line)
+		if !ok {
+			break
+		}
+		if continuation != "" && len(ingredients) > 0 {
+			ingredients[len(ingredients)-1] += " " + continuation
+		}
+		ingredients = append(ingredients, parsed...)
+	}
+	flush()
+
+	// wrapped lines are joined, blank lines separate the steps
+	var steps []string
+	paragraph := ""
+	for ; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			if paragraph != "" {
+				steps = append(steps, paragraph)
+			}
+			paragraph = ""
+			continue
+		}
+		paragraph = strings.TrimSpace(paragraph + " " + line)
+	}
+	if paragraph != "" {
+		steps = append(steps, paragraph)
+	}
+	if len(steps) > 0 {
+		recipe.Directions = directions(steps)
+	}
+
+	return Entry{Title: recipe.Title, Recipe: recipe}
+}
+
+// mealMasterIngredients parses an ingredient line, which may hold two
+// ingredients side by side. A line whose ingredient starts with "-" continues
+// the previous ingredient.
+func mealMasterIngredients(line string) (ingredients []string, continuation string, ok bool) {
+	columns := []string{line}
+	// two column layouts start the second ingredient at column 42
+	if len(line) > 41 && mealMasterIngredientRegex.MatchString(line[41:]) {
+		columns = []string{strings.TrimRight(line[:41], " "), line[41:]}
+	}
+
+	for _, column := range columns {
+		m := mealMasterIngredientRegex.FindStringSubmatch(column)
+		if m == nil {
+			return nil, "", false
+		}
+		amount, unit, name := strings.TrimSpace(m[1]), strings.TrimSpace(m[2]), strings.TrimSpace(m[3])
+		if amount == "" && unit == "" && strings.HasPrefix(name, "-") {
+			continuation = strings.TrimSpace(strings.TrimPrefix(name, "-"))
+			continue
+		}
+		if unitName, ok := mealMasterUnits[unit]; ok {
+			unit = unitName
+		} else if unitName, ok := mealMasterUnits[strings.ToLower(unit)]; ok {
+			unit = unitName
+		}
+		ingredients = append(ingredients, strings.Join(strings.Fields(amount+" "+unit+" "+name), " "))
+	}
+	return ingredients, continuation, true
+}
@@ -0,0 +1,135 @@
+package recipeimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// paprikaRecipe is a recipe in a Paprika export.
+type paprikaRecipe struct {
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	Ingredients     string   `json:"ingredients"`
+	Directions      string   `json:"directions"`
+	Notes           string   `json:"notes"`
+	Servings        string   `json:"servings"`
+	PrepTime        string   `json:"prep_time"`
+	CookTime        string   `json:"cook_time"`
+	TotalTime       string   `json:"total_time"`
+	Categories      []string `json:"categories"`
+	Source          string   `json:"source"`
+	SourceURL       string   `json:"source_url"`
+	ImageURL        string   `json:"image_url"`
+	PhotoData       string   `json:"photo_data"`
+	NutritionalInfo string   `json:"nutritional_info"`
+}
+
+// ParsePaprika parses a .paprikarecipes archive, a zip of gzipped JSON
+// recipes, or a single gzipped .paprikarecipe file.
+func ParsePaprika(data []byte) ([]Entry, error) {
+	if !isZip(data) {
+		entry, _ := parsePaprikaRecipe("recipe", bytes.NewReader(data))
+		return []Entry{entry}, nil
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read paprika archive: %w", err)
+	}
+
+	var entries []Entry
+	var size int64
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		name := strings.TrimSuffix(path.Base(f.Name), path.Ext(f.Name))
+		r, err := f.Open()
+		if err != nil {
+			entries = append(entries, Entry{Title: name, Err: fmt.Errorf("unable to open recipe: %w", err)})
+			continue
+		}
+		entry, n := parsePaprikaRecipe(name, r)
+		r.Close()
+
+		size += n
+		if size > maxArchiveSize {
+			return nil, fmt.Errorf("paprika archive is larger than %d MB once decompressed", maxArchiveSize/1024/1024)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// parsePaprikaRecipe parses a gzipped JSON recipe. It also returns the
+// number of bytes it decompressed, which is never more than one past
+// maxRecipeFileSize.
+func parsePaprikaRecipe(name string, r io.Reader) (Entry, int64) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Entry{Title: name, Err: fmt.Errorf("recipe is not gzipped: %w", err)}, 0
+	}
+	defer gz.Close()
+
+	data, err := io.ReadAll(io.LimitReader(gz, maxRecipeFileSize+1))
+	size := int64(len(data))
+	if err != nil {
+		return Entry{Title: name, Err: fmt.Errorf("unable to decompress recipe: %w", err)}, size
+	}
+	if size > maxRecipeFileSize {
+		return Entry{Title: name, Err: fmt.Errorf("recipe is larger than %d MB once decompressed", maxRecipeFileSize/1024/1024)}, size
+	}
+
+	var p paprikaRecipe
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Entry{Title: name, Err: fmt.Errorf("invalid recipe json: %w", err)}, size
+	}
+	if strings.TrimSpace(p.Name) == "" {
+		return Entry{Title: name, Err: fmt.Errorf("recipe has no name")}, size
+	}
+
+	recipe := model.Recipe{
+		Title:            strings.TrimSpace(p.Name),
+		Description:      strings.TrimSpace(p.Description),
+		IngredientGroups: ingredientGroups(splitLines(p.Ingredients)),
+		Directions:       directions(splitLines(p.Directions)),
+		YieldAmount:      strings.TrimSpace(p.Servings),
+		PrepDuration:     parseDuration(p.PrepTime),
+		CookDuration:     parseDuration(p.CookTime),
+		TotalDuration:    parseDuration(p.TotalTime),
+		Categories:       p.Categories,
+		Citation:         p.SourceURL,
+	}
+	if recipe.Citation == "" {
+		recipe.Citation = strings.TrimSpace(p.Source)
+	}
+	if notes := strings.TrimSpace(p.Notes); notes != "" {
+		recipe.Description = strings.TrimSpace(recipe.Description + "\n\n" + notes)
+	}
+
+	entry := Entry{Title: recipe.Title, Recipe: recipe}
+	if p.PhotoData != "" {
+		image, err := base64.StdEncoding.DecodeString(p.PhotoData)
+		if err != nil {
+			entry.Err = fmt.Errorf("invalid photo data: %w", err)
+			return entry, size
+		}
+		entry.Image = image
+	} else {
+		entry.Recipe.ImageURI = p.ImageURL
+	}
+	return entry, size
+}
+
+func isZip(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("PK\x03\x04"))
+}
@@ -0,0 +1,200 @@
+// Package recipeimport parses the export files of other recipe managers into
+// recipes.
+package recipeimport
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/schemaorgrecipe"
+)
+
+const (
+	// maxRecipeFileSize is the largest a single file in an import may be once
+	// decompressed, embedded photo included.
+	maxRecipeFileSize = 16 * 1024 * 1024 // 16 MB
+	// maxArchiveSize is the largest all files of an import archive may be
+	// together once decompressed.
+	maxArchiveSize = 256 * 1024 * 1024 // 256 MB
+)
+
+// Source is the recipe manager an import file was exported from.
+type Source string
+
+const (
+	SourcePaprika    Source = "paprika"
+	SourceMealie     Source = "mealie"
+	SourceMealMaster Source = "mealmaster"
+	SourceCSV        Source = "csv"
+)
+
+// ParseSource parses an import source.
+func ParseSource(s string) (Source, error) {
+	switch Source(strings.ToLower(strings.TrimSpace(s))) {
+	case SourcePaprika:
+		return SourcePaprika, nil
+	case SourceMealie:
+		return SourceMealie, nil
+	case SourceMealMaster, "meal-master", "mmf":
+		return SourceMealMaster, nil
+	case SourceCSV:
+		return SourceCSV, nil
+	}
+	return "", fmt.Errorf("unsupported import source %q", s)
+}
+
+// Entry is a recipe read from an import file.
+type Entry struct {
+	// Title identifies the entry in the import report. It is the recipe title
+	// when the entry could be parsed.
+	Title  string
+	Recipe model.Recipe
+	// Image is the photo embedded in the import file, if any. Images that are
+	// only referenced by URL are kept in Recipe.ImageURI instead.
+	Image []byte
+	// Err is set when the entry could not be parsed.
+	Err error
+}
+
+// Parse parses the import file of the source into its recipes. An error is
+// only returned when the file as a whole cannot be read; entries that cannot
+// be parsed are returned with Err set.
+func Parse(source Source, data []byte) ([]Entry, error) {
+	switch source {
+	case SourcePaprika:
+		return ParsePaprika(data)
+	case SourceMealie:
+		return ParseMealie(data)
+	case SourceMealMaster:
+		return ParseMealMaster(data)
+	case SourceCSV:
+		return ParseCSV(data)
+	}
+	return nil, fmt.Errorf("unsupported import source %q", source)
+}
+
+// ingredientGroups parses ingredient lines. Lines ending in a colon, such as
+// "For the glaze:", start a new group.
+func ingredientGroups(lines []string) []model.IngredientGroup {
+	var groups []model.IngredientGroup
+	current := model.IngredientGroup{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if title, ok := sectionTitle(line); ok {
+			if len(current.RecipeIngredients) > 0 || current.Title != "" {
+				groups = append(groups, current)
+			}
+			current = model.IngredientGroup{Title: title}
+			continue
+		}
+		current.RecipeIngredients = append(current.RecipeIngredients, schemaorgrecipe.ParseRecipeIngredient(line))
+	}
+	if len(current.RecipeIngredients) > 0 || current.Title != "" {
+		groups = append(groups, current)
+	}
+	return groups
+}
+
+// directions parses direction lines into steps. Lines ending in a colon start
+// a new direction.
+func directions(lines []string) []model.RecipeDirection {
+	var out []model.RecipeDirection
+	current := model.RecipeDirection{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if title, ok := sectionTitle(line); ok {
+			if len(current.Steps) > 0 || current.Title != "" {
+				out = append(out, current)
+			}
+			current = model.RecipeDirection{Title: title}
+			continue
+		}
+		current.Steps = append(current.Steps, stepNumberRegex.ReplaceAllString(line, ""))
+	}
+	if len(current.Steps) > 0 || current.Title != "" {
+		out = append(out, current)
+	}
+	return out
+}
+
+var stepNumberRegex = regexp.MustCompile(`^(?:step\s*)?\d+[.):]\s+`)
+
+// sectionTitle reports whether the line is a section header such as
+// "For the glaze:".
+func sectionTitle(line string) (string, bool) {
+	if !strings.HasSuffix(line, ":") || len(line) > 60 {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimSuffix(line, ":")), true
+}
+
+// splitLines splits text on line breaks.
+func splitLines(text string) []string {
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
+
+// splitList splits a comma separated list, dropping empty values.
+func splitList(text string) []string {
+	var out []string
+	for _, s := range strings.Split(text, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+var (
+	isoDurationRegex  = regexp.MustCompile(`(?i)^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+	textDurationRegex = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*(hours?|hrs?|h|minutes?|mins?|m)\b`)
+	clockDuration     = regexp.MustCompile(`^(\d+):(\d{2})$`)
+)
+
+// parseDuration parses the durations written by recipe managers, such as
+// "PT1H30M", "1 hr 30 mins", "1:30" or "45" (minutes). Zero is returned when
+// the duration cannot be parsed.
+func parseDuration(s string) time.Duration {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	if m := isoDurationRegex.FindStringSubmatch(s); m != nil {
+		var d time.Duration
+		for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+			if m[i+1] != "" {
+				n, _ := strconv.Atoi(m[i+1])
+				d += time.Duration(n) * unit
+			}
+		}
+		return d
+	}
+	if m := clockDuration.FindStringSubmatch(s); m != nil {
+		hours, _ := strconv.Atoi(m[1])
+		minutes, _ := strconv.Atoi(m[2])
+		return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	}
+	if minutes, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(minutes * float64(time.Minute))
+	}
+
+	var d time.Duration
+	for _, m := range textDurationRegex.FindAllStringSubmatch(s, -1) {
+		n, _ := strconv.ParseFloat(m[1], 64)
+		if strings.HasPrefix(strings.ToLower(m[2]), "h") {
+			d += time.Duration(n * float64(time.Hour))
+		} else {
+			d += time.Duration(n * float64(time.Minute))
+		}
+	}
+	return d
+}
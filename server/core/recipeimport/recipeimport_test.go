@@ -0,0 +1,277 @@
+package recipeimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("unable to read fixture: %v", err)
+	}
+	return data
+}
+
+func gzipJSON(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(gz).Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	gz.Close()
+	return buf.Bytes()
+}
+
+func TestParsePaprika(t *testing.T) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	files := map[string][]byte{
+		"Chili.paprikarecipe": gzipJSON(t, map[string]interface{}{
+			"name":        "Chili",
+			"ingredients": "1 lb beef\nFor the topping:\n1 cup cheese",
+			"directions":  "Brown the beef.\n\nSimmer for an hour.",
+			"notes":       "Better the next day.",
+			"servings":    "6",
+			"prep_time":   "15 mins",
+			"cook_time":   "1 hr 30 mins",
+			"categories":  []string{"Dinner"},
+			"source_url":  "https://example.com/chili",
+			"photo_data":  base64.StdEncoding.EncodeToString([]byte("jpeg")),
+		}),
+		"Broken.paprikarecipe": []byte("not gzip"),
+	}
+	for _, name := range []string{"Chili.paprikarecipe", "Broken.paprikarecipe"} {
+		w, _ := archive.Create(name)
+		w.Write(files[name])
+	}
+	archive.Close()
+
+	entries, err := Parse(SourcePaprika, buf.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	chili := entries[0]
+	if chili.Err != nil {
+		t.Fatalf("unexpected entry error: %v", chili.Err)
+	}
+	recipe := chili.Recipe
+	if recipe.Title != "Chili" || recipe.YieldAmount != "6" || recipe.Citation != "https://example.com/chili" {
+		t.Errorf("unexpected recipe: %+v", recipe)
+	}
+	if recipe.Description != "Better the next day." {
+		t.Errorf("notes not kept: %q", recipe.Description)
+	}
+	if recipe.PrepDuration != 15*time.Minute || recipe.CookDuration != 90*time.Minute {
+		t.Errorf("unexpected durations: %v %v", recipe.PrepDuration, recipe.CookDuration)
+	}
+	if len(recipe.IngredientGroups) != 2 || recipe.IngredientGroups[1].Title != "For the topping" {
+		t.Fatalf("unexpected ingredient groups: %+v", recipe.IngredientGroups)
+	}
+	beef := recipe.IngredientGroups[0].RecipeIngredients[0]
+	if beef.MeasurementAmount != 1 || beef.MeasurementType != pb.Recipe_MEASUREMENT_TYPE_POUND || beef.Title != "beef" {
+		t.Errorf("unexpected ingredient: %+v", beef)
+	}
+	if len(recipe.Directions) != 1 || len(recipe.Directions[0].Steps) != 2 {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+	if string(chili.Image) != "jpeg" {
+		t.Errorf("photo not decoded: %q", chili.Image)
+	}
+
+	if entries[1].Err == nil || entries[1].Title != "Broken" {
+		t.Errorf("expected the broken entry to fail, got %+v", entries[1])
+	}
+}
+
+// gzipSpaces gzips n spaces, which compress to almost nothing.
+func gzipSpaces(t *testing.T, n int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(bytes.Repeat([]byte(" "), n)); err != nil {
+		t.Fatal(err)
+	}
+	gz.Close()
+	return buf.Bytes()
+}
+
+func TestParsePaprika_DecompressedSizeLimits(t *testing.T) {
+	entries, err := Parse(SourcePaprika, gzipSpaces(t, maxRecipeFileSize+1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Err == nil || !strings.Contains(entries[0].Err.Error(), "larger than") {
+		t.Errorf("expected the oversized recipe to fail, got %+v", entries)
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	recipe := gzipSpaces(t, maxRecipeFileSize)
+	for i := range maxArchiveSize/maxRecipeFileSize + 1 {
+		w, _ := archive.Create(fmt.Sprintf("Recipe %d.paprikarecipe", i))
+		w.Write(recipe)
+	}
+	archive.Close()
+
+	_, err = Parse(SourcePaprika, buf.Bytes())
+	if err == nil || !strings.Contains(err.Error(), "larger than") {
+		t.Errorf("expected the oversized archive to fail, got %v", err)
+	}
+}
+
+func TestParseMealie(t *testing.T) {
+	entries, err := Parse(SourceMealie, readFixture(t, "pancakes.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Err != nil {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	recipe := entries[0].Recipe
+	if recipe.Title != "Pancakes" || recipe.YieldAmount != "4 servings" || recipe.Citation != "https://example.com/pancakes" {
+		t.Errorf("unexpected recipe: %+v", recipe)
+	}
+	if recipe.PrepDuration != 10*time.Minute || recipe.CookDuration != 15*time.Minute || recipe.TotalDuration != 25*time.Minute {
+		t.Errorf("unexpected durations: %v %v %v", recipe.PrepDuration, recipe.CookDuration, recipe.TotalDuration)
+	}
+	if !slices.Equal(recipe.Categories, []string{"Breakfast", "Quick"}) {
+		t.Errorf("unexpected categories: %v", recipe.Categories)
+	}
+	if len(recipe.IngredientGroups) != 2 || recipe.IngredientGroups[0].Title != "Batter" || recipe.IngredientGroups[1].Title != "Topping" {
+		t.Fatalf("unexpected ingredient groups: %+v", recipe.IngredientGroups)
+	}
+	sugar := recipe.IngredientGroups[0].RecipeIngredients[1]
+	if sugar.MeasurementAmount != 2 || sugar.MeasurementType != pb.Recipe_MEASUREMENT_TYPE_TABLESPOON || sugar.Title != "sugar" {
+		t.Errorf("unexpected ingredient: %+v", sugar)
+	}
+	if len(recipe.Directions) != 2 || recipe.Directions[1].Title != "Cook" {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+}
+
+func TestParseMealie_DecompressedSizeLimit(t *testing.T) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	w, _ := archive.Create("huge/huge.json")
+	w.Write(bytes.Repeat([]byte(" "), maxRecipeFileSize+1))
+	archive.Close()
+
+	entries, err := Parse(SourceMealie, buf.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Err == nil || !strings.Contains(entries[0].Err.Error(), "larger than") {
+		t.Errorf("expected the oversized recipe to fail, got %+v", entries)
+	}
+}
+
+func TestParseMealMaster(t *testing.T) {
+	entries, err := Parse(SourceMealMaster, readFixture(t, "banana_bread.mmf"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	recipe := entries[0].Recipe
+	if recipe.Title != "Banana Bread" || recipe.YieldAmount != "1 loaf" || !slices.Equal(recipe.Categories, []string{"Breads", "Desserts"}) {
+		t.Errorf("unexpected recipe: %+v", recipe)
+	}
+	if len(recipe.IngredientGroups) != 2 || recipe.IngredientGroups[1].Title != "GLAZE" {
+		t.Fatalf("unexpected ingredient groups: %+v", recipe.IngredientGroups)
+	}
+	flour := recipe.IngredientGroups[0].RecipeIngredients[0]
+	if flour.MeasurementAmount != 2 || flour.MeasurementType != pb.Recipe_MEASUREMENT_TYPE_CUP || flour.Title != "Flour" {
+		t.Errorf("unexpected ingredient: %+v", flour)
+	}
+	walnuts := recipe.IngredientGroups[0].RecipeIngredients[2]
+	if walnuts.MeasurementAmount != 0.5 || walnuts.MeasurementType != pb.Recipe_MEASUREMENT_TYPE_CUP {
+		t.Errorf("unexpected ingredient: %+v", walnuts)
+	}
+	milk := recipe.IngredientGroups[1].RecipeIngredients[1]
+	if milk.MeasurementType != pb.Recipe_MEASUREMENT_TYPE_TABLESPOON || milk.Title != "Milk warmed" {
+		t.Errorf("unexpected continued ingredient: %+v", milk)
+	}
+	if len(recipe.Directions) != 1 || !slices.Equal(recipe.Directions[0].Steps, []string{
+		"Preheat the oven to 350 degrees. Mix the flour and baking soda.",
+		"Fold in the bananas and walnuts and bake for one hour.",
+	}) {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+
+	if entries[1].Recipe.Title != "Toast" || entries[1].Recipe.YieldAmount != "1" {
+		t.Errorf("unexpected second recipe: %+v", entries[1].Recipe)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	entries, err := Parse(SourceCSV, readFixture(t, "recipes.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	recipe := entries[0].Recipe
+	if recipe.Title != "Omelette" || recipe.Citation != "https://example.com/omelette" {
+		t.Errorf("unexpected recipe: %+v", recipe)
+	}
+	if recipe.PrepDuration != 5*time.Minute || recipe.CookDuration != 65*time.Minute {
+		t.Errorf("unexpected durations: %v %v", recipe.PrepDuration, recipe.CookDuration)
+	}
+	if !slices.Equal(recipe.Categories, []string{"Breakfast", "Eggs"}) {
+		t.Errorf("unexpected categories: %v", recipe.Categories)
+	}
+	if len(recipe.IngredientGroups) != 1 || len(recipe.IngredientGroups[0].RecipeIngredients) != 2 {
+		t.Errorf("unexpected ingredients: %+v", recipe.IngredientGroups)
+	}
+	if len(recipe.Directions) != 1 || !slices.Equal(recipe.Directions[0].Steps, []string{"Beat the eggs.", "Cook in butter."}) {
+		t.Errorf("unexpected directions: %+v", recipe.Directions)
+	}
+
+	if entries[1].Err == nil {
+		t.Errorf("expected a row without a title to fail")
+	}
+}
+
+func TestParseCSV_NoTitleColumn(t *testing.T) {
+	if _, err := ParseCSV([]byte("name,ingredients\nToast,bread\n")); err == nil {
+		t.Error("expected an error without a title column")
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"PT1H30M":      90 * time.Minute,
+		"1 hr 30 mins": 90 * time.Minute,
+		"2 hours":      2 * time.Hour,
+		"1:15":         75 * time.Minute,
+		"45":           45 * time.Minute,
+		"soon":         0,
+	} {
+		if got := parseDuration(in); got != want {
+			t.Errorf("parseDuration(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
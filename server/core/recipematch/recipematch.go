@@ -0,0 +1,107 @@
+// Package recipematch scores recipes by how many of their ingredients are on
+// hand.
+package recipematch
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// OnHand is a set of ingredients that are available.
+type OnHand struct {
+	names []string
+	set   map[string]struct{}
+}
+
+// NewOnHand creates the set of ingredients on hand from free-form titles.
+func NewOnHand(titles []string) OnHand {
+	onHand := OnHand{set: map[string]struct{}{}}
+	for _, title := range titles {
+		name := ingredientcatalog.Canonical(title)
+		if name == "" {
+			continue
+		}
+		if _, ok := onHand.set[name]; ok {
+			continue
+		}
+		onHand.set[name] = struct{}{}
+		onHand.names = append(onHand.names, name)
+	}
+	return onHand
+}
+
+// Has reports whether an ingredient title is on hand. Synonyms match exactly
+// and other titles when they are similar enough, e.g. misspellings.
+func (o OnHand) Has(title string) bool {
+	name := ingredientcatalog.Canonical(title)
+	if name == "" {
+		return false
+	}
+	if _, ok := o.set[name]; ok {
+		return true
+	}
+	for _, onHand := range o.names {
+		if ingredientcatalog.Similarity(name, onHand) >= ingredientcatalog.MatchThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+// Match scores a recipe against the ingredients on hand. Optional ingredients
+// are not required. A recipe without required ingredients has full coverage.
+func Match(recipe model.Recipe, onHand OnHand) model.RecipeMatch {
+	match := model.RecipeMatch{
+		Recipe:               recipe,
+		AvailableIngredients: []string{},
+		MissingIngredients:   []string{},
+	}
+
+	seen := map[string]struct{}{}
+	for _, group := range recipe.IngredientGroups {
+		for _, ingredient := range group.RecipeIngredients {
+			if ingredient.Optional {
+				continue
+			}
+			// the same ingredient in several groups is only required once
+			name := ingredientcatalog.Canonical(ingredient.Title)
+			if _, ok := seen[name]; ok || name == "" {
+				continue
+			}
+			seen[name] = struct{}{}
+
+			if onHand.Has(ingredient.Title) {
+				match.AvailableIngredients = append(match.AvailableIngredients, ingredient.Title)
+			} else {
+				match.MissingIngredients = append(match.MissingIngredients, ingredient.Title)
+			}
+		}
+	}
+
+	required := len(match.AvailableIngredients) + len(match.MissingIngredients)
+	if required == 0 {
+		match.Coverage = 100
+		return match
+	}
+	match.Coverage = math.Round(float64(len(match.AvailableIngredients))/float64(required)*1000) / 10
+
+	return match
+}
+
+// Rank sorts matches by coverage, then by the fewest missing ingredients and
+// then by the newest recipe.
+func Rank(matches []model.RecipeMatch) {
+	slices.SortStableFunc(matches, func(a, b model.RecipeMatch) int {
+		if c := cmp.Compare(b.Coverage, a.Coverage); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(len(a.MissingIngredients), len(b.MissingIngredients)); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Recipe.Id.RecipeId, a.Recipe.Id.RecipeId)
+	})
+}
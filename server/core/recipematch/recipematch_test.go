@@ -0,0 +1,64 @@
+package recipematch
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+func recipe(id int64, ingredients ...model.RecipeIngredient) model.Recipe {
+	return model.Recipe{
+		Id:               model.RecipeId{RecipeId: id},
+		IngredientGroups: []model.IngredientGroup{{RecipeIngredients: ingredients}},
+	}
+}
+
+func TestMatch(t *testing.T) {
+	onHand := NewOnHand([]string{"scallions", "Eggs", "soy sauce", "jasmin rice"})
+
+	r := recipe(1,
+		model.RecipeIngredient{Title: "green onions, thinly sliced"},
+		model.RecipeIngredient{Title: "2 large eggs"},
+		model.RecipeIngredient{Title: "jasmine rice"},
+		model.RecipeIngredient{Title: "sesame oil"},
+		model.RecipeIngredient{Title: "cilantro", Optional: true},
+	)
+
+	match := Match(r, onHand)
+	if match.Coverage != 75 {
+		t.Errorf("Match() coverage = %v, want 75", match.Coverage)
+	}
+	if !slices.Equal(match.MissingIngredients, []string{"sesame oil"}) {
+		t.Errorf("Match() missing = %v, want [sesame oil]", match.MissingIngredients)
+	}
+	if len(match.AvailableIngredients) != 3 {
+		t.Errorf("Match() available = %v, want 3 ingredients", match.AvailableIngredients)
+	}
+}
+
+func TestMatchWithoutRequiredIngredients(t *testing.T) {
+	match := Match(recipe(1, model.RecipeIngredient{Title: "parsley", Optional: true}), NewOnHand(nil))
+	if match.Coverage != 100 {
+		t.Errorf("Match() coverage = %v, want 100", match.Coverage)
+	}
+}
+
+func TestRank(t *testing.T) {
+	matches := []model.RecipeMatch{
+		{Recipe: recipe(1), Coverage: 50, MissingIngredients: []string{"a", "b"}},
+		{Recipe: recipe(2), Coverage: 100},
+		{Recipe: recipe(3), Coverage: 50, MissingIngredients: []string{"a"}},
+		{Recipe: recipe(4), Coverage: 50, MissingIngredients: []string{"a"}},
+	}
+
+	Rank(matches)
+
+	got := []int64{}
+	for _, match := range matches {
+		got = append(got, match.Recipe.Id.RecipeId)
+	}
+	if want := []int64{2, 4, 3, 1}; !slices.Equal(got, want) {
+		t.Errorf("Rank() order = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,336 @@
+// Package recipesteps extracts the timers, temperatures and ingredients that
+// the steps of a recipe call for from their text.
+package recipesteps
+
+import (
+	"cmp"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+const numberPattern = `(\d+(?:\.\d+)?(?:\s+\d+/\d+)?|\d+/\d+|an?|one|two|three|four|five|six|seven|eight|nine|ten|twelve|fifteen|twenty|thirty|forty-five|forty|sixty)`
+
+const unitPattern = `(hours?|hrs?|minutes?|mins?|seconds?|secs?)`
+
+var (
+	// timerRegex matches durations such as "20 minutes", "1 1/2 hours",
+	// "8-10 mins" and "1 hour and 30 minutes".
+	timerRegex = regexp.MustCompile(`(?i)\b` + numberPattern + `(?:\s*(?:-|–|to)\s*` + numberPattern + `)?\s*` + unitPattern + `\b\.?(?:,?\s*(?:and\s+)?` + numberPattern + `(?:\s*(?:-|–|to)\s*` + numberPattern + `)?\s*` + unitPattern + `\b\.?)?`)
+	// halfHourRegex matches "half an hour".
+	halfHourRegex = regexp.MustCompile(`(?i)\bhalf\s+an?\s+hour\b`)
+	// temperatureRegex matches temperatures with a degree sign or word such as
+	// "350°F", "180 °C", "350 degrees" and "375 to 400 degrees fahrenheit".
+	temperatureRegex = regexp.MustCompile(`(?i)\b(\d{2,3}(?:\.\d+)?)(?:\s*(?:-|–|to)\s*\d{2,3}(?:\.\d+)?)?\s*(?:°|º|degrees?\b|deg\b\.?)\s*(fahrenheit|celsius|centigrade|f\b|c\b)?`)
+	// bareTemperatureRegex matches temperatures written without a degree sign
+	// such as "350F" and "180 C". The unit has to be upper case.
+	bareTemperatureRegex = regexp.MustCompile(`\b(\d{2,3})\s?([FC])\b`)
+	// clauseRegex splits a step into the clauses that are matched against the
+	// ingredients.
+	clauseRegex = regexp.MustCompile(`[,.;:!?()]+`)
+)
+
+var numberWords = map[string]float64{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10, "twelve": 12,
+	"fifteen": 15, "twenty": 20, "thirty": 30, "forty": 40, "forty-five": 45,
+	"sixty": 60,
+}
+
+// Parse extracts the timers, temperatures and ingredients mentioned in the
+// text of a step. Ingredients are referenced by their position in groups.
+func Parse(text string, groups []model.IngredientGroup) model.RecipeStepDetail {
+	return model.RecipeStepDetail{
+		Timers:       parseTimers(text),
+		Temperatures: parseTemperatures(text),
+		Ingredients:  parseIngredients(text, groups),
+	}
+}
+
+// Annotate returns the directions with a step detail for every step. Step
+// details that were given are kept, except when the text of the step changed
+// from previous while its details did not, and empty ones are extracted from
+// the step text. References to ingredients that are not in groups are
+// dropped.
+func Annotate(directions []model.RecipeDirection, groups []model.IngredientGroup, previous []model.RecipeDirection) []model.RecipeDirection {
+	if directions == nil {
+		return nil
+	}
+
+	annotated := make([]model.RecipeDirection, len(directions))
+	for i, direction := range directions {
+		var previousDirection model.RecipeDirection
+		if i < len(previous) {
+			previousDirection = previous[i]
+		}
+
+		details := make([]model.RecipeStepDetail, len(direction.Steps))
+		for j, step := range direction.Steps {
+			var detail model.RecipeStepDetail
+			if j < len(direction.StepDetails) {
+				detail = validIngredients(direction.StepDetails[j], groups)
+			}
+			if !detail.IsZero() && j < len(previousDirection.Steps) && j < len(previousDirection.StepDetails) &&
+				previousDirection.Steps[j] != step && reflect.DeepEqual(detail, validIngredients(previousDirection.StepDetails[j], groups)) {
+				detail = model.RecipeStepDetail{}
+			}
+			if detail.IsZero() {
+				detail = Parse(step, groups)
+			}
+			details[j] = detail
+		}
+
+		direction.StepDetails = details
+		annotated[i] = direction
+	}
+
+	return annotated
+}
+
+// LinkIngredients returns the directions with the ingredient references of
+// every step extracted again from the step text. It is used when the
+// ingredients change, since the references are positional.
+func LinkIngredients(directions []model.RecipeDirection, groups []model.IngredientGroup) []model.RecipeDirection {
+	if directions == nil {
+		return nil
+	}
+
+	linked := make([]model.RecipeDirection, len(directions))
+	for i, direction := range directions {
+		details := make([]model.RecipeStepDetail, len(direction.Steps))
+		for j, step := range direction.Steps {
+			if j < len(direction.StepDetails) {
+				details[j] = direction.StepDetails[j]
+			}
+			details[j].Ingredients = parseIngredients(step, groups)
+		}
+
+		direction.StepDetails = details
+		linked[i] = direction
+	}
+
+	return linked
+}
+
+// validIngredients drops the ingredient references of a step detail that do
+// not point to an ingredient in groups.
+func validIngredients(detail model.RecipeStepDetail, groups []model.IngredientGroup) model.RecipeStepDetail {
+	var ingredients []model.RecipeStepIngredient
+	for _, ingredient := range detail.Ingredients {
+		if ingredient.GroupIndex < 0 || int(ingredient.GroupIndex) >= len(groups) {
+			continue
+		}
+		if ingredient.IngredientIndex < 0 || int(ingredient.IngredientIndex) >= len(groups[ingredient.GroupIndex].RecipeIngredients) {
+			continue
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+	detail.Ingredients = ingredients
+	return detail
+}
+
+func parseTimers(text string) []model.RecipeStepTimer {
+	var timers []model.RecipeStepTimer
+	halfHours := halfHourRegex.FindAllStringIndex(text, -1)
+	for _, match := range timerRegex.FindAllStringSubmatchIndex(text, -1) {
+		if overlaps(halfHours, match) {
+			continue
+		}
+		group := func(i int) string {
+			if match[2*i] < 0 {
+				return ""
+			}
+			return text[match[2*i]:match[2*i+1]]
+		}
+
+		duration := unitDuration(parseNumber(group(1)), group(3))
+		if group(4) == "" {
+			timers = appendTimer(timers, text[match[0]:match[1]], duration)
+			continue
+		}
+
+		// "1 hour 30 minutes" is a single timer but "5 minutes, 2 minutes"
+		// are two
+		second := unitDuration(parseNumber(group(4)), group(6))
+		if unitDuration(1, group(3)) > unitDuration(1, group(6)) {
+			timers = appendTimer(timers, text[match[0]:match[1]], duration+second)
+			continue
+		}
+		timers = appendTimer(timers, text[match[0]:match[7]], duration)
+		timers = appendTimer(timers, text[match[8]:match[1]], second)
+	}
+
+	for _, match := range halfHours {
+		timers = appendTimer(timers, text[match[0]:match[1]], 30*time.Minute)
+	}
+
+	return timers
+}
+
+func appendTimer(timers []model.RecipeStepTimer, text string, duration time.Duration) []model.RecipeStepTimer {
+	if duration <= 0 {
+		return timers
+	}
+	return append(timers, model.RecipeStepTimer{
+		Text:     strings.TrimSuffix(strings.TrimSpace(text), "."),
+		Duration: duration,
+	})
+}
+
+func parseNumber(s string) float64 {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if value, ok := numberWords[s]; ok {
+		return value
+	}
+
+	var total float64
+	for _, part := range strings.Fields(s) {
+		if numerator, denominator, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.ParseFloat(numerator, 64)
+			if err != nil {
+				return 0
+			}
+			d, err := strconv.ParseFloat(denominator, 64)
+			if err != nil || d == 0 {
+				return 0
+			}
+			total += n / d
+			continue
+		}
+		value, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0
+		}
+		total += value
+	}
+	return total
+}
+
+func unitDuration(amount float64, unit string) time.Duration {
+	unit = strings.ToLower(unit)
+	switch {
+	case strings.HasPrefix(unit, "h"):
+		return time.Duration(amount * float64(time.Hour))
+	case strings.HasPrefix(unit, "m"):
+		return time.Duration(amount * float64(time.Minute))
+	case strings.HasPrefix(unit, "s"):
+		return time.Duration(amount * float64(time.Second))
+	}
+	return 0
+}
+
+func parseTemperatures(text string) []model.RecipeStepTemperature {
+	var temperatures []model.RecipeStepTemperature
+	var matched [][]int
+	for _, match := range temperatureRegex.FindAllStringSubmatchIndex(text, -1) {
+		value, err := strconv.ParseFloat(text[match[2]:match[3]], 64)
+		if err != nil {
+			continue
+		}
+		unit := ""
+		if match[4] >= 0 {
+			unit = text[match[4]:match[5]]
+		}
+		temperatures = append(temperatures, model.RecipeStepTemperature{
+			Text:  strings.TrimSpace(text[match[0]:match[1]]),
+			Value: value,
+			Unit:  temperatureUnit(unit),
+		})
+		matched = append(matched, match)
+	}
+
+	for _, match := range bareTemperatureRegex.FindAllStringSubmatchIndex(text, -1) {
+		if overlaps(matched, match) {
+			continue
+		}
+		value, err := strconv.ParseFloat(text[match[2]:match[3]], 64)
+		if err != nil {
+			continue
+		}
+		temperatures = append(temperatures, model.RecipeStepTemperature{
+			Text:  text[match[0]:match[1]],
+			Value: value,
+			Unit:  temperatureUnit(text[match[4]:match[5]]),
+		})
+	}
+
+	return temperatures
+}
+
+func overlaps(matches [][]int, match []int) bool {
+	for _, m := range matches {
+		if match[0] < m[1] && m[0] < match[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func temperatureUnit(unit string) pb.Recipe_TemperatureUnit {
+	switch strings.ToLower(unit) {
+	case "f", "fahrenheit":
+		return pb.Recipe_TEMPERATURE_UNIT_FAHRENHEIT
+	case "c", "celsius", "centigrade":
+		return pb.Recipe_TEMPERATURE_UNIT_CELSIUS
+	}
+	return pb.Recipe_TEMPERATURE_UNIT_UNSPECIFIED
+}
+
+// parseIngredients finds the ingredients whose normalized title appears in
+// the step. An ingredient is also matched by its last word, e.g. "flour" for
+// "all-purpose flour", unless another ingredient matched that word in full.
+func parseIngredients(text string, groups []model.IngredientGroup) []model.RecipeStepIngredient {
+	var clauses []string
+	for _, clause := range clauseRegex.Split(text, -1) {
+		if clause = ingredientcatalog.Normalize(clause); clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+	normalized := " " + strings.Join(clauses, " | ") + " "
+
+	type candidate struct {
+		ref  model.RecipeStepIngredient
+		head string
+	}
+	var ingredients []model.RecipeStepIngredient
+	var headMatches []candidate
+	fullHeads := map[string]struct{}{}
+	for i, group := range groups {
+		for j, ingredient := range group.RecipeIngredients {
+			name := ingredientcatalog.Normalize(ingredient.Title)
+			if name == "" {
+				continue
+			}
+			ref := model.RecipeStepIngredient{GroupIndex: int32(i), IngredientIndex: int32(j)}
+			words := strings.Fields(name)
+			head := words[len(words)-1]
+			if strings.Contains(normalized, " "+name+" ") {
+				ingredients = append(ingredients, ref)
+				fullHeads[head] = struct{}{}
+				continue
+			}
+			if len(words) > 1 && len(head) > 2 && strings.Contains(normalized, " "+head+" ") {
+				headMatches = append(headMatches, candidate{ref: ref, head: head})
+			}
+		}
+	}
+
+	for _, match := range headMatches {
+		if _, ok := fullHeads[match.head]; !ok {
+			ingredients = append(ingredients, match.ref)
+		}
+	}
+
+	slices.SortFunc(ingredients, func(a, b model.RecipeStepIngredient) int {
+		return cmp.Or(cmp.Compare(a.GroupIndex, b.GroupIndex), cmp.Compare(a.IngredientIndex, b.IngredientIndex))
+	})
+
+	return ingredients
+}
@@ -0,0 +1,131 @@
+package recipesteps
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+)
+
+func TestParseTimers(t *testing.T) {
+	tests := []struct {
+		text string
+		want []model.RecipeStepTimer
+	}{
+		{"Simmer 20 minutes.", []model.RecipeStepTimer{{Text: "20 minutes", Duration: 20 * time.Minute}}},
+		{"Bake for 1 1/2 hours", []model.RecipeStepTimer{{Text: "1 1/2 hours", Duration: 90 * time.Minute}}},
+		{"Cook 8-10 mins, stirring", []model.RecipeStepTimer{{Text: "8-10 mins", Duration: 8 * time.Minute}}},
+		{"Roast for 1 hour and 15 minutes", []model.RecipeStepTimer{{Text: "1 hour and 15 minutes", Duration: 75 * time.Minute}}},
+		{"Rest for forty-five minutes", []model.RecipeStepTimer{{Text: "forty-five minutes", Duration: 45 * time.Minute}}},
+		{"Boil 5 minutes, 2 minutes more for soft yolks", []model.RecipeStepTimer{
+			{Text: "5 minutes", Duration: 5 * time.Minute},
+			{Text: "2 minutes", Duration: 2 * time.Minute},
+		}},
+		{"Chill for half an hour", []model.RecipeStepTimer{{Text: "half an hour", Duration: 30 * time.Minute}}},
+		{"Whisk until smooth", nil},
+	}
+
+	for _, tt := range tests {
+		if got := parseTimers(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTimers(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseTemperatures(t *testing.T) {
+	tests := []struct {
+		text string
+		want []model.RecipeStepTemperature
+	}{
+		{"Preheat the oven to 350°F.", []model.RecipeStepTemperature{{Text: "350°F", Value: 350, Unit: pb.Recipe_TEMPERATURE_UNIT_FAHRENHEIT}}},
+		{"Heat oil to 180 degrees Celsius", []model.RecipeStepTemperature{{Text: "180 degrees Celsius", Value: 180, Unit: pb.Recipe_TEMPERATURE_UNIT_CELSIUS}}},
+		{"Bake at 425F for 20 minutes", []model.RecipeStepTemperature{{Text: "425F", Value: 425, Unit: pb.Recipe_TEMPERATURE_UNIT_FAHRENHEIT}}},
+		{"Bake at 375 degrees for an hour", []model.RecipeStepTemperature{{Text: "375 degrees", Value: 375, Unit: pb.Recipe_TEMPERATURE_UNIT_UNSPECIFIED}}},
+		{"Add 2 cups of flour", nil},
+	}
+
+	for _, tt := range tests {
+		if got := parseTemperatures(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTemperatures(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseIngredients(t *testing.T) {
+	groups := []model.IngredientGroup{
+		{RecipeIngredients: []model.RecipeIngredient{
+			{Title: "all-purpose flour"},
+			{Title: "large eggs, beaten"},
+			{Title: "olive oil"},
+			{Title: "vegetable oil"},
+		}},
+		{Title: "Topping", RecipeIngredients: []model.RecipeIngredient{
+			{Title: "brown sugar"},
+		}},
+	}
+
+	tests := []struct {
+		text string
+		want []model.RecipeStepIngredient
+	}{
+		{"Whisk the flour and the egg.", []model.RecipeStepIngredient{{GroupIndex: 0, IngredientIndex: 0}, {GroupIndex: 0, IngredientIndex: 1}}},
+		{"Heat the olive oil in a pan", []model.RecipeStepIngredient{{GroupIndex: 0, IngredientIndex: 2}}},
+		{"Heat the oil", []model.RecipeStepIngredient{{GroupIndex: 0, IngredientIndex: 2}, {GroupIndex: 0, IngredientIndex: 3}}},
+		{"Sprinkle with brown sugar", []model.RecipeStepIngredient{{GroupIndex: 1, IngredientIndex: 0}}},
+		{"Let it rest", nil},
+	}
+
+	for _, tt := range tests {
+		if got := parseIngredients(tt.text, groups); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseIngredients(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	groups := []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{{Title: "rice"}}}}
+	edited := model.RecipeStepDetail{Timers: []model.RecipeStepTimer{{Text: "simmer", Duration: 18 * time.Minute}}}
+	previous := []model.RecipeDirection{{
+		Steps:       []string{"Rinse the rice", "Simmer 20 minutes"},
+		StepDetails: []model.RecipeStepDetail{{}, edited},
+	}}
+
+	t.Run("keeps edited details", func(t *testing.T) {
+		got := Annotate(previous, groups, previous)
+		if !reflect.DeepEqual(got[0].StepDetails[1], edited) {
+			t.Errorf("StepDetails[1] = %+v, want %+v", got[0].StepDetails[1], edited)
+		}
+		want := model.RecipeStepDetail{Ingredients: []model.RecipeStepIngredient{{GroupIndex: 0, IngredientIndex: 0}}}
+		if !reflect.DeepEqual(got[0].StepDetails[0], want) {
+			t.Errorf("StepDetails[0] = %+v, want %+v", got[0].StepDetails[0], want)
+		}
+	})
+
+	t.Run("extracts details of changed steps", func(t *testing.T) {
+		directions := []model.RecipeDirection{{
+			Steps:       []string{"Rinse the rice", "Simmer 25 minutes"},
+			StepDetails: previous[0].StepDetails,
+		}}
+		got := Annotate(directions, groups, previous)
+		want := []model.RecipeStepTimer{{Text: "25 minutes", Duration: 25 * time.Minute}}
+		if !reflect.DeepEqual(got[0].StepDetails[1].Timers, want) {
+			t.Errorf("Timers = %+v, want %+v", got[0].StepDetails[1].Timers, want)
+		}
+	})
+
+	t.Run("drops missing ingredients", func(t *testing.T) {
+		directions := []model.RecipeDirection{{
+			Steps: []string{"Simmer 20 minutes"},
+			StepDetails: []model.RecipeStepDetail{{
+				Timers:      edited.Timers,
+				Ingredients: []model.RecipeStepIngredient{{GroupIndex: 0, IngredientIndex: 3}},
+			}},
+		}}
+		got := Annotate(directions, groups, nil)
+		if !reflect.DeepEqual(got[0].StepDetails[0], edited) {
+			t.Errorf("StepDetails[0] = %+v, want %+v", got[0].StepDetails[0], edited)
+		}
+	})
+}
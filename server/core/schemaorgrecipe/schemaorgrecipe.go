@@ -2,11 +2,15 @@ package schemaorgrecipe
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/measurement"
 	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipesteps"
 	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
 )
 
@@ -254,6 +258,32 @@ func MapUnitToMeasurementType(unit string) pb.Recipe_MeasurementType {
 	}
 }
 
+// ParseRecipeIngredient parses an ingredient line such as "1 1/2 cups flour"
+// into a recipe ingredient. A trailing "*" marks the ingredient as optional.
+func ParseRecipeIngredient(text string) model.RecipeIngredient {
+	amount1, unit1, conj, amount2, unit2, name := ParseIngredient(text)
+	measurementType1 := MapUnitToMeasurementType(unit1)
+	measurementType2 := MapUnitToMeasurementType(unit2)
+	if measurementType1 == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
+		name = unit1 + " " + name
+		name = strings.TrimSpace(name)
+	}
+	optional := false
+	if strings.HasSuffix(name, "*") {
+		optional = true
+		name = strings.TrimSuffix(name, "*")
+	}
+	return model.RecipeIngredient{
+		Optional:                optional,
+		MeasurementAmount:       amount1,
+		MeasurementType:         measurementType1,
+		MeasurementConjunction:  MapConjunctionToProto(conj),
+		SecondMeasurementAmount: amount2,
+		SecondMeasurementType:   measurementType2,
+		Title:                   name,
+	}
+}
+
 // Helper to map conjunction string to proto enum
 func MapConjunctionToProto(conj string) pb.Recipe_Ingredient_MeasurementConjunction {
 	switch conj {
@@ -388,42 +418,19 @@ func ToModelRecipe(schemaRecipe SchemaOrgRecipe) model.Recipe {
 	// Ingredients
 	var ingredientGroups []model.IngredientGroup
 	var parseIngredientGroups func(interface{}, string)
-	parseIngredient := func(ing string) model.RecipeIngredient {
-		amount1, unit1, conj, amount2, unit2, name := ParseIngredient(ing)
-		measurementType1 := MapUnitToMeasurementType(unit1)
-		measurementType2 := MapUnitToMeasurementType(unit2)
-		if measurementType1 == pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
-			name = unit1 + " " + name
-			name = strings.TrimSpace(name)
-		}
-		optional := false
-		if strings.HasSuffix(name, "*") {
-			optional = true
-			name = strings.TrimSuffix(name, "*")
-		}
-		return model.RecipeIngredient{
-			Optional:                optional,
-			MeasurementAmount:       amount1,
-			MeasurementType:         measurementType1,
-			MeasurementConjunction:  MapConjunctionToProto(conj),
-			SecondMeasurementAmount: amount2,
-			SecondMeasurementType:   measurementType2,
-			Title:                   name,
-		}
-	}
 	parseIngredientGroups = func(instr interface{}, sectionTitle string) {
 		var ingredients []model.RecipeIngredient
 		switch v := instr.(type) {
 		case string:
 			if v != "" {
-				ingredients = append(ingredients, parseIngredient(v))
+				ingredients = append(ingredients, ParseRecipeIngredient(v))
 			}
 		case []interface{}:
 			for _, step := range v {
 				switch st := step.(type) {
 				case string:
 					if st != "" {
-						ingredients = append(ingredients, parseIngredient(st))
+						ingredients = append(ingredients, ParseRecipeIngredient(st))
 					}
 				case map[string]interface{}:
 					typeVal, _ := st["@type"].(string)
@@ -432,9 +439,9 @@ func ToModelRecipe(schemaRecipe SchemaOrgRecipe) model.Recipe {
 						parseIngredientGroups(st["itemListElement"], name)
 					} else {
 						if txt, ok := st["text"].(string); ok && txt != "" {
-							ingredients = append(ingredients, parseIngredient(txt))
+							ingredients = append(ingredients, ParseRecipeIngredient(txt))
 						} else if txt, ok := st["name"].(string); ok && txt != "" {
-							ingredients = append(ingredients, parseIngredient(txt))
+							ingredients = append(ingredients, ParseRecipeIngredient(txt))
 						}
 					}
 				}
@@ -454,35 +461,59 @@ func ToModelRecipe(schemaRecipe SchemaOrgRecipe) model.Recipe {
 	var parseInstructions func(interface{}, string)
 	parseInstructions = func(instr interface{}, sectionTitle string) {
 		var steps []string
+		var details []model.RecipeStepDetail
+		addDirection := func() {
+			direction := model.RecipeDirection{Title: sectionTitle, Steps: steps}
+			if slices.ContainsFunc(details, func(detail model.RecipeStepDetail) bool { return !detail.IsZero() }) {
+				direction.StepDetails = details
+			}
+			directions = append(directions, direction)
+		}
 		switch v := instr.(type) {
 		case string:
-			if v != "" {
-				steps = append(steps, v)
+			// a single block of instructions has one step per line
+			for _, line := range strings.Split(v, "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					steps = append(steps, line)
+					details = append(details, model.RecipeStepDetail{})
+				}
 			}
+		case map[string]interface{}:
+			parseInstructions([]interface{}{v}, sectionTitle)
 		case []interface{}:
 			for _, step := range v {
 				switch st := step.(type) {
 				case string:
 					if st != "" {
 						steps = append(steps, st)
+						details = append(details, model.RecipeStepDetail{})
 					}
 				case map[string]interface{}:
 					typeVal, _ := st["@type"].(string)
 					if typeVal == "HowToSection" || typeVal == "ItemList" {
+						// steps before the section keep their own group
+						if len(steps) > 0 {
+							addDirection()
+							steps = nil
+							details = nil
+						}
 						name, _ := st["name"].(string)
 						parseInstructions(st["itemListElement"], name)
 					} else {
-						if txt, ok := st["text"].(string); ok && txt != "" {
-							steps = append(steps, txt)
-						} else if txt, ok := st["name"].(string); ok && txt != "" {
+						txt, _ := st["text"].(string)
+						if txt == "" {
+							txt, _ = st["name"].(string)
+						}
+						if txt != "" {
 							steps = append(steps, txt)
+							details = append(details, stepDetailFromSchemaOrg(st, txt, ingredientGroups))
 						}
 					}
 				}
 			}
 		}
 		if len(steps) > 0 {
-			directions = append(directions, model.RecipeDirection{Title: sectionTitle, Steps: steps})
+			addDirection()
 		}
 	}
 	parseInstructions(schemaRecipe.RecipeInstructions, "")
@@ -498,6 +529,13 @@ func parseImageURI(imageData interface{}) string {
 	switch v := imageData.(type) {
 	case string:
 		return v
+	case map[string]interface{}:
+		if imgURI, ok := v["url"].(string); ok {
+			return imgURI
+		}
+		if imgURI, ok := v["contentUrl"].(string); ok {
+			return imgURI
+		}
 	case []interface{}:
 		for _, item := range v {
 			if img, ok := item.(string); ok {
@@ -539,15 +577,12 @@ func timeToRFC3339(t time.Time) string {
 	return t.UTC().Format(time.RFC3339)
 }
 
-// ingredientToString converts a RecipeIngredient to a string for schema.org
-func ingredientToString(ing model.RecipeIngredient) string {
-	// Try to reconstruct the original string as best as possible
+// IngredientText renders a recipe ingredient as a single line of text such as
+// "1.5 cups flour" or "1 to 2 tbsp honey".
+func IngredientText(ing model.RecipeIngredient) string {
 	var parts []string
 	if ing.MeasurementAmount > 0 {
-		parts = append(parts, strings.TrimRight(strings.TrimRight(strconv.FormatFloat(ing.MeasurementAmount, 'f', -1, 64), "0"), "."))
-	}
-	if ing.MeasurementType != pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
-		parts = append(parts, pb.Recipe_MeasurementType_name[int32(ing.MeasurementType)])
+		parts = append(parts, measurement.Format(ing.MeasurementAmount, ing.MeasurementType))
 	}
 	if ing.MeasurementConjunction != pb.Recipe_Ingredient_MEASUREMENT_CONJUNCTION_UNSPECIFIED && ing.SecondMeasurementAmount > 0 {
 		conj := ""
@@ -562,10 +597,7 @@ func ingredientToString(ing model.RecipeIngredient) string {
 		if conj != "" {
 			parts = append(parts, conj)
 		}
-		parts = append(parts, strings.TrimRight(strings.TrimRight(strconv.FormatFloat(ing.SecondMeasurementAmount, 'f', -1, 64), "0"), "."))
-		if ing.SecondMeasurementType != pb.Recipe_MEASUREMENT_TYPE_UNSPECIFIED {
-			parts = append(parts, pb.Recipe_MeasurementType_name[int32(ing.SecondMeasurementType)])
-		}
+		parts = append(parts, measurement.Format(ing.SecondMeasurementAmount, ing.SecondMeasurementType))
 	}
 	if ing.Title != "" {
 		parts = append(parts, ing.Title)
@@ -573,28 +605,249 @@ func ingredientToString(ing model.RecipeIngredient) string {
 	return strings.Join(parts, " ")
 }
 
-// directionsToSchemaOrg converts []model.RecipeDirection to schema.org format
-func directionsToSchemaOrg(directions []model.RecipeDirection) interface{} {
+// directionsToSchemaOrg converts []model.RecipeDirection to schema.org format.
+// Steps with images are written as HowToStep objects with an image list.
+func directionsToSchemaOrg(directions []model.RecipeDirection, ingredientGroups []model.IngredientGroup, stepImages map[model.RecipeDirectionStep][]string) interface{} {
 	if len(directions) == 1 && directions[0].Title == "" {
 		// Single section, no title: return steps as []string
-		return directions[0].Steps
+		return stepsToSchemaOrg(directions[0], 0, ingredientGroups, stepImages)
 	}
 	var out []map[string]interface{}
-	for _, dir := range directions {
+	for i, dir := range directions {
 		section := map[string]interface{}{
 			"@type":           "HowToSection",
 			"name":            dir.Title,
-			"itemListElement": dir.Steps,
+			"itemListElement": stepsToSchemaOrg(dir, int32(i), ingredientGroups, stepImages),
 		}
 		out = append(out, section)
 	}
 	return out
 }
 
+// stepsToSchemaOrg returns the steps of a direction as strings, or as
+// HowToStep objects when the recipe has step images or the steps have
+// details.
+func stepsToSchemaOrg(direction model.RecipeDirection, directionIndex int32, ingredientGroups []model.IngredientGroup, stepImages map[model.RecipeDirectionStep][]string) interface{} {
+	hasDetails := slices.ContainsFunc(direction.StepDetails, func(detail model.RecipeStepDetail) bool {
+		return !detail.IsZero()
+	})
+	if len(stepImages) == 0 && !hasDetails {
+		return direction.Steps
+	}
+	var out []map[string]interface{}
+	for i, step := range direction.Steps {
+		howToStep := map[string]interface{}{
+			"@type": "HowToStep",
+			"text":  step,
+		}
+		if images := stepImages[model.RecipeDirectionStep{DirectionIndex: directionIndex, StepIndex: int32(i)}]; len(images) > 0 {
+			howToStep["image"] = images
+		}
+		if i < len(direction.StepDetails) && !direction.StepDetails[i].IsZero() {
+			howToStep["itemListElement"] = []map[string]interface{}{
+				stepDetailToSchemaOrg(step, direction.StepDetails[i], ingredientGroups),
+			}
+		}
+		out = append(out, howToStep)
+	}
+	return out
+}
+
+// stepDetailToSchemaOrg returns the details of a step as a HowToDirection.
+// The timers are its performTime, the ingredients its supplies and the
+// temperatures are PropertyValues with UN/CEFACT unit codes.
+func stepDetailToSchemaOrg(step string, detail model.RecipeStepDetail, ingredientGroups []model.IngredientGroup) map[string]interface{} {
+	direction := map[string]interface{}{
+		"@type": "HowToDirection",
+		"text":  step,
+	}
+
+	var performTimes []string
+	for _, timer := range detail.Timers {
+		performTimes = append(performTimes, durationToISO8601(timer.Duration))
+	}
+	if len(performTimes) > 0 {
+		direction["performTime"] = performTimes
+	}
+
+	var supplies []map[string]interface{}
+	for _, ingredient := range detail.Ingredients {
+		if int(ingredient.GroupIndex) >= len(ingredientGroups) || int(ingredient.IngredientIndex) >= len(ingredientGroups[ingredient.GroupIndex].RecipeIngredients) {
+			continue
+		}
+		supplies = append(supplies, map[string]interface{}{
+			"@type": "HowToSupply",
+			"name":  ingredientGroups[ingredient.GroupIndex].RecipeIngredients[ingredient.IngredientIndex].Title,
+		})
+	}
+	if len(supplies) > 0 {
+		direction["supply"] = supplies
+	}
+
+	var temperatures []map[string]interface{}
+	for _, temperature := range detail.Temperatures {
+		property := map[string]interface{}{
+			"@type": "PropertyValue",
+			"name":  "temperature",
+			"value": temperature.Value,
+		}
+		if unitCode := temperatureUnitCodes[temperature.Unit]; unitCode != "" {
+			property["unitCode"] = unitCode
+		}
+		temperatures = append(temperatures, property)
+	}
+	if len(temperatures) > 0 {
+		direction["additionalProperty"] = temperatures
+	}
+
+	return direction
+}
+
+// temperatureUnitCodes are the UN/CEFACT codes of the temperature units.
+var temperatureUnitCodes = map[pb.Recipe_TemperatureUnit]string{
+	pb.Recipe_TEMPERATURE_UNIT_FAHRENHEIT: "FAH",
+	pb.Recipe_TEMPERATURE_UNIT_CELSIUS:    "CEL",
+}
+
+// stepDetailFromSchemaOrg reads the details of a HowToStep from its
+// HowToDirection. The text of the timers and temperatures is taken from the
+// step when it mentions them.
+func stepDetailFromSchemaOrg(howToStep map[string]interface{}, text string, ingredientGroups []model.IngredientGroup) model.RecipeStepDetail {
+	var directions []map[string]interface{}
+	switch v := howToStep["itemListElement"].(type) {
+	case map[string]interface{}:
+		directions = append(directions, v)
+	case []interface{}:
+		for _, item := range v {
+			if direction, ok := item.(map[string]interface{}); ok {
+				directions = append(directions, direction)
+			}
+		}
+	}
+
+	parsed := recipesteps.Parse(text, ingredientGroups)
+	detail := model.RecipeStepDetail{}
+	for _, direction := range directions {
+		if typeVal, _ := direction["@type"].(string); typeVal != "HowToDirection" {
+			continue
+		}
+
+		for _, performTime := range AsStringSlice(direction["performTime"]) {
+			duration, err := parseISODuration(performTime)
+			if err != nil || duration <= 0 {
+				continue
+			}
+			timer := model.RecipeStepTimer{Duration: duration}
+			if i := slices.IndexFunc(parsed.Timers, func(t model.RecipeStepTimer) bool { return t.Duration == duration }); i >= 0 {
+				timer.Text = parsed.Timers[i].Text
+			}
+			detail.Timers = append(detail.Timers, timer)
+		}
+
+		for _, supply := range asObjects(direction["supply"]) {
+			name, _ := supply["name"].(string)
+			if ingredient, ok := findIngredient(ingredientGroups, name); ok {
+				detail.Ingredients = append(detail.Ingredients, ingredient)
+			}
+		}
+
+		for _, property := range asObjects(direction["additionalProperty"]) {
+			if name, _ := property["name"].(string); name != "temperature" {
+				continue
+			}
+			value, ok := property["value"].(float64)
+			if !ok {
+				continue
+			}
+			temperature := model.RecipeStepTemperature{Value: value}
+			unitCode, _ := property["unitCode"].(string)
+			for unit, code := range temperatureUnitCodes {
+				if code == unitCode {
+					temperature.Unit = unit
+				}
+			}
+			if i := slices.IndexFunc(parsed.Temperatures, func(t model.RecipeStepTemperature) bool {
+				return t.Value == temperature.Value && t.Unit == temperature.Unit
+			}); i >= 0 {
+				temperature.Text = parsed.Temperatures[i].Text
+			}
+			detail.Temperatures = append(detail.Temperatures, temperature)
+		}
+	}
+
+	return detail
+}
+
+// asObjects returns a JSON-LD value that is an object or a list of objects as
+// a list of objects.
+func asObjects(v interface{}) []map[string]interface{} {
+	var out []map[string]interface{}
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out = append(out, val)
+	case []interface{}:
+		for _, item := range val {
+			if object, ok := item.(map[string]interface{}); ok {
+				out = append(out, object)
+			}
+		}
+	}
+	return out
+}
+
+// findIngredient finds the ingredient with the same normalized title.
+func findIngredient(ingredientGroups []model.IngredientGroup, title string) (model.RecipeStepIngredient, bool) {
+	name := ingredientcatalog.Normalize(title)
+	if name == "" {
+		return model.RecipeStepIngredient{}, false
+	}
+	for i, group := range ingredientGroups {
+		for j, ingredient := range group.RecipeIngredients {
+			if ingredientcatalog.Normalize(ingredient.Title) == name {
+				return model.RecipeStepIngredient{GroupIndex: int32(i), IngredientIndex: int32(j)}, true
+			}
+		}
+	}
+	return model.RecipeStepIngredient{}, false
+}
+
+// imagesToSchemaOrg returns the cover image followed by the gallery images of
+// a recipe, or just the cover image when it has no gallery.
+func imagesToSchemaOrg(r model.Recipe) interface{} {
+	var images []string
+	if r.ImageURI != "" {
+		images = append(images, r.ImageURI)
+	}
+	for _, image := range r.Images {
+		if image.Step == nil && image.ImageURI != r.ImageURI {
+			images = append(images, image.ImageURI)
+		}
+	}
+	if len(images) <= 1 {
+		return r.ImageURI
+	}
+	return images
+}
+
+// stepImagesByStep groups the step images of a recipe by their step.
+func stepImagesByStep(images []model.RecipeImage) map[model.RecipeDirectionStep][]string {
+	stepImages := map[model.RecipeDirectionStep][]string{}
+	for _, image := range images {
+		if image.Step != nil {
+			stepImages[*image.Step] = append(stepImages[*image.Step], image.ImageURI)
+		}
+	}
+	return stepImages
+}
+
 func ingredientsToSchemaOrg(ingredients []model.IngredientGroup) interface{} {
 	if len(ingredients) == 1 && ingredients[0].Title == "" {
-		// Single section, no title: return steps as []string
-		return ingredients[0].RecipeIngredients
+		// Single section, no title: return ingredients as []string
+		var out []string
+		for _, ingredient := range ingredients[0].RecipeIngredients {
+			out = append(out, IngredientText(ingredient))
+		}
+		return out
 	}
 	var out []map[string]interface{}
 	for _, group := range ingredients {
@@ -602,7 +855,7 @@ func ingredientsToSchemaOrg(ingredients []model.IngredientGroup) interface{} {
 		for _, ingredient := range group.RecipeIngredients {
 			ings = append(ings, map[string]interface{}{
 				"@type": "HowToStep",
-				"text":  ingredientToString(ingredient),
+				"text":  IngredientText(ingredient),
 			})
 		}
 		section := map[string]interface{}{
@@ -622,9 +875,9 @@ func ToSchemaOrgRecipe(r model.Recipe) SchemaOrgRecipe {
 		Type:               "Recipe",
 		Name:               r.Title,
 		Description:        r.Description,
-		Image:              r.ImageURI,
+		Image:              imagesToSchemaOrg(r),
 		RecipeIngredient:   ingredientsToSchemaOrg(r.IngredientGroups),
-		RecipeInstructions: directionsToSchemaOrg(r.Directions),
+		RecipeInstructions: directionsToSchemaOrg(r.Directions, r.IngredientGroups, stepImagesByStep(r.Images)),
 		RecipeYield:        r.YieldAmount,
 		DatePublished:      timeToRFC3339(r.CreateTime),
 		PrepTime:           durationToISO8601(r.PrepDuration),
@@ -634,5 +887,21 @@ func ToSchemaOrgRecipe(r model.Recipe) SchemaOrgRecipe {
 		RecipeCuisine:      r.Cuisines,
 		CookingMethod:      r.CookingMethod,
 		MainEntityOfPage:   r.Citation,
+		AggregateRating:    aggregateRatingToSchemaOrg(r.AverageRating, r.RatingCount),
+	}
+}
+
+// aggregateRatingToSchemaOrg returns the schema.org AggregateRating of a
+// recipe, or nil when it has no ratings.
+func aggregateRatingToSchemaOrg(averageRating float64, ratingCount int64) interface{} {
+	if ratingCount == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"@type":       "AggregateRating",
+		"ratingValue": measurement.FormatAmount(averageRating),
+		"ratingCount": ratingCount,
+		"bestRating":  5,
+		"worstRating": 1,
 	}
 }
@@ -1,7 +1,13 @@
 package schemaorgrecipe
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	pb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
 )
 
 func TestParseIngredient(t *testing.T) {
@@ -43,3 +49,77 @@ func TestParseIngredient(t *testing.T) {
 		}
 	}
 }
+
+func TestToSchemaOrgRecipeImages(t *testing.T) {
+	recipe := model.Recipe{
+		ImageURI: "cover.jpg",
+		Directions: []model.RecipeDirection{
+			{Steps: []string{"Mix", "Bake"}},
+		},
+		Images: []model.RecipeImage{
+			{ImageURI: "cover.jpg"},
+			{ImageURI: "gallery.jpg"},
+			{ImageURI: "step.jpg", Step: &model.RecipeDirectionStep{DirectionIndex: 0, StepIndex: 1}},
+		},
+	}
+
+	schemaRecipe := ToSchemaOrgRecipe(recipe)
+
+	if !reflect.DeepEqual(schemaRecipe.Image, []string{"cover.jpg", "gallery.jpg"}) {
+		t.Errorf("Image = %v, want [cover.jpg gallery.jpg]", schemaRecipe.Image)
+	}
+
+	steps, ok := schemaRecipe.RecipeInstructions.([]map[string]interface{})
+	if !ok || len(steps) != 2 {
+		t.Fatalf("RecipeInstructions = %v, want 2 HowToSteps", schemaRecipe.RecipeInstructions)
+	}
+	if _, ok := steps[0]["image"]; ok {
+		t.Errorf("step 0 image = %v, want none", steps[0]["image"])
+	}
+	if !reflect.DeepEqual(steps[1]["image"], []string{"step.jpg"}) {
+		t.Errorf("step 1 image = %v, want [step.jpg]", steps[1]["image"])
+	}
+
+	recipe.Images = nil
+	schemaRecipe = ToSchemaOrgRecipe(recipe)
+	if schemaRecipe.Image != "cover.jpg" {
+		t.Errorf("Image = %v, want cover.jpg", schemaRecipe.Image)
+	}
+	if !reflect.DeepEqual(schemaRecipe.RecipeInstructions, []string{"Mix", "Bake"}) {
+		t.Errorf("RecipeInstructions = %v, want [Mix Bake]", schemaRecipe.RecipeInstructions)
+	}
+}
+
+func TestSchemaOrgRecipeStepDetailsRoundTrip(t *testing.T) {
+	recipe := model.Recipe{
+		IngredientGroups: []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{
+			{MeasurementAmount: 2, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP, Title: "rice"},
+			{MeasurementAmount: 3, MeasurementType: pb.Recipe_MEASUREMENT_TYPE_CUP, Title: "water"},
+		}}},
+		Directions: []model.RecipeDirection{{
+			Steps: []string{"Rinse the rice", "Simmer the rice in the water at 95°C for 20 minutes"},
+			StepDetails: []model.RecipeStepDetail{
+				{},
+				{
+					Timers:       []model.RecipeStepTimer{{Text: "20 minutes", Duration: 20 * time.Minute}},
+					Temperatures: []model.RecipeStepTemperature{{Text: "95°C", Value: 95, Unit: pb.Recipe_TEMPERATURE_UNIT_CELSIUS}},
+					Ingredients:  []model.RecipeStepIngredient{{GroupIndex: 0, IngredientIndex: 1}},
+				},
+			},
+		}},
+	}
+
+	data, err := json.Marshal(ToSchemaOrgRecipe(recipe))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schemaRecipe SchemaOrgRecipe
+	if err := json.Unmarshal(data, &schemaRecipe); err != nil {
+		t.Fatal(err)
+	}
+
+	got := ToModelRecipe(schemaRecipe)
+	if !reflect.DeepEqual(got.Directions, recipe.Directions) {
+		t.Errorf("Directions = %+v, want %+v", got.Directions, recipe.Directions)
+	}
+}
@@ -0,0 +1,84 @@
+package images
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	config "github.com/jcfug8/daylear/server/adapters/clients/config"
+	gorm "github.com/jcfug8/daylear/server/adapters/clients/gorm"
+	gorm_dialer "github.com/jcfug8/daylear/server/adapters/clients/gorm/dialer"
+	"github.com/jcfug8/daylear/server/adapters/clients/gorm/dialer/dialects/postgres"
+	"github.com/jcfug8/daylear/server/adapters/clients/http/fileretriever"
+	"github.com/jcfug8/daylear/server/adapters/clients/imagemagick"
+	tokenClient "github.com/jcfug8/daylear/server/adapters/clients/jwt/token"
+	llmProvider "github.com/jcfug8/daylear/server/adapters/clients/llm/provider"
+	s3 "github.com/jcfug8/daylear/server/adapters/clients/s3"
+	logger "github.com/jcfug8/daylear/server/adapters/clients/zerolog/logger"
+	domain "github.com/jcfug8/daylear/server/domain"
+	domainPort "github.com/jcfug8/daylear/server/ports/domain"
+	"go.uber.org/fx"
+)
+
+const (
+	// ImagesCmd defines the command for managing stored images.
+	ImagesCmd = "images"
+	// BackfillCmd defines the sub command for generating missing image variants.
+	BackfillCmd = "backfill"
+)
+
+// Images runs an images sub command.
+func Images(args ...string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: daylearctl %s %s [-timeout duration]", ImagesCmd, BackfillCmd)
+	}
+
+	switch args[0] {
+	case BackfillCmd:
+		return backfill(args[1:]...)
+	default:
+		return fmt.Errorf("unknown %s command: %s", ImagesCmd, args[0])
+	}
+}
+
+// backfill generates the variants and placeholders of the recipe, recipe
+// gallery, user and circle images uploaded before they were generated.
+func backfill(args ...string) error {
+	flags := flag.NewFlagSet(BackfillCmd, flag.ContinueOnError)
+	timeout := flags.Duration("timeout", 6*time.Hour, "maximum time to run the backfill for")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	var d domainPort.Domain
+	app := fx.New(
+		logger.Module,
+		config.Module,
+		gorm.Module,
+		gorm_dialer.Module,
+		postgres.Module,
+		tokenClient.Module,
+		s3.Module,
+		imagemagick.Module,
+		fileretriever.Module,
+		llmProvider.Module,
+		domain.Module,
+		fx.Populate(&d),
+		fx.WithLogger(logger.NewFxLogger),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	err = app.Start(ctx)
+	if err != nil {
+		return err
+	}
+	defer app.Stop(context.Background())
+
+	updated, failed, err := d.BackfillImageSets(ctx)
+	fmt.Printf("Backfilled %d images, %d failed\n", updated, failed)
+	return err
+}
@@ -4,18 +4,21 @@ import (
 	"flag"
 
 	config "github.com/jcfug8/daylear/server/adapters/clients/config"
-	"github.com/jcfug8/daylear/server/adapters/clients/gemini"
 	gorm "github.com/jcfug8/daylear/server/adapters/clients/gorm"
 	gorm_dialer "github.com/jcfug8/daylear/server/adapters/clients/gorm/dialer"
 	"github.com/jcfug8/daylear/server/adapters/clients/gorm/dialer/dialects/postgres"
 	"github.com/jcfug8/daylear/server/adapters/clients/http/fileretriever"
 	"github.com/jcfug8/daylear/server/adapters/clients/imagemagick"
 	tokenClient "github.com/jcfug8/daylear/server/adapters/clients/jwt/token"
+	llmProvider "github.com/jcfug8/daylear/server/adapters/clients/llm/provider"
 	s3 "github.com/jcfug8/daylear/server/adapters/clients/s3"
 	grpcCalendarsV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/calendars/calendar/v1alpha1"
 	grpcCirclesV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/circles/circle/v1alpha1"
 	grpcListsV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/lists/list/v1alpha1"
+	grpcCookbooksV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/meals/cookbooks/v1alpha1"
 	grpcRecipesV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/meals/recipes/v1alpha1"
+	grpcOperationsV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/operations/operation/v1alpha1"
+	grpcPantriesV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/pantries/pantry/v1alpha1"
 	grpcUsersV1alpha1 "github.com/jcfug8/daylear/server/adapters/services/grpc/users/user/v1alpha1"
 	oauth2 "github.com/jcfug8/daylear/server/adapters/services/http/auth/oauth2"
 	tokenService "github.com/jcfug8/daylear/server/adapters/services/http/auth/token"
@@ -72,6 +75,12 @@ func start(opts ...fx.Option) error {
 		grpcCalendarsV1alpha1.Module,
 		// lists
 		grpcListsV1alpha1.Module,
+		// pantries
+		grpcPantriesV1alpha1.Module,
+		// cookbooks
+		grpcCookbooksV1alpha1.Module,
+		// operations
+		grpcOperationsV1alpha1.Module,
 
 		// driven/secondary adapters
 		gorm.Module,
@@ -81,10 +90,11 @@ func start(opts ...fx.Option) error {
 		s3.Module,
 		imagemagick.Module,
 		fileretriever.Module,
-		gemini.Module,
+		llmProvider.Module,
 
 		// domain
 		domain.Module,
+		domain.WorkersModule,
 
 		fx.WithLogger(logger.NewFxLogger),
 	}, opts...)
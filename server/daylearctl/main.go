@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/jcfug8/daylear/server/daylearctl/commands/images"
 	"github.com/jcfug8/daylear/server/daylearctl/commands/start"
 )
 
@@ -13,6 +14,8 @@ func main() {
 		switch os.Args[1] {
 		case "users":
 			// err = users.Users(os.Args[2:]...)
+		case images.ImagesCmd:
+			err = images.Images(os.Args[2:]...)
 		default:
 			fmt.Printf("Unknown command: %s\n", os.Args[1])
 			os.Exit(1)
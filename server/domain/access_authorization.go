@@ -98,6 +98,24 @@ func (d *Domain) determineAccessOwnershipDetails(ctx context.Context, authAccoun
 			}
 			return d.determineRecipeAccess(ctx, authAccount, a.RecipeId, withResourceVisibilityLevel(dbRecipe.VisibilityLevel), withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC))
 		}
+	case model.PantryAccess:
+		config.determineResourceAccess = func() (model.Access, error) {
+			dbPantry, err := d.repo.GetPantry(ctx, authAccount, a.PantryId, []string{model.PantryField_VisibilityLevel})
+			if err != nil {
+				log.Error().Err(err).Msg("unable to get pantry when determining access ownership details")
+				return model.PantryAccess{}, err
+			}
+			return d.determinePantryAccess(ctx, authAccount, a.PantryId, withResourceVisibilityLevel(dbPantry.VisibilityLevel), withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC))
+		}
+	case model.CookbookAccess:
+		config.determineResourceAccess = func() (model.Access, error) {
+			dbCookbook, err := d.repo.GetCookbook(ctx, authAccount, a.CookbookId, []string{model.CookbookField_VisibilityLevel})
+			if err != nil {
+				log.Error().Err(err).Msg("unable to get cookbook when determining access ownership details")
+				return model.CookbookAccess{}, err
+			}
+			return d.determineCookbookAccess(ctx, authAccount, a.CookbookId, withResourceVisibilityLevel(dbCookbook.VisibilityLevel), withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC))
+		}
 	default:
 		log.Warn().Msg("unable to determine access ownership details for unknown access type")
 		return accessOwnershipDetails{}, domain.ErrInternal{Msg: "unable to determine access ownership details for unknown access type"}
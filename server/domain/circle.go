@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"path"
 	"regexp"
 	"slices"
 	"strconv"
@@ -16,7 +15,6 @@ import (
 	model "github.com/jcfug8/daylear/server/core/model"
 	"github.com/jcfug8/daylear/server/genapi/api/types"
 	domain "github.com/jcfug8/daylear/server/ports/domain"
-	uuid "github.com/satori/go.uuid"
 )
 
 // TODO: consolidate recipe image upload and circle image upload
@@ -46,7 +44,7 @@ func (d *Domain) CreateCircle(ctx context.Context, authAccount model.AuthAccount
 	}
 	defer tx.Rollback()
 
-	circle.ImageURI, err = d.createCircleImageURI(ctx, circle)
+	circle.ImageURI, circle.ImageSet, err = d.createCircleImageURI(ctx, circle)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to create circle image")
 		return model.Circle{}, domain.ErrInternal{Msg: "unable to create circle image"}
@@ -128,18 +126,40 @@ func (d *Domain) DeleteCircle(ctx context.Context, authAccount model.AuthAccount
 		}
 	}
 
+	// the members lose the recipes and lists shared with the circle along with the circle
+	memberIds, err := listCircleMemberIds(ctx, tx, authAccount, id)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list circle members")
+		return model.Circle{}, domain.ErrInternal{Msg: "unable to list circle members"}
+	}
+
 	err = tx.BulkDeleteCircleAccess(ctx, model.CircleAccessParent{CircleId: id})
 	if err != nil {
 		log.Error().Err(err).Msg("unable to delete circle accesses")
 		return model.Circle{}, domain.ErrInternal{Msg: "unable to delete circle accesses"}
 	}
 
+	for _, memberId := range memberIds {
+		err = d.pruneUserListItemAssignments(ctx, tx, memberId)
+		if err != nil {
+			log.Error().Err(err).Int64("userId", memberId.UserId).Msg("unable to prune list item assignments when deleting a circle")
+			return model.Circle{}, domain.ErrInternal{Msg: "unable to prune list item assignments"}
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		log.Error().Err(err).Msg("unable to finish deleting circle")
 		return model.Circle{}, domain.ErrInternal{Msg: "unable to finish deleting circle"}
 	}
 
+	for _, memberId := range memberIds {
+		err = d.pruneUserRecipeNotes(ctx, memberId)
+		if err != nil {
+			log.Warn().Err(err).Int64("userId", memberId.UserId).Msg("unable to prune recipe notes when deleting a circle")
+		}
+	}
+
 	return circle, nil
 }
 
@@ -229,6 +249,11 @@ func (d *Domain) UpdateCircle(ctx context.Context, authAccount model.AuthAccount
 		return model.Circle{}, err
 	}
 
+	// the image set is generated from the uploaded image
+	fields = slices.DeleteFunc(slices.Clone(fields), func(field string) bool {
+		return field == model.CircleField_ImageSet
+	})
+
 	if slices.Contains(fields, model.CircleField_Handle) && circle.Handle == "" {
 		circle.Handle, _ = d.generateUniqueCircleHandle(ctx, circle.Title)
 	}
@@ -247,11 +272,12 @@ func (d *Domain) UpdateCircle(ctx context.Context, authAccount model.AuthAccount
 	defer tx.Rollback()
 
 	if slices.Contains(fields, model.CircleField_ImageURI) && circle.ImageURI != previousDbCircle.ImageURI {
-		circle.ImageURI, err = d.updateCircleImageURI(ctx, authAccount, circle)
+		circle.ImageURI, circle.ImageSet, err = d.updateCircleImageURI(ctx, authAccount, circle)
 		if err != nil {
 			log.Error().Err(err).Msg("unable to update circle image")
 			return model.Circle{}, domain.ErrInternal{Msg: "unable to update circle image"}
 		}
+		fields = append(fields, model.CircleField_ImageSet)
 	}
 
 	updated, err := d.repo.UpdateCircle(ctx, authAccount, circle, fields)
@@ -289,14 +315,13 @@ func (d *Domain) UploadCircleImage(ctx context.Context, authAccount model.AuthAc
 		return "", err
 	}
 
-	dbCircle, err := d.repo.GetCircle(ctx, authAccount, id, []string{model.CircleField_ImageURI})
+	dbCircle, err := d.repo.GetCircle(ctx, authAccount, id, []string{model.CircleField_ImageURI, model.CircleField_ImageSet})
 	if err != nil {
 		log.Error().Err(err).Msg("unable to get circle when uploading a circle image")
 		return "", domain.ErrInternal{Msg: "unable to get circle"}
 	}
-	oldImageURI := dbCircle.ImageURI
 
-	imageURI, err = d.uploadCircleImage(ctx, id, imageReader)
+	imageURI, imageSet, err := d.uploadCircleImage(ctx, id, imageReader)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to upload circle image")
 		return "", domain.ErrInternal{Msg: "unable to upload circle image"}
@@ -305,117 +330,62 @@ func (d *Domain) UploadCircleImage(ctx context.Context, authAccount model.AuthAc
 	_, err = d.repo.UpdateCircle(ctx, authAccount, model.Circle{
 		Id:       id,
 		ImageURI: imageURI,
-	}, []string{model.CircleField_ImageURI})
+		ImageSet: imageSet,
+	}, []string{model.CircleField_ImageURI, model.CircleField_ImageSet})
 	if err != nil {
 		log.Error().Err(err).Msg("unable to update circle image")
 		return "", domain.ErrInternal{Msg: "unable to update circle image"}
 	}
 
-	go d.fileStore.DeleteFile(context.Background(), oldImageURI)
+	go d.deleteImageFiles(context.Background(), dbCircle.ImageURI, dbCircle.ImageSet)
 
 	return imageURI, nil
 }
 
 // Helper methods for image handling
-func (d *Domain) createCircleImageURI(ctx context.Context, circle model.Circle) (string, error) {
+func (d *Domain) createCircleImageURI(ctx context.Context, circle model.Circle) (string, model.ImageSet, error) {
 	if circle.ImageURI == "" {
-		return "", nil
+		return "", model.ImageSet{}, nil
 	}
 
 	fileContents, err := d.fileRetriever.GetFileContents(ctx, circle.ImageURI)
 	if err != nil {
-		return "", err
+		return "", model.ImageSet{}, err
 	}
 	defer fileContents.Close()
 
-	imageURI, err := d.uploadCircleImage(ctx, circle.Id, fileContents)
-	if err != nil {
-		return "", err
-	}
-
-	return imageURI, nil
+	return d.uploadCircleImage(ctx, circle.Id, fileContents)
 }
 
-func (d *Domain) updateCircleImageURI(ctx context.Context, authAccount model.AuthAccount, circle model.Circle) (string, error) {
+func (d *Domain) updateCircleImageURI(ctx context.Context, authAccount model.AuthAccount, circle model.Circle) (string, model.ImageSet, error) {
 	if circle.ImageURI == "" {
 		err := d.removeCircleImage(ctx, authAccount, circle.Id)
 		if err != nil {
-			return "", err
+			return "", model.ImageSet{}, err
 		}
-		return "", nil
+		return "", model.ImageSet{}, nil
 	}
 
 	fileContents, err := d.fileRetriever.GetFileContents(ctx, circle.ImageURI)
 	if err != nil {
-		return "", err
+		return "", model.ImageSet{}, err
 	}
 	defer fileContents.Close()
 
-	imageURI, err := d.uploadCircleImage(ctx, circle.Id, fileContents)
-	if err != nil {
-		return "", err
-	}
-
-	return imageURI, nil
+	return d.uploadCircleImage(ctx, circle.Id, fileContents)
 }
 
-func (d *Domain) uploadCircleImage(ctx context.Context, id model.CircleId, imageReader io.Reader) (imageURL string, err error) {
-	image, err := d.imageClient.CreateImage(ctx, imageReader)
-	if err != nil {
-		return "", err
-	}
-
-	err = image.Convert(ctx, "jpg")
-	if err != nil {
-		return "", err
-	}
-
-	width, height, err := image.GetDimensions(ctx)
-	if err != nil {
-		return "", err
-	}
-
-	if width > maxImageWidth || height > maxImageHeight {
-		newWidth, newHeight := resizeToFit(width, height, maxImageWidth)
-		err = image.Resize(ctx, newWidth, newHeight)
-		if err != nil {
-			return "", err
-		}
-	}
-
-	file, err := image.GetFile()
-	if err != nil {
-		return "", err
-	}
-	defer image.Remove(ctx)
-
-	imagePath := path.Join(CircleImageRoot, strconv.FormatInt(id.CircleId, 10), uuid.NewV4().String())
-	imagePath = fmt.Sprintf("%s%s", imagePath, file.Extension)
-
-	imageURI, err := d.fileStore.UploadPublicFile(ctx, imagePath, file)
-	if err != nil {
-		return "", err
-	}
-
-	return imageURI, nil
+func (d *Domain) uploadCircleImage(ctx context.Context, id model.CircleId, imageReader io.Reader) (imageURI string, imageSet model.ImageSet, err error) {
+	return d.uploadImage(ctx, CircleImageRoot, id.CircleId, imageReader)
 }
 
 func (d *Domain) removeCircleImage(ctx context.Context, authAccount model.AuthAccount, id model.CircleId) (err error) {
-	circle, err := d.GetCircle(ctx, authAccount, model.CircleParent{}, id, []string{model.CircleField_ImageURI})
-	if err != nil {
-		return err
-	}
-
-	if circle.ImageURI == "" {
-		return nil
-	}
-
-	err = d.fileStore.DeleteFile(ctx, circle.ImageURI)
+	circle, err := d.GetCircle(ctx, authAccount, model.CircleParent{}, id, []string{model.CircleField_ImageURI, model.CircleField_ImageSet})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return d.deleteImageFiles(ctx, circle.ImageURI, circle.ImageSet)
 }
 
 // generateUniqueCircleHandle generates a unique handle for a circle based on the title or a random string
@@ -80,12 +80,36 @@ func (d *Domain) DeleteCircleAccess(ctx context.Context, authAccount model.AuthA
 		return domain.ErrPermissionDenied{Msg: "access denied"}
 	}
 
-	err = d.repo.DeleteCircleAccess(ctx, parent, id)
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin deleting circle access")
+		return domain.ErrInternal{Msg: "unable to begin deleting circle access"}
+	}
+	defer tx.Rollback()
+
+	err = tx.DeleteCircleAccess(ctx, parent, id)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to delete circle access when deleting a circle access")
 		return domain.ErrInternal{Msg: "unable to delete circle access"}
 	}
 
+	err = d.pruneUserListItemAssignments(ctx, tx, dbAccess.Recipient)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prune list item assignments when deleting a circle access")
+		return domain.ErrInternal{Msg: "unable to prune list item assignments"}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to finish deleting circle access")
+		return domain.ErrInternal{Msg: "unable to finish deleting circle access"}
+	}
+
+	err = d.pruneUserRecipeNotes(ctx, dbAccess.Recipient)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to prune recipe notes when deleting a circle access")
+	}
+
 	return nil
 }
 
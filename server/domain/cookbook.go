@@ -0,0 +1,281 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// CreateCookbook creates a new cookbook.
+func (d *Domain) CreateCookbook(ctx context.Context, authAccount model.AuthAccount, cookbook model.Cookbook) (dbCookbook model.Cookbook, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if cookbook.Parent.CircleId != 0 && authAccount.CircleId != 0 && cookbook.Parent.CircleId != authAccount.CircleId {
+		log.Warn().Msg("both circle ids set but do not match")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "both circle ids set but do not match"}
+	}
+
+	if cookbook.Title == "" {
+		log.Warn().Msg("title required when creating a cookbook")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "title required"}
+	}
+
+	if cookbook.Parent.CircleId != 0 {
+		authAccount.CircleId = cookbook.Parent.CircleId
+	} else if cookbook.Parent.UserId != 0 {
+		authAccount.UserId = cookbook.Parent.UserId
+	}
+
+	cookbook.Id.CookbookId = 0
+
+	if cookbook.Parent.CircleId != 0 {
+		_, err = d.determineCircleAccess(ctx, authAccount, model.CircleId{CircleId: cookbook.Parent.CircleId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine circle access")
+			return model.Cookbook{}, err
+		}
+	} else if cookbook.Parent.UserId != 0 {
+		_, err = d.determineUserAccess(ctx, authAccount, model.UserId{UserId: authAccount.UserId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_ADMIN))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine user access")
+			return model.Cookbook{}, err
+		}
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.Cookbook{}, err
+	}
+	defer tx.Rollback()
+
+	dbCookbook, err = tx.CreateCookbook(ctx, authAccount, cookbook)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CreateCookbook failed")
+		return model.Cookbook{}, err
+	}
+
+	cookbookAccess := model.CookbookAccess{
+		CookbookAccessParent: model.CookbookAccessParent{
+			CookbookId: dbCookbook.Id,
+		},
+		PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_ADMIN,
+		State:           types.AccessState_ACCESS_STATE_ACCEPTED,
+		Requester: model.CookbookRecipientOrRequester{
+			UserId: authAccount.AuthUserId,
+		},
+	}
+	if authAccount.CircleId != 0 {
+		cookbookAccess.Recipient = model.CookbookRecipientOrRequester{
+			CircleId: authAccount.CircleId,
+		}
+	} else {
+		cookbookAccess.Recipient = model.CookbookRecipientOrRequester{
+			UserId: authAccount.AuthUserId,
+		}
+	}
+
+	dbCookbookAccess, err := tx.CreateCookbookAccess(ctx, cookbookAccess, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CreateCookbookAccess failed")
+		return model.Cookbook{}, err
+	}
+
+	dbCookbook.CookbookAccess = dbCookbookAccess
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.Cookbook{}, err
+	}
+
+	dbCookbook.Parent = cookbook.Parent
+
+	return dbCookbook, nil
+}
+
+// GetCookbook gets a cookbook.
+func (d *Domain) GetCookbook(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookParent, id model.CookbookId, fields []string) (cookbook model.Cookbook, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("parent required")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "parent required"}
+	}
+
+	if id.CookbookId == 0 {
+		log.Warn().Msg("id required")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	cookbook, err = d.repo.GetCookbook(ctx, authAccount, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetCookbook failed")
+		return model.Cookbook{}, err
+	}
+
+	cookbook.CookbookAccess, err = d.determineCookbookAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(cookbook.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+		withAllowPendingAccess(),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access")
+		return model.Cookbook{}, err
+	}
+
+	cookbook.Parent = parent
+
+	return cookbook, nil
+}
+
+// ListCookbooks lists cookbooks.
+func (d *Domain) ListCookbooks(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookParent, pageSize int32, pageOffset int32, filter string, fields []string) (cookbooks []model.Cookbook, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user_id required")
+		return nil, domain.ErrInvalidArgument{Msg: "user_id required"}
+	}
+
+	authAccount.PermissionLevel = types.PermissionLevel_PERMISSION_LEVEL_ADMIN
+
+	if parent.CircleId != 0 {
+		authAccount.CircleId = parent.CircleId
+		dbCircle, err := d.repo.GetCircle(ctx, authAccount, model.CircleId{CircleId: parent.CircleId}, []string{model.CircleField_Visibility})
+		if err != nil {
+			log.Error().Err(err).Msg("unable to get circle when listing cookbooks")
+			return nil, domain.ErrInternal{Msg: "unable to get circle when listing cookbooks"}
+		}
+		determinedCircleAccess, err := d.determineCircleAccess(
+			ctx, authAccount, model.CircleId{CircleId: parent.CircleId},
+			withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+			withResourceVisibilityLevel(dbCircle.VisibilityLevel),
+		)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine access when listing cookbooks")
+			return nil, err
+		}
+		authAccount.PermissionLevel = determinedCircleAccess.GetPermissionLevel()
+	} else if parent.UserId != 0 {
+		authAccount.UserId = parent.UserId
+		determinedUserAccess, err := d.determineUserAccess(
+			ctx, authAccount, model.UserId{UserId: authAccount.UserId},
+			withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+			withResourceVisibilityLevel(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine access when listing cookbooks")
+			return nil, err
+		}
+		authAccount.PermissionLevel = determinedUserAccess.GetPermissionLevel()
+	}
+
+	cookbooks, err = d.repo.ListCookbooks(ctx, authAccount, pageSize, pageOffset, filter, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListCookbooks failed")
+		return nil, err
+	}
+
+	for i := range cookbooks {
+		cookbooks[i].Parent = parent
+	}
+
+	return cookbooks, nil
+}
+
+// UpdateCookbook updates a cookbook.
+func (d *Domain) UpdateCookbook(ctx context.Context, authAccount model.AuthAccount, cookbook model.Cookbook, fields []string) (dbCookbook model.Cookbook, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("parent required")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "parent required"}
+	}
+
+	if cookbook.Id.CookbookId == 0 {
+		log.Warn().Msg("id required")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err = d.determineCookbookAccess(
+		ctx, authAccount, cookbook.Id,
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access")
+		return model.Cookbook{}, err
+	}
+
+	dbCookbook, err = d.repo.UpdateCookbook(ctx, authAccount, cookbook, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateCookbook failed")
+		return model.Cookbook{}, err
+	}
+
+	dbCookbook.Parent = cookbook.Parent
+
+	return dbCookbook, nil
+}
+
+// DeleteCookbook deletes a cookbook along with its accesses and entries.
+func (d *Domain) DeleteCookbook(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookParent, id model.CookbookId) (dbCookbook model.Cookbook, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("parent required")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "parent required"}
+	}
+
+	if id.CookbookId == 0 {
+		log.Warn().Msg("id required")
+		return model.Cookbook{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err = d.determineCookbookAccess(
+		ctx, authAccount, id,
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_ADMIN),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access")
+		return model.Cookbook{}, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.Cookbook{}, err
+	}
+	defer tx.Rollback()
+
+	err = tx.BulkDeleteCookbookEntries(ctx, model.CookbookEntryParent{CookbookId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete cookbook entries")
+		return model.Cookbook{}, err
+	}
+
+	err = tx.BulkDeleteCookbookAccess(ctx, model.CookbookAccessParent{CookbookId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete cookbook accesses")
+		return model.Cookbook{}, err
+	}
+
+	dbCookbook, err = tx.DeleteCookbook(ctx, authAccount, id)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.DeleteCookbook failed")
+		return model.Cookbook{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.Cookbook{}, err
+	}
+
+	dbCookbook.Parent = parent
+
+	return dbCookbook, nil
+}
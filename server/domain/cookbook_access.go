@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// Cookbook Access Methods
+func (d *Domain) CreateCookbookAccess(ctx context.Context, authAccount model.AuthAccount, access model.CookbookAccess) (cookbookAccess model.CookbookAccess, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if access.CookbookAccessParent.CookbookId.CookbookId == 0 {
+		log.Warn().Msg("cookbook id is required when creating a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "cookbook id is required"}
+	}
+
+	determinedCookbookAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, access)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access ownership details when creating a cookbook access")
+		return model.CookbookAccess{}, err
+	}
+
+	access.Requester = model.CookbookRecipientOrRequester{
+		UserId: authAccount.AuthUserId,
+	}
+	access.AcceptTarget = determinedCookbookAccessOwnershipDetails.acceptTarget
+	access.State = determinedCookbookAccessOwnershipDetails.accessState
+
+	if access.PermissionLevel > determinedCookbookAccessOwnershipDetails.maximumPermissionLevel {
+		log.Warn().Msg("unable to create cookbook access with the given permission level")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "cannot create access level higher than your own level"}
+	}
+
+	// create access
+	access, err = d.repo.CreateCookbookAccess(ctx, access, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create cookbook access")
+		return model.CookbookAccess{}, err
+	}
+
+	return access, nil
+}
+
+func (d *Domain) DeleteCookbookAccess(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, id model.CookbookAccessId) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	// verify cookbook is set
+	if parent.CookbookId.CookbookId == 0 {
+		log.Warn().Msg("cookbook id is required when deleting a cookbook access")
+		return domain.ErrInvalidArgument{Msg: "cookbook id is required"}
+	}
+
+	// verify access id is set
+	if id.CookbookAccessId == 0 {
+		log.Warn().Msg("access id is required when deleting a cookbook access")
+		return domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	dbAccess, err := d.repo.GetCookbookAccess(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get cookbook access")
+		return err
+	}
+
+	determinedCookbookAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, dbAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access ownership details when deleting a cookbook access")
+		return domain.ErrInternal{Msg: "unable to determine cookbook access ownership details"}
+	}
+
+	if !determinedCookbookAccessOwnershipDetails.isRecipientOwner && !determinedCookbookAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("access denied when deleting a cookbook access")
+		return domain.ErrPermissionDenied{Msg: "access denied"}
+	}
+
+	err = d.repo.DeleteCookbookAccess(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete cookbook access")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Domain) GetCookbookAccess(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, id model.CookbookAccessId, fields []string) (model.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if parent.CookbookId.CookbookId == 0 {
+		log.Warn().Msg("cookbook id is required when getting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "cookbook id is required"}
+	}
+
+	// verify access id is set
+	if id.CookbookAccessId == 0 {
+		log.Warn().Msg("access id is required when getting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	dbAccess, err := d.repo.GetCookbookAccess(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get cookbook access when getting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInternal{Msg: "unable to get cookbook access"}
+	}
+
+	determinedCookbookAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, dbAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access ownership details when getting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInternal{Msg: "unable to determine cookbook access ownership details"}
+	}
+
+	if !determinedCookbookAccessOwnershipDetails.isRecipientOwner && !determinedCookbookAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("access denied when getting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrPermissionDenied{Msg: "access denied"}
+	}
+
+	return dbAccess, nil
+}
+
+func (d *Domain) ListCookbookAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) (cookbookAccesses []model.CookbookAccess, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 && authAccount.CircleId == 0 {
+		log.Warn().Msg("requester is required when listing cookbook accesses")
+		return nil, domain.ErrInvalidArgument{Msg: "requester is required"}
+	}
+
+	if parent.CookbookId.CookbookId != 0 {
+		_, err := d.determineCookbookAccess(ctx, authAccount, parent.CookbookId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine cookbook access")
+			return nil, err
+		}
+	}
+
+	cookbookAccesses, err = d.repo.ListCookbookAccesses(ctx, authAccount, parent, int32(pageSize), int64(pageOffset), filter, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list cookbook accesses")
+		return nil, domain.ErrInternal{Msg: "unable to list cookbook accesses"}
+	}
+
+	return cookbookAccesses, nil
+}
+
+func (d *Domain) UpdateCookbookAccess(ctx context.Context, authAccount model.AuthAccount, access model.CookbookAccess, fields []string) (model.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if access.CookbookAccessParent.CookbookId.CookbookId == 0 {
+		log.Warn().Msg("cookbook id is required when updating a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "cookbook id is required"}
+	}
+
+	// verify access id is set
+	if access.CookbookAccessId.CookbookAccessId == 0 {
+		log.Warn().Msg("access id is required when updating a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	// get the existing access record to verify it exists
+	dbAccess, err := d.repo.GetCookbookAccess(ctx, access.CookbookAccessParent, access.CookbookAccessId, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get cookbook access")
+		return model.CookbookAccess{}, domain.ErrInternal{Msg: "unable to get cookbook access"}
+	}
+
+	determinedCookbookAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, dbAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access ownership details when updating a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInternal{Msg: "unable to determine cookbook access ownership details"}
+	}
+
+	if !determinedCookbookAccessOwnershipDetails.isRecipientOwner && !determinedCookbookAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("access denied when updating a cookbook access")
+		return model.CookbookAccess{}, domain.ErrPermissionDenied{Msg: "access denied"}
+	}
+
+	if slices.Contains(fields, model.CookbookAccessField_PermissionLevel) && determinedCookbookAccessOwnershipDetails.maximumPermissionLevel < access.PermissionLevel {
+		log.Warn().Msg("cannot update cookbook access permission level to a higher level than your own")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "cannot update cookbook access permission level to a higher level than your own"}
+	}
+
+	// update access
+	updatedAccess, err := d.repo.UpdateCookbookAccess(ctx, access, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update cookbook access")
+		return model.CookbookAccess{}, domain.ErrInternal{Msg: "unable to update cookbook access"}
+	}
+
+	return updatedAccess, nil
+}
+
+// AcceptCookbookAccess accepts a pending cookbook access.
+func (d *Domain) AcceptCookbookAccess(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, id model.CookbookAccessId) (model.CookbookAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if parent.CookbookId.CookbookId == 0 {
+		log.Warn().Msg("cookbook id is required when accepting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "cookbook id is required"}
+	}
+
+	// verify access id is set
+	if id.CookbookAccessId == 0 {
+		log.Warn().Msg("access id is required when accepting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	// get the current access
+	dbAccess, err := d.repo.GetCookbookAccess(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get cookbook access")
+		return model.CookbookAccess{}, err
+	}
+
+	// verify the access is in pending state
+	if dbAccess.State != types.AccessState_ACCESS_STATE_PENDING {
+		log.Warn().Msg("access must be in pending state to be accepted")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "access must be in pending state to be accepted"}
+	}
+
+	determinedCookbookAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(
+		ctx, authAccount, dbAccess,
+		withAllowAutoOmitAccessChecks(),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access ownership details when accepting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInternal{Msg: "unable to determine cookbook access ownership details"}
+	}
+
+	if determinedCookbookAccessOwnershipDetails.acceptTarget == types.AcceptTarget_ACCEPT_TARGET_RESOURCE && !determinedCookbookAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("must be resource owner to accept resourse targeted cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "must be resource owner to accept resourse targeted cookbook access"}
+	} else if determinedCookbookAccessOwnershipDetails.acceptTarget == types.AcceptTarget_ACCEPT_TARGET_RECIPIENT && !determinedCookbookAccessOwnershipDetails.isRecipientOwner {
+		log.Warn().Msg("must be recipient owner to accept recipient targeted cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "must be recipient owner to accept recipient targeted cookbook access"}
+	} else if determinedCookbookAccessOwnershipDetails.acceptTarget == types.AcceptTarget_ACCEPT_TARGET_UNSPECIFIED {
+		log.Warn().Msg("unspecified accept target when accepting a cookbook access")
+		return model.CookbookAccess{}, domain.ErrInvalidArgument{Msg: "unspecified accept target"}
+	}
+
+	// update the access state to accepted
+	dbAccess.State = types.AccessState_ACCESS_STATE_ACCEPTED
+
+	// update access using the repository
+	updatedAccess, err := d.repo.UpdateCookbookAccess(ctx, dbAccess, []string{model.CookbookAccessField_State})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update cookbook access")
+		return model.CookbookAccess{}, err
+	}
+
+	return updatedAccess, nil
+}
@@ -0,0 +1,359 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// AddCookbookEntry adds a recipe to the end of a cookbook. The caller must be
+// able to read the recipe.
+func (d *Domain) AddCookbookEntry(ctx context.Context, authAccount model.AuthAccount, entry model.CookbookEntry) (dbEntry model.CookbookEntry, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if entry.RecipeId.RecipeId == 0 {
+		log.Warn().Msg("recipe id required when adding a cookbook entry")
+		return model.CookbookEntry{}, domain.ErrInvalidArgument{Msg: "recipe id required"}
+	}
+
+	err = d.checkCookbookEntryAccess(ctx, authAccount, entry.Parent.CookbookId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.CookbookEntry{}, err
+	}
+
+	recipe, accessible, err := d.readableCookbookRecipe(ctx, authAccount, entry.RecipeId)
+	if err != nil {
+		return model.CookbookEntry{}, err
+	}
+	if !accessible {
+		log.Warn().Msg("recipe not accessible when adding a cookbook entry")
+		return model.CookbookEntry{}, domain.ErrPermissionDenied{Msg: "recipe not accessible"}
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.CookbookEntry{}, err
+	}
+	defer tx.Rollback()
+
+	entries, err := tx.ListCookbookEntries(ctx, entry.Parent, 0, 0, []string{model.CookbookEntryField_Id})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.ListCookbookEntries failed")
+		return model.CookbookEntry{}, err
+	}
+
+	entry.Id = model.CookbookEntryId{}
+	entry.Position = int32(len(entries))
+
+	dbEntry, err = tx.CreateCookbookEntry(ctx, entry)
+	if err != nil {
+		if errors.As(err, &repository.ErrNewAlreadyExists{}) {
+			log.Warn().Err(err).Msg("recipe already in cookbook")
+			return model.CookbookEntry{}, domain.ErrInvalidArgument{Msg: "recipe already in cookbook"}
+		}
+		log.Error().Err(err).Msg("tx.CreateCookbookEntry failed")
+		return model.CookbookEntry{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.CookbookEntry{}, err
+	}
+
+	dbEntry.Accessible = true
+	dbEntry.Recipe = recipe
+
+	return dbEntry, nil
+}
+
+// GetCookbookEntry gets a cookbook entry.
+func (d *Domain) GetCookbookEntry(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, id model.CookbookEntryId) (model.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.CookbookEntryId == 0 {
+		log.Warn().Msg("id required")
+		return model.CookbookEntry{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	err := d.checkCookbookEntryAccess(ctx, authAccount, parent.CookbookId, types.PermissionLevel_PERMISSION_LEVEL_READ)
+	if err != nil {
+		return model.CookbookEntry{}, err
+	}
+
+	entry, err := d.repo.GetCookbookEntry(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetCookbookEntry failed")
+		return model.CookbookEntry{}, err
+	}
+
+	entry.Recipe, entry.Accessible, err = d.readableCookbookRecipe(ctx, authAccount, entry.RecipeId)
+	if err != nil {
+		return model.CookbookEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// ListCookbookEntries lists the entries of a cookbook in order. Entries whose
+// recipe the caller cannot read are marked as inaccessible and leave out the
+// recipe details.
+func (d *Domain) ListCookbookEntries(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, pageSize int32, offset int64) ([]model.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err := d.checkCookbookEntryAccess(ctx, authAccount, parent.CookbookId, types.PermissionLevel_PERMISSION_LEVEL_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := d.repo.ListCookbookEntries(ctx, parent, pageSize, offset, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListCookbookEntries failed")
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Recipe, entries[i].Accessible, err = d.readableCookbookRecipe(ctx, authAccount, entries[i].RecipeId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+// UpdateCookbookEntry updates the note of a cookbook entry. The position is
+// changed with ReorderCookbookEntries.
+func (d *Domain) UpdateCookbookEntry(ctx context.Context, authAccount model.AuthAccount, entry model.CookbookEntry, fields []string) (model.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if entry.Id.CookbookEntryId == 0 {
+		log.Warn().Msg("id required")
+		return model.CookbookEntry{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	err := d.checkCookbookEntryAccess(ctx, authAccount, entry.Parent.CookbookId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.CookbookEntry{}, err
+	}
+
+	fields = slices.DeleteFunc(slices.Clone(fields), func(field string) bool {
+		return field == model.CookbookEntryField_Position
+	})
+
+	dbEntry, err := d.repo.UpdateCookbookEntry(ctx, entry, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateCookbookEntry failed")
+		return model.CookbookEntry{}, err
+	}
+
+	dbEntry.Recipe, dbEntry.Accessible, err = d.readableCookbookRecipe(ctx, authAccount, dbEntry.RecipeId)
+	if err != nil {
+		return model.CookbookEntry{}, err
+	}
+
+	return dbEntry, nil
+}
+
+// RemoveCookbookEntry removes an entry from a cookbook and closes the gap it
+// leaves in the order.
+func (d *Domain) RemoveCookbookEntry(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, id model.CookbookEntryId) (dbEntry model.CookbookEntry, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.CookbookEntryId == 0 {
+		log.Warn().Msg("id required")
+		return model.CookbookEntry{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	err = d.checkCookbookEntryAccess(ctx, authAccount, parent.CookbookId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.CookbookEntry{}, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.CookbookEntry{}, err
+	}
+	defer tx.Rollback()
+
+	dbEntry, err = tx.DeleteCookbookEntry(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.DeleteCookbookEntry failed")
+		return model.CookbookEntry{}, err
+	}
+
+	entries, err := tx.ListCookbookEntries(ctx, parent, 0, 0, []string{model.CookbookEntryField_Parent, model.CookbookEntryField_Id, model.CookbookEntryField_Position})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.ListCookbookEntries failed")
+		return model.CookbookEntry{}, err
+	}
+
+	_, err = saveCookbookEntryPositions(ctx, tx, entries)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to save cookbook entry positions")
+		return model.CookbookEntry{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.CookbookEntry{}, err
+	}
+
+	return dbEntry, nil
+}
+
+// ReorderCookbookEntries sets the order of the entries of a cookbook. Every
+// entry of the cookbook must be given exactly once.
+func (d *Domain) ReorderCookbookEntries(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, ids []model.CookbookEntryId) ([]model.CookbookEntry, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err := d.checkCookbookEntryAccess(ctx, authAccount, parent.CookbookId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	entries, err := tx.ListCookbookEntries(ctx, parent, 0, 0, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.ListCookbookEntries failed")
+		return nil, err
+	}
+
+	if len(ids) != len(entries) {
+		log.Warn().Int("given", len(ids)).Int("entries", len(entries)).Msg("every cookbook entry must be given when reordering")
+		return nil, domain.ErrInvalidArgument{Msg: "every cookbook entry must be given exactly once"}
+	}
+
+	byId := make(map[int64]model.CookbookEntry, len(entries))
+	for _, entry := range entries {
+		byId[entry.Id.CookbookEntryId] = entry
+	}
+
+	reordered := make([]model.CookbookEntry, 0, len(ids))
+	for _, id := range ids {
+		entry, ok := byId[id.CookbookEntryId]
+		if !ok {
+			log.Warn().Int64("cookbookEntryId", id.CookbookEntryId).Msg("unknown or repeated cookbook entry when reordering")
+			return nil, domain.ErrInvalidArgument{Msg: "every cookbook entry must be given exactly once"}
+		}
+		delete(byId, id.CookbookEntryId)
+		reordered = append(reordered, entry)
+	}
+
+	reordered, err = saveCookbookEntryPositions(ctx, tx, reordered)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to save cookbook entry positions")
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return nil, err
+	}
+
+	for i := range reordered {
+		reordered[i].Recipe, reordered[i].Accessible, err = d.readableCookbookRecipe(ctx, authAccount, reordered[i].RecipeId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reordered, nil
+}
+
+// saveCookbookEntryPositions numbers the entries in the given order and
+// writes the positions that changed.
+func saveCookbookEntryPositions(ctx context.Context, tx repository.TxClient, entries []model.CookbookEntry) ([]model.CookbookEntry, error) {
+	for i := range entries {
+		if entries[i].Position == int32(i) {
+			continue
+		}
+		entries[i].Position = int32(i)
+		_, err := tx.UpdateCookbookEntry(ctx, entries[i], []string{model.CookbookEntryField_Position})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// checkCookbookEntryAccess checks that the caller has at least the given
+// permission on the cookbook the entries belong to.
+func (d *Domain) checkCookbookEntryAccess(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId, permissionLevel types.PermissionLevel) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.CookbookId == 0 {
+		log.Warn().Msg("cookbook id required")
+		return domain.ErrInvalidArgument{Msg: "cookbook id required"}
+	}
+
+	cookbook, err := d.repo.GetCookbook(ctx, authAccount, id, []string{model.CookbookField_VisibilityLevel})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetCookbook failed")
+		return err
+	}
+
+	_, err = d.determineCookbookAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(cookbook.VisibilityLevel),
+		withMinimumPermissionLevel(permissionLevel),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine cookbook access")
+		return err
+	}
+
+	return nil
+}
+
+// readableCookbookRecipe gets the details of the recipe of a cookbook entry.
+// It reports the recipe as inaccessible, without its details, when the caller
+// cannot get it like GetRecipe or it no longer exists.
+func (d *Domain) readableCookbookRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.Recipe, bool, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	recipe, err := d.repo.GetRecipe(ctx, authAccount, id, []string{
+		model.RecipeField_Id,
+		model.RecipeField_Title,
+		model.RecipeField_ImageURI,
+		model.RecipeField_VisibilityLevel,
+	})
+	if errors.As(err, &repository.ErrNotFound{}) {
+		return model.Recipe{}, false, nil
+	} else if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return model.Recipe{}, false, err
+	}
+
+	_, err = d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(recipe.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+		withAllowPendingAccess(),
+	)
+	if errors.As(err, &domain.ErrPermissionDenied{}) {
+		return model.Recipe{}, false, nil
+	} else if err != nil {
+		log.Error().Err(err).Msg("unable to determine recipe access")
+		return model.Recipe{}, false, err
+	}
+
+	return recipe, true, nil
+}
@@ -0,0 +1,295 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// cookbookRepo holds one cookbook, its entries and the recipes they point
+// to. The caller has the cookbook permission level and can read the recipes
+// in readable.
+type cookbookRepo struct {
+	repository.Client
+
+	permissionLevel types.PermissionLevel
+	entries         []model.CookbookEntry
+	recipes         map[int64]model.Recipe
+	readable        map[int64]bool
+}
+
+func (r *cookbookRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return fakeTx{r}, nil
+}
+
+func (r *cookbookRepo) GetCookbook(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId, fields []string) (model.Cookbook, error) {
+	return model.Cookbook{Id: id, VisibilityLevel: types.VisibilityLevel_VISIBILITY_LEVEL_PRIVATE}, nil
+}
+
+func (r *cookbookRepo) FindStandardUserCookbookAccess(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId) (model.CookbookAccess, error) {
+	return model.CookbookAccess{PermissionLevel: r.permissionLevel, State: types.AccessState_ACCESS_STATE_ACCEPTED}, nil
+}
+
+func (r *cookbookRepo) FindDelegatedCircleCookbookAccess(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId) (model.CookbookAccess, model.CircleAccess, error) {
+	return model.CookbookAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookbookRepo) FindDelegatedUserCookbookAccess(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId) (model.CookbookAccess, model.UserAccess, error) {
+	return model.CookbookAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookbookRepo) GetRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, fields []string) (model.Recipe, error) {
+	recipe, ok := r.recipes[id.RecipeId]
+	if !ok {
+		return model.Recipe{}, repository.ErrNotFound{}
+	}
+	return recipe, nil
+}
+
+func (r *cookbookRepo) FindStandardUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error) {
+	if !r.readable[id.RecipeId] {
+		return model.RecipeAccess{}, repository.ErrNotFound{}
+	}
+	return model.RecipeAccess{PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_READ, State: types.AccessState_ACCESS_STATE_ACCEPTED}, nil
+}
+
+func (r *cookbookRepo) FindDelegatedCircleRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.CircleAccess, error) {
+	return model.RecipeAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookbookRepo) FindDelegatedUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.UserAccess, error) {
+	return model.RecipeAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookbookRepo) GetCookbookEntry(ctx context.Context, parent model.CookbookEntryParent, id model.CookbookEntryId, fields []string) (model.CookbookEntry, error) {
+	for _, entry := range r.entries {
+		if entry.Id == id {
+			return entry, nil
+		}
+	}
+	return model.CookbookEntry{}, repository.ErrNotFound{}
+}
+
+func (r *cookbookRepo) ListCookbookEntries(ctx context.Context, parent model.CookbookEntryParent, pageSize int32, offset int64, fields []string) ([]model.CookbookEntry, error) {
+	entries := slices.Clone(r.entries)
+	slices.SortFunc(entries, func(a, b model.CookbookEntry) int {
+		return int(a.Position - b.Position)
+	})
+	return entries, nil
+}
+
+func (r *cookbookRepo) UpdateCookbookEntry(ctx context.Context, entry model.CookbookEntry, fields []string) (model.CookbookEntry, error) {
+	for i := range r.entries {
+		if r.entries[i].Id != entry.Id {
+			continue
+		}
+		if slices.Contains(fields, model.CookbookEntryField_Position) {
+			r.entries[i].Position = entry.Position
+		}
+		if slices.Contains(fields, model.CookbookEntryField_Note) {
+			r.entries[i].Note = entry.Note
+		}
+		return r.entries[i], nil
+	}
+	return model.CookbookEntry{}, repository.ErrNotFound{}
+}
+
+func (r *cookbookRepo) DeleteCookbookEntry(ctx context.Context, parent model.CookbookEntryParent, id model.CookbookEntryId) (model.CookbookEntry, error) {
+	for i, entry := range r.entries {
+		if entry.Id == id {
+			r.entries = slices.Delete(r.entries, i, i+1)
+			return entry, nil
+		}
+	}
+	return model.CookbookEntry{}, repository.ErrNotFound{}
+}
+
+var (
+	cookbookCaller = model.AuthAccount{AuthUserId: 1, UserId: 1}
+	cookbookParent = model.CookbookEntryParent{CookbookId: model.CookbookId{CookbookId: 1}}
+)
+
+// newCookbookRepo creates a cookbook with an entry for each recipe, in the
+// given order, that the caller can write to.
+func newCookbookRepo(recipes ...model.Recipe) *cookbookRepo {
+	repo := &cookbookRepo{
+		permissionLevel: types.PermissionLevel_PERMISSION_LEVEL_WRITE,
+		recipes:         map[int64]model.Recipe{},
+		readable:        map[int64]bool{},
+	}
+	for i, recipe := range recipes {
+		repo.recipes[recipe.Id.RecipeId] = recipe
+		repo.readable[recipe.Id.RecipeId] = true
+		repo.entries = append(repo.entries, model.CookbookEntry{
+			Parent:   cookbookParent,
+			Id:       model.CookbookEntryId{CookbookEntryId: int64(i + 1)},
+			RecipeId: recipe.Id,
+			Position: int32(i),
+		})
+	}
+	return repo
+}
+
+func cookbookRecipe(id int64, title string) model.Recipe {
+	return model.Recipe{
+		Id:              model.RecipeId{RecipeId: id},
+		Title:           title,
+		VisibilityLevel: types.VisibilityLevel_VISIBILITY_LEVEL_PRIVATE,
+	}
+}
+
+func TestListCookbookEntries_RedactsInaccessibleRecipes(t *testing.T) {
+	repo := newCookbookRepo(
+		cookbookRecipe(1, "Shared soup"),
+		cookbookRecipe(2, "Secret stew"),
+		cookbookRecipe(3, "Deleted salad"),
+	)
+	repo.readable[2] = false
+	delete(repo.recipes, 3)
+	d := newTestDomain(repo)
+
+	entries, err := d.ListCookbookEntries(context.Background(), cookbookCaller, cookbookParent, 0, 0)
+	if err != nil {
+		t.Fatalf("ListCookbookEntries() error = %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("ListCookbookEntries() = %d entries, want 3", len(entries))
+	}
+
+	if !entries[0].Accessible || entries[0].Recipe.Title != "Shared soup" {
+		t.Errorf("entry 1 = (%v, %q), want the readable recipe", entries[0].Accessible, entries[0].Recipe.Title)
+	}
+	for _, entry := range entries[1:] {
+		if entry.Accessible {
+			t.Errorf("entry %d is accessible, want it marked inaccessible", entry.Id.CookbookEntryId)
+		}
+		if entry.Recipe.Title != "" || entry.Recipe.Id.RecipeId != 0 {
+			t.Errorf("entry %d leaks recipe %+v", entry.Id.CookbookEntryId, entry.Recipe)
+		}
+		if entry.RecipeId.RecipeId == 0 {
+			t.Errorf("entry %d lost its recipe id", entry.Id.CookbookEntryId)
+		}
+	}
+}
+
+func TestGetCookbookEntry_RedactsInaccessibleRecipe(t *testing.T) {
+	repo := newCookbookRepo(cookbookRecipe(1, "Secret stew"))
+	repo.readable[1] = false
+	d := newTestDomain(repo)
+
+	entry, err := d.GetCookbookEntry(context.Background(), cookbookCaller, cookbookParent, model.CookbookEntryId{CookbookEntryId: 1})
+	if err != nil {
+		t.Fatalf("GetCookbookEntry() error = %v", err)
+	}
+	if entry.Accessible || entry.Recipe.Title != "" {
+		t.Errorf("GetCookbookEntry() = (%v, %q), want an inaccessible entry without the title", entry.Accessible, entry.Recipe.Title)
+	}
+}
+
+func TestGetCookbookEntry_PublicRecipeIsAccessible(t *testing.T) {
+	recipe := cookbookRecipe(1, "Public pie")
+	recipe.VisibilityLevel = types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC
+	repo := newCookbookRepo(recipe)
+	repo.readable[1] = false
+	d := newTestDomain(repo)
+
+	entry, err := d.GetCookbookEntry(context.Background(), cookbookCaller, cookbookParent, model.CookbookEntryId{CookbookEntryId: 1})
+	if err != nil {
+		t.Fatalf("GetCookbookEntry() error = %v", err)
+	}
+	if !entry.Accessible || entry.Recipe.Title != "Public pie" {
+		t.Errorf("GetCookbookEntry() = (%v, %q), want the public recipe without a share", entry.Accessible, entry.Recipe.Title)
+	}
+}
+
+func TestReorderCookbookEntries(t *testing.T) {
+	repo := newCookbookRepo(
+		cookbookRecipe(1, "Soup"),
+		cookbookRecipe(2, "Stew"),
+		cookbookRecipe(3, "Salad"),
+	)
+	d := newTestDomain(repo)
+
+	ids := []model.CookbookEntryId{{CookbookEntryId: 3}, {CookbookEntryId: 1}, {CookbookEntryId: 2}}
+	entries, err := d.ReorderCookbookEntries(context.Background(), cookbookCaller, cookbookParent, ids)
+	if err != nil {
+		t.Fatalf("ReorderCookbookEntries() error = %v", err)
+	}
+
+	for i, entry := range entries {
+		if entry.Id != ids[i] || entry.Position != int32(i) {
+			t.Errorf("entries[%d] = (%d at %d), want (%d at %d)", i, entry.Id.CookbookEntryId, entry.Position, ids[i].CookbookEntryId, i)
+		}
+	}
+	stored, _ := repo.ListCookbookEntries(context.Background(), cookbookParent, 0, 0, nil)
+	for i, entry := range stored {
+		if entry.Id != ids[i] {
+			t.Errorf("stored entries[%d] = %d, want %d", i, entry.Id.CookbookEntryId, ids[i].CookbookEntryId)
+		}
+	}
+}
+
+func TestReorderCookbookEntries_InvalidIds(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []model.CookbookEntryId
+	}{
+		{name: "missing entry", ids: []model.CookbookEntryId{{CookbookEntryId: 2}, {CookbookEntryId: 1}}},
+		{name: "repeated entry", ids: []model.CookbookEntryId{{CookbookEntryId: 1}, {CookbookEntryId: 1}, {CookbookEntryId: 2}}},
+		{name: "unknown entry", ids: []model.CookbookEntryId{{CookbookEntryId: 1}, {CookbookEntryId: 2}, {CookbookEntryId: 9}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newCookbookRepo(
+				cookbookRecipe(1, "Soup"),
+				cookbookRecipe(2, "Stew"),
+				cookbookRecipe(3, "Salad"),
+			)
+			d := newTestDomain(repo)
+
+			_, err := d.ReorderCookbookEntries(context.Background(), cookbookCaller, cookbookParent, tt.ids)
+			if !errors.As(err, &domain.ErrInvalidArgument{}) {
+				t.Errorf("ReorderCookbookEntries() error = %v, want invalid argument", err)
+			}
+		})
+	}
+}
+
+func TestReorderCookbookEntries_RequiresWrite(t *testing.T) {
+	repo := newCookbookRepo(cookbookRecipe(1, "Soup"))
+	repo.permissionLevel = types.PermissionLevel_PERMISSION_LEVEL_READ
+	d := newTestDomain(repo)
+
+	_, err := d.ReorderCookbookEntries(context.Background(), cookbookCaller, cookbookParent, []model.CookbookEntryId{{CookbookEntryId: 1}})
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("ReorderCookbookEntries() error = %v, want permission denied", err)
+	}
+}
+
+func TestRemoveCookbookEntry_ClosesGap(t *testing.T) {
+	repo := newCookbookRepo(
+		cookbookRecipe(1, "Soup"),
+		cookbookRecipe(2, "Stew"),
+		cookbookRecipe(3, "Salad"),
+	)
+	d := newTestDomain(repo)
+
+	_, err := d.RemoveCookbookEntry(context.Background(), cookbookCaller, cookbookParent, model.CookbookEntryId{CookbookEntryId: 1})
+	if err != nil {
+		t.Fatalf("RemoveCookbookEntry() error = %v", err)
+	}
+
+	stored, _ := repo.ListCookbookEntries(context.Background(), cookbookParent, 0, 0, nil)
+	for i, entry := range stored {
+		if entry.Position != int32(i) {
+			t.Errorf("entry %d at %d, want %d", entry.Id.CookbookEntryId, entry.Position, i)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/dietary"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// flagRestrictionConflicts sets the restriction conflicts of the recipes to
+// the dietary restrictions of the auth user they do not meet.
+func (d *Domain) flagRestrictionConflicts(ctx context.Context, authAccount model.AuthAccount, recipes []model.Recipe) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if len(recipes) == 0 {
+		return nil
+	}
+
+	user, err := d.repo.GetUser(ctx, authAccount, model.UserId{UserId: authAccount.AuthUserId}, []string{model.UserField_DietaryRestrictions})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetUser failed")
+		return err
+	}
+
+	for i, recipe := range recipes {
+		recipes[i].RestrictionConflicts = dietary.Conflicts(recipe.Diets, recipe.Allergens, user.DietaryRestrictions)
+	}
+
+	return nil
+}
+
+// restrictionConflictFields adds the fields the restriction conflicts are
+// computed from to the fields of a recipe read.
+func restrictionConflictFields(fields []string) []string {
+	if fields == nil || !slices.Contains(fields, model.RecipeField_RestrictionConflicts) {
+		return fields
+	}
+	fields = slices.Clone(fields)
+	for _, field := range []string{model.RecipeField_Diets, model.RecipeField_Allergens} {
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func validateDietaryOverrides(overrides model.DietaryOverrides) error {
+	for _, diets := range [][]string{overrides.AddedDiets, overrides.RemovedDiets} {
+		if err := dietary.ValidateDiets(diets); err != nil {
+			return domain.ErrInvalidArgument{Msg: err.Error()}
+		}
+	}
+	for _, allergens := range [][]string{overrides.AddedAllergens, overrides.RemovedAllergens} {
+		if err := dietary.ValidateAllergens(allergens); err != nil {
+			return domain.ErrInvalidArgument{Msg: err.Error()}
+		}
+	}
+	return nil
+}
+
+func validateDietaryRestrictions(restrictions model.DietaryRestrictions) error {
+	if err := dietary.ValidateDiets(restrictions.Diets); err != nil {
+		return domain.ErrInvalidArgument{Msg: err.Error()}
+	}
+	if err := dietary.ValidateAllergens(restrictions.Allergens); err != nil {
+		return domain.ErrInvalidArgument{Msg: err.Error()}
+	}
+	return nil
+}
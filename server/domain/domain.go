@@ -1,8 +1,11 @@
 package domain
 
 import (
+	"strconv"
 	"sync"
+	"time"
 
+	"github.com/jcfug8/daylear/server/ports/config"
 	domainPort "github.com/jcfug8/daylear/server/ports/domain"
 	"github.com/jcfug8/daylear/server/ports/fileretriever"
 	"github.com/jcfug8/daylear/server/ports/filestorage"
@@ -13,22 +16,22 @@ import (
 	"github.com/jcfug8/daylear/server/ports/token"
 
 	"github.com/rs/zerolog"
+	uuid "github.com/satori/go.uuid"
 	"go.uber.org/fx"
 )
 
 var _ domainPort.Domain = &Domain{}
 
-const (
-	maxImageWidth  = 1000
-	maxImageHeight = 1000
-)
+// maxImageDimension is the maximum width and height of stored images.
+const maxImageDimension = 1000
 
 // DomainParams defines the dependencies for the domain layer.
 type DomainParams struct {
 	fx.In
 
-	Log  zerolog.Logger
-	Repo repository.Client
+	Log    zerolog.Logger
+	Repo   repository.Client
+	Config config.Client
 
 	TokenClient    token.Client
 	ImageStore     filestorage.Client
@@ -39,7 +42,7 @@ type DomainParams struct {
 }
 
 // NewDomain creates a new domain.
-func NewDomain(params DomainParams) domainPort.Domain {
+func NewDomain(params DomainParams) *Domain {
 	d := &Domain{
 		log:  params.Log,
 		repo: params.Repo,
@@ -51,6 +54,17 @@ func NewDomain(params DomainParams) domainPort.Domain {
 		fileRetriever:  params.FileRetriever,
 		imageGenerator: params.ImageGenerator,
 		recipeScraper:  params.RecipeScraper,
+
+		scraperLLMEnabled: scraperLLMEnabled(params.Config),
+
+		imageGenerationDailyLimit: imageGenerationDailyLimit(params.Config),
+
+		operationWorkers:   operationWorkers(params.Config),
+		operationRetention: operationRetention(params.Config),
+		operationOwner:     uuid.NewV4().String(),
+		operationLease:     operationLeaseDuration,
+		operationWake:      make(chan struct{}, 1),
+		operationCancels:   &sync.Map{},
 	}
 	return d
 }
@@ -67,4 +81,118 @@ type Domain struct {
 	fileRetriever  fileretriever.Client
 	imageGenerator imagegenerator.Client
 	recipeScraper  recipescraper.Client
+
+	// scraperLLMEnabled is whether pages without complete structured recipe
+	// data are sent to the recipe scraper.
+	scraperLLMEnabled bool
+
+	// imageGenerationDailyLimit is the number of images a user can have
+	// generated in a day.
+	imageGenerationDailyLimit int
+
+	// operationWorkers is the number of operations run at the same time.
+	operationWorkers int
+	// operationRetention is how long finished operations are kept.
+	operationRetention time.Duration
+	// operationOwner identifies this process in the leases of the operations
+	// it runs.
+	operationOwner string
+	// operationLease is how long this process holds a running operation
+	// without renewing its lease.
+	operationLease time.Duration
+	// operationWake wakes a worker when an operation is started.
+	operationWake chan struct{}
+	// operationCancels holds the cancel func of every operation running in
+	// this process by its id.
+	operationCancels *sync.Map
+	// stopOperations stops the workers and operationsDone waits for them.
+	stopOperations func()
+	operationsDone sync.WaitGroup
+}
+
+// scraperLLMEnabled reads the scraper.llm setting, which defaults to enabled.
+func scraperLLMEnabled(configClient config.Client) bool {
+	scraperConfig, ok := configClient.GetConfig()["scraper"].(map[string]interface{})
+	if !ok {
+		return true
+	}
+	switch llm := scraperConfig["llm"].(type) {
+	case bool:
+		return llm
+	case string:
+		enabled, err := strconv.ParseBool(llm)
+		if err != nil {
+			return true
+		}
+		return enabled
+	}
+	return true
+}
+
+// operationWorkers reads the operations.workers setting, which defaults to
+// defaultOperationWorkers.
+func operationWorkers(configClient config.Client) int {
+	operationsConfig, ok := configClient.GetConfig()["operations"].(map[string]interface{})
+	if !ok {
+		return defaultOperationWorkers
+	}
+	switch workers := operationsConfig["workers"].(type) {
+	case int:
+		if workers > 0 {
+			return workers
+		}
+	case float64:
+		if workers > 0 {
+			return int(workers)
+		}
+	case string:
+		n, err := strconv.Atoi(workers)
+		if err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultOperationWorkers
+}
+
+// operationRetention reads the operations.retention setting, a duration like
+// "24h", which defaults to defaultOperationRetention.
+func operationRetention(configClient config.Client) time.Duration {
+	operationsConfig, ok := configClient.GetConfig()["operations"].(map[string]interface{})
+	if !ok {
+		return defaultOperationRetention
+	}
+	retention, ok := operationsConfig["retention"].(string)
+	if !ok {
+		return defaultOperationRetention
+	}
+	d, err := time.ParseDuration(retention)
+	if err != nil || d <= 0 {
+		return defaultOperationRetention
+	}
+	return d
+}
+
+// imageGenerationDailyLimit reads the image_generation.daily_limit setting,
+// which defaults to defaultImageGenerationDailyLimit.
+func imageGenerationDailyLimit(configClient config.Client) int {
+	generationConfig, ok := configClient.GetConfig()["image_generation"].(map[string]interface{})
+	if !ok {
+		return defaultImageGenerationDailyLimit
+	}
+	switch limit := generationConfig["daily_limit"].(type) {
+	case int:
+		if limit >= 0 {
+			return limit
+		}
+	case float64:
+		if limit >= 0 {
+			return int(limit)
+		}
+	case string:
+		n, err := strconv.Atoi(limit)
+		if err == nil && n >= 0 {
+			return n
+		}
+	}
+	return defaultImageGenerationDailyLimit
 }
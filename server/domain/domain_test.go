@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/ports/image"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	"github.com/rs/zerolog"
+)
+
+// The domain tests run against in-memory fakes. A fake repository embeds
+// repository.Client and overrides the methods a test exercises; calling any
+// other method panics on the nil embedded client.
+
+// newTestDomain creates a domain backed by the given repository.
+func newTestDomain(repo repository.Client) *Domain {
+	return &Domain{
+		log:              zerolog.Nop(),
+		repo:             repo,
+		operationOwner:   "test",
+		operationLease:   operationLeaseDuration,
+		operationWake:    make(chan struct{}, 1),
+		operationCancels: &sync.Map{},
+	}
+}
+
+// fakeTx runs the statements of a transaction directly on the fake
+// repository it wraps. Rollback does not undo them.
+type fakeTx struct {
+	repository.Client
+}
+
+func (fakeTx) Commit() error { return nil }
+
+func (fakeTx) Rollback() {}
+
+// fakeFileStore keeps the paths of the uploaded and deleted files.
+type fakeFileStore struct {
+	mu       sync.Mutex
+	uploaded []string
+	deleted  []string
+}
+
+func (s *fakeFileStore) UploadPublicFile(ctx context.Context, path string, file file.File) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uploaded = append(s.uploaded, path)
+	return "files/" + path, nil
+}
+
+func (s *fakeFileStore) DeleteFile(ctx context.Context, path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted = append(s.deleted, path)
+	return nil
+}
+
+// fakeFileRetriever returns the location as the contents of every file.
+type fakeFileRetriever struct{}
+
+func (fakeFileRetriever) GetFileContents(ctx context.Context, location string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(location)), nil
+}
+
+// fakeImageClient creates 10x10 jpeg images that ignore their contents.
+type fakeImageClient struct{}
+
+func (fakeImageClient) CreateImage(ctx context.Context, imageReader io.Reader) (image.Image, error) {
+	return fakeImage{}, nil
+}
+
+type fakeImage struct{}
+
+func (fakeImage) Convert(ctx context.Context, format string) error { return nil }
+
+func (fakeImage) Resize(ctx context.Context, width int, height int) error { return nil }
+
+func (fakeImage) GetDimensions(ctx context.Context) (int, int, error) { return 10, 10, nil }
+
+func (fakeImage) GetFormat() string { return "jpeg" }
+
+func (fakeImage) Clone(ctx context.Context) (image.Image, error) { return fakeImage{}, nil }
+
+func (fakeImage) Remove(ctx context.Context) error { return nil }
+
+func (fakeImage) GetFile() (file.File, error) {
+	return file.File{
+		ContentType:    "image/jpeg",
+		Extension:      ".jpeg",
+		ReadSeekCloser: file.NewReadSeekCloser(nil),
+	}, nil
+}
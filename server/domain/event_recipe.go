@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/jcfug8/daylear/server/core/logutil"
 	"github.com/jcfug8/daylear/server/core/model"
@@ -190,3 +191,103 @@ func (d *Domain) ListEventRecipes(ctx context.Context, authAccount model.AuthAcc
 
 	return dbEventRecipes, nil
 }
+
+// MarkEventRecipeDone marks the recipe of an event as done. When a cook log is
+// given it is created for the caller, cooked at the start of the event unless
+// the event has not started yet.
+func (d *Domain) MarkEventRecipeDone(ctx context.Context, authAccount model.AuthAccount, parent model.EventRecipeParent, id model.EventRecipeId, cookLog *model.RecipeCookLog) (model.EventRecipe, *model.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required when marking an event recipe done")
+		return model.EventRecipe{}, nil, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.EventRecipeId == 0 {
+		log.Warn().Msg("event recipe id required when marking an event recipe done")
+		return model.EventRecipe{}, nil, domain.ErrInvalidArgument{Msg: "event recipe id required"}
+	}
+
+	// Validate that the user has access to the calendar
+	_, err := d.determineCalendarAccess(ctx, authAccount, model.CalendarId{CalendarId: parent.CalendarId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine calendar access when marking an event recipe done")
+		return model.EventRecipe{}, nil, err
+	}
+
+	eventRecipe, err := d.repo.GetEventRecipe(ctx, authAccount, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get event recipe when marking an event recipe done")
+		return model.EventRecipe{}, nil, err
+	}
+	if eventRecipe.Parent.EventId != parent.EventId {
+		log.Warn().Msg("event recipe does not belong to the event")
+		return model.EventRecipe{}, nil, domain.ErrNotFound{Msg: "event recipe not found"}
+	}
+
+	now := time.Now()
+	if cookLog != nil {
+		_, err = d.checkRecipeCookLogAccess(ctx, authAccount, eventRecipe.RecipeId)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine recipe access when marking an event recipe done")
+			return model.EventRecipe{}, nil, err
+		}
+
+		event, err := d.repo.GetEvent(ctx, authAccount, model.EventId{EventId: parent.EventId}, []string{model.EventField_StartTime, model.EventField_OverridenStartTime})
+		if err != nil {
+			log.Error().Err(err).Msg("unable to get event when marking an event recipe done")
+			return model.EventRecipe{}, nil, err
+		}
+
+		cookLog.Parent = model.RecipeCookLogParent{RecipeId: eventRecipe.RecipeId}
+		cookLog.Photos = nil
+		cookLog.EventRecipe = &model.RecipeCookLogEventRecipe{Parent: parent, Id: id}
+		cookLog.CookTime = event.StartTime
+		if event.OverridenStartTime != nil {
+			cookLog.CookTime = *event.OverridenStartTime
+		}
+		if cookLog.CookTime.After(now) {
+			cookLog.CookTime = now
+		}
+
+		*cookLog, err = newRecipeCookLog(authAccount, *cookLog)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid cook log when marking an event recipe done")
+			return model.EventRecipe{}, nil, err
+		}
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin marking event recipe done")
+		return model.EventRecipe{}, nil, domain.ErrInternal{Msg: "unable to begin marking event recipe done"}
+	}
+	defer tx.Rollback()
+
+	eventRecipe.Parent = parent
+	eventRecipe.DoneTime = &now
+	eventRecipe, err = tx.UpdateEventRecipe(ctx, eventRecipe, []string{model.EventRecipeField_DoneTime})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update event recipe")
+		return model.EventRecipe{}, nil, err
+	}
+
+	if cookLog != nil {
+		created, err := tx.CreateRecipeCookLog(ctx, *cookLog)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to create cook log when marking an event recipe done")
+			return model.EventRecipe{}, nil, err
+		}
+		cookLog = &created
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to finish marking event recipe done")
+		return model.EventRecipe{}, nil, domain.ErrInternal{Msg: "unable to finish marking event recipe done"}
+	}
+
+	eventRecipe.Parent = parent
+
+	return eventRecipe, cookLog, nil
+}
@@ -0,0 +1,288 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jcfug8/daylear/server/core/grocerylist"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// groceryListOtherSection is the section for ingredients without a category.
+const groceryListOtherSection = "Other"
+
+// GenerateGroceryList aggregates the ingredients of recipes and meal-plan events
+// into list items, grouped into sections by ingredient category.
+func (d *Domain) GenerateGroceryList(ctx context.Context, authAccount model.AuthAccount, request model.GroceryListRequest) (dbList model.List, dbListItems []model.ListItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.List{}, nil, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if len(request.Recipes) == 0 && request.CalendarId.CalendarId == 0 {
+		log.Warn().Msg("recipes or calendar required when generating grocery list")
+		return model.List{}, nil, domain.ErrInvalidArgument{Msg: "recipes or calendar required"}
+	}
+
+	sources := make([]grocerylist.Source, 0, len(request.Recipes))
+	for _, groceryRecipe := range request.Recipes {
+		recipe, err := d.GetRecipe(ctx, authAccount, model.RecipeParent{}, groceryRecipe.RecipeId, nil)
+		if err != nil {
+			log.Error().Err(err).Int64("recipeId", groceryRecipe.RecipeId.RecipeId).Msg("unable to get recipe when generating grocery list")
+			return model.List{}, nil, err
+		}
+		sources = append(sources, grocerylist.Source{
+			Recipe: recipe,
+			Scale:  grocerylist.ScaleForServings(groceryRecipe.Servings, recipe.YieldAmount),
+		})
+	}
+
+	if request.CalendarId.CalendarId != 0 {
+		mealPlanSources, err := d.mealPlanGrocerySources(ctx, authAccount, request.CalendarId, request.StartTime, request.EndTime)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to get meal plan recipes when generating grocery list")
+			return model.List{}, nil, err
+		}
+		sources = append(sources, mealPlanSources...)
+	}
+
+	// look up the catalog entries to convert units and pick sections
+	densities := map[int64]float64{}
+	categories := map[int64]string{}
+	for _, source := range sources {
+		for _, group := range source.Recipe.IngredientGroups {
+			for _, ingredient := range group.RecipeIngredients {
+				id := ingredient.IngredientId
+				if id.IngredientId == 0 {
+					continue
+				}
+				if _, ok := categories[id.IngredientId]; ok {
+					continue
+				}
+				dbIngredient, err := d.repo.GetIngredient(ctx, id, []string{model.IngredientField_Category, model.IngredientField_Density})
+				if err != nil {
+					log.Error().Err(err).Msg("repo.GetIngredient failed")
+					return model.List{}, nil, domain.ErrInternal{Msg: "unable to get ingredient"}
+				}
+				categories[id.IngredientId] = dbIngredient.Category
+				if dbIngredient.Density != nil {
+					densities[id.IngredientId] = *dbIngredient.Density
+				}
+			}
+		}
+	}
+
+	items := grocerylist.Aggregate(sources, densities, request.IncludeOptional)
+
+	newListAccount := authAccount
+	if request.ListId.ListId == 0 {
+		if request.NewList.Title == "" {
+			request.NewList.Title = "Grocery List"
+		}
+		newListAccount, request.NewList, err = d.prepareNewList(ctx, authAccount, request.NewList)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to prepare list when generating grocery list")
+			return model.List{}, nil, err
+		}
+	} else {
+		_, err = d.determineListAccess(ctx, authAccount, request.ListId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine list access when generating grocery list")
+			return model.List{}, nil, err
+		}
+		dbList, err = d.repo.GetList(ctx, authAccount, request.ListId, nil)
+		if err != nil {
+			log.Error().Err(err).Msg("repo.GetList failed")
+			return model.List{}, nil, err
+		}
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.List{}, nil, domain.ErrInternal{Msg: "unable to begin transaction"}
+	}
+	defer tx.Rollback()
+
+	// a new list is only kept if all of its items are created
+	if request.ListId.ListId == 0 {
+		dbList, err = d.createList(ctx, tx, newListAccount, request.NewList)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to create list when generating grocery list")
+			return model.List{}, nil, err
+		}
+	}
+
+	// reuse sections with the same title and add the missing ones
+	sectionIds := map[string]int64{}
+	for _, section := range dbList.Sections {
+		sectionIds[strings.ToLower(section.Title)] = section.Id
+	}
+	sectionsAdded := false
+	itemSectionTitles := make([]string, len(items))
+	for i, item := range items {
+		title := groceryListOtherSection
+		if category := categories[item.IngredientId.IngredientId]; category != "" {
+			r, size := utf8.DecodeRuneInString(category)
+			title = string(unicode.ToUpper(r)) + category[size:]
+		}
+		itemSectionTitles[i] = title
+		if _, ok := sectionIds[strings.ToLower(title)]; ok {
+			continue
+		}
+		id := newListSectionId(len(dbList.Sections))
+		dbList.Sections = append(dbList.Sections, model.ListSection{ListId: dbList.Id.ListId, Id: id, Title: title})
+		sectionIds[strings.ToLower(title)] = id
+		sectionsAdded = true
+	}
+
+	if sectionsAdded {
+		updatedList, err := tx.UpdateList(ctx, authAccount, dbList, []string{model.ListField_Sections})
+		if err != nil {
+			log.Error().Err(err).Msg("tx.UpdateList failed")
+			return model.List{}, nil, domain.ErrInternal{Msg: "unable to update list sections"}
+		}
+		dbList.Sections = updatedList.Sections
+	}
+
+	dbListItems = make([]model.ListItem, 0, len(items))
+	for i, item := range items {
+		dbListItem, err := tx.CreateListItem(ctx, authAccount, model.ListItem{
+			Parent:          model.ListItemParent{ListId: dbList.Id},
+			Title:           item.String(),
+			ListSectionId:   sectionIds[strings.ToLower(itemSectionTitles[i])],
+			SourceRecipeIds: item.RecipeIds,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("tx.CreateListItem failed")
+			return model.List{}, nil, domain.ErrInternal{Msg: "unable to create list item"}
+		}
+		dbListItem.Parent = model.ListItemParent{ListId: dbList.Id}
+		dbListItems = append(dbListItems, dbListItem)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.List{}, nil, domain.ErrInternal{Msg: "unable to commit transaction"}
+	}
+
+	return dbList, dbListItems, nil
+}
+
+// mealPlanGrocerySources returns a source for every recipe of every event
+// occurrence in the calendar between start and end. Recurring events are
+// expanded and overridden occurrences use the recipes of their override.
+func (d *Domain) mealPlanGrocerySources(ctx context.Context, authAccount model.AuthAccount, calendarId model.CalendarId, start, end time.Time) ([]grocerylist.Source, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		log.Warn().Msg("valid start and end time required when generating grocery list from a calendar")
+		return nil, domain.ErrInvalidArgument{Msg: "valid start and end time required"}
+	}
+
+	parent := model.EventParent{CalendarId: calendarId.CalendarId}
+	filter := fmt.Sprintf("start_time < '%s'", end.UTC().Format(time.RFC3339))
+	events, err := d.ListEvents(ctx, authAccount, parent, 0, 0, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// overridden occurrences of recurring events are replaced by their override
+	overridden := map[int64]map[int64]bool{}
+	for _, event := range events {
+		if event.ParentEventId == nil || event.OverridenStartTime == nil {
+			continue
+		}
+		if overridden[*event.ParentEventId] == nil {
+			overridden[*event.ParentEventId] = map[int64]bool{}
+		}
+		overridden[*event.ParentEventId][event.OverridenStartTime.Unix()] = true
+	}
+
+	inRange := func(t time.Time) bool {
+		return !t.Before(start) && t.Before(end)
+	}
+
+	occurrences := map[int64]int{}
+	parentEventIds := map[int64]int64{}
+	eventIds := []int64{}
+	for _, event := range events {
+		if event.DeleteTime != nil {
+			continue
+		}
+
+		count := 0
+		if event.RecurrenceRule != nil && *event.RecurrenceRule != "" && event.ParentEventId == nil {
+			// GenerateClones does not include the first occurrence
+			clones, err := event.GenerateClones(start, end)
+			if err != nil {
+				log.Error().Err(err).Int64("eventId", event.Id.EventId).Msg("unable to generate event occurrences")
+				return nil, domain.ErrInternal{Msg: "unable to generate event occurrences"}
+			}
+			clones = append(clones, model.Event{StartTime: event.StartTime})
+			for _, clone := range clones {
+				if inRange(clone.StartTime) && !overridden[event.Id.EventId][clone.StartTime.Unix()] {
+					count++
+				}
+			}
+		} else if inRange(event.StartTime) {
+			count = 1
+		}
+
+		if count == 0 {
+			continue
+		}
+		if _, ok := occurrences[event.Id.EventId]; !ok {
+			eventIds = append(eventIds, event.Id.EventId)
+		}
+		if event.ParentEventId != nil {
+			parentEventIds[event.Id.EventId] = *event.ParentEventId
+		}
+		occurrences[event.Id.EventId] += count
+	}
+
+	recipes := map[int64]model.Recipe{}
+	sources := []grocerylist.Source{}
+	for _, eventId := range eventIds {
+		eventRecipes, err := d.repo.ListEventRecipes(ctx, authAccount, model.EventRecipeParent{CalendarId: calendarId.CalendarId, EventId: eventId}, 0, 0, "", nil)
+		if err != nil {
+			log.Error().Err(err).Msg("repo.ListEventRecipes failed")
+			return nil, domain.ErrInternal{Msg: "unable to list event recipes"}
+		}
+
+		// overrides without their own recipes use the recipes of the recurring event
+		if parentEventId, ok := parentEventIds[eventId]; ok && len(eventRecipes) == 0 {
+			eventRecipes, err = d.repo.ListEventRecipes(ctx, authAccount, model.EventRecipeParent{CalendarId: calendarId.CalendarId, EventId: parentEventId}, 0, 0, "", nil)
+			if err != nil {
+				log.Error().Err(err).Msg("repo.ListEventRecipes failed")
+				return nil, domain.ErrInternal{Msg: "unable to list event recipes"}
+			}
+		}
+
+		for _, eventRecipe := range eventRecipes {
+			recipe, ok := recipes[eventRecipe.RecipeId.RecipeId]
+			if !ok {
+				recipe, err = d.GetRecipe(ctx, authAccount, model.RecipeParent{}, eventRecipe.RecipeId, nil)
+				if err != nil {
+					// skip meal-plan recipes the user can no longer see
+					log.Warn().Err(err).Int64("recipeId", eventRecipe.RecipeId.RecipeId).Msg("unable to get event recipe when generating grocery list")
+					continue
+				}
+				recipes[eventRecipe.RecipeId.RecipeId] = recipe
+			}
+			for range occurrences[eventId] {
+				sources = append(sources, grocerylist.Source{Recipe: recipe, Scale: 1})
+			}
+		}
+	}
+
+	return sources, nil
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// groceryRepo adds the ingredient catalog and the lists to a noteRepo. The
+// lists and their items are only stored when the transaction that created
+// them commits.
+type groceryRepo struct {
+	*noteRepo
+
+	ingredients map[int64]model.Ingredient
+	lists       []model.List
+	listItems   []model.ListItem
+	failItems   bool
+}
+
+func newGroceryRepo(ingredients ...model.Ingredient) *groceryRepo {
+	r := &groceryRepo{
+		noteRepo:    newNoteRepo(groceryRecipe()),
+		ingredients: map[int64]model.Ingredient{},
+	}
+	for _, ingredient := range ingredients {
+		r.ingredients[ingredient.Id.IngredientId] = ingredient
+	}
+	return r
+}
+
+func (r *groceryRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return &groceryTx{TxClient: fakeTx{r}, grocery: r}, nil
+}
+
+func (r *groceryRepo) GetIngredient(ctx context.Context, id model.IngredientId, fields []string) (model.Ingredient, error) {
+	ingredient, ok := r.ingredients[id.IngredientId]
+	if !ok {
+		return model.Ingredient{}, repository.ErrNotFound{}
+	}
+	return ingredient, nil
+}
+
+// CreateList stores the list outside of any transaction.
+func (r *groceryRepo) CreateList(ctx context.Context, authAccount model.AuthAccount, list model.List) (model.List, error) {
+	list.Id.ListId = int64(len(r.lists) + 1)
+	r.lists = append(r.lists, list)
+	return list, nil
+}
+
+// groceryTx keeps the lists and list items created within a transaction of
+// a groceryRepo until it commits.
+type groceryTx struct {
+	repository.TxClient
+	grocery *groceryRepo
+
+	lists     []model.List
+	listItems []model.ListItem
+}
+
+func (tx *groceryTx) CreateList(ctx context.Context, authAccount model.AuthAccount, list model.List) (model.List, error) {
+	list.Id.ListId = int64(len(tx.grocery.lists) + len(tx.lists) + 1)
+	tx.lists = append(tx.lists, list)
+	return list, nil
+}
+
+func (tx *groceryTx) CreateListAccess(ctx context.Context, access model.ListAccess, fields []string) (model.ListAccess, error) {
+	return access, nil
+}
+
+func (tx *groceryTx) UpdateList(ctx context.Context, authAccount model.AuthAccount, list model.List, fields []string) (model.List, error) {
+	for i := range tx.lists {
+		if tx.lists[i].Id == list.Id {
+			tx.lists[i].Sections = list.Sections
+			return tx.lists[i], nil
+		}
+	}
+	return model.List{}, repository.ErrNotFound{}
+}
+
+func (tx *groceryTx) CreateListItem(ctx context.Context, authAccount model.AuthAccount, listItem model.ListItem) (model.ListItem, error) {
+	if tx.grocery.failItems {
+		return model.ListItem{}, errors.New("create failed")
+	}
+	listItem.Id.ListItemId = int64(len(tx.listItems) + 1)
+	tx.listItems = append(tx.listItems, listItem)
+	return listItem, nil
+}
+
+func (tx *groceryTx) Commit() error {
+	tx.grocery.lists = append(tx.grocery.lists, tx.lists...)
+	tx.grocery.listItems = append(tx.grocery.listItems, tx.listItems...)
+	return nil
+}
+
+var (
+	shopper          = model.AuthAccount{AuthUserId: 41, UserId: 41}
+	groceryRecipeId  = model.RecipeId{RecipeId: 13}
+	groceryFlourId   = model.IngredientId{IngredientId: 1}
+	groceryApricotId = model.IngredientId{IngredientId: 2}
+)
+
+// groceryRecipe creates a public recipe with two ingredients of the
+// catalog.
+func groceryRecipe() model.Recipe {
+	recipe := noteRecipe(groceryRecipeId, types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+	recipe.IngredientGroups = []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{
+		{Title: "flour", IngredientId: groceryFlourId},
+		{Title: "apricots", IngredientId: groceryApricotId},
+	}}}
+	return recipe
+}
+
+func newGroceryListRequest() model.GroceryListRequest {
+	return model.GroceryListRequest{
+		NewList: model.List{Parent: model.ListParent{UserId: shopper.UserId}},
+		Recipes: []model.GroceryListRecipe{{RecipeId: groceryRecipeId}},
+	}
+}
+
+func TestGenerateGroceryList_CreatesList(t *testing.T) {
+	repo := newGroceryRepo(
+		model.Ingredient{Id: groceryFlourId, Category: "baking"},
+		model.Ingredient{Id: groceryApricotId, Category: "épicerie"},
+	)
+	d := newTestDomain(repo)
+
+	dbList, dbListItems, err := d.GenerateGroceryList(context.Background(), shopper, newGroceryListRequest())
+	if err != nil {
+		t.Fatalf("GenerateGroceryList() error = %v", err)
+	}
+	if len(repo.lists) != 1 || len(repo.listItems) != 2 || len(dbListItems) != 2 {
+		t.Fatalf("stored %d lists and %d items, want the list and both items", len(repo.lists), len(repo.listItems))
+	}
+	if dbList.Title != "Grocery List" {
+		t.Errorf("list title = %q, want the default title", dbList.Title)
+	}
+
+	if len(dbList.Sections) != 2 {
+		t.Fatalf("sections = %+v, want one per category", dbList.Sections)
+	}
+	if dbList.Sections[0].Title != "Baking" || dbList.Sections[1].Title != "Épicerie" {
+		t.Errorf("section titles = (%q, %q), want the capitalized categories", dbList.Sections[0].Title, dbList.Sections[1].Title)
+	}
+	if dbList.Sections[0].Id == 0 || dbList.Sections[0].Id == dbList.Sections[1].Id {
+		t.Errorf("section ids = (%d, %d), want distinct ids", dbList.Sections[0].Id, dbList.Sections[1].Id)
+	}
+	for i, dbListItem := range dbListItems {
+		if dbListItem.ListSectionId != dbList.Sections[i].Id {
+			t.Errorf("item %q section = %d, want %d", dbListItem.Title, dbListItem.ListSectionId, dbList.Sections[i].Id)
+		}
+	}
+}
+
+func TestGenerateGroceryList_FailureCreatesNoList(t *testing.T) {
+	repo := newGroceryRepo(model.Ingredient{Id: groceryFlourId}, model.Ingredient{Id: groceryApricotId})
+	repo.failItems = true
+	d := newTestDomain(repo)
+
+	_, _, err := d.GenerateGroceryList(context.Background(), shopper, newGroceryListRequest())
+	if err == nil {
+		t.Fatal("GenerateGroceryList() error = nil, want the item error")
+	}
+	if len(repo.lists) != 0 {
+		t.Errorf("lists = %d, want the new list rolled back", len(repo.lists))
+	}
+}
@@ -0,0 +1,300 @@
+package domain
+
+import (
+	"context"
+	"image/png"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+
+	"github.com/jcfug8/daylear/server/core/blurhash"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/image"
+	uuid "github.com/satori/go.uuid"
+)
+
+// imageVariantSizes are the sizes generated for every uploaded image, by the
+// maximum width and height of each. Images are never enlarged.
+var imageVariantSizes = []struct {
+	size         model.ImageSize
+	maxDimension int
+}{
+	{model.ImageSize_Thumbnail, 200},
+	{model.ImageSize_Medium, 600},
+	{model.ImageSize_Large, maxImageDimension},
+}
+
+// imageVariantFormats are the formats generated for every size in addition to
+// the source format. A format the image client can't encode is skipped.
+var imageVariantFormats = []string{"webp", "avif"}
+
+// webImageFormats are the source formats kept as is; other formats are
+// converted to jpeg.
+var webImageFormats = []string{"jpeg", "png", "webp"}
+
+const (
+	placeholderDimension   = 32
+	placeholderXComponents = 4
+	placeholderYComponents = 3
+)
+
+// uploadImage stores the variants of an uploaded image under root/id. It
+// returns the URI of the large variant in the source format, which is kept as
+// the image URI of the resource, along with the image set.
+func (d *Domain) uploadImage(ctx context.Context, root string, id int64, imageReader io.Reader) (string, model.ImageSet, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	img, err := d.imageClient.CreateImage(ctx, imageReader)
+	if err != nil {
+		log.Error().Err(err).Msg("imageClient.CreateImage failed")
+		return "", model.ImageSet{}, err
+	}
+	defer img.Remove(ctx)
+
+	width, height, err := img.GetDimensions(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("image.GetDimensions failed")
+		return "", model.ImageSet{}, err
+	}
+
+	imageSet := model.ImageSet{
+		Width:  int32(width),
+		Height: int32(height),
+	}
+
+	imageSet.Placeholder, err = d.imagePlaceholder(ctx, img)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to compute image placeholder")
+	}
+
+	sourceFormat := img.GetFormat()
+	if !slices.Contains(webImageFormats, sourceFormat) {
+		sourceFormat = "jpeg"
+	}
+	formats := []string{sourceFormat}
+	for _, format := range imageVariantFormats {
+		if !slices.Contains(formats, format) {
+			formats = append(formats, format)
+		}
+	}
+
+	dir := path.Join(root, strconv.FormatInt(id, 10), uuid.NewV4().String())
+	imageURI := ""
+	for _, variantSize := range imageVariantSizes {
+		for _, format := range formats {
+			variant, err := d.uploadImageVariant(ctx, img, dir, variantSize.size, variantSize.maxDimension, format)
+			if err != nil && format == sourceFormat {
+				log.Error().Err(err).Str("format", format).Msg("uploadImageVariant failed")
+				go d.deleteImageFiles(context.Background(), "", imageSet)
+				return "", model.ImageSet{}, err
+			} else if err != nil {
+				log.Warn().Err(err).Str("format", format).Msg("skipping image variant")
+				continue
+			}
+
+			imageSet.Variants = append(imageSet.Variants, variant)
+			if variantSize.size == model.ImageSize_Large && format == sourceFormat {
+				imageURI = variant.URI
+			}
+		}
+	}
+
+	return imageURI, imageSet, nil
+}
+
+// uploadImageVariant stores a copy of the source image fit within
+// maxDimension and encoded in format.
+func (d *Domain) uploadImageVariant(ctx context.Context, source image.Image, dir string, size model.ImageSize, maxDimension int, format string) (model.ImageVariant, error) {
+	img, err := source.Clone(ctx)
+	if err != nil {
+		return model.ImageVariant{}, err
+	}
+	defer img.Remove(ctx)
+
+	err = img.Resize(ctx, maxDimension, maxDimension)
+	if err != nil {
+		return model.ImageVariant{}, err
+	}
+
+	err = img.Convert(ctx, format)
+	if err != nil {
+		return model.ImageVariant{}, err
+	}
+
+	width, height, err := img.GetDimensions(ctx)
+	if err != nil {
+		return model.ImageVariant{}, err
+	}
+
+	file, err := img.GetFile()
+	if err != nil {
+		return model.ImageVariant{}, err
+	}
+
+	uri, err := d.fileStore.UploadPublicFile(ctx, path.Join(dir, string(size)+file.Extension), file)
+	if err != nil {
+		return model.ImageVariant{}, err
+	}
+
+	return model.ImageVariant{
+		Size:        size,
+		ContentType: file.ContentType,
+		Width:       int32(width),
+		Height:      int32(height),
+		URI:         uri,
+	}, nil
+}
+
+// imagePlaceholder returns the BlurHash of a small copy of the source image.
+func (d *Domain) imagePlaceholder(ctx context.Context, source image.Image) (string, error) {
+	img, err := source.Clone(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer img.Remove(ctx)
+
+	err = img.Resize(ctx, placeholderDimension, placeholderDimension)
+	if err != nil {
+		return "", err
+	}
+
+	err = img.Convert(ctx, "png")
+	if err != nil {
+		return "", err
+	}
+
+	file, err := img.GetFile()
+	if err != nil {
+		return "", err
+	}
+
+	decoded, err := png.Decode(file)
+	if err != nil {
+		return "", err
+	}
+
+	return blurhash.Encode(placeholderXComponents, placeholderYComponents, decoded)
+}
+
+// deleteImageFiles deletes the stored file of an image and the files of its
+// variants.
+func (d *Domain) deleteImageFiles(ctx context.Context, imageURI string, imageSet model.ImageSet) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	for _, uri := range imageFileURIs(imageURI, imageSet) {
+		err := d.fileStore.DeleteFile(ctx, uri)
+		if err != nil {
+			log.Error().Err(err).Str("uri", uri).Msg("fileStore.DeleteFile failed")
+			return err
+		}
+	}
+	return nil
+}
+
+// imageFileURIs returns the URIs of the stored file of an image and of its
+// variants, once each.
+func imageFileURIs(imageURI string, imageSet model.ImageSet) []string {
+	uris := []string{}
+	for _, uri := range append([]string{imageURI}, imageSet.URIs()...) {
+		if uri != "" && !slices.Contains(uris, uri) {
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
+
+// imageBackfillPageSize is the number of resources read at a time by
+// BackfillImageSets.
+const imageBackfillPageSize = 100
+
+// imageBackfillOrder is the order resources are backfilled in. Recipe images
+// go before recipes so a cover that is a gallery image reuses its variants.
+var imageBackfillOrder = []model.ImageSetOwnerKind{
+	model.ImageSetOwnerKind_RecipeImage,
+	model.ImageSetOwnerKind_Recipe,
+	model.ImageSetOwnerKind_User,
+	model.ImageSetOwnerKind_Circle,
+}
+
+// BackfillImageSets generates the variants of stored images uploaded before
+// variants were generated. The stored image URIs are left as they are. An
+// image that can't be read or converted is logged and skipped.
+func (d *Domain) BackfillImageSets(ctx context.Context) (updated int, failed int, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	log.Info().Msg("Domain BackfillImageSets called")
+
+	for _, kind := range imageBackfillOrder {
+		afterId := int64(0)
+		for {
+			owners, err := d.repo.ListImageSetOwners(ctx, kind, afterId, imageBackfillPageSize)
+			if err != nil {
+				log.Error().Err(err).Str("kind", string(kind)).Msg("repo.ListImageSetOwners failed")
+				return updated, failed, err
+			}
+
+			for _, owner := range owners {
+				afterId = owner.Id
+				err = d.backfillImageSet(ctx, owner)
+				if err != nil {
+					log.Warn().Err(err).Str("kind", string(kind)).Int64("id", owner.Id).Str("imageUri", owner.ImageURI).Msg("unable to backfill image set")
+					failed++
+					continue
+				}
+				updated++
+			}
+
+			if len(owners) < imageBackfillPageSize {
+				break
+			}
+		}
+	}
+
+	log.Info().Int("updated", updated).Int("failed", failed).Msg("Domain BackfillImageSets success")
+	return updated, failed, nil
+}
+
+// backfillImageSet generates and stores the variants of one stored image.
+func (d *Domain) backfillImageSet(ctx context.Context, owner model.ImageSetOwner) error {
+	if owner.Kind == model.ImageSetOwnerKind_Recipe {
+		images, err := d.listRecipeImages(ctx, model.RecipeId{RecipeId: owner.Id})
+		if err != nil {
+			return err
+		}
+		for _, image := range images {
+			if image.ImageURI == owner.ImageURI && len(image.ImageSet.Variants) > 0 {
+				owner.ImageSet = image.ImageSet
+				return d.repo.UpdateImageSet(ctx, owner)
+			}
+		}
+	}
+
+	root, id := "", owner.Id
+	switch owner.Kind {
+	case model.ImageSetOwnerKind_Recipe:
+		root = RecipeImageRoot
+	case model.ImageSetOwnerKind_RecipeImage:
+		root, id = RecipeImageRoot, owner.ParentId
+	case model.ImageSetOwnerKind_User:
+		root = UserImageRoot
+	case model.ImageSetOwnerKind_Circle:
+		root = CircleImageRoot
+	}
+
+	fileContents, err := d.fileRetriever.GetFileContents(ctx, owner.ImageURI)
+	if err != nil {
+		return err
+	}
+	defer fileContents.Close()
+
+	_, owner.ImageSet, err = d.uploadImage(ctx, root, id, fileContents)
+	if err != nil {
+		return err
+	}
+
+	err = d.repo.UpdateImageSet(ctx, owner)
+	if err != nil {
+		go d.deleteImageFiles(context.Background(), "", owner.ImageSet)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/ingredientcatalog"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// GetIngredient gets a catalog ingredient.
+func (d *Domain) GetIngredient(ctx context.Context, authAccount model.AuthAccount, id model.IngredientId, fields []string) (model.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Ingredient{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.IngredientId == 0 {
+		log.Warn().Msg("id required")
+		return model.Ingredient{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	ingredient, err := d.repo.GetIngredient(ctx, id, withIngredientCreatorField(fields))
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetIngredient failed")
+		return model.Ingredient{}, err
+	}
+
+	// uncurated ingredients come from the recipes of their creator
+	if ingredient.CreatorId.UserId != 0 && ingredient.CreatorId.UserId != authAccount.AuthUserId {
+		log.Warn().Msg("ingredient not visible to the caller")
+		return model.Ingredient{}, domain.ErrNotFound{Msg: "ingredient not found"}
+	}
+
+	return ingredient, nil
+}
+
+// ListIngredients lists catalog ingredients.
+func (d *Domain) ListIngredients(ctx context.Context, authAccount model.AuthAccount, pageSize int32, offset int64, filter string, fields []string) ([]model.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return nil, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	ingredients, err := d.repo.ListIngredients(ctx, model.UserId{UserId: authAccount.AuthUserId}, pageSize, offset, filter, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListIngredients failed")
+		return nil, err
+	}
+
+	return ingredients, nil
+}
+
+// AutocompleteIngredients returns the catalog ingredients that start with the query.
+func (d *Domain) AutocompleteIngredients(ctx context.Context, authAccount model.AuthAccount, query string, pageSize int32) ([]model.Ingredient, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return nil, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		log.Warn().Msg("query required")
+		return nil, domain.ErrInvalidArgument{Msg: "query required"}
+	}
+
+	ingredients, err := d.repo.AutocompleteIngredients(ctx, model.UserId{UserId: authAccount.AuthUserId}, query, pageSize)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.AutocompleteIngredients failed")
+		return nil, err
+	}
+
+	return ingredients, nil
+}
+
+// withIngredientCreatorField adds the creator to the requested ingredient
+// fields so visibility can be checked. All fields are requested when fields is
+// empty.
+func withIngredientCreatorField(fields []string) []string {
+	if len(fields) == 0 || slices.Contains(fields, model.IngredientField_CreatorId) {
+		return fields
+	}
+	return append(slices.Clone(fields), model.IngredientField_CreatorId)
+}
+
+// linkRecipeIngredients links every ingredient in the groups to a catalog
+// ingredient visible to the creator, adding a catalog entry only they can see
+// for titles that match nothing. The groups are updated in place and the
+// distinct linked ingredient ids are returned.
+func (d *Domain) linkRecipeIngredients(ctx context.Context, tx repository.TxClient, creatorId model.UserId, groups []model.IngredientGroup) ([]model.IngredientId, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	resolved := map[string]model.IngredientId{}
+	ingredientIds := []model.IngredientId{}
+
+	for i := range groups {
+		for j := range groups[i].RecipeIngredients {
+			recipeIngredient := &groups[i].RecipeIngredients[j]
+			recipeIngredient.IngredientId = model.IngredientId{}
+
+			normalized := ingredientcatalog.Normalize(recipeIngredient.Title)
+			if normalized == "" {
+				continue
+			}
+
+			if id, ok := resolved[normalized]; ok {
+				recipeIngredient.IngredientId = id
+				continue
+			}
+
+			candidates, err := tx.FindIngredientCandidates(ctx, creatorId, normalized)
+			if err != nil {
+				log.Error().Err(err).Msg("tx.FindIngredientCandidates failed")
+				return nil, err
+			}
+
+			ingredient, found := ingredientcatalog.Match(recipeIngredient.Title, candidates)
+			if !found {
+				ingredient, err = tx.CreateIngredient(ctx, model.Ingredient{Title: normalized, CreatorId: creatorId}, nil)
+				if err != nil {
+					log.Error().Err(err).Msg("tx.CreateIngredient failed")
+					return nil, err
+				}
+			}
+
+			resolved[normalized] = ingredient.Id
+			recipeIngredient.IngredientId = ingredient.Id
+			ingredientIds = append(ingredientIds, ingredient.Id)
+		}
+	}
+
+	return ingredientIds, nil
+}
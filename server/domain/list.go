@@ -8,19 +8,51 @@ import (
 	model "github.com/jcfug8/daylear/server/core/model"
 	"github.com/jcfug8/daylear/server/genapi/api/types"
 	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
 )
 
 // CreateList creates a new list.
 func (d *Domain) CreateList(ctx context.Context, authAccount model.AuthAccount, list model.List) (dbList model.List, err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	authAccount, list, err = d.prepareNewList(ctx, authAccount, list)
+	if err != nil {
+		return model.List{}, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.List{}, err
+	}
+	defer tx.Rollback()
+
+	dbList, err = d.createList(ctx, tx, authAccount, list)
+	if err != nil {
+		return model.List{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.List{}, err
+	}
+
+	return dbList, nil
+}
+
+// prepareNewList checks that the caller can create the list in its parent
+// and gives its sections ids. The returned account acts as the parent.
+func (d *Domain) prepareNewList(ctx context.Context, authAccount model.AuthAccount, list model.List) (model.AuthAccount, model.List, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
 	if authAccount.AuthUserId == 0 {
 		log.Warn().Msg("user id required")
-		return model.List{}, domain.ErrInvalidArgument{Msg: "user id required"}
+		return model.AuthAccount{}, model.List{}, domain.ErrInvalidArgument{Msg: "user id required"}
 	}
 
 	if list.Parent.CircleId != 0 && authAccount.CircleId != 0 && list.Parent.CircleId != authAccount.CircleId {
 		log.Warn().Msg("both circle ids set but do not match")
-		return model.List{}, domain.ErrInvalidArgument{Msg: "both circle ids set but do not match"}
+		return model.AuthAccount{}, model.List{}, domain.ErrInvalidArgument{Msg: "both circle ids set but do not match"}
 	}
 
 	if list.Parent.CircleId != 0 {
@@ -32,42 +64,44 @@ func (d *Domain) CreateList(ctx context.Context, authAccount model.AuthAccount,
 	list.Id.ListId = 0
 
 	if list.Parent.CircleId != 0 {
-		_, err = d.determineCircleAccess(ctx, authAccount, model.CircleId{CircleId: list.Parent.CircleId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+		_, err := d.determineCircleAccess(ctx, authAccount, model.CircleId{CircleId: list.Parent.CircleId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
 		if err != nil {
 			log.Error().Err(err).Msg("unable to determine circle access")
-			return model.List{}, err
+			return model.AuthAccount{}, model.List{}, err
 		}
 	} else if list.Parent.UserId != 0 {
-		_, err = d.determineUserAccess(ctx, authAccount, model.UserId{UserId: authAccount.UserId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_ADMIN))
+		_, err := d.determineUserAccess(ctx, authAccount, model.UserId{UserId: authAccount.UserId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_ADMIN))
 		if err != nil {
 			log.Error().Err(err).Msg("unable to determine user access")
-			return model.List{}, err
+			return model.AuthAccount{}, model.List{}, err
 		}
 	}
 
 	for i, section := range list.Sections {
 		if section.Title == "" {
 			log.Warn().Msg("title is required when updating a list section")
-			return model.List{}, domain.ErrInvalidArgument{Msg: "title is required"}
+			return model.AuthAccount{}, model.List{}, domain.ErrInvalidArgument{Msg: "title is required"}
 		}
 
 		if section.Id == 0 {
-			list.Sections[i].Id = time.Now().UnixMilli() + int64(i)
+			list.Sections[i].Id = newListSectionId(i)
 		} else {
 			log.Warn().Msg("invalid section id when creating a list")
-			return model.List{}, domain.ErrInvalidArgument{Msg: "invalid section id"}
+			return model.AuthAccount{}, model.List{}, domain.ErrInvalidArgument{Msg: "invalid section id"}
 		}
 	}
 
-	tx, err := d.repo.Begin(ctx)
-	if err != nil {
-		log.Error().Err(err).Msg("repo.Begin failed")
-		return model.List{}, err
-	}
+	return authAccount, list, nil
+}
 
-	dbList, err = d.repo.CreateList(ctx, authAccount, list)
+// createList creates a prepared list and the admin access of its creator
+// within the given transaction.
+func (d *Domain) createList(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, list model.List) (model.List, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	dbList, err := tx.CreateList(ctx, authAccount, list)
 	if err != nil {
-		log.Error().Err(err).Msg("repo.CreateList failed")
+		log.Error().Err(err).Msg("tx.CreateList failed")
 		return model.List{}, err
 	}
 
@@ -98,18 +132,17 @@ func (d *Domain) CreateList(ctx context.Context, authAccount model.AuthAccount,
 	}
 
 	dbList.ListAccess = dbListAccess
-
-	err = tx.Commit()
-	if err != nil {
-		log.Error().Err(err).Msg("tx.Commit failed")
-		return model.List{}, err
-	}
-
 	dbList.Parent = list.Parent
 
 	return dbList, nil
 }
 
+// newListSectionId returns the id of a new section at index i of the
+// sections of a list.
+func newListSectionId(i int) int64 {
+	return time.Now().UnixMilli() + int64(i)
+}
+
 // GetList gets a list.
 func (d *Domain) GetList(ctx context.Context, authAccount model.AuthAccount, parent model.ListParent, id model.ListId, fields []string) (list model.List, err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
@@ -236,7 +269,7 @@ func (d *Domain) UpdateList(ctx context.Context, authAccount model.AuthAccount,
 		}
 
 		if section.Id == 0 {
-			list.Sections[i].Id = time.Now().UnixMilli() + int64(i)
+			list.Sections[i].Id = newListSectionId(i)
 		}
 	}
 
@@ -304,7 +337,11 @@ func (d *Domain) DeleteList(ctx context.Context, authAccount model.AuthAccount,
 		log.Error().Err(err).Msg("unable to bulk delete list favorites")
 		return err
 	}
-	// TODO:delete completions
+	err = d.repo.BulkDeleteListItemCompletions(ctx, model.ListItemCompletionParent{ListId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete list item completions")
+		return err
+	}
 
 	err = tx.Commit()
 	if err != nil {
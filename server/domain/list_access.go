@@ -78,12 +78,31 @@ func (d *Domain) DeleteListAccess(ctx context.Context, authAccount model.AuthAcc
 		return domain.ErrPermissionDenied{Msg: "access denied"}
 	}
 
-	err = d.repo.DeleteListAccess(ctx, parent, id)
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin deleting list access")
+		return domain.ErrInternal{Msg: "unable to begin deleting list access"}
+	}
+	defer tx.Rollback()
+
+	err = tx.DeleteListAccess(ctx, parent, id)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to delete list access")
 		return err
 	}
 
+	err = d.pruneListItemAssignments(ctx, tx, parent.ListId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to prune list item assignments when deleting a list access")
+		return domain.ErrInternal{Msg: "unable to prune list item assignments"}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to finish deleting list access")
+		return domain.ErrInternal{Msg: "unable to finish deleting list access"}
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/jcfug8/daylear/server/core/logutil"
 	"github.com/jcfug8/daylear/server/core/model"
@@ -10,6 +11,9 @@ import (
 	domain "github.com/jcfug8/daylear/server/ports/domain"
 )
 
+// maxListItemCompletionWindow is the longest completion window of a list item.
+const maxListItemCompletionWindow = 366 * 24 * time.Hour
+
 // CreateListItem creates a new list item
 func (d *Domain) CreateListItem(ctx context.Context, authAccount model.AuthAccount, listItem model.ListItem) (dbListItem model.ListItem, err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
@@ -36,9 +40,29 @@ func (d *Domain) CreateListItem(ctx context.Context, authAccount model.AuthAccou
 		return model.ListItem{}, err
 	}
 
+	err = validateListItemSchedule(listItem, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid schedule when creating list item")
+		return model.ListItem{}, err
+	}
+
+	err = d.validateListItemAssignment(ctx, authAccount, listItem, model.UserId{}, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid assignment when creating list item")
+		return model.ListItem{}, err
+	}
+
 	// Set ID to 0 for new items
 	listItem.Id.ListItemId = 0
 
+	// A new item has no completions, so it is next due on its first due time
+	listItem.CreateTime = time.Now()
+	listItem.NextDueTime, err = listItem.GetNextDueTime(time.Time{})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get next due time when creating list item")
+		return model.ListItem{}, domain.ErrInvalidArgument{Msg: "invalid recurrence rule"}
+	}
+
 	dbListItem, err = d.repo.CreateListItem(ctx, authAccount, listItem)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to create list item")
@@ -84,12 +108,24 @@ func (d *Domain) GetListItem(ctx context.Context, authAccount model.AuthAccount,
 		return model.ListItem{}, err
 	}
 
+	fields, progress := withListItemProgressFields(fields)
+
 	dbListItem, err = d.repo.GetListItem(ctx, authAccount, id, fields)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to get list item")
 		return model.ListItem{}, domain.ErrInternal{Msg: "unable to get list item"}
 	}
 
+	if progress {
+		dbListItems := []model.ListItem{dbListItem}
+		err = d.setListItemsProgress(ctx, parent, dbListItems)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine completion when getting list item")
+			return model.ListItem{}, domain.ErrInternal{Msg: "unable to get list item"}
+		}
+		dbListItem = dbListItems[0]
+	}
+
 	return dbListItem, nil
 }
 
@@ -124,12 +160,22 @@ func (d *Domain) ListListItems(ctx context.Context, authAccount model.AuthAccoun
 		return []model.ListItem{}, err
 	}
 
+	fields, progress := withListItemProgressFields(fields)
+
 	dbListItems, err = d.repo.ListListItems(ctx, authAccount, parent, pageSize, pageOffset, filter, fields)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to list list items")
 		return []model.ListItem{}, domain.ErrInternal{Msg: "unable to list list items"}
 	}
 
+	if progress {
+		err = d.setListItemsProgress(ctx, parent, dbListItems)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine completion when listing list items")
+			return []model.ListItem{}, domain.ErrInternal{Msg: "unable to list list items"}
+		}
+	}
+
 	return dbListItems, nil
 }
 
@@ -152,8 +198,14 @@ func (d *Domain) UpdateListItem(ctx context.Context, authAccount model.AuthAccou
 		return model.ListItem{}, domain.ErrInvalidArgument{Msg: "list item id is required"}
 	}
 
+	// Members who can read the list can claim its items without editing them
+	minimumPermissionLevel := types.PermissionLevel_PERMISSION_LEVEL_WRITE
+	if isListItemClaim(fields) {
+		minimumPermissionLevel = types.PermissionLevel_PERMISSION_LEVEL_READ
+	}
+
 	// Check access to the parent list
-	_, err = d.determineListAccess(ctx, authAccount, listItem.Parent.ListId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	access, err := d.determineListAccess(ctx, authAccount, listItem.Parent.ListId, withMinimumPermissionLevel(minimumPermissionLevel))
 	if err != nil {
 		log.Error().Err(err).Msg("unable to determine access when updating list item")
 		return model.ListItem{}, err
@@ -167,13 +219,90 @@ func (d *Domain) UpdateListItem(ctx context.Context, authAccount model.AuthAccou
 		}
 	}
 
-	dbListItem, err = d.repo.UpdateListItem(ctx, authAccount, listItem, fields)
+	err = validateListItemSchedule(listItem, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid schedule when updating list item")
+		return model.ListItem{}, err
+	}
+
+	var claimedById model.UserId
+	if len(fields) == 0 || slices.Contains(fields, model.ListItemField_ClaimedBy) {
+		claimedById, err = d.checkListItemClaimChange(ctx, authAccount, access, listItem)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to change claim when updating list item")
+			return model.ListItem{}, err
+		}
+	}
+
+	err = d.validateListItemAssignment(ctx, authAccount, listItem, claimedById, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid assignment when updating list item")
+		return model.ListItem{}, err
+	}
+
+	// The create time anchors the recurrence and the next due time is derived,
+	// so neither can be written directly
+	fields = slices.DeleteFunc(slices.Clone(fields), func(field string) bool {
+		return field == model.ListItemField_CreateTime || field == model.ListItemField_NextDueTime
+	})
+	listItem.CreateTime = time.Time{}
+	listItem.NextDueTime = nil
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin transaction when updating list item")
+		return model.ListItem{}, domain.ErrInternal{Msg: "unable to update list item"}
+	}
+	defer tx.Rollback()
+
+	dbListItem, err = tx.UpdateListItem(ctx, authAccount, listItem, fields)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to update list item")
 		return model.ListItem{}, domain.ErrInternal{Msg: "unable to update list item"}
 	}
 
-	return dbListItem, nil
+	if len(fields) == 0 || slices.Contains(fields, model.ListItemField_RecurrenceRule) || slices.Contains(fields, model.ListItemField_CompletionWindow) {
+		dbListItem.NextDueTime, err = d.refreshListItemNextDueTime(ctx, tx, authAccount, listItem.Parent, listItem.Id)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to refresh next due time when updating list item")
+			return model.ListItem{}, domain.ErrInternal{Msg: "unable to update list item"}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit transaction when updating list item")
+		return model.ListItem{}, domain.ErrInternal{Msg: "unable to update list item"}
+	}
+
+	dbListItems := []model.ListItem{dbListItem}
+	err = d.setListItemsProgress(ctx, listItem.Parent, dbListItems)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine completion when updating list item")
+		return model.ListItem{}, domain.ErrInternal{Msg: "unable to update list item"}
+	}
+
+	return dbListItems[0], nil
+}
+
+// validateListItemSchedule checks the recurrence rule and completion window of
+// a list item that are being written. All fields are checked when fields is
+// empty.
+func validateListItemSchedule(listItem model.ListItem, fields []string) error {
+	if len(fields) == 0 || slices.Contains(fields, model.ListItemField_RecurrenceRule) {
+		err := listItem.ValidateRecurrenceRule()
+		if err != nil {
+			return domain.ErrInvalidArgument{Msg: err.Error()}
+		}
+	}
+
+	if len(fields) == 0 || slices.Contains(fields, model.ListItemField_CompletionWindow) {
+		if listItem.CompletionWindow < 0 || listItem.CompletionWindow > maxListItemCompletionWindow {
+			return domain.ErrInvalidArgument{Msg: "completion window must be between 0 and 366 days"}
+		}
+	}
+
+	return nil
 }
 
 // DeleteListItem deletes a list item
@@ -208,5 +337,11 @@ func (d *Domain) DeleteListItem(ctx context.Context, authAccount model.AuthAccou
 		return model.ListItem{}, domain.ErrInternal{Msg: "unable to delete list item"}
 	}
 
+	err = d.repo.BulkDeleteListItemCompletions(ctx, model.ListItemCompletionParent{ListId: parent.ListId, ListItemId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete list item completions")
+		return model.ListItem{}, domain.ErrInternal{Msg: "unable to delete list item"}
+	}
+
 	return dbListItem, nil
 }
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// listItemAssignmentPageSize is the page size used to list the items of a
+// list when pruning their assignments.
+const listItemAssignmentPageSize = 100
+
+// isListItemClaim reports whether an update only claims or releases a list
+// item. Members who can read a list can claim its items.
+func isListItemClaim(fields []string) bool {
+	return len(fields) == 1 && fields[0] == model.ListItemField_ClaimedBy
+}
+
+// validateListItemAssignment checks the assignees and the claim of a list item
+// that are being written. Only members of the circles of the list can be
+// assigned or claim an item, and an item can only be claimed by the caller
+// unless the claim is left as it was. All fields are checked when fields is
+// empty.
+func (d *Domain) validateListItemAssignment(ctx context.Context, authAccount model.AuthAccount, listItem model.ListItem, claimedById model.UserId, fields []string) error {
+	assigning := len(fields) == 0 || slices.Contains(fields, model.ListItemField_Assignees)
+	claiming := len(fields) == 0 || slices.Contains(fields, model.ListItemField_ClaimedBy)
+
+	if !assigning {
+		listItem.AssigneeIds = nil
+	}
+	if !claiming || listItem.ClaimedById == claimedById {
+		listItem.ClaimedById = model.UserId{}
+	}
+
+	if listItem.ClaimedById.UserId != 0 && listItem.ClaimedById.UserId != authAccount.AuthUserId {
+		return domain.ErrInvalidArgument{Msg: "list items can only be claimed by the caller"}
+	}
+
+	if len(listItem.AssigneeIds) == 0 && listItem.ClaimedById.UserId == 0 {
+		return nil
+	}
+
+	memberIds, err := d.repo.ListListCircleMemberIds(ctx, listItem.Parent.ListId)
+	if err != nil {
+		return domain.ErrInternal{Msg: "unable to list circle members"}
+	}
+
+	for i, assigneeId := range listItem.AssigneeIds {
+		if slices.Contains(listItem.AssigneeIds[:i], assigneeId) {
+			return domain.ErrInvalidArgument{Msg: "assignees cannot be repeated"}
+		}
+		if !slices.Contains(memberIds, assigneeId) {
+			return domain.ErrInvalidArgument{Msg: "assignees must be members of a circle of the list"}
+		}
+	}
+
+	if listItem.ClaimedById.UserId != 0 && !slices.Contains(memberIds, listItem.ClaimedById) {
+		return domain.ErrPermissionDenied{Msg: "only members of a circle of the list can claim its items"}
+	}
+
+	return nil
+}
+
+// checkListItemClaimChange checks the caller can change the claim on a list
+// item and returns the current claim. Releasing or taking over the claim of
+// another member requires write access to the list.
+func (d *Domain) checkListItemClaimChange(ctx context.Context, authAccount model.AuthAccount, access model.ListAccess, listItem model.ListItem) (model.UserId, error) {
+	dbListItem, err := d.repo.GetListItem(ctx, authAccount, listItem.Id, []string{model.ListItemField_Parent, model.ListItemField_ClaimedBy})
+	if err != nil {
+		return model.UserId{}, domain.ErrInternal{Msg: "unable to get list item"}
+	}
+
+	if dbListItem.Parent.ListId != listItem.Parent.ListId {
+		return model.UserId{}, domain.ErrNotFound{Msg: "list item not found"}
+	}
+
+	claimedById := dbListItem.ClaimedById
+	if claimedById.UserId == 0 || claimedById.UserId == authAccount.AuthUserId || claimedById == listItem.ClaimedById {
+		return claimedById, nil
+	}
+
+	if access.GetPermissionLevel() < types.PermissionLevel_PERMISSION_LEVEL_WRITE {
+		return model.UserId{}, domain.ErrPermissionDenied{Msg: "list item is claimed by another member"}
+	}
+
+	return claimedById, nil
+}
+
+// pruneListItemAssignments clears the assignments and claims on the items of a
+// list that are held by users who are no longer members of its circles. It
+// runs in the transaction that removed the members.
+func (d *Domain) pruneListItemAssignments(ctx context.Context, tx repository.TxClient, id model.ListId) error {
+	memberIds, err := tx.ListListCircleMemberIds(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	parent := model.ListItemParent{ListId: id}
+	fields := []string{model.ListItemField_Id, model.ListItemField_Parent, model.ListItemField_Assignees, model.ListItemField_ClaimedBy}
+	for offset := int32(0); ; offset += listItemAssignmentPageSize {
+		listItems, err := tx.ListListItems(ctx, model.AuthAccount{}, parent, listItemAssignmentPageSize, offset, "", fields)
+		if err != nil {
+			return err
+		}
+
+		for _, listItem := range listItems {
+			assigneeIds := slices.DeleteFunc(slices.Clone(listItem.AssigneeIds), func(assigneeId model.UserId) bool {
+				return !slices.Contains(memberIds, assigneeId)
+			})
+			claimedById := listItem.ClaimedById
+			if claimedById.UserId != 0 && !slices.Contains(memberIds, claimedById) {
+				claimedById = model.UserId{}
+			}
+			if len(assigneeIds) == len(listItem.AssigneeIds) && claimedById == listItem.ClaimedById {
+				continue
+			}
+
+			listItem.AssigneeIds = assigneeIds
+			listItem.ClaimedById = claimedById
+			_, err = tx.UpdateListItem(ctx, model.AuthAccount{}, listItem, []string{model.ListItemField_Assignees, model.ListItemField_ClaimedBy})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(listItems) < listItemAssignmentPageSize {
+			return nil
+		}
+	}
+}
+
+// pruneUserListItemAssignments clears the assignments and claims of a user on
+// the lists they are no longer a member of. It runs in the transaction that
+// removed the user.
+func (d *Domain) pruneUserListItemAssignments(ctx context.Context, tx repository.TxClient, userId model.UserId) error {
+	listIds, err := tx.ListAssignedListIds(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, listId := range listIds {
+		err = d.pruneListItemAssignments(ctx, tx, listId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
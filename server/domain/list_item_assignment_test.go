@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// assignmentRepo holds the items of a restricted list shared with the
+// members of its circles.
+type assignmentRepo struct {
+	repository.Client
+
+	access          map[int64]model.ListAccess
+	memberIds       []model.UserId
+	listItems       map[int64]model.ListItem
+	failItemUpdates bool
+}
+
+func newAssignmentRepo(listItems ...model.ListItem) *assignmentRepo {
+	r := &assignmentRepo{
+		access: map[int64]model.ListAccess{
+			assignmentWriter.AuthUserId:   {PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_WRITE, State: types.AccessState_ACCESS_STATE_ACCEPTED},
+			assignmentReader.AuthUserId:   {PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_READ, State: types.AccessState_ACCESS_STATE_ACCEPTED},
+			assignmentOutsider.AuthUserId: {PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_READ, State: types.AccessState_ACCESS_STATE_ACCEPTED},
+		},
+		memberIds: []model.UserId{{UserId: assignmentWriter.UserId}, {UserId: assignmentReader.UserId}, {UserId: assignmentMember.UserId}},
+		listItems: map[int64]model.ListItem{},
+	}
+	for _, listItem := range listItems {
+		r.listItems[listItem.Id.ListItemId] = listItem
+	}
+	return r
+}
+
+func (r *assignmentRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return fakeTx{r}, nil
+}
+
+func (r *assignmentRepo) GetList(ctx context.Context, authAccount model.AuthAccount, id model.ListId, fields []string) (model.List, error) {
+	if id != assignmentList {
+		return model.List{}, repository.ErrNotFound{}
+	}
+	return model.List{Id: id, VisibilityLevel: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED}, nil
+}
+
+func (r *assignmentRepo) FindStandardUserListAccess(ctx context.Context, authAccount model.AuthAccount, id model.ListId) (model.ListAccess, error) {
+	access, ok := r.access[authAccount.AuthUserId]
+	if !ok {
+		return model.ListAccess{}, repository.ErrNotFound{}
+	}
+	return access, nil
+}
+
+func (r *assignmentRepo) FindDelegatedCircleListAccess(ctx context.Context, authAccount model.AuthAccount, id model.ListId) (model.ListAccess, model.CircleAccess, error) {
+	return model.ListAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *assignmentRepo) FindDelegatedUserListAccess(ctx context.Context, authAccount model.AuthAccount, id model.ListId) (model.ListAccess, model.UserAccess, error) {
+	return model.ListAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *assignmentRepo) ListListCircleMemberIds(ctx context.Context, id model.ListId) ([]model.UserId, error) {
+	return r.memberIds, nil
+}
+
+func (r *assignmentRepo) ListAssignedListIds(ctx context.Context, userId model.UserId) ([]model.ListId, error) {
+	for _, listItem := range r.listItems {
+		if slices.Contains(listItem.AssigneeIds, userId) || listItem.ClaimedById == userId {
+			return []model.ListId{assignmentList}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *assignmentRepo) GetListItem(ctx context.Context, authAccount model.AuthAccount, id model.ListItemId, fields []string) (model.ListItem, error) {
+	listItem, ok := r.listItems[id.ListItemId]
+	if !ok {
+		return model.ListItem{}, repository.ErrNotFound{}
+	}
+	return listItem, nil
+}
+
+func (r *assignmentRepo) ListListItems(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.ListItem, error) {
+	var listItems []model.ListItem
+	for _, listItem := range r.listItems {
+		if listItem.Parent == parent {
+			listItems = append(listItems, listItem)
+		}
+	}
+	return listItems, nil
+}
+
+func (r *assignmentRepo) UpdateListItem(ctx context.Context, authAccount model.AuthAccount, listItem model.ListItem, fields []string) (model.ListItem, error) {
+	if r.failItemUpdates {
+		return model.ListItem{}, errors.New("update failed")
+	}
+	stored, ok := r.listItems[listItem.Id.ListItemId]
+	if !ok {
+		return model.ListItem{}, repository.ErrNotFound{}
+	}
+	if slices.Contains(fields, model.ListItemField_Assignees) {
+		stored.AssigneeIds = listItem.AssigneeIds
+	}
+	if slices.Contains(fields, model.ListItemField_ClaimedBy) {
+		stored.ClaimedById = listItem.ClaimedById
+	}
+	r.listItems[listItem.Id.ListItemId] = stored
+	return stored, nil
+}
+
+func (r *assignmentRepo) GetLastListItemCompleteTimes(ctx context.Context, parent model.ListItemParent, ids []model.ListItemId) (map[model.ListItemId]time.Time, error) {
+	return map[model.ListItemId]time.Time{}, nil
+}
+
+var (
+	assignmentWriter   = model.AuthAccount{AuthUserId: 21, UserId: 21}
+	assignmentReader   = model.AuthAccount{AuthUserId: 22, UserId: 22}
+	assignmentOutsider = model.AuthAccount{AuthUserId: 23, UserId: 23}
+	assignmentMember   = model.AuthAccount{AuthUserId: 24, UserId: 24}
+	assignmentList     = model.ListId{ListId: 6}
+	choresItem         = model.ListItemId{ListItemId: 9}
+)
+
+// newChoresListItem creates an item of the list claimed by the given user,
+// if any.
+func newChoresListItem(claimedById int64) model.ListItem {
+	return model.ListItem{
+		Parent:      model.ListItemParent{ListId: assignmentList},
+		Id:          choresItem,
+		Title:       "Take out the bins",
+		ClaimedById: model.UserId{UserId: claimedById},
+	}
+}
+
+func TestUpdateListItem_AssignmentPermissions(t *testing.T) {
+	assign := []string{model.ListItemField_Assignees}
+	claim := []string{model.ListItemField_ClaimedBy}
+
+	tests := []struct {
+		name        string
+		caller      model.AuthAccount
+		claimedById int64
+		assignees   []int64
+		claim       int64
+		fields      []string
+		wantErr     any
+	}{
+		{name: "writer assigns members", caller: assignmentWriter, assignees: []int64{22, 24}, fields: assign},
+		{name: "writer assigns a non member", caller: assignmentWriter, assignees: []int64{23}, fields: assign, wantErr: &domain.ErrInvalidArgument{}},
+		{name: "writer repeats an assignee", caller: assignmentWriter, assignees: []int64{22, 22}, fields: assign, wantErr: &domain.ErrInvalidArgument{}},
+		{name: "reader assigns", caller: assignmentReader, assignees: []int64{22}, fields: assign, wantErr: &domain.ErrPermissionDenied{}},
+		{name: "reader claims", caller: assignmentReader, claim: 22, fields: claim},
+		{name: "reader claims for another member", caller: assignmentReader, claim: 24, fields: claim, wantErr: &domain.ErrInvalidArgument{}},
+		{name: "non member claims", caller: assignmentOutsider, claim: 23, fields: claim, wantErr: &domain.ErrPermissionDenied{}},
+		{name: "reader releases their claim", caller: assignmentReader, claimedById: 22, fields: claim},
+		{name: "reader releases the claim of another member", caller: assignmentReader, claimedById: 24, fields: claim, wantErr: &domain.ErrPermissionDenied{}},
+		{name: "reader takes over the claim of another member", caller: assignmentReader, claimedById: 24, claim: 22, fields: claim, wantErr: &domain.ErrPermissionDenied{}},
+		{name: "writer releases the claim of another member", caller: assignmentWriter, claimedById: 24, fields: claim},
+		{name: "writer takes over the claim of another member", caller: assignmentWriter, claimedById: 24, claim: 21, fields: claim},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newAssignmentRepo(newChoresListItem(tt.claimedById))
+			d := newTestDomain(repo)
+
+			listItem := newChoresListItem(tt.claim)
+			for _, assigneeId := range tt.assignees {
+				listItem.AssigneeIds = append(listItem.AssigneeIds, model.UserId{UserId: assigneeId})
+			}
+
+			_, err := d.UpdateListItem(context.Background(), tt.caller, listItem, tt.fields)
+			stored := repo.listItems[choresItem.ListItemId]
+			if tt.wantErr != nil {
+				if !errors.As(err, tt.wantErr) {
+					t.Fatalf("UpdateListItem() error = %v, want %T", err, tt.wantErr)
+				}
+				if stored.ClaimedById.UserId != tt.claimedById || len(stored.AssigneeIds) != 0 {
+					t.Errorf("stored item = %+v, want it unchanged", stored)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateListItem() error = %v", err)
+			}
+			if stored.ClaimedById != listItem.ClaimedById {
+				t.Errorf("claimed by = %v, want %v", stored.ClaimedById, listItem.ClaimedById)
+			}
+			if !slices.Equal(stored.AssigneeIds, listItem.AssigneeIds) {
+				t.Errorf("assignees = %v, want %v", stored.AssigneeIds, listItem.AssigneeIds)
+			}
+		})
+	}
+}
+
+func TestPruneListItemAssignments(t *testing.T) {
+	listItem := newChoresListItem(23)
+	listItem.AssigneeIds = []model.UserId{{UserId: 22}, {UserId: 23}}
+	repo := newAssignmentRepo(listItem)
+	d := newTestDomain(repo)
+
+	tx, _ := repo.Begin(context.Background())
+	err := d.pruneUserListItemAssignments(context.Background(), tx, model.UserId{UserId: 23})
+	if err != nil {
+		t.Fatalf("pruneUserListItemAssignments() error = %v", err)
+	}
+
+	stored := repo.listItems[choresItem.ListItemId]
+	if stored.ClaimedById.UserId != 0 {
+		t.Errorf("claimed by = %v, want the claim of the non member cleared", stored.ClaimedById)
+	}
+	if want := []model.UserId{{UserId: 22}}; !slices.Equal(stored.AssigneeIds, want) {
+		t.Errorf("assignees = %v, want %v", stored.AssigneeIds, want)
+	}
+}
+
+func TestPruneListItemAssignments_ReturnsErrors(t *testing.T) {
+	repo := newAssignmentRepo(newChoresListItem(23))
+	repo.failItemUpdates = true
+	d := newTestDomain(repo)
+
+	tx, _ := repo.Begin(context.Background())
+	err := d.pruneListItemAssignments(context.Background(), tx, assignmentList)
+	if err == nil {
+		t.Fatal("pruneListItemAssignments() error = nil, want the update error")
+	}
+}
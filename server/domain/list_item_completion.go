@@ -0,0 +1,417 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// CreateListItemCompletion checks off a list item. The title, points and
+// section of the item are copied onto the completion, along with whether the
+// caller is one of its assignees. When a pantry is given, it is restocked
+// with the item along with the creation of the completion.
+func (d *Domain) CreateListItemCompletion(ctx context.Context, authAccount model.AuthAccount, completion model.ListItemCompletion, pantryId model.PantryId) (dbCompletion model.ListItemCompletion, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err = validateListItemCompletionParent(authAccount, completion.Parent)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid parent when creating list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	// Check access to the parent list
+	_, err = d.determineListAccess(ctx, authAccount, completion.Parent.ListId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when creating list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	dbListItem, err := d.getCompletionListItem(ctx, authAccount, completion.Parent)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get list item when creating list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	if pantryId.PantryId != 0 {
+		err = d.checkPantryWriteAccess(ctx, authAccount, pantryId)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine pantry access when creating list item completion")
+			return model.ListItemCompletion{}, err
+		}
+	}
+
+	if completion.CompleteTime.IsZero() {
+		completion.CompleteTime = time.Now()
+	}
+	if completion.CompleteTime.After(time.Now()) {
+		log.Error().Msg("complete time is in the future when creating list item completion")
+		return model.ListItemCompletion{}, domain.ErrInvalidArgument{Msg: "complete time cannot be in the future"}
+	}
+
+	completion.Id.ListItemCompletionId = 0
+	completion.Title = dbListItem.Title
+	completion.Points = dbListItem.Points
+	completion.ListSectionId = dbListItem.ListSectionId
+	completion.UserId = model.UserId{UserId: authAccount.AuthUserId}
+	completion.CircleId = model.CircleId{CircleId: authAccount.CircleId}
+	completion.CompletedByAssignee = slices.Contains(dbListItem.AssigneeIds, completion.UserId)
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin transaction when creating list item completion")
+		return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to create list item completion"}
+	}
+	defer tx.Rollback()
+
+	dbCompletion, err = tx.CreateListItemCompletion(ctx, completion)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create list item completion")
+		return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to create list item completion"}
+	}
+
+	_, err = d.refreshListItemNextDueTime(ctx, tx, authAccount, model.ListItemParent{ListId: completion.Parent.ListId}, completion.Parent.ListItemId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to refresh next due time when creating list item completion")
+		return model.ListItemCompletion{}, listItemCompletionRepoError(err, "list item not found", "unable to create list item completion")
+	}
+
+	if pantryId.PantryId != 0 {
+		_, err = d.restockPantry(ctx, tx, authAccount, pantryId, []string{dbListItem.Title})
+		if err != nil {
+			log.Error().Err(err).Msg("unable to restock pantry when creating list item completion")
+			return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to restock pantry"}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit transaction when creating list item completion")
+		return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to create list item completion"}
+	}
+
+	return dbCompletion, nil
+}
+
+// GetListItemCompletion retrieves a list item completion
+func (d *Domain) GetListItemCompletion(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemCompletionParent, id model.ListItemCompletionId, fields []string) (dbCompletion model.ListItemCompletion, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err = validateListItemCompletionParent(authAccount, parent)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid parent when getting list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	if id.ListItemCompletionId == 0 {
+		log.Error().Msg("list item completion id is required when getting list item completion")
+		return model.ListItemCompletion{}, domain.ErrInvalidArgument{Msg: "list item completion id is required"}
+	}
+
+	err = d.checkListReadAccess(ctx, authAccount, parent.ListId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when getting list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	dbCompletion, err = d.repo.GetListItemCompletion(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get list item completion")
+		return model.ListItemCompletion{}, listItemCompletionRepoError(err, "list item completion not found", "unable to get list item completion")
+	}
+
+	return dbCompletion, nil
+}
+
+// ListListItemCompletions lists the completions of a list item, most recently
+// completed first
+func (d *Domain) ListListItemCompletions(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemCompletionParent, pageSize int32, pageOffset int32, filter string, fields []string) (dbCompletions []model.ListItemCompletion, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err = validateListItemCompletionParent(authAccount, parent)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid parent when listing list item completions")
+		return []model.ListItemCompletion{}, err
+	}
+
+	err = d.checkListReadAccess(ctx, authAccount, parent.ListId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when listing list item completions")
+		return []model.ListItemCompletion{}, err
+	}
+
+	dbCompletions, err = d.repo.ListListItemCompletions(ctx, parent, pageSize, pageOffset, filter, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list list item completions")
+		return []model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to list list item completions"}
+	}
+
+	return dbCompletions, nil
+}
+
+// UpdateListItemCompletion updates the complete time of a list item completion.
+// Everything else about a completion is copied from the item or the caller.
+func (d *Domain) UpdateListItemCompletion(ctx context.Context, authAccount model.AuthAccount, completion model.ListItemCompletion, fields []string) (dbCompletion model.ListItemCompletion, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err = validateListItemCompletionParent(authAccount, completion.Parent)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid parent when updating list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	if completion.Id.ListItemCompletionId == 0 {
+		log.Error().Msg("list item completion id is required when updating list item completion")
+		return model.ListItemCompletion{}, domain.ErrInvalidArgument{Msg: "list item completion id is required"}
+	}
+
+	// Check access to the parent list
+	_, err = d.determineListAccess(ctx, authAccount, completion.Parent.ListId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when updating list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	if len(fields) == 0 || slices.Contains(fields, model.ListItemCompletionField_CompleteTime) {
+		if completion.CompleteTime.IsZero() {
+			log.Error().Msg("complete time is required when updating list item completion")
+			return model.ListItemCompletion{}, domain.ErrInvalidArgument{Msg: "complete time is required"}
+		}
+		if completion.CompleteTime.After(time.Now()) {
+			log.Error().Msg("complete time is in the future when updating list item completion")
+			return model.ListItemCompletion{}, domain.ErrInvalidArgument{Msg: "complete time cannot be in the future"}
+		}
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin transaction when updating list item completion")
+		return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to update list item completion"}
+	}
+	defer tx.Rollback()
+
+	dbCompletion, err = tx.UpdateListItemCompletion(ctx, completion, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update list item completion")
+		return model.ListItemCompletion{}, listItemCompletionRepoError(err, "list item completion not found", "unable to update list item completion")
+	}
+
+	_, err = d.refreshListItemNextDueTime(ctx, tx, authAccount, model.ListItemParent{ListId: completion.Parent.ListId}, completion.Parent.ListItemId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to refresh next due time when updating list item completion")
+		return model.ListItemCompletion{}, listItemCompletionRepoError(err, "list item not found", "unable to update list item completion")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit transaction when updating list item completion")
+		return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to update list item completion"}
+	}
+
+	return dbCompletion, nil
+}
+
+// DeleteListItemCompletion deletes a list item completion, which unchecks the
+// item if it was its only completion in the current period
+func (d *Domain) DeleteListItemCompletion(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemCompletionParent, id model.ListItemCompletionId) (dbCompletion model.ListItemCompletion, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err = validateListItemCompletionParent(authAccount, parent)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid parent when deleting list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	if id.ListItemCompletionId == 0 {
+		log.Error().Msg("list item completion id is required when deleting list item completion")
+		return model.ListItemCompletion{}, domain.ErrInvalidArgument{Msg: "list item completion id is required"}
+	}
+
+	// Check access to the parent list
+	_, err = d.determineListAccess(ctx, authAccount, parent.ListId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when deleting list item completion")
+		return model.ListItemCompletion{}, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin transaction when deleting list item completion")
+		return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to delete list item completion"}
+	}
+	defer tx.Rollback()
+
+	dbCompletion, err = tx.DeleteListItemCompletion(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete list item completion")
+		return model.ListItemCompletion{}, listItemCompletionRepoError(err, "list item completion not found", "unable to delete list item completion")
+	}
+
+	_, err = d.refreshListItemNextDueTime(ctx, tx, authAccount, model.ListItemParent{ListId: parent.ListId}, parent.ListItemId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to refresh next due time when deleting list item completion")
+		return model.ListItemCompletion{}, listItemCompletionRepoError(err, "list item not found", "unable to delete list item completion")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit transaction when deleting list item completion")
+		return model.ListItemCompletion{}, domain.ErrInternal{Msg: "unable to delete list item completion"}
+	}
+
+	return dbCompletion, nil
+}
+
+// validateListItemCompletionParent checks the caller and the parent of a list
+// item completion are set.
+func validateListItemCompletionParent(authAccount model.AuthAccount, parent model.ListItemCompletionParent) error {
+	if authAccount.AuthUserId == 0 {
+		return domain.ErrInvalidArgument{Msg: "user id is required"}
+	}
+
+	if parent.ListId.ListId == 0 {
+		return domain.ErrInvalidArgument{Msg: "list id is required"}
+	}
+
+	if parent.ListItemId.ListItemId == 0 {
+		return domain.ErrInvalidArgument{Msg: "list item id is required"}
+	}
+
+	return nil
+}
+
+// getCompletionListItem gets the list item a completion is for, making sure it
+// belongs to the list of the completion.
+func (d *Domain) getCompletionListItem(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemCompletionParent) (model.ListItem, error) {
+	dbListItem, err := d.repo.GetListItem(ctx, authAccount, parent.ListItemId, []string{
+		model.ListItemField_Parent,
+		model.ListItemField_Title,
+		model.ListItemField_Points,
+		model.ListItemField_ListSectionId,
+		model.ListItemField_Assignees,
+	})
+	if err != nil {
+		return model.ListItem{}, listItemCompletionRepoError(err, "list item not found", "unable to get list item")
+	}
+
+	if dbListItem.Parent.ListId != parent.ListId {
+		return model.ListItem{}, domain.ErrNotFound{Msg: "list item not found"}
+	}
+
+	return dbListItem, nil
+}
+
+// listItemCompletionRepoError reports a resource the repository did not find
+// as not found with notFoundMsg, and any other error as internal with msg.
+// Errors that are already domain errors, like a denied permission, are kept.
+func listItemCompletionRepoError(err error, notFoundMsg string, msg string) error {
+	switch {
+	case errors.As(err, &repository.ErrNotFound{}), errors.As(err, &domain.ErrNotFound{}):
+		return domain.ErrNotFound{Msg: notFoundMsg}
+	case errors.As(err, &domain.ErrPermissionDenied{}), errors.As(err, &domain.ErrInvalidArgument{}):
+		return err
+	default:
+		return domain.ErrInternal{Msg: msg}
+	}
+}
+
+// checkListReadAccess checks the caller can read a list, taking the visibility
+// of the list into account.
+func (d *Domain) checkListReadAccess(ctx context.Context, authAccount model.AuthAccount, listId model.ListId) error {
+	dbList, err := d.repo.GetList(ctx, authAccount, listId, []string{model.ListField_VisibilityLevel})
+	if err != nil {
+		return listItemCompletionRepoError(err, "list not found", "unable to get list")
+	}
+
+	_, err = d.determineListAccess(
+		ctx, authAccount, listId,
+		withResourceVisibilityLevel(dbList.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_READ),
+	)
+	return err
+}
+
+// listItemProgressFields are the list item fields needed to determine whether
+// a list item is completed or overdue.
+var listItemProgressFields = []string{
+	model.ListItemField_Id,
+	model.ListItemField_RecurrenceRule,
+	model.ListItemField_CompletionWindow,
+	model.ListItemField_NextDueTime,
+	model.ListItemField_CreateTime,
+}
+
+// withListItemProgressFields reports whether the completion state of list
+// items is requested by the given fields, and adds the fields needed to
+// determine it. All fields are requested when fields is empty.
+func withListItemProgressFields(fields []string) ([]string, bool) {
+	if len(fields) == 0 {
+		return fields, true
+	}
+
+	if !slices.Contains(fields, model.ListItemField_Completed) && !slices.Contains(fields, model.ListItemField_IsOverdue) {
+		return fields, false
+	}
+
+	return append(slices.Clone(fields), listItemProgressFields...), true
+}
+
+// setListItemsProgress sets whether each of the given items of a list has a
+// completion in its current recurrence period and whether it is overdue.
+func (d *Domain) setListItemsProgress(ctx context.Context, parent model.ListItemParent, listItems []model.ListItem) error {
+	ids := make([]model.ListItemId, len(listItems))
+	for i, listItem := range listItems {
+		ids[i] = listItem.Id
+	}
+
+	lastCompleteTimes, err := d.repo.GetLastListItemCompleteTimes(ctx, parent, ids)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i, listItem := range listItems {
+		listItems[i].Completed, err = listItem.IsCompleted(lastCompleteTimes[listItem.Id], now)
+		if err != nil {
+			return err
+		}
+		listItems[i].IsOverdue = listItem.NextDueTime != nil && listItem.NextDueTime.Before(now)
+	}
+
+	return nil
+}
+
+// refreshListItemNextDueTime recomputes the next due time of a list item from
+// its last completion. It is called whenever the schedule or the completions
+// of the item change, in the transaction that changes them.
+func (d *Domain) refreshListItemNextDueTime(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, parent model.ListItemParent, id model.ListItemId) (*time.Time, error) {
+	dbListItem, err := tx.GetListItem(ctx, authAccount, id, listItemProgressFields)
+	if err != nil {
+		return nil, err
+	}
+
+	lastCompleteTimes, err := tx.GetLastListItemCompleteTimes(ctx, parent, []model.ListItemId{id})
+	if err != nil {
+		return nil, err
+	}
+
+	dbListItem.Id = id
+	dbListItem.Parent = parent
+	dbListItem.NextDueTime, err = dbListItem.GetNextDueTime(lastCompleteTimes[id])
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.UpdateListItem(ctx, authAccount, dbListItem, []string{model.ListItemField_NextDueTime})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbListItem.NextDueTime, nil
+}
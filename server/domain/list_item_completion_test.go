@@ -0,0 +1,308 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// completionRepo holds the items and completions of a restricted list the
+// completer can write to. Changes made in a transaction are undone when it
+// is rolled back.
+type completionRepo struct {
+	repository.Client
+
+	access          map[int64]model.ListAccess
+	listItems       map[int64]model.ListItem
+	completions     map[int64]model.ListItemCompletion
+	failItemUpdates bool
+}
+
+func newCompletionRepo(listItems ...model.ListItem) *completionRepo {
+	r := &completionRepo{
+		access:      map[int64]model.ListAccess{completer.AuthUserId: {PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_WRITE, State: types.AccessState_ACCESS_STATE_ACCEPTED}},
+		listItems:   map[int64]model.ListItem{},
+		completions: map[int64]model.ListItemCompletion{},
+	}
+	for _, listItem := range listItems {
+		r.listItems[listItem.Id.ListItemId] = listItem
+	}
+	return r
+}
+
+func (r *completionRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return &completionTx{
+		completionRepo: r,
+		listItems:      maps.Clone(r.listItems),
+		completions:    maps.Clone(r.completions),
+	}, nil
+}
+
+func (r *completionRepo) GetList(ctx context.Context, authAccount model.AuthAccount, id model.ListId, fields []string) (model.List, error) {
+	if id != completionList {
+		return model.List{}, repository.ErrNotFound{}
+	}
+	return model.List{Id: id, VisibilityLevel: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED}, nil
+}
+
+func (r *completionRepo) FindStandardUserListAccess(ctx context.Context, authAccount model.AuthAccount, id model.ListId) (model.ListAccess, error) {
+	access, ok := r.access[authAccount.AuthUserId]
+	if !ok {
+		return model.ListAccess{}, repository.ErrNotFound{}
+	}
+	return access, nil
+}
+
+func (r *completionRepo) FindDelegatedCircleListAccess(ctx context.Context, authAccount model.AuthAccount, id model.ListId) (model.ListAccess, model.CircleAccess, error) {
+	return model.ListAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *completionRepo) FindDelegatedUserListAccess(ctx context.Context, authAccount model.AuthAccount, id model.ListId) (model.ListAccess, model.UserAccess, error) {
+	return model.ListAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *completionRepo) GetListItem(ctx context.Context, authAccount model.AuthAccount, id model.ListItemId, fields []string) (model.ListItem, error) {
+	listItem, ok := r.listItems[id.ListItemId]
+	if !ok {
+		return model.ListItem{}, repository.ErrNotFound{}
+	}
+	return listItem, nil
+}
+
+func (r *completionRepo) UpdateListItem(ctx context.Context, authAccount model.AuthAccount, listItem model.ListItem, fields []string) (model.ListItem, error) {
+	if r.failItemUpdates {
+		return model.ListItem{}, errors.New("update failed")
+	}
+	stored, ok := r.listItems[listItem.Id.ListItemId]
+	if !ok {
+		return model.ListItem{}, repository.ErrNotFound{}
+	}
+	stored.NextDueTime = listItem.NextDueTime
+	r.listItems[listItem.Id.ListItemId] = stored
+	return stored, nil
+}
+
+func (r *completionRepo) GetLastListItemCompleteTimes(ctx context.Context, parent model.ListItemParent, ids []model.ListItemId) (map[model.ListItemId]time.Time, error) {
+	lastCompleteTimes := map[model.ListItemId]time.Time{}
+	for _, completion := range r.completions {
+		id := completion.Parent.ListItemId
+		if completion.CompleteTime.After(lastCompleteTimes[id]) {
+			lastCompleteTimes[id] = completion.CompleteTime
+		}
+	}
+	return lastCompleteTimes, nil
+}
+
+func (r *completionRepo) CreateListItemCompletion(ctx context.Context, completion model.ListItemCompletion) (model.ListItemCompletion, error) {
+	completion.Id.ListItemCompletionId = int64(len(r.completions) + 1)
+	r.completions[completion.Id.ListItemCompletionId] = completion
+	return completion, nil
+}
+
+func (r *completionRepo) GetListItemCompletion(ctx context.Context, parent model.ListItemCompletionParent, id model.ListItemCompletionId, fields []string) (model.ListItemCompletion, error) {
+	completion, ok := r.completions[id.ListItemCompletionId]
+	if !ok || completion.Parent != parent {
+		return model.ListItemCompletion{}, repository.ErrNotFound{}
+	}
+	return completion, nil
+}
+
+func (r *completionRepo) UpdateListItemCompletion(ctx context.Context, completion model.ListItemCompletion, fields []string) (model.ListItemCompletion, error) {
+	stored, ok := r.completions[completion.Id.ListItemCompletionId]
+	if !ok || stored.Parent != completion.Parent {
+		return model.ListItemCompletion{}, repository.ErrNotFound{}
+	}
+	stored.CompleteTime = completion.CompleteTime
+	r.completions[completion.Id.ListItemCompletionId] = stored
+	return stored, nil
+}
+
+func (r *completionRepo) DeleteListItemCompletion(ctx context.Context, parent model.ListItemCompletionParent, id model.ListItemCompletionId) (model.ListItemCompletion, error) {
+	completion, ok := r.completions[id.ListItemCompletionId]
+	if !ok || completion.Parent != parent {
+		return model.ListItemCompletion{}, repository.ErrNotFound{}
+	}
+	delete(r.completions, id.ListItemCompletionId)
+	return completion, nil
+}
+
+// completionTx keeps the state of the repository from when it began, to put
+// back on rollback.
+type completionTx struct {
+	*completionRepo
+	listItems   map[int64]model.ListItem
+	completions map[int64]model.ListItemCompletion
+	committed   bool
+}
+
+func (tx *completionTx) Commit() error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *completionTx) Rollback() {
+	if !tx.committed {
+		tx.completionRepo.listItems = tx.listItems
+		tx.completionRepo.completions = tx.completions
+	}
+}
+
+var (
+	completer            = model.AuthAccount{AuthUserId: 11, UserId: 11}
+	completionList       = model.ListId{ListId: 4}
+	dailyItem            = model.ListItemId{ListItemId: 8}
+	dailyItemCompletions = model.ListItemCompletionParent{ListId: completionList, ListItemId: dailyItem}
+)
+
+// newDailyListItem creates an item of the list that is due every day at
+// noon, created three days ago.
+func newDailyListItem() model.ListItem {
+	return model.ListItem{
+		Parent:         model.ListItemParent{ListId: completionList},
+		Id:             dailyItem,
+		Title:          "Water the plants",
+		RecurrenceRule: "FREQ=DAILY;BYHOUR=12;BYMINUTE=0;BYSECOND=0",
+		CreateTime:     time.Now().Add(-72 * time.Hour),
+	}
+}
+
+func TestListItemCompletion_RefreshesNextDueTime(t *testing.T) {
+	repo := newCompletionRepo(newDailyListItem())
+	d := newTestDomain(repo)
+	ctx := context.Background()
+
+	completion, err := d.CreateListItemCompletion(ctx, completer, model.ListItemCompletion{Parent: dailyItemCompletions}, model.PantryId{})
+	if err != nil {
+		t.Fatalf("CreateListItemCompletion() error = %v", err)
+	}
+	afterCreate := repo.listItems[dailyItem.ListItemId].NextDueTime
+	if afterCreate == nil || !afterCreate.After(time.Now()) {
+		t.Fatalf("next due time after completing = %v, want a time in the future", afterCreate)
+	}
+
+	_, err = d.DeleteListItemCompletion(ctx, completer, dailyItemCompletions, completion.Id)
+	if err != nil {
+		t.Fatalf("DeleteListItemCompletion() error = %v", err)
+	}
+	afterDelete := repo.listItems[dailyItem.ListItemId].NextDueTime
+	if afterDelete == nil || !afterDelete.Before(*afterCreate) {
+		t.Errorf("next due time after uncompleting = %v, want one before %v", afterDelete, afterCreate)
+	}
+}
+
+func TestListItemCompletion_RollsBackWhenRefreshFails(t *testing.T) {
+	existing := model.ListItemCompletion{
+		Parent:       dailyItemCompletions,
+		Id:           model.ListItemCompletionId{ListItemCompletionId: 1},
+		CompleteTime: time.Now().Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name  string
+		write func(d *Domain) error
+	}{
+		{name: "create", write: func(d *Domain) error {
+			_, err := d.CreateListItemCompletion(context.Background(), completer, model.ListItemCompletion{Parent: dailyItemCompletions}, model.PantryId{})
+			return err
+		}},
+		{name: "update", write: func(d *Domain) error {
+			completion := existing
+			completion.CompleteTime = time.Now().Add(-2 * time.Hour)
+			_, err := d.UpdateListItemCompletion(context.Background(), completer, completion, []string{model.ListItemCompletionField_CompleteTime})
+			return err
+		}},
+		{name: "delete", write: func(d *Domain) error {
+			_, err := d.DeleteListItemCompletion(context.Background(), completer, dailyItemCompletions, existing.Id)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newCompletionRepo(newDailyListItem())
+			repo.completions[existing.Id.ListItemCompletionId] = existing
+			repo.failItemUpdates = true
+			d := newTestDomain(repo)
+
+			err := tt.write(d)
+			if !errors.As(err, &domain.ErrInternal{}) {
+				t.Fatalf("error = %v, want ErrInternal", err)
+			}
+
+			if len(repo.completions) != 1 || !repo.completions[existing.Id.ListItemCompletionId].CompleteTime.Equal(existing.CompleteTime) {
+				t.Errorf("completions = %v, want only the existing one unchanged", repo.completions)
+			}
+		})
+	}
+}
+
+func TestListItemCompletion_Errors(t *testing.T) {
+	missing := model.ListItemCompletionId{ListItemCompletionId: 99}
+	otherList := model.ListItem{
+		Parent: model.ListItemParent{ListId: model.ListId{ListId: 5}},
+		Id:     model.ListItemId{ListItemId: 9},
+	}
+	isNotFound := func(err error) bool { return errors.As(err, &domain.ErrNotFound{}) }
+	isPermissionDenied := func(err error) bool { return errors.As(err, &domain.ErrPermissionDenied{}) }
+
+	tests := []struct {
+		name    string
+		call    func(d *Domain) error
+		wantErr func(error) bool
+	}{
+		{name: "get missing completion", wantErr: isNotFound, call: func(d *Domain) error {
+			_, err := d.GetListItemCompletion(context.Background(), completer, dailyItemCompletions, missing, nil)
+			return err
+		}},
+		{name: "get in missing list", wantErr: isNotFound, call: func(d *Domain) error {
+			parent := model.ListItemCompletionParent{ListId: model.ListId{ListId: 5}, ListItemId: dailyItem}
+			_, err := d.GetListItemCompletion(context.Background(), completer, parent, missing, nil)
+			return err
+		}},
+		{name: "get without access", wantErr: isPermissionDenied, call: func(d *Domain) error {
+			_, err := d.GetListItemCompletion(context.Background(), model.AuthAccount{AuthUserId: 12, UserId: 12}, dailyItemCompletions, missing, nil)
+			return err
+		}},
+		{name: "list without access", wantErr: isPermissionDenied, call: func(d *Domain) error {
+			_, err := d.ListListItemCompletions(context.Background(), model.AuthAccount{AuthUserId: 12, UserId: 12}, dailyItemCompletions, 10, 0, "", nil)
+			return err
+		}},
+		{name: "create for missing item", wantErr: isNotFound, call: func(d *Domain) error {
+			parent := model.ListItemCompletionParent{ListId: completionList, ListItemId: model.ListItemId{ListItemId: 99}}
+			_, err := d.CreateListItemCompletion(context.Background(), completer, model.ListItemCompletion{Parent: parent}, model.PantryId{})
+			return err
+		}},
+		{name: "create for item of another list", wantErr: isNotFound, call: func(d *Domain) error {
+			parent := model.ListItemCompletionParent{ListId: completionList, ListItemId: otherList.Id}
+			_, err := d.CreateListItemCompletion(context.Background(), completer, model.ListItemCompletion{Parent: parent}, model.PantryId{})
+			return err
+		}},
+		{name: "update missing completion", wantErr: isNotFound, call: func(d *Domain) error {
+			completion := model.ListItemCompletion{Parent: dailyItemCompletions, Id: missing, CompleteTime: time.Now().Add(-time.Hour)}
+			_, err := d.UpdateListItemCompletion(context.Background(), completer, completion, nil)
+			return err
+		}},
+		{name: "delete missing completion", wantErr: isNotFound, call: func(d *Domain) error {
+			_, err := d.DeleteListItemCompletion(context.Background(), completer, dailyItemCompletions, missing)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestDomain(newCompletionRepo(newDailyListItem(), otherList))
+
+			err := tt.call(d)
+			if !tt.wantErr(err) {
+				t.Errorf("error = %v (%T)", err, err)
+			}
+		})
+	}
+}
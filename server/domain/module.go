@@ -1,6 +1,7 @@
 package domain
 
 import (
+	domainPort "github.com/jcfug8/daylear/server/ports/domain"
 	"go.uber.org/fx"
 )
 
@@ -8,6 +9,29 @@ import (
 var Module = fx.Module(
 	"domain",
 	fx.Provide(
-		NewDomain,
+		fx.Annotate(
+			NewDomain,
+			fx.As(new(domainPort.Domain)),
+			fx.As(fx.Self()),
+		),
 	),
 )
+
+// WorkersModule runs the operation workers of the domain for the lifetime of
+// the app. Only the server includes it, so commands sharing the database run
+// no operations.
+var WorkersModule = fx.Module(
+	"domain-workers",
+	fx.Invoke(
+		registerOperationWorkers,
+	),
+)
+
+// registerOperationWorkers starts the operation workers with the app and
+// stops them with it.
+func registerOperationWorkers(lifecycle fx.Lifecycle, d *Domain) {
+	lifecycle.Append(fx.Hook{
+		OnStart: d.startOperationWorkers,
+		OnStop:  d.stopOperationWorkers,
+	})
+}
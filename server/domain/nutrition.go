@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/nutrition"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// calculateRecipeNutrition estimates the nutrition facts of a recipe whose
+// ingredients have already been linked to the ingredient catalog.
+func (d *Domain) calculateRecipeNutrition(ctx context.Context, tx repository.TxClient, recipe model.Recipe) (model.Nutrition, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	catalog := map[int64]model.Ingredient{}
+	for _, group := range recipe.IngredientGroups {
+		for _, ingredient := range group.RecipeIngredients {
+			id := ingredient.IngredientId
+			if id.IngredientId == 0 {
+				continue
+			}
+			if _, ok := catalog[id.IngredientId]; ok {
+				continue
+			}
+			dbIngredient, err := tx.GetIngredient(ctx, id, nil)
+			if err != nil {
+				log.Error().Err(err).Msg("tx.GetIngredient failed")
+				return model.Nutrition{}, err
+			}
+			catalog[id.IngredientId] = dbIngredient
+		}
+	}
+
+	return nutrition.Calculate(recipe, catalog), nil
+}
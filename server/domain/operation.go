@@ -0,0 +1,678 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+	uuid "github.com/satori/go.uuid"
+)
+
+// OperationFileRoot is the directory the files of operations are stored in.
+const OperationFileRoot = "operations"
+
+const (
+	// defaultOperationWorkers is the number of operations run at the same
+	// time unless configured otherwise.
+	defaultOperationWorkers = 4
+	// defaultOperationRetention is how long finished operations are kept
+	// unless configured otherwise.
+	defaultOperationRetention = 24 * time.Hour
+	// operationClaimInterval is how often idle workers look for pending
+	// operations, e.g. ones started by another process.
+	operationClaimInterval = 5 * time.Second
+	// operationPollInterval is how often WaitOperation checks an operation.
+	operationPollInterval = 500 * time.Millisecond
+	// operationCleanupInterval is how often expired operations are deleted.
+	operationCleanupInterval = time.Hour
+	// operationLeaseDuration is how long a process holds a running operation
+	// without renewing its lease. The lease is renewed three times within it;
+	// operations whose lease expired, e.g. because the process stopped, are
+	// requeued.
+	operationLeaseDuration = time.Minute
+)
+
+// StartScrapeRecipe records an operation that scrapes a recipe from a web
+// page in the background. Its result holds the recipe and its likely
+// duplicates in the parent collection.
+func (d *Domain) StartScrapeRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, uri string) (model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if uri == "" {
+		log.Warn().Msg("uri required")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: "uri required"}
+	}
+
+	return d.startOperation(ctx, authAccount, model.OperationKind_ScrapeRecipe, model.OperationRequest{
+		RecipeParent: parent,
+		URI:          uri,
+	})
+}
+
+// StartOCRRecipe stores the photos and records an operation that reads a
+// recipe from them in the background. The photos are deleted once the
+// operation has finished.
+func (d *Domain) StartOCRRecipe(ctx context.Context, authAccount model.AuthAccount, imageReaders []io.Reader) (model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if len(imageReaders) == 0 {
+		log.Warn().Msg("images required")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: "at least one image is required"}
+	}
+
+	dir := path.Join(OperationFileRoot, strconv.FormatInt(authAccount.AuthUserId, 10), uuid.NewV4().String())
+	fileURIs := []string{}
+	for i, imageReader := range imageReaders {
+		uri, err := d.uploadOperationImage(ctx, path.Join(dir, "input-"+strconv.Itoa(i)), imageReader)
+		if err != nil {
+			log.Error().Err(err).Msg("uploadOperationImage failed")
+			go d.deleteOperationFiles(context.Background(), model.Operation{Request: model.OperationRequest{FileURIs: fileURIs}})
+			return model.Operation{}, err
+		}
+		fileURIs = append(fileURIs, uri)
+	}
+
+	operation, err := d.startOperation(ctx, authAccount, model.OperationKind_OCRRecipe, model.OperationRequest{
+		FileURIs: fileURIs,
+	})
+	if err != nil {
+		go d.deleteOperationFiles(context.Background(), model.Operation{Request: model.OperationRequest{FileURIs: fileURIs}})
+		return model.Operation{}, err
+	}
+
+	return operation, nil
+}
+
+// StartGenerateRecipeImage records an operation that generates candidate
+// images for a recipe in the background. The candidates are kept with the
+// recipe until one is selected as its cover. Every candidate generated counts
+// against the daily generation quota of the caller.
+func (d *Domain) StartGenerateRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, candidateCount int32) (model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("id required")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	if candidateCount == 0 {
+		candidateCount = defaultRecipeImageCandidates
+	}
+	if candidateCount < 0 || candidateCount > maxRecipeImageCandidates {
+		log.Warn().Int32("candidateCount", candidateCount).Msg("invalid candidate count")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: fmt.Sprintf("candidate count must be between 1 and %d", maxRecipeImageCandidates)}
+	}
+
+	// fail before queueing when the caller can't change the recipe
+	_, err := d.getRecipeForImageGeneration(ctx, authAccount, id)
+	if err != nil {
+		return model.Operation{}, err
+	}
+
+	err = d.checkImageGenerationQuota(ctx, authAccount, candidateCount)
+	if err != nil {
+		return model.Operation{}, err
+	}
+
+	return d.startOperation(ctx, authAccount, model.OperationKind_GenerateRecipeImage, model.OperationRequest{
+		RecipeParent:   parent,
+		RecipeId:       id,
+		CandidateCount: candidateCount,
+	})
+}
+
+// GetOperation gets one of the caller's operations.
+func (d *Domain) GetOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId) (model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.OperationId == 0 {
+		log.Warn().Msg("id required")
+		return model.Operation{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	if err := d.checkOperationAccess(ctx, authAccount, parent); err != nil {
+		return model.Operation{}, err
+	}
+
+	operation, err := d.repo.GetOperation(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetOperation failed")
+		return model.Operation{}, err
+	}
+
+	return operation, nil
+}
+
+// ListOperations lists the caller's operations, newest first.
+func (d *Domain) ListOperations(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, pageSize int32, offset int64) ([]model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if err := d.checkOperationAccess(ctx, authAccount, parent); err != nil {
+		return nil, err
+	}
+
+	operations, err := d.repo.ListOperations(ctx, parent, pageSize, offset, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListOperations failed")
+		return nil, err
+	}
+
+	return operations, nil
+}
+
+// CancelOperation cancels one of the caller's operations that has not
+// finished yet. Cancelling a finished operation leaves it as it is.
+func (d *Domain) CancelOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId) (model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	operation, err := d.GetOperation(ctx, authAccount, parent, id)
+	if err != nil {
+		return model.Operation{}, err
+	}
+	if operation.State.Done() {
+		return operation, nil
+	}
+
+	d.finishOperation(&operation, model.OperationState_Cancelled, nil, "operation cancelled")
+	// cancelled whichever process holds the lease
+	operation.LeaseOwner = ""
+	operation, err = d.repo.UpdateOperation(ctx, operation, operationFinishFields)
+	if errors.As(err, &repository.ErrNotFound{}) {
+		// the operation finished in the meantime
+		return d.GetOperation(ctx, authAccount, parent, id)
+	} else if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateOperation failed")
+		return model.Operation{}, err
+	}
+
+	// stop the work when it runs in this process, otherwise the result is
+	// dropped when it is done
+	if cancel, ok := d.operationCancels.Load(id); ok {
+		cancel.(context.CancelFunc)()
+	}
+	d.abandonOperation(ctx, operation)
+
+	log.Info().Int64("operationId", id.OperationId).Msg("operation cancelled")
+	return operation, nil
+}
+
+// DeleteOperation deletes one of the caller's operations along with its
+// result. An operation that has not finished yet is stopped.
+func (d *Domain) DeleteOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.OperationId == 0 {
+		log.Warn().Msg("id required")
+		return domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	if err := d.checkOperationAccess(ctx, authAccount, parent); err != nil {
+		return err
+	}
+
+	operation, err := d.repo.DeleteOperation(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.DeleteOperation failed")
+		return err
+	}
+
+	if cancel, ok := d.operationCancels.Load(id); ok {
+		cancel.(context.CancelFunc)()
+	}
+	if !operation.State.Done() {
+		d.abandonOperation(ctx, operation)
+	}
+	go d.deleteOperationFiles(context.Background(), operation)
+
+	return nil
+}
+
+// WaitOperation waits until one of the caller's operations is done or the
+// timeout has passed, and returns its latest state.
+func (d *Domain) WaitOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId, timeout time.Duration) (model.Operation, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(operationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		operation, err := d.GetOperation(context.WithoutCancel(ctx), authAccount, parent, id)
+		if err != nil || operation.State.Done() {
+			return operation, err
+		}
+
+		select {
+		case <-ctx.Done():
+			if timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return operation, nil
+			}
+			return model.Operation{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkOperationAccess makes sure the caller is the user the operations
+// belong to.
+func (d *Domain) checkOperationAccess(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+	if parent.UserId != authAccount.AuthUserId {
+		log.Warn().Int64("userId", parent.UserId).Msg("operations of another user requested")
+		return domain.ErrPermissionDenied{Msg: "operations can only be accessed by the user who started them"}
+	}
+	return nil
+}
+
+// startOperation records a pending operation and wakes a worker to run it.
+func (d *Domain) startOperation(ctx context.Context, authAccount model.AuthAccount, kind model.OperationKind, request model.OperationRequest) (model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	request.AuthAccount = authAccount
+	operation, err := d.repo.CreateOperation(ctx, model.Operation{
+		Parent:  model.OperationParent{UserId: authAccount.AuthUserId},
+		Kind:    kind,
+		State:   model.OperationState_Pending,
+		Request: request,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.CreateOperation failed")
+		return model.Operation{}, err
+	}
+
+	d.wakeOperationWorker()
+
+	log.Info().Int64("operationId", operation.Id.OperationId).Str("kind", string(kind)).Msg("operation started")
+	return operation, nil
+}
+
+// runOperationAndWait waits for an operation started by one of the
+// synchronous methods. The operation is cancelled when the caller stops
+// waiting.
+func (d *Domain) runOperationAndWait(ctx context.Context, authAccount model.AuthAccount, operation model.Operation) (model.OperationResult, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	finished, err := d.WaitOperation(ctx, authAccount, operation.Parent, operation.Id, 0)
+	if err != nil {
+		if ctx.Err() != nil {
+			_, cancelErr := d.CancelOperation(context.WithoutCancel(ctx), authAccount, operation.Parent, operation.Id)
+			if cancelErr != nil {
+				log.Warn().Err(cancelErr).Msg("unable to cancel abandoned operation")
+			}
+		}
+		return model.OperationResult{}, err
+	}
+
+	switch {
+	case finished.State == model.OperationState_Failed:
+		return model.OperationResult{}, domain.ErrInternal{Msg: finished.Error}
+	case finished.State != model.OperationState_Succeeded || finished.Result == nil:
+		return model.OperationResult{}, domain.ErrInternal{Msg: "operation did not complete"}
+	}
+
+	return *finished.Result, nil
+}
+
+// operationFinishFields are the fields set when an operation finishes.
+var operationFinishFields = []string{
+	model.OperationField_State,
+	model.OperationField_Result,
+	model.OperationField_Error,
+	model.OperationField_EndTime,
+	model.OperationField_ExpireTime,
+}
+
+// finishOperation sets the outcome of an operation and when it expires.
+func (d *Domain) finishOperation(operation *model.Operation, state model.OperationState, result *model.OperationResult, errMsg string) {
+	endTime := time.Now()
+	expireTime := endTime.Add(d.operationRetention)
+	operation.State = state
+	operation.Result = result
+	operation.Error = errMsg
+	operation.EndTime = &endTime
+	operation.ExpireTime = &expireTime
+}
+
+// startOperationWorkers starts the workers, the requeueing of operations
+// whose lease expired and the cleanup of expired operations.
+func (d *Domain) startOperationWorkers(ctx context.Context) error {
+	workerCtx, stop := context.WithCancel(context.Background())
+	d.stopOperations = stop
+
+	d.operationsDone.Add(d.operationWorkers + 2)
+	for range d.operationWorkers {
+		go func() {
+			defer d.operationsDone.Done()
+			d.runOperationWorker(workerCtx)
+		}()
+	}
+	go func() {
+		defer d.operationsDone.Done()
+		d.runOperationRequeue(workerCtx)
+	}()
+	go func() {
+		defer d.operationsDone.Done()
+		d.runOperationCleanup(workerCtx)
+	}()
+
+	d.log.Info().Int("workers", d.operationWorkers).Dur("retention", d.operationRetention).Str("owner", d.operationOwner).Msg("operation workers started")
+	return nil
+}
+
+// stopOperationWorkers stops the workers. Operations that were interrupted
+// are left running and requeued once their lease expires.
+func (d *Domain) stopOperationWorkers(ctx context.Context) error {
+	if d.stopOperations == nil {
+		return nil
+	}
+	d.stopOperations()
+
+	done := make(chan struct{})
+	go func() {
+		d.operationsDone.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// runOperationWorker runs pending operations one at a time until stopped.
+func (d *Domain) runOperationWorker(ctx context.Context) {
+	ticker := time.NewTicker(operationClaimInterval)
+	defer ticker.Stop()
+
+	for {
+		operation, err := d.repo.ClaimOperation(ctx, d.operationOwner, time.Now().Add(d.operationLease))
+		if err == nil {
+			d.runOperation(ctx, operation)
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if !errors.As(err, &repository.ErrNotFound{}) {
+			d.log.Error().Err(err).Msg("repo.ClaimOperation failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.operationWake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOperation does the work of a claimed operation and stores its outcome.
+func (d *Domain) runOperation(ctx context.Context, operation model.Operation) {
+	log := d.log.With().
+		Int64("operationId", operation.Id.OperationId).
+		Str("kind", string(operation.Kind)).
+		Logger()
+
+	operationCtx, cancel := context.WithCancel(ctx)
+	d.operationCancels.Store(operation.Id, cancel)
+
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		d.renewOperationLease(operationCtx, cancel, operation.Id)
+	}()
+	defer func() {
+		d.operationCancels.Delete(operation.Id)
+		cancel()
+		<-heartbeatDone
+	}()
+
+	result, err := d.doOperation(operationCtx, operation)
+	if ctx.Err() != nil {
+		// shutting down, the operation is requeued once its lease expires
+		if result != nil {
+			go d.discardOperationResult(context.Background(), *result)
+		}
+		return
+	}
+
+	if err != nil {
+		log.Warn().Err(err).Msg("operation failed")
+		d.finishOperation(&operation, model.OperationState_Failed, nil, err.Error())
+	} else {
+		d.finishOperation(&operation, model.OperationState_Succeeded, result, "")
+	}
+
+	// only stored while this process still holds the lease
+	operation.LeaseOwner = d.operationOwner
+	_, err = d.repo.UpdateOperation(ctx, operation, operationFinishFields)
+	if errors.As(err, &repository.ErrNotFound{}) {
+		// cancelled, deleted or requeued while running, the result is not
+		// wanted
+		log.Info().Msg("dropping result of cancelled operation")
+		if result != nil {
+			go d.discardOperationResult(context.Background(), *result)
+		}
+	} else if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateOperation failed")
+	}
+
+	// the inputs are not needed any more, whatever the outcome
+	d.deleteOperationFiles(ctx, model.Operation{Request: operation.Request})
+
+	log.Info().Int("state", int(operation.State)).Msg("operation finished")
+}
+
+// renewOperationLease renews the lease on a running operation until ctx is
+// done. The operation is cancelled when the lease was lost, e.g. because the
+// operation was cancelled by another process or requeued after this one
+// stalled.
+func (d *Domain) renewOperationLease(ctx context.Context, cancel context.CancelFunc, id model.OperationId) {
+	ticker := time.NewTicker(d.operationLease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := d.repo.RenewOperationLease(ctx, id, d.operationOwner, time.Now().Add(d.operationLease))
+		if errors.As(err, &repository.ErrNotFound{}) {
+			d.log.Info().Int64("operationId", id.OperationId).Msg("operation lease lost, stopping operation")
+			cancel()
+			return
+		} else if err != nil && ctx.Err() == nil {
+			// keep working, the lease is renewed on the next beat
+			d.log.Warn().Err(err).Int64("operationId", id.OperationId).Msg("repo.RenewOperationLease failed")
+		}
+	}
+}
+
+// doOperation does the work of an operation as the account that started it.
+func (d *Domain) doOperation(ctx context.Context, operation model.Operation) (*model.OperationResult, error) {
+	request := operation.Request
+
+	switch operation.Kind {
+	case model.OperationKind_ScrapeRecipe:
+		recipe, err := d.scrapeRecipe(ctx, request.URI)
+		if err != nil {
+			return nil, err
+		}
+		duplicates, err := d.findRecipeDuplicates(ctx, request.AuthAccount, request.RecipeParent, recipe)
+		if err != nil {
+			return nil, err
+		}
+		return &model.OperationResult{Recipe: &recipe, Duplicates: duplicates}, nil
+
+	case model.OperationKind_OCRRecipe:
+		readers := make([]io.Reader, 0, len(request.FileURIs))
+		for _, uri := range request.FileURIs {
+			contents, err := d.fileRetriever.GetFileContents(ctx, uri)
+			if err != nil {
+				return nil, err
+			}
+			defer contents.Close()
+			readers = append(readers, contents)
+		}
+		recipe, err := d.ocrRecipe(ctx, readers)
+		if err != nil {
+			return nil, err
+		}
+		return &model.OperationResult{Recipe: &recipe}, nil
+
+	case model.OperationKind_GenerateRecipeImage:
+		candidates, generations, err := d.generateRecipeImageCandidates(ctx, request.AuthAccount, request.RecipeId, request.CandidateCount)
+		if err != nil {
+			return nil, err
+		}
+		return &model.OperationResult{Candidates: candidates, Generations: generations}, nil
+
+	case model.OperationKind_ImportRecipes:
+		// the report is kept with the recipe import rather than the operation
+		err := d.runRecipeImport(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		return &model.OperationResult{}, nil
+	}
+
+	return nil, fmt.Errorf("unknown operation kind %q", operation.Kind)
+}
+
+// runOperationRequeue requeues the operations whose lease expired until
+// stopped, and wakes a worker to run them.
+func (d *Domain) runOperationRequeue(ctx context.Context) {
+	ticker := time.NewTicker(d.operationLease)
+	defer ticker.Stop()
+
+	for {
+		d.requeueExpiredOperations(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// requeueExpiredOperations requeues the operations whose lease expired and
+// wakes a worker when there are any.
+func (d *Domain) requeueExpiredOperations(ctx context.Context) {
+	requeued, err := d.repo.RequeueExpiredOperations(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() == nil {
+			d.log.Error().Err(err).Msg("repo.RequeueExpiredOperations failed")
+		}
+		return
+	}
+	if requeued == 0 {
+		return
+	}
+
+	d.wakeOperationWorker()
+}
+
+// wakeOperationWorker wakes an idle worker to claim a pending operation.
+func (d *Domain) wakeOperationWorker() {
+	select {
+	case d.operationWake <- struct{}{}:
+	default:
+	}
+}
+
+// runOperationCleanup deletes expired operations until stopped.
+func (d *Domain) runOperationCleanup(ctx context.Context) {
+	ticker := time.NewTicker(operationCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		operations, err := d.repo.DeleteExpiredOperations(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			d.log.Error().Err(err).Msg("repo.DeleteExpiredOperations failed")
+		}
+		for _, operation := range operations {
+			d.deleteOperationFiles(ctx, operation)
+		}
+		if len(operations) > 0 {
+			d.log.Info().Int("operations", len(operations)).Msg("deleted expired operations")
+		}
+		d.cleanupRecipeImageCandidates(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// uploadOperationImage stores an image for an operation under name, with the
+// extension of its format.
+func (d *Domain) uploadOperationImage(ctx context.Context, name string, imageReader io.Reader) (string, error) {
+	img, err := d.imageClient.CreateImage(ctx, imageReader)
+	if err != nil {
+		return "", err
+	}
+	defer img.Remove(ctx)
+
+	file, err := img.GetFile()
+	if err != nil {
+		return "", err
+	}
+
+	return d.fileStore.UploadPublicFile(ctx, name+file.Extension, file)
+}
+
+// deleteOperationFiles deletes the uploaded inputs of an operation.
+func (d *Domain) deleteOperationFiles(ctx context.Context, operation model.Operation) {
+	for _, uri := range operation.Request.FileURIs {
+		err := d.fileStore.DeleteFile(ctx, uri)
+		if err != nil {
+			d.log.Warn().Err(err).Str("uri", uri).Msg("unable to delete operation file")
+		}
+	}
+}
+
+// abandonOperation records that an operation was stopped before it finished
+// wherever its work is reported outside the operation.
+func (d *Domain) abandonOperation(ctx context.Context, operation model.Operation) {
+	if operation.Kind == model.OperationKind_ImportRecipes {
+		d.failRecipeImport(context.WithoutCancel(ctx), operation.Request, "recipe import cancelled")
+	}
+}
+
+// discardOperationResult deletes what an operation stored for a result that
+// is not wanted and refunds what it charged.
+func (d *Domain) discardOperationResult(ctx context.Context, result model.OperationResult) {
+	d.discardRecipeImageCandidates(ctx, result.Candidates, result.Generations)
+}
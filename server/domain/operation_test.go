@@ -0,0 +1,364 @@
+package domain
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// operationRepo holds operations the way the database does: updates of
+// finished operations and of leases held by another owner are refused.
+type operationRepo struct {
+	repository.Client
+
+	mu         sync.Mutex
+	operations map[int64]model.Operation
+	renewals   int
+}
+
+func newOperationRepo(operations ...model.Operation) *operationRepo {
+	r := &operationRepo{operations: map[int64]model.Operation{}}
+	for _, operation := range operations {
+		r.operations[operation.Id.OperationId] = operation
+	}
+	return r
+}
+
+func (r *operationRepo) get(id model.OperationId) model.Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.operations[id.OperationId]
+}
+
+func (r *operationRepo) set(operation model.Operation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operations[operation.Id.OperationId] = operation
+}
+
+func (r *operationRepo) GetOperation(ctx context.Context, parent model.OperationParent, id model.OperationId, fields []string) (model.Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	operation, ok := r.operations[id.OperationId]
+	if !ok || operation.Parent != parent {
+		return model.Operation{}, repository.ErrNotFound{}
+	}
+	return operation, nil
+}
+
+func (r *operationRepo) UpdateOperation(ctx context.Context, operation model.Operation, fields []string) (model.Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.operations[operation.Id.OperationId]
+	if !ok || stored.State.Done() || (operation.LeaseOwner != "" && operation.LeaseOwner != stored.LeaseOwner) {
+		return model.Operation{}, repository.ErrNotFound{}
+	}
+	stored.State = operation.State
+	stored.Result = operation.Result
+	stored.Error = operation.Error
+	stored.EndTime = operation.EndTime
+	stored.ExpireTime = operation.ExpireTime
+	r.operations[operation.Id.OperationId] = stored
+	return stored, nil
+}
+
+func (r *operationRepo) ClaimOperation(ctx context.Context, owner string, leaseExpireTime time.Time) (model.Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var next *model.Operation
+	for _, operation := range r.operations {
+		if operation.State == model.OperationState_Pending && (next == nil || operation.Id.OperationId < next.Id.OperationId) {
+			next = &operation
+		}
+	}
+	if next == nil {
+		return model.Operation{}, repository.ErrNotFound{}
+	}
+	next.State = model.OperationState_Running
+	next.LeaseOwner = owner
+	next.LeaseExpireTime = &leaseExpireTime
+	r.operations[next.Id.OperationId] = *next
+	return *next, nil
+}
+
+func (r *operationRepo) RenewOperationLease(ctx context.Context, id model.OperationId, owner string, leaseExpireTime time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	operation, ok := r.operations[id.OperationId]
+	if !ok || operation.State != model.OperationState_Running || operation.LeaseOwner != owner {
+		return repository.ErrNotFound{}
+	}
+	operation.LeaseExpireTime = &leaseExpireTime
+	r.operations[id.OperationId] = operation
+	r.renewals++
+	return nil
+}
+
+func (r *operationRepo) RequeueExpiredOperations(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var requeued int64
+	for id, operation := range r.operations {
+		if operation.State != model.OperationState_Running || (operation.LeaseExpireTime != nil && !operation.LeaseExpireTime.Before(before)) {
+			continue
+		}
+		operation.State = model.OperationState_Pending
+		operation.LeaseOwner = ""
+		operation.LeaseExpireTime = nil
+		r.operations[id] = operation
+		requeued++
+	}
+	return requeued, nil
+}
+
+// ocrScraper reads a recipe from photos. When block is set it waits until
+// the operation is stopped instead.
+type ocrScraper struct {
+	block   bool
+	started chan struct{}
+	once    sync.Once
+}
+
+func newOCRScraper(block bool) *ocrScraper {
+	return &ocrScraper{block: block, started: make(chan struct{})}
+}
+
+func (s *ocrScraper) RecipeFromData(ctx context.Context, data []byte) (model.Recipe, error) {
+	return model.Recipe{}, nil
+}
+
+func (s *ocrScraper) RecipeFromImage(ctx context.Context, files []file.File) (model.Recipe, error) {
+	s.once.Do(func() { close(s.started) })
+	if s.block {
+		<-ctx.Done()
+		return model.Recipe{}, ctx.Err()
+	}
+	return model.Recipe{Title: "Pancakes"}, nil
+}
+
+var (
+	operationCaller = model.AuthAccount{AuthUserId: 1, UserId: 1}
+	operationParent = model.OperationParent{UserId: 1}
+	operationId     = model.OperationId{OperationId: 1}
+	operationInput  = "files/operations/1/input-0.jpeg"
+)
+
+func pendingOCROperation() model.Operation {
+	return model.Operation{
+		Parent: operationParent,
+		Id:     operationId,
+		Kind:   model.OperationKind_OCRRecipe,
+		State:  model.OperationState_Pending,
+		Request: model.OperationRequest{
+			AuthAccount: operationCaller,
+			FileURIs:    []string{operationInput},
+		},
+	}
+}
+
+// newOperationDomain creates a domain that runs OCR operations with the
+// given scraper against the given repository.
+func newOperationDomain(repo *operationRepo, scraper *ocrScraper) (*Domain, *fakeFileStore) {
+	fileStore := &fakeFileStore{}
+	d := newTestDomain(repo)
+	d.fileStore = fileStore
+	d.fileRetriever = fakeFileRetriever{}
+	d.imageClient = fakeImageClient{}
+	d.recipeScraper = scraper
+	return d, fileStore
+}
+
+// startWorker runs one operation worker until the test ends.
+func startWorker(t *testing.T, d *Domain) {
+	t.Helper()
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		d.runOperationWorker(ctx)
+	}()
+	t.Cleanup(func() {
+		stop()
+		<-done
+	})
+}
+
+// waitFor fails the test when cond does not hold within a second.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// operationStopped reports whether the operation no longer runs in d.
+func operationStopped(d *Domain, id model.OperationId) func() bool {
+	return func() bool {
+		_, ok := d.operationCancels.Load(id)
+		return !ok
+	}
+}
+
+func TestRunOperationWorker_ClaimsAndFinishes(t *testing.T) {
+	repo := newOperationRepo(pendingOCROperation())
+	d, fileStore := newOperationDomain(repo, newOCRScraper(false))
+
+	startWorker(t, d)
+	waitFor(t, "the operation to finish", func() bool { return repo.get(operationId).State.Done() })
+
+	operation := repo.get(operationId)
+	if operation.State != model.OperationState_Succeeded {
+		t.Fatalf("operation state = %v (%s), want succeeded", operation.State, operation.Error)
+	}
+	if operation.LeaseOwner != "test" || operation.LeaseExpireTime == nil {
+		t.Errorf("operation lease = (%q, %v), want one held by the worker", operation.LeaseOwner, operation.LeaseExpireTime)
+	}
+	if operation.Result == nil || operation.Result.Recipe == nil || operation.Result.Recipe.Title != "Pancakes" {
+		t.Errorf("operation result = %+v, want the recipe read from the photos", operation.Result)
+	}
+	if operation.ExpireTime == nil {
+		t.Error("operation expire time not set")
+	}
+
+	waitFor(t, "the inputs to be deleted", func() bool {
+		fileStore.mu.Lock()
+		defer fileStore.mu.Unlock()
+		return len(fileStore.deleted) == 1 && fileStore.deleted[0] == operationInput
+	})
+}
+
+func TestRunOperation_RenewsLease(t *testing.T) {
+	repo := newOperationRepo(pendingOCROperation())
+	scraper := newOCRScraper(true)
+	d, _ := newOperationDomain(repo, scraper)
+	d.operationLease = 30 * time.Millisecond
+
+	startWorker(t, d)
+	<-scraper.started
+
+	waitFor(t, "the lease to be renewed", func() bool {
+		repo.mu.Lock()
+		defer repo.mu.Unlock()
+		return repo.renewals >= 2
+	})
+
+	// the renewed lease keeps the operation from being requeued
+	time.Sleep(20 * time.Millisecond)
+	d.requeueExpiredOperations(context.Background())
+	if operation := repo.get(operationId); operation.State != model.OperationState_Running || operation.LeaseOwner != "test" {
+		t.Errorf("operation = (%v, %q), want it still running under the worker's lease", operation.State, operation.LeaseOwner)
+	}
+}
+
+func TestCancelOperation_StopsRunningOperation(t *testing.T) {
+	repo := newOperationRepo(pendingOCROperation())
+	scraper := newOCRScraper(true)
+	d, _ := newOperationDomain(repo, scraper)
+
+	startWorker(t, d)
+	<-scraper.started
+
+	cancelled, err := d.CancelOperation(context.Background(), operationCaller, operationParent, operationId)
+	if err != nil {
+		t.Fatalf("CancelOperation() error = %v", err)
+	}
+	if cancelled.State != model.OperationState_Cancelled {
+		t.Errorf("CancelOperation() state = %v, want cancelled", cancelled.State)
+	}
+
+	waitFor(t, "the operation to stop", operationStopped(d, operationId))
+
+	// the stopped work does not overwrite the cancellation
+	if operation := repo.get(operationId); operation.State != model.OperationState_Cancelled || operation.Error != "operation cancelled" {
+		t.Errorf("operation = (%v, %q), want it cancelled", operation.State, operation.Error)
+	}
+}
+
+func TestCancelOperation_Finished(t *testing.T) {
+	operation := pendingOCROperation()
+	operation.State = model.OperationState_Succeeded
+	repo := newOperationRepo(operation)
+	d, _ := newOperationDomain(repo, newOCRScraper(false))
+
+	got, err := d.CancelOperation(context.Background(), operationCaller, operationParent, operationId)
+	if err != nil {
+		t.Fatalf("CancelOperation() error = %v", err)
+	}
+	if got.State != model.OperationState_Succeeded {
+		t.Errorf("CancelOperation() state = %v, want the finished operation left as it is", got.State)
+	}
+}
+
+func TestRunOperation_LostLeaseStopsOperation(t *testing.T) {
+	repo := newOperationRepo(pendingOCROperation())
+	scraper := newOCRScraper(true)
+	d, _ := newOperationDomain(repo, scraper)
+	d.operationLease = 30 * time.Millisecond
+
+	startWorker(t, d)
+	<-scraper.started
+
+	// another process requeued the operation after the lease expired and
+	// claimed it
+	operation := repo.get(operationId)
+	operation.LeaseOwner = "other"
+	repo.set(operation)
+
+	waitFor(t, "the operation to stop", operationStopped(d, operationId))
+
+	// the stopped work does not overwrite the operation of the new owner
+	if operation := repo.get(operationId); operation.State != model.OperationState_Running || operation.LeaseOwner != "other" {
+		t.Errorf("operation = (%v, %q), want it still running under the new lease", operation.State, operation.LeaseOwner)
+	}
+}
+
+func TestRequeueExpiredOperations(t *testing.T) {
+	expired := time.Now().Add(-time.Second)
+	held := time.Now().Add(time.Minute)
+	tests := []struct {
+		name            string
+		leaseExpireTime *time.Time
+		wantState       model.OperationState
+		wantWake        bool
+	}{
+		{name: "expired lease", leaseExpireTime: &expired, wantState: model.OperationState_Pending, wantWake: true},
+		{name: "without lease", wantState: model.OperationState_Pending, wantWake: true},
+		{name: "held lease", leaseExpireTime: &held, wantState: model.OperationState_Running},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := pendingOCROperation()
+			operation.State = model.OperationState_Running
+			operation.LeaseOwner = "other"
+			operation.LeaseExpireTime = tt.leaseExpireTime
+			repo := newOperationRepo(operation)
+			d, _ := newOperationDomain(repo, newOCRScraper(false))
+
+			d.requeueExpiredOperations(context.Background())
+
+			if got := repo.get(operationId).State; got != tt.wantState {
+				t.Errorf("operation state = %v, want %v", got, tt.wantState)
+			}
+			select {
+			case <-d.operationWake:
+				if !tt.wantWake {
+					t.Error("a worker was woken, want none")
+				}
+			default:
+				if tt.wantWake {
+					t.Error("no worker was woken, want one to run the requeued operation")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/grocerylist"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/pantry"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// CreatePantry creates a new pantry.
+func (d *Domain) CreatePantry(ctx context.Context, authAccount model.AuthAccount, pantry model.Pantry) (dbPantry model.Pantry, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if pantry.Parent.CircleId != 0 && authAccount.CircleId != 0 && pantry.Parent.CircleId != authAccount.CircleId {
+		log.Warn().Msg("both circle ids set but do not match")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "both circle ids set but do not match"}
+	}
+
+	if pantry.Title == "" {
+		log.Warn().Msg("title required when creating a pantry")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "title required"}
+	}
+
+	if pantry.Parent.CircleId != 0 {
+		authAccount.CircleId = pantry.Parent.CircleId
+	} else if pantry.Parent.UserId != 0 {
+		authAccount.UserId = pantry.Parent.UserId
+	}
+
+	pantry.Id.PantryId = 0
+
+	if pantry.Parent.CircleId != 0 {
+		_, err = d.determineCircleAccess(ctx, authAccount, model.CircleId{CircleId: pantry.Parent.CircleId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine circle access")
+			return model.Pantry{}, err
+		}
+	} else if pantry.Parent.UserId != 0 {
+		_, err = d.determineUserAccess(ctx, authAccount, model.UserId{UserId: authAccount.UserId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_ADMIN))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine user access")
+			return model.Pantry{}, err
+		}
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.Pantry{}, err
+	}
+	defer tx.Rollback()
+
+	dbPantry, err = tx.CreatePantry(ctx, authAccount, pantry)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CreatePantry failed")
+		return model.Pantry{}, err
+	}
+
+	pantryAccess := model.PantryAccess{
+		PantryAccessParent: model.PantryAccessParent{
+			PantryId: dbPantry.Id,
+		},
+		PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_ADMIN,
+		State:           types.AccessState_ACCESS_STATE_ACCEPTED,
+		Requester: model.PantryRecipientOrRequester{
+			UserId: authAccount.AuthUserId,
+		},
+	}
+	if authAccount.CircleId != 0 {
+		pantryAccess.Recipient = model.PantryRecipientOrRequester{
+			CircleId: authAccount.CircleId,
+		}
+	} else {
+		pantryAccess.Recipient = model.PantryRecipientOrRequester{
+			UserId: authAccount.AuthUserId,
+		}
+	}
+
+	dbPantryAccess, err := tx.CreatePantryAccess(ctx, pantryAccess, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CreatePantryAccess failed")
+		return model.Pantry{}, err
+	}
+
+	dbPantry.PantryAccess = dbPantryAccess
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.Pantry{}, err
+	}
+
+	dbPantry.Parent = pantry.Parent
+
+	return dbPantry, nil
+}
+
+// GetPantry gets a pantry.
+func (d *Domain) GetPantry(ctx context.Context, authAccount model.AuthAccount, parent model.PantryParent, id model.PantryId, fields []string) (pantry model.Pantry, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("parent required")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "parent required"}
+	}
+
+	if id.PantryId == 0 {
+		log.Warn().Msg("id required")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	pantry, err = d.repo.GetPantry(ctx, authAccount, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetPantry failed")
+		return model.Pantry{}, err
+	}
+
+	pantry.PantryAccess, err = d.determinePantryAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(pantry.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+		withAllowPendingAccess(),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access")
+		return model.Pantry{}, err
+	}
+
+	pantry.Parent = parent
+
+	return pantry, nil
+}
+
+// ListPantries lists pantries.
+func (d *Domain) ListPantries(ctx context.Context, authAccount model.AuthAccount, parent model.PantryParent, pageSize int32, pageOffset int32, filter string, fields []string) (pantries []model.Pantry, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user_id required")
+		return nil, domain.ErrInvalidArgument{Msg: "user_id required"}
+	}
+
+	authAccount.PermissionLevel = types.PermissionLevel_PERMISSION_LEVEL_ADMIN
+
+	if parent.CircleId != 0 {
+		authAccount.CircleId = parent.CircleId
+		dbCircle, err := d.repo.GetCircle(ctx, authAccount, model.CircleId{CircleId: parent.CircleId}, []string{model.CircleField_Visibility})
+		if err != nil {
+			log.Error().Err(err).Msg("unable to get circle when listing pantries")
+			return nil, domain.ErrInternal{Msg: "unable to get circle when listing pantries"}
+		}
+		determinedCircleAccess, err := d.determineCircleAccess(
+			ctx, authAccount, model.CircleId{CircleId: parent.CircleId},
+			withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+			withResourceVisibilityLevel(dbCircle.VisibilityLevel),
+		)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine access when listing pantries")
+			return nil, err
+		}
+		authAccount.PermissionLevel = determinedCircleAccess.GetPermissionLevel()
+	} else if parent.UserId != 0 {
+		authAccount.UserId = parent.UserId
+		determinedUserAccess, err := d.determineUserAccess(
+			ctx, authAccount, model.UserId{UserId: authAccount.UserId},
+			withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+			withResourceVisibilityLevel(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine access when listing pantries")
+			return nil, err
+		}
+		authAccount.PermissionLevel = determinedUserAccess.GetPermissionLevel()
+	}
+
+	pantries, err = d.repo.ListPantries(ctx, authAccount, pageSize, pageOffset, filter, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListPantries failed")
+		return nil, err
+	}
+
+	for i := range pantries {
+		pantries[i].Parent = parent
+	}
+
+	return pantries, nil
+}
+
+// UpdatePantry updates a pantry.
+func (d *Domain) UpdatePantry(ctx context.Context, authAccount model.AuthAccount, pantry model.Pantry, fields []string) (dbPantry model.Pantry, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("parent required")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "parent required"}
+	}
+
+	if pantry.Id.PantryId == 0 {
+		log.Warn().Msg("id required")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err = d.determinePantryAccess(
+		ctx, authAccount, pantry.Id,
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access")
+		return model.Pantry{}, err
+	}
+
+	dbPantry, err = d.repo.UpdatePantry(ctx, authAccount, pantry, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdatePantry failed")
+		return model.Pantry{}, err
+	}
+
+	dbPantry.Parent = pantry.Parent
+
+	return dbPantry, nil
+}
+
+// DeletePantry deletes a pantry along with its accesses and items.
+func (d *Domain) DeletePantry(ctx context.Context, authAccount model.AuthAccount, parent model.PantryParent, id model.PantryId) (dbPantry model.Pantry, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("parent required")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "parent required"}
+	}
+
+	if id.PantryId == 0 {
+		log.Warn().Msg("id required")
+		return model.Pantry{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err = d.determinePantryAccess(
+		ctx, authAccount, id,
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_ADMIN),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access")
+		return model.Pantry{}, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.Pantry{}, err
+	}
+	defer tx.Rollback()
+
+	err = tx.BulkDeletePantryItems(ctx, model.PantryItemParent{PantryId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete pantry items")
+		return model.Pantry{}, err
+	}
+
+	err = tx.BulkDeletePantryAccess(ctx, model.PantryAccessParent{PantryId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to bulk delete pantry accesses")
+		return model.Pantry{}, err
+	}
+
+	dbPantry, err = tx.DeletePantry(ctx, authAccount, id)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.DeletePantry failed")
+		return model.Pantry{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.Pantry{}, err
+	}
+
+	dbPantry.Parent = parent
+
+	return dbPantry, nil
+}
+
+// ConsumeRecipe decrements the pantry items used by the ingredients of a
+// cooked recipe. The recipe is scaled to the given servings, or used as
+// written when servings is not set.
+func (d *Domain) ConsumeRecipe(ctx context.Context, authAccount model.AuthAccount, id model.PantryId, recipeId model.RecipeId, servings float64) (consumption model.PantryConsumption, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.PantryConsumption{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.PantryId == 0 {
+		log.Warn().Msg("pantry id required when consuming a recipe")
+		return model.PantryConsumption{}, domain.ErrInvalidArgument{Msg: "pantry id required"}
+	}
+
+	if recipeId.RecipeId == 0 {
+		log.Warn().Msg("recipe id required when consuming a recipe")
+		return model.PantryConsumption{}, domain.ErrInvalidArgument{Msg: "recipe id required"}
+	}
+
+	_, err = d.determinePantryAccess(ctx, authAccount, id, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access when consuming a recipe")
+		return model.PantryConsumption{}, err
+	}
+
+	recipe, err := d.GetRecipe(ctx, authAccount, model.RecipeParent{}, recipeId, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get recipe when consuming a recipe")
+		return model.PantryConsumption{}, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin transaction when consuming a recipe")
+		return model.PantryConsumption{}, err
+	}
+	defer tx.Rollback()
+
+	consumption, err = d.consumeRecipe(ctx, tx, authAccount, id, recipe, servings)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to consume a recipe")
+		return model.PantryConsumption{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit transaction when consuming a recipe")
+		return model.PantryConsumption{}, err
+	}
+
+	return consumption, nil
+}
+
+// RestockPantry increments the pantry items matching the given bought grocery
+// list items, creating new items for anything not yet in the pantry.
+func (d *Domain) RestockPantry(ctx context.Context, authAccount model.AuthAccount, id model.PantryId, listItems []model.ListItem) (pantryItems []model.PantryItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return nil, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.PantryId == 0 {
+		log.Warn().Msg("pantry id required when restocking a pantry")
+		return nil, domain.ErrInvalidArgument{Msg: "pantry id required"}
+	}
+
+	if len(listItems) == 0 {
+		log.Warn().Msg("list items required when restocking a pantry")
+		return nil, domain.ErrInvalidArgument{Msg: "list items required"}
+	}
+
+	_, err = d.determinePantryAccess(ctx, authAccount, id, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access when restocking a pantry")
+		return nil, err
+	}
+
+	titles := make([]string, len(listItems))
+	for i, listItem := range listItems {
+		dbListItem, err := d.GetListItem(ctx, authAccount, listItem.Parent, listItem.Id, []string{model.ListItemField_Title})
+		if err != nil {
+			log.Error().Err(err).Int64("listItemId", listItem.Id.ListItemId).Msg("unable to get list item when restocking a pantry")
+			return nil, err
+		}
+		titles[i] = dbListItem.Title
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin transaction when restocking a pantry")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	pantryItems, err = d.restockPantry(ctx, tx, authAccount, id, titles)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to restock a pantry")
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit transaction when restocking a pantry")
+		return nil, err
+	}
+
+	return pantryItems, nil
+}
+
+// checkPantryWriteAccess verifies that the pantry can be changed, before it
+// is used by the cooking of a recipe or the completion of a list item.
+func (d *Domain) checkPantryWriteAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) error {
+	_, err := d.determinePantryAccess(ctx, authAccount, id, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	return err
+}
+
+// consumeRecipe decrements the pantry items used by the ingredients of a
+// recipe within the given transaction.
+func (d *Domain) consumeRecipe(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, id model.PantryId, recipe model.Recipe, servings float64) (consumption model.PantryConsumption, err error) {
+	items, err := tx.ListPantryItems(ctx, authAccount, model.PantryItemParent{PantryId: id}, 0, 0, "", nil)
+	if err != nil {
+		return model.PantryConsumption{}, err
+	}
+
+	stock := pantry.NewStock(items)
+	consumption.MissingIngredients = stock.Consume(recipe, grocerylist.ScaleForServings(servings, recipe.YieldAmount))
+
+	consumption.PantryItems, err = d.saveStock(ctx, tx, authAccount, id, stock, []string{model.PantryItemField_Quantity})
+	if err != nil {
+		return model.PantryConsumption{}, err
+	}
+
+	return consumption, nil
+}
+
+// restockPantry increments the pantry items matching the given titles within
+// the given transaction.
+func (d *Domain) restockPantry(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, id model.PantryId, titles []string) ([]model.PantryItem, error) {
+	items, err := tx.ListPantryItems(ctx, authAccount, model.PantryItemParent{PantryId: id}, 0, 0, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stock := pantry.NewStock(items)
+	purchaseTime := time.Now().UTC()
+	for _, title := range titles {
+		stock.Restock(title, purchaseTime)
+	}
+
+	return d.saveStock(ctx, tx, authAccount, id, stock, []string{model.PantryItemField_Quantity, model.PantryItemField_PurchaseTime})
+}
+
+// saveStock writes the changed items of a stock. New items are created and
+// existing items have the given fields updated.
+func (d *Domain) saveStock(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, id model.PantryId, stock *pantry.Stock, fields []string) (pantryItems []model.PantryItem, err error) {
+	changed := stock.Changed()
+	pantryItems = make([]model.PantryItem, len(changed))
+	for i, item := range changed {
+		item.Parent.PantryId = id
+		if item.Id.PantryItemId == 0 {
+			pantryItems[i], err = tx.CreatePantryItem(ctx, authAccount, item)
+		} else {
+			pantryItems[i], err = tx.UpdatePantryItem(ctx, authAccount, item, fields)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pantryItems, nil
+}
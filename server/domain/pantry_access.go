@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// Pantry Access Methods
+func (d *Domain) CreatePantryAccess(ctx context.Context, authAccount model.AuthAccount, access model.PantryAccess) (pantryAccess model.PantryAccess, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if access.PantryAccessParent.PantryId.PantryId == 0 {
+		log.Warn().Msg("pantry id is required when creating a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	determinedPantryAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, access)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access ownership details when creating a pantry access")
+		return model.PantryAccess{}, err
+	}
+
+	access.Requester = model.PantryRecipientOrRequester{
+		UserId: authAccount.AuthUserId,
+	}
+	access.AcceptTarget = determinedPantryAccessOwnershipDetails.acceptTarget
+	access.State = determinedPantryAccessOwnershipDetails.accessState
+
+	if access.PermissionLevel > determinedPantryAccessOwnershipDetails.maximumPermissionLevel {
+		log.Warn().Msg("unable to create pantry access with the given permission level")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "cannot create access level higher than your own level"}
+	}
+
+	// create access
+	access, err = d.repo.CreatePantryAccess(ctx, access, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create pantry access")
+		return model.PantryAccess{}, err
+	}
+
+	return access, nil
+}
+
+func (d *Domain) DeletePantryAccess(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, id model.PantryAccessId) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	// verify pantry is set
+	if parent.PantryId.PantryId == 0 {
+		log.Warn().Msg("pantry id is required when deleting a pantry access")
+		return domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	// verify access id is set
+	if id.PantryAccessId == 0 {
+		log.Warn().Msg("access id is required when deleting a pantry access")
+		return domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	dbAccess, err := d.repo.GetPantryAccess(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry access")
+		return err
+	}
+
+	determinedPantryAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, dbAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access ownership details when deleting a pantry access")
+		return domain.ErrInternal{Msg: "unable to determine pantry access ownership details"}
+	}
+
+	if !determinedPantryAccessOwnershipDetails.isRecipientOwner && !determinedPantryAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("access denied when deleting a pantry access")
+		return domain.ErrPermissionDenied{Msg: "access denied"}
+	}
+
+	err = d.repo.DeletePantryAccess(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete pantry access")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Domain) GetPantryAccess(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, id model.PantryAccessId, fields []string) (model.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if parent.PantryId.PantryId == 0 {
+		log.Warn().Msg("pantry id is required when getting a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	// verify access id is set
+	if id.PantryAccessId == 0 {
+		log.Warn().Msg("access id is required when getting a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	dbAccess, err := d.repo.GetPantryAccess(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry access when getting a pantry access")
+		return model.PantryAccess{}, domain.ErrInternal{Msg: "unable to get pantry access"}
+	}
+
+	determinedPantryAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, dbAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access ownership details when getting a pantry access")
+		return model.PantryAccess{}, domain.ErrInternal{Msg: "unable to determine pantry access ownership details"}
+	}
+
+	if !determinedPantryAccessOwnershipDetails.isRecipientOwner && !determinedPantryAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("access denied when getting a pantry access")
+		return model.PantryAccess{}, domain.ErrPermissionDenied{Msg: "access denied"}
+	}
+
+	return dbAccess, nil
+}
+
+func (d *Domain) ListPantryAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) (pantryAccesses []model.PantryAccess, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 && authAccount.CircleId == 0 {
+		log.Warn().Msg("requester is required when listing pantry accesses")
+		return nil, domain.ErrInvalidArgument{Msg: "requester is required"}
+	}
+
+	if parent.PantryId.PantryId != 0 {
+		_, err := d.determinePantryAccess(ctx, authAccount, parent.PantryId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine pantry access")
+			return nil, err
+		}
+	}
+
+	pantryAccesses, err = d.repo.ListPantryAccesses(ctx, authAccount, parent, int32(pageSize), int64(pageOffset), filter, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list pantry accesses")
+		return nil, domain.ErrInternal{Msg: "unable to list pantry accesses"}
+	}
+
+	return pantryAccesses, nil
+}
+
+func (d *Domain) UpdatePantryAccess(ctx context.Context, authAccount model.AuthAccount, access model.PantryAccess, fields []string) (model.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if access.PantryAccessParent.PantryId.PantryId == 0 {
+		log.Warn().Msg("pantry id is required when updating a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	// verify access id is set
+	if access.PantryAccessId.PantryAccessId == 0 {
+		log.Warn().Msg("access id is required when updating a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	// get the existing access record to verify it exists
+	dbAccess, err := d.repo.GetPantryAccess(ctx, access.PantryAccessParent, access.PantryAccessId, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry access")
+		return model.PantryAccess{}, domain.ErrInternal{Msg: "unable to get pantry access"}
+	}
+
+	determinedPantryAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(ctx, authAccount, dbAccess)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access ownership details when updating a pantry access")
+		return model.PantryAccess{}, domain.ErrInternal{Msg: "unable to determine pantry access ownership details"}
+	}
+
+	if !determinedPantryAccessOwnershipDetails.isRecipientOwner && !determinedPantryAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("access denied when updating a pantry access")
+		return model.PantryAccess{}, domain.ErrPermissionDenied{Msg: "access denied"}
+	}
+
+	if slices.Contains(fields, model.PantryAccessField_PermissionLevel) && determinedPantryAccessOwnershipDetails.maximumPermissionLevel < access.PermissionLevel {
+		log.Warn().Msg("cannot update pantry access permission level to a higher level than your own")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "cannot update pantry access permission level to a higher level than your own"}
+	}
+
+	// update access
+	updatedAccess, err := d.repo.UpdatePantryAccess(ctx, access, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update pantry access")
+		return model.PantryAccess{}, domain.ErrInternal{Msg: "unable to update pantry access"}
+	}
+
+	return updatedAccess, nil
+}
+
+// AcceptPantryAccess accepts a pending pantry access.
+func (d *Domain) AcceptPantryAccess(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, id model.PantryAccessId) (model.PantryAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if parent.PantryId.PantryId == 0 {
+		log.Warn().Msg("pantry id is required when accepting a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	// verify access id is set
+	if id.PantryAccessId == 0 {
+		log.Warn().Msg("access id is required when accepting a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "access id is required"}
+	}
+
+	// get the current access
+	dbAccess, err := d.repo.GetPantryAccess(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry access")
+		return model.PantryAccess{}, err
+	}
+
+	// verify the access is in pending state
+	if dbAccess.State != types.AccessState_ACCESS_STATE_PENDING {
+		log.Warn().Msg("access must be in pending state to be accepted")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "access must be in pending state to be accepted"}
+	}
+
+	determinedPantryAccessOwnershipDetails, err := d.determineAccessOwnershipDetails(
+		ctx, authAccount, dbAccess,
+		withAllowAutoOmitAccessChecks(),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine pantry access ownership details when accepting a pantry access")
+		return model.PantryAccess{}, domain.ErrInternal{Msg: "unable to determine pantry access ownership details"}
+	}
+
+	if determinedPantryAccessOwnershipDetails.acceptTarget == types.AcceptTarget_ACCEPT_TARGET_RESOURCE && !determinedPantryAccessOwnershipDetails.isResourceOwner {
+		log.Warn().Msg("must be resource owner to accept resourse targeted pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "must be resource owner to accept resourse targeted pantry access"}
+	} else if determinedPantryAccessOwnershipDetails.acceptTarget == types.AcceptTarget_ACCEPT_TARGET_RECIPIENT && !determinedPantryAccessOwnershipDetails.isRecipientOwner {
+		log.Warn().Msg("must be recipient owner to accept recipient targeted pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "must be recipient owner to accept recipient targeted pantry access"}
+	} else if determinedPantryAccessOwnershipDetails.acceptTarget == types.AcceptTarget_ACCEPT_TARGET_UNSPECIFIED {
+		log.Warn().Msg("unspecified accept target when accepting a pantry access")
+		return model.PantryAccess{}, domain.ErrInvalidArgument{Msg: "unspecified accept target"}
+	}
+
+	// update the access state to accepted
+	dbAccess.State = types.AccessState_ACCESS_STATE_ACCEPTED
+
+	// update access using the repository
+	updatedAccess, err := d.repo.UpdatePantryAccess(ctx, dbAccess, []string{model.PantryAccessField_State})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update pantry access")
+		return model.PantryAccess{}, err
+	}
+
+	return updatedAccess, nil
+}
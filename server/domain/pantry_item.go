@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// CreatePantryItem creates a new pantry item
+func (d *Domain) CreatePantryItem(ctx context.Context, authAccount model.AuthAccount, pantryItem model.PantryItem) (dbPantryItem model.PantryItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 {
+		log.Error().Msg("user id is required when creating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "user id is required"}
+	}
+
+	if pantryItem.Parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required when creating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	if pantryItem.Title == "" {
+		log.Error().Msg("title is required when creating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "title is required"}
+	}
+
+	if pantryItem.Quantity < 0 {
+		log.Error().Msg("quantity can not be negative when creating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "quantity can not be negative"}
+	}
+
+	// Check access to the parent pantry
+	_, err = d.determinePantryAccess(ctx, authAccount, pantryItem.Parent.PantryId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when creating pantry item")
+		return model.PantryItem{}, err
+	}
+
+	// Set ID to 0 for new items
+	pantryItem.Id.PantryItemId = 0
+
+	dbPantryItem, err = d.repo.CreatePantryItem(ctx, authAccount, pantryItem)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create pantry item")
+		return model.PantryItem{}, domain.ErrInternal{Msg: "unable to create pantry item"}
+	}
+
+	return dbPantryItem, nil
+}
+
+// GetPantryItem retrieves a pantry item
+func (d *Domain) GetPantryItem(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, id model.PantryItemId, fields []string) (dbPantryItem model.PantryItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 {
+		log.Error().Msg("user id is required when getting pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "user id is required"}
+	}
+
+	if parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required when getting pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	if id.PantryItemId == 0 {
+		log.Error().Msg("pantry item id is required when getting pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry item id is required"}
+	}
+
+	err = d.checkPantryReadAccess(ctx, authAccount, parent.PantryId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when getting pantry item")
+		return model.PantryItem{}, err
+	}
+
+	dbPantryItem, err = d.repo.GetPantryItem(ctx, authAccount, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry item")
+		return model.PantryItem{}, domain.ErrInternal{Msg: "unable to get pantry item"}
+	}
+
+	if dbPantryItem.Parent.PantryId != parent.PantryId {
+		log.Warn().Msg("pantry item does not belong to the pantry")
+		return model.PantryItem{}, domain.ErrNotFound{Msg: "pantry item not found"}
+	}
+
+	return dbPantryItem, nil
+}
+
+// ListPantryItems lists pantry items with pagination and filtering
+func (d *Domain) ListPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, pageSize int32, pageOffset int32, filter string, fields []string) (dbPantryItems []model.PantryItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 {
+		log.Error().Msg("user id is required when listing pantry items")
+		return []model.PantryItem{}, domain.ErrInvalidArgument{Msg: "user id is required"}
+	}
+
+	if parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required when listing pantry items")
+		return []model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	err = d.checkPantryReadAccess(ctx, authAccount, parent.PantryId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when listing pantry items")
+		return []model.PantryItem{}, err
+	}
+
+	dbPantryItems, err = d.repo.ListPantryItems(ctx, authAccount, parent, pageSize, pageOffset, filter, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list pantry items")
+		return []model.PantryItem{}, err
+	}
+
+	return dbPantryItems, nil
+}
+
+// ListExpiringPantryItems lists the pantry items that expire within the given
+// duration from now, including the ones that have already expired.
+func (d *Domain) ListExpiringPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, expireWithin time.Duration, pageSize int32, pageOffset int32, fields []string) (dbPantryItems []model.PantryItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 {
+		log.Error().Msg("user id is required when listing expiring pantry items")
+		return []model.PantryItem{}, domain.ErrInvalidArgument{Msg: "user id is required"}
+	}
+
+	if parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required when listing expiring pantry items")
+		return []model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	if expireWithin < 0 {
+		log.Error().Msg("expire within can not be negative when listing expiring pantry items")
+		return []model.PantryItem{}, domain.ErrInvalidArgument{Msg: "expire within can not be negative"}
+	}
+
+	err = d.checkPantryReadAccess(ctx, authAccount, parent.PantryId)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when listing expiring pantry items")
+		return []model.PantryItem{}, err
+	}
+
+	dbPantryItems, err = d.repo.ListExpiringPantryItems(ctx, authAccount, parent, time.Now().Add(expireWithin), pageSize, pageOffset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list expiring pantry items")
+		return []model.PantryItem{}, domain.ErrInternal{Msg: "unable to list expiring pantry items"}
+	}
+
+	return dbPantryItems, nil
+}
+
+// UpdatePantryItem updates an existing pantry item
+func (d *Domain) UpdatePantryItem(ctx context.Context, authAccount model.AuthAccount, pantryItem model.PantryItem, fields []string) (dbPantryItem model.PantryItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 {
+		log.Error().Msg("user id is required when updating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "user id is required"}
+	}
+
+	if pantryItem.Parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required when updating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	if pantryItem.Id.PantryItemId == 0 {
+		log.Error().Msg("pantry item id is required when updating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry item id is required"}
+	}
+
+	// Check access to the parent pantry
+	_, err = d.determinePantryAccess(ctx, authAccount, pantryItem.Parent.PantryId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when updating pantry item")
+		return model.PantryItem{}, err
+	}
+
+	// Validate title if it's being updated
+	if len(fields) == 0 || slices.Contains(fields, model.PantryItemField_Title) {
+		if pantryItem.Title == "" {
+			log.Error().Msg("title is required when updating pantry item")
+			return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "title is required"}
+		}
+	}
+
+	if pantryItem.Quantity < 0 {
+		log.Error().Msg("quantity can not be negative when updating pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "quantity can not be negative"}
+	}
+
+	dbPantryItem, err = d.repo.GetPantryItem(ctx, authAccount, pantryItem.Id, []string{model.PantryItemField_Parent})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry item")
+		return model.PantryItem{}, err
+	}
+
+	if dbPantryItem.Parent.PantryId != pantryItem.Parent.PantryId {
+		log.Warn().Msg("pantry item does not belong to the pantry")
+		return model.PantryItem{}, domain.ErrNotFound{Msg: "pantry item not found"}
+	}
+
+	dbPantryItem, err = d.repo.UpdatePantryItem(ctx, authAccount, pantryItem, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to update pantry item")
+		return model.PantryItem{}, domain.ErrInternal{Msg: "unable to update pantry item"}
+	}
+
+	return dbPantryItem, nil
+}
+
+// DeletePantryItem deletes a pantry item
+func (d *Domain) DeletePantryItem(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, id model.PantryItemId) (dbPantryItem model.PantryItem, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if authAccount.AuthUserId == 0 {
+		log.Error().Msg("user id is required when deleting pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "user id is required"}
+	}
+
+	if parent.PantryId.PantryId == 0 {
+		log.Error().Msg("pantry id is required when deleting pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry id is required"}
+	}
+
+	if id.PantryItemId == 0 {
+		log.Error().Msg("pantry item id is required when deleting pantry item")
+		return model.PantryItem{}, domain.ErrInvalidArgument{Msg: "pantry item id is required"}
+	}
+
+	// Check access to the parent pantry
+	_, err = d.determinePantryAccess(ctx, authAccount, parent.PantryId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine access when deleting pantry item")
+		return model.PantryItem{}, err
+	}
+
+	dbPantryItem, err = d.repo.GetPantryItem(ctx, authAccount, id, []string{model.PantryItemField_Parent})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get pantry item")
+		return model.PantryItem{}, err
+	}
+
+	if dbPantryItem.Parent.PantryId != parent.PantryId {
+		log.Warn().Msg("pantry item does not belong to the pantry")
+		return model.PantryItem{}, domain.ErrNotFound{Msg: "pantry item not found"}
+	}
+
+	dbPantryItem, err = d.repo.DeletePantryItem(ctx, authAccount, id)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to delete pantry item")
+		return model.PantryItem{}, domain.ErrInternal{Msg: "unable to delete pantry item"}
+	}
+
+	return dbPantryItem, nil
+}
+
+// checkPantryReadAccess verifies that the pantry can be read, taking its
+// visibility into account. The items of a pantry can be read by anyone who
+// can see the pantry, like GetPantry.
+func (d *Domain) checkPantryReadAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) error {
+	dbPantry, err := d.repo.GetPantry(ctx, authAccount, id, []string{model.PantryField_VisibilityLevel})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.determinePantryAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(dbPantry.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+	)
+	return err
+}
@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	recipePb "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// pantryRepo holds a pantry and its items. Everything else goes to the
+// repository it wraps.
+type pantryRepo struct {
+	repository.Client
+
+	visibility types.VisibilityLevel
+	access     map[int64]model.PantryAccess
+	items      map[int64]model.PantryItem
+}
+
+func newPantryRepo(client repository.Client, items ...model.PantryItem) *pantryRepo {
+	r := &pantryRepo{
+		Client:     client,
+		visibility: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED,
+		access:     map[int64]model.PantryAccess{},
+		items:      map[int64]model.PantryItem{},
+	}
+	for _, item := range items {
+		r.items[item.Id.PantryItemId] = item
+	}
+	return r
+}
+
+func (r *pantryRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	tx, err := r.Client.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pantryTx{TxClient: tx, pantry: r}, nil
+}
+
+func (r *pantryRepo) GetPantry(ctx context.Context, authAccount model.AuthAccount, id model.PantryId, fields []string) (model.Pantry, error) {
+	if id != kitchenPantry {
+		return model.Pantry{}, repository.ErrNotFound{}
+	}
+	return model.Pantry{Id: id, VisibilityLevel: r.visibility}, nil
+}
+
+func (r *pantryRepo) FindStandardUserPantryAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) (model.PantryAccess, error) {
+	access, ok := r.access[authAccount.AuthUserId]
+	if !ok {
+		return model.PantryAccess{}, repository.ErrNotFound{}
+	}
+	return access, nil
+}
+
+func (r *pantryRepo) FindDelegatedCirclePantryAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) (model.PantryAccess, model.CircleAccess, error) {
+	return model.PantryAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *pantryRepo) FindDelegatedUserPantryAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) (model.PantryAccess, model.UserAccess, error) {
+	return model.PantryAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *pantryRepo) ListPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.PantryItem, error) {
+	items := []model.PantryItem{}
+	for _, item := range r.items {
+		if item.Parent == parent {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (r *pantryRepo) GetPantryItem(ctx context.Context, authAccount model.AuthAccount, id model.PantryItemId, fields []string) (model.PantryItem, error) {
+	item, ok := r.items[id.PantryItemId]
+	if !ok {
+		return model.PantryItem{}, repository.ErrNotFound{}
+	}
+	return item, nil
+}
+
+func (r *pantryRepo) ListExpiringPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, before time.Time, pageSize int32, pageOffset int32, fields []string) ([]model.PantryItem, error) {
+	return []model.PantryItem{}, nil
+}
+
+func (r *pantryRepo) CreatePantryItem(ctx context.Context, authAccount model.AuthAccount, item model.PantryItem) (model.PantryItem, error) {
+	item.Id.PantryItemId = int64(len(r.items) + 1)
+	r.items[item.Id.PantryItemId] = item
+	return item, nil
+}
+
+func (r *pantryRepo) UpdatePantryItem(ctx context.Context, authAccount model.AuthAccount, item model.PantryItem, fields []string) (model.PantryItem, error) {
+	stored, ok := r.items[item.Id.PantryItemId]
+	if !ok {
+		return model.PantryItem{}, repository.ErrNotFound{}
+	}
+	stored.Quantity = item.Quantity
+	stored.PurchaseTime = item.PurchaseTime
+	r.items[item.Id.PantryItemId] = stored
+	return stored, nil
+}
+
+// pantryTx writes the pantry items of a pantryRepo within a transaction of
+// the repository it wraps.
+type pantryTx struct {
+	repository.TxClient
+	pantry *pantryRepo
+}
+
+func (tx *pantryTx) ListPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.PantryItem, error) {
+	return tx.pantry.ListPantryItems(ctx, authAccount, parent, pageSize, pageOffset, filter, fields)
+}
+
+func (tx *pantryTx) CreatePantryItem(ctx context.Context, authAccount model.AuthAccount, item model.PantryItem) (model.PantryItem, error) {
+	return tx.pantry.CreatePantryItem(ctx, authAccount, item)
+}
+
+func (tx *pantryTx) UpdatePantryItem(ctx context.Context, authAccount model.AuthAccount, item model.PantryItem, fields []string) (model.PantryItem, error) {
+	return tx.pantry.UpdatePantryItem(ctx, authAccount, item, fields)
+}
+
+var (
+	kitchenPantry = model.PantryId{PantryId: 5}
+	pantryWriter  = model.PantryAccess{PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_WRITE, State: types.AccessState_ACCESS_STATE_ACCEPTED}
+	pantryReader  = model.PantryAccess{PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_READ, State: types.AccessState_ACCESS_STATE_ACCEPTED}
+)
+
+// newFlourItem creates an item of the pantry holding the given grams of
+// flour.
+func newFlourItem(grams float64) model.PantryItem {
+	return model.PantryItem{
+		Parent:   model.PantryItemParent{PantryId: kitchenPantry},
+		Id:       model.PantryItemId{PantryItemId: 1},
+		Title:    "flour",
+		Quantity: grams,
+		Unit:     recipePb.Recipe_MEASUREMENT_TYPE_GRAM,
+	}
+}
+
+func TestCreateRecipeCookLog_ConsumesPantry(t *testing.T) {
+	cookLogs := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+	cookLogs.ingredients = []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{
+		{Title: "flour", MeasurementAmount: 200, MeasurementType: recipePb.Recipe_MEASUREMENT_TYPE_GRAM},
+	}}}
+	repo := newPantryRepo(cookLogs, newFlourItem(500))
+	repo.access[cook.AuthUserId] = pantryWriter
+	d := newTestDomain(repo)
+
+	_, err := d.CreateRecipeCookLog(context.Background(), cook, model.RecipeCookLog{Parent: cookLogParent}, kitchenPantry)
+	if err != nil {
+		t.Fatalf("CreateRecipeCookLog() error = %v", err)
+	}
+
+	if got := repo.items[1].Quantity; got != 300 {
+		t.Errorf("flour = %v g, want 300 g", got)
+	}
+	if len(cookLogs.cookLogs) != 1 {
+		t.Errorf("cook logs = %d, want 1", len(cookLogs.cookLogs))
+	}
+}
+
+func TestCreateListItemCompletion_RestocksPantry(t *testing.T) {
+	listItem := newDailyListItem()
+	listItem.Title = "250 g flour"
+	completions := newCompletionRepo(listItem)
+	repo := newPantryRepo(completions, newFlourItem(100))
+	repo.access[completer.AuthUserId] = pantryWriter
+	d := newTestDomain(repo)
+
+	_, err := d.CreateListItemCompletion(context.Background(), completer, model.ListItemCompletion{Parent: dailyItemCompletions}, kitchenPantry)
+	if err != nil {
+		t.Fatalf("CreateListItemCompletion() error = %v", err)
+	}
+
+	restocked := repo.items[1]
+	if restocked.Quantity != 350 || restocked.PurchaseTime.IsZero() {
+		t.Errorf("flour = (%v g, bought %v), want 350 g bought now", restocked.Quantity, restocked.PurchaseTime)
+	}
+	if len(completions.completions) != 1 {
+		t.Errorf("completions = %d, want 1", len(completions.completions))
+	}
+}
+
+func TestPantryHooks_RequirePantryWriteAccess(t *testing.T) {
+	cookLogs := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+	repo := newPantryRepo(cookLogs, newFlourItem(500))
+	repo.access[cook.AuthUserId] = pantryReader
+	d := newTestDomain(repo)
+
+	_, err := d.CreateRecipeCookLog(context.Background(), cook, model.RecipeCookLog{Parent: cookLogParent}, kitchenPantry)
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Fatalf("CreateRecipeCookLog() error = %v, want permission denied", err)
+	}
+	if len(cookLogs.cookLogs) != 0 {
+		t.Errorf("cook logs = %d, want none", len(cookLogs.cookLogs))
+	}
+
+	completions := newCompletionRepo(newDailyListItem())
+	repo = newPantryRepo(completions, newFlourItem(100))
+	repo.access[completer.AuthUserId] = pantryReader
+	d = newTestDomain(repo)
+
+	_, err = d.CreateListItemCompletion(context.Background(), completer, model.ListItemCompletion{Parent: dailyItemCompletions}, kitchenPantry)
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Fatalf("CreateListItemCompletion() error = %v, want permission denied", err)
+	}
+	if len(completions.completions) != 0 {
+		t.Errorf("completions = %d, want none", len(completions.completions))
+	}
+}
+
+func TestPantryItems_FollowPantryVisibility(t *testing.T) {
+	parent := model.PantryItemParent{PantryId: kitchenPantry}
+	flour := model.PantryItemId{PantryItemId: 1}
+
+	tests := []struct {
+		name       string
+		visibility types.VisibilityLevel
+		wantErr    bool
+	}{
+		{name: "public pantry", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC},
+		{name: "restricted pantry", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newPantryRepo(nil, newFlourItem(500))
+			repo.visibility = tt.visibility
+			d := newTestDomain(repo)
+			ctx := context.Background()
+
+			// the caller has no share of the pantry
+			items, listErr := d.ListPantryItems(ctx, cook, parent, 10, 0, "", nil)
+			_, getErr := d.GetPantryItem(ctx, cook, parent, flour, nil)
+			_, expiringErr := d.ListExpiringPantryItems(ctx, cook, parent, time.Hour, 10, 0, nil)
+			for _, err := range []error{listErr, getErr, expiringErr} {
+				if tt.wantErr {
+					if !errors.As(err, &domain.ErrPermissionDenied{}) {
+						t.Errorf("error = %v, want permission denied", err)
+					}
+				} else if err != nil {
+					t.Errorf("error = %v", err)
+				}
+			}
+			if !tt.wantErr && len(items) != 1 {
+				t.Errorf("ListPantryItems() = %d items, want 1", len(items))
+			}
+		})
+	}
+}
@@ -1,34 +1,55 @@
 package domain
 
 import (
-	"bytes"
 	"compress/flate"
 	"compress/gzip"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"path"
-	"strconv"
+	"slices"
 
-	"github.com/PuerkitoBio/goquery"
+	"github.com/jcfug8/daylear/server/core/dietary"
 	"github.com/jcfug8/daylear/server/core/file"
 	"github.com/jcfug8/daylear/server/core/logutil"
 	model "github.com/jcfug8/daylear/server/core/model"
-	"github.com/jcfug8/daylear/server/core/schemaorgrecipe"
+	"github.com/jcfug8/daylear/server/core/recipeextract"
+	"github.com/jcfug8/daylear/server/core/recipesteps"
 	"github.com/jcfug8/daylear/server/genapi/api/types"
 	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
 	"github.com/microcosm-cc/bluemonday"
-	"github.com/rs/zerolog"
-	uuid "github.com/satori/go.uuid"
 )
 
 const RecipeImageRoot = "recipes"
 
-// CreateRecipe creates a new recipe.
+// CreateRecipe creates a new recipe and finds the recipes of its collection it
+// is likely a copy of.
 func (d *Domain) CreateRecipe(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe) (dbRecipe model.Recipe, err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	dbRecipe, err = d.createRecipe(ctx, authAccount, recipe)
+	if err != nil {
+		return model.Recipe{}, err
+	}
+
+	duplicates, err := d.findRecipeDuplicates(ctx, authAccount, dbRecipe.Parent, dbRecipe)
+	if err != nil {
+		// the recipe is created either way
+		log.Warn().Err(err).Int64("recipeId", dbRecipe.Id.RecipeId).Msg("unable to find duplicates of created recipe")
+		return dbRecipe, nil
+	}
+	for _, duplicate := range duplicates {
+		dbRecipe.PossibleDuplicates = append(dbRecipe.PossibleDuplicates, duplicate.Recipe.Id)
+	}
+
+	return dbRecipe, nil
+}
+
+// createRecipe creates a new recipe without looking for duplicates.
+func (d *Domain) createRecipe(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe) (dbRecipe model.Recipe, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
 	if authAccount.AuthUserId == 0 {
 		log.Warn().Msg("user id required")
 		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
@@ -47,6 +68,12 @@ func (d *Domain) CreateRecipe(ctx context.Context, authAccount model.AuthAccount
 
 	recipe.Id.RecipeId = 0
 
+	err = validateDietaryOverrides(recipe.DietaryOverrides)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid dietary overrides")
+		return model.Recipe{}, err
+	}
+
 	if recipe.Parent.CircleId != 0 {
 		_, err = d.determineCircleAccess(ctx, authAccount, model.CircleId{CircleId: authAccount.CircleId}, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE))
 		if err != nil {
@@ -68,18 +95,51 @@ func (d *Domain) CreateRecipe(ctx context.Context, authAccount model.AuthAccount
 	}
 	defer tx.Rollback()
 
-	recipe.ImageURI, err = d.createRecipeImageURI(ctx, recipe)
+	recipe.ImageURI, recipe.ImageSet, err = d.createRecipeImageURI(ctx, recipe)
 	if err != nil {
 		log.Error().Err(err).Msg("createRecipeImageURI failed")
 		return model.Recipe{}, err
 	}
 
+	ingredientIds, err := d.linkRecipeIngredients(ctx, tx, model.UserId{UserId: authAccount.AuthUserId}, recipe.IngredientGroups)
+	if err != nil {
+		log.Error().Err(err).Msg("linkRecipeIngredients failed")
+		return model.Recipe{}, err
+	}
+
+	recipe.Nutrition, err = d.calculateRecipeNutrition(ctx, tx, recipe)
+	if err != nil {
+		log.Error().Err(err).Msg("calculateRecipeNutrition failed")
+		return model.Recipe{}, err
+	}
+
+	recipe.Directions = recipesteps.Annotate(recipe.Directions, recipe.IngredientGroups, nil)
+	recipe.Diets, recipe.Allergens = dietary.Tag(recipe.IngredientGroups, recipe.DietaryOverrides)
+
 	dbRecipe, err = tx.CreateRecipe(ctx, recipe, nil)
 	if err != nil {
 		log.Error().Err(err).Msg("tx.CreateRecipe failed")
 		return model.Recipe{}, err
 	}
 
+	err = tx.SetRecipeIngredients(ctx, dbRecipe.Id, ingredientIds)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.SetRecipeIngredients failed")
+		return model.Recipe{}, err
+	}
+
+	err = d.copyRecipeStepImages(ctx, tx, dbRecipe.Id, recipe.Images)
+	if err != nil {
+		log.Error().Err(err).Msg("copyRecipeStepImages failed")
+		return model.Recipe{}, err
+	}
+
+	err = d.recordRecipeRevision(ctx, tx, authAccount, dbRecipe.Id, nil, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("recordRecipeRevision failed")
+		return model.Recipe{}, err
+	}
+
 	recipeAccess := model.RecipeAccess{
 		RecipeAccessParent: model.RecipeAccessParent{
 			RecipeId: dbRecipe.Id,
@@ -147,37 +207,108 @@ func (d *Domain) DeleteRecipe(ctx context.Context, authAccount model.AuthAccount
 
 	defer tx.Rollback()
 
-	recipe, err = tx.DeleteRecipe(ctx, authAccount, id)
+	recipe, imageURIs, err := d.deleteRecipe(ctx, tx, authAccount, id)
 	if err != nil {
-		log.Error().Err(err).Msg("tx.DeleteRecipe failed")
+		log.Error().Err(err).Msg("deleteRecipe failed")
+		return model.Recipe{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
 		return model.Recipe{}, err
 	}
 
-	if recipe.ImageURI != "" {
-		go d.fileStore.DeleteFile(context.Background(), recipe.ImageURI)
+	for _, imageURI := range imageURIs {
+		go d.fileStore.DeleteFile(context.Background(), imageURI)
+	}
+
+	recipe.Parent = parent
+
+	return recipe, nil
+}
+
+// deleteRecipe deletes a recipe and the rows that belong to it. The files of
+// the returned image uris are left to delete once the transaction commits.
+func (d *Domain) deleteRecipe(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, id model.RecipeId) (model.Recipe, []string, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	recipe, err := tx.DeleteRecipe(ctx, authAccount, id)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.DeleteRecipe failed")
+		return model.Recipe{}, nil, err
+	}
+
+	images, err := tx.BulkDeleteRecipeImages(ctx, model.RecipeImageParent{RecipeId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.BulkDeleteRecipeImages failed")
+		return model.Recipe{}, nil, err
+	}
+
+	imageURIs := imageFileURIs(recipe.ImageURI, recipe.ImageSet)
+	for _, image := range images {
+		for _, imageURI := range imageFileURIs(image.ImageURI, image.ImageSet) {
+			if !slices.Contains(imageURIs, imageURI) {
+				imageURIs = append(imageURIs, imageURI)
+			}
+		}
 	}
 
 	err = tx.BulkDeleteRecipeAccess(ctx, model.RecipeAccessParent{RecipeId: id})
 	if err != nil {
 		log.Error().Err(err).Msg("tx.BulkDeleteRecipeAccess failed")
-		return model.Recipe{}, err
+		return model.Recipe{}, nil, err
 	}
 
 	err = tx.BulkDeleteRecipeFavorites(ctx, id)
 	if err != nil {
 		log.Error().Err(err).Msg("tx.BulkDeleteRecipeFavorites failed")
-		return model.Recipe{}, err
+		return model.Recipe{}, nil, err
 	}
 
-	err = tx.Commit()
+	err = tx.BulkDeleteRecipeIngredients(ctx, id)
 	if err != nil {
-		log.Error().Err(err).Msg("tx.Commit failed")
-		return model.Recipe{}, err
+		log.Error().Err(err).Msg("tx.BulkDeleteRecipeIngredients failed")
+		return model.Recipe{}, nil, err
 	}
 
-	recipe.Parent = parent
+	err = tx.BulkDeleteRecipeRevisions(ctx, model.RecipeRevisionParent{RecipeId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.BulkDeleteRecipeRevisions failed")
+		return model.Recipe{}, nil, err
+	}
 
-	return recipe, nil
+	err = tx.BulkDeleteRecipeReviews(ctx, model.RecipeReviewParent{RecipeId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.BulkDeleteRecipeReviews failed")
+		return model.Recipe{}, nil, err
+	}
+
+	cookLogs, err := tx.BulkDeleteRecipeCookLogs(ctx, model.RecipeCookLogParent{RecipeId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.BulkDeleteRecipeCookLogs failed")
+		return model.Recipe{}, nil, err
+	}
+	for _, cookLog := range cookLogs {
+		imageURIs = append(imageURIs, recipeCookLogPhotoURIs(cookLog.Photos)...)
+	}
+
+	err = tx.BulkDeleteRecipeNotes(ctx, model.RecipeNoteParent{RecipeId: id}, model.UserId{})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.BulkDeleteRecipeNotes failed")
+		return model.Recipe{}, nil, err
+	}
+
+	candidates, err := tx.BulkDeleteRecipeImageCandidates(ctx, model.RecipeImageCandidateParent{RecipeId: id})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.BulkDeleteRecipeImageCandidates failed")
+		return model.Recipe{}, nil, err
+	}
+	for _, candidate := range candidates {
+		imageURIs = append(imageURIs, candidate.ImageURI)
+	}
+
+	return recipe, imageURIs, nil
 }
 
 // GetRecipe gets a recipe.
@@ -193,7 +324,7 @@ func (d *Domain) GetRecipe(ctx context.Context, authAccount model.AuthAccount, p
 		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "id required"}
 	}
 
-	recipe, err = d.repo.GetRecipe(ctx, authAccount, id, fields)
+	recipe, err = d.repo.GetRecipe(ctx, authAccount, id, restrictionConflictFields(fields))
 	if err != nil {
 		log.Error().Err(err).Msg("repo.GetRecipe failed")
 		return model.Recipe{}, err
@@ -210,11 +341,29 @@ func (d *Domain) GetRecipe(ctx context.Context, authAccount model.AuthAccount, p
 		return model.Recipe{}, err
 	}
 
+	if fields == nil || slices.Contains(fields, model.RecipeField_RestrictionConflicts) {
+		recipes := []model.Recipe{recipe}
+		err = d.flagRestrictionConflicts(ctx, authAccount, recipes)
+		if err != nil {
+			log.Error().Err(err).Msg("flagRestrictionConflicts failed")
+			return model.Recipe{}, err
+		}
+		recipe = recipes[0]
+	}
+
+	if fields == nil || slices.Contains(fields, model.RecipeField_PrivateNotes) {
+		recipe.PrivateNotes, err = d.repo.ListRecipeNotes(ctx, model.UserId{UserId: authAccount.AuthUserId}, model.RecipeNoteParent{RecipeId: id}, 0, 0, nil)
+		if err != nil {
+			log.Error().Err(err).Msg("repo.ListRecipeNotes failed")
+			return model.Recipe{}, err
+		}
+	}
+
 	return recipe, nil
 }
 
 // ListRecipes lists recipes.
-func (d *Domain) ListRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, pageSize int32, pageOffset int64, filter string, fields []string) (recipes []model.Recipe, err error) {
+func (d *Domain) ListRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, pageSize int32, pageOffset int64, filter string, orderBy string, fields []string) (recipes []model.Recipe, err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
 	if authAccount.AuthUserId == 0 {
 		log.Warn().Msg("user_id required")
@@ -253,12 +402,20 @@ func (d *Domain) ListRecipes(ctx context.Context, authAccount model.AuthAccount,
 		authAccount.PermissionLevel = determinedUserAccess.GetPermissionLevel()
 	}
 
-	recipes, err = d.repo.ListRecipes(ctx, authAccount, pageSize, pageOffset, filter, fields)
+	recipes, err = d.repo.ListRecipes(ctx, authAccount, pageSize, pageOffset, filter, orderBy, restrictionConflictFields(fields))
 	if err != nil {
 		log.Error().Err(err).Msg("repo.ListRecipes failed")
 		return nil, err
 	}
 
+	if fields == nil || slices.Contains(fields, model.RecipeField_RestrictionConflicts) {
+		err = d.flagRestrictionConflicts(ctx, authAccount, recipes)
+		if err != nil {
+			log.Error().Err(err).Msg("flagRestrictionConflicts failed")
+			return nil, err
+		}
+	}
+
 	return recipes, nil
 }
 
@@ -296,20 +453,115 @@ func (d *Domain) UpdateRecipe(ctx context.Context, authAccount model.AuthAccount
 		log.Error().Err(err).Msg("repo.Begin failed")
 		return model.Recipe{}, err
 	}
+	defer tx.Rollback()
+
+	// the full recipe is kept as a baseline revision if it has none yet
+	unchangedRecipe, err := tx.GetRecipe(ctx, authAccount, recipe.Id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.GetRecipe failed")
+		return model.Recipe{}, err
+	}
+
+	if slices.Contains(fields, model.RecipeField_IngredientGroups) {
+		ingredientIds, err := d.linkRecipeIngredients(ctx, tx, model.UserId{UserId: authAccount.AuthUserId}, recipe.IngredientGroups)
+		if err != nil {
+			log.Error().Err(err).Msg("linkRecipeIngredients failed")
+			return model.Recipe{}, err
+		}
+
+		err = tx.SetRecipeIngredients(ctx, recipe.Id, ingredientIds)
+		if err != nil {
+			log.Error().Err(err).Msg("tx.SetRecipeIngredients failed")
+			return model.Recipe{}, err
+		}
+	}
+
+	// the nutrition, diets and allergens depend on the ingredients, the lineage is set when forking,
+	// the ratings come from the reviews, the cook history from the cook logs, the private notes
+	// are written separately and the image set is generated from the uploaded image
+	fields = slices.DeleteFunc(slices.Clone(fields), func(field string) bool {
+		return field == model.RecipeField_Nutrition || field == model.RecipeField_ForkedFrom || field == model.RecipeField_ForkCount ||
+			field == model.RecipeField_AverageRating || field == model.RecipeField_RatingCount || field == model.RecipeField_ImageSet ||
+			field == model.RecipeField_Diets || field == model.RecipeField_Allergens || field == model.RecipeField_RestrictionConflicts ||
+			field == model.RecipeField_LastCookedTime || field == model.RecipeField_TimesCooked || field == model.RecipeField_PrivateNotes
+	})
+	updateMask := slices.Clone(fields)
+	if slices.Contains(fields, model.RecipeField_IngredientGroups) || slices.Contains(fields, model.RecipeField_YieldAmount) {
+		nutritionRecipe := recipe
+		if !slices.Contains(fields, model.RecipeField_IngredientGroups) {
+			current, err := tx.GetRecipe(ctx, authAccount, recipe.Id, []string{model.RecipeField_IngredientGroups})
+			if err != nil {
+				log.Error().Err(err).Msg("tx.GetRecipe failed")
+				return model.Recipe{}, err
+			}
+			nutritionRecipe.IngredientGroups = current.IngredientGroups
+		} else if !slices.Contains(fields, model.RecipeField_YieldAmount) {
+			current, err := tx.GetRecipe(ctx, authAccount, recipe.Id, []string{model.RecipeField_YieldAmount})
+			if err != nil {
+				log.Error().Err(err).Msg("tx.GetRecipe failed")
+				return model.Recipe{}, err
+			}
+			nutritionRecipe.YieldAmount = current.YieldAmount
+		}
+
+		recipe.Nutrition, err = d.calculateRecipeNutrition(ctx, tx, nutritionRecipe)
+		if err != nil {
+			log.Error().Err(err).Msg("calculateRecipeNutrition failed")
+			return model.Recipe{}, err
+		}
+		fields = append(fields, model.RecipeField_Nutrition)
+	}
+
+	// the step details reference the ingredients by their position
+	if slices.Contains(fields, model.RecipeField_Directions) {
+		ingredientGroups := unchangedRecipe.IngredientGroups
+		if slices.Contains(fields, model.RecipeField_IngredientGroups) {
+			ingredientGroups = recipe.IngredientGroups
+		}
+		recipe.Directions = recipesteps.Annotate(recipe.Directions, ingredientGroups, unchangedRecipe.Directions)
+	} else if slices.Contains(fields, model.RecipeField_IngredientGroups) {
+		recipe.Directions = recipesteps.LinkIngredients(unchangedRecipe.Directions, recipe.IngredientGroups)
+		fields = append(fields, model.RecipeField_Directions)
+	}
+
+	if slices.Contains(fields, model.RecipeField_IngredientGroups) || slices.Contains(fields, model.RecipeField_DietaryOverrides) {
+		ingredientGroups := unchangedRecipe.IngredientGroups
+		if slices.Contains(fields, model.RecipeField_IngredientGroups) {
+			ingredientGroups = recipe.IngredientGroups
+		}
+		dietaryOverrides := unchangedRecipe.DietaryOverrides
+		if slices.Contains(fields, model.RecipeField_DietaryOverrides) {
+			err = validateDietaryOverrides(recipe.DietaryOverrides)
+			if err != nil {
+				log.Warn().Err(err).Msg("invalid dietary overrides")
+				return model.Recipe{}, err
+			}
+			dietaryOverrides = recipe.DietaryOverrides
+		}
+		recipe.Diets, recipe.Allergens = dietary.Tag(ingredientGroups, dietaryOverrides)
+		fields = append(fields, model.RecipeField_Diets, model.RecipeField_Allergens)
+	}
 
 	for _, updateMaskField := range fields {
 		if updateMaskField == model.RecipeField_ImageURI && recipe.ImageURI != previousDbRecipe.ImageURI {
-			recipe.ImageURI, err = d.updateRecipeImageURI(ctx, authAccount, recipe)
+			recipe.ImageURI, recipe.ImageSet, err = d.updateRecipeImageURI(ctx, authAccount, recipe)
 			if err != nil {
 				log.Error().Err(err).Msg("updateRecipeImageURI failed")
 				return model.Recipe{}, err
 			}
+			fields = append(fields, model.RecipeField_ImageSet)
 		}
 	}
 
-	dbRecipe, err = d.repo.UpdateRecipe(ctx, authAccount, recipe, fields)
+	dbRecipe, err = tx.UpdateRecipe(ctx, authAccount, recipe, fields)
 	if err != nil {
-		log.Error().Err(err).Msg("repo.UpdateRecipe failed")
+		log.Error().Err(err).Msg("tx.UpdateRecipe failed")
+		return model.Recipe{}, err
+	}
+
+	err = d.recordRecipeRevision(ctx, tx, authAccount, recipe.Id, updateMask, &unchangedRecipe)
+	if err != nil {
+		log.Error().Err(err).Msg("recordRecipeRevision failed")
 		return model.Recipe{}, err
 	}
 
@@ -319,6 +571,14 @@ func (d *Domain) UpdateRecipe(ctx context.Context, authAccount model.AuthAccount
 		return model.Recipe{}, err
 	}
 
+	// narrowing the visibility can take the recipe away from the authors of private notes
+	if slices.Contains(fields, model.RecipeField_VisibilityLevel) && recipe.VisibilityLevel > previousDbRecipe.VisibilityLevel {
+		err = d.pruneRecipeNotes(ctx, recipe.Id)
+		if err != nil {
+			log.Warn().Err(err).Msg("pruneRecipeNotes failed")
+		}
+	}
+
 	dbRecipe.Parent = recipe.Parent
 
 	return dbRecipe, nil
@@ -339,12 +599,11 @@ func (d *Domain) UploadRecipeImage(ctx context.Context, authAccount model.AuthAc
 		return "", domain.ErrInvalidArgument{Msg: "id required"}
 	}
 
-	dbRecipe, err := d.repo.GetRecipe(ctx, authAccount, id, []string{model.RecipeField_ImageURI, model.RecipeField_VisibilityLevel})
+	dbRecipe, err := d.repo.GetRecipe(ctx, authAccount, id, []string{model.RecipeField_ImageURI, model.RecipeField_ImageSet, model.RecipeField_VisibilityLevel})
 	if err != nil {
 		log.Error().Err(err).Msg("repo.GetRecipe failed")
 		return "", err
 	}
-	oldImageURI := dbRecipe.ImageURI
 
 	_, err = d.determineRecipeAccess(
 		ctx, authAccount, id,
@@ -356,7 +615,7 @@ func (d *Domain) UploadRecipeImage(ctx context.Context, authAccount model.AuthAc
 		return "", err
 	}
 
-	imageURI, err = d.uploadRecipeImage(ctx, id, imageReader)
+	imageURI, imageSet, err := d.uploadRecipeImage(ctx, id, imageReader)
 	if err != nil {
 		log.Error().Err(err).Msg("uploadRecipeImage failed")
 		return "", err
@@ -365,28 +624,76 @@ func (d *Domain) UploadRecipeImage(ctx context.Context, authAccount model.AuthAc
 	_, err = d.repo.UpdateRecipe(ctx, authAccount, model.Recipe{
 		Id:       id,
 		ImageURI: imageURI,
-	}, []string{model.RecipeField_ImageURI})
+		ImageSet: imageSet,
+	}, []string{model.RecipeField_ImageURI, model.RecipeField_ImageSet})
 	if err != nil {
 		log.Error().Err(err).Msg("repo.UpdateRecipe failed")
 		return "", err
 	}
 
-	go d.fileStore.DeleteFile(context.Background(), oldImageURI)
+	// the previous cover may be one of the recipe images, which keep their file
+	isRecipeImage, err := d.isRecipeImage(ctx, id, dbRecipe.ImageURI)
+	if err != nil {
+		log.Error().Err(err).Msg("isRecipeImage failed")
+		return "", err
+	}
+	if !isRecipeImage {
+		go d.deleteImageFiles(context.Background(), dbRecipe.ImageURI, dbRecipe.ImageSet)
+	}
 
 	return imageURI, nil
 }
 
+// GenerateRecipeImage generates a single candidate image for a recipe through
+// an operation and waits for it. The candidate is kept with the recipe like
+// the ones of StartGenerateRecipeImage.
 func (d *Domain) GenerateRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId) (file.File, error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
-	if authAccount.AuthUserId == 0 {
-		log.Warn().Msg("user id required")
-		return file.File{}, domain.ErrInvalidArgument{Msg: "user id required"}
+
+	operation, err := d.StartGenerateRecipeImage(ctx, authAccount, parent, id, 1)
+	if err != nil {
+		return file.File{}, err
+	}
+
+	result, err := d.runOperationAndWait(ctx, authAccount, operation)
+	if err != nil {
+		log.Error().Err(err).Msg("generate recipe image operation failed")
+		return file.File{}, err
+	}
+	if len(result.Candidates) == 0 {
+		return file.File{}, domain.ErrInternal{Msg: "no image generated"}
+	}
+	imageURI := result.Candidates[0].ImageURI
+
+	contents, err := d.fileRetriever.GetFileContents(ctx, imageURI)
+	if err != nil {
+		log.Error().Err(err).Msg("fileRetriever.GetFileContents failed")
+		return file.File{}, err
+	}
+	defer contents.Close()
+
+	data, err := io.ReadAll(contents)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to read generated image")
+		return file.File{}, err
 	}
 
+	return file.File{
+		ContentType:    http.DetectContentType(data),
+		Extension:      path.Ext(imageURI),
+		ReadSeekCloser: file.NewReadSeekCloser(data),
+		ContentLength:  int64(len(data)),
+	}, nil
+}
+
+// getRecipeForImageGeneration gets a recipe the caller can write to.
+func (d *Domain) getRecipeForImageGeneration(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
 	dbRecipe, err := d.repo.GetRecipe(ctx, authAccount, id, nil)
 	if err != nil {
 		log.Error().Err(err).Msg("repo.GetRecipe failed")
-		return file.File{}, err
+		return model.Recipe{}, err
 	}
 
 	_, err = d.determineRecipeAccess(
@@ -396,26 +703,39 @@ func (d *Domain) GenerateRecipeImage(ctx context.Context, authAccount model.Auth
 	)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to determine recipe access")
-		return file.File{}, err
+		return model.Recipe{}, err
 	}
 
-	f, err := d.imageGenerator.GenerateRecipeImage(ctx, dbRecipe)
+	return dbRecipe, nil
+}
+
+// ScrapeRecipe scrapes a recipe from a web page and finds the recipes of the
+// parent collection it is likely a copy of. The work runs as an operation
+// that is waited for.
+func (d *Domain) ScrapeRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, uri string) (recipe model.Recipe, duplicates []model.RecipeDuplicate, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	operation, err := d.StartScrapeRecipe(ctx, authAccount, parent, uri)
 	if err != nil {
-		log.Error().Err(err).Msg("imageGenerator.GenerateRecipeImage failed")
-		return file.File{}, err
+		return model.Recipe{}, nil, err
 	}
 
-	return f, nil
+	result, err := d.runOperationAndWait(ctx, authAccount, operation)
+	if err != nil {
+		log.Error().Err(err).Msg("scrape recipe operation failed")
+		return model.Recipe{}, nil, err
+	}
+	if result.Recipe == nil {
+		return model.Recipe{}, nil, domain.ErrInternal{Msg: "no recipe scraped"}
+	}
+
+	return *result.Recipe, result.Duplicates, nil
 }
 
-func (d *Domain) ScrapeRecipe(ctx context.Context, authAccount model.AuthAccount, uri string) (recipe model.Recipe, err error) {
+// scrapeRecipe reads the recipe of a web page from its structured data, or
+// with the recipe scraper when there is none.
+func (d *Domain) scrapeRecipe(ctx context.Context, uri string) (recipe model.Recipe, err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
-	if authAccount.AuthUserId == 0 {
-		log.Warn().Msg("user id required")
-		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
-	}
-
-	recipe.Id.RecipeId = 0
 
 	request, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
 	if err != nil {
@@ -467,7 +787,26 @@ func (d *Domain) ScrapeRecipe(ctx context.Context, authAccount model.AuthAccount
 		return
 	}
 
-	extraRecipeData := d.appendSchemaOrgRecipe(log, body)
+	// structured data is tried first, the recipe scraper is only needed for
+	// pages without a complete recipe
+	extracted, found := recipeextract.Extract(body)
+	if found && recipeextract.Complete(extracted) {
+		extracted.Citation = uri
+		extracted.Directions = recipesteps.Annotate(extracted.Directions, extracted.IngredientGroups, nil)
+		return extracted, nil
+	}
+
+	if !d.scraperLLMEnabled {
+		if !found {
+			log.Warn().Str("uri", uri).Msg("no structured recipe data found")
+			return model.Recipe{}, domain.ErrInvalidArgument{Msg: "no recipe found at uri"}
+		}
+		log.Info().Str("uri", uri).Msg("returning incomplete structured recipe data")
+		extracted.Citation = uri
+		extracted.Directions = recipesteps.Annotate(extracted.Directions, extracted.IngredientGroups, nil)
+		return extracted, nil
+	}
+
 	body = bluemonday.StrictPolicy().SanitizeBytes(body)
 
 	recipe, err = d.recipeScraper.RecipeFromData(ctx, body)
@@ -476,21 +815,40 @@ func (d *Domain) ScrapeRecipe(ctx context.Context, authAccount model.AuthAccount
 		return model.Recipe{}, err
 	}
 
-	if extraRecipeData.ImageURI != "" {
-		recipe.ImageURI = extraRecipeData.ImageURI
+	if extracted.ImageURI != "" {
+		recipe.ImageURI = extracted.ImageURI
 	}
 	recipe.Citation = uri
+	recipe.Directions = recipesteps.Annotate(recipe.Directions, recipe.IngredientGroups, nil)
 
 	return recipe, nil
 }
 
+// OCRRecipe reads a recipe from photos through an operation and waits for it.
 func (d *Domain) OCRRecipe(ctx context.Context, authAccount model.AuthAccount, imageReaders []io.Reader) (recipe model.Recipe, err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
-	if authAccount.AuthUserId == 0 {
-		log.Warn().Msg("user id required")
-		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
+
+	operation, err := d.StartOCRRecipe(ctx, authAccount, imageReaders)
+	if err != nil {
+		return model.Recipe{}, err
+	}
+
+	result, err := d.runOperationAndWait(ctx, authAccount, operation)
+	if err != nil {
+		log.Error().Err(err).Msg("ocr recipe operation failed")
+		return model.Recipe{}, err
+	}
+	if result.Recipe == nil {
+		return model.Recipe{}, domain.ErrInternal{Msg: "no recipe read"}
 	}
 
+	return *result.Recipe, nil
+}
+
+// ocrRecipe reads a recipe from photos with the recipe scraper.
+func (d *Domain) ocrRecipe(ctx context.Context, imageReaders []io.Reader) (recipe model.Recipe, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
 	var files []file.File
 
 	for _, imageReader := range imageReaders {
@@ -520,172 +878,87 @@ func (d *Domain) OCRRecipe(ctx context.Context, authAccount model.AuthAccount, i
 	return recipe, nil
 }
 
-func (d *Domain) appendSchemaOrgRecipe(log zerolog.Logger, body []byte) model.Recipe {
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
-	if err != nil {
-		log.Error().Err(err).Msg("failed to parse HTML")
-		return model.Recipe{}
-	}
-
-	// Try to parse JSON-LD first
-	schemaTag := doc.Find("script[type='application/ld+json']")
-	found := false
-	schemaRecipes := []schemaorgrecipe.SchemaOrgRecipe{}
-	schemaRecipe := schemaorgrecipe.SchemaOrgRecipe{}
-	schemaTag.EachWithBreak(func(i int, s *goquery.Selection) bool {
-		schemaRecipes = []schemaorgrecipe.SchemaOrgRecipe{}
-		schemaRecipe = schemaorgrecipe.SchemaOrgRecipe{}
-
-		jsonText := s.Text()
-		// Try @graph object
-		var graphObj map[string]interface{}
-		err := json.Unmarshal([]byte(jsonText), &graphObj)
-		if err == nil {
-			if graph, ok := graphObj["@graph"]; ok {
-				if arr, ok := graph.([]interface{}); ok {
-					for _, item := range arr {
-						if m, ok := item.(map[string]interface{}); ok {
-							typeVal, _ := m["@type"].(string)
-							if typeVal == "Recipe" {
-								b, _ := json.Marshal(m)
-								_ = json.Unmarshal(b, &schemaRecipe)
-								found = true
-								return false // break
-							}
-						}
-					}
-				}
-			}
+// Helper methods
+
+const ownedRecipesPageSize = 100
+
+// listOwnedRecipes lists every recipe the caller has admin access to.
+func (d *Domain) listOwnedRecipes(ctx context.Context, authAccount model.AuthAccount, fields []string) ([]model.Recipe, error) {
+	parent := model.RecipeParent{UserId: authAccount.AuthUserId}
+	filter := fmt.Sprintf("permission = %d AND state = %d", types.PermissionLevel_PERMISSION_LEVEL_ADMIN, types.AccessState_ACCESS_STATE_ACCEPTED)
+
+	var owned []model.Recipe
+	for offset := int64(0); ; offset += ownedRecipesPageSize {
+		recipes, err := d.ListRecipes(ctx, authAccount, parent, ownedRecipesPageSize, offset, filter, "", fields)
+		if err != nil {
+			return nil, err
 		}
-		// Try array of recipes
-		err = json.Unmarshal([]byte(jsonText), &schemaRecipes)
-		if err == nil && len(schemaRecipes) > 0 {
-			for _, rec := range schemaRecipes {
-				if schemaorgrecipe.AsString(rec.Type) == "Recipe" {
-					schemaRecipe = rec
-					found = true
-					return false // break
-				}
-			}
+		for _, recipe := range recipes {
+			recipe.Parent = parent
+			owned = append(owned, recipe)
 		}
-		// Try single recipe
-		err = json.Unmarshal([]byte(jsonText), &schemaRecipe)
-		if err == nil && schemaorgrecipe.AsString(schemaRecipe.Type) == "Recipe" {
-			found = true
-			return false // break
+		if len(recipes) < ownedRecipesPageSize {
+			return owned, nil
 		}
-		return true // continue
-	})
-	if !found {
-		log.Warn().Msg("no schema.org recipe found in ld+json")
-		return model.Recipe{}
 	}
-
-	return schemaorgrecipe.ToModelRecipe(schemaRecipe)
 }
 
-// Helper methods
-
-func (d *Domain) createRecipeImageURI(ctx context.Context, recipe model.Recipe) (string, error) {
+func (d *Domain) createRecipeImageURI(ctx context.Context, recipe model.Recipe) (string, model.ImageSet, error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
 	if recipe.ImageURI == "" {
-		return "", nil
+		return "", model.ImageSet{}, nil
 	}
 
 	fileContents, err := d.fileRetriever.GetFileContents(ctx, recipe.ImageURI)
 	if err != nil {
 		log.Error().Err(err).Msg("fileRetriever.GetFileContents failed")
-		return "", err
+		return "", model.ImageSet{}, err
 	}
 	defer fileContents.Close()
 
-	imageURI, err := d.uploadRecipeImage(ctx, recipe.Id, fileContents)
+	imageURI, imageSet, err := d.uploadRecipeImage(ctx, recipe.Id, fileContents)
 	if err != nil {
 		log.Error().Err(err).Msg("uploadRecipeImage failed")
-		return "", err
+		return "", model.ImageSet{}, err
 	}
 
-	return imageURI, nil
+	return imageURI, imageSet, nil
 }
 
-func (d *Domain) updateRecipeImageURI(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe) (string, error) {
+func (d *Domain) updateRecipeImageURI(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe) (string, model.ImageSet, error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
 	if recipe.ImageURI == "" {
 		err := d.removeRecipeImage(ctx, authAccount, recipe.Parent, recipe.Id)
 		if err != nil {
 			log.Error().Err(err).Msg("removeRecipeImage failed")
-			return "", err
+			return "", model.ImageSet{}, err
 		}
-		return "", nil
+		return "", model.ImageSet{}, nil
 	}
 
 	fileContents, err := d.fileRetriever.GetFileContents(ctx, recipe.ImageURI)
 	if err != nil {
 		log.Error().Err(err).Msg("fileRetriever.GetFileContents failed")
-		return "", err
+		return "", model.ImageSet{}, err
 	}
 	defer fileContents.Close()
 
-	imageURI, err := d.uploadRecipeImage(ctx, recipe.Id, fileContents)
+	imageURI, imageSet, err := d.uploadRecipeImage(ctx, recipe.Id, fileContents)
 	if err != nil {
 		log.Error().Err(err).Msg("uploadRecipeImage failed")
-		return "", err
+		return "", model.ImageSet{}, err
 	}
 
-	return imageURI, nil
+	return imageURI, imageSet, nil
 }
 
-func (d *Domain) uploadRecipeImage(ctx context.Context, id model.RecipeId, imageReader io.Reader) (imageURL string, err error) {
-	log := logutil.EnrichLoggerWithContext(d.log, ctx)
-	image, err := d.imageClient.CreateImage(ctx, imageReader)
-	if err != nil {
-		log.Error().Err(err).Msg("imageClient.CreateImage failed")
-		return "", err
-	}
-
-	err = image.Convert(ctx, "jpeg")
-	if err != nil {
-		log.Error().Err(err).Msg("image.Convert failed")
-		return "", err
-	}
-
-	width, height, err := image.GetDimensions(ctx)
-	if err != nil {
-		log.Error().Err(err).Msg("image.GetDimensions failed")
-		return "", err
-	}
-
-	if width > maxImageWidth || height > maxImageHeight {
-		newWidth, newHeight := resizeToFit(width, height, maxImageWidth)
-		err = image.Resize(ctx, newWidth, newHeight)
-		if err != nil {
-			log.Error().Err(err).Msg("image.Resize failed")
-			return "", err
-		}
-	}
-
-	file, err := image.GetFile()
-	if err != nil {
-		log.Error().Err(err).Msg("image.GetFile failed")
-		return "", err
-	}
-	defer image.Remove(ctx)
-
-	imagePath := path.Join(RecipeImageRoot, strconv.FormatInt(id.RecipeId, 10), uuid.NewV4().String())
-	imagePath = fmt.Sprintf("%s%s", imagePath, file.Extension)
-
-	iamgeURI, err := d.fileStore.UploadPublicFile(ctx, imagePath, file)
-	if err != nil {
-		log.Error().Err(err).Msg("fileStore.UploadPublicFile failed")
-		return "", err
-	}
-
-	return iamgeURI, nil
+func (d *Domain) uploadRecipeImage(ctx context.Context, id model.RecipeId, imageReader io.Reader) (imageURI string, imageSet model.ImageSet, err error) {
+	return d.uploadImage(ctx, RecipeImageRoot, id.RecipeId, imageReader)
 }
 
 func (d *Domain) removeRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId) (err error) {
 	log := logutil.EnrichLoggerWithContext(d.log, ctx)
-	recipe, err := d.GetRecipe(ctx, authAccount, parent, id, []string{model.RecipeField_ImageURI})
+	recipe, err := d.GetRecipe(ctx, authAccount, parent, id, []string{model.RecipeField_ImageURI, model.RecipeField_ImageSet})
 	if err != nil {
 		log.Error().Err(err).Msg("GetRecipe failed")
 		return err
@@ -695,23 +968,16 @@ func (d *Domain) removeRecipeImage(ctx context.Context, authAccount model.AuthAc
 		return nil
 	}
 
-	err = d.fileStore.DeleteFile(ctx, recipe.ImageURI)
+	isRecipeImage, err := d.isRecipeImage(ctx, id, recipe.ImageURI)
 	if err != nil {
-		log.Error().Err(err).Msg("fileStore.DeleteFile failed")
+		log.Error().Err(err).Msg("isRecipeImage failed")
 		return err
 	}
-
-	return nil
-}
-
-func resizeToFit(width, height, maxDim int) (newWidth, newHeight int) {
-	scale := float64(maxDim) / float64(width)
-	if height > width {
-		scale = float64(maxDim) / float64(height)
+	if isRecipeImage {
+		return nil
 	}
-	newWidth = int(float64(width) * scale)
-	newHeight = int(float64(height) * scale)
-	return
+
+	return d.deleteImageFiles(ctx, recipe.ImageURI, recipe.ImageSet)
 }
 
 // FavoriteRecipe favorites a recipe for a user.
@@ -799,3 +1065,84 @@ func (d *Domain) UnfavoriteRecipe(ctx context.Context, authAccount model.AuthAcc
 	log.Info().Int64("recipeId", id.RecipeId).Msg("recipe unfavorited successfully")
 	return nil
 }
+
+// ForkRecipe copies a recipe the user can read into a user or circle
+// collection. The copy records the recipe it was forked from and gets its own
+// copy of the image. Public recipes can be read, and so forked, by everyone;
+// hidden recipes only by users with explicit access.
+func (d *Domain) ForkRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId) (dbRecipe model.Recipe, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	source, err := d.repo.GetRecipe(ctx, authAccount, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return model.Recipe{}, err
+	}
+
+	minimumPermissionLevel := types.PermissionLevel_PERMISSION_LEVEL_READ
+	if source.VisibilityLevel == types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC {
+		minimumPermissionLevel = types.PermissionLevel_PERMISSION_LEVEL_PUBLIC
+	}
+
+	_, err = d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(source.VisibilityLevel),
+		withMinimumPermissionLevel(minimumPermissionLevel),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine recipe access when forking recipe")
+		return model.Recipe{}, err
+	}
+
+	// the step images are copied along with the directions they belong to
+	images, err := d.listRecipeImages(ctx, id)
+	if err != nil {
+		log.Error().Err(err).Msg("listRecipeImages failed")
+		return model.Recipe{}, err
+	}
+	stepImages := []model.RecipeImage{}
+	for _, image := range images {
+		if image.Step != nil {
+			stepImages = append(stepImages, image)
+		}
+	}
+
+	fork := model.Recipe{
+		Parent:           parent,
+		Title:            source.Title,
+		Description:      source.Description,
+		Directions:       source.Directions,
+		IngredientGroups: source.IngredientGroups,
+		ImageURI:         source.ImageURI,
+		VisibilityLevel:  source.VisibilityLevel,
+		Citation:         source.Citation,
+		PrepDuration:     source.PrepDuration,
+		CookDuration:     source.CookDuration,
+		TotalDuration:    source.TotalDuration,
+		CookingMethod:    source.CookingMethod,
+		Categories:       source.Categories,
+		YieldAmount:      source.YieldAmount,
+		Cuisines:         source.Cuisines,
+		DietaryOverrides: source.DietaryOverrides,
+		Images:           stepImages,
+		ForkedFrom:       id,
+	}
+
+	dbRecipe, err = d.createRecipe(ctx, authAccount, fork)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create recipe when forking recipe")
+		return model.Recipe{}, err
+	}
+
+	log.Info().Int64("recipeId", id.RecipeId).Int64("forkId", dbRecipe.Id.RecipeId).Msg("recipe forked successfully")
+	return dbRecipe, nil
+}
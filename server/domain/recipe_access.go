@@ -85,6 +85,11 @@ func (d *Domain) DeleteRecipeAccess(ctx context.Context, authAccount model.AuthA
 		return err
 	}
 
+	err = d.pruneRecipeNotes(ctx, parent.RecipeId)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to prune recipe notes when deleting a recipe access")
+	}
+
 	return nil
 }
 
@@ -0,0 +1,343 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// RecipeCookLogImageRoot is the root the photos of cook logs are stored under.
+const RecipeCookLogImageRoot = "cook_logs"
+
+// CreateRecipeCookLog records that the caller cooked a recipe. The cook time
+// defaults to now. When a pantry is given, the ingredients of the recipe are
+// taken from it along with the creation of the cook log.
+func (d *Domain) CreateRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, cookLog model.RecipeCookLog, pantryId model.PantryId) (model.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	_, err := d.checkRecipeCookLogAccess(ctx, authAccount, cookLog.Parent.RecipeId)
+	if err != nil {
+		return model.RecipeCookLog{}, err
+	}
+
+	if pantryId.PantryId != 0 {
+		err = d.checkPantryWriteAccess(ctx, authAccount, pantryId)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to determine pantry access when creating a cook log")
+			return model.RecipeCookLog{}, err
+		}
+	}
+
+	// photos are uploaded to the cook log once it exists
+	cookLog.Photos = nil
+	cookLog.EventRecipe = nil
+
+	cookLog, err = newRecipeCookLog(authAccount, cookLog)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid cook log")
+		return model.RecipeCookLog{}, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.RecipeCookLog{}, err
+	}
+	defer tx.Rollback()
+
+	cookLog, err = tx.CreateRecipeCookLog(ctx, cookLog)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CreateRecipeCookLog failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	if pantryId.PantryId != 0 {
+		recipe, err := tx.GetRecipe(ctx, authAccount, cookLog.Parent.RecipeId, nil)
+		if err != nil {
+			log.Error().Err(err).Msg("tx.GetRecipe failed")
+			return model.RecipeCookLog{}, err
+		}
+
+		_, err = d.consumeRecipe(ctx, tx, authAccount, pantryId, recipe, 0)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to consume the recipe when creating a cook log")
+			return model.RecipeCookLog{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	return cookLog, nil
+}
+
+// GetRecipeCookLog gets a recipe cook log.
+func (d *Domain) GetRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, id model.RecipeCookLogId, fields []string) (model.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeCookLogId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeCookLog{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err := d.checkRecipeCookLogAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeCookLog{}, err
+	}
+
+	cookLog, err := d.repo.GetRecipeCookLog(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeCookLog failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	return cookLog, nil
+}
+
+// ListRecipeCookLogs lists the cook logs of a recipe, most recently cooked
+// first.
+func (d *Domain) ListRecipeCookLogs(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, pageSize int32, offset int64, fields []string) ([]model.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	_, err := d.checkRecipeCookLogAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return nil, err
+	}
+
+	cookLogs, err := d.repo.ListRecipeCookLogs(ctx, parent, pageSize, offset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeCookLogs failed")
+		return nil, err
+	}
+
+	return cookLogs, nil
+}
+
+// UpdateRecipeCookLog updates a recipe cook log. Only the cook can update a
+// cook log. Updating the photos keeps the listed photos and deletes the
+// others; new photos are uploaded with AddRecipeCookLogPhoto.
+func (d *Domain) UpdateRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, cookLog model.RecipeCookLog, fields []string) (model.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if cookLog.Id.RecipeCookLogId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeCookLog{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	if slices.Contains(fields, model.RecipeCookLogField_Rating) {
+		if err := validateRecipeCookLogRating(cookLog.Rating); err != nil {
+			log.Warn().Int32("rating", cookLog.Rating).Msg("rating out of range")
+			return model.RecipeCookLog{}, err
+		}
+	}
+
+	if slices.Contains(fields, model.RecipeCookLogField_CookTime) && cookLog.CookTime.IsZero() {
+		log.Warn().Msg("cook time required")
+		return model.RecipeCookLog{}, domain.ErrInvalidArgument{Msg: "cook time required"}
+	}
+
+	_, err := d.checkRecipeCookLogAccess(ctx, authAccount, cookLog.Parent.RecipeId)
+	if err != nil {
+		return model.RecipeCookLog{}, err
+	}
+
+	current, err := d.repo.GetRecipeCookLog(ctx, cookLog.Parent, cookLog.Id, []string{model.RecipeCookLogField_CookId, model.RecipeCookLogField_Photos})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeCookLog failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	if current.CookId.UserId != authAccount.AuthUserId {
+		log.Warn().Msg("only the cook can update a cook log")
+		return model.RecipeCookLog{}, domain.ErrPermissionDenied{Msg: "only the cook can update a cook log"}
+	}
+
+	removedPhotos := []model.RecipeCookLogPhoto{}
+	if slices.Contains(fields, model.RecipeCookLogField_Photos) {
+		keptPhotos := make([]model.RecipeCookLogPhoto, 0, len(cookLog.Photos))
+		for _, photo := range current.Photos {
+			if slices.ContainsFunc(cookLog.Photos, func(kept model.RecipeCookLogPhoto) bool { return kept.ImageURI == photo.ImageURI }) {
+				keptPhotos = append(keptPhotos, photo)
+			} else {
+				removedPhotos = append(removedPhotos, photo)
+			}
+		}
+		if len(keptPhotos) != len(cookLog.Photos) {
+			log.Warn().Msg("unknown cook log photo")
+			return model.RecipeCookLog{}, domain.ErrInvalidArgument{Msg: "photos can only be removed from a cook log"}
+		}
+		cookLog.Photos = keptPhotos
+	}
+
+	cookLog, err = d.repo.UpdateRecipeCookLog(ctx, cookLog, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateRecipeCookLog failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	for _, uri := range recipeCookLogPhotoURIs(removedPhotos) {
+		go d.fileStore.DeleteFile(context.Background(), uri)
+	}
+
+	return cookLog, nil
+}
+
+// DeleteRecipeCookLog deletes a recipe cook log and its photos. The cook or
+// an admin of the recipe can delete a cook log.
+func (d *Domain) DeleteRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, id model.RecipeCookLogId) (model.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeCookLogId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeCookLog{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipeAccess, err := d.checkRecipeCookLogAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeCookLog{}, err
+	}
+
+	current, err := d.repo.GetRecipeCookLog(ctx, parent, id, []string{model.RecipeCookLogField_CookId})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeCookLog failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	if current.CookId.UserId != authAccount.AuthUserId && recipeAccess.PermissionLevel < types.PermissionLevel_PERMISSION_LEVEL_ADMIN {
+		log.Warn().Msg("only the cook or a recipe admin can delete a cook log")
+		return model.RecipeCookLog{}, domain.ErrPermissionDenied{Msg: "only the cook or a recipe admin can delete a cook log"}
+	}
+
+	cookLog, err := d.repo.DeleteRecipeCookLog(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.DeleteRecipeCookLog failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	for _, uri := range recipeCookLogPhotoURIs(cookLog.Photos) {
+		go d.fileStore.DeleteFile(context.Background(), uri)
+	}
+
+	return cookLog, nil
+}
+
+// AddRecipeCookLogPhoto uploads a photo to a recipe cook log. Only the cook
+// can add photos.
+func (d *Domain) AddRecipeCookLogPhoto(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, id model.RecipeCookLogId, imageReader io.Reader) (model.RecipeCookLog, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeCookLogId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeCookLog{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err := d.checkRecipeCookLogAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeCookLog{}, err
+	}
+
+	current, err := d.repo.GetRecipeCookLog(ctx, parent, id, []string{model.RecipeCookLogField_CookId, model.RecipeCookLogField_Photos})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeCookLog failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	if current.CookId.UserId != authAccount.AuthUserId {
+		log.Warn().Msg("only the cook can add photos to a cook log")
+		return model.RecipeCookLog{}, domain.ErrPermissionDenied{Msg: "only the cook can add photos to a cook log"}
+	}
+
+	imageURI, imageSet, err := d.uploadImage(ctx, RecipeCookLogImageRoot, id.RecipeCookLogId, imageReader)
+	if err != nil {
+		log.Error().Err(err).Msg("uploadImage failed")
+		return model.RecipeCookLog{}, err
+	}
+
+	current.Parent = parent
+	current.Id = id
+	current.Photos = append(current.Photos, model.RecipeCookLogPhoto{ImageURI: imageURI, ImageSet: imageSet})
+
+	cookLog, err := d.repo.UpdateRecipeCookLog(ctx, current, []string{model.RecipeCookLogField_Photos})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateRecipeCookLog failed")
+		go d.deleteImageFiles(context.Background(), imageURI, imageSet)
+		return model.RecipeCookLog{}, err
+	}
+
+	return cookLog, nil
+}
+
+// newRecipeCookLog validates a cook log of the caller and fills in its
+// defaults before it is created.
+func newRecipeCookLog(authAccount model.AuthAccount, cookLog model.RecipeCookLog) (model.RecipeCookLog, error) {
+	if err := validateRecipeCookLogRating(cookLog.Rating); err != nil {
+		return model.RecipeCookLog{}, err
+	}
+
+	if cookLog.CookTime.IsZero() {
+		cookLog.CookTime = time.Now()
+	}
+	cookLog.Id = model.RecipeCookLogId{}
+	cookLog.CookId = model.UserId{UserId: authAccount.AuthUserId}
+
+	return cookLog, nil
+}
+
+// validateRecipeCookLogRating checks that a cook log rating is unset or in
+// the range of a recipe rating.
+func validateRecipeCookLogRating(rating int32) error {
+	if rating != 0 && (rating < minRecipeRating || rating > maxRecipeRating) {
+		return domain.ErrInvalidArgument{Msg: "rating must be between 1 and 5, or 0 when not rated"}
+	}
+	return nil
+}
+
+// recipeCookLogPhotoURIs returns the URIs of the stored files of the photos
+// of a cook log.
+func recipeCookLogPhotoURIs(photos []model.RecipeCookLogPhoto) []string {
+	uris := []string{}
+	for _, photo := range photos {
+		uris = append(uris, imageFileURIs(photo.ImageURI, photo.ImageSet)...)
+	}
+	return uris
+}
+
+// checkRecipeCookLogAccess checks that the caller can see the recipe the
+// cook logs belong to, like GetRecipe. Cook logs are visible to anyone who can
+// see the recipe, including public recipes they have no share of.
+func (d *Domain) checkRecipeCookLogAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.RecipeAccess{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("recipe id required")
+		return model.RecipeAccess{}, domain.ErrInvalidArgument{Msg: "recipe id required"}
+	}
+
+	recipe, err := d.repo.GetRecipe(ctx, authAccount, id, []string{model.RecipeField_VisibilityLevel})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return model.RecipeAccess{}, err
+	}
+
+	recipeAccess, err := d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(recipe.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+		withAllowPendingAccess(),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine recipe access")
+		return model.RecipeAccess{}, err
+	}
+
+	return recipeAccess, nil
+}
@@ -0,0 +1,533 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// cookLogRepo holds the cook logs of recipes, the access of users to the
+// recipes and an event recipe of a calendar the users can write to. The cook
+// can read the recipes.
+type cookLogRepo struct {
+	repository.Client
+
+	mu           sync.Mutex
+	visibility   types.VisibilityLevel
+	ingredients  []model.IngredientGroup
+	recipeAccess map[int64]model.RecipeAccess
+	cookLogs     map[int64]model.RecipeCookLog
+	event        model.Event
+	eventRecipe  model.EventRecipe
+}
+
+func newCookLogRepo(visibility types.VisibilityLevel, cookLogs ...model.RecipeCookLog) *cookLogRepo {
+	r := &cookLogRepo{
+		visibility:   visibility,
+		recipeAccess: map[int64]model.RecipeAccess{cook.AuthUserId: acceptedRead},
+		cookLogs:     map[int64]model.RecipeCookLog{},
+	}
+	for _, cookLog := range cookLogs {
+		r.cookLogs[cookLog.Id.RecipeCookLogId] = cookLog
+	}
+	return r
+}
+
+func (r *cookLogRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return fakeTx{r}, nil
+}
+
+func (r *cookLogRepo) GetRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, fields []string) (model.Recipe, error) {
+	return model.Recipe{Id: id, VisibilityLevel: r.visibility, IngredientGroups: r.ingredients}, nil
+}
+
+func (r *cookLogRepo) FindStandardUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error) {
+	access, ok := r.recipeAccess[authAccount.AuthUserId]
+	if !ok {
+		return model.RecipeAccess{}, repository.ErrNotFound{}
+	}
+	return access, nil
+}
+
+func (r *cookLogRepo) FindDelegatedCircleRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.CircleAccess, error) {
+	return model.RecipeAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookLogRepo) FindDelegatedUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.UserAccess, error) {
+	return model.RecipeAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookLogRepo) CreateRecipeCookLog(ctx context.Context, cookLog model.RecipeCookLog) (model.RecipeCookLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cookLog.Id.RecipeCookLogId = int64(len(r.cookLogs) + 1)
+	r.cookLogs[cookLog.Id.RecipeCookLogId] = cookLog
+	return cookLog, nil
+}
+
+func (r *cookLogRepo) GetRecipeCookLog(ctx context.Context, parent model.RecipeCookLogParent, id model.RecipeCookLogId, fields []string) (model.RecipeCookLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cookLog, ok := r.cookLogs[id.RecipeCookLogId]
+	if !ok || cookLog.Parent != parent {
+		return model.RecipeCookLog{}, repository.ErrNotFound{}
+	}
+	return cookLog, nil
+}
+
+func (r *cookLogRepo) ListRecipeCookLogs(ctx context.Context, parent model.RecipeCookLogParent, pageSize int32, offset int64, fields []string) ([]model.RecipeCookLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cookLogs := []model.RecipeCookLog{}
+	for _, cookLog := range r.cookLogs {
+		if cookLog.Parent == parent {
+			cookLogs = append(cookLogs, cookLog)
+		}
+	}
+	slices.SortFunc(cookLogs, func(a, b model.RecipeCookLog) int { return b.CookTime.Compare(a.CookTime) })
+	return cookLogs, nil
+}
+
+func (r *cookLogRepo) UpdateRecipeCookLog(ctx context.Context, cookLog model.RecipeCookLog, fields []string) (model.RecipeCookLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.cookLogs[cookLog.Id.RecipeCookLogId]
+	if !ok || stored.Parent != cookLog.Parent {
+		return model.RecipeCookLog{}, repository.ErrNotFound{}
+	}
+	for _, field := range fields {
+		switch field {
+		case model.RecipeCookLogField_Notes:
+			stored.Notes = cookLog.Notes
+		case model.RecipeCookLogField_Rating:
+			stored.Rating = cookLog.Rating
+		case model.RecipeCookLogField_CookTime:
+			stored.CookTime = cookLog.CookTime
+		case model.RecipeCookLogField_Photos:
+			stored.Photos = cookLog.Photos
+		}
+	}
+	r.cookLogs[cookLog.Id.RecipeCookLogId] = stored
+	return stored, nil
+}
+
+func (r *cookLogRepo) DeleteRecipeCookLog(ctx context.Context, parent model.RecipeCookLogParent, id model.RecipeCookLogId) (model.RecipeCookLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cookLog, ok := r.cookLogs[id.RecipeCookLogId]
+	if !ok || cookLog.Parent != parent {
+		return model.RecipeCookLog{}, repository.ErrNotFound{}
+	}
+	delete(r.cookLogs, id.RecipeCookLogId)
+	return cookLog, nil
+}
+
+func (r *cookLogRepo) FindStandardUserCalendarAccess(ctx context.Context, authAccount model.AuthAccount, id model.CalendarId) (model.CalendarAccess, error) {
+	return model.CalendarAccess{
+		PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_WRITE,
+		State:           types.AccessState_ACCESS_STATE_ACCEPTED,
+	}, nil
+}
+
+func (r *cookLogRepo) FindDelegatedCircleCalendarAccess(ctx context.Context, authAccount model.AuthAccount, id model.CalendarId) (model.CalendarAccess, model.CircleAccess, error) {
+	return model.CalendarAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookLogRepo) FindDelegatedUserCalendarAccess(ctx context.Context, authAccount model.AuthAccount, id model.CalendarId) (model.CalendarAccess, model.UserAccess, error) {
+	return model.CalendarAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *cookLogRepo) GetEventRecipe(ctx context.Context, authAccount model.AuthAccount, id model.EventRecipeId, fields []string) (model.EventRecipe, error) {
+	if id != r.eventRecipe.EventRecipeId {
+		return model.EventRecipe{}, repository.ErrNotFound{}
+	}
+	return r.eventRecipe, nil
+}
+
+func (r *cookLogRepo) UpdateEventRecipe(ctx context.Context, eventRecipe model.EventRecipe, fields []string) (model.EventRecipe, error) {
+	r.eventRecipe = eventRecipe
+	return eventRecipe, nil
+}
+
+func (r *cookLogRepo) GetEvent(ctx context.Context, authAccount model.AuthAccount, id model.EventId, fields []string) (model.Event, error) {
+	return r.event, nil
+}
+
+var (
+	cookLogRecipeId = model.RecipeId{RecipeId: 1}
+	cookLogParent   = model.RecipeCookLogParent{RecipeId: cookLogRecipeId}
+	cook            = model.AuthAccount{AuthUserId: 1, UserId: 1}
+	otherCook       = model.AuthAccount{AuthUserId: 2, UserId: 2}
+	recipeAdmin     = model.AuthAccount{AuthUserId: 3, UserId: 3}
+)
+
+var acceptedAdmin = model.RecipeAccess{
+	PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_ADMIN,
+	State:           types.AccessState_ACCESS_STATE_ACCEPTED,
+}
+
+func cookLogOf(id int64, cookId int64, cookTime time.Time) model.RecipeCookLog {
+	return model.RecipeCookLog{
+		Parent:   cookLogParent,
+		Id:       model.RecipeCookLogId{RecipeCookLogId: id},
+		CookId:   model.UserId{UserId: cookId},
+		CookTime: cookTime,
+	}
+}
+
+func TestCreateRecipeCookLog(t *testing.T) {
+	repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+	d := newTestDomain(repo)
+
+	before := time.Now()
+	cookLog, err := d.CreateRecipeCookLog(context.Background(), cook, model.RecipeCookLog{
+		Parent:      cookLogParent,
+		CookId:      model.UserId{UserId: otherCook.AuthUserId},
+		Rating:      4,
+		Notes:       "less sugar",
+		Photos:      []model.RecipeCookLogPhoto{{ImageURI: "files/elsewhere.jpeg"}},
+		EventRecipe: &model.RecipeCookLogEventRecipe{Id: model.EventRecipeId{EventRecipeId: 9}},
+	}, model.PantryId{})
+	if err != nil {
+		t.Fatalf("CreateRecipeCookLog() error = %v", err)
+	}
+
+	if cookLog.CookId.UserId != cook.AuthUserId {
+		t.Errorf("cook = %d, want the caller", cookLog.CookId.UserId)
+	}
+	if cookLog.CookTime.Before(before) || cookLog.CookTime.After(time.Now()) {
+		t.Errorf("cook time = %v, want now", cookLog.CookTime)
+	}
+	if len(cookLog.Photos) != 0 || cookLog.EventRecipe != nil {
+		t.Errorf("cook log = (%v, %v), want no photos or event recipe", cookLog.Photos, cookLog.EventRecipe)
+	}
+	if cookLog.Rating != 4 || cookLog.Notes != "less sugar" {
+		t.Errorf("cook log = (%d, %q), want the given rating and notes", cookLog.Rating, cookLog.Notes)
+	}
+}
+
+func TestCreateRecipeCookLog_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		caller     model.AuthAccount
+		visibility types.VisibilityLevel
+		rating     int32
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "rating out of range",
+			caller:     cook,
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC,
+			rating:     6,
+			wantErr:    func(err error) bool { return errors.As(err, &domain.ErrInvalidArgument{}) },
+		},
+		{
+			name:       "hidden recipe",
+			caller:     otherCook,
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN,
+			wantErr:    func(err error) bool { return errors.As(err, &domain.ErrPermissionDenied{}) },
+		},
+		{
+			name:       "restricted recipe without access",
+			caller:     otherCook,
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED,
+			wantErr:    func(err error) bool { return errors.As(err, &domain.ErrPermissionDenied{}) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newCookLogRepo(tt.visibility)
+			d := newTestDomain(repo)
+
+			_, err := d.CreateRecipeCookLog(context.Background(), tt.caller, model.RecipeCookLog{Parent: cookLogParent, Rating: tt.rating}, model.PantryId{})
+			if !tt.wantErr(err) {
+				t.Errorf("CreateRecipeCookLog() error = %v", err)
+			}
+			if len(repo.cookLogs) != 0 {
+				t.Errorf("CreateRecipeCookLog() created %d cook logs, want none", len(repo.cookLogs))
+			}
+		})
+	}
+}
+
+func TestListRecipeCookLogs(t *testing.T) {
+	now := time.Now()
+	otherRecipeLog := cookLogOf(3, cook.AuthUserId, now)
+	otherRecipeLog.Parent = model.RecipeCookLogParent{RecipeId: model.RecipeId{RecipeId: 2}}
+	repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC,
+		cookLogOf(1, cook.AuthUserId, now.Add(-48*time.Hour)),
+		cookLogOf(2, otherCook.AuthUserId, now.Add(-time.Hour)),
+		otherRecipeLog,
+	)
+	d := newTestDomain(repo)
+
+	cookLogs, err := d.ListRecipeCookLogs(context.Background(), cook, cookLogParent, 0, 0, nil)
+	if err != nil {
+		t.Fatalf("ListRecipeCookLogs() error = %v", err)
+	}
+
+	// the cook logs of every cook of the recipe, and no other recipe
+	ids := []int64{}
+	for _, cookLog := range cookLogs {
+		ids = append(ids, cookLog.Id.RecipeCookLogId)
+	}
+	if !slices.Equal(ids, []int64{2, 1}) {
+		t.Errorf("ListRecipeCookLogs() = %v, want [2 1]", ids)
+	}
+
+	_, err = d.GetRecipeCookLog(context.Background(), cook, cookLogParent, otherRecipeLog.Id, nil)
+	if !errors.As(err, &repository.ErrNotFound{}) {
+		t.Errorf("GetRecipeCookLog() of another recipe error = %v, want not found", err)
+	}
+}
+
+func TestListRecipeCookLogs_HiddenRecipe(t *testing.T) {
+	repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, cookLogOf(1, cook.AuthUserId, time.Now()))
+	d := newTestDomain(repo)
+
+	_, err := d.ListRecipeCookLogs(context.Background(), otherCook, cookLogParent, 0, 0, nil)
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("ListRecipeCookLogs() error = %v, want permission denied", err)
+	}
+
+	repo.recipeAccess[otherCook.AuthUserId] = acceptedRead
+	cookLogs, err := d.ListRecipeCookLogs(context.Background(), otherCook, cookLogParent, 0, 0, nil)
+	if err != nil || len(cookLogs) != 1 {
+		t.Errorf("ListRecipeCookLogs() with access = (%d, %v), want the cook log", len(cookLogs), err)
+	}
+}
+
+func TestRecipeCookLogs_PublicRecipe(t *testing.T) {
+	repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, cookLogOf(1, cook.AuthUserId, time.Now()))
+	d := newTestDomain(repo)
+
+	// anyone who can see the recipe can read and add cook logs
+	cookLogs, err := d.ListRecipeCookLogs(context.Background(), otherCook, cookLogParent, 0, 0, nil)
+	if err != nil || len(cookLogs) != 1 {
+		t.Errorf("ListRecipeCookLogs() without access = (%d, %v), want the cook log", len(cookLogs), err)
+	}
+	_, err = d.GetRecipeCookLog(context.Background(), otherCook, cookLogParent, model.RecipeCookLogId{RecipeCookLogId: 1}, nil)
+	if err != nil {
+		t.Errorf("GetRecipeCookLog() without access error = %v", err)
+	}
+	_, err = d.CreateRecipeCookLog(context.Background(), otherCook, model.RecipeCookLog{Parent: cookLogParent, Rating: 5}, model.PantryId{})
+	if err != nil {
+		t.Errorf("CreateRecipeCookLog() without access error = %v", err)
+	}
+
+	// but only the cook or a recipe admin can delete them
+	_, err = d.DeleteRecipeCookLog(context.Background(), otherCook, cookLogParent, model.RecipeCookLogId{RecipeCookLogId: 1})
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("DeleteRecipeCookLog() without access error = %v, want permission denied", err)
+	}
+}
+
+func TestUpdateRecipeCookLog_OnlyCook(t *testing.T) {
+	repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, cookLogOf(1, cook.AuthUserId, time.Now()))
+	repo.recipeAccess[recipeAdmin.AuthUserId] = acceptedAdmin
+	repo.recipeAccess[otherCook.AuthUserId] = acceptedRead
+	d := newTestDomain(repo)
+
+	update := cookLogOf(1, 0, time.Time{})
+	update.Notes = "more salt"
+	fields := []string{model.RecipeCookLogField_Notes}
+
+	for _, caller := range []model.AuthAccount{otherCook, recipeAdmin} {
+		_, err := d.UpdateRecipeCookLog(context.Background(), caller, update, fields)
+		if !errors.As(err, &domain.ErrPermissionDenied{}) {
+			t.Errorf("UpdateRecipeCookLog() by user %d error = %v, want permission denied", caller.AuthUserId, err)
+		}
+	}
+
+	updated, err := d.UpdateRecipeCookLog(context.Background(), cook, update, fields)
+	if err != nil {
+		t.Fatalf("UpdateRecipeCookLog() by the cook error = %v", err)
+	}
+	if updated.Notes != "more salt" {
+		t.Errorf("notes = %q, want %q", updated.Notes, "more salt")
+	}
+}
+
+func TestUpdateRecipeCookLog_RemovesPhotos(t *testing.T) {
+	kept := model.RecipeCookLogPhoto{ImageURI: "files/cook_logs/1/kept.jpeg"}
+	removed := model.RecipeCookLogPhoto{ImageURI: "files/cook_logs/1/removed.jpeg"}
+	cookLog := cookLogOf(1, cook.AuthUserId, time.Now())
+	cookLog.Photos = []model.RecipeCookLogPhoto{kept, removed}
+	repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, cookLog)
+	fileStore := &fakeFileStore{}
+	d := newTestDomain(repo)
+	d.fileStore = fileStore
+
+	update := cookLogOf(1, 0, time.Time{})
+	fields := []string{model.RecipeCookLogField_Photos}
+
+	update.Photos = []model.RecipeCookLogPhoto{kept, {ImageURI: "files/elsewhere.jpeg"}}
+	_, err := d.UpdateRecipeCookLog(context.Background(), cook, update, fields)
+	if !errors.As(err, &domain.ErrInvalidArgument{}) {
+		t.Errorf("UpdateRecipeCookLog() with an unknown photo error = %v, want invalid argument", err)
+	}
+
+	update.Photos = []model.RecipeCookLogPhoto{kept}
+	updated, err := d.UpdateRecipeCookLog(context.Background(), cook, update, fields)
+	if err != nil {
+		t.Fatalf("UpdateRecipeCookLog() error = %v", err)
+	}
+	if len(updated.Photos) != 1 || updated.Photos[0].ImageURI != kept.ImageURI {
+		t.Errorf("photos = %v, want only the kept photo", updated.Photos)
+	}
+
+	waitFor(t, "the removed photo to be deleted", func() bool {
+		fileStore.mu.Lock()
+		defer fileStore.mu.Unlock()
+		return slices.Equal(fileStore.deleted, []string{removed.ImageURI})
+	})
+}
+
+func TestDeleteRecipeCookLog(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  model.AuthAccount
+		allowed bool
+	}{
+		{name: "cook", caller: cook, allowed: true},
+		{name: "recipe admin", caller: recipeAdmin, allowed: true},
+		{name: "other cook", caller: otherCook},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, cookLogOf(1, cook.AuthUserId, time.Now()))
+			repo.recipeAccess[recipeAdmin.AuthUserId] = acceptedAdmin
+			repo.recipeAccess[otherCook.AuthUserId] = acceptedRead
+			d := newTestDomain(repo)
+			d.fileStore = &fakeFileStore{}
+
+			_, err := d.DeleteRecipeCookLog(context.Background(), tt.caller, cookLogParent, model.RecipeCookLogId{RecipeCookLogId: 1})
+			if !tt.allowed {
+				if !errors.As(err, &domain.ErrPermissionDenied{}) {
+					t.Errorf("DeleteRecipeCookLog() error = %v, want permission denied", err)
+				}
+				if len(repo.cookLogs) != 1 {
+					t.Error("DeleteRecipeCookLog() deleted the cook log, want it kept")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteRecipeCookLog() error = %v", err)
+			}
+			if len(repo.cookLogs) != 0 {
+				t.Error("DeleteRecipeCookLog() kept the cook log, want it deleted")
+			}
+		})
+	}
+}
+
+var (
+	cookLogEventParent   = model.EventRecipeParent{CalendarId: 1, EventId: 1}
+	cookLogEventRecipeId = model.EventRecipeId{EventRecipeId: 1}
+)
+
+// newEventCookLogRepo creates a repository with an event starting at the
+// given time whose recipe is the cook log recipe.
+func newEventCookLogRepo(startTime time.Time, overridenStartTime *time.Time) *cookLogRepo {
+	repo := newCookLogRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+	repo.event = model.Event{
+		Id:                 model.EventId{EventId: cookLogEventParent.EventId},
+		StartTime:          startTime,
+		OverridenStartTime: overridenStartTime,
+	}
+	repo.eventRecipe = model.EventRecipe{
+		Parent:        cookLogEventParent,
+		EventRecipeId: cookLogEventRecipeId,
+		RecipeId:      cookLogRecipeId,
+	}
+	return repo
+}
+
+func TestMarkEventRecipeDone_CreatesCookLog(t *testing.T) {
+	yesterday := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	lastWeek := yesterday.Add(-6 * 24 * time.Hour)
+	tomorrow := time.Now().Add(24 * time.Hour)
+	tests := []struct {
+		name               string
+		startTime          time.Time
+		overridenStartTime *time.Time
+		wantCookTime       *time.Time
+	}{
+		{name: "past event", startTime: yesterday, wantCookTime: &yesterday},
+		{name: "moved event", startTime: lastWeek, overridenStartTime: &yesterday, wantCookTime: &yesterday},
+		{name: "future event", startTime: tomorrow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newEventCookLogRepo(tt.startTime, tt.overridenStartTime)
+			d := newTestDomain(repo)
+
+			before := time.Now()
+			eventRecipe, cookLog, err := d.MarkEventRecipeDone(context.Background(), cook, cookLogEventParent, cookLogEventRecipeId, &model.RecipeCookLog{Rating: 5})
+			if err != nil {
+				t.Fatalf("MarkEventRecipeDone() error = %v", err)
+			}
+
+			if eventRecipe.DoneTime == nil {
+				t.Error("event recipe done time not set")
+			}
+			if cookLog == nil || len(repo.cookLogs) != 1 {
+				t.Fatalf("MarkEventRecipeDone() cook log = %v, want one created", cookLog)
+			}
+			if cookLog.Parent != cookLogParent || cookLog.CookId.UserId != cook.AuthUserId || cookLog.Rating != 5 {
+				t.Errorf("cook log = (%v, %d, %d), want a rated cook log of the caller for the event's recipe", cookLog.Parent, cookLog.CookId.UserId, cookLog.Rating)
+			}
+			if cookLog.EventRecipe == nil || cookLog.EventRecipe.Parent != cookLogEventParent || cookLog.EventRecipe.Id != cookLogEventRecipeId {
+				t.Errorf("cook log event recipe = %v, want the event recipe", cookLog.EventRecipe)
+			}
+			if tt.wantCookTime != nil {
+				if !cookLog.CookTime.Equal(*tt.wantCookTime) {
+					t.Errorf("cook time = %v, want the start of the event %v", cookLog.CookTime, *tt.wantCookTime)
+				}
+			} else if cookLog.CookTime.Before(before) || cookLog.CookTime.After(time.Now()) {
+				t.Errorf("cook time = %v, want now for an event that has not started", cookLog.CookTime)
+			}
+		})
+	}
+}
+
+func TestMarkEventRecipeDone_WithoutCookLog(t *testing.T) {
+	repo := newEventCookLogRepo(time.Now().Add(-time.Hour), nil)
+	d := newTestDomain(repo)
+
+	eventRecipe, cookLog, err := d.MarkEventRecipeDone(context.Background(), cook, cookLogEventParent, cookLogEventRecipeId, nil)
+	if err != nil {
+		t.Fatalf("MarkEventRecipeDone() error = %v", err)
+	}
+	if eventRecipe.DoneTime == nil {
+		t.Error("event recipe done time not set")
+	}
+	if cookLog != nil || len(repo.cookLogs) != 0 {
+		t.Errorf("MarkEventRecipeDone() cook log = %v, want none", cookLog)
+	}
+}
+
+func TestMarkEventRecipeDone_OtherEvent(t *testing.T) {
+	repo := newEventCookLogRepo(time.Now().Add(-time.Hour), nil)
+	d := newTestDomain(repo)
+
+	otherEvent := model.EventRecipeParent{CalendarId: cookLogEventParent.CalendarId, EventId: 2}
+	_, _, err := d.MarkEventRecipeDone(context.Background(), cook, otherEvent, cookLogEventRecipeId, &model.RecipeCookLog{})
+	if !errors.As(err, &domain.ErrNotFound{}) {
+		t.Errorf("MarkEventRecipeDone() error = %v, want not found", err)
+	}
+	if repo.eventRecipe.DoneTime != nil || len(repo.cookLogs) != 0 {
+		t.Error("MarkEventRecipeDone() changed the event recipe of another event")
+	}
+}
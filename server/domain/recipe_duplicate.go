@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeduplicate"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// recipeDuplicateBatchSize is the number of recipes read at a time when
+// looking for duplicates.
+const recipeDuplicateBatchSize = 500
+
+// recipeDuplicateFields are the recipe fields read when looking for
+// duplicates.
+var recipeDuplicateFields = []string{
+	model.RecipeField_Id,
+	model.RecipeField_Title,
+	model.RecipeField_Description,
+	model.RecipeField_Citation,
+	model.RecipeField_IngredientGroups,
+	model.RecipeField_ImageURI,
+	model.RecipeField_ImageSet,
+	model.RecipeField_CreateTime,
+	model.RecipeField_UpdateTime,
+}
+
+// FindDuplicateRecipes groups the recipes of a user or circle that are likely
+// copies of each other, starting with the group of the oldest recipe.
+func (d *Domain) FindDuplicateRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, pageSize int32, offset int64) ([]model.RecipeDuplicateGroup, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return nil, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if parent.UserId == 0 && parent.CircleId == 0 {
+		log.Warn().Msg("parent required")
+		return nil, domain.ErrInvalidArgument{Msg: "parent required"}
+	}
+
+	recipes, err := d.listCollectionRecipes(ctx, authAccount, parent)
+	if err != nil {
+		log.Error().Err(err).Msg("listCollectionRecipes failed")
+		return nil, err
+	}
+
+	groups := recipeduplicate.Group(recipes)
+
+	if offset >= int64(len(groups)) {
+		return []model.RecipeDuplicateGroup{}, nil
+	}
+	groups = groups[offset:]
+	if pageSize > 0 && int(pageSize) < len(groups) {
+		groups = groups[:pageSize]
+	}
+
+	return groups, nil
+}
+
+// MergeRecipes keeps a recipe and moves the favorites, accesses, event links,
+// cook logs and private notes of the merged recipes onto it. The merged
+// recipes are then deleted.
+func (d *Domain) MergeRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, mergedIds []model.RecipeId) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	if len(mergedIds) == 0 {
+		log.Warn().Msg("recipes to merge required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "recipes to merge required"}
+	}
+
+	seen := map[model.RecipeId]struct{}{id: {}}
+	for _, mergedId := range mergedIds {
+		if mergedId.RecipeId == 0 {
+			log.Warn().Msg("merged recipe id required")
+			return model.Recipe{}, domain.ErrInvalidArgument{Msg: "merged recipe id required"}
+		}
+		if _, ok := seen[mergedId]; ok {
+			log.Warn().Int64("recipeId", mergedId.RecipeId).Msg("recipe merged more than once")
+			return model.Recipe{}, domain.ErrInvalidArgument{Msg: "a recipe can only be merged once and not into itself"}
+		}
+		seen[mergedId] = struct{}{}
+	}
+
+	// the kept recipe takes over the sharing of the merged recipes and the
+	// merged recipes are deleted, so all of them need admin access
+	for recipeId := range seen {
+		_, err := d.determineRecipeAccess(ctx, authAccount, recipeId, withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_ADMIN))
+		if err != nil {
+			log.Error().Err(err).Int64("recipeId", recipeId.RecipeId).Msg("unable to determine recipe access when merging recipes")
+			return model.Recipe{}, err
+		}
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.Recipe{}, err
+	}
+	defer tx.Rollback()
+
+	imageURIs := []string{}
+	for _, mergedId := range mergedIds {
+		err = tx.MoveRecipeFavorites(ctx, mergedId, id)
+		if err != nil {
+			log.Error().Err(err).Msg("tx.MoveRecipeFavorites failed")
+			return model.Recipe{}, err
+		}
+
+		err = tx.MoveRecipeAccesses(ctx, mergedId, id)
+		if err != nil {
+			log.Error().Err(err).Msg("tx.MoveRecipeAccesses failed")
+			return model.Recipe{}, err
+		}
+
+		err = tx.MoveEventRecipes(ctx, mergedId, id)
+		if err != nil {
+			log.Error().Err(err).Msg("tx.MoveEventRecipes failed")
+			return model.Recipe{}, err
+		}
+
+		err = tx.MoveRecipeCookLogs(ctx, mergedId, id)
+		if err != nil {
+			log.Error().Err(err).Msg("tx.MoveRecipeCookLogs failed")
+			return model.Recipe{}, err
+		}
+
+		err = tx.MoveRecipeNotes(ctx, mergedId, id)
+		if err != nil {
+			log.Error().Err(err).Msg("tx.MoveRecipeNotes failed")
+			return model.Recipe{}, err
+		}
+
+		_, mergedImageURIs, err := d.deleteRecipe(ctx, tx, authAccount, mergedId)
+		if err != nil {
+			log.Error().Err(err).Msg("deleteRecipe failed")
+			return model.Recipe{}, err
+		}
+		imageURIs = append(imageURIs, mergedImageURIs...)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.Recipe{}, err
+	}
+
+	for _, imageURI := range imageURIs {
+		go d.fileStore.DeleteFile(context.Background(), imageURI)
+	}
+
+	recipe, err := d.GetRecipe(ctx, authAccount, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get merged recipe")
+		return model.Recipe{}, err
+	}
+	recipe.Parent = parent
+
+	log.Info().Int64("recipeId", id.RecipeId).Int("merged", len(mergedIds)).Msg("recipes merged successfully")
+	return recipe, nil
+}
+
+// findRecipeDuplicates finds the recipes of a collection that the recipe is
+// likely a copy of. The collection defaults to the one of the caller.
+func (d *Domain) findRecipeDuplicates(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, recipe model.Recipe) ([]model.RecipeDuplicate, error) {
+	if parent.UserId == 0 && parent.CircleId == 0 {
+		if authAccount.CircleId != 0 {
+			parent.CircleId = authAccount.CircleId
+		} else {
+			parent.UserId = authAccount.AuthUserId
+		}
+	}
+
+	recipes, err := d.listCollectionRecipes(ctx, authAccount, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipeduplicate.Find(recipe, recipes), nil
+}
+
+// listCollectionRecipes lists the recipes of a user or circle with the fields
+// needed to find duplicates.
+func (d *Domain) listCollectionRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent) ([]model.Recipe, error) {
+	var collection []model.Recipe
+	for offset := int64(0); ; offset += recipeDuplicateBatchSize {
+		// ListRecipes applies the visibility and access rules
+		recipes, err := d.ListRecipes(ctx, authAccount, parent, recipeDuplicateBatchSize, offset, "", "", recipeDuplicateFields)
+		if err != nil {
+			return nil, err
+		}
+		for _, recipe := range recipes {
+			recipe.Parent = parent
+			collection = append(collection, recipe)
+		}
+		if len(recipes) < recipeDuplicateBatchSize {
+			return collection, nil
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeexport"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// ExportRecipe renders a recipe the caller can read in the export format.
+func (d *Domain) ExportRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, format recipeexport.Format) (file.File, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	recipe, err := d.GetRecipe(ctx, authAccount, parent, id, nil)
+	if err != nil {
+		return file.File{}, err
+	}
+
+	content, err := d.renderRecipeExport(ctx, recipe, format)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to render recipe export")
+		return file.File{}, err
+	}
+
+	return file.File{
+		ContentType:    format.ContentType(),
+		Extension:      format.Extension(),
+		ReadSeekCloser: file.NewReadSeekCloser(content),
+		ContentLength:  int64(len(content)),
+	}, nil
+}
+
+// ExportRecipes renders every recipe the caller owns in the export format and
+// bundles them into a zip archive.
+func (d *Domain) ExportRecipes(ctx context.Context, authAccount model.AuthAccount, format recipeexport.Format) (file.File, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return file.File{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	owned, err := d.listOwnedRecipes(ctx, authAccount, []string{model.RecipeField_Id})
+	if err != nil {
+		return file.File{}, err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, listed := range owned {
+		recipe, err := d.GetRecipe(ctx, authAccount, listed.Parent, listed.Id, nil)
+		if err != nil {
+			return file.File{}, err
+		}
+
+		content, err := d.renderRecipeExport(ctx, recipe, format)
+		if err != nil {
+			log.Error().Err(err).Int64("recipeId", recipe.Id.RecipeId).Msg("unable to render recipe export")
+			return file.File{}, err
+		}
+
+		w, err := archive.Create(recipeexport.FileName(recipe, format))
+		if err != nil {
+			log.Error().Err(err).Msg("unable to add recipe to export archive")
+			return file.File{}, domain.ErrInternal{Msg: "unable to create export archive"}
+		}
+		if _, err = w.Write(content); err != nil {
+			log.Error().Err(err).Msg("unable to write recipe to export archive")
+			return file.File{}, domain.ErrInternal{Msg: "unable to create export archive"}
+		}
+	}
+
+	if err = archive.Close(); err != nil {
+		log.Error().Err(err).Msg("unable to close export archive")
+		return file.File{}, domain.ErrInternal{Msg: "unable to create export archive"}
+	}
+
+	return file.File{
+		ContentType:    "application/zip",
+		Extension:      "zip",
+		ReadSeekCloser: file.NewReadSeekCloser(buf.Bytes()),
+		ContentLength:  int64(buf.Len()),
+	}, nil
+}
+
+// renderRecipeExport renders the recipe in the export format, with its gallery
+// and step images. The HTML format inlines the recipe image; when it cannot be
+// retrieved the page is rendered without it.
+func (d *Domain) renderRecipeExport(ctx context.Context, recipe model.Recipe, format recipeexport.Format) ([]byte, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	images, err := d.listRecipeImages(ctx, recipe.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("listRecipeImages failed")
+		return nil, err
+	}
+	recipe.Images = images
+
+	var image *recipeexport.Image
+	if format == recipeexport.FormatHTML && recipe.ImageURI != "" {
+		image = d.exportRecipeImage(ctx, recipe.ImageURI)
+	}
+
+	content, err := recipeexport.Render(recipe, format, image)
+	if err != nil {
+		return nil, domain.ErrInternal{Msg: "unable to render recipe export"}
+	}
+	return content, nil
+}
+
+func (d *Domain) exportRecipeImage(ctx context.Context, imageURI string) *recipeexport.Image {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	contents, err := d.fileRetriever.GetFileContents(ctx, imageURI)
+	if err != nil {
+		log.Warn().Err(err).Msg("fileRetriever.GetFileContents failed, exporting without image")
+		return nil
+	}
+	defer contents.Close()
+
+	data, err := io.ReadAll(contents)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to read recipe image, exporting without image")
+		return nil
+	}
+
+	return &recipeexport.Image{
+		ContentType: http.DetectContentType(data),
+		Data:        data,
+	}
+}
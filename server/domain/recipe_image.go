@@ -0,0 +1,425 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// CreateRecipeImage resizes and stores an image and adds it to the gallery of
+// the recipe, or to one of its steps when step is set. The first gallery image
+// of a recipe without a cover becomes its cover.
+func (d *Domain) CreateRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, step *model.RecipeDirectionStep, imageReader io.Reader) (model.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.RecipeImage{}, err
+	}
+
+	if err := validateRecipeImageStep(recipe, step); err != nil {
+		log.Warn().Err(err).Msg("invalid recipe image step")
+		return model.RecipeImage{}, err
+	}
+
+	images, err := d.repo.ListRecipeImages(ctx, parent, 0, 0, []string{model.RecipeImageField_Position})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeImages failed")
+		return model.RecipeImage{}, err
+	}
+	position := int32(0)
+	for _, image := range images {
+		position = max(position, image.Position+1)
+	}
+
+	imageURI, imageSet, err := d.uploadRecipeImage(ctx, parent.RecipeId, imageReader)
+	if err != nil {
+		log.Error().Err(err).Msg("uploadRecipeImage failed")
+		return model.RecipeImage{}, err
+	}
+
+	image, err := d.repo.CreateRecipeImage(ctx, model.RecipeImage{
+		Parent:   parent,
+		ImageURI: imageURI,
+		ImageSet: imageSet,
+		Position: position,
+		Step:     step,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.CreateRecipeImage failed")
+		go d.deleteImageFiles(context.Background(), imageURI, imageSet)
+		return model.RecipeImage{}, err
+	}
+
+	if recipe.ImageURI == "" && step == nil {
+		err = d.setRecipeCover(ctx, authAccount, parent.RecipeId, image.ImageURI, image.ImageSet)
+		if err != nil {
+			log.Error().Err(err).Msg("setRecipeCover failed")
+			return model.RecipeImage{}, err
+		}
+		recipe.ImageURI = image.ImageURI
+	}
+
+	image.Cover = image.ImageURI == recipe.ImageURI
+	return image, nil
+}
+
+// GetRecipeImage gets a recipe image.
+func (d *Domain) GetRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, id model.RecipeImageId, fields []string) (model.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeImageId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeImage{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_READ)
+	if err != nil {
+		return model.RecipeImage{}, err
+	}
+
+	image, err := d.repo.GetRecipeImage(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeImage failed")
+		return model.RecipeImage{}, err
+	}
+
+	image.Cover = image.ImageURI != "" && image.ImageURI == recipe.ImageURI
+	return image, nil
+}
+
+// ListRecipeImages lists the images of a recipe in their display order.
+func (d *Domain) ListRecipeImages(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := d.repo.ListRecipeImages(ctx, parent, pageSize, offset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeImages failed")
+		return nil, err
+	}
+
+	for i := range images {
+		images[i].Cover = images[i].ImageURI != "" && images[i].ImageURI == recipe.ImageURI
+	}
+	return images, nil
+}
+
+// UpdateRecipeImage updates the step a recipe image is attached to. Clearing
+// the step moves the image back to the gallery.
+func (d *Domain) UpdateRecipeImage(ctx context.Context, authAccount model.AuthAccount, image model.RecipeImage, fields []string) (model.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if image.Id.RecipeImageId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeImage{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, image.Parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.RecipeImage{}, err
+	}
+
+	if slices.Contains(fields, model.RecipeImageField_Step) {
+		if err := validateRecipeImageStep(recipe, image.Step); err != nil {
+			log.Warn().Err(err).Msg("invalid recipe image step")
+			return model.RecipeImage{}, err
+		}
+	}
+
+	image, err = d.repo.UpdateRecipeImage(ctx, image, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateRecipeImage failed")
+		return model.RecipeImage{}, err
+	}
+
+	image.Cover = image.ImageURI != "" && image.ImageURI == recipe.ImageURI
+	return image, nil
+}
+
+// DeleteRecipeImage deletes a recipe image and its stored file. When the
+// cover is deleted the next gallery image becomes the cover.
+func (d *Domain) DeleteRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, id model.RecipeImageId) (model.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeImageId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeImage{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.RecipeImage{}, err
+	}
+
+	image, err := d.repo.DeleteRecipeImage(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.DeleteRecipeImage failed")
+		return model.RecipeImage{}, err
+	}
+
+	if image.ImageURI == recipe.ImageURI {
+		image.Cover = true
+
+		images, err := d.repo.ListRecipeImages(ctx, parent, 0, 0, nil)
+		if err != nil {
+			log.Error().Err(err).Msg("repo.ListRecipeImages failed")
+			return model.RecipeImage{}, err
+		}
+		cover := model.RecipeImage{}
+		for _, next := range images {
+			if next.Step == nil {
+				cover = next
+				break
+			}
+		}
+		err = d.setRecipeCover(ctx, authAccount, parent.RecipeId, cover.ImageURI, cover.ImageSet)
+		if err != nil {
+			log.Error().Err(err).Msg("setRecipeCover failed")
+			return model.RecipeImage{}, err
+		}
+	}
+
+	go d.deleteImageFiles(context.Background(), image.ImageURI, image.ImageSet)
+
+	return image, nil
+}
+
+// ReorderRecipeImages sets the display order of the images of a recipe. Every
+// image of the recipe must be listed once.
+func (d *Domain) ReorderRecipeImages(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, ids []model.RecipeImageId) ([]model.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	images, err := tx.ListRecipeImages(ctx, parent, 0, 0, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.ListRecipeImages failed")
+		return nil, err
+	}
+
+	byId := make(map[int64]model.RecipeImage, len(images))
+	for _, image := range images {
+		byId[image.Id.RecipeImageId] = image
+	}
+	if len(ids) != len(images) {
+		log.Warn().Int("images", len(images)).Int("ids", len(ids)).Msg("reorder does not list every image")
+		return nil, domain.ErrInvalidArgument{Msg: "every image of the recipe must be listed once"}
+	}
+
+	reordered := make([]model.RecipeImage, 0, len(ids))
+	for position, id := range ids {
+		image, ok := byId[id.RecipeImageId]
+		if !ok {
+			log.Warn().Int64("recipeImageId", id.RecipeImageId).Msg("reorder lists an unknown or repeated image")
+			return nil, domain.ErrInvalidArgument{Msg: "every image of the recipe must be listed once"}
+		}
+		delete(byId, id.RecipeImageId)
+
+		if image.Position != int32(position) {
+			image.Position = int32(position)
+			image, err = tx.UpdateRecipeImage(ctx, image, []string{model.RecipeImageField_Position})
+			if err != nil {
+				log.Error().Err(err).Msg("tx.UpdateRecipeImage failed")
+				return nil, err
+			}
+		}
+		image.Cover = image.ImageURI == recipe.ImageURI
+		reordered = append(reordered, image)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return nil, err
+	}
+
+	return reordered, nil
+}
+
+// SetRecipeCoverImage makes an image the cover image of its recipe.
+func (d *Domain) SetRecipeCoverImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, id model.RecipeImageId) (model.RecipeImage, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeImageId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeImage{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.RecipeImage{}, err
+	}
+
+	image, err := d.repo.GetRecipeImage(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeImage failed")
+		return model.RecipeImage{}, err
+	}
+
+	if image.ImageURI != recipe.ImageURI {
+		previousCoverURI := recipe.ImageURI
+		err = d.setRecipeCover(ctx, authAccount, parent.RecipeId, image.ImageURI, image.ImageSet)
+		if err != nil {
+			log.Error().Err(err).Msg("setRecipeCover failed")
+			return model.RecipeImage{}, err
+		}
+
+		// a cover uploaded before the recipe had a gallery is not kept
+		isGalleryImage, err := d.isRecipeImage(ctx, parent.RecipeId, previousCoverURI)
+		if err != nil {
+			log.Error().Err(err).Msg("isRecipeImage failed")
+			return model.RecipeImage{}, err
+		}
+		if !isGalleryImage {
+			go d.deleteImageFiles(context.Background(), previousCoverURI, recipe.ImageSet)
+		}
+	}
+
+	image.Cover = true
+	return image, nil
+}
+
+// checkRecipeImageAccess checks that the caller has the permission level on
+// the recipe the images belong to, and returns the recipe with its cover
+// image, its variants and the directions.
+func (d *Domain) checkRecipeImageAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, permissionLevel types.PermissionLevel) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("recipe id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "recipe id required"}
+	}
+
+	recipe, err := d.repo.GetRecipe(ctx, authAccount, id, []string{
+		model.RecipeField_ImageURI,
+		model.RecipeField_ImageSet,
+		model.RecipeField_VisibilityLevel,
+		model.RecipeField_Directions,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return model.Recipe{}, err
+	}
+
+	_, err = d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(recipe.VisibilityLevel),
+		withMinimumPermissionLevel(permissionLevel),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine recipe access")
+		return model.Recipe{}, err
+	}
+
+	return recipe, nil
+}
+
+// setRecipeCover sets the cover image of a recipe and its variants.
+func (d *Domain) setRecipeCover(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, imageURI string, imageSet model.ImageSet) error {
+	_, err := d.repo.UpdateRecipe(ctx, authAccount, model.Recipe{
+		Id:       id,
+		ImageURI: imageURI,
+		ImageSet: imageSet,
+	}, []string{model.RecipeField_ImageURI, model.RecipeField_ImageSet})
+	return err
+}
+
+// isRecipeImage reports whether the URI is the stored file of one of the
+// images of the recipe. Such files are owned by the image and must not be
+// deleted when the cover changes.
+func (d *Domain) isRecipeImage(ctx context.Context, id model.RecipeId, imageURI string) (bool, error) {
+	if imageURI == "" {
+		return false, nil
+	}
+
+	images, err := d.repo.ListRecipeImages(ctx, model.RecipeImageParent{RecipeId: id}, 0, 0, []string{model.RecipeImageField_ImageURI})
+	if err != nil {
+		return false, err
+	}
+
+	for _, image := range images {
+		if image.ImageURI == imageURI {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// listRecipeImages lists every image of a recipe, without access checks.
+func (d *Domain) listRecipeImages(ctx context.Context, id model.RecipeId) ([]model.RecipeImage, error) {
+	return d.repo.ListRecipeImages(ctx, model.RecipeImageParent{RecipeId: id}, 0, 0, nil)
+}
+
+// copyRecipeStepImages adds copies of the step images of another recipe to a
+// recipe. The files are copied too so the copies outlive the images they were
+// made from. Gallery images are skipped.
+func (d *Domain) copyRecipeStepImages(ctx context.Context, tx repository.TxClient, id model.RecipeId, images []model.RecipeImage) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	for _, image := range images {
+		if image.Step == nil {
+			continue
+		}
+
+		fileContents, err := d.fileRetriever.GetFileContents(ctx, image.ImageURI)
+		if err != nil {
+			log.Error().Err(err).Msg("fileRetriever.GetFileContents failed")
+			return err
+		}
+		imageURI, imageSet, err := d.uploadRecipeImage(ctx, id, fileContents)
+		fileContents.Close()
+		if err != nil {
+			log.Error().Err(err).Msg("uploadRecipeImage failed")
+			return err
+		}
+
+		_, err = tx.CreateRecipeImage(ctx, model.RecipeImage{
+			Parent:   model.RecipeImageParent{RecipeId: id},
+			ImageURI: imageURI,
+			ImageSet: imageSet,
+			Position: image.Position,
+			Step:     image.Step,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("tx.CreateRecipeImage failed")
+			go d.deleteImageFiles(context.Background(), imageURI, imageSet)
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRecipeImageStep checks that the step exists in the recipe
+// directions.
+func validateRecipeImageStep(recipe model.Recipe, step *model.RecipeDirectionStep) error {
+	if step == nil {
+		return nil
+	}
+	if step.DirectionIndex < 0 || int(step.DirectionIndex) >= len(recipe.Directions) {
+		return domain.ErrInvalidArgument{Msg: "step direction index out of range"}
+	}
+	if step.StepIndex < 0 || int(step.StepIndex) >= len(recipe.Directions[step.DirectionIndex].Steps) {
+		return domain.ErrInvalidArgument{Msg: "step index out of range"}
+	}
+	return nil
+}
@@ -0,0 +1,363 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// defaultRecipeImageCandidates is the number of images generated when the
+	// caller doesn't ask for a number.
+	defaultRecipeImageCandidates = 3
+	// maxRecipeImageCandidates is the most images generated at once.
+	maxRecipeImageCandidates = 4
+	// defaultImageGenerationDailyLimit is the number of images a user can have
+	// generated in a day unless configured otherwise.
+	defaultImageGenerationDailyLimit = 20
+	// imageGenerationQuotaWindow is the window the generation quota applies
+	// to.
+	imageGenerationQuotaWindow = 24 * time.Hour
+	// recipeImageCandidateRetention is how long candidates that are never
+	// selected are kept.
+	recipeImageCandidateRetention = 7 * 24 * time.Hour
+)
+
+// GetRecipeImageCandidate gets a generated image of a recipe the caller can
+// write to.
+func (d *Domain) GetRecipeImageCandidate(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId, fields []string) (model.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeImageCandidateId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeImageCandidate{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.RecipeImageCandidate{}, err
+	}
+
+	candidate, err := d.repo.GetRecipeImageCandidate(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeImageCandidate failed")
+		return model.RecipeImageCandidate{}, err
+	}
+
+	return candidate, nil
+}
+
+// ListRecipeImageCandidates lists the generated images of a recipe the caller
+// can write to, newest first.
+func (d *Domain) ListRecipeImageCandidates(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	_, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := d.repo.ListRecipeImageCandidates(ctx, parent, pageSize, offset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeImageCandidates failed")
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// DeleteRecipeImageCandidate discards a generated image of a recipe and
+// deletes its file.
+func (d *Domain) DeleteRecipeImageCandidate(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId) (model.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeImageCandidateId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeImageCandidate{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.RecipeImageCandidate{}, err
+	}
+
+	candidate, err := d.repo.DeleteRecipeImageCandidate(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.DeleteRecipeImageCandidate failed")
+		return model.RecipeImageCandidate{}, err
+	}
+
+	go d.deleteRecipeImageCandidateFiles(context.Background(), []model.RecipeImageCandidate{candidate})
+
+	return candidate, nil
+}
+
+// SelectRecipeImageCandidate makes a generated image the cover of its recipe.
+// The image goes through the same resizing as an uploaded one, and every
+// candidate of the recipe is discarded afterwards. The recipe is returned with
+// its new cover image.
+func (d *Domain) SelectRecipeImageCandidate(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeImageCandidateId == 0 {
+		log.Warn().Msg("id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipe, err := d.checkRecipeImageAccess(ctx, authAccount, parent.RecipeId, types.PermissionLevel_PERMISSION_LEVEL_WRITE)
+	if err != nil {
+		return model.Recipe{}, err
+	}
+
+	candidate, err := d.repo.GetRecipeImageCandidate(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeImageCandidate failed")
+		return model.Recipe{}, err
+	}
+
+	contents, err := d.fileRetriever.GetFileContents(ctx, candidate.ImageURI)
+	if err != nil {
+		log.Error().Err(err).Msg("fileRetriever.GetFileContents failed")
+		return model.Recipe{}, err
+	}
+	defer contents.Close()
+
+	imageURI, imageSet, err := d.uploadRecipeImage(ctx, parent.RecipeId, contents)
+	if err != nil {
+		log.Error().Err(err).Msg("uploadRecipeImage failed")
+		return model.Recipe{}, err
+	}
+
+	err = d.setRecipeCover(ctx, authAccount, parent.RecipeId, imageURI, imageSet)
+	if err != nil {
+		log.Error().Err(err).Msg("setRecipeCover failed")
+		go d.deleteImageFiles(context.Background(), imageURI, imageSet)
+		return model.Recipe{}, err
+	}
+
+	// the previous cover may be one of the recipe images, which keep their file
+	isRecipeImage, err := d.isRecipeImage(ctx, parent.RecipeId, recipe.ImageURI)
+	if err != nil {
+		log.Error().Err(err).Msg("isRecipeImage failed")
+		return model.Recipe{}, err
+	}
+	if !isRecipeImage {
+		go d.deleteImageFiles(context.Background(), recipe.ImageURI, recipe.ImageSet)
+	}
+
+	candidates, err := d.repo.BulkDeleteRecipeImageCandidates(ctx, parent)
+	if err != nil {
+		log.Warn().Err(err).Msg("repo.BulkDeleteRecipeImageCandidates failed")
+	}
+	go d.deleteRecipeImageCandidateFiles(context.Background(), candidates)
+
+	recipe.ImageURI = imageURI
+	recipe.ImageSet = imageSet
+	return recipe, nil
+}
+
+// checkImageGenerationQuota makes sure the user can have count more images
+// generated today. Nothing is charged, the images are charged one at a time
+// as they are generated.
+func (d *Domain) checkImageGenerationQuota(ctx context.Context, authAccount model.AuthAccount, count int32) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	used, err := d.repo.CountRecipeImageGenerations(ctx, model.UserId{UserId: authAccount.AuthUserId}, time.Now().Add(-imageGenerationQuotaWindow))
+	if err != nil {
+		log.Error().Err(err).Msg("repo.CountRecipeImageGenerations failed")
+		return err
+	}
+
+	return d.imageGenerationQuotaError(ctx, used, count)
+}
+
+// chargeImageGeneration records an image about to be generated against the
+// daily quota of the user. The generations of the user are locked while the
+// quota is checked, so concurrent generations can't go over it.
+func (d *Domain) chargeImageGeneration(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeImageGeneration, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	userId := model.UserId{UserId: authAccount.AuthUserId}
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.Begin failed")
+		return model.RecipeImageGeneration{}, err
+	}
+	defer tx.Rollback()
+
+	err = tx.LockRecipeImageGenerations(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.LockRecipeImageGenerations failed")
+		return model.RecipeImageGeneration{}, err
+	}
+
+	used, err := tx.CountRecipeImageGenerations(ctx, userId, time.Now().Add(-imageGenerationQuotaWindow))
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CountRecipeImageGenerations failed")
+		return model.RecipeImageGeneration{}, err
+	}
+	err = d.imageGenerationQuotaError(ctx, used, 1)
+	if err != nil {
+		return model.RecipeImageGeneration{}, err
+	}
+
+	generation, err := tx.CreateRecipeImageGeneration(ctx, model.RecipeImageGeneration{
+		UserId:   userId,
+		RecipeId: id,
+		Count:    1,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CreateRecipeImageGeneration failed")
+		return model.RecipeImageGeneration{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("tx.Commit failed")
+		return model.RecipeImageGeneration{}, err
+	}
+
+	return generation, nil
+}
+
+// imageGenerationQuotaError returns an error when count more images would take
+// the used images over the daily limit.
+func (d *Domain) imageGenerationQuotaError(ctx context.Context, used int64, count int32) error {
+	if used+int64(count) <= int64(d.imageGenerationDailyLimit) {
+		return nil
+	}
+
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	log.Warn().Int64("used", used).Int32("count", count).Int("limit", d.imageGenerationDailyLimit).Msg("image generation quota exceeded")
+	return domain.ErrResourceExhausted{Msg: fmt.Sprintf("daily image generation quota exceeded: %d of %d images used", used, d.imageGenerationDailyLimit)}
+}
+
+// refundImageGenerations gives back the quota charged for images nobody will
+// see.
+func (d *Domain) refundImageGenerations(ctx context.Context, generations []model.RecipeImageGeneration) {
+	for _, generation := range generations {
+		err := d.repo.DeleteRecipeImageGeneration(ctx, generation.Id)
+		if err != nil {
+			d.log.Warn().Err(err).Int64("recipeImageGenerationId", generation.Id.RecipeImageGenerationId).Msg("unable to refund recipe image generation")
+		}
+	}
+}
+
+// generateRecipeImageCandidates generates images for a recipe and stores them
+// as candidates, charging each one against the quota of the user. The images
+// generated before a failure are kept unless the operation was stopped, and
+// the charges of the images that are not kept are refunded.
+func (d *Domain) generateRecipeImageCandidates(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, count int32) ([]model.RecipeImageCandidate, []model.RecipeImageGeneration, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	dbRecipe, err := d.getRecipeForImageGeneration(ctx, authAccount, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidates := []model.RecipeImageCandidate{}
+	generations := []model.RecipeImageGeneration{}
+	for range max(count, 1) {
+		generation, err := d.chargeImageGeneration(ctx, authAccount, id)
+		var candidate model.RecipeImageCandidate
+		if err == nil {
+			candidate, err = d.generateRecipeImageCandidate(ctx, authAccount, dbRecipe)
+			if err != nil {
+				go d.refundImageGenerations(context.Background(), []model.RecipeImageGeneration{generation})
+			}
+		}
+		if err != nil {
+			if len(candidates) > 0 && ctx.Err() == nil {
+				log.Warn().Err(err).Int("generated", len(candidates)).Msg("unable to generate every image candidate")
+				break
+			}
+			go d.discardRecipeImageCandidates(context.Background(), candidates, generations)
+			return nil, nil, err
+		}
+		candidates = append(candidates, candidate)
+		generations = append(generations, generation)
+	}
+
+	return candidates, generations, nil
+}
+
+// generateRecipeImageCandidate generates an image for a recipe and stores it
+// as a candidate.
+func (d *Domain) generateRecipeImageCandidate(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe) (model.RecipeImageCandidate, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	f, err := d.imageGenerator.GenerateRecipeImage(ctx, recipe)
+	if err != nil {
+		log.Error().Err(err).Msg("imageGenerator.GenerateRecipeImage failed")
+		return model.RecipeImageCandidate{}, err
+	}
+	defer f.Close()
+
+	name := path.Join(RecipeImageRoot, strconv.FormatInt(recipe.Id.RecipeId, 10), "candidates", uuid.NewV4().String()+f.Extension)
+	uri, err := d.fileStore.UploadPublicFile(ctx, name, f)
+	if err != nil {
+		log.Error().Err(err).Msg("fileStore.UploadPublicFile failed")
+		return model.RecipeImageCandidate{}, err
+	}
+
+	candidate, err := d.repo.CreateRecipeImageCandidate(ctx, model.RecipeImageCandidate{
+		Parent:    model.RecipeImageCandidateParent{RecipeId: recipe.Id},
+		CreatorId: model.UserId{UserId: authAccount.AuthUserId},
+		ImageURI:  uri,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.CreateRecipeImageCandidate failed")
+		go d.deleteRecipeImageCandidateFiles(context.Background(), []model.RecipeImageCandidate{{ImageURI: uri}})
+		return model.RecipeImageCandidate{}, err
+	}
+
+	return candidate, nil
+}
+
+// discardRecipeImageCandidates deletes candidates nobody will see, like the
+// ones generated by a cancelled operation, and refunds their generations.
+func (d *Domain) discardRecipeImageCandidates(ctx context.Context, candidates []model.RecipeImageCandidate, generations []model.RecipeImageGeneration) {
+	for _, candidate := range candidates {
+		_, err := d.repo.DeleteRecipeImageCandidate(ctx, candidate.Parent, candidate.Id)
+		if err != nil {
+			d.log.Warn().Err(err).Int64("recipeImageCandidateId", candidate.Id.RecipeImageCandidateId).Msg("unable to discard recipe image candidate")
+		}
+	}
+	d.deleteRecipeImageCandidateFiles(ctx, candidates)
+	d.refundImageGenerations(ctx, generations)
+}
+
+// cleanupRecipeImageCandidates deletes the candidates that were never
+// selected and the generations that no longer count against the quota.
+func (d *Domain) cleanupRecipeImageCandidates(ctx context.Context) {
+	now := time.Now()
+
+	candidates, err := d.repo.DeleteExpiredRecipeImageCandidates(ctx, now.Add(-recipeImageCandidateRetention))
+	if err != nil && ctx.Err() == nil {
+		d.log.Error().Err(err).Msg("repo.DeleteExpiredRecipeImageCandidates failed")
+	}
+	d.deleteRecipeImageCandidateFiles(ctx, candidates)
+	if len(candidates) > 0 {
+		d.log.Info().Int("candidates", len(candidates)).Msg("deleted expired recipe image candidates")
+	}
+
+	err = d.repo.DeleteRecipeImageGenerations(ctx, now.Add(-imageGenerationQuotaWindow))
+	if err != nil && ctx.Err() == nil {
+		d.log.Error().Err(err).Msg("repo.DeleteRecipeImageGenerations failed")
+	}
+}
+
+// deleteRecipeImageCandidateFiles deletes the stored images of candidates.
+func (d *Domain) deleteRecipeImageCandidateFiles(ctx context.Context, candidates []model.RecipeImageCandidate) {
+	for _, candidate := range candidates {
+		err := d.fileStore.DeleteFile(ctx, candidate.ImageURI)
+		if err != nil {
+			d.log.Warn().Err(err).Str("uri", candidate.ImageURI).Msg("unable to delete recipe image candidate file")
+		}
+	}
+}
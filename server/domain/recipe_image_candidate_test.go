@@ -0,0 +1,392 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// imageGenerationRepo holds the quota charges and candidates of a recipe the
+// caller can write to. Locking the generations holds a mutex until the
+// transaction ends, like the advisory lock does.
+type imageGenerationRepo struct {
+	repository.Client
+
+	lock        sync.Mutex
+	mu          sync.Mutex
+	generations map[int64]model.RecipeImageGeneration
+	candidates  map[int64]model.RecipeImageCandidate
+	nextId      int64
+}
+
+func newImageGenerationRepo(used int) *imageGenerationRepo {
+	r := &imageGenerationRepo{
+		generations: map[int64]model.RecipeImageGeneration{},
+		candidates:  map[int64]model.RecipeImageCandidate{},
+	}
+	for range used {
+		r.nextId++
+		r.generations[r.nextId] = model.RecipeImageGeneration{
+			Id:         model.RecipeImageGenerationId{RecipeImageGenerationId: r.nextId},
+			UserId:     model.UserId{UserId: imageCaller.AuthUserId},
+			Count:      1,
+			CreateTime: time.Now(),
+		}
+	}
+	return r
+}
+
+// charged returns the images charged to the caller.
+func (r *imageGenerationRepo) charged() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.generations)
+}
+
+func (r *imageGenerationRepo) candidateCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.candidates)
+}
+
+func (r *imageGenerationRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return &imageGenerationTx{imageGenerationRepo: r}, nil
+}
+
+func (r *imageGenerationRepo) GetRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, fields []string) (model.Recipe, error) {
+	return model.Recipe{Id: id, VisibilityLevel: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED}, nil
+}
+
+func (r *imageGenerationRepo) FindStandardUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error) {
+	return acceptedAdmin, nil
+}
+
+func (r *imageGenerationRepo) FindDelegatedCircleRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.CircleAccess, error) {
+	return model.RecipeAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *imageGenerationRepo) FindDelegatedUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.UserAccess, error) {
+	return model.RecipeAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *imageGenerationRepo) CountRecipeImageGenerations(ctx context.Context, userId model.UserId, since time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var used int64
+	for _, generation := range r.generations {
+		if generation.UserId == userId && !generation.CreateTime.Before(since) {
+			used += int64(generation.Count)
+		}
+	}
+	return used, nil
+}
+
+func (r *imageGenerationRepo) CreateRecipeImageGeneration(ctx context.Context, generation model.RecipeImageGeneration) (model.RecipeImageGeneration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextId++
+	generation.Id.RecipeImageGenerationId = r.nextId
+	generation.CreateTime = time.Now()
+	r.generations[r.nextId] = generation
+	return generation, nil
+}
+
+func (r *imageGenerationRepo) DeleteRecipeImageGeneration(ctx context.Context, id model.RecipeImageGenerationId) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.generations, id.RecipeImageGenerationId)
+	return nil
+}
+
+func (r *imageGenerationRepo) CreateRecipeImageCandidate(ctx context.Context, candidate model.RecipeImageCandidate) (model.RecipeImageCandidate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextId++
+	candidate.Id.RecipeImageCandidateId = r.nextId
+	r.candidates[r.nextId] = candidate
+	return candidate, nil
+}
+
+func (r *imageGenerationRepo) DeleteRecipeImageCandidate(ctx context.Context, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId) (model.RecipeImageCandidate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	candidate, ok := r.candidates[id.RecipeImageCandidateId]
+	if !ok {
+		return model.RecipeImageCandidate{}, repository.ErrNotFound{}
+	}
+	delete(r.candidates, id.RecipeImageCandidateId)
+	return candidate, nil
+}
+
+// imageGenerationTx releases the lock on the generations when it ends.
+type imageGenerationTx struct {
+	*imageGenerationRepo
+	locked bool
+}
+
+func (tx *imageGenerationTx) LockRecipeImageGenerations(ctx context.Context, userId model.UserId) error {
+	tx.lock.Lock()
+	tx.locked = true
+	return nil
+}
+
+func (tx *imageGenerationTx) Commit() error {
+	tx.Rollback()
+	return nil
+}
+
+func (tx *imageGenerationTx) Rollback() {
+	if tx.locked {
+		tx.locked = false
+		tx.lock.Unlock()
+	}
+}
+
+// recipeImageGenerator generates images, failing from the call numbered
+// failFrom on. The context is cancelled first on the call numbered
+// cancelAt.
+type recipeImageGenerator struct {
+	mu       sync.Mutex
+	calls    int
+	failFrom int
+	cancelAt int
+	cancel   context.CancelFunc
+}
+
+func (g *recipeImageGenerator) GenerateRecipeImage(ctx context.Context, recipe model.Recipe) (file.File, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.calls++
+	if g.calls == g.cancelAt {
+		g.cancel()
+		return file.File{}, ctx.Err()
+	}
+	if g.failFrom > 0 && g.calls >= g.failFrom {
+		return file.File{}, errors.New("generation failed")
+	}
+	return file.File{
+		ContentType:    "image/png",
+		Extension:      ".png",
+		ReadSeekCloser: file.NewReadSeekCloser([]byte("image")),
+	}, nil
+}
+
+var (
+	imageCaller = model.AuthAccount{AuthUserId: 7, UserId: 7}
+	imageRecipe = model.RecipeId{RecipeId: 3}
+)
+
+func newImageGenerationDomain(repo *imageGenerationRepo, generator *recipeImageGenerator, limit int) *Domain {
+	d := newTestDomain(repo)
+	d.fileStore = &fakeFileStore{}
+	d.imageGenerator = generator
+	d.imageGenerationDailyLimit = limit
+	return d
+}
+
+func TestChargeImageGeneration_Concurrent(t *testing.T) {
+	repo := newImageGenerationRepo(0)
+	d := newImageGenerationDomain(repo, &recipeImageGenerator{}, 3)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := d.chargeImageGeneration(context.Background(), imageCaller, imageRecipe)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	charged := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			charged++
+		case !errors.As(err, &domain.ErrResourceExhausted{}):
+			t.Errorf("chargeImageGeneration() error = %v, want ErrResourceExhausted", err)
+		}
+	}
+	if charged != 3 {
+		t.Errorf("chargeImageGeneration() succeeded %d times, want 3", charged)
+	}
+	if got := repo.charged(); got != 3 {
+		t.Errorf("charged %d images, want 3", got)
+	}
+}
+
+func TestGenerateRecipeImageCandidates_ChargesGenerated(t *testing.T) {
+	tests := []struct {
+		name           string
+		used           int
+		limit          int
+		failFrom       int
+		wantCandidates int
+		wantErr        func(error) bool
+	}{
+		{name: "all generated", limit: 20, wantCandidates: 3},
+		{name: "later image fails", limit: 20, failFrom: 3, wantCandidates: 2},
+		{name: "first image fails", limit: 20, failFrom: 1, wantErr: func(err error) bool { return err != nil }},
+		{name: "quota runs out", used: 18, limit: 20, wantCandidates: 2},
+		{name: "quota used up", used: 20, limit: 20, wantErr: func(err error) bool { return errors.As(err, &domain.ErrResourceExhausted{}) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newImageGenerationRepo(tt.used)
+			d := newImageGenerationDomain(repo, &recipeImageGenerator{failFrom: tt.failFrom}, tt.limit)
+
+			candidates, generations, err := d.generateRecipeImageCandidates(context.Background(), imageCaller, imageRecipe, 3)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("generateRecipeImageCandidates() error = %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("generateRecipeImageCandidates() error = %v", err)
+			}
+
+			if len(candidates) != tt.wantCandidates || len(generations) != tt.wantCandidates {
+				t.Errorf("generateRecipeImageCandidates() = %d candidates, %d generations, want %d", len(candidates), len(generations), tt.wantCandidates)
+			}
+			waitFor(t, "refunds", func() bool { return repo.charged() == tt.used+tt.wantCandidates })
+		})
+	}
+}
+
+func TestGenerateRecipeImageCandidates_CancelledRefunds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := newImageGenerationRepo(0)
+	d := newImageGenerationDomain(repo, &recipeImageGenerator{cancelAt: 2, cancel: cancel}, 20)
+
+	_, _, err := d.generateRecipeImageCandidates(ctx, imageCaller, imageRecipe, 3)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("generateRecipeImageCandidates() error = %v, want %v", err, context.Canceled)
+	}
+
+	waitFor(t, "candidates to be discarded", func() bool { return repo.candidateCount() == 0 })
+	waitFor(t, "refunds", func() bool { return repo.charged() == 0 })
+}
+
+func TestDiscardOperationResult_Refunds(t *testing.T) {
+	repo := newImageGenerationRepo(0)
+	d := newImageGenerationDomain(repo, &recipeImageGenerator{}, 20)
+
+	candidates, generations, err := d.generateRecipeImageCandidates(context.Background(), imageCaller, imageRecipe, 2)
+	if err != nil {
+		t.Fatalf("generateRecipeImageCandidates() error = %v", err)
+	}
+
+	d.discardOperationResult(context.Background(), model.OperationResult{Candidates: candidates, Generations: generations})
+
+	if got := repo.candidateCount(); got != 0 {
+		t.Errorf("kept %d candidates, want 0", got)
+	}
+	if got := repo.charged(); got != 0 {
+		t.Errorf("charged %d images, want 0", got)
+	}
+}
+
+func TestStartGenerateRecipeImage_Quota(t *testing.T) {
+	tests := []struct {
+		name    string
+		used    int
+		wantErr bool
+	}{
+		{name: "within quota", used: 17},
+		{name: "over quota", used: 18, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &startGenerationRepo{imageGenerationRepo: newImageGenerationRepo(tt.used)}
+			d := newImageGenerationDomain(repo.imageGenerationRepo, &recipeImageGenerator{}, 20)
+			d.repo = repo
+
+			_, err := d.StartGenerateRecipeImage(context.Background(), imageCaller, model.RecipeParent{}, imageRecipe, 3)
+			if tt.wantErr {
+				if !errors.As(err, &domain.ErrResourceExhausted{}) {
+					t.Fatalf("StartGenerateRecipeImage() error = %v, want ErrResourceExhausted", err)
+				}
+			} else if err != nil {
+				t.Fatalf("StartGenerateRecipeImage() error = %v", err)
+			}
+
+			// images are only charged once they are generated
+			if got := repo.charged(); got != tt.used {
+				t.Errorf("charged %d images, want %d", got, tt.used)
+			}
+		})
+	}
+}
+
+// startGenerationRepo queues operations without running them.
+type startGenerationRepo struct {
+	*imageGenerationRepo
+}
+
+func (r *startGenerationRepo) CreateOperation(ctx context.Context, operation model.Operation) (model.Operation, error) {
+	operation.Id = model.OperationId{OperationId: 1}
+	return operation, nil
+}
+
+// generateImageRepo runs the image generation operations of an
+// imageGenerationRepo.
+type generateImageRepo struct {
+	*imageGenerationRepo
+	operations *operationRepo
+}
+
+func (r *generateImageRepo) CreateOperation(ctx context.Context, operation model.Operation) (model.Operation, error) {
+	operation.Id = operationId
+	r.operations.set(operation)
+	return operation, nil
+}
+
+func (r *generateImageRepo) GetOperation(ctx context.Context, parent model.OperationParent, id model.OperationId, fields []string) (model.Operation, error) {
+	return r.operations.GetOperation(ctx, parent, id, fields)
+}
+
+func (r *generateImageRepo) UpdateOperation(ctx context.Context, operation model.Operation, fields []string) (model.Operation, error) {
+	return r.operations.UpdateOperation(ctx, operation, fields)
+}
+
+func (r *generateImageRepo) ClaimOperation(ctx context.Context, owner string, leaseExpireTime time.Time) (model.Operation, error) {
+	return r.operations.ClaimOperation(ctx, owner, leaseExpireTime)
+}
+
+func (r *generateImageRepo) RenewOperationLease(ctx context.Context, id model.OperationId, owner string, leaseExpireTime time.Time) error {
+	return r.operations.RenewOperationLease(ctx, id, owner, leaseExpireTime)
+}
+
+func TestGenerateRecipeImage_FailureIsNotCharged(t *testing.T) {
+	repo := &generateImageRepo{imageGenerationRepo: newImageGenerationRepo(0), operations: newOperationRepo()}
+	d := newImageGenerationDomain(repo.imageGenerationRepo, &recipeImageGenerator{failFrom: 1}, 20)
+	d.repo = repo
+	startWorker(t, d)
+
+	_, err := d.GenerateRecipeImage(context.Background(), imageCaller, model.RecipeParent{}, imageRecipe)
+	if !errors.As(err, &domain.ErrInternal{}) {
+		t.Fatalf("GenerateRecipeImage() error = %v, want ErrInternal", err)
+	}
+
+	if operation := repo.operations.get(operationId); operation.State != model.OperationState_Failed {
+		t.Errorf("operation state = %v, want failed", operation.State)
+	}
+	// the image that failed is refunded
+	waitFor(t, "refunds", func() bool { return repo.charged() == 0 })
+	if got := repo.candidateCount(); got != 0 {
+		t.Errorf("kept %d candidates, want 0", got)
+	}
+}
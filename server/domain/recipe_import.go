@@ -0,0 +1,355 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeduplicate"
+	"github.com/jcfug8/daylear/server/core/recipeimport"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+// maxActiveRecipeImports is the number of imports a user can have pending or
+// running at the same time.
+const maxActiveRecipeImports = 1
+
+// StartRecipeImport stores the file and records a new import of it, along
+// with the operation that imports its recipes into the caller's recipes in
+// the background. The returned import is still running; its report is filled
+// in once every recipe has been handled.
+func (d *Domain) StartRecipeImport(ctx context.Context, authAccount model.AuthAccount, source recipeimport.Source, data []byte) (model.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.RecipeImport{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if len(data) == 0 {
+		log.Warn().Msg("empty import file")
+		return model.RecipeImport{}, domain.ErrInvalidArgument{Msg: "import file is empty"}
+	}
+
+	name := path.Join(OperationFileRoot, strconv.FormatInt(authAccount.AuthUserId, 10), uuid.NewV4().String(), "import")
+	uri, err := d.fileStore.UploadPublicFile(ctx, name, file.File{
+		ContentType:    "application/octet-stream",
+		ReadSeekCloser: file.NewReadSeekCloser(data),
+		ContentLength:  int64(len(data)),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to upload import file")
+		return model.RecipeImport{}, domain.ErrInternal{Msg: "unable to store import file"}
+	}
+
+	recipeImport, operation, err := d.createRecipeImport(ctx, authAccount, source, uri)
+	if err != nil {
+		go d.deleteOperationFiles(context.Background(), model.Operation{Request: model.OperationRequest{FileURIs: []string{uri}}})
+		return model.RecipeImport{}, err
+	}
+
+	d.wakeOperationWorker()
+
+	log.Info().Int64("recipeImportId", recipeImport.Id.RecipeImportId).Int64("operationId", operation.Id.OperationId).Msg("recipe import started")
+	return recipeImport, nil
+}
+
+// createRecipeImport records a running import and the pending operation that
+// runs it, unless the caller already has as many imports as allowed.
+func (d *Domain) createRecipeImport(ctx context.Context, authAccount model.AuthAccount, source recipeimport.Source, uri string) (model.RecipeImport, model.Operation, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	tx, err := d.repo.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to begin transaction when starting recipe import")
+		return model.RecipeImport{}, model.Operation{}, domain.ErrInternal{Msg: "unable to start recipe import"}
+	}
+	defer tx.Rollback()
+
+	operationParent := model.OperationParent{UserId: authAccount.AuthUserId}
+	err = tx.LockOperations(ctx, operationParent)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to lock operations when starting recipe import")
+		return model.RecipeImport{}, model.Operation{}, domain.ErrInternal{Msg: "unable to start recipe import"}
+	}
+
+	active, err := tx.CountActiveOperations(ctx, operationParent, model.OperationKind_ImportRecipes)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to count active recipe imports")
+		return model.RecipeImport{}, model.Operation{}, domain.ErrInternal{Msg: "unable to start recipe import"}
+	}
+	if active >= maxActiveRecipeImports {
+		log.Warn().Int64("active", active).Msg("too many active recipe imports")
+		return model.RecipeImport{}, model.Operation{}, domain.ErrResourceExhausted{Msg: "wait for the running recipe import to finish before starting another"}
+	}
+
+	recipeImport, err := tx.CreateRecipeImport(ctx, model.RecipeImport{
+		Parent: model.RecipeImportParent{UserId: authAccount.AuthUserId},
+		Source: string(source),
+		State:  model.RecipeImportState_Running,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.CreateRecipeImport failed")
+		return model.RecipeImport{}, model.Operation{}, domain.ErrInternal{Msg: "unable to start recipe import"}
+	}
+
+	operation, err := tx.CreateOperation(ctx, model.Operation{
+		Parent: operationParent,
+		Kind:   model.OperationKind_ImportRecipes,
+		State:  model.OperationState_Pending,
+		Request: model.OperationRequest{
+			AuthAccount:    authAccount,
+			RecipeImportId: recipeImport.Id,
+			ImportSource:   string(source),
+			FileURIs:       []string{uri},
+		},
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.CreateOperation failed")
+		return model.RecipeImport{}, model.Operation{}, domain.ErrInternal{Msg: "unable to start recipe import"}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit transaction when starting recipe import")
+		return model.RecipeImport{}, model.Operation{}, domain.ErrInternal{Msg: "unable to start recipe import"}
+	}
+
+	return recipeImport, operation, nil
+}
+
+// GetRecipeImport gets one of the caller's recipe imports.
+func (d *Domain) GetRecipeImport(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImportParent, id model.RecipeImportId, fields []string) (model.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeImportId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeImport{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	if err := d.checkRecipeImportAccess(ctx, authAccount, parent); err != nil {
+		return model.RecipeImport{}, err
+	}
+
+	recipeImport, err := d.repo.GetRecipeImport(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeImport failed")
+		return model.RecipeImport{}, err
+	}
+
+	return recipeImport, nil
+}
+
+// ListRecipeImports lists the caller's recipe imports, newest first.
+func (d *Domain) ListRecipeImports(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImportParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImport, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if err := d.checkRecipeImportAccess(ctx, authAccount, parent); err != nil {
+		return nil, err
+	}
+
+	recipeImports, err := d.repo.ListRecipeImports(ctx, parent, pageSize, offset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeImports failed")
+		return nil, err
+	}
+
+	return recipeImports, nil
+}
+
+// checkRecipeImportAccess makes sure the caller is the user the imports
+// belong to.
+func (d *Domain) checkRecipeImportAccess(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImportParent) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+	if parent.UserId != authAccount.AuthUserId {
+		log.Warn().Int64("userId", parent.UserId).Msg("recipe imports of another user requested")
+		return domain.ErrPermissionDenied{Msg: "recipe imports can only be accessed by their user"}
+	}
+	return nil
+}
+
+// runRecipeImport imports the recipes of the file of an import operation and
+// stores the report on the import. When the operation is stopped before it is
+// done the import is left running: it is either requeued, and the recipes
+// already created are found as duplicates when it runs again, or it was
+// cancelled and has been marked as failed.
+func (d *Domain) runRecipeImport(ctx context.Context, request model.OperationRequest) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx).With().
+		Int64("recipeImportId", request.RecipeImportId.RecipeImportId).
+		Str("source", request.ImportSource).
+		Logger()
+
+	recipeImport := model.RecipeImport{
+		Parent:  model.RecipeImportParent{UserId: request.AuthAccount.AuthUserId},
+		Id:      request.RecipeImportId,
+		Results: []model.RecipeImportResult{},
+		State:   model.RecipeImportState_Succeeded,
+	}
+
+	entries, err := d.readRecipeImportFile(ctx, request)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Warn().Err(err).Msg("unable to parse import file")
+		recipeImport.State = model.RecipeImportState_Failed
+		recipeImport.Error = err.Error()
+	} else {
+		recipeImport.Results, err = d.importRecipes(ctx, request.AuthAccount, entries)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("unable to import recipes")
+			recipeImport.State = model.RecipeImportState_Failed
+			recipeImport.Error = "unable to import recipes"
+		}
+	}
+
+	err = d.completeRecipeImport(ctx, recipeImport)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Int("recipes", len(recipeImport.Results)).Msg("recipe import finished")
+	if recipeImport.State == model.RecipeImportState_Failed {
+		return errors.New(recipeImport.Error)
+	}
+	return nil
+}
+
+// readRecipeImportFile reads and parses the file of an import operation.
+func (d *Domain) readRecipeImportFile(ctx context.Context, request model.OperationRequest) ([]recipeimport.Entry, error) {
+	if len(request.FileURIs) != 1 {
+		return nil, errors.New("import file missing")
+	}
+
+	contents, err := d.fileRetriever.GetFileContents(ctx, request.FileURIs[0])
+	if err != nil {
+		return nil, fmt.Errorf("unable to read import file: %w", err)
+	}
+	defer contents.Close()
+
+	data, err := io.ReadAll(contents)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read import file: %w", err)
+	}
+
+	return recipeimport.Parse(recipeimport.Source(request.ImportSource), data)
+}
+
+// failRecipeImport marks the import of an operation that was cancelled or
+// deleted before it finished as failed.
+func (d *Domain) failRecipeImport(ctx context.Context, request model.OperationRequest, errMsg string) {
+	err := d.completeRecipeImport(ctx, model.RecipeImport{
+		Parent:  model.RecipeImportParent{UserId: request.AuthAccount.AuthUserId},
+		Id:      request.RecipeImportId,
+		State:   model.RecipeImportState_Failed,
+		Error:   errMsg,
+		Results: []model.RecipeImportResult{},
+	})
+	if err != nil {
+		d.log.Warn().Err(err).Int64("recipeImportId", request.RecipeImportId.RecipeImportId).Msg("unable to mark recipe import as failed")
+	}
+}
+
+// completeRecipeImport stores the outcome of an import.
+func (d *Domain) completeRecipeImport(ctx context.Context, recipeImport model.RecipeImport) error {
+	completeTime := time.Now()
+	recipeImport.CompleteTime = &completeTime
+	_, err := d.repo.UpdateRecipeImport(ctx, recipeImport, []string{
+		model.RecipeImportField_State,
+		model.RecipeImportField_Error,
+		model.RecipeImportField_Results,
+		model.RecipeImportField_CompleteTime,
+	})
+	if err != nil {
+		d.log.Error().Err(err).Int64("recipeImportId", recipeImport.Id.RecipeImportId).Msg("repo.UpdateRecipeImport failed")
+		return err
+	}
+	return nil
+}
+
+// importRecipes creates a recipe for every entry. Entries that are likely
+// copies of a recipe the caller already owns, or of an earlier entry, are
+// skipped as duplicates.
+func (d *Domain) importRecipes(ctx context.Context, authAccount model.AuthAccount, entries []recipeimport.Entry) ([]model.RecipeImportResult, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	owned, err := d.listOwnedRecipes(ctx, authAccount, recipeDuplicateFields)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := model.RecipeParent{UserId: authAccount.AuthUserId}
+	results := make([]model.RecipeImportResult, 0, len(entries))
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		result := model.RecipeImportResult{Title: entry.Title}
+		if entry.Err != nil {
+			result.Outcome = model.RecipeImportOutcome_Failed
+			result.Error = entry.Err.Error()
+			results = append(results, result)
+			continue
+		}
+
+		if duplicates := recipeduplicate.Find(entry.Recipe, owned); len(duplicates) > 0 {
+			result.Outcome = model.RecipeImportOutcome_Duplicate
+			result.RecipeId = duplicates[0].Recipe.Id
+			results = append(results, result)
+			continue
+		}
+
+		recipe := entry.Recipe
+		recipe.Parent = parent
+		recipe.VisibilityLevel = types.VisibilityLevel_VISIBILITY_LEVEL_PRIVATE
+
+		dbRecipe, err := d.createRecipe(ctx, authAccount, recipe)
+		if err != nil && recipe.ImageURI != "" {
+			// the image may no longer exist, keep the recipe without it
+			log.Warn().Err(err).Str("title", recipe.Title).Msg("unable to import recipe with its image, retrying without")
+			recipe.ImageURI = ""
+			result.Error = "the recipe image could not be imported"
+			dbRecipe, err = d.createRecipe(ctx, authAccount, recipe)
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("title", recipe.Title).Msg("unable to import recipe")
+			result.Outcome = model.RecipeImportOutcome_Failed
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+
+		result.Outcome = model.RecipeImportOutcome_Created
+		result.RecipeId = dbRecipe.Id
+		recipe.Id = dbRecipe.Id
+		owned = append(owned, recipe)
+
+		if len(entry.Image) > 0 {
+			_, err = d.UploadRecipeImage(ctx, authAccount, parent, dbRecipe.Id, bytes.NewReader(entry.Image))
+			if err != nil {
+				log.Warn().Err(err).Int64("recipeId", dbRecipe.Id.RecipeId).Msg("unable to upload imported recipe image")
+				result.Error = "the recipe image could not be imported"
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeimport"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// importRepo adds recipe imports to an operationRepo.
+type importRepo struct {
+	*operationRepo
+	imports map[int64]model.RecipeImport
+}
+
+func newImportRepo() *importRepo {
+	return &importRepo{operationRepo: newOperationRepo(), imports: map[int64]model.RecipeImport{}}
+}
+
+func (r *importRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return fakeTx{r}, nil
+}
+
+func (r *importRepo) LockOperations(ctx context.Context, parent model.OperationParent) error {
+	return nil
+}
+
+func (r *importRepo) CountActiveOperations(ctx context.Context, parent model.OperationParent, kind model.OperationKind) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var active int64
+	for _, operation := range r.operations {
+		if operation.Parent == parent && operation.Kind == kind && !operation.State.Done() {
+			active++
+		}
+	}
+	return active, nil
+}
+
+func (r *importRepo) CreateOperation(ctx context.Context, operation model.Operation) (model.Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	operation.Id.OperationId = int64(len(r.operations) + 1)
+	r.operations[operation.Id.OperationId] = operation
+	return operation, nil
+}
+
+func (r *importRepo) CreateRecipeImport(ctx context.Context, recipeImport model.RecipeImport) (model.RecipeImport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recipeImport.Id.RecipeImportId = int64(len(r.imports) + 1)
+	r.imports[recipeImport.Id.RecipeImportId] = recipeImport
+	return recipeImport, nil
+}
+
+func (r *importRepo) UpdateRecipeImport(ctx context.Context, recipeImport model.RecipeImport, fields []string) (model.RecipeImport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.imports[recipeImport.Id.RecipeImportId]
+	if !ok || stored.Parent != recipeImport.Parent {
+		return model.RecipeImport{}, repository.ErrNotFound{}
+	}
+	stored.State = recipeImport.State
+	stored.Error = recipeImport.Error
+	stored.Results = recipeImport.Results
+	stored.CompleteTime = recipeImport.CompleteTime
+	r.imports[recipeImport.Id.RecipeImportId] = stored
+	return stored, nil
+}
+
+func (r *importRepo) getImport(id model.RecipeImportId) model.RecipeImport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.imports[id.RecipeImportId]
+}
+
+// newImportDomain creates a domain that runs recipe imports against the
+// given repository. The import files read back as their location, which is
+// not a valid CSV file.
+func newImportDomain(repo *importRepo) (*Domain, *fakeFileStore) {
+	fileStore := &fakeFileStore{}
+	d := newTestDomain(repo)
+	d.fileStore = fileStore
+	d.fileRetriever = fakeFileRetriever{}
+	return d, fileStore
+}
+
+func TestStartRecipeImport_QueuesOperation(t *testing.T) {
+	repo := newImportRepo()
+	d, fileStore := newImportDomain(repo)
+	ctx := context.Background()
+
+	recipeImport, err := d.StartRecipeImport(ctx, operationCaller, recipeimport.SourceCSV, []byte("title\nPancakes\n"))
+	if err != nil {
+		t.Fatalf("StartRecipeImport() error = %v", err)
+	}
+	if recipeImport.State != model.RecipeImportState_Running {
+		t.Errorf("recipe import state = %v, want running", recipeImport.State)
+	}
+
+	operation := repo.get(model.OperationId{OperationId: 1})
+	if operation.Kind != model.OperationKind_ImportRecipes || operation.State != model.OperationState_Pending {
+		t.Fatalf("operation = (%q, %v), want a pending import", operation.Kind, operation.State)
+	}
+	request := operation.Request
+	if request.RecipeImportId != recipeImport.Id || request.ImportSource != string(recipeimport.SourceCSV) || request.AuthAccount != operationCaller {
+		t.Errorf("operation request = %+v, want the import of the caller", request)
+	}
+	if len(request.FileURIs) != 1 || len(fileStore.uploaded) != 1 || request.FileURIs[0] != "files/"+fileStore.uploaded[0] {
+		t.Errorf("operation files = %v, want the uploaded file %v", request.FileURIs, fileStore.uploaded)
+	}
+
+	// only one import runs at a time
+	_, err = d.StartRecipeImport(ctx, operationCaller, recipeimport.SourceCSV, []byte("title\nWaffles\n"))
+	if !errors.As(err, &domain.ErrResourceExhausted{}) {
+		t.Fatalf("second StartRecipeImport() error = %v, want ErrResourceExhausted", err)
+	}
+	waitFor(t, "the refused upload to be deleted", func() bool {
+		fileStore.mu.Lock()
+		defer fileStore.mu.Unlock()
+		return len(fileStore.deleted) == 1 && fileStore.deleted[0] == "files/"+fileStore.uploaded[1]
+	})
+	if len(repo.imports) != 1 {
+		t.Errorf("recipe imports = %d, want only the first", len(repo.imports))
+	}
+}
+
+func TestRecipeImport_RunsOnOperationWorker(t *testing.T) {
+	repo := newImportRepo()
+	d, fileStore := newImportDomain(repo)
+
+	recipeImport, err := d.StartRecipeImport(context.Background(), operationCaller, recipeimport.SourceCSV, []byte("not read"))
+	if err != nil {
+		t.Fatalf("StartRecipeImport() error = %v", err)
+	}
+
+	startWorker(t, d)
+	importOperationId := model.OperationId{OperationId: 1}
+	waitFor(t, "the operation to finish", func() bool { return repo.get(importOperationId).State.Done() })
+
+	if operation := repo.get(importOperationId); operation.State != model.OperationState_Failed {
+		t.Errorf("operation state = %v, want failed", operation.State)
+	}
+	stored := repo.getImport(recipeImport.Id)
+	if stored.State != model.RecipeImportState_Failed || stored.Error == "" || stored.CompleteTime == nil {
+		t.Errorf("recipe import = (%v, %q, %v), want it completed as failed", stored.State, stored.Error, stored.CompleteTime)
+	}
+	waitFor(t, "the import file to be deleted", func() bool {
+		fileStore.mu.Lock()
+		defer fileStore.mu.Unlock()
+		return len(fileStore.deleted) == 1
+	})
+}
+
+func TestRunRecipeImport_StoppedLeavesImportRunning(t *testing.T) {
+	repo := newImportRepo()
+	d, _ := newImportDomain(repo)
+
+	recipeImport, err := d.StartRecipeImport(context.Background(), operationCaller, recipeimport.SourceCSV, []byte("not read"))
+	if err != nil {
+		t.Fatalf("StartRecipeImport() error = %v", err)
+	}
+
+	// stopped as when the process shuts down, the operation is requeued
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = d.runRecipeImport(ctx, repo.get(model.OperationId{OperationId: 1}).Request)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("runRecipeImport() error = %v, want context.Canceled", err)
+	}
+	if stored := repo.getImport(recipeImport.Id); stored.State != model.RecipeImportState_Running {
+		t.Errorf("recipe import state = %v, want it still running", stored.State)
+	}
+}
+
+func TestCancelOperation_FailsRecipeImport(t *testing.T) {
+	repo := newImportRepo()
+	d, _ := newImportDomain(repo)
+
+	recipeImport, err := d.StartRecipeImport(context.Background(), operationCaller, recipeimport.SourceCSV, []byte("not read"))
+	if err != nil {
+		t.Fatalf("StartRecipeImport() error = %v", err)
+	}
+
+	_, err = d.CancelOperation(context.Background(), operationCaller, operationParent, model.OperationId{OperationId: 1})
+	if err != nil {
+		t.Fatalf("CancelOperation() error = %v", err)
+	}
+
+	stored := repo.getImport(recipeImport.Id)
+	if stored.State != model.RecipeImportState_Failed || stored.Error != "recipe import cancelled" {
+		t.Errorf("recipe import = (%v, %q), want it failed as cancelled", stored.State, stored.Error)
+	}
+}
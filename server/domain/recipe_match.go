@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipematch"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+)
+
+// recipeMatchBatchSize is the number of recipes read at a time when matching.
+const recipeMatchBatchSize = 500
+
+// MatchRecipes ranks the recipes the user can see by how many of their
+// required ingredients are on hand. Recipes without any ingredient on hand are
+// left out.
+func (d *Domain) MatchRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, request model.RecipeMatchRequest, pageSize int32, offset int64) (matches []model.RecipeMatch, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return nil, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	onHand := recipematch.NewOnHand(request.Ingredients)
+
+	matches = []model.RecipeMatch{}
+	for batchOffset := int64(0); ; batchOffset += recipeMatchBatchSize {
+		// ListRecipes applies the visibility and access rules
+		recipes, err := d.ListRecipes(ctx, authAccount, parent, recipeMatchBatchSize, batchOffset, request.Filter, "", nil)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to list recipes when matching recipes")
+			return nil, err
+		}
+
+		for _, recipe := range recipes {
+			match := recipematch.Match(recipe, onHand)
+			if len(match.AvailableIngredients) == 0 || match.Coverage < request.MinimumCoverage {
+				continue
+			}
+			matches = append(matches, match)
+		}
+
+		if len(recipes) < recipeMatchBatchSize {
+			break
+		}
+	}
+
+	recipematch.Rank(matches)
+
+	if offset >= int64(len(matches)) {
+		return []model.RecipeMatch{}, nil
+	}
+	matches = matches[offset:]
+	if pageSize > 0 && int(pageSize) < len(matches) {
+		matches = matches[:pageSize]
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,276 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// recipeNoteMemberPageSize is the page size used to list the members of a
+// circle when pruning their notes.
+const recipeNoteMemberPageSize = 100
+
+// CreateRecipeNote creates a private note of the caller on a recipe they can
+// read.
+func (d *Domain) CreateRecipeNote(ctx context.Context, authAccount model.AuthAccount, note model.RecipeNote) (model.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	recipe, err := d.checkRecipeNoteAccess(ctx, authAccount, note.Parent.RecipeId, model.RecipeField_IngredientGroups, model.RecipeField_Directions)
+	if err != nil {
+		return model.RecipeNote{}, err
+	}
+
+	err = validateRecipeNote(recipe, note, nil)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid recipe note")
+		return model.RecipeNote{}, err
+	}
+
+	note.Id = model.RecipeNoteId{}
+	note.AuthorId = model.UserId{UserId: authAccount.AuthUserId}
+
+	note, err = d.repo.CreateRecipeNote(ctx, note)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.CreateRecipeNote failed")
+		return model.RecipeNote{}, err
+	}
+
+	return note, nil
+}
+
+// GetRecipeNote gets a private note of the caller.
+func (d *Domain) GetRecipeNote(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeNoteParent, id model.RecipeNoteId, fields []string) (model.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeNoteId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeNote{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err := d.checkRecipeNoteAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeNote{}, err
+	}
+
+	note, err := d.repo.GetRecipeNote(ctx, model.UserId{UserId: authAccount.AuthUserId}, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeNote failed")
+		return model.RecipeNote{}, err
+	}
+
+	return note, nil
+}
+
+// ListRecipeNotes lists the private notes of the caller on a recipe, oldest
+// first.
+func (d *Domain) ListRecipeNotes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeNoteParent, pageSize int32, offset int64, fields []string) ([]model.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	_, err := d.checkRecipeNoteAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := d.repo.ListRecipeNotes(ctx, model.UserId{UserId: authAccount.AuthUserId}, parent, pageSize, offset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeNotes failed")
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+// UpdateRecipeNote updates a private note of the caller. The ingredient and
+// step of a note are updated together since a note is attached to at most
+// one of them.
+func (d *Domain) UpdateRecipeNote(ctx context.Context, authAccount model.AuthAccount, note model.RecipeNote, fields []string) (model.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if note.Id.RecipeNoteId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeNote{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipe, err := d.checkRecipeNoteAccess(ctx, authAccount, note.Parent.RecipeId, model.RecipeField_IngredientGroups, model.RecipeField_Directions)
+	if err != nil {
+		return model.RecipeNote{}, err
+	}
+
+	if slices.Contains(fields, model.RecipeNoteField_Ingredient) || slices.Contains(fields, model.RecipeNoteField_Step) {
+		fields = slices.DeleteFunc(slices.Clone(fields), func(field string) bool {
+			return field == model.RecipeNoteField_Ingredient || field == model.RecipeNoteField_Step
+		})
+		fields = append(fields, model.RecipeNoteField_Ingredient, model.RecipeNoteField_Step)
+	}
+
+	err = validateRecipeNote(recipe, note, fields)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid recipe note")
+		return model.RecipeNote{}, err
+	}
+
+	note.AuthorId = model.UserId{UserId: authAccount.AuthUserId}
+
+	note, err = d.repo.UpdateRecipeNote(ctx, note, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateRecipeNote failed")
+		return model.RecipeNote{}, err
+	}
+
+	return note, nil
+}
+
+// DeleteRecipeNote deletes a private note of the caller.
+func (d *Domain) DeleteRecipeNote(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeNoteParent, id model.RecipeNoteId) (model.RecipeNote, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.RecipeNote{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeNoteId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeNote{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	note, err := d.repo.DeleteRecipeNote(ctx, model.UserId{UserId: authAccount.AuthUserId}, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.DeleteRecipeNote failed")
+		return model.RecipeNote{}, err
+	}
+
+	return note, nil
+}
+
+// validateRecipeNote checks the fields of a note that are being written
+// against the recipe it is on. All fields are checked when fields is nil.
+func validateRecipeNote(recipe model.Recipe, note model.RecipeNote, fields []string) error {
+	if (fields == nil || slices.Contains(fields, model.RecipeNoteField_Text)) && strings.TrimSpace(note.Text) == "" {
+		return domain.ErrInvalidArgument{Msg: "text required"}
+	}
+
+	if fields != nil && !slices.Contains(fields, model.RecipeNoteField_Ingredient) {
+		return nil
+	}
+
+	if note.Ingredient != nil && note.Step != nil {
+		return domain.ErrInvalidArgument{Msg: "a note can be attached to an ingredient or a step, not both"}
+	}
+
+	if note.Ingredient != nil {
+		ingredient := note.Ingredient
+		if ingredient.GroupIndex < 0 || int(ingredient.GroupIndex) >= len(recipe.IngredientGroups) {
+			return domain.ErrInvalidArgument{Msg: "ingredient group index out of range"}
+		}
+		if ingredient.IngredientIndex < 0 || int(ingredient.IngredientIndex) >= len(recipe.IngredientGroups[ingredient.GroupIndex].RecipeIngredients) {
+			return domain.ErrInvalidArgument{Msg: "ingredient index out of range"}
+		}
+	}
+
+	return validateRecipeImageStep(recipe, note.Step)
+}
+
+// checkRecipeNoteAccess checks that the caller can read the recipe, including
+// public recipes, and returns it with the visibility and the given fields.
+func (d *Domain) checkRecipeNoteAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, fields ...string) (model.Recipe, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("recipe id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "recipe id required"}
+	}
+
+	recipe, err := d.repo.GetRecipe(ctx, authAccount, id, append([]string{model.RecipeField_VisibilityLevel}, fields...))
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return model.Recipe{}, err
+	}
+
+	_, err = d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(recipe.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to determine recipe access")
+		return model.Recipe{}, err
+	}
+
+	return recipe, nil
+}
+
+// pruneRecipeNotes deletes the notes on a recipe whose authors can no longer
+// read it.
+func (d *Domain) pruneRecipeNotes(ctx context.Context, id model.RecipeId) error {
+	authorIds, err := d.repo.ListRecipeNoteAuthors(ctx, model.RecipeNoteParent{RecipeId: id})
+	if err != nil {
+		return err
+	}
+
+	for _, authorId := range authorIds {
+		err = d.pruneAuthorRecipeNotes(ctx, authorId, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneUserRecipeNotes deletes the notes of a user on the recipes they can no
+// longer read.
+func (d *Domain) pruneUserRecipeNotes(ctx context.Context, authorId model.UserId) error {
+	recipeIds, err := d.repo.ListRecipeNoteRecipes(ctx, authorId)
+	if err != nil {
+		return err
+	}
+
+	for _, recipeId := range recipeIds {
+		err = d.pruneAuthorRecipeNotes(ctx, authorId, recipeId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listCircleMemberIds lists the users who have access to a circle.
+func listCircleMemberIds(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, id model.CircleId) ([]model.UserId, error) {
+	memberIds := []model.UserId{}
+	for offset := int64(0); ; offset += recipeNoteMemberPageSize {
+		accesses, err := tx.ListCircleAccesses(ctx, authAccount, model.CircleAccessParent{CircleId: id}, recipeNoteMemberPageSize, offset, "", []string{model.CircleAccessField_Recipient})
+		if err != nil {
+			return nil, err
+		}
+		for _, access := range accesses {
+			memberIds = append(memberIds, access.Recipient)
+		}
+		if len(accesses) < recipeNoteMemberPageSize {
+			return memberIds, nil
+		}
+	}
+}
+
+// pruneAuthorRecipeNotes deletes the notes of a user on a recipe when they can
+// no longer read it.
+func (d *Domain) pruneAuthorRecipeNotes(ctx context.Context, authorId model.UserId, id model.RecipeId) error {
+	_, err := d.checkRecipeNoteAccess(ctx, model.AuthAccount{AuthUserId: authorId.UserId}, id)
+	if err == nil {
+		return nil
+	}
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		return err
+	}
+
+	return d.repo.BulkDeleteRecipeNotes(ctx, model.RecipeNoteParent{RecipeId: id}, authorId)
+}
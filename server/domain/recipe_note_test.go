@@ -0,0 +1,486 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// noteRepo holds recipes, the standard access of users to them and the notes
+// on them. Notes are read and written on behalf of their author like the
+// database does.
+type noteRepo struct {
+	repository.Client
+
+	recipes   map[int64]model.Recipe
+	access    map[int64]model.RecipeAccess
+	accessErr error
+	notes     []model.RecipeNote
+	deleted   []model.RecipeId
+}
+
+func newNoteRepo(recipes ...model.Recipe) *noteRepo {
+	r := &noteRepo{recipes: map[int64]model.Recipe{}, access: map[int64]model.RecipeAccess{}}
+	for _, recipe := range recipes {
+		r.recipes[recipe.Id.RecipeId] = recipe
+	}
+	return r
+}
+
+func (r *noteRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return fakeTx{r}, nil
+}
+
+func (r *noteRepo) GetRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, fields []string) (model.Recipe, error) {
+	recipe, ok := r.recipes[id.RecipeId]
+	if !ok {
+		return model.Recipe{}, repository.ErrNotFound{}
+	}
+	return recipe, nil
+}
+
+func (r *noteRepo) GetUser(ctx context.Context, authAccount model.AuthAccount, id model.UserId, fields []string) (model.User, error) {
+	return model.User{}, nil
+}
+
+func (r *noteRepo) FindStandardUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error) {
+	if r.accessErr != nil {
+		return model.RecipeAccess{}, r.accessErr
+	}
+	access, ok := r.access[authAccount.AuthUserId]
+	if !ok {
+		return model.RecipeAccess{}, repository.ErrNotFound{}
+	}
+	return access, nil
+}
+
+func (r *noteRepo) FindDelegatedCircleRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.CircleAccess, error) {
+	return model.RecipeAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *noteRepo) FindDelegatedUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.UserAccess, error) {
+	return model.RecipeAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+}
+
+func (r *noteRepo) CreateRecipeNote(ctx context.Context, note model.RecipeNote) (model.RecipeNote, error) {
+	note.Id.RecipeNoteId = int64(len(r.notes) + 1)
+	r.notes = append(r.notes, note)
+	return note, nil
+}
+
+// find returns the index of a note of the author, or -1.
+func (r *noteRepo) find(authorId model.UserId, parent model.RecipeNoteParent, id model.RecipeNoteId) int {
+	return slices.IndexFunc(r.notes, func(note model.RecipeNote) bool {
+		return note.AuthorId == authorId && note.Parent == parent && note.Id == id
+	})
+}
+
+func (r *noteRepo) GetRecipeNote(ctx context.Context, authorId model.UserId, parent model.RecipeNoteParent, id model.RecipeNoteId, fields []string) (model.RecipeNote, error) {
+	i := r.find(authorId, parent, id)
+	if i < 0 {
+		return model.RecipeNote{}, repository.ErrNotFound{}
+	}
+	return r.notes[i], nil
+}
+
+func (r *noteRepo) ListRecipeNotes(ctx context.Context, authorId model.UserId, parent model.RecipeNoteParent, pageSize int32, offset int64, fields []string) ([]model.RecipeNote, error) {
+	notes := []model.RecipeNote{}
+	for _, note := range r.notes {
+		if note.AuthorId == authorId && note.Parent == parent {
+			notes = append(notes, note)
+		}
+	}
+	return notes, nil
+}
+
+func (r *noteRepo) UpdateRecipeNote(ctx context.Context, note model.RecipeNote, fields []string) (model.RecipeNote, error) {
+	i := r.find(note.AuthorId, note.Parent, note.Id)
+	if i < 0 {
+		return model.RecipeNote{}, repository.ErrNotFound{}
+	}
+	if slices.Contains(fields, model.RecipeNoteField_Text) {
+		r.notes[i].Text = note.Text
+	}
+	return r.notes[i], nil
+}
+
+func (r *noteRepo) DeleteRecipeNote(ctx context.Context, authorId model.UserId, parent model.RecipeNoteParent, id model.RecipeNoteId) (model.RecipeNote, error) {
+	i := r.find(authorId, parent, id)
+	if i < 0 {
+		return model.RecipeNote{}, repository.ErrNotFound{}
+	}
+	note := r.notes[i]
+	r.notes = slices.Delete(r.notes, i, i+1)
+	return note, nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeNotes(ctx context.Context, parent model.RecipeNoteParent, authorId model.UserId) error {
+	r.notes = slices.DeleteFunc(r.notes, func(note model.RecipeNote) bool {
+		return note.Parent == parent && (authorId.UserId == 0 || note.AuthorId == authorId)
+	})
+	return nil
+}
+
+func (r *noteRepo) ListRecipeNoteAuthors(ctx context.Context, parent model.RecipeNoteParent) ([]model.UserId, error) {
+	authorIds := []model.UserId{}
+	for _, note := range r.notes {
+		if note.Parent == parent && !slices.Contains(authorIds, note.AuthorId) {
+			authorIds = append(authorIds, note.AuthorId)
+		}
+	}
+	return authorIds, nil
+}
+
+func (r *noteRepo) ListRecipeNoteRecipes(ctx context.Context, authorId model.UserId) ([]model.RecipeId, error) {
+	recipeIds := []model.RecipeId{}
+	for _, note := range r.notes {
+		if note.AuthorId == authorId && !slices.Contains(recipeIds, note.Parent.RecipeId) {
+			recipeIds = append(recipeIds, note.Parent.RecipeId)
+		}
+	}
+	return recipeIds, nil
+}
+
+func (r *noteRepo) MoveRecipeNotes(ctx context.Context, from model.RecipeId, to model.RecipeId) error {
+	for i, note := range r.notes {
+		if note.Parent.RecipeId == from {
+			r.notes[i].Parent.RecipeId = to
+			r.notes[i].Ingredient = nil
+			r.notes[i].Step = nil
+		}
+	}
+	return nil
+}
+
+// The merge moves and deletes the rest of the recipe, which the notes do not
+// depend on.
+
+func (r *noteRepo) MoveRecipeFavorites(ctx context.Context, from model.RecipeId, to model.RecipeId) error {
+	return nil
+}
+
+func (r *noteRepo) MoveRecipeAccesses(ctx context.Context, from model.RecipeId, to model.RecipeId) error {
+	return nil
+}
+
+func (r *noteRepo) MoveEventRecipes(ctx context.Context, from model.RecipeId, to model.RecipeId) error {
+	return nil
+}
+
+func (r *noteRepo) MoveRecipeCookLogs(ctx context.Context, from model.RecipeId, to model.RecipeId) error {
+	return nil
+}
+
+func (r *noteRepo) DeleteRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.Recipe, error) {
+	recipe := r.recipes[id.RecipeId]
+	delete(r.recipes, id.RecipeId)
+	r.deleted = append(r.deleted, id)
+	return recipe, nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeImages(ctx context.Context, parent model.RecipeImageParent) ([]model.RecipeImage, error) {
+	return nil, nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeAccess(ctx context.Context, parent model.RecipeAccessParent) error {
+	return nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeFavorites(ctx context.Context, id model.RecipeId) error {
+	return nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeIngredients(ctx context.Context, id model.RecipeId) error {
+	return nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeRevisions(ctx context.Context, parent model.RecipeRevisionParent) error {
+	return nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeReviews(ctx context.Context, parent model.RecipeReviewParent) error {
+	return nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeCookLogs(ctx context.Context, parent model.RecipeCookLogParent) ([]model.RecipeCookLog, error) {
+	return nil, nil
+}
+
+func (r *noteRepo) BulkDeleteRecipeImageCandidates(ctx context.Context, parent model.RecipeImageCandidateParent) ([]model.RecipeImageCandidate, error) {
+	return nil, nil
+}
+
+var (
+	noteRecipeId = model.RecipeId{RecipeId: 1}
+	noteParent   = model.RecipeNoteParent{RecipeId: noteRecipeId}
+	noteAuthor   = model.AuthAccount{AuthUserId: 1, UserId: 1}
+	noteReader   = model.AuthAccount{AuthUserId: 2, UserId: 2}
+)
+
+// noteRecipe creates a recipe with one ingredient and one step.
+func noteRecipe(id model.RecipeId, visibility types.VisibilityLevel) model.Recipe {
+	return model.Recipe{
+		Id:               id,
+		VisibilityLevel:  visibility,
+		IngredientGroups: []model.IngredientGroup{{RecipeIngredients: []model.RecipeIngredient{{Title: "flour"}}}},
+		Directions:       []model.RecipeDirection{{Steps: []string{"Whisk"}}},
+	}
+}
+
+func noteOf(id int64, recipeId model.RecipeId, authorId int64) model.RecipeNote {
+	return model.RecipeNote{
+		Parent:   model.RecipeNoteParent{RecipeId: recipeId},
+		Id:       model.RecipeNoteId{RecipeNoteId: id},
+		AuthorId: model.UserId{UserId: authorId},
+		Text:     "use less salt",
+	}
+}
+
+func TestRecipeNotes_PrivateToAuthor(t *testing.T) {
+	repo := newNoteRepo(noteRecipe(noteRecipeId, types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC))
+	d := newTestDomain(repo)
+	ctx := context.Background()
+
+	// the author is always the caller
+	note, err := d.CreateRecipeNote(ctx, noteAuthor, model.RecipeNote{
+		Parent:   noteParent,
+		AuthorId: model.UserId{UserId: noteReader.AuthUserId},
+		Text:     "use less salt",
+	})
+	if err != nil {
+		t.Fatalf("CreateRecipeNote() error = %v", err)
+	}
+	if note.AuthorId.UserId != noteAuthor.AuthUserId {
+		t.Errorf("note author = %d, want the caller", note.AuthorId.UserId)
+	}
+
+	notes, err := d.ListRecipeNotes(ctx, noteReader, noteParent, 0, 0, nil)
+	if err != nil || len(notes) != 0 {
+		t.Errorf("ListRecipeNotes() by another user = (%v, %v), want no notes", notes, err)
+	}
+
+	_, err = d.GetRecipeNote(ctx, noteReader, noteParent, note.Id, nil)
+	if !errors.As(err, &repository.ErrNotFound{}) {
+		t.Errorf("GetRecipeNote() by another user error = %v, want not found", err)
+	}
+
+	update := note
+	update.Text = "changed"
+	_, err = d.UpdateRecipeNote(ctx, noteReader, update, []string{model.RecipeNoteField_Text})
+	if !errors.As(err, &repository.ErrNotFound{}) {
+		t.Errorf("UpdateRecipeNote() by another user error = %v, want not found", err)
+	}
+
+	_, err = d.DeleteRecipeNote(ctx, noteReader, noteParent, note.Id)
+	if !errors.As(err, &repository.ErrNotFound{}) {
+		t.Errorf("DeleteRecipeNote() by another user error = %v, want not found", err)
+	}
+
+	notes, err = d.ListRecipeNotes(ctx, noteAuthor, noteParent, 0, 0, nil)
+	if err != nil || len(notes) != 1 || notes[0].Text != "use less salt" {
+		t.Errorf("ListRecipeNotes() by the author = (%v, %v), want the unchanged note", notes, err)
+	}
+
+	recipe, err := d.GetRecipe(ctx, noteReader, model.RecipeParent{UserId: noteReader.AuthUserId}, noteRecipeId, []string{model.RecipeField_PrivateNotes})
+	if err != nil || len(recipe.PrivateNotes) != 0 {
+		t.Errorf("GetRecipe() private notes of another user = (%v, %v), want none", recipe.PrivateNotes, err)
+	}
+}
+
+func TestCreateRecipeNote_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility types.VisibilityLevel
+		note       model.RecipeNote
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "hidden recipe",
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN,
+			note:       model.RecipeNote{Text: "note"},
+			wantErr:    func(err error) bool { return errors.As(err, &domain.ErrPermissionDenied{}) },
+		},
+		{
+			name:       "empty text",
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC,
+			note:       model.RecipeNote{Text: " "},
+			wantErr:    func(err error) bool { return errors.As(err, &domain.ErrInvalidArgument{}) },
+		},
+		{
+			name:       "unknown ingredient",
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC,
+			note:       model.RecipeNote{Text: "note", Ingredient: &model.RecipeStepIngredient{GroupIndex: 0, IngredientIndex: 1}},
+			wantErr:    func(err error) bool { return errors.As(err, &domain.ErrInvalidArgument{}) },
+		},
+		{
+			name:       "ingredient and step",
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC,
+			note: model.RecipeNote{
+				Text:       "note",
+				Ingredient: &model.RecipeStepIngredient{},
+				Step:       &model.RecipeDirectionStep{},
+			},
+			wantErr: func(err error) bool { return errors.As(err, &domain.ErrInvalidArgument{}) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newNoteRepo(noteRecipe(noteRecipeId, tt.visibility))
+			d := newTestDomain(repo)
+
+			tt.note.Parent = noteParent
+			_, err := d.CreateRecipeNote(context.Background(), noteAuthor, tt.note)
+			if !tt.wantErr(err) {
+				t.Errorf("CreateRecipeNote() error = %v", err)
+			}
+			if len(repo.notes) != 0 {
+				t.Errorf("CreateRecipeNote() created %d notes, want none", len(repo.notes))
+			}
+		})
+	}
+}
+
+func TestPruneRecipeNotes(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility types.VisibilityLevel
+		access     map[int64]model.RecipeAccess
+		wantAuthor []int64
+	}{
+		{
+			name:       "public recipe",
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC,
+			wantAuthor: []int64{noteAuthor.AuthUserId, noteReader.AuthUserId},
+		},
+		{
+			name:       "restricted recipe shared with one author",
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED,
+			access:     map[int64]model.RecipeAccess{noteAuthor.AuthUserId: acceptedRead},
+			wantAuthor: []int64{noteAuthor.AuthUserId},
+		},
+		{
+			name:       "hidden recipe shared with nobody",
+			visibility: types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			otherRecipeId := model.RecipeId{RecipeId: 2}
+			repo := newNoteRepo(noteRecipe(noteRecipeId, tt.visibility))
+			if tt.access != nil {
+				repo.access = tt.access
+			}
+			repo.notes = []model.RecipeNote{
+				noteOf(1, noteRecipeId, noteAuthor.AuthUserId),
+				noteOf(2, noteRecipeId, noteReader.AuthUserId),
+				// notes on other recipes are left alone
+				noteOf(3, otherRecipeId, noteReader.AuthUserId),
+			}
+			d := newTestDomain(repo)
+
+			err := d.pruneRecipeNotes(context.Background(), noteRecipeId)
+			if err != nil {
+				t.Fatalf("pruneRecipeNotes() error = %v", err)
+			}
+
+			authors := []int64{}
+			for _, note := range repo.notes {
+				if note.Parent.RecipeId == noteRecipeId {
+					authors = append(authors, note.AuthorId.UserId)
+				}
+			}
+			if !slices.Equal(authors, tt.wantAuthor) {
+				t.Errorf("notes kept of authors %v, want %v", authors, tt.wantAuthor)
+			}
+			if !slices.ContainsFunc(repo.notes, func(note model.RecipeNote) bool { return note.Parent.RecipeId == otherRecipeId }) {
+				t.Error("pruneRecipeNotes() deleted a note on another recipe")
+			}
+		})
+	}
+}
+
+func TestPruneUserRecipeNotes(t *testing.T) {
+	repo := newNoteRepo(noteRecipe(noteRecipeId, types.VisibilityLevel_VISIBILITY_LEVEL_PRIVATE))
+	repo.access[noteAuthor.AuthUserId] = acceptedRead
+	repo.notes = []model.RecipeNote{
+		noteOf(1, noteRecipeId, noteAuthor.AuthUserId),
+		noteOf(2, noteRecipeId, noteReader.AuthUserId),
+	}
+	d := newTestDomain(repo)
+
+	// the reader left the circle the recipe was shared with
+	err := d.pruneUserRecipeNotes(context.Background(), model.UserId{UserId: noteReader.AuthUserId})
+	if err != nil {
+		t.Fatalf("pruneUserRecipeNotes() error = %v", err)
+	}
+
+	if len(repo.notes) != 1 || repo.notes[0].AuthorId.UserId != noteAuthor.AuthUserId {
+		t.Errorf("notes = %v, want only the note of the other author", repo.notes)
+	}
+}
+
+func TestPruneAuthorRecipeNotes_AccessError(t *testing.T) {
+	repo := newNoteRepo(noteRecipe(noteRecipeId, types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN))
+	repo.accessErr = repository.ErrInternal{}
+	repo.notes = []model.RecipeNote{noteOf(1, noteRecipeId, noteAuthor.AuthUserId)}
+	d := newTestDomain(repo)
+
+	// an access that can't be determined is not a lost access
+	err := d.pruneAuthorRecipeNotes(context.Background(), model.UserId{UserId: noteAuthor.AuthUserId}, noteRecipeId)
+	if err == nil {
+		t.Error("pruneAuthorRecipeNotes() error = nil, want the access error")
+	}
+	if len(repo.notes) != 1 {
+		t.Error("pruneAuthorRecipeNotes() deleted the note, want it kept")
+	}
+}
+
+func TestMergeRecipes_MovesNotes(t *testing.T) {
+	mergedId := model.RecipeId{RecipeId: 2}
+	repo := newNoteRepo(
+		noteRecipe(noteRecipeId, types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC),
+		noteRecipe(mergedId, types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC),
+	)
+	repo.access[noteAuthor.AuthUserId] = acceptedAdmin
+	attached := noteOf(2, mergedId, noteAuthor.AuthUserId)
+	attached.Step = &model.RecipeDirectionStep{}
+	repo.notes = []model.RecipeNote{
+		noteOf(1, noteRecipeId, noteAuthor.AuthUserId),
+		attached,
+		noteOf(3, mergedId, noteReader.AuthUserId),
+	}
+	d := newTestDomain(repo)
+
+	recipe, err := d.MergeRecipes(context.Background(), noteAuthor, model.RecipeParent{UserId: noteAuthor.AuthUserId}, noteRecipeId, []model.RecipeId{mergedId})
+	if err != nil {
+		t.Fatalf("MergeRecipes() error = %v", err)
+	}
+
+	if !slices.Equal(repo.deleted, []model.RecipeId{mergedId}) {
+		t.Errorf("deleted recipes = %v, want the merged recipe", repo.deleted)
+	}
+	if len(repo.notes) != 3 {
+		t.Fatalf("%d notes left, want the notes of the merged recipe kept", len(repo.notes))
+	}
+	for _, note := range repo.notes {
+		if note.Parent.RecipeId != noteRecipeId {
+			t.Errorf("note %d is on recipe %d, want the kept recipe", note.Id.RecipeNoteId, note.Parent.RecipeId.RecipeId)
+		}
+		if note.Step != nil || note.Ingredient != nil {
+			t.Errorf("note %d is attached to (%v, %v), want it detached from the steps of the merged recipe", note.Id.RecipeNoteId, note.Ingredient, note.Step)
+		}
+	}
+
+	// the merged recipe shows the notes of the caller only
+	if len(recipe.PrivateNotes) != 2 {
+		t.Errorf("merged recipe has %d private notes, want the 2 of the caller", len(recipe.PrivateNotes))
+	}
+}
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+const (
+	minRecipeRating = 1
+	maxRecipeRating = 5
+)
+
+// CreateRecipeReview creates a review of a recipe by the caller. Anyone who
+// can see a recipe can review it, once.
+func (d *Domain) CreateRecipeReview(ctx context.Context, authAccount model.AuthAccount, review model.RecipeReview) (model.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	if review.Rating < minRecipeRating || review.Rating > maxRecipeRating {
+		log.Warn().Int32("rating", review.Rating).Msg("rating out of range")
+		return model.RecipeReview{}, domain.ErrInvalidArgument{Msg: "rating must be between 1 and 5"}
+	}
+
+	_, err := d.checkRecipeReviewAccess(ctx, authAccount, review.Parent.RecipeId)
+	if err != nil {
+		return model.RecipeReview{}, err
+	}
+
+	review.Id = model.RecipeReviewId{}
+	review.AuthorId = model.UserId{UserId: authAccount.AuthUserId}
+
+	review, err = d.repo.CreateRecipeReview(ctx, review)
+	if err != nil {
+		if errors.As(err, &repository.ErrNewAlreadyExists{}) {
+			log.Warn().Err(err).Msg("recipe already reviewed")
+			return model.RecipeReview{}, domain.ErrInvalidArgument{Msg: "recipe already reviewed"}
+		}
+		log.Error().Err(err).Msg("repo.CreateRecipeReview failed")
+		return model.RecipeReview{}, err
+	}
+
+	return review, nil
+}
+
+// GetRecipeReview gets a recipe review.
+func (d *Domain) GetRecipeReview(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeReviewParent, id model.RecipeReviewId, fields []string) (model.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeReviewId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeReview{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	_, err := d.checkRecipeReviewAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeReview{}, err
+	}
+
+	review, err := d.repo.GetRecipeReview(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeReview failed")
+		return model.RecipeReview{}, err
+	}
+
+	return review, nil
+}
+
+// ListRecipeReviews lists the reviews of a recipe, newest first.
+func (d *Domain) ListRecipeReviews(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeReviewParent, pageSize int32, offset int64, fields []string) ([]model.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	_, err := d.checkRecipeReviewAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := d.repo.ListRecipeReviews(ctx, parent, pageSize, offset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeReviews failed")
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+// UpdateRecipeReview updates a recipe review. Only the author can update a
+// review.
+func (d *Domain) UpdateRecipeReview(ctx context.Context, authAccount model.AuthAccount, review model.RecipeReview, fields []string) (model.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if review.Id.RecipeReviewId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeReview{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	if slices.Contains(fields, model.RecipeReviewField_Rating) && (review.Rating < minRecipeRating || review.Rating > maxRecipeRating) {
+		log.Warn().Int32("rating", review.Rating).Msg("rating out of range")
+		return model.RecipeReview{}, domain.ErrInvalidArgument{Msg: "rating must be between 1 and 5"}
+	}
+
+	_, err := d.checkRecipeReviewAccess(ctx, authAccount, review.Parent.RecipeId)
+	if err != nil {
+		return model.RecipeReview{}, err
+	}
+
+	current, err := d.repo.GetRecipeReview(ctx, review.Parent, review.Id, []string{model.RecipeReviewField_AuthorId})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeReview failed")
+		return model.RecipeReview{}, err
+	}
+
+	if current.AuthorId.UserId != authAccount.AuthUserId {
+		log.Warn().Msg("only the author can update a review")
+		return model.RecipeReview{}, domain.ErrPermissionDenied{Msg: "only the author can update a review"}
+	}
+
+	review, err = d.repo.UpdateRecipeReview(ctx, review, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.UpdateRecipeReview failed")
+		return model.RecipeReview{}, err
+	}
+
+	return review, nil
+}
+
+// DeleteRecipeReview deletes a recipe review. The author or an admin of the
+// recipe can delete a review.
+func (d *Domain) DeleteRecipeReview(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeReviewParent, id model.RecipeReviewId) (model.RecipeReview, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeReviewId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeReview{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	recipeAccess, err := d.checkRecipeReviewAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeReview{}, err
+	}
+
+	current, err := d.repo.GetRecipeReview(ctx, parent, id, []string{model.RecipeReviewField_AuthorId})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeReview failed")
+		return model.RecipeReview{}, err
+	}
+
+	if current.AuthorId.UserId != authAccount.AuthUserId && recipeAccess.PermissionLevel < types.PermissionLevel_PERMISSION_LEVEL_ADMIN {
+		log.Warn().Msg("only the author or a recipe admin can delete a review")
+		return model.RecipeReview{}, domain.ErrPermissionDenied{Msg: "only the author or a recipe admin can delete a review"}
+	}
+
+	review, err := d.repo.DeleteRecipeReview(ctx, parent, id)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.DeleteRecipeReview failed")
+		return model.RecipeReview{}, err
+	}
+
+	return review, nil
+}
+
+// checkRecipeReviewAccess checks that the caller can see the recipe the
+// reviews belong to. Reviews follow the visibility of the recipe, so a public
+// recipe's reviews can be read and written without a share.
+func (d *Domain) checkRecipeReviewAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.RecipeAccess{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("recipe id required")
+		return model.RecipeAccess{}, domain.ErrInvalidArgument{Msg: "recipe id required"}
+	}
+
+	recipe, err := d.repo.GetRecipe(ctx, authAccount, id, []string{model.RecipeField_VisibilityLevel})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return model.RecipeAccess{}, err
+	}
+
+	recipeAccess, err := d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(recipe.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine recipe access")
+		return model.RecipeAccess{}, err
+	}
+
+	return recipeAccess, nil
+}
@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// reviewRepo adds the reviews of the recipes to a noteRepo. A user can only
+// have one review of a recipe, like the unique index in the database.
+type reviewRepo struct {
+	*noteRepo
+	reviews []model.RecipeReview
+}
+
+func newReviewRepo(recipes ...model.Recipe) *reviewRepo {
+	return &reviewRepo{noteRepo: newNoteRepo(recipes...)}
+}
+
+func (r *reviewRepo) CreateRecipeReview(ctx context.Context, review model.RecipeReview) (model.RecipeReview, error) {
+	for _, existing := range r.reviews {
+		if existing.Parent == review.Parent && existing.AuthorId == review.AuthorId {
+			return model.RecipeReview{}, repository.ErrNewAlreadyExists{}
+		}
+	}
+	review.Id.RecipeReviewId = int64(len(r.reviews) + 1)
+	r.reviews = append(r.reviews, review)
+	return review, nil
+}
+
+// find returns the index of a review, or -1.
+func (r *reviewRepo) find(parent model.RecipeReviewParent, id model.RecipeReviewId) int {
+	return slices.IndexFunc(r.reviews, func(review model.RecipeReview) bool {
+		return review.Parent == parent && review.Id == id
+	})
+}
+
+func (r *reviewRepo) GetRecipeReview(ctx context.Context, parent model.RecipeReviewParent, id model.RecipeReviewId, fields []string) (model.RecipeReview, error) {
+	i := r.find(parent, id)
+	if i < 0 {
+		return model.RecipeReview{}, repository.ErrNotFound{}
+	}
+	return r.reviews[i], nil
+}
+
+func (r *reviewRepo) ListRecipeReviews(ctx context.Context, parent model.RecipeReviewParent, pageSize int32, offset int64, fields []string) ([]model.RecipeReview, error) {
+	reviews := []model.RecipeReview{}
+	for _, review := range r.reviews {
+		if review.Parent == parent {
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews, nil
+}
+
+func (r *reviewRepo) UpdateRecipeReview(ctx context.Context, review model.RecipeReview, fields []string) (model.RecipeReview, error) {
+	i := r.find(review.Parent, review.Id)
+	if i < 0 {
+		return model.RecipeReview{}, repository.ErrNotFound{}
+	}
+	if slices.Contains(fields, model.RecipeReviewField_Rating) {
+		r.reviews[i].Rating = review.Rating
+	}
+	return r.reviews[i], nil
+}
+
+func (r *reviewRepo) DeleteRecipeReview(ctx context.Context, parent model.RecipeReviewParent, id model.RecipeReviewId) (model.RecipeReview, error) {
+	i := r.find(parent, id)
+	if i < 0 {
+		return model.RecipeReview{}, repository.ErrNotFound{}
+	}
+	review := r.reviews[i]
+	r.reviews = slices.Delete(r.reviews, i, i+1)
+	return review, nil
+}
+
+var (
+	reviewRecipeId = model.RecipeId{RecipeId: 3}
+	reviewParent   = model.RecipeReviewParent{RecipeId: reviewRecipeId}
+	reviewer       = model.AuthAccount{AuthUserId: 31, UserId: 31}
+	reviewAdmin    = model.AuthAccount{AuthUserId: 32, UserId: 32}
+	otherReviewer  = model.AuthAccount{AuthUserId: 33, UserId: 33}
+)
+
+// newReviewedRecipeRepo creates a repository with a recipe of the given
+// visibility that the reviewer has reviewed. Only the admin has a share of
+// the recipe.
+func newReviewedRecipeRepo(visibility types.VisibilityLevel) *reviewRepo {
+	repo := newReviewRepo(noteRecipe(reviewRecipeId, visibility))
+	repo.access[reviewAdmin.AuthUserId] = acceptedAdmin
+	repo.reviews = []model.RecipeReview{{
+		Parent:   reviewParent,
+		Id:       model.RecipeReviewId{RecipeReviewId: 1},
+		AuthorId: model.UserId{UserId: reviewer.AuthUserId},
+		Rating:   4,
+	}}
+	return repo
+}
+
+func TestRecipeReviews_FollowRecipeVisibility(t *testing.T) {
+	ctx := context.Background()
+
+	d := newTestDomain(newReviewedRecipeRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC))
+	reviews, err := d.ListRecipeReviews(ctx, otherReviewer, reviewParent, 10, 0, nil)
+	if err != nil {
+		t.Fatalf("ListRecipeReviews() on a public recipe error = %v", err)
+	}
+	if len(reviews) != 1 {
+		t.Errorf("ListRecipeReviews() on a public recipe = %d reviews, want 1", len(reviews))
+	}
+	_, err = d.GetRecipeReview(ctx, otherReviewer, reviewParent, model.RecipeReviewId{RecipeReviewId: 1}, nil)
+	if err != nil {
+		t.Errorf("GetRecipeReview() on a public recipe error = %v", err)
+	}
+	_, err = d.CreateRecipeReview(ctx, otherReviewer, model.RecipeReview{Parent: reviewParent, Rating: 5})
+	if err != nil {
+		t.Errorf("CreateRecipeReview() on a public recipe error = %v", err)
+	}
+
+	d = newTestDomain(newReviewedRecipeRepo(types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED))
+	_, err = d.ListRecipeReviews(ctx, otherReviewer, reviewParent, 10, 0, nil)
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("ListRecipeReviews() on a restricted recipe error = %v, want permission denied", err)
+	}
+	_, err = d.CreateRecipeReview(ctx, otherReviewer, model.RecipeReview{Parent: reviewParent, Rating: 5})
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("CreateRecipeReview() on a restricted recipe error = %v, want permission denied", err)
+	}
+}
+
+func TestCreateRecipeReview_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		review  model.RecipeReview
+		wantErr any
+	}{
+		{name: "rating too low", review: model.RecipeReview{Parent: reviewParent, Rating: 0}, wantErr: &domain.ErrInvalidArgument{}},
+		{name: "rating too high", review: model.RecipeReview{Parent: reviewParent, Rating: 6}, wantErr: &domain.ErrInvalidArgument{}},
+		{name: "already reviewed", review: model.RecipeReview{Parent: reviewParent, Rating: 3}, wantErr: &domain.ErrInvalidArgument{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newReviewedRecipeRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+			d := newTestDomain(repo)
+
+			_, err := d.CreateRecipeReview(context.Background(), reviewer, tt.review)
+			if !errors.As(err, tt.wantErr) {
+				t.Fatalf("CreateRecipeReview() error = %v, want %T", err, tt.wantErr)
+			}
+			if len(repo.reviews) != 1 {
+				t.Errorf("reviews = %d, want the existing one only", len(repo.reviews))
+			}
+		})
+	}
+}
+
+func TestUpdateRecipeReview_AuthorOnly(t *testing.T) {
+	fields := []string{model.RecipeReviewField_Rating}
+	review := model.RecipeReview{Parent: reviewParent, Id: model.RecipeReviewId{RecipeReviewId: 1}, Rating: 2}
+
+	tests := []struct {
+		name    string
+		caller  model.AuthAccount
+		rating  int32
+		wantErr any
+	}{
+		{name: "author", caller: reviewer, rating: 2},
+		{name: "recipe admin", caller: reviewAdmin, rating: 2, wantErr: &domain.ErrPermissionDenied{}},
+		{name: "other user", caller: otherReviewer, rating: 2, wantErr: &domain.ErrPermissionDenied{}},
+		{name: "rating out of range", caller: reviewer, rating: 9, wantErr: &domain.ErrInvalidArgument{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newReviewedRecipeRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+			d := newTestDomain(repo)
+
+			review.Rating = tt.rating
+			_, err := d.UpdateRecipeReview(context.Background(), tt.caller, review, fields)
+			if tt.wantErr != nil {
+				if !errors.As(err, tt.wantErr) {
+					t.Fatalf("UpdateRecipeReview() error = %v, want %T", err, tt.wantErr)
+				}
+				if repo.reviews[0].Rating != 4 {
+					t.Errorf("rating = %d, want it unchanged", repo.reviews[0].Rating)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateRecipeReview() error = %v", err)
+			}
+			if repo.reviews[0].Rating != tt.rating {
+				t.Errorf("rating = %d, want %d", repo.reviews[0].Rating, tt.rating)
+			}
+		})
+	}
+}
+
+func TestDeleteRecipeReview_AuthorOrAdmin(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  model.AuthAccount
+		wantErr bool
+	}{
+		{name: "author", caller: reviewer},
+		{name: "recipe admin", caller: reviewAdmin},
+		{name: "other user", caller: otherReviewer, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newReviewedRecipeRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC)
+			d := newTestDomain(repo)
+
+			_, err := d.DeleteRecipeReview(context.Background(), tt.caller, reviewParent, model.RecipeReviewId{RecipeReviewId: 1})
+			if tt.wantErr {
+				if !errors.As(err, &domain.ErrPermissionDenied{}) {
+					t.Fatalf("DeleteRecipeReview() error = %v, want permission denied", err)
+				}
+				if len(repo.reviews) != 1 {
+					t.Errorf("reviews = %d, want the review kept", len(repo.reviews))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteRecipeReview() error = %v", err)
+			}
+			if len(repo.reviews) != 0 {
+				t.Errorf("reviews = %d, want the review deleted", len(repo.reviews))
+			}
+		})
+	}
+}
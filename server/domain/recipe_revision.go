@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/jcfug8/daylear/server/core/logutil"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipediff"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// GetRecipeRevision gets a recipe revision.
+func (d *Domain) GetRecipeRevision(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeRevisionParent, id model.RecipeRevisionId, fields []string) (revision model.RecipeRevision, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeRevisionId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeRevision{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	err = d.checkRecipeRevisionAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeRevision{}, err
+	}
+
+	revision, err = d.repo.GetRecipeRevision(ctx, parent, id, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeRevision failed")
+		return model.RecipeRevision{}, err
+	}
+
+	return revision, nil
+}
+
+// ListRecipeRevisions lists the revisions of a recipe, newest first.
+func (d *Domain) ListRecipeRevisions(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeRevisionParent, pageSize int32, offset int64, fields []string) (revisions []model.RecipeRevision, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+
+	err = d.checkRecipeRevisionAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err = d.repo.ListRecipeRevisions(ctx, parent, pageSize, offset, fields)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.ListRecipeRevisions failed")
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// DiffRecipeRevisions compares a revision with a base revision. When no base
+// revision is given the revision before it is used.
+func (d *Domain) DiffRecipeRevisions(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeRevisionParent, id model.RecipeRevisionId, baseId model.RecipeRevisionId) (base model.RecipeRevision, target model.RecipeRevision, diff model.RecipeDiff, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if id.RecipeRevisionId == 0 {
+		log.Warn().Msg("id required")
+		return model.RecipeRevision{}, model.RecipeRevision{}, model.RecipeDiff{}, domain.ErrInvalidArgument{Msg: "id required"}
+	}
+
+	err = d.checkRecipeRevisionAccess(ctx, authAccount, parent.RecipeId)
+	if err != nil {
+		return model.RecipeRevision{}, model.RecipeRevision{}, model.RecipeDiff{}, err
+	}
+
+	target, err = d.repo.GetRecipeRevision(ctx, parent, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeRevision failed")
+		return model.RecipeRevision{}, model.RecipeRevision{}, model.RecipeDiff{}, err
+	}
+
+	if baseId.RecipeRevisionId != 0 {
+		base, err = d.repo.GetRecipeRevision(ctx, parent, baseId, nil)
+		if err != nil {
+			log.Error().Err(err).Msg("repo.GetRecipeRevision failed")
+			return model.RecipeRevision{}, model.RecipeRevision{}, model.RecipeDiff{}, err
+		}
+	} else {
+		base, err = d.repo.GetPreviousRecipeRevision(ctx, parent, id, nil)
+		// the first revision is compared with an empty recipe
+		if _, ok := err.(repository.ErrNotFound); ok {
+			base, err = model.RecipeRevision{Parent: parent}, nil
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("repo.GetPreviousRecipeRevision failed")
+			return model.RecipeRevision{}, model.RecipeRevision{}, model.RecipeDiff{}, err
+		}
+	}
+
+	return base, target, recipediff.Diff(base.Recipe, target.Recipe), nil
+}
+
+// RevertRecipe restores the fields of a recipe to how they were in a revision.
+// The revert is an update of its own and is recorded as a new revision. The
+// image is left as is since replaced images are removed from the file store.
+func (d *Domain) RevertRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, revisionId model.RecipeRevisionId) (dbRecipe model.Recipe, err error) {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 || revisionId.RecipeRevisionId == 0 {
+		log.Warn().Msg("id and revision required")
+		return model.Recipe{}, domain.ErrInvalidArgument{Msg: "id and revision required"}
+	}
+
+	current, err := d.repo.GetRecipe(ctx, authAccount, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return model.Recipe{}, err
+	}
+
+	_, err = d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(current.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_WRITE),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine recipe access")
+		return model.Recipe{}, err
+	}
+
+	revision, err := d.repo.GetRecipeRevision(ctx, model.RecipeRevisionParent{RecipeId: id}, revisionId, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipeRevision failed")
+		return model.Recipe{}, err
+	}
+
+	fields := slices.DeleteFunc(recipediff.Diff(current, revision.Recipe).ChangedFields, func(field string) bool {
+		return field == model.RecipeField_ImageURI
+	})
+	if len(fields) == 0 {
+		return current, nil
+	}
+
+	recipe := revision.Recipe
+	recipe.Id = id
+	recipe.ImageURI = current.ImageURI
+
+	return d.UpdateRecipe(ctx, authAccount, recipe, fields)
+}
+
+// checkRecipeRevisionAccess checks that the caller can see the recipe the
+// revisions belong to, like GetRecipe. Only reverting needs write access.
+func (d *Domain) checkRecipeRevisionAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	if authAccount.AuthUserId == 0 {
+		log.Warn().Msg("user id required")
+		return domain.ErrInvalidArgument{Msg: "user id required"}
+	}
+
+	if id.RecipeId == 0 {
+		log.Warn().Msg("recipe id required")
+		return domain.ErrInvalidArgument{Msg: "recipe id required"}
+	}
+
+	recipe, err := d.repo.GetRecipe(ctx, authAccount, id, []string{model.RecipeField_VisibilityLevel})
+	if err != nil {
+		log.Error().Err(err).Msg("repo.GetRecipe failed")
+		return err
+	}
+
+	_, err = d.determineRecipeAccess(
+		ctx, authAccount, id,
+		withResourceVisibilityLevel(recipe.VisibilityLevel),
+		withMinimumPermissionLevel(types.PermissionLevel_PERMISSION_LEVEL_PUBLIC),
+		withAllowPendingAccess(),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to determine recipe access")
+		return err
+	}
+
+	return nil
+}
+
+// recordRecipeRevision stores a snapshot of the recipe as it is in the
+// transaction. Recipes created before revisions were recorded get a baseline
+// revision of their previous state first, so the change can be diffed.
+func (d *Domain) recordRecipeRevision(ctx context.Context, tx repository.TxClient, authAccount model.AuthAccount, id model.RecipeId, updateMask []string, previous *model.Recipe) error {
+	log := logutil.EnrichLoggerWithContext(d.log, ctx)
+	parent := model.RecipeRevisionParent{RecipeId: id}
+
+	if previous != nil {
+		existing, err := tx.ListRecipeRevisions(ctx, parent, 1, 0, []string{model.RecipeRevisionField_Id})
+		if err != nil {
+			log.Error().Err(err).Msg("tx.ListRecipeRevisions failed")
+			return err
+		}
+		if len(existing) == 0 {
+			_, err = tx.CreateRecipeRevision(ctx, model.RecipeRevision{
+				Parent: parent,
+				Recipe: *previous,
+			})
+			if err != nil {
+				log.Error().Err(err).Msg("tx.CreateRecipeRevision failed")
+				return err
+			}
+		}
+	}
+
+	snapshot, err := tx.GetRecipe(ctx, authAccount, id, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("tx.GetRecipe failed")
+		return err
+	}
+
+	_, err = tx.CreateRecipeRevision(ctx, model.RecipeRevision{
+		Parent:     parent,
+		EditorId:   model.UserId{UserId: authAccount.AuthUserId},
+		UpdateMask: updateMask,
+		Recipe:     snapshot,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("tx.CreateRecipeRevision failed")
+		return err
+	}
+
+	return nil
+}
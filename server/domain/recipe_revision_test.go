@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// revisionRepo adds the revisions of the recipes to a noteRepo.
+type revisionRepo struct {
+	*noteRepo
+	revisions []model.RecipeRevision
+}
+
+func (r *revisionRepo) GetRecipeRevision(ctx context.Context, parent model.RecipeRevisionParent, id model.RecipeRevisionId, fields []string) (model.RecipeRevision, error) {
+	for _, revision := range r.revisions {
+		if revision.Parent == parent && revision.Id == id {
+			return revision, nil
+		}
+	}
+	return model.RecipeRevision{}, repository.ErrNotFound{}
+}
+
+func (r *revisionRepo) ListRecipeRevisions(ctx context.Context, parent model.RecipeRevisionParent, pageSize int32, offset int64, fields []string) ([]model.RecipeRevision, error) {
+	revisions := []model.RecipeRevision{}
+	for _, revision := range r.revisions {
+		if revision.Parent == parent {
+			revisions = append(revisions, revision)
+		}
+	}
+	return revisions, nil
+}
+
+var revisionParent = model.RecipeRevisionParent{RecipeId: noteRecipeId}
+
+// newRevisedRecipeRepo creates a repository with a revision of a recipe of
+// the given visibility. No one has a share of the recipe.
+func newRevisedRecipeRepo(visibility types.VisibilityLevel) *revisionRepo {
+	recipe := noteRecipe(noteRecipeId, visibility)
+	return &revisionRepo{
+		noteRepo:  newNoteRepo(recipe),
+		revisions: []model.RecipeRevision{{Parent: revisionParent, Id: model.RecipeRevisionId{RecipeRevisionId: 1}, Recipe: recipe}},
+	}
+}
+
+func TestRecipeRevisions_FollowRecipeVisibility(t *testing.T) {
+	ctx := context.Background()
+	revisionId := model.RecipeRevisionId{RecipeRevisionId: 1}
+
+	d := newTestDomain(newRevisedRecipeRepo(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC))
+	revisions, err := d.ListRecipeRevisions(ctx, noteReader, revisionParent, 10, 0, nil)
+	if err != nil || len(revisions) != 1 {
+		t.Errorf("ListRecipeRevisions() on a public recipe = (%d, %v), want the revision", len(revisions), err)
+	}
+	_, err = d.GetRecipeRevision(ctx, noteReader, revisionParent, revisionId, nil)
+	if err != nil {
+		t.Errorf("GetRecipeRevision() on a public recipe error = %v", err)
+	}
+	_, err = d.RevertRecipe(ctx, noteReader, noteRecipeId, revisionId)
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("RevertRecipe() on a public recipe error = %v, want permission denied", err)
+	}
+
+	d = newTestDomain(newRevisedRecipeRepo(types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED))
+	_, err = d.ListRecipeRevisions(ctx, noteReader, revisionParent, 10, 0, nil)
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("ListRecipeRevisions() on a restricted recipe error = %v, want permission denied", err)
+	}
+	_, err = d.GetRecipeRevision(ctx, noteReader, revisionParent, revisionId, nil)
+	if !errors.As(err, &domain.ErrPermissionDenied{}) {
+		t.Errorf("GetRecipeRevision() on a restricted recipe error = %v, want permission denied", err)
+	}
+}
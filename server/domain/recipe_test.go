@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/genapi/api/types"
+	domain "github.com/jcfug8/daylear/server/ports/domain"
+	"github.com/jcfug8/daylear/server/ports/repository"
+)
+
+// forkRepo holds a source recipe, the access of the caller to it and the
+// recipes and images created by forking it.
+type forkRepo struct {
+	repository.Client
+
+	recipes        map[int64]model.Recipe
+	images         []model.RecipeImage
+	standardAccess model.RecipeAccess
+	userAccess     model.RecipeAccess
+}
+
+func newForkRepo(source model.Recipe) *forkRepo {
+	return &forkRepo{recipes: map[int64]model.Recipe{source.Id.RecipeId: source}}
+}
+
+func (r *forkRepo) Begin(ctx context.Context) (repository.TxClient, error) {
+	return fakeTx{r}, nil
+}
+
+func (r *forkRepo) GetRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, fields []string) (model.Recipe, error) {
+	recipe, ok := r.recipes[id.RecipeId]
+	if !ok {
+		return model.Recipe{}, repository.ErrNotFound{}
+	}
+	for _, other := range r.recipes {
+		if other.ForkedFrom == id {
+			recipe.ForkCount++
+		}
+	}
+	return recipe, nil
+}
+
+func (r *forkRepo) CreateRecipe(ctx context.Context, recipe model.Recipe, fields []string) (model.Recipe, error) {
+	recipe.Id.RecipeId = int64(len(r.recipes) + 1)
+	recipe.Images = nil
+	r.recipes[recipe.Id.RecipeId] = recipe
+	return recipe, nil
+}
+
+func (r *forkRepo) SetRecipeIngredients(ctx context.Context, id model.RecipeId, ingredientIds []model.IngredientId) error {
+	return nil
+}
+
+func (r *forkRepo) CreateRecipeRevision(ctx context.Context, revision model.RecipeRevision) (model.RecipeRevision, error) {
+	return revision, nil
+}
+
+func (r *forkRepo) CreateRecipeAccess(ctx context.Context, access model.RecipeAccess, fields []string) (model.RecipeAccess, error) {
+	return access, nil
+}
+
+func (r *forkRepo) FindStandardUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error) {
+	if r.standardAccess.PermissionLevel == types.PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED {
+		return model.RecipeAccess{}, repository.ErrNotFound{}
+	}
+	return r.standardAccess, nil
+}
+
+func (r *forkRepo) FindDelegatedCircleRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.CircleAccess, error) {
+	return model.RecipeAccess{}, model.CircleAccess{}, repository.ErrNotFound{}
+}
+
+func (r *forkRepo) FindDelegatedUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, model.UserAccess, error) {
+	if r.userAccess.PermissionLevel == types.PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED {
+		return model.RecipeAccess{}, model.UserAccess{}, repository.ErrNotFound{}
+	}
+	return r.userAccess, model.UserAccess{}, nil
+}
+
+func (r *forkRepo) ListRecipeImages(ctx context.Context, parent model.RecipeImageParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImage, error) {
+	images := []model.RecipeImage{}
+	for _, image := range r.images {
+		if image.Parent == parent {
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+func (r *forkRepo) CreateRecipeImage(ctx context.Context, image model.RecipeImage) (model.RecipeImage, error) {
+	image.Id.RecipeImageId = int64(len(r.images) + 1)
+	r.images = append(r.images, image)
+	return image, nil
+}
+
+var (
+	forkSourceId = model.RecipeId{RecipeId: 1}
+	forkCaller   = model.AuthAccount{AuthUserId: 2, UserId: 2}
+	forkParent   = model.RecipeParent{UserId: 2}
+)
+
+func forkSource(visibility types.VisibilityLevel) model.Recipe {
+	return model.Recipe{
+		Id:              forkSourceId,
+		Title:           "Pancakes",
+		VisibilityLevel: visibility,
+		Directions: []model.RecipeDirection{
+			{Title: "Batter", Steps: []string{"Whisk", "Rest"}},
+		},
+	}
+}
+
+var acceptedRead = model.RecipeAccess{
+	PermissionLevel: types.PermissionLevel_PERMISSION_LEVEL_READ,
+	State:           types.AccessState_ACCESS_STATE_ACCEPTED,
+}
+
+func TestForkRecipe_Visibility(t *testing.T) {
+	tests := []struct {
+		name           string
+		visibility     types.VisibilityLevel
+		standardAccess model.RecipeAccess
+		userAccess     model.RecipeAccess
+		allowed        bool
+	}{
+		{name: "public without access", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC, allowed: true},
+		{name: "restricted without access", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED},
+		{name: "restricted through a connection", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED, userAccess: acceptedRead, allowed: true},
+		{name: "restricted shared with the caller", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED, standardAccess: acceptedRead, allowed: true},
+		{name: "hidden through a connection", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, userAccess: acceptedRead},
+		{name: "hidden shared with the caller", visibility: types.VisibilityLevel_VISIBILITY_LEVEL_HIDDEN, standardAccess: acceptedRead, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newForkRepo(forkSource(tt.visibility))
+			repo.standardAccess = tt.standardAccess
+			repo.userAccess = tt.userAccess
+			d := newTestDomain(repo)
+
+			fork, err := d.ForkRecipe(context.Background(), forkCaller, forkParent, forkSourceId)
+			if !tt.allowed {
+				if !errors.As(err, &domain.ErrPermissionDenied{}) {
+					t.Fatalf("ForkRecipe() error = %v, want permission denied", err)
+				}
+				if len(repo.recipes) != 1 {
+					t.Errorf("ForkRecipe() created %d recipes, want none", len(repo.recipes)-1)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ForkRecipe() error = %v", err)
+			}
+
+			if fork.Id == forkSourceId {
+				t.Errorf("fork id = %v, want a new recipe", fork.Id)
+			}
+			if fork.ForkedFrom != forkSourceId {
+				t.Errorf("fork forked from = %v, want %v", fork.ForkedFrom, forkSourceId)
+			}
+			if fork.Title != "Pancakes" || fork.VisibilityLevel != tt.visibility {
+				t.Errorf("fork = (%q, %v), want the title and visibility of the source", fork.Title, fork.VisibilityLevel)
+			}
+			if fork.RecipeAccess.Recipient.UserId != forkCaller.AuthUserId || fork.RecipeAccess.PermissionLevel != types.PermissionLevel_PERMISSION_LEVEL_ADMIN {
+				t.Errorf("fork access = %+v, want admin access for the caller", fork.RecipeAccess)
+			}
+		})
+	}
+}
+
+func TestForkRecipe_ForkCount(t *testing.T) {
+	repo := newForkRepo(forkSource(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC))
+	d := newTestDomain(repo)
+
+	for range 2 {
+		_, err := d.ForkRecipe(context.Background(), forkCaller, forkParent, forkSourceId)
+		if err != nil {
+			t.Fatalf("ForkRecipe() error = %v", err)
+		}
+	}
+
+	source, err := repo.GetRecipe(context.Background(), forkCaller, forkSourceId, nil)
+	if err != nil {
+		t.Fatalf("GetRecipe() error = %v", err)
+	}
+	if source.ForkCount != 2 {
+		t.Errorf("source fork count = %d, want 2", source.ForkCount)
+	}
+}
+
+func TestForkRecipe_CopiesStepImages(t *testing.T) {
+	repo := newForkRepo(forkSource(types.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC))
+	sourceParent := model.RecipeImageParent{RecipeId: forkSourceId}
+	step := &model.RecipeDirectionStep{DirectionIndex: 0, StepIndex: 1}
+	repo.images = []model.RecipeImage{
+		{Parent: sourceParent, Id: model.RecipeImageId{RecipeImageId: 1}, ImageURI: "files/recipes/1/gallery/large.jpeg", Position: 0},
+		{Parent: sourceParent, Id: model.RecipeImageId{RecipeImageId: 2}, ImageURI: "files/recipes/1/step/large.jpeg", Position: 1, Step: step},
+	}
+	fileStore := &fakeFileStore{}
+	d := newTestDomain(repo)
+	d.fileStore = fileStore
+	d.fileRetriever = fakeFileRetriever{}
+	d.imageClient = fakeImageClient{}
+
+	fork, err := d.ForkRecipe(context.Background(), forkCaller, forkParent, forkSourceId)
+	if err != nil {
+		t.Fatalf("ForkRecipe() error = %v", err)
+	}
+
+	forkImages, err := repo.ListRecipeImages(context.Background(), model.RecipeImageParent{RecipeId: fork.Id}, 0, 0, nil)
+	if err != nil {
+		t.Fatalf("ListRecipeImages() error = %v", err)
+	}
+	if len(forkImages) != 1 {
+		t.Fatalf("fork has %d images, want only the step image", len(forkImages))
+	}
+
+	image := forkImages[0]
+	if image.Step == nil || *image.Step != *step || image.Position != 1 {
+		t.Errorf("fork image step = %v at %d, want %v at 1", image.Step, image.Position, step)
+	}
+	if image.ImageURI == "" || image.ImageURI == repo.images[1].ImageURI {
+		t.Errorf("fork image uri = %q, want a copy of the source file", image.ImageURI)
+	}
+	for _, uploaded := range fileStore.uploaded {
+		if !strings.HasPrefix(uploaded, RecipeImageRoot+"/2/") {
+			t.Errorf("uploaded %q, want it stored under the fork", uploaded)
+		}
+	}
+}
@@ -118,6 +118,28 @@ func (d *Domain) determineAccess(ctx context.Context, authAccount model.AuthAcco
 			return d.repo.FindDelegatedUserListAccess(ctx, authAccount, i)
 		}
 		determinedAccess = model.ListAccess{}
+	case model.PantryId:
+		config.findStandardUserAccess = func() (model.Access, error) {
+			return d.repo.FindStandardUserPantryAccess(ctx, authAccount, i)
+		}
+		config.findDelegatedCircleAccess = func() (model.Access, model.CircleAccess, error) {
+			return d.repo.FindDelegatedCirclePantryAccess(ctx, authAccount, i)
+		}
+		config.findDelegatedUserAccess = func() (model.Access, model.UserAccess, error) {
+			return d.repo.FindDelegatedUserPantryAccess(ctx, authAccount, i)
+		}
+		determinedAccess = model.PantryAccess{}
+	case model.CookbookId:
+		config.findStandardUserAccess = func() (model.Access, error) {
+			return d.repo.FindStandardUserCookbookAccess(ctx, authAccount, i)
+		}
+		config.findDelegatedCircleAccess = func() (model.Access, model.CircleAccess, error) {
+			return d.repo.FindDelegatedCircleCookbookAccess(ctx, authAccount, i)
+		}
+		config.findDelegatedUserAccess = func() (model.Access, model.UserAccess, error) {
+			return d.repo.FindDelegatedUserCookbookAccess(ctx, authAccount, i)
+		}
+		determinedAccess = model.CookbookAccess{}
 	case model.UserId:
 		config.findStandardUserAccess = func() (model.Access, error) {
 			// if the user is requesting access to their own user, return the admin access
@@ -243,3 +265,21 @@ func (d *Domain) determineListAccess(ctx context.Context, authAccount model.Auth
 	}
 	return access.(model.ListAccess), nil
 }
+
+// determinePantryAccess - convenience function to determine the pantry access for a given pantry id that wraps the determineAccess function
+func (d *Domain) determinePantryAccess(ctx context.Context, authAccount model.AuthAccount, pantryId model.PantryId, options ...determineAccessOption) (model.PantryAccess, error) {
+	access, err := d.determineAccess(ctx, authAccount, pantryId, options...)
+	if err != nil {
+		return model.PantryAccess{}, err
+	}
+	return access.(model.PantryAccess), nil
+}
+
+// determineCookbookAccess - convenience function to determine the cookbook access for a given cookbook id that wraps the determineAccess function
+func (d *Domain) determineCookbookAccess(ctx context.Context, authAccount model.AuthAccount, cookbookId model.CookbookId, options ...determineAccessOption) (model.CookbookAccess, error) {
+	access, err := d.determineAccess(ctx, authAccount, cookbookId, options...)
+	if err != nil {
+		return model.CookbookAccess{}, err
+	}
+	return access.(model.CookbookAccess), nil
+}
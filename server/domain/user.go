@@ -4,18 +4,15 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strings"
 
 	"io"
-	"path"
-	"strconv"
 
 	"github.com/jcfug8/daylear/server/core/logutil"
 	model "github.com/jcfug8/daylear/server/core/model"
 	"github.com/jcfug8/daylear/server/genapi/api/types"
 	domain "github.com/jcfug8/daylear/server/ports/domain"
-
-	uuid "github.com/satori/go.uuid"
 )
 
 const UserImageRoot = "users"
@@ -80,6 +77,13 @@ func (d *Domain) ListUsers(ctx context.Context, authAccount model.AuthAccount, p
 		return nil, err
 	}
 
+	// dietary restrictions are only visible to the user themself
+	for i := range users {
+		if users[i].Id.UserId != authAccount.AuthUserId {
+			users[i].DietaryRestrictions = model.DietaryRestrictions{}
+		}
+	}
+
 	return users, nil
 }
 
@@ -102,6 +106,19 @@ func (d *Domain) UpdateUser(ctx context.Context, authAccount model.AuthAccount,
 		return model.User{}, err
 	}
 
+	// the image set is generated from the uploaded image
+	updateMask = slices.DeleteFunc(slices.Clone(updateMask), func(field string) bool {
+		return field == model.UserField_ImageSet
+	})
+
+	if slices.Contains(updateMask, model.UserField_DietaryRestrictions) {
+		err = validateDietaryRestrictions(user.DietaryRestrictions)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid dietary restrictions")
+			return model.User{}, err
+		}
+	}
+
 	dbUser, err = d.repo.UpdateUser(ctx, authAccount, user, updateMask)
 	if err != nil {
 		return model.User{}, err
@@ -135,6 +152,11 @@ func (d *Domain) GetUser(ctx context.Context, authAccount model.AuthAccount, par
 		return model.User{}, err
 	}
 
+	// dietary restrictions are only visible to the user themself
+	if id.UserId != authAccount.AuthUserId {
+		dbUser.DietaryRestrictions = model.DietaryRestrictions{}
+	}
+
 	return dbUser, nil
 }
 
@@ -262,61 +284,29 @@ func (d *Domain) UploadUserImage(ctx context.Context, authAccount model.AuthAcco
 		return "", err
 	}
 
-	user, err := d.repo.GetUser(ctx, authAccount, id, []string{model.UserField_ImageUri})
+	user, err := d.repo.GetUser(ctx, authAccount, id, []string{model.UserField_ImageUri, model.UserField_ImageSet})
 	if err != nil {
 		return "", err
 	}
-	oldImageURI := user.ImageUri
 
-	imageURI, err = d.uploadUserImage(ctx, id, imageReader)
+	imageURI, imageSet, err := d.uploadUserImage(ctx, id, imageReader)
 	if err != nil {
 		return "", err
 	}
 	_, err = d.repo.UpdateUser(ctx, authAccount, model.User{
 		Id:       id,
 		ImageUri: imageURI,
-	}, []string{model.UserField_ImageUri})
+		ImageSet: imageSet,
+	}, []string{model.UserField_ImageUri, model.UserField_ImageSet})
 	if err != nil {
 		return "", err
 	}
-	if oldImageURI != "" && oldImageURI != imageURI {
-		go d.fileStore.DeleteFile(context.Background(), oldImageURI)
-	}
+	go d.deleteImageFiles(context.Background(), user.ImageUri, user.ImageSet)
 	return imageURI, nil
 }
 
-func (d *Domain) uploadUserImage(ctx context.Context, id model.UserId, imageReader io.Reader) (imageURL string, err error) {
-	image, err := d.imageClient.CreateImage(ctx, imageReader)
-	if err != nil {
-		return "", err
-	}
-	err = image.Convert(ctx, "jpg")
-	if err != nil {
-		return "", err
-	}
-	width, height, err := image.GetDimensions(ctx)
-	if err != nil {
-		return "", err
-	}
-	if width > maxImageWidth || height > maxImageHeight {
-		newWidth, newHeight := resizeToFit(width, height, maxImageWidth)
-		err = image.Resize(ctx, newWidth, newHeight)
-		if err != nil {
-			return "", err
-		}
-	}
-	file, err := image.GetFile()
-	if err != nil {
-		return "", err
-	}
-	defer image.Remove(ctx)
-	imagePath := path.Join(UserImageRoot, strconv.FormatInt(id.UserId, 10), uuid.NewV4().String())
-	imagePath = imagePath + file.Extension
-	imageURI, err := d.fileStore.UploadPublicFile(ctx, imagePath, file)
-	if err != nil {
-		return "", err
-	}
-	return imageURI, nil
+func (d *Domain) uploadUserImage(ctx context.Context, id model.UserId, imageReader io.Reader) (imageURI string, imageSet model.ImageSet, err error) {
+	return d.uploadImage(ctx, UserImageRoot, id.UserId, imageReader)
 }
 
 // FavoriteUser adds a user to the current user's favorites.
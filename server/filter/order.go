@@ -0,0 +1,37 @@
+package filter
+
+import (
+	"fmt"
+
+	"go.einride.tech/aip/ordering"
+)
+
+// OrderByColumn is a SQL column to sort by
+type OrderByColumn struct {
+	Column string
+	Desc   bool
+}
+
+// ConvertOrderBy parses an AIP-132 order_by expression and converts it to SQL
+// columns using the field mapping of the converter
+func (c *SQLConverter) ConvertOrderBy(orderBy string) ([]OrderByColumn, error) {
+	if orderBy == "" {
+		return nil, nil
+	}
+
+	var parsed ordering.OrderBy
+	if err := parsed.UnmarshalString(orderBy); err != nil {
+		return nil, fmt.Errorf("failed to parse order_by: %w", err)
+	}
+
+	columns := make([]OrderByColumn, 0, len(parsed.Fields))
+	for _, field := range parsed.Fields {
+		sqlField, ok := c.FieldMapping[field.Path]
+		if !ok {
+			return nil, fmt.Errorf("field not found in field mapping: %s", field.Path)
+		}
+		columns = append(columns, OrderByColumn{Column: sqlField.String(), Desc: field.Desc})
+	}
+
+	return columns, nil
+}
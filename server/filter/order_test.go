@@ -0,0 +1,36 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLConverter_ConvertOrderBy(t *testing.T) {
+	converter := newTestConverter()
+	got, err := converter.ConvertOrderBy("score desc, name")
+	assert.NoError(t, err)
+	assert.Equal(t, []OrderByColumn{
+		{Column: "user_score", Desc: true},
+		{Column: "user_name"},
+	}, got)
+}
+
+func TestSQLConverter_ConvertOrderBy_Empty(t *testing.T) {
+	converter := newTestConverter()
+	got, err := converter.ConvertOrderBy("")
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestSQLConverter_ConvertOrderBy_InvalidField(t *testing.T) {
+	converter := newTestConverter()
+	_, err := converter.ConvertOrderBy("password desc")
+	assert.Error(t, err)
+}
+
+func TestSQLConverter_ConvertOrderBy_InvalidSyntax(t *testing.T) {
+	converter := newTestConverter()
+	_, err := converter.ConvertOrderBy("score sideways")
+	assert.Error(t, err)
+}
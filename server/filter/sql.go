@@ -112,6 +112,12 @@ func (c *Conversion) addParam(value interface{}) string {
 	return fmt.Sprintf("$%d", paramIndex+1)
 }
 
+// AddParam adds a parameter to the conversion and returns its placeholder.
+// It allows CustomConverters to bind values instead of inlining them.
+func (c *Conversion) AddParam(value interface{}) string {
+	return c.addParam(value)
+}
+
 func (c *Conversion) convertWildcardString(value string) string {
 	return strings.ReplaceAll(value, "*", "%")
 }
@@ -158,6 +164,10 @@ func (c *Conversion) convertExpression(expr *expr.Expr) (string, error) {
 		return c.addParam(value), nil
 	}
 	if ident := expr.GetIdentExpr(); ident != nil {
+		// a bare field is shorthand for comparing it to true
+		if _, ok := c.FieldMapping[ident.Name]; ok {
+			return c.convertCallExpr(isTrueCall(expr))
+		}
 		value, err := c.convertIdentExpr(ident)
 		if err != nil {
 			return "", err
@@ -167,6 +177,17 @@ func (c *Conversion) convertExpression(expr *expr.Expr) (string, error) {
 	return "", fmt.Errorf(errUnsupportedExpr, expr.ExprKind)
 }
 
+// isTrueCall builds the comparison of a field expression to true.
+func isTrueCall(field *expr.Expr) *expr.Expr_Call {
+	return &expr.Expr_Call{
+		Function: "=",
+		Args: []*expr.Expr{
+			field,
+			{ExprKind: &expr.Expr_ConstExpr{ConstExpr: &expr.Constant{ConstantKind: &expr.Constant_BoolValue{BoolValue: true}}}},
+		},
+	}
+}
+
 func (c *Conversion) convertCallExpr(call *expr.Expr_Call) (string, error) {
 	function := call.Function
 	args := call.Args
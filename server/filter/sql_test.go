@@ -135,6 +135,15 @@ func TestSQLConverter_BooleanValue(t *testing.T) {
 	assert.Equal(t, map[string]int{"is_active": 1}, got.UsedColumns)
 }
 
+func TestSQLConverter_BareField(t *testing.T) {
+	converter := newTestConverter()
+	got, err := converter.Convert("is_active AND NOT age > 18")
+	assert.NoError(t, err)
+	assert.Equal(t, "is_active = $1 AND user_age <= $2", got.WhereClause)
+	assert.Equal(t, []interface{}{true, int64(18)}, got.Params)
+	assert.Equal(t, map[string]int{"is_active": 1, "user_age": 1}, got.UsedColumns)
+}
+
 // durations don't work as expected because the einride/aip-go parser doesn't support them
 // func TestSQLConverter_DurationValue(t *testing.T) {
 // 	converter := newTestConverter()
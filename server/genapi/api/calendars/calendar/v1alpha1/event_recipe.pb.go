@@ -33,7 +33,9 @@ type EventRecipe struct {
 	// the name of the recipe
 	Recipe string `protobuf:"bytes,2,opt,name=recipe,proto3" json:"recipe,omitempty"`
 	// the create time of the event recipe
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the recipe of the event was marked as done, unset when it is not done
+	DoneTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=done_time,json=doneTime,proto3" json:"done_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +91,13 @@ func (x *EventRecipe) GetCreateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EventRecipe) GetDoneTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DoneTime
+	}
+	return nil
+}
+
 // the request to create an event recipe
 type CreateEventRecipeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -364,16 +373,145 @@ func (x *ListEventRecipesResponse) GetNextPageToken() string {
 	return ""
 }
 
+// the request to mark an event recipe as done
+type MarkEventRecipeDoneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the event recipe
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// whether to create a cook log of the recipe for the caller
+	CreateCookLog bool `protobuf:"varint,2,opt,name=create_cook_log,json=createCookLog,proto3" json:"create_cook_log,omitempty"`
+	// the rating of the cook log, from 1 to 5, or 0 when not rated
+	Rating int32 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// the notes of the cook log
+	Notes         string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkEventRecipeDoneRequest) Reset() {
+	*x = MarkEventRecipeDoneRequest{}
+	mi := &file_api_calendars_calendar_v1alpha1_event_recipe_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkEventRecipeDoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkEventRecipeDoneRequest) ProtoMessage() {}
+
+func (x *MarkEventRecipeDoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_calendars_calendar_v1alpha1_event_recipe_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkEventRecipeDoneRequest.ProtoReflect.Descriptor instead.
+func (*MarkEventRecipeDoneRequest) Descriptor() ([]byte, []int) {
+	return file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarkEventRecipeDoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarkEventRecipeDoneRequest) GetCreateCookLog() bool {
+	if x != nil {
+		return x.CreateCookLog
+	}
+	return false
+}
+
+func (x *MarkEventRecipeDoneRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *MarkEventRecipeDoneRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// the response to mark an event recipe as done
+type MarkEventRecipeDoneResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the event recipe
+	EventRecipe *EventRecipe `protobuf:"bytes,1,opt,name=event_recipe,json=eventRecipe,proto3" json:"event_recipe,omitempty"`
+	// the cook log created for the caller, if any
+	CookLog       string `protobuf:"bytes,2,opt,name=cook_log,json=cookLog,proto3" json:"cook_log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkEventRecipeDoneResponse) Reset() {
+	*x = MarkEventRecipeDoneResponse{}
+	mi := &file_api_calendars_calendar_v1alpha1_event_recipe_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkEventRecipeDoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkEventRecipeDoneResponse) ProtoMessage() {}
+
+func (x *MarkEventRecipeDoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_calendars_calendar_v1alpha1_event_recipe_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkEventRecipeDoneResponse.ProtoReflect.Descriptor instead.
+func (*MarkEventRecipeDoneResponse) Descriptor() ([]byte, []int) {
+	return file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarkEventRecipeDoneResponse) GetEventRecipe() *EventRecipe {
+	if x != nil {
+		return x.EventRecipe
+	}
+	return nil
+}
+
+func (x *MarkEventRecipeDoneResponse) GetCookLog() string {
+	if x != nil {
+		return x.CookLog
+	}
+	return ""
+}
+
 var File_api_calendars_calendar_v1alpha1_event_recipe_proto protoreflect.FileDescriptor
 
 const file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDesc = "" +
 	"\n" +
-	"2api/calendars/calendar/v1alpha1/event_recipe.proto\x12\x1fapi.calendars.calendar.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x95\x02\n" +
+	"2api/calendars/calendar/v1alpha1/event_recipe.proto\x12\x1fapi.calendars.calendar.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd3\x02\n" +
 	"\vEventRecipe\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
 	"\x06recipe\x18\x02 \x01(\tB\x03\xe0A\x02R\x06recipe\x12@\n" +
 	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"createTime:\x8d\x01\xeaA\x89\x01\n" +
+	"createTime\x12<\n" +
+	"\tdone_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\bdoneTime:\x8d\x01\xeaA\x89\x01\n" +
 	"+api.calendars.calendar.v1alpha1/EventRecipe\x12?calendars/{calendar}/events/{event}/eventRecipes/{event_recipe}*\feventRecipes2\veventRecipe\"\xbd\x01\n" +
 	"\x18CreateEventRecipeRequest\x12K\n" +
 	"\x06parent\x18\x01 \x01(\tB3\xe0A\x02\xfaA-\x12+api.calendars.calendar.v1alpha1/EventRecipeR\x06parent\x12T\n" +
@@ -392,7 +530,17 @@ const file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDesc = "" +
 	"\x06filter\x18\x04 \x01(\tB\x03\xe0A\x01R\x06filter\"\x95\x01\n" +
 	"\x18ListEventRecipesResponse\x12Q\n" +
 	"\revent_recipes\x18\x01 \x03(\v2,.api.calendars.calendar.v1alpha1.EventRecipeR\feventRecipes\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xfa\t\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xca\x01\n" +
+	"\x1aMarkEventRecipeDoneRequest\x12G\n" +
+	"\x04name\x18\x01 \x01(\tB3\xe0A\x02\xfaA-\n" +
+	"+api.calendars.calendar.v1alpha1/EventRecipeR\x04name\x12+\n" +
+	"\x0fcreate_cook_log\x18\x02 \x01(\bB\x03\xe0A\x01R\rcreateCookLog\x12\x1b\n" +
+	"\x06rating\x18\x03 \x01(\x05B\x03\xe0A\x01R\x06rating\x12\x19\n" +
+	"\x05notes\x18\x04 \x01(\tB\x03\xe0A\x01R\x05notes\"\xb7\x01\n" +
+	"\x1bMarkEventRecipeDoneResponse\x12O\n" +
+	"\fevent_recipe\x18\x01 \x01(\v2,.api.calendars.calendar.v1alpha1.EventRecipeR\veventRecipe\x12G\n" +
+	"\bcook_log\x18\x02 \x01(\tB,\xfaA)\n" +
+	"'api.meals.recipe.v1alpha1/RecipeCookLogR\acookLog2\xb8\r\n" +
 	"\x12EventRecipeService\x12\xd8\x02\n" +
 	"\x11CreateEventRecipe\x129.api.calendars.calendar.v1alpha1.CreateEventRecipeRequest\x1a,.api.calendars.calendar.v1alpha1.EventRecipe\"\xd9\x01\x92Al\n" +
 	"\x12EventRecipeService\x12\x16Create an event recipe\x1a>Creates a new event recipe for the specified event and recipe.\xdaA\x13parent,event_recipe\x82\xd3\xe4\x93\x02N:\fevent_recipe\">/calendars/v1alpha1/{parent=calendars/*/events/*}/eventRecipes\x12\xa4\x02\n" +
@@ -401,7 +549,9 @@ const file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDesc = "" +
 	"\x11DeleteEventRecipe\x129.api.calendars.calendar.v1alpha1.DeleteEventRecipeRequest\x1a,.api.calendars.calendar.v1alpha1.EventRecipe\"\xa7\x01\x92AW\n" +
 	"\x12EventRecipeService\x12\x16Delete an event recipe\x1a)Deletes an event recipe by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02@*>/calendars/v1alpha1/{name=calendars/*/events/*/eventRecipes/*}\x12\xb8\x02\n" +
 	"\x10ListEventRecipes\x128.api.calendars.calendar.v1alpha1.ListEventRecipesRequest\x1a9.api.calendars.calendar.v1alpha1.ListEventRecipesResponse\"\xae\x01\x92A\\\n" +
-	"\x12EventRecipeService\x12\x12List event recipes\x1a2Lists all event recipes in the specified calendar.\xdaA\x06parent\x82\xd3\xe4\x93\x02@\x12>/calendars/v1alpha1/{parent=calendars/*/events/*}/eventRecipesB\x8b\x03\x92AXZD\n" +
+	"\x12EventRecipeService\x12\x12List event recipes\x1a2Lists all event recipes in the specified calendar.\xdaA\x06parent\x82\xd3\xe4\x93\x02@\x12>/calendars/v1alpha1/{parent=calendars/*/events/*}/eventRecipes\x12\xbb\x03\n" +
+	"\x13MarkEventRecipeDone\x12;.api.calendars.calendar.v1alpha1.MarkEventRecipeDoneRequest\x1a<.api.calendars.calendar.v1alpha1.MarkEventRecipeDoneResponse\"\xa8\x02\x92A\xcb\x01\n" +
+	"\x12EventRecipeService\x12\x1cMark an event recipe as done\x1a\x96\x01Marks the recipe of an event as cooked. When create_cook_log is set a cook log of the recipe is created for the caller at the start time of the event.\xdaA\x04name\x82\xd3\xe4\x93\x02L:\x01*\"G/calendars/v1alpha1/{name=calendars/*/events/*/eventRecipes/*}:markDoneB\x8b\x03\x92AXZD\n" +
 	"B\n" +
 	"\n" +
 	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
@@ -422,33 +572,39 @@ func file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDescGZIP() []byt
 	return file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDescData
 }
 
-var file_api_calendars_calendar_v1alpha1_event_recipe_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_calendars_calendar_v1alpha1_event_recipe_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_api_calendars_calendar_v1alpha1_event_recipe_proto_goTypes = []any{
-	(*EventRecipe)(nil),              // 0: api.calendars.calendar.v1alpha1.EventRecipe
-	(*CreateEventRecipeRequest)(nil), // 1: api.calendars.calendar.v1alpha1.CreateEventRecipeRequest
-	(*GetEventRecipeRequest)(nil),    // 2: api.calendars.calendar.v1alpha1.GetEventRecipeRequest
-	(*DeleteEventRecipeRequest)(nil), // 3: api.calendars.calendar.v1alpha1.DeleteEventRecipeRequest
-	(*ListEventRecipesRequest)(nil),  // 4: api.calendars.calendar.v1alpha1.ListEventRecipesRequest
-	(*ListEventRecipesResponse)(nil), // 5: api.calendars.calendar.v1alpha1.ListEventRecipesResponse
-	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*EventRecipe)(nil),                 // 0: api.calendars.calendar.v1alpha1.EventRecipe
+	(*CreateEventRecipeRequest)(nil),    // 1: api.calendars.calendar.v1alpha1.CreateEventRecipeRequest
+	(*GetEventRecipeRequest)(nil),       // 2: api.calendars.calendar.v1alpha1.GetEventRecipeRequest
+	(*DeleteEventRecipeRequest)(nil),    // 3: api.calendars.calendar.v1alpha1.DeleteEventRecipeRequest
+	(*ListEventRecipesRequest)(nil),     // 4: api.calendars.calendar.v1alpha1.ListEventRecipesRequest
+	(*ListEventRecipesResponse)(nil),    // 5: api.calendars.calendar.v1alpha1.ListEventRecipesResponse
+	(*MarkEventRecipeDoneRequest)(nil),  // 6: api.calendars.calendar.v1alpha1.MarkEventRecipeDoneRequest
+	(*MarkEventRecipeDoneResponse)(nil), // 7: api.calendars.calendar.v1alpha1.MarkEventRecipeDoneResponse
+	(*timestamppb.Timestamp)(nil),       // 8: google.protobuf.Timestamp
 }
 var file_api_calendars_calendar_v1alpha1_event_recipe_proto_depIdxs = []int32{
-	6, // 0: api.calendars.calendar.v1alpha1.EventRecipe.create_time:type_name -> google.protobuf.Timestamp
-	0, // 1: api.calendars.calendar.v1alpha1.CreateEventRecipeRequest.event_recipe:type_name -> api.calendars.calendar.v1alpha1.EventRecipe
-	0, // 2: api.calendars.calendar.v1alpha1.ListEventRecipesResponse.event_recipes:type_name -> api.calendars.calendar.v1alpha1.EventRecipe
-	1, // 3: api.calendars.calendar.v1alpha1.EventRecipeService.CreateEventRecipe:input_type -> api.calendars.calendar.v1alpha1.CreateEventRecipeRequest
-	2, // 4: api.calendars.calendar.v1alpha1.EventRecipeService.GetEventRecipe:input_type -> api.calendars.calendar.v1alpha1.GetEventRecipeRequest
-	3, // 5: api.calendars.calendar.v1alpha1.EventRecipeService.DeleteEventRecipe:input_type -> api.calendars.calendar.v1alpha1.DeleteEventRecipeRequest
-	4, // 6: api.calendars.calendar.v1alpha1.EventRecipeService.ListEventRecipes:input_type -> api.calendars.calendar.v1alpha1.ListEventRecipesRequest
-	0, // 7: api.calendars.calendar.v1alpha1.EventRecipeService.CreateEventRecipe:output_type -> api.calendars.calendar.v1alpha1.EventRecipe
-	0, // 8: api.calendars.calendar.v1alpha1.EventRecipeService.GetEventRecipe:output_type -> api.calendars.calendar.v1alpha1.EventRecipe
-	0, // 9: api.calendars.calendar.v1alpha1.EventRecipeService.DeleteEventRecipe:output_type -> api.calendars.calendar.v1alpha1.EventRecipe
-	5, // 10: api.calendars.calendar.v1alpha1.EventRecipeService.ListEventRecipes:output_type -> api.calendars.calendar.v1alpha1.ListEventRecipesResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: api.calendars.calendar.v1alpha1.EventRecipe.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 1: api.calendars.calendar.v1alpha1.EventRecipe.done_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: api.calendars.calendar.v1alpha1.CreateEventRecipeRequest.event_recipe:type_name -> api.calendars.calendar.v1alpha1.EventRecipe
+	0,  // 3: api.calendars.calendar.v1alpha1.ListEventRecipesResponse.event_recipes:type_name -> api.calendars.calendar.v1alpha1.EventRecipe
+	0,  // 4: api.calendars.calendar.v1alpha1.MarkEventRecipeDoneResponse.event_recipe:type_name -> api.calendars.calendar.v1alpha1.EventRecipe
+	1,  // 5: api.calendars.calendar.v1alpha1.EventRecipeService.CreateEventRecipe:input_type -> api.calendars.calendar.v1alpha1.CreateEventRecipeRequest
+	2,  // 6: api.calendars.calendar.v1alpha1.EventRecipeService.GetEventRecipe:input_type -> api.calendars.calendar.v1alpha1.GetEventRecipeRequest
+	3,  // 7: api.calendars.calendar.v1alpha1.EventRecipeService.DeleteEventRecipe:input_type -> api.calendars.calendar.v1alpha1.DeleteEventRecipeRequest
+	4,  // 8: api.calendars.calendar.v1alpha1.EventRecipeService.ListEventRecipes:input_type -> api.calendars.calendar.v1alpha1.ListEventRecipesRequest
+	6,  // 9: api.calendars.calendar.v1alpha1.EventRecipeService.MarkEventRecipeDone:input_type -> api.calendars.calendar.v1alpha1.MarkEventRecipeDoneRequest
+	0,  // 10: api.calendars.calendar.v1alpha1.EventRecipeService.CreateEventRecipe:output_type -> api.calendars.calendar.v1alpha1.EventRecipe
+	0,  // 11: api.calendars.calendar.v1alpha1.EventRecipeService.GetEventRecipe:output_type -> api.calendars.calendar.v1alpha1.EventRecipe
+	0,  // 12: api.calendars.calendar.v1alpha1.EventRecipeService.DeleteEventRecipe:output_type -> api.calendars.calendar.v1alpha1.EventRecipe
+	5,  // 13: api.calendars.calendar.v1alpha1.EventRecipeService.ListEventRecipes:output_type -> api.calendars.calendar.v1alpha1.ListEventRecipesResponse
+	7,  // 14: api.calendars.calendar.v1alpha1.EventRecipeService.MarkEventRecipeDone:output_type -> api.calendars.calendar.v1alpha1.MarkEventRecipeDoneResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_calendars_calendar_v1alpha1_event_recipe_proto_init() }
@@ -462,7 +618,7 @@ func file_api_calendars_calendar_v1alpha1_event_recipe_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDesc), len(file_api_calendars_calendar_v1alpha1_event_recipe_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -211,6 +211,51 @@ func local_request_EventRecipeService_ListEventRecipes_0(ctx context.Context, ma
 	return msg, metadata, err
 }
 
+func request_EventRecipeService_MarkEventRecipeDone_0(ctx context.Context, marshaler runtime.Marshaler, client EventRecipeServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq MarkEventRecipeDoneRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := client.MarkEventRecipeDone(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_EventRecipeService_MarkEventRecipeDone_0(ctx context.Context, marshaler runtime.Marshaler, server EventRecipeServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq MarkEventRecipeDoneRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := server.MarkEventRecipeDone(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterEventRecipeServiceHandlerServer registers the http handlers for service EventRecipeService to "mux".
 // UnaryRPC     :call EventRecipeServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -297,6 +342,26 @@ func RegisterEventRecipeServiceHandlerServer(ctx context.Context, mux *runtime.S
 		}
 		forward_EventRecipeService_ListEventRecipes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_EventRecipeService_MarkEventRecipeDone_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/api.calendars.calendar.v1alpha1.EventRecipeService/MarkEventRecipeDone", runtime.WithHTTPPathPattern("/calendars/v1alpha1/{name=calendars/*/events/*/eventRecipes/*}:markDone"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_EventRecipeService_MarkEventRecipeDone_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_EventRecipeService_MarkEventRecipeDone_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -405,19 +470,38 @@ func RegisterEventRecipeServiceHandlerClient(ctx context.Context, mux *runtime.S
 		}
 		forward_EventRecipeService_ListEventRecipes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_EventRecipeService_MarkEventRecipeDone_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/api.calendars.calendar.v1alpha1.EventRecipeService/MarkEventRecipeDone", runtime.WithHTTPPathPattern("/calendars/v1alpha1/{name=calendars/*/events/*/eventRecipes/*}:markDone"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_EventRecipeService_MarkEventRecipeDone_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_EventRecipeService_MarkEventRecipeDone_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_EventRecipeService_CreateEventRecipe_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 4, 4, 5, 3, 2, 4}, []string{"calendars", "v1alpha1", "events", "parent", "eventRecipes"}, ""))
-	pattern_EventRecipeService_GetEventRecipe_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 2, 3, 1, 0, 4, 6, 5, 4}, []string{"calendars", "v1alpha1", "events", "eventRecipes", "name"}, ""))
-	pattern_EventRecipeService_DeleteEventRecipe_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 2, 3, 1, 0, 4, 6, 5, 4}, []string{"calendars", "v1alpha1", "events", "eventRecipes", "name"}, ""))
-	pattern_EventRecipeService_ListEventRecipes_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 4, 4, 5, 3, 2, 4}, []string{"calendars", "v1alpha1", "events", "parent", "eventRecipes"}, ""))
+	pattern_EventRecipeService_CreateEventRecipe_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 4, 4, 5, 3, 2, 4}, []string{"calendars", "v1alpha1", "events", "parent", "eventRecipes"}, ""))
+	pattern_EventRecipeService_GetEventRecipe_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 2, 3, 1, 0, 4, 6, 5, 4}, []string{"calendars", "v1alpha1", "events", "eventRecipes", "name"}, ""))
+	pattern_EventRecipeService_DeleteEventRecipe_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 2, 3, 1, 0, 4, 6, 5, 4}, []string{"calendars", "v1alpha1", "events", "eventRecipes", "name"}, ""))
+	pattern_EventRecipeService_ListEventRecipes_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 4, 4, 5, 3, 2, 4}, []string{"calendars", "v1alpha1", "events", "parent", "eventRecipes"}, ""))
+	pattern_EventRecipeService_MarkEventRecipeDone_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 2, 2, 1, 0, 2, 3, 1, 0, 4, 6, 5, 4}, []string{"calendars", "v1alpha1", "events", "eventRecipes", "name"}, "markDone"))
 )
 
 var (
-	forward_EventRecipeService_CreateEventRecipe_0 = runtime.ForwardResponseMessage
-	forward_EventRecipeService_GetEventRecipe_0    = runtime.ForwardResponseMessage
-	forward_EventRecipeService_DeleteEventRecipe_0 = runtime.ForwardResponseMessage
-	forward_EventRecipeService_ListEventRecipes_0  = runtime.ForwardResponseMessage
+	forward_EventRecipeService_CreateEventRecipe_0   = runtime.ForwardResponseMessage
+	forward_EventRecipeService_GetEventRecipe_0      = runtime.ForwardResponseMessage
+	forward_EventRecipeService_DeleteEventRecipe_0   = runtime.ForwardResponseMessage
+	forward_EventRecipeService_ListEventRecipes_0    = runtime.ForwardResponseMessage
+	forward_EventRecipeService_MarkEventRecipeDone_0 = runtime.ForwardResponseMessage
 )
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	EventRecipeService_CreateEventRecipe_FullMethodName = "/api.calendars.calendar.v1alpha1.EventRecipeService/CreateEventRecipe"
-	EventRecipeService_GetEventRecipe_FullMethodName    = "/api.calendars.calendar.v1alpha1.EventRecipeService/GetEventRecipe"
-	EventRecipeService_DeleteEventRecipe_FullMethodName = "/api.calendars.calendar.v1alpha1.EventRecipeService/DeleteEventRecipe"
-	EventRecipeService_ListEventRecipes_FullMethodName  = "/api.calendars.calendar.v1alpha1.EventRecipeService/ListEventRecipes"
+	EventRecipeService_CreateEventRecipe_FullMethodName   = "/api.calendars.calendar.v1alpha1.EventRecipeService/CreateEventRecipe"
+	EventRecipeService_GetEventRecipe_FullMethodName      = "/api.calendars.calendar.v1alpha1.EventRecipeService/GetEventRecipe"
+	EventRecipeService_DeleteEventRecipe_FullMethodName   = "/api.calendars.calendar.v1alpha1.EventRecipeService/DeleteEventRecipe"
+	EventRecipeService_ListEventRecipes_FullMethodName    = "/api.calendars.calendar.v1alpha1.EventRecipeService/ListEventRecipes"
+	EventRecipeService_MarkEventRecipeDone_FullMethodName = "/api.calendars.calendar.v1alpha1.EventRecipeService/MarkEventRecipeDone"
 )
 
 // EventRecipeServiceClient is the client API for EventRecipeService service.
@@ -39,6 +40,8 @@ type EventRecipeServiceClient interface {
 	DeleteEventRecipe(ctx context.Context, in *DeleteEventRecipeRequest, opts ...grpc.CallOption) (*EventRecipe, error)
 	// list event recipes
 	ListEventRecipes(ctx context.Context, in *ListEventRecipesRequest, opts ...grpc.CallOption) (*ListEventRecipesResponse, error)
+	// mark the recipe of an event as done
+	MarkEventRecipeDone(ctx context.Context, in *MarkEventRecipeDoneRequest, opts ...grpc.CallOption) (*MarkEventRecipeDoneResponse, error)
 }
 
 type eventRecipeServiceClient struct {
@@ -89,6 +92,16 @@ func (c *eventRecipeServiceClient) ListEventRecipes(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *eventRecipeServiceClient) MarkEventRecipeDone(ctx context.Context, in *MarkEventRecipeDoneRequest, opts ...grpc.CallOption) (*MarkEventRecipeDoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkEventRecipeDoneResponse)
+	err := c.cc.Invoke(ctx, EventRecipeService_MarkEventRecipeDone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventRecipeServiceServer is the server API for EventRecipeService service.
 // All implementations must embed UnimplementedEventRecipeServiceServer
 // for forward compatibility.
@@ -103,6 +116,8 @@ type EventRecipeServiceServer interface {
 	DeleteEventRecipe(context.Context, *DeleteEventRecipeRequest) (*EventRecipe, error)
 	// list event recipes
 	ListEventRecipes(context.Context, *ListEventRecipesRequest) (*ListEventRecipesResponse, error)
+	// mark the recipe of an event as done
+	MarkEventRecipeDone(context.Context, *MarkEventRecipeDoneRequest) (*MarkEventRecipeDoneResponse, error)
 	mustEmbedUnimplementedEventRecipeServiceServer()
 }
 
@@ -125,6 +140,9 @@ func (UnimplementedEventRecipeServiceServer) DeleteEventRecipe(context.Context,
 func (UnimplementedEventRecipeServiceServer) ListEventRecipes(context.Context, *ListEventRecipesRequest) (*ListEventRecipesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEventRecipes not implemented")
 }
+func (UnimplementedEventRecipeServiceServer) MarkEventRecipeDone(context.Context, *MarkEventRecipeDoneRequest) (*MarkEventRecipeDoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkEventRecipeDone not implemented")
+}
 func (UnimplementedEventRecipeServiceServer) mustEmbedUnimplementedEventRecipeServiceServer() {}
 func (UnimplementedEventRecipeServiceServer) testEmbeddedByValue()                            {}
 
@@ -218,6 +236,24 @@ func _EventRecipeService_ListEventRecipes_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventRecipeService_MarkEventRecipeDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkEventRecipeDoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventRecipeServiceServer).MarkEventRecipeDone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventRecipeService_MarkEventRecipeDone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventRecipeServiceServer).MarkEventRecipeDone(ctx, req.(*MarkEventRecipeDoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventRecipeService_ServiceDesc is the grpc.ServiceDesc for EventRecipeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +277,10 @@ var EventRecipeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEventRecipes",
 			Handler:    _EventRecipeService_ListEventRecipes_Handler,
 		},
+		{
+			MethodName: "MarkEventRecipeDone",
+			Handler:    _EventRecipeService_MarkEventRecipeDone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/calendars/calendar/v1alpha1/event_recipe.proto",
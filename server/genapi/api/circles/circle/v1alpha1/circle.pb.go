@@ -43,7 +43,9 @@ type Circle struct {
 	// the description of the circle
 	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	// whether the current user has favorited this circle
-	Favorited     bool `protobuf:"varint,8,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	Favorited bool `protobuf:"varint,8,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	// the resized variants and placeholder of the circle image
+	Images        *types.ImageSet `protobuf:"bytes,9,opt,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +136,13 @@ func (x *Circle) GetFavorited() bool {
 	return false
 }
 
+func (x *Circle) GetImages() *types.ImageSet {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
 // the request to create a circle
 type CreateCircleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -707,7 +716,7 @@ var File_api_circles_circle_v1alpha1_circle_proto protoreflect.FileDescriptor
 
 const file_api_circles_circle_v1alpha1_circle_proto_rawDesc = "" +
 	"\n" +
-	"(api/circles/circle/v1alpha1/circle.proto\x12\x1bapi.circles.circle.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb9\x05\n" +
+	"(api/circles/circle/v1alpha1/circle.proto\x12\x1bapi.circles.circle.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a\x19api/types/image_set.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xeb\x05\n" +
 	"\x06Circle\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12 \n" +
@@ -718,7 +727,8 @@ const file_api_circles_circle_v1alpha1_circle_proto_rawDesc = "" +
 	"\rcircle_access\x18\x05 \x01(\v20.api.circles.circle.v1alpha1.Circle.CircleAccessB\x03\xe0A\x03R\fcircleAccess\x12\x1b\n" +
 	"\x06handle\x18\x06 \x01(\tB\x03\xe0A\x01R\x06handle\x12%\n" +
 	"\vdescription\x18\a \x01(\tB\x03\xe0A\x01R\vdescription\x12!\n" +
-	"\tfavorited\x18\b \x01(\bB\x03\xe0A\x03R\tfavorited\x1a\xe9\x01\n" +
+	"\tfavorited\x18\b \x01(\bB\x03\xe0A\x03R\tfavorited\x120\n" +
+	"\x06images\x18\t \x01(\v2\x13.api.types.ImageSetB\x03\xe0A\x03R\x06images\x1a\xe9\x01\n" +
 	"\fCircleAccess\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12J\n" +
 	"\x10permission_level\x18\x02 \x01(\x0e2\x1a.api.types.PermissionLevelB\x03\xe0A\x03R\x0fpermissionLevel\x121\n" +
@@ -806,40 +816,42 @@ var file_api_circles_circle_v1alpha1_circle_proto_goTypes = []any{
 	(*UnfavoriteCircleResponse)(nil), // 10: api.circles.circle.v1alpha1.UnfavoriteCircleResponse
 	(*Circle_CircleAccess)(nil),      // 11: api.circles.circle.v1alpha1.Circle.CircleAccess
 	(types.VisibilityLevel)(0),       // 12: api.types.VisibilityLevel
-	(*fieldmaskpb.FieldMask)(nil),    // 13: google.protobuf.FieldMask
-	(types.PermissionLevel)(0),       // 14: api.types.PermissionLevel
-	(types.AccessState)(0),           // 15: api.types.AccessState
-	(types.AcceptTarget)(0),          // 16: api.types.AcceptTarget
+	(*types.ImageSet)(nil),           // 13: api.types.ImageSet
+	(*fieldmaskpb.FieldMask)(nil),    // 14: google.protobuf.FieldMask
+	(types.PermissionLevel)(0),       // 15: api.types.PermissionLevel
+	(types.AccessState)(0),           // 16: api.types.AccessState
+	(types.AcceptTarget)(0),          // 17: api.types.AcceptTarget
 }
 var file_api_circles_circle_v1alpha1_circle_proto_depIdxs = []int32{
 	12, // 0: api.circles.circle.v1alpha1.Circle.visibility:type_name -> api.types.VisibilityLevel
 	11, // 1: api.circles.circle.v1alpha1.Circle.circle_access:type_name -> api.circles.circle.v1alpha1.Circle.CircleAccess
-	0,  // 2: api.circles.circle.v1alpha1.CreateCircleRequest.circle:type_name -> api.circles.circle.v1alpha1.Circle
-	0,  // 3: api.circles.circle.v1alpha1.ListCirclesResponse.circles:type_name -> api.circles.circle.v1alpha1.Circle
-	0,  // 4: api.circles.circle.v1alpha1.UpdateCircleRequest.circle:type_name -> api.circles.circle.v1alpha1.Circle
-	13, // 5: api.circles.circle.v1alpha1.UpdateCircleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	14, // 6: api.circles.circle.v1alpha1.Circle.CircleAccess.permission_level:type_name -> api.types.PermissionLevel
-	15, // 7: api.circles.circle.v1alpha1.Circle.CircleAccess.state:type_name -> api.types.AccessState
-	16, // 8: api.circles.circle.v1alpha1.Circle.CircleAccess.accept_target:type_name -> api.types.AcceptTarget
-	1,  // 9: api.circles.circle.v1alpha1.CircleService.CreateCircle:input_type -> api.circles.circle.v1alpha1.CreateCircleRequest
-	2,  // 10: api.circles.circle.v1alpha1.CircleService.ListCircles:input_type -> api.circles.circle.v1alpha1.ListCirclesRequest
-	4,  // 11: api.circles.circle.v1alpha1.CircleService.UpdateCircle:input_type -> api.circles.circle.v1alpha1.UpdateCircleRequest
-	5,  // 12: api.circles.circle.v1alpha1.CircleService.DeleteCircle:input_type -> api.circles.circle.v1alpha1.DeleteCircleRequest
-	6,  // 13: api.circles.circle.v1alpha1.CircleService.GetCircle:input_type -> api.circles.circle.v1alpha1.GetCircleRequest
-	7,  // 14: api.circles.circle.v1alpha1.CircleService.FavoriteCircle:input_type -> api.circles.circle.v1alpha1.FavoriteCircleRequest
-	9,  // 15: api.circles.circle.v1alpha1.CircleService.UnfavoriteCircle:input_type -> api.circles.circle.v1alpha1.UnfavoriteCircleRequest
-	0,  // 16: api.circles.circle.v1alpha1.CircleService.CreateCircle:output_type -> api.circles.circle.v1alpha1.Circle
-	3,  // 17: api.circles.circle.v1alpha1.CircleService.ListCircles:output_type -> api.circles.circle.v1alpha1.ListCirclesResponse
-	0,  // 18: api.circles.circle.v1alpha1.CircleService.UpdateCircle:output_type -> api.circles.circle.v1alpha1.Circle
-	0,  // 19: api.circles.circle.v1alpha1.CircleService.DeleteCircle:output_type -> api.circles.circle.v1alpha1.Circle
-	0,  // 20: api.circles.circle.v1alpha1.CircleService.GetCircle:output_type -> api.circles.circle.v1alpha1.Circle
-	8,  // 21: api.circles.circle.v1alpha1.CircleService.FavoriteCircle:output_type -> api.circles.circle.v1alpha1.FavoriteCircleResponse
-	10, // 22: api.circles.circle.v1alpha1.CircleService.UnfavoriteCircle:output_type -> api.circles.circle.v1alpha1.UnfavoriteCircleResponse
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	13, // 2: api.circles.circle.v1alpha1.Circle.images:type_name -> api.types.ImageSet
+	0,  // 3: api.circles.circle.v1alpha1.CreateCircleRequest.circle:type_name -> api.circles.circle.v1alpha1.Circle
+	0,  // 4: api.circles.circle.v1alpha1.ListCirclesResponse.circles:type_name -> api.circles.circle.v1alpha1.Circle
+	0,  // 5: api.circles.circle.v1alpha1.UpdateCircleRequest.circle:type_name -> api.circles.circle.v1alpha1.Circle
+	14, // 6: api.circles.circle.v1alpha1.UpdateCircleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	15, // 7: api.circles.circle.v1alpha1.Circle.CircleAccess.permission_level:type_name -> api.types.PermissionLevel
+	16, // 8: api.circles.circle.v1alpha1.Circle.CircleAccess.state:type_name -> api.types.AccessState
+	17, // 9: api.circles.circle.v1alpha1.Circle.CircleAccess.accept_target:type_name -> api.types.AcceptTarget
+	1,  // 10: api.circles.circle.v1alpha1.CircleService.CreateCircle:input_type -> api.circles.circle.v1alpha1.CreateCircleRequest
+	2,  // 11: api.circles.circle.v1alpha1.CircleService.ListCircles:input_type -> api.circles.circle.v1alpha1.ListCirclesRequest
+	4,  // 12: api.circles.circle.v1alpha1.CircleService.UpdateCircle:input_type -> api.circles.circle.v1alpha1.UpdateCircleRequest
+	5,  // 13: api.circles.circle.v1alpha1.CircleService.DeleteCircle:input_type -> api.circles.circle.v1alpha1.DeleteCircleRequest
+	6,  // 14: api.circles.circle.v1alpha1.CircleService.GetCircle:input_type -> api.circles.circle.v1alpha1.GetCircleRequest
+	7,  // 15: api.circles.circle.v1alpha1.CircleService.FavoriteCircle:input_type -> api.circles.circle.v1alpha1.FavoriteCircleRequest
+	9,  // 16: api.circles.circle.v1alpha1.CircleService.UnfavoriteCircle:input_type -> api.circles.circle.v1alpha1.UnfavoriteCircleRequest
+	0,  // 17: api.circles.circle.v1alpha1.CircleService.CreateCircle:output_type -> api.circles.circle.v1alpha1.Circle
+	3,  // 18: api.circles.circle.v1alpha1.CircleService.ListCircles:output_type -> api.circles.circle.v1alpha1.ListCirclesResponse
+	0,  // 19: api.circles.circle.v1alpha1.CircleService.UpdateCircle:output_type -> api.circles.circle.v1alpha1.Circle
+	0,  // 20: api.circles.circle.v1alpha1.CircleService.DeleteCircle:output_type -> api.circles.circle.v1alpha1.Circle
+	0,  // 21: api.circles.circle.v1alpha1.CircleService.GetCircle:output_type -> api.circles.circle.v1alpha1.Circle
+	8,  // 22: api.circles.circle.v1alpha1.CircleService.FavoriteCircle:output_type -> api.circles.circle.v1alpha1.FavoriteCircleResponse
+	10, // 23: api.circles.circle.v1alpha1.CircleService.UnfavoriteCircle:output_type -> api.circles.circle.v1alpha1.UnfavoriteCircleResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_api_circles_circle_v1alpha1_circle_proto_init() }
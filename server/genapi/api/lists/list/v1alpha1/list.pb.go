@@ -660,6 +660,170 @@ func (*UnfavoriteListResponse) Descriptor() ([]byte, []int) {
 	return file_api_lists_list_v1alpha1_list_proto_rawDescGZIP(), []int{10}
 }
 
+// the request to generate a grocery list
+type GenerateGroceryListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the existing list to add the grocery items to. when not set a new list is created from new_list
+	List string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	// the list to create when list is not set
+	NewList *List `protobuf:"bytes,2,opt,name=new_list,json=newList,proto3" json:"new_list,omitempty"`
+	// the parent of the list to create
+	Parent string `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the recipes to shop for
+	Recipes []*GenerateGroceryListRequest_RecipeSource `protobuf:"bytes,4,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	// the calendar whose planned recipes should be shopped for
+	Calendar string `protobuf:"bytes,5,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	// the start of the meal plan range (inclusive)
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// the end of the meal plan range (exclusive)
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// whether to add optional ingredients. they are flagged as optional in the item title
+	IncludeOptional bool `protobuf:"varint,8,opt,name=include_optional,json=includeOptional,proto3" json:"include_optional,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateGroceryListRequest) Reset() {
+	*x = GenerateGroceryListRequest{}
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateGroceryListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGroceryListRequest) ProtoMessage() {}
+
+func (x *GenerateGroceryListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGroceryListRequest.ProtoReflect.Descriptor instead.
+func (*GenerateGroceryListRequest) Descriptor() ([]byte, []int) {
+	return file_api_lists_list_v1alpha1_list_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenerateGroceryListRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *GenerateGroceryListRequest) GetNewList() *List {
+	if x != nil {
+		return x.NewList
+	}
+	return nil
+}
+
+func (x *GenerateGroceryListRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *GenerateGroceryListRequest) GetRecipes() []*GenerateGroceryListRequest_RecipeSource {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *GenerateGroceryListRequest) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+func (x *GenerateGroceryListRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GenerateGroceryListRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GenerateGroceryListRequest) GetIncludeOptional() bool {
+	if x != nil {
+		return x.IncludeOptional
+	}
+	return false
+}
+
+// the response to generate a grocery list
+type GenerateGroceryListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the list the grocery items were written to
+	List *List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	// the list items that were created
+	ListItems     []*ListItem `protobuf:"bytes,2,rep,name=list_items,json=listItems,proto3" json:"list_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateGroceryListResponse) Reset() {
+	*x = GenerateGroceryListResponse{}
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateGroceryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGroceryListResponse) ProtoMessage() {}
+
+func (x *GenerateGroceryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGroceryListResponse.ProtoReflect.Descriptor instead.
+func (*GenerateGroceryListResponse) Descriptor() ([]byte, []int) {
+	return file_api_lists_list_v1alpha1_list_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GenerateGroceryListResponse) GetList() *List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GenerateGroceryListResponse) GetListItems() []*ListItem {
+	if x != nil {
+		return x.ListItems
+	}
+	return nil
+}
+
 // the details for a list section
 type List_ListSection struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -673,7 +837,7 @@ type List_ListSection struct {
 
 func (x *List_ListSection) Reset() {
 	*x = List_ListSection{}
-	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[11]
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +849,7 @@ func (x *List_ListSection) String() string {
 func (*List_ListSection) ProtoMessage() {}
 
 func (x *List_ListSection) ProtoReflect() protoreflect.Message {
-	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[11]
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +896,7 @@ type List_ListAccess struct {
 
 func (x *List_ListAccess) Reset() {
 	*x = List_ListAccess{}
-	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[12]
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -744,7 +908,7 @@ func (x *List_ListAccess) String() string {
 func (*List_ListAccess) ProtoMessage() {}
 
 func (x *List_ListAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[12]
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,11 +952,66 @@ func (x *List_ListAccess) GetAcceptTarget() types.AcceptTarget {
 	return types.AcceptTarget(0)
 }
 
+// a recipe to shop for
+type GenerateGroceryListRequest_RecipeSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the recipe
+	Recipe string `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// the number of servings to shop for. the recipe is scaled against its yield; when not set the recipe is used as written
+	Servings      float64 `protobuf:"fixed64,2,opt,name=servings,proto3" json:"servings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateGroceryListRequest_RecipeSource) Reset() {
+	*x = GenerateGroceryListRequest_RecipeSource{}
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateGroceryListRequest_RecipeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGroceryListRequest_RecipeSource) ProtoMessage() {}
+
+func (x *GenerateGroceryListRequest_RecipeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_lists_list_v1alpha1_list_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGroceryListRequest_RecipeSource.ProtoReflect.Descriptor instead.
+func (*GenerateGroceryListRequest_RecipeSource) Descriptor() ([]byte, []int) {
+	return file_api_lists_list_v1alpha1_list_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *GenerateGroceryListRequest_RecipeSource) GetRecipe() string {
+	if x != nil {
+		return x.Recipe
+	}
+	return ""
+}
+
+func (x *GenerateGroceryListRequest_RecipeSource) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
 var File_api_lists_list_v1alpha1_list_proto protoreflect.FileDescriptor
 
 const file_api_lists_list_v1alpha1_list_proto_rawDesc = "" +
 	"\n" +
-	"\"api/lists/list/v1alpha1/list.proto\x12\x17api.lists.list.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb6\a\n" +
+	"\"api/lists/list/v1alpha1/list.proto\x12\x17api.lists.list.v1alpha1\x1a'api/lists/list/v1alpha1/list_item.proto\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb6\a\n" +
 	"\x04List\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12%\n" +
@@ -850,7 +1069,27 @@ const file_api_lists_list_v1alpha1_list_proto_rawDesc = "" +
 	"\x15UnfavoriteListRequest\x128\n" +
 	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
 	"\x1capi.lists.list.v1alpha1/ListR\x04name\"\x18\n" +
-	"\x16UnfavoriteListResponse2\xe6\x0f\n" +
+	"\x16UnfavoriteListResponse\"\xa1\x05\n" +
+	"\x1aGenerateGroceryListRequest\x128\n" +
+	"\x04list\x18\x01 \x01(\tB$\xe0A\x01\xfaA\x1e\n" +
+	"\x1capi.lists.list.v1alpha1/ListR\x04list\x12=\n" +
+	"\bnew_list\x18\x02 \x01(\v2\x1d.api.lists.list.v1alpha1.ListB\x03\xe0A\x01R\anewList\x12<\n" +
+	"\x06parent\x18\x03 \x01(\tB$\xe0A\x01\xfaA\x1e\x12\x1capi.lists.list.v1alpha1/ListR\x06parent\x12_\n" +
+	"\arecipes\x18\x04 \x03(\v2@.api.lists.list.v1alpha1.GenerateGroceryListRequest.RecipeSourceB\x03\xe0A\x01R\arecipes\x12L\n" +
+	"\bcalendar\x18\x05 \x01(\tB0\xe0A\x01\xfaA*\n" +
+	"(api.calendars.calendar.v1alpha1/CalendarR\bcalendar\x12>\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartTime\x12:\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\aendTime\x12.\n" +
+	"\x10include_optional\x18\b \x01(\bB\x03\xe0A\x01R\x0fincludeOptional\x1aq\n" +
+	"\fRecipeSource\x12@\n" +
+	"\x06recipe\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06recipe\x12\x1f\n" +
+	"\bservings\x18\x02 \x01(\x01B\x03\xe0A\x01R\bservings\"\x92\x01\n" +
+	"\x1bGenerateGroceryListResponse\x121\n" +
+	"\x04list\x18\x01 \x01(\v2\x1d.api.lists.list.v1alpha1.ListR\x04list\x12@\n" +
+	"\n" +
+	"list_items\x18\x02 \x03(\v2!.api.lists.list.v1alpha1.ListItemR\tlistItems2\x9c\x13\n" +
 	"\vListService\x12\xc3\x02\n" +
 	"\n" +
 	"CreateList\x12*.api.lists.list.v1alpha1.CreateListRequest\x1a\x1d.api.lists.list.v1alpha1.List\"\xe9\x01\x92AK\n" +
@@ -870,7 +1109,9 @@ const file_api_lists_list_v1alpha1_list_proto_rawDesc = "" +
 	"\fFavoriteList\x12,.api.lists.list.v1alpha1.FavoriteListRequest\x1a-.api.lists.list.v1alpha1.FavoriteListResponse\"\xed\x01\x92AB\n" +
 	"\vListService\x12\x0fFavorite a list\x1a\"Favorites a list by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\x9a\x01:\x01*Z6:\x01*\"1/meals/v1alpha1/{name=circles/*/lists/*}:favoriteZ4:\x01*\"//meals/v1alpha1/{name=users/*/lists/*}:favorite\"'/meals/v1alpha1/{name=lists/*}:favorite\x12\xeb\x02\n" +
 	"\x0eUnfavoriteList\x12..api.lists.list.v1alpha1.UnfavoriteListRequest\x1a/.api.lists.list.v1alpha1.UnfavoriteListResponse\"\xf7\x01\x92AF\n" +
-	"\vListService\x12\x11Unfavorite a list\x1a$Unfavorites a list by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\xa0\x01:\x01*Z8:\x01*\"3/meals/v1alpha1/{name=circles/*/lists/*}:unfavoriteZ6:\x01*\"1/meals/v1alpha1/{name=users/*/lists/*}:unfavorite\")/meals/v1alpha1/{name=lists/*}:unfavoriteB\xd2\x02\x92AXZD\n" +
+	"\vListService\x12\x11Unfavorite a list\x1a$Unfavorites a list by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\xa0\x01:\x01*Z8:\x01*\"3/meals/v1alpha1/{name=circles/*/lists/*}:unfavoriteZ6:\x01*\"1/meals/v1alpha1/{name=users/*/lists/*}:unfavorite\")/meals/v1alpha1/{name=lists/*}:unfavorite\x12\xb3\x03\n" +
+	"\x13GenerateGroceryList\x123.api.lists.list.v1alpha1.GenerateGroceryListRequest\x1a4.api.lists.list.v1alpha1.GenerateGroceryListResponse\"\xb0\x02\x92A\xbf\x01\n" +
+	"\vListService\x12\x17Generate a grocery list\x1a\x96\x01Aggregates the ingredients of the given recipes, or of the recipes planned on a calendar in a date range, and writes them into a new or existing list.\x82\xd3\xe4\x93\x02g:\x01*Z7:\x01*\"2/lists/v1alpha1/{list=lists/*}:generateGroceryList\")/lists/v1alpha1/lists:generateGroceryListB\xd2\x02\x92AXZD\n" +
 	"B\n" +
 	"\n" +
 	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
@@ -891,60 +1132,72 @@ func file_api_lists_list_v1alpha1_list_proto_rawDescGZIP() []byte {
 	return file_api_lists_list_v1alpha1_list_proto_rawDescData
 }
 
-var file_api_lists_list_v1alpha1_list_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_lists_list_v1alpha1_list_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_api_lists_list_v1alpha1_list_proto_goTypes = []any{
-	(*List)(nil),                   // 0: api.lists.list.v1alpha1.List
-	(*CreateListRequest)(nil),      // 1: api.lists.list.v1alpha1.CreateListRequest
-	(*ListListsRequest)(nil),       // 2: api.lists.list.v1alpha1.ListListsRequest
-	(*ListListsResponse)(nil),      // 3: api.lists.list.v1alpha1.ListListsResponse
-	(*UpdateListRequest)(nil),      // 4: api.lists.list.v1alpha1.UpdateListRequest
-	(*DeleteListRequest)(nil),      // 5: api.lists.list.v1alpha1.DeleteListRequest
-	(*GetListRequest)(nil),         // 6: api.lists.list.v1alpha1.GetListRequest
-	(*FavoriteListRequest)(nil),    // 7: api.lists.list.v1alpha1.FavoriteListRequest
-	(*FavoriteListResponse)(nil),   // 8: api.lists.list.v1alpha1.FavoriteListResponse
-	(*UnfavoriteListRequest)(nil),  // 9: api.lists.list.v1alpha1.UnfavoriteListRequest
-	(*UnfavoriteListResponse)(nil), // 10: api.lists.list.v1alpha1.UnfavoriteListResponse
-	(*List_ListSection)(nil),       // 11: api.lists.list.v1alpha1.List.ListSection
-	(*List_ListAccess)(nil),        // 12: api.lists.list.v1alpha1.List.ListAccess
-	(types.VisibilityLevel)(0),     // 13: api.types.VisibilityLevel
-	(*timestamppb.Timestamp)(nil),  // 14: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),  // 15: google.protobuf.FieldMask
-	(types.PermissionLevel)(0),     // 16: api.types.PermissionLevel
-	(types.AccessState)(0),         // 17: api.types.AccessState
-	(types.AcceptTarget)(0),        // 18: api.types.AcceptTarget
+	(*List)(nil),                                    // 0: api.lists.list.v1alpha1.List
+	(*CreateListRequest)(nil),                       // 1: api.lists.list.v1alpha1.CreateListRequest
+	(*ListListsRequest)(nil),                        // 2: api.lists.list.v1alpha1.ListListsRequest
+	(*ListListsResponse)(nil),                       // 3: api.lists.list.v1alpha1.ListListsResponse
+	(*UpdateListRequest)(nil),                       // 4: api.lists.list.v1alpha1.UpdateListRequest
+	(*DeleteListRequest)(nil),                       // 5: api.lists.list.v1alpha1.DeleteListRequest
+	(*GetListRequest)(nil),                          // 6: api.lists.list.v1alpha1.GetListRequest
+	(*FavoriteListRequest)(nil),                     // 7: api.lists.list.v1alpha1.FavoriteListRequest
+	(*FavoriteListResponse)(nil),                    // 8: api.lists.list.v1alpha1.FavoriteListResponse
+	(*UnfavoriteListRequest)(nil),                   // 9: api.lists.list.v1alpha1.UnfavoriteListRequest
+	(*UnfavoriteListResponse)(nil),                  // 10: api.lists.list.v1alpha1.UnfavoriteListResponse
+	(*GenerateGroceryListRequest)(nil),              // 11: api.lists.list.v1alpha1.GenerateGroceryListRequest
+	(*GenerateGroceryListResponse)(nil),             // 12: api.lists.list.v1alpha1.GenerateGroceryListResponse
+	(*List_ListSection)(nil),                        // 13: api.lists.list.v1alpha1.List.ListSection
+	(*List_ListAccess)(nil),                         // 14: api.lists.list.v1alpha1.List.ListAccess
+	(*GenerateGroceryListRequest_RecipeSource)(nil), // 15: api.lists.list.v1alpha1.GenerateGroceryListRequest.RecipeSource
+	(types.VisibilityLevel)(0),                      // 16: api.types.VisibilityLevel
+	(*timestamppb.Timestamp)(nil),                   // 17: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                   // 18: google.protobuf.FieldMask
+	(*ListItem)(nil),                                // 19: api.lists.list.v1alpha1.ListItem
+	(types.PermissionLevel)(0),                      // 20: api.types.PermissionLevel
+	(types.AccessState)(0),                          // 21: api.types.AccessState
+	(types.AcceptTarget)(0),                         // 22: api.types.AcceptTarget
 }
 var file_api_lists_list_v1alpha1_list_proto_depIdxs = []int32{
-	13, // 0: api.lists.list.v1alpha1.List.visibility:type_name -> api.types.VisibilityLevel
-	12, // 1: api.lists.list.v1alpha1.List.list_access:type_name -> api.lists.list.v1alpha1.List.ListAccess
-	14, // 2: api.lists.list.v1alpha1.List.create_time:type_name -> google.protobuf.Timestamp
-	14, // 3: api.lists.list.v1alpha1.List.update_time:type_name -> google.protobuf.Timestamp
-	11, // 4: api.lists.list.v1alpha1.List.sections:type_name -> api.lists.list.v1alpha1.List.ListSection
+	16, // 0: api.lists.list.v1alpha1.List.visibility:type_name -> api.types.VisibilityLevel
+	14, // 1: api.lists.list.v1alpha1.List.list_access:type_name -> api.lists.list.v1alpha1.List.ListAccess
+	17, // 2: api.lists.list.v1alpha1.List.create_time:type_name -> google.protobuf.Timestamp
+	17, // 3: api.lists.list.v1alpha1.List.update_time:type_name -> google.protobuf.Timestamp
+	13, // 4: api.lists.list.v1alpha1.List.sections:type_name -> api.lists.list.v1alpha1.List.ListSection
 	0,  // 5: api.lists.list.v1alpha1.CreateListRequest.list:type_name -> api.lists.list.v1alpha1.List
 	0,  // 6: api.lists.list.v1alpha1.ListListsResponse.lists:type_name -> api.lists.list.v1alpha1.List
 	0,  // 7: api.lists.list.v1alpha1.UpdateListRequest.list:type_name -> api.lists.list.v1alpha1.List
-	15, // 8: api.lists.list.v1alpha1.UpdateListRequest.update_mask:type_name -> google.protobuf.FieldMask
-	16, // 9: api.lists.list.v1alpha1.List.ListAccess.permission_level:type_name -> api.types.PermissionLevel
-	17, // 10: api.lists.list.v1alpha1.List.ListAccess.state:type_name -> api.types.AccessState
-	18, // 11: api.lists.list.v1alpha1.List.ListAccess.accept_target:type_name -> api.types.AcceptTarget
-	1,  // 12: api.lists.list.v1alpha1.ListService.CreateList:input_type -> api.lists.list.v1alpha1.CreateListRequest
-	2,  // 13: api.lists.list.v1alpha1.ListService.ListLists:input_type -> api.lists.list.v1alpha1.ListListsRequest
-	4,  // 14: api.lists.list.v1alpha1.ListService.UpdateList:input_type -> api.lists.list.v1alpha1.UpdateListRequest
-	5,  // 15: api.lists.list.v1alpha1.ListService.DeleteList:input_type -> api.lists.list.v1alpha1.DeleteListRequest
-	6,  // 16: api.lists.list.v1alpha1.ListService.GetList:input_type -> api.lists.list.v1alpha1.GetListRequest
-	7,  // 17: api.lists.list.v1alpha1.ListService.FavoriteList:input_type -> api.lists.list.v1alpha1.FavoriteListRequest
-	9,  // 18: api.lists.list.v1alpha1.ListService.UnfavoriteList:input_type -> api.lists.list.v1alpha1.UnfavoriteListRequest
-	0,  // 19: api.lists.list.v1alpha1.ListService.CreateList:output_type -> api.lists.list.v1alpha1.List
-	3,  // 20: api.lists.list.v1alpha1.ListService.ListLists:output_type -> api.lists.list.v1alpha1.ListListsResponse
-	0,  // 21: api.lists.list.v1alpha1.ListService.UpdateList:output_type -> api.lists.list.v1alpha1.List
-	0,  // 22: api.lists.list.v1alpha1.ListService.DeleteList:output_type -> api.lists.list.v1alpha1.List
-	0,  // 23: api.lists.list.v1alpha1.ListService.GetList:output_type -> api.lists.list.v1alpha1.List
-	8,  // 24: api.lists.list.v1alpha1.ListService.FavoriteList:output_type -> api.lists.list.v1alpha1.FavoriteListResponse
-	10, // 25: api.lists.list.v1alpha1.ListService.UnfavoriteList:output_type -> api.lists.list.v1alpha1.UnfavoriteListResponse
-	19, // [19:26] is the sub-list for method output_type
-	12, // [12:19] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	18, // 8: api.lists.list.v1alpha1.UpdateListRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 9: api.lists.list.v1alpha1.GenerateGroceryListRequest.new_list:type_name -> api.lists.list.v1alpha1.List
+	15, // 10: api.lists.list.v1alpha1.GenerateGroceryListRequest.recipes:type_name -> api.lists.list.v1alpha1.GenerateGroceryListRequest.RecipeSource
+	17, // 11: api.lists.list.v1alpha1.GenerateGroceryListRequest.start_time:type_name -> google.protobuf.Timestamp
+	17, // 12: api.lists.list.v1alpha1.GenerateGroceryListRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 13: api.lists.list.v1alpha1.GenerateGroceryListResponse.list:type_name -> api.lists.list.v1alpha1.List
+	19, // 14: api.lists.list.v1alpha1.GenerateGroceryListResponse.list_items:type_name -> api.lists.list.v1alpha1.ListItem
+	20, // 15: api.lists.list.v1alpha1.List.ListAccess.permission_level:type_name -> api.types.PermissionLevel
+	21, // 16: api.lists.list.v1alpha1.List.ListAccess.state:type_name -> api.types.AccessState
+	22, // 17: api.lists.list.v1alpha1.List.ListAccess.accept_target:type_name -> api.types.AcceptTarget
+	1,  // 18: api.lists.list.v1alpha1.ListService.CreateList:input_type -> api.lists.list.v1alpha1.CreateListRequest
+	2,  // 19: api.lists.list.v1alpha1.ListService.ListLists:input_type -> api.lists.list.v1alpha1.ListListsRequest
+	4,  // 20: api.lists.list.v1alpha1.ListService.UpdateList:input_type -> api.lists.list.v1alpha1.UpdateListRequest
+	5,  // 21: api.lists.list.v1alpha1.ListService.DeleteList:input_type -> api.lists.list.v1alpha1.DeleteListRequest
+	6,  // 22: api.lists.list.v1alpha1.ListService.GetList:input_type -> api.lists.list.v1alpha1.GetListRequest
+	7,  // 23: api.lists.list.v1alpha1.ListService.FavoriteList:input_type -> api.lists.list.v1alpha1.FavoriteListRequest
+	9,  // 24: api.lists.list.v1alpha1.ListService.UnfavoriteList:input_type -> api.lists.list.v1alpha1.UnfavoriteListRequest
+	11, // 25: api.lists.list.v1alpha1.ListService.GenerateGroceryList:input_type -> api.lists.list.v1alpha1.GenerateGroceryListRequest
+	0,  // 26: api.lists.list.v1alpha1.ListService.CreateList:output_type -> api.lists.list.v1alpha1.List
+	3,  // 27: api.lists.list.v1alpha1.ListService.ListLists:output_type -> api.lists.list.v1alpha1.ListListsResponse
+	0,  // 28: api.lists.list.v1alpha1.ListService.UpdateList:output_type -> api.lists.list.v1alpha1.List
+	0,  // 29: api.lists.list.v1alpha1.ListService.DeleteList:output_type -> api.lists.list.v1alpha1.List
+	0,  // 30: api.lists.list.v1alpha1.ListService.GetList:output_type -> api.lists.list.v1alpha1.List
+	8,  // 31: api.lists.list.v1alpha1.ListService.FavoriteList:output_type -> api.lists.list.v1alpha1.FavoriteListResponse
+	10, // 32: api.lists.list.v1alpha1.ListService.UnfavoriteList:output_type -> api.lists.list.v1alpha1.UnfavoriteListResponse
+	12, // 33: api.lists.list.v1alpha1.ListService.GenerateGroceryList:output_type -> api.lists.list.v1alpha1.GenerateGroceryListResponse
+	26, // [26:34] is the sub-list for method output_type
+	18, // [18:26] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_api_lists_list_v1alpha1_list_proto_init() }
@@ -952,13 +1205,14 @@ func file_api_lists_list_v1alpha1_list_proto_init() {
 	if File_api_lists_list_v1alpha1_list_proto != nil {
 		return
 	}
+	file_api_lists_list_v1alpha1_list_item_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_lists_list_v1alpha1_list_proto_rawDesc), len(file_api_lists_list_v1alpha1_list_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
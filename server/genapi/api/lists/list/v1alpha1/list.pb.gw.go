@@ -764,6 +764,78 @@ func local_request_ListService_UnfavoriteList_2(ctx context.Context, marshaler r
 	return msg, metadata, err
 }
 
+func request_ListService_GenerateGroceryList_0(ctx context.Context, marshaler runtime.Marshaler, client ListServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GenerateGroceryListRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.GenerateGroceryList(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_ListService_GenerateGroceryList_0(ctx context.Context, marshaler runtime.Marshaler, server ListServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GenerateGroceryListRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GenerateGroceryList(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_ListService_GenerateGroceryList_1(ctx context.Context, marshaler runtime.Marshaler, client ListServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GenerateGroceryListRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["list"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "list")
+	}
+	protoReq.List, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "list", err)
+	}
+	msg, err := client.GenerateGroceryList(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_ListService_GenerateGroceryList_1(ctx context.Context, marshaler runtime.Marshaler, server ListServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GenerateGroceryListRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["list"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "list")
+	}
+	protoReq.List, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "list", err)
+	}
+	msg, err := server.GenerateGroceryList(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterListServiceHandlerServer registers the http handlers for service ListService to "mux".
 // UnaryRPC     :call ListServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -1070,6 +1142,46 @@ func RegisterListServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 		}
 		forward_ListService_UnfavoriteList_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_ListService_GenerateGroceryList_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/api.lists.list.v1alpha1.ListService/GenerateGroceryList", runtime.WithHTTPPathPattern("/lists/v1alpha1/lists:generateGroceryList"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_ListService_GenerateGroceryList_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_ListService_GenerateGroceryList_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_ListService_GenerateGroceryList_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/api.lists.list.v1alpha1.ListService/GenerateGroceryList", runtime.WithHTTPPathPattern("/lists/v1alpha1/{list=lists/*}:generateGroceryList"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_ListService_GenerateGroceryList_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_ListService_GenerateGroceryList_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -1365,41 +1477,79 @@ func RegisterListServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux
 		}
 		forward_ListService_UnfavoriteList_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_ListService_GenerateGroceryList_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/api.lists.list.v1alpha1.ListService/GenerateGroceryList", runtime.WithHTTPPathPattern("/lists/v1alpha1/lists:generateGroceryList"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_ListService_GenerateGroceryList_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_ListService_GenerateGroceryList_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_ListService_GenerateGroceryList_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/api.lists.list.v1alpha1.ListService/GenerateGroceryList", runtime.WithHTTPPathPattern("/lists/v1alpha1/{list=lists/*}:generateGroceryList"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_ListService_GenerateGroceryList_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_ListService_GenerateGroceryList_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_ListService_CreateList_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0}, []string{"lists", "v1alpha1"}, ""))
-	pattern_ListService_CreateList_1     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "circles", "parent"}, ""))
-	pattern_ListService_CreateList_2     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "users", "parent"}, ""))
-	pattern_ListService_ListLists_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0}, []string{"lists", "v1alpha1"}, ""))
-	pattern_ListService_ListLists_1      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "circles", "parent"}, ""))
-	pattern_ListService_ListLists_2      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "users", "parent"}, ""))
-	pattern_ListService_UpdateList_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 4, 2, 5, 2}, []string{"lists", "v1alpha1", "list.name"}, ""))
-	pattern_ListService_DeleteList_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 4, 2, 5, 2}, []string{"lists", "v1alpha1", "name"}, ""))
-	pattern_ListService_GetList_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 4, 2, 5, 2}, []string{"lists", "v1alpha1", "name"}, ""))
-	pattern_ListService_FavoriteList_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3}, []string{"meals", "v1alpha1", "lists", "name"}, "favorite"))
-	pattern_ListService_FavoriteList_1   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "circles", "lists", "name"}, "favorite"))
-	pattern_ListService_FavoriteList_2   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "users", "lists", "name"}, "favorite"))
-	pattern_ListService_UnfavoriteList_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3}, []string{"meals", "v1alpha1", "lists", "name"}, "unfavorite"))
-	pattern_ListService_UnfavoriteList_1 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "circles", "lists", "name"}, "unfavorite"))
-	pattern_ListService_UnfavoriteList_2 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "users", "lists", "name"}, "unfavorite"))
+	pattern_ListService_CreateList_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0}, []string{"lists", "v1alpha1"}, ""))
+	pattern_ListService_CreateList_1          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "circles", "parent"}, ""))
+	pattern_ListService_CreateList_2          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "users", "parent"}, ""))
+	pattern_ListService_ListLists_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0}, []string{"lists", "v1alpha1"}, ""))
+	pattern_ListService_ListLists_1           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "circles", "parent"}, ""))
+	pattern_ListService_ListLists_2           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3, 2, 0}, []string{"lists", "v1alpha1", "users", "parent"}, ""))
+	pattern_ListService_UpdateList_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 4, 2, 5, 2}, []string{"lists", "v1alpha1", "list.name"}, ""))
+	pattern_ListService_DeleteList_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 4, 2, 5, 2}, []string{"lists", "v1alpha1", "name"}, ""))
+	pattern_ListService_GetList_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 4, 2, 5, 2}, []string{"lists", "v1alpha1", "name"}, ""))
+	pattern_ListService_FavoriteList_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3}, []string{"meals", "v1alpha1", "lists", "name"}, "favorite"))
+	pattern_ListService_FavoriteList_1        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "circles", "lists", "name"}, "favorite"))
+	pattern_ListService_FavoriteList_2        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "users", "lists", "name"}, "favorite"))
+	pattern_ListService_UnfavoriteList_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 2, 5, 3}, []string{"meals", "v1alpha1", "lists", "name"}, "unfavorite"))
+	pattern_ListService_UnfavoriteList_1      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "circles", "lists", "name"}, "unfavorite"))
+	pattern_ListService_UnfavoriteList_2      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 2, 3, 1, 0, 4, 4, 5, 4}, []string{"meals", "v1alpha1", "users", "lists", "name"}, "unfavorite"))
+	pattern_ListService_GenerateGroceryList_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0}, []string{"lists", "v1alpha1"}, "generateGroceryList"))
+	pattern_ListService_GenerateGroceryList_1 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 0, 1, 0, 4, 2, 5, 2}, []string{"lists", "v1alpha1", "list"}, "generateGroceryList"))
 )
 
 var (
-	forward_ListService_CreateList_0     = runtime.ForwardResponseMessage
-	forward_ListService_CreateList_1     = runtime.ForwardResponseMessage
-	forward_ListService_CreateList_2     = runtime.ForwardResponseMessage
-	forward_ListService_ListLists_0      = runtime.ForwardResponseMessage
-	forward_ListService_ListLists_1      = runtime.ForwardResponseMessage
-	forward_ListService_ListLists_2      = runtime.ForwardResponseMessage
-	forward_ListService_UpdateList_0     = runtime.ForwardResponseMessage
-	forward_ListService_DeleteList_0     = runtime.ForwardResponseMessage
-	forward_ListService_GetList_0        = runtime.ForwardResponseMessage
-	forward_ListService_FavoriteList_0   = runtime.ForwardResponseMessage
-	forward_ListService_FavoriteList_1   = runtime.ForwardResponseMessage
-	forward_ListService_FavoriteList_2   = runtime.ForwardResponseMessage
-	forward_ListService_UnfavoriteList_0 = runtime.ForwardResponseMessage
-	forward_ListService_UnfavoriteList_1 = runtime.ForwardResponseMessage
-	forward_ListService_UnfavoriteList_2 = runtime.ForwardResponseMessage
+	forward_ListService_CreateList_0          = runtime.ForwardResponseMessage
+	forward_ListService_CreateList_1          = runtime.ForwardResponseMessage
+	forward_ListService_CreateList_2          = runtime.ForwardResponseMessage
+	forward_ListService_ListLists_0           = runtime.ForwardResponseMessage
+	forward_ListService_ListLists_1           = runtime.ForwardResponseMessage
+	forward_ListService_ListLists_2           = runtime.ForwardResponseMessage
+	forward_ListService_UpdateList_0          = runtime.ForwardResponseMessage
+	forward_ListService_DeleteList_0          = runtime.ForwardResponseMessage
+	forward_ListService_GetList_0             = runtime.ForwardResponseMessage
+	forward_ListService_FavoriteList_0        = runtime.ForwardResponseMessage
+	forward_ListService_FavoriteList_1        = runtime.ForwardResponseMessage
+	forward_ListService_FavoriteList_2        = runtime.ForwardResponseMessage
+	forward_ListService_UnfavoriteList_0      = runtime.ForwardResponseMessage
+	forward_ListService_UnfavoriteList_1      = runtime.ForwardResponseMessage
+	forward_ListService_UnfavoriteList_2      = runtime.ForwardResponseMessage
+	forward_ListService_GenerateGroceryList_0 = runtime.ForwardResponseMessage
+	forward_ListService_GenerateGroceryList_1 = runtime.ForwardResponseMessage
 )
@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	ListService_CreateList_FullMethodName     = "/api.lists.list.v1alpha1.ListService/CreateList"
-	ListService_ListLists_FullMethodName      = "/api.lists.list.v1alpha1.ListService/ListLists"
-	ListService_UpdateList_FullMethodName     = "/api.lists.list.v1alpha1.ListService/UpdateList"
-	ListService_DeleteList_FullMethodName     = "/api.lists.list.v1alpha1.ListService/DeleteList"
-	ListService_GetList_FullMethodName        = "/api.lists.list.v1alpha1.ListService/GetList"
-	ListService_FavoriteList_FullMethodName   = "/api.lists.list.v1alpha1.ListService/FavoriteList"
-	ListService_UnfavoriteList_FullMethodName = "/api.lists.list.v1alpha1.ListService/UnfavoriteList"
+	ListService_CreateList_FullMethodName          = "/api.lists.list.v1alpha1.ListService/CreateList"
+	ListService_ListLists_FullMethodName           = "/api.lists.list.v1alpha1.ListService/ListLists"
+	ListService_UpdateList_FullMethodName          = "/api.lists.list.v1alpha1.ListService/UpdateList"
+	ListService_DeleteList_FullMethodName          = "/api.lists.list.v1alpha1.ListService/DeleteList"
+	ListService_GetList_FullMethodName             = "/api.lists.list.v1alpha1.ListService/GetList"
+	ListService_FavoriteList_FullMethodName        = "/api.lists.list.v1alpha1.ListService/FavoriteList"
+	ListService_UnfavoriteList_FullMethodName      = "/api.lists.list.v1alpha1.ListService/UnfavoriteList"
+	ListService_GenerateGroceryList_FullMethodName = "/api.lists.list.v1alpha1.ListService/GenerateGroceryList"
 )
 
 // ListServiceClient is the client API for ListService service.
@@ -48,6 +49,8 @@ type ListServiceClient interface {
 	FavoriteList(ctx context.Context, in *FavoriteListRequest, opts ...grpc.CallOption) (*FavoriteListResponse, error)
 	// unfavorite a list
 	UnfavoriteList(ctx context.Context, in *UnfavoriteListRequest, opts ...grpc.CallOption) (*UnfavoriteListResponse, error)
+	// generate a grocery list from recipes or a meal plan
+	GenerateGroceryList(ctx context.Context, in *GenerateGroceryListRequest, opts ...grpc.CallOption) (*GenerateGroceryListResponse, error)
 }
 
 type listServiceClient struct {
@@ -128,6 +131,16 @@ func (c *listServiceClient) UnfavoriteList(ctx context.Context, in *UnfavoriteLi
 	return out, nil
 }
 
+func (c *listServiceClient) GenerateGroceryList(ctx context.Context, in *GenerateGroceryListRequest, opts ...grpc.CallOption) (*GenerateGroceryListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateGroceryListResponse)
+	err := c.cc.Invoke(ctx, ListService_GenerateGroceryList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ListServiceServer is the server API for ListService service.
 // All implementations must embed UnimplementedListServiceServer
 // for forward compatibility.
@@ -148,6 +161,8 @@ type ListServiceServer interface {
 	FavoriteList(context.Context, *FavoriteListRequest) (*FavoriteListResponse, error)
 	// unfavorite a list
 	UnfavoriteList(context.Context, *UnfavoriteListRequest) (*UnfavoriteListResponse, error)
+	// generate a grocery list from recipes or a meal plan
+	GenerateGroceryList(context.Context, *GenerateGroceryListRequest) (*GenerateGroceryListResponse, error)
 	mustEmbedUnimplementedListServiceServer()
 }
 
@@ -179,6 +194,9 @@ func (UnimplementedListServiceServer) FavoriteList(context.Context, *FavoriteLis
 func (UnimplementedListServiceServer) UnfavoriteList(context.Context, *UnfavoriteListRequest) (*UnfavoriteListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfavoriteList not implemented")
 }
+func (UnimplementedListServiceServer) GenerateGroceryList(context.Context, *GenerateGroceryListRequest) (*GenerateGroceryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateGroceryList not implemented")
+}
 func (UnimplementedListServiceServer) mustEmbedUnimplementedListServiceServer() {}
 func (UnimplementedListServiceServer) testEmbeddedByValue()                     {}
 
@@ -326,6 +344,24 @@ func _ListService_UnfavoriteList_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListService_GenerateGroceryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateGroceryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListServiceServer).GenerateGroceryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListService_GenerateGroceryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListServiceServer).GenerateGroceryList(ctx, req.(*GenerateGroceryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ListService_ServiceDesc is the grpc.ServiceDesc for ListService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,6 +397,10 @@ var ListService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfavoriteList",
 			Handler:    _ListService_UnfavoriteList_Handler,
 		},
+		{
+			MethodName: "GenerateGroceryList",
+			Handler:    _ListService_GenerateGroceryList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/lists/list/v1alpha1/list.proto",
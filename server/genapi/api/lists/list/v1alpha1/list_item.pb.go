@@ -12,7 +12,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/durationpb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -39,12 +39,35 @@ type ListItem struct {
 	ListSection string `protobuf:"bytes,3,opt,name=list_section,json=listSection,proto3" json:"list_section,omitempty"`
 	// points
 	Points int32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
-	// the recurrence rule of the listItem
+	// the recurrence rule of the listItem, e.g. "FREQ=WEEKLY;BYDAY=SU". Each
+	// occurrence is a due time, starting from the day the listItem was created
+	// (UTC). A completion counts for the due times whose completion window
+	// opened before it.
 	RecurrenceRule string `protobuf:"bytes,5,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
 	// the time the listItem was created (UTC)
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// the time the listItem was last updated (UTC)
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// the recipes this listItem was generated from
+	SourceRecipes []string `protobuf:"bytes,8,rep,name=source_recipes,json=sourceRecipes,proto3" json:"source_recipes,omitempty"`
+	// whether the listItem has been completed in its current recurrence period
+	Completed bool `protobuf:"varint,9,opt,name=completed,proto3" json:"completed,omitempty"`
+	// how long before each due time the listItem can be completed. Defaults to
+	// the whole time since the previous due time, e.g. a weekly chore can be
+	// done any day of the week.
+	CompletionWindow *durationpb.Duration `protobuf:"bytes,10,opt,name=completion_window,json=completionWindow,proto3" json:"completion_window,omitempty"`
+	// the next due time of a recurring listItem that has not been completed
+	// for it yet (UTC)
+	NextDueTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_due_time,json=nextDueTime,proto3" json:"next_due_time,omitempty"`
+	// whether the next due time of the listItem has passed
+	IsOverdue bool `protobuf:"varint,12,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
+	// the users the listItem is assigned to. Each must be a member of a circle
+	// the list is shared with.
+	Assignees []string `protobuf:"bytes,13,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	// the member who has claimed the listItem, if any. Members can only claim
+	// listItems for themselves, and can claim or release them with read access
+	// to the list by updating only this field.
+	ClaimedBy     string `protobuf:"bytes,14,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -128,6 +151,55 @@ func (x *ListItem) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ListItem) GetSourceRecipes() []string {
+	if x != nil {
+		return x.SourceRecipes
+	}
+	return nil
+}
+
+func (x *ListItem) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ListItem) GetCompletionWindow() *durationpb.Duration {
+	if x != nil {
+		return x.CompletionWindow
+	}
+	return nil
+}
+
+func (x *ListItem) GetNextDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDueTime
+	}
+	return nil
+}
+
+func (x *ListItem) GetIsOverdue() bool {
+	if x != nil {
+		return x.IsOverdue
+	}
+	return false
+}
+
+func (x *ListItem) GetAssignees() []string {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+func (x *ListItem) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
 // the request to create a listItem
 type CreateListItemRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -462,7 +534,7 @@ var File_api_lists_list_v1alpha1_list_item_proto protoreflect.FileDescriptor
 
 const file_api_lists_list_v1alpha1_list_item_proto_rawDesc = "" +
 	"\n" +
-	"'api/lists/list/v1alpha1/list_item.proto\x12\x17api.lists.list.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x95\x03\n" +
+	"'api/lists/list/v1alpha1/list_item.proto\x12\x17api.lists.list.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xc8\x06\n" +
 	"\bListItem\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12&\n" +
@@ -472,7 +544,20 @@ const file_api_lists_list_v1alpha1_list_item_proto_rawDesc = "" +
 	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime:^\xeaA[\n" +
+	"updateTime\x12O\n" +
+	"\x0esource_recipes\x18\b \x03(\tB(\xe0A\x01\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\rsourceRecipes\x12!\n" +
+	"\tcompleted\x18\t \x01(\bB\x03\xe0A\x03R\tcompleted\x12K\n" +
+	"\x11completion_window\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\x10completionWindow\x12C\n" +
+	"\rnext_due_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vnextDueTime\x12\"\n" +
+	"\n" +
+	"is_overdue\x18\f \x01(\bB\x03\xe0A\x03R\tisOverdue\x12B\n" +
+	"\tassignees\x18\r \x03(\tB$\xe0A\x01\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\tassignees\x12C\n" +
+	"\n" +
+	"claimed_by\x18\x0e \x01(\tB$\xe0A\x01\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\tclaimedBy:^\xeaA[\n" +
 	" api.lists.list.v1alpha1/ListItem\x12\"lists/{list}/listItems/{list_item}*\tlistItems2\blistItem\"\x9a\x01\n" +
 	"\x15CreateListItemRequest\x12<\n" +
 	"\x06parent\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
@@ -498,13 +583,12 @@ const file_api_lists_list_v1alpha1_list_item_proto_rawDesc = "" +
 	" api.lists.list.v1alpha1/ListItemR\x04name\"R\n" +
 	"\x12GetListItemRequest\x12<\n" +
 	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
-	" api.lists.list.v1alpha1/ListItemR\x04name2\xc8\n" +
-	"\n" +
+	" api.lists.list.v1alpha1/ListItemR\x04name2\xb8\v\n" +
 	"\x0fListItemService\x12\x90\x02\n" +
 	"\x0eCreateListItem\x12..api.lists.list.v1alpha1.CreateListItemRequest\x1a!.api.lists.list.v1alpha1.ListItem\"\xaa\x01\x92AW\n" +
-	"\x0fListItemService\x12\x11Create a listItem\x1a1Creates a new listItem with the provided details.\xdaA\x10parent,list_item\x82\xd3\xe4\x93\x027:\tlist_item\"*/lists/v1alpha1/{parent=lists/*}/listItems\x12\xa6\x02\n" +
-	"\rListListItems\x12-.api.lists.list.v1alpha1.ListListItemsRequest\x1a..api.lists.list.v1alpha1.ListListItemsResponse\"\xb5\x01\x92Aw\n" +
-	"\x0fListItemService\x12\x13ListItem list_items\x1aORetrieves a paginated listItem of listItems. Supports filtering and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02,\x12*/lists/v1alpha1/{parent=lists/*}/listItems\x12\x9a\x02\n" +
+	"\x0fListItemService\x12\x11Create a listItem\x1a1Creates a new listItem with the provided details.\xdaA\x10parent,list_item\x82\xd3\xe4\x93\x027:\tlist_item\"*/lists/v1alpha1/{parent=lists/*}/listItems\x12\x96\x03\n" +
+	"\rListListItems\x12-.api.lists.list.v1alpha1.ListListItemsRequest\x1a..api.lists.list.v1alpha1.ListListItemsResponse\"\xa5\x02\x92A\xe6\x01\n" +
+	"\x0fListItemService\x12\x13ListItem list_items\x1a\xbd\x01Retrieves a paginated listItem of listItems. Supports filtering by title, points, next_due_time, is_overdue, assignee (e.g. assignee = users/123), unassigned and claimed_by, and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02,\x12*/lists/v1alpha1/{parent=lists/*}/listItems\x12\x9a\x02\n" +
 	"\x0eUpdateListItem\x12..api.lists.list.v1alpha1.UpdateListItemRequest\x1a!.api.lists.list.v1alpha1.ListItem\"\xb4\x01\x92AR\n" +
 	"\x0fListItemService\x12\x11Update a listItem\x1a,Updates the details of an existing listItem.\xdaA\x15list_item,update_mask\x82\xd3\xe4\x93\x02A:\tlist_item24/lists/v1alpha1/{list_item.name=lists/*/listItems/*}\x12\xec\x01\n" +
 	"\x0eDeleteListItem\x12..api.lists.list.v1alpha1.DeleteListItemRequest\x1a!.api.lists.list.v1alpha1.ListItem\"\x86\x01\x92AJ\n" +
@@ -541,30 +625,33 @@ var file_api_lists_list_v1alpha1_list_item_proto_goTypes = []any{
 	(*DeleteListItemRequest)(nil), // 5: api.lists.list.v1alpha1.DeleteListItemRequest
 	(*GetListItemRequest)(nil),    // 6: api.lists.list.v1alpha1.GetListItemRequest
 	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),   // 8: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
 }
 var file_api_lists_list_v1alpha1_list_item_proto_depIdxs = []int32{
 	7,  // 0: api.lists.list.v1alpha1.ListItem.create_time:type_name -> google.protobuf.Timestamp
 	7,  // 1: api.lists.list.v1alpha1.ListItem.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 2: api.lists.list.v1alpha1.CreateListItemRequest.list_item:type_name -> api.lists.list.v1alpha1.ListItem
-	0,  // 3: api.lists.list.v1alpha1.ListListItemsResponse.list_items:type_name -> api.lists.list.v1alpha1.ListItem
-	0,  // 4: api.lists.list.v1alpha1.UpdateListItemRequest.list_item:type_name -> api.lists.list.v1alpha1.ListItem
-	8,  // 5: api.lists.list.v1alpha1.UpdateListItemRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 6: api.lists.list.v1alpha1.ListItemService.CreateListItem:input_type -> api.lists.list.v1alpha1.CreateListItemRequest
-	2,  // 7: api.lists.list.v1alpha1.ListItemService.ListListItems:input_type -> api.lists.list.v1alpha1.ListListItemsRequest
-	4,  // 8: api.lists.list.v1alpha1.ListItemService.UpdateListItem:input_type -> api.lists.list.v1alpha1.UpdateListItemRequest
-	5,  // 9: api.lists.list.v1alpha1.ListItemService.DeleteListItem:input_type -> api.lists.list.v1alpha1.DeleteListItemRequest
-	6,  // 10: api.lists.list.v1alpha1.ListItemService.GetListItem:input_type -> api.lists.list.v1alpha1.GetListItemRequest
-	0,  // 11: api.lists.list.v1alpha1.ListItemService.CreateListItem:output_type -> api.lists.list.v1alpha1.ListItem
-	3,  // 12: api.lists.list.v1alpha1.ListItemService.ListListItems:output_type -> api.lists.list.v1alpha1.ListListItemsResponse
-	0,  // 13: api.lists.list.v1alpha1.ListItemService.UpdateListItem:output_type -> api.lists.list.v1alpha1.ListItem
-	0,  // 14: api.lists.list.v1alpha1.ListItemService.DeleteListItem:output_type -> api.lists.list.v1alpha1.ListItem
-	0,  // 15: api.lists.list.v1alpha1.ListItemService.GetListItem:output_type -> api.lists.list.v1alpha1.ListItem
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	8,  // 2: api.lists.list.v1alpha1.ListItem.completion_window:type_name -> google.protobuf.Duration
+	7,  // 3: api.lists.list.v1alpha1.ListItem.next_due_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: api.lists.list.v1alpha1.CreateListItemRequest.list_item:type_name -> api.lists.list.v1alpha1.ListItem
+	0,  // 5: api.lists.list.v1alpha1.ListListItemsResponse.list_items:type_name -> api.lists.list.v1alpha1.ListItem
+	0,  // 6: api.lists.list.v1alpha1.UpdateListItemRequest.list_item:type_name -> api.lists.list.v1alpha1.ListItem
+	9,  // 7: api.lists.list.v1alpha1.UpdateListItemRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: api.lists.list.v1alpha1.ListItemService.CreateListItem:input_type -> api.lists.list.v1alpha1.CreateListItemRequest
+	2,  // 9: api.lists.list.v1alpha1.ListItemService.ListListItems:input_type -> api.lists.list.v1alpha1.ListListItemsRequest
+	4,  // 10: api.lists.list.v1alpha1.ListItemService.UpdateListItem:input_type -> api.lists.list.v1alpha1.UpdateListItemRequest
+	5,  // 11: api.lists.list.v1alpha1.ListItemService.DeleteListItem:input_type -> api.lists.list.v1alpha1.DeleteListItemRequest
+	6,  // 12: api.lists.list.v1alpha1.ListItemService.GetListItem:input_type -> api.lists.list.v1alpha1.GetListItemRequest
+	0,  // 13: api.lists.list.v1alpha1.ListItemService.CreateListItem:output_type -> api.lists.list.v1alpha1.ListItem
+	3,  // 14: api.lists.list.v1alpha1.ListItemService.ListListItems:output_type -> api.lists.list.v1alpha1.ListListItemsResponse
+	0,  // 15: api.lists.list.v1alpha1.ListItemService.UpdateListItem:output_type -> api.lists.list.v1alpha1.ListItem
+	0,  // 16: api.lists.list.v1alpha1.ListItemService.DeleteListItem:output_type -> api.lists.list.v1alpha1.ListItem
+	0,  // 17: api.lists.list.v1alpha1.ListItemService.GetListItem:output_type -> api.lists.list.v1alpha1.ListItem
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_lists_list_v1alpha1_list_item_proto_init() }
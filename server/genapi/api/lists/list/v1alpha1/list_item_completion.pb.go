@@ -33,18 +33,26 @@ type ListItemCompletion struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// the name of the listItemCompletion
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// the title of the listItemCompletion
+	// the title of the listItem when it was completed
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// List Section
+	// the section of the listItem when it was completed
 	ListSection string `protobuf:"bytes,3,opt,name=list_section,json=listSection,proto3" json:"list_section,omitempty"`
-	// points
+	// the points of the listItem when it was completed
 	Points int32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
 	// the time the listItemCompletion was created (UTC)
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// the time the listItemCompletion was last updated (UTC)
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// the user who completed the listItem
+	User string `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
+	// the circle the user was acting as when completing the listItem, if any
+	Circle string `protobuf:"bytes,8,opt,name=circle,proto3" json:"circle,omitempty"`
+	// the time the listItem was completed (UTC), defaults to now
+	CompleteTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	// whether the user was assigned the listItem when completing it
+	CompletedByAssignee bool `protobuf:"varint,10,opt,name=completed_by_assignee,json=completedByAssignee,proto3" json:"completed_by_assignee,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ListItemCompletion) Reset() {
@@ -119,6 +127,34 @@ func (x *ListItemCompletion) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ListItemCompletion) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListItemCompletion) GetCircle() string {
+	if x != nil {
+		return x.Circle
+	}
+	return ""
+}
+
+func (x *ListItemCompletion) GetCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return nil
+}
+
+func (x *ListItemCompletion) GetCompletedByAssignee() bool {
+	if x != nil {
+		return x.CompletedByAssignee
+	}
+	return false
+}
+
 // the request to create a listItemCompletion
 type CreateListItemCompletionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -126,8 +162,10 @@ type CreateListItemCompletionRequest struct {
 	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	// the listItemCompletion to create
 	ListItemCompletion *ListItemCompletion `protobuf:"bytes,2,opt,name=list_item_completion,json=listItemCompletion,proto3" json:"list_item_completion,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// the pantry to restock with the list item, if any
+	Pantry        string `protobuf:"bytes,3,opt,name=pantry,proto3" json:"pantry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateListItemCompletionRequest) Reset() {
@@ -175,6 +213,13 @@ func (x *CreateListItemCompletionRequest) GetListItemCompletion() *ListItemCompl
 }
 
 // the request to listItemCompletion listItemCompletions
+func (x *CreateListItemCompletionRequest) GetPantry() string {
+	if x != nil {
+		return x.Pantry
+	}
+	return ""
+}
+
 type ListListItemCompletionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// the parent of the listItemCompletions
@@ -453,24 +498,33 @@ var File_api_lists_list_v1alpha1_list_item_completion_proto protoreflect.FileDes
 
 const file_api_lists_list_v1alpha1_list_item_completion_proto_rawDesc = "" +
 	"\n" +
-	"2api/lists/list/v1alpha1/list_item_completion.proto\x12\x17api.lists.list.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xbc\x03\n" +
+	"2api/lists/list/v1alpha1/list_item_completion.proto\x12\x17api.lists.list.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb9\x05\n" +
 	"\x12ListItemCompletion\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12&\n" +
-	"\flist_section\x18\x03 \x01(\tB\x03\xe0A\x02R\vlistSection\x12\x1b\n" +
-	"\x06points\x18\x04 \x01(\x05B\x03\xe0A\x02R\x06points\x12@\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x03R\x05title\x12&\n" +
+	"\flist_section\x18\x03 \x01(\tB\x03\xe0A\x03R\vlistSection\x12\x1b\n" +
+	"\x06points\x18\x04 \x01(\x05B\x03\xe0A\x03R\x06points\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime:\xa8\x01\xeaA\xa4\x01\n" +
-	"*api.lists.list.v1alpha1/ListItemCompletion\x12Mlists/{list}/listItems/{list_item}/listItemCompletions/{list_item_completion}*\x13listItemCompletions2\x12listItemCompletion\"\xc7\x01\n" +
+	"updateTime\x128\n" +
+	"\x04user\x18\a \x01(\tB$\xe0A\x03\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\x04user\x12B\n" +
+	"\x06circle\x18\b \x01(\tB*\xe0A\x03\xfaA$\n" +
+	"\"api.circles.circle.v1alpha1/CircleR\x06circle\x12D\n" +
+	"\rcomplete_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\fcompleteTime\x127\n" +
+	"\x15completed_by_assignee\x18\n" +
+	" \x01(\bB\x03\xe0A\x03R\x13completedByAssignee:\xa8\x01\xeaA\xa4\x01\n" +
+	"*api.lists.list.v1alpha1/ListItemCompletion\x12Mlists/{list}/listItems/{list_item}/listItemCompletions/{list_item_completion}*\x13listItemCompletions2\x12listItemCompletion\"\x8c\x02\n" +
 	"\x1fCreateListItemCompletionRequest\x12@\n" +
 	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
-	" api.lists/list.v1alpha1/ListItemR\x06parent\x12b\n" +
-	"\x14list_item_completion\x18\x02 \x01(\v2+.api.lists.list.v1alpha1.ListItemCompletionB\x03\xe0A\x02R\x12listItemCompletion\"\xc5\x01\n" +
+	" api.lists.list.v1alpha1/ListItemR\x06parent\x12b\n" +
+	"\x14list_item_completion\x18\x02 \x01(\v2+.api.lists.list.v1alpha1.ListItemCompletionB\x03\xe0A\x02R\x12listItemCompletion\x12C\n" +
+	"\x06pantry\x18\x03 \x01(\tB+\xe0A\x01\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x06pantry\"\xc5\x01\n" +
 	"\x1eListListItemCompletionsRequest\x12@\n" +
 	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
-	" api.lists/list.v1alpha1/ListItemR\x06parent\x12 \n" +
+	" api.lists.list.v1alpha1/ListItemR\x06parent\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
@@ -487,14 +541,14 @@ const file_api_lists_list_v1alpha1_list_item_completion_proto_rawDesc = "" +
 	"*api.lists.list.v1alpha1/ListItemCompletionR\x04name\"f\n" +
 	"\x1cGetListItemCompletionRequest\x12F\n" +
 	"\x04name\x18\x01 \x01(\tB2\xe0A\x02\xfaA,\n" +
-	"*api.lists.list.v1alpha1/ListItemCompletionR\x04name2\xae\x0e\n" +
-	"\x19ListItemCompletionService\x12\xf8\x02\n" +
-	"\x18CreateListItemCompletion\x128.api.lists.list.v1alpha1.CreateListItemCompletionRequest\x1a+.api.lists.list.v1alpha1.ListItemCompletion\"\xf4\x01\x92Au\n" +
-	"\x19ListItemCompletionService\x12\x1bCreate a listItemCompletion\x1a;Creates a new listItemCompletion with the provided details.\xdaA\x1bparent,list_item_completion\x82\xd3\xe4\x93\x02X:\x14list_item_completion\"@/lists/v1alpha1/{parent=lists/*/listItems/*}/listItemCompletions\x12\x83\x03\n" +
+	"*api.lists.list.v1alpha1/ListItemCompletionR\x04name2\x90\x0f\n" +
+	"\x19ListItemCompletionService\x12\xd4\x03\n" +
+	"\x18CreateListItemCompletion\x128.api.lists.list.v1alpha1.CreateListItemCompletionRequest\x1a+.api.lists.list.v1alpha1.ListItemCompletion\"\xd0\x02\x92A\xd0\x01\n" +
+	"\x19ListItemCompletionService\x12\x1bCreate a listItemCompletion\x1a\x95\x01Checks off a listItem. The title, section and points of the listItem are copied onto the completion, along with whether the caller is assigned to it.\xdaA\x1bparent,list_item_completion\x82\xd3\xe4\x93\x02X:\x14list_item_completion\"@/lists/v1alpha1/{parent=lists/*/listItems/*}/listItemCompletions\x12\x83\x03\n" +
 	"\x17ListListItemCompletions\x127.api.lists.list.v1alpha1.ListListItemCompletionsRequest\x1a8.api.lists.list.v1alpha1.ListListItemCompletionsResponse\"\xf4\x01\x92A\x9f\x01\n" +
-	"\x19ListItemCompletionService\x12\x1dListItemCompletion list_items\x1acRetrieves a paginated listItemCompletion of listItemCompletions. Supports filtering and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02B\x12@/lists/v1alpha1/{parent=lists/*/listItems/*}/listItemCompletions\x12\x8d\x03\n" +
-	"\x18UpdateListItemCompletion\x128.api.lists.list.v1alpha1.UpdateListItemCompletionRequest\x1a+.api.lists.list.v1alpha1.ListItemCompletion\"\x89\x02\x92Ap\n" +
-	"\x19ListItemCompletionService\x12\x1bUpdate a listItemCompletion\x1a6Updates the details of an existing listItemCompletion.\xdaA list_item_completion,update_mask\x82\xd3\xe4\x93\x02m:\x14list_item_completion2U/lists/v1alpha1/{list_item_completion.name=lists/*/listItems/*/listItemCompletions/*}\x12\xbe\x02\n" +
+	"\x19ListItemCompletionService\x12\x1dListItemCompletion list_items\x1acRetrieves a paginated listItemCompletion of listItemCompletions. Supports filtering and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02B\x12@/lists/v1alpha1/{parent=lists/*/listItems/*}/listItemCompletions\x12\x93\x03\n" +
+	"\x18UpdateListItemCompletion\x128.api.lists.list.v1alpha1.UpdateListItemCompletionRequest\x1a+.api.lists.list.v1alpha1.ListItemCompletion\"\x8f\x02\x92Av\n" +
+	"\x19ListItemCompletionService\x12\x1bUpdate a listItemCompletion\x1a<Updates the complete time of an existing listItemCompletion.\xdaA list_item_completion,update_mask\x82\xd3\xe4\x93\x02m:\x14list_item_completion2U/lists/v1alpha1/{list_item_completion.name=lists/*/listItems/*/listItemCompletions/*}\x12\xbe\x02\n" +
 	"\x18DeleteListItemCompletion\x128.api.lists.list.v1alpha1.DeleteListItemCompletionRequest\x1a+.api.lists.list.v1alpha1.ListItemCompletion\"\xba\x01\x92Ah\n" +
 	"\x19ListItemCompletionService\x12\x1bDelete a listItemCompletion\x1a.Deletes a listItemCompletion by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02B*@/lists/v1alpha1/{name=lists/*/listItems/*/listItemCompletions/*}\x12\xbe\x02\n" +
 	"\x15GetListItemCompletion\x125.api.lists.list.v1alpha1.GetListItemCompletionRequest\x1a+.api.lists.list.v1alpha1.ListItemCompletion\"\xc0\x01\x92An\n" +
@@ -534,25 +588,26 @@ var file_api_lists_list_v1alpha1_list_item_completion_proto_goTypes = []any{
 var file_api_lists_list_v1alpha1_list_item_completion_proto_depIdxs = []int32{
 	7,  // 0: api.lists.list.v1alpha1.ListItemCompletion.create_time:type_name -> google.protobuf.Timestamp
 	7,  // 1: api.lists.list.v1alpha1.ListItemCompletion.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 2: api.lists.list.v1alpha1.CreateListItemCompletionRequest.list_item_completion:type_name -> api.lists.list.v1alpha1.ListItemCompletion
-	0,  // 3: api.lists.list.v1alpha1.ListListItemCompletionsResponse.list_item_completions:type_name -> api.lists.list.v1alpha1.ListItemCompletion
-	0,  // 4: api.lists.list.v1alpha1.UpdateListItemCompletionRequest.list_item_completion:type_name -> api.lists.list.v1alpha1.ListItemCompletion
-	8,  // 5: api.lists.list.v1alpha1.UpdateListItemCompletionRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 6: api.lists.list.v1alpha1.ListItemCompletionService.CreateListItemCompletion:input_type -> api.lists.list.v1alpha1.CreateListItemCompletionRequest
-	2,  // 7: api.lists.list.v1alpha1.ListItemCompletionService.ListListItemCompletions:input_type -> api.lists.list.v1alpha1.ListListItemCompletionsRequest
-	4,  // 8: api.lists.list.v1alpha1.ListItemCompletionService.UpdateListItemCompletion:input_type -> api.lists.list.v1alpha1.UpdateListItemCompletionRequest
-	5,  // 9: api.lists.list.v1alpha1.ListItemCompletionService.DeleteListItemCompletion:input_type -> api.lists.list.v1alpha1.DeleteListItemCompletionRequest
-	6,  // 10: api.lists.list.v1alpha1.ListItemCompletionService.GetListItemCompletion:input_type -> api.lists.list.v1alpha1.GetListItemCompletionRequest
-	0,  // 11: api.lists.list.v1alpha1.ListItemCompletionService.CreateListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
-	3,  // 12: api.lists.list.v1alpha1.ListItemCompletionService.ListListItemCompletions:output_type -> api.lists.list.v1alpha1.ListListItemCompletionsResponse
-	0,  // 13: api.lists.list.v1alpha1.ListItemCompletionService.UpdateListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
-	0,  // 14: api.lists.list.v1alpha1.ListItemCompletionService.DeleteListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
-	0,  // 15: api.lists.list.v1alpha1.ListItemCompletionService.GetListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	7,  // 2: api.lists.list.v1alpha1.ListItemCompletion.complete_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: api.lists.list.v1alpha1.CreateListItemCompletionRequest.list_item_completion:type_name -> api.lists.list.v1alpha1.ListItemCompletion
+	0,  // 4: api.lists.list.v1alpha1.ListListItemCompletionsResponse.list_item_completions:type_name -> api.lists.list.v1alpha1.ListItemCompletion
+	0,  // 5: api.lists.list.v1alpha1.UpdateListItemCompletionRequest.list_item_completion:type_name -> api.lists.list.v1alpha1.ListItemCompletion
+	8,  // 6: api.lists.list.v1alpha1.UpdateListItemCompletionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 7: api.lists.list.v1alpha1.ListItemCompletionService.CreateListItemCompletion:input_type -> api.lists.list.v1alpha1.CreateListItemCompletionRequest
+	2,  // 8: api.lists.list.v1alpha1.ListItemCompletionService.ListListItemCompletions:input_type -> api.lists.list.v1alpha1.ListListItemCompletionsRequest
+	4,  // 9: api.lists.list.v1alpha1.ListItemCompletionService.UpdateListItemCompletion:input_type -> api.lists.list.v1alpha1.UpdateListItemCompletionRequest
+	5,  // 10: api.lists.list.v1alpha1.ListItemCompletionService.DeleteListItemCompletion:input_type -> api.lists.list.v1alpha1.DeleteListItemCompletionRequest
+	6,  // 11: api.lists.list.v1alpha1.ListItemCompletionService.GetListItemCompletion:input_type -> api.lists.list.v1alpha1.GetListItemCompletionRequest
+	0,  // 12: api.lists.list.v1alpha1.ListItemCompletionService.CreateListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
+	3,  // 13: api.lists.list.v1alpha1.ListItemCompletionService.ListListItemCompletions:output_type -> api.lists.list.v1alpha1.ListListItemCompletionsResponse
+	0,  // 14: api.lists.list.v1alpha1.ListItemCompletionService.UpdateListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
+	0,  // 15: api.lists.list.v1alpha1.ListItemCompletionService.DeleteListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
+	0,  // 16: api.lists.list.v1alpha1.ListItemCompletionService.GetListItemCompletion:output_type -> api.lists.list.v1alpha1.ListItemCompletion
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_api_lists_list_v1alpha1_list_item_completion_proto_init() }
@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/cookbook/v1alpha1/cookbook.proto
+
+package cookbookv1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	types "github.com/jcfug8/daylear/server/genapi/api/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a named, ordered collection of recipes
+type Cookbook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cookbook
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the title of the cookbook
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the cookbook
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// the visibility of the cookbook
+	Visibility types.VisibilityLevel `protobuf:"varint,4,opt,name=visibility,proto3,enum=api.types.VisibilityLevel" json:"visibility,omitempty"`
+	// the access details for the current user/circle
+	CookbookAccess *Cookbook_CookbookAccess `protobuf:"bytes,5,opt,name=cookbook_access,json=cookbookAccess,proto3" json:"cookbook_access,omitempty"`
+	// the time the cookbook was created (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the cookbook was last updated (UTC)
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cookbook) Reset() {
+	*x = Cookbook{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cookbook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cookbook) ProtoMessage() {}
+
+func (x *Cookbook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cookbook.ProtoReflect.Descriptor instead.
+func (*Cookbook) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cookbook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cookbook) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Cookbook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Cookbook) GetVisibility() types.VisibilityLevel {
+	if x != nil {
+		return x.Visibility
+	}
+	return types.VisibilityLevel(0)
+}
+
+func (x *Cookbook) GetCookbookAccess() *Cookbook_CookbookAccess {
+	if x != nil {
+		return x.CookbookAccess
+	}
+	return nil
+}
+
+func (x *Cookbook) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Cookbook) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// the request to create a cookbook
+type CreateCookbookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cookbook to create
+	Cookbook *Cookbook `protobuf:"bytes,1,opt,name=cookbook,proto3" json:"cookbook,omitempty"`
+	// the parent of the cookbook
+	Parent        string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCookbookRequest) Reset() {
+	*x = CreateCookbookRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCookbookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCookbookRequest) ProtoMessage() {}
+
+func (x *CreateCookbookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCookbookRequest.ProtoReflect.Descriptor instead.
+func (*CreateCookbookRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCookbookRequest) GetCookbook() *Cookbook {
+	if x != nil {
+		return x.Cookbook
+	}
+	return nil
+}
+
+func (x *CreateCookbookRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// the request to list cookbooks
+type ListCookbooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// returned page
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// used to specify the filter
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// the parent of the cookbooks
+	Parent        string `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookbooksRequest) Reset() {
+	*x = ListCookbooksRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookbooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookbooksRequest) ProtoMessage() {}
+
+func (x *ListCookbooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookbooksRequest.ProtoReflect.Descriptor instead.
+func (*ListCookbooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCookbooksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCookbooksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCookbooksRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListCookbooksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// the response to list cookbooks
+type ListCookbooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cookbooks
+	Cookbooks []*Cookbook `protobuf:"bytes,1,rep,name=cookbooks,proto3" json:"cookbooks,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookbooksResponse) Reset() {
+	*x = ListCookbooksResponse{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookbooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookbooksResponse) ProtoMessage() {}
+
+func (x *ListCookbooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookbooksResponse.ProtoReflect.Descriptor instead.
+func (*ListCookbooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCookbooksResponse) GetCookbooks() []*Cookbook {
+	if x != nil {
+		return x.Cookbooks
+	}
+	return nil
+}
+
+func (x *ListCookbooksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to update a cookbook
+type UpdateCookbookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cookbook to update
+	Cookbook *Cookbook `protobuf:"bytes,1,opt,name=cookbook,proto3" json:"cookbook,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCookbookRequest) Reset() {
+	*x = UpdateCookbookRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCookbookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCookbookRequest) ProtoMessage() {}
+
+func (x *UpdateCookbookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCookbookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCookbookRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateCookbookRequest) GetCookbook() *Cookbook {
+	if x != nil {
+		return x.Cookbook
+	}
+	return nil
+}
+
+func (x *UpdateCookbookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to delete a cookbook
+type DeleteCookbookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cookbook
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCookbookRequest) Reset() {
+	*x = DeleteCookbookRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCookbookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCookbookRequest) ProtoMessage() {}
+
+func (x *DeleteCookbookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCookbookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCookbookRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCookbookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to get a cookbook
+type GetCookbookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cookbook
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCookbookRequest) Reset() {
+	*x = GetCookbookRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCookbookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCookbookRequest) ProtoMessage() {}
+
+func (x *GetCookbookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCookbookRequest.ProtoReflect.Descriptor instead.
+func (*GetCookbookRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCookbookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the cookbook access details
+type Cookbook_CookbookAccess struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cookbook access
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the permission of the cookbook
+	PermissionLevel types.PermissionLevel `protobuf:"varint,2,opt,name=permission_level,json=permissionLevel,proto3,enum=api.types.PermissionLevel" json:"permission_level,omitempty"`
+	// the access state of the user to the cookbook
+	State types.AccessState `protobuf:"varint,3,opt,name=state,proto3,enum=api.types.AccessState" json:"state,omitempty"`
+	// the accept target of the cookbook
+	AcceptTarget  types.AcceptTarget `protobuf:"varint,4,opt,name=accept_target,json=acceptTarget,proto3,enum=api.types.AcceptTarget" json:"accept_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cookbook_CookbookAccess) Reset() {
+	*x = Cookbook_CookbookAccess{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cookbook_CookbookAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cookbook_CookbookAccess) ProtoMessage() {}
+
+func (x *Cookbook_CookbookAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cookbook_CookbookAccess.ProtoReflect.Descriptor instead.
+func (*Cookbook_CookbookAccess) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Cookbook_CookbookAccess) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cookbook_CookbookAccess) GetPermissionLevel() types.PermissionLevel {
+	if x != nil {
+		return x.PermissionLevel
+	}
+	return types.PermissionLevel(0)
+}
+
+func (x *Cookbook_CookbookAccess) GetState() types.AccessState {
+	if x != nil {
+		return x.State
+	}
+	return types.AccessState(0)
+}
+
+func (x *Cookbook_CookbookAccess) GetAcceptTarget() types.AcceptTarget {
+	if x != nil {
+		return x.AcceptTarget
+	}
+	return types.AcceptTarget(0)
+}
+
+var File_api_meals_cookbook_v1alpha1_cookbook_proto protoreflect.FileDescriptor
+
+const file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDesc = "" +
+	"\n" +
+	"*api/meals/cookbook/v1alpha1/cookbook.proto\x12\x1bapi.meals.cookbook.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9e\x06\n" +
+	"\bCookbook\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x01R\vdescription\x12?\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x1a.api.types.VisibilityLevelB\x03\xe0A\x02R\n" +
+	"visibility\x12b\n" +
+	"\x0fcookbook_access\x18\x05 \x01(\v24.api.meals.cookbook.v1alpha1.Cookbook.CookbookAccessB\x03\xe0A\x03R\x0ecookbookAccess\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x1a\xeb\x01\n" +
+	"\x0eCookbookAccess\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12J\n" +
+	"\x10permission_level\x18\x02 \x01(\x0e2\x1a.api.types.PermissionLevelB\x03\xe0A\x03R\x0fpermissionLevel\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x16.api.types.AccessStateB\x03\xe0A\x03R\x05state\x12A\n" +
+	"\raccept_target\x18\x04 \x01(\x0e2\x17.api.types.AcceptTargetB\x03\xe0A\x03R\facceptTarget:\x9f\x01\xeaA\x9b\x01\n" +
+	"$api.meals.cookbook.v1alpha1/Cookbook\x12\x14cookbooks/{cookbook}\x12%circles/{circle}/cookbooks/{cookbook}\x12!users/{user}/cookbooks/{cookbook}*\tcookbooks2\bcookbook\"\xa5\x01\n" +
+	"\x15CreateCookbookRequest\x12F\n" +
+	"\bcookbook\x18\x01 \x01(\v2%.api.meals.cookbook.v1alpha1.CookbookB\x03\xe0A\x02R\bcookbook\x12D\n" +
+	"\x06parent\x18\x02 \x01(\tB,\xe0A\x01\xfaA&\x12$api.meals.cookbook.v1alpha1/CookbookR\x06parent\"\xbf\x01\n" +
+	"\x14ListCookbooksRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12D\n" +
+	"\x06parent\x18\x04 \x01(\tB,\xe0A\x01\xfaA&\x12$api.meals.cookbook.v1alpha1/CookbookR\x06parent\"\x84\x01\n" +
+	"\x15ListCookbooksResponse\x12C\n" +
+	"\tcookbooks\x18\x01 \x03(\v2%.api.meals.cookbook.v1alpha1.CookbookR\tcookbooks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa1\x01\n" +
+	"\x15UpdateCookbookRequest\x12F\n" +
+	"\bcookbook\x18\x01 \x01(\v2%.api.meals.cookbook.v1alpha1.CookbookB\x03\xe0A\x02R\bcookbook\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"Y\n" +
+	"\x15DeleteCookbookRequest\x12@\n" +
+	"\x04name\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.cookbook.v1alpha1/CookbookR\x04name\"V\n" +
+	"\x12GetCookbookRequest\x12@\n" +
+	"\x04name\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.cookbook.v1alpha1/CookbookR\x04name2\x90\f\n" +
+	"\x0fCookbookService\x12\xf8\x02\n" +
+	"\x0eCreateCookbook\x122.api.meals.cookbook.v1alpha1.CreateCookbookRequest\x1a%.api.meals.cookbook.v1alpha1.Cookbook\"\x8a\x02\x92AW\n" +
+	"\x0fCookbookService\x12\x11Create a cookbook\x1a1Creates a new cookbook with the provided details.\xdaA\x0fparent,cookbook\x82\xd3\xe4\x93\x02\x97\x01:\bcookbookZ8:\bcookbook\",/meals/v1alpha1/{parent=circles/*}/cookbooksZ6:\bcookbook\"*/meals/v1alpha1/{parent=users/*}/cookbooks\"\x19/meals/v1alpha1/cookbooks\x12\xf2\x02\n" +
+	"\rListCookbooks\x121.api.meals.cookbook.v1alpha1.ListCookbooksRequest\x1a2.api.meals.cookbook.v1alpha1.ListCookbooksResponse\"\xf9\x01\x92An\n" +
+	"\x0fCookbookService\x12\x0eList cookbooks\x1aKRetrieves a paginated list of cookbooks. Supports filtering and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02yZ.\x12,/meals/v1alpha1/{parent=circles/*}/cookbooksZ,\x12*/meals/v1alpha1/{parent=users/*}/cookbooks\x12\x19/meals/v1alpha1/cookbooks\x12\x97\x02\n" +
+	"\x0eUpdateCookbook\x122.api.meals.cookbook.v1alpha1.UpdateCookbookRequest\x1a%.api.meals.cookbook.v1alpha1.Cookbook\"\xa9\x01\x92AR\n" +
+	"\x0fCookbookService\x12\x11Update a cookbook\x1a,Updates the details of an existing cookbook.\xdaA\x14cookbook,update_mask\x82\xd3\xe4\x93\x027:\bcookbook2+/meals/v1alpha1/{cookbook.name=cookbooks/*}\x12\x83\x02\n" +
+	"\x0eDeleteCookbook\x122.api.meals.cookbook.v1alpha1.DeleteCookbookRequest\x1a%.api.meals.cookbook.v1alpha1.Cookbook\"\x95\x01\x92Aa\n" +
+	"\x0fCookbookService\x12\x11Delete a cookbook\x1a;Deletes a cookbook and all of its entries by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/meals/v1alpha1/{name=cookbooks/*}\x12\xec\x01\n" +
+	"\vGetCookbook\x12/.api.meals.cookbook.v1alpha1.GetCookbookRequest\x1a%.api.meals.cookbook.v1alpha1.Cookbook\"\x84\x01\x92AP\n" +
+	"\x0fCookbookService\x12\x0eGet a cookbook\x1a-Retrieves a single cookbook by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/meals/v1alpha1/{name=cookbooks/*}B\xf0\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1fcom.api.meals.cookbook.v1alpha1B\rCookbookProtoP\x01ZTgithub.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1;cookbookv1alpha1\xa2\x02\x03AMC\xaa\x02\x1bApi.Meals.Cookbook.V1alpha1\xca\x02\x1bApi\\Meals\\Cookbook\\V1alpha1\xe2\x02'Api\\Meals\\Cookbook\\V1alpha1\\GPBMetadata\xea\x02\x1eApi::Meals::Cookbook::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescOnce sync.Once
+	file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescData []byte
+)
+
+func file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescGZIP() []byte {
+	file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescOnce.Do(func() {
+		file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDesc), len(file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDesc)))
+	})
+	return file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDescData
+}
+
+var file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_meals_cookbook_v1alpha1_cookbook_proto_goTypes = []any{
+	(*Cookbook)(nil),                // 0: api.meals.cookbook.v1alpha1.Cookbook
+	(*CreateCookbookRequest)(nil),   // 1: api.meals.cookbook.v1alpha1.CreateCookbookRequest
+	(*ListCookbooksRequest)(nil),    // 2: api.meals.cookbook.v1alpha1.ListCookbooksRequest
+	(*ListCookbooksResponse)(nil),   // 3: api.meals.cookbook.v1alpha1.ListCookbooksResponse
+	(*UpdateCookbookRequest)(nil),   // 4: api.meals.cookbook.v1alpha1.UpdateCookbookRequest
+	(*DeleteCookbookRequest)(nil),   // 5: api.meals.cookbook.v1alpha1.DeleteCookbookRequest
+	(*GetCookbookRequest)(nil),      // 6: api.meals.cookbook.v1alpha1.GetCookbookRequest
+	(*Cookbook_CookbookAccess)(nil), // 7: api.meals.cookbook.v1alpha1.Cookbook.CookbookAccess
+	(types.VisibilityLevel)(0),      // 8: api.types.VisibilityLevel
+	(*timestamppb.Timestamp)(nil),   // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),   // 10: google.protobuf.FieldMask
+	(types.PermissionLevel)(0),      // 11: api.types.PermissionLevel
+	(types.AccessState)(0),          // 12: api.types.AccessState
+	(types.AcceptTarget)(0),         // 13: api.types.AcceptTarget
+}
+var file_api_meals_cookbook_v1alpha1_cookbook_proto_depIdxs = []int32{
+	8,  // 0: api.meals.cookbook.v1alpha1.Cookbook.visibility:type_name -> api.types.VisibilityLevel
+	7,  // 1: api.meals.cookbook.v1alpha1.Cookbook.cookbook_access:type_name -> api.meals.cookbook.v1alpha1.Cookbook.CookbookAccess
+	9,  // 2: api.meals.cookbook.v1alpha1.Cookbook.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 3: api.meals.cookbook.v1alpha1.Cookbook.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: api.meals.cookbook.v1alpha1.CreateCookbookRequest.cookbook:type_name -> api.meals.cookbook.v1alpha1.Cookbook
+	0,  // 5: api.meals.cookbook.v1alpha1.ListCookbooksResponse.cookbooks:type_name -> api.meals.cookbook.v1alpha1.Cookbook
+	0,  // 6: api.meals.cookbook.v1alpha1.UpdateCookbookRequest.cookbook:type_name -> api.meals.cookbook.v1alpha1.Cookbook
+	10, // 7: api.meals.cookbook.v1alpha1.UpdateCookbookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 8: api.meals.cookbook.v1alpha1.Cookbook.CookbookAccess.permission_level:type_name -> api.types.PermissionLevel
+	12, // 9: api.meals.cookbook.v1alpha1.Cookbook.CookbookAccess.state:type_name -> api.types.AccessState
+	13, // 10: api.meals.cookbook.v1alpha1.Cookbook.CookbookAccess.accept_target:type_name -> api.types.AcceptTarget
+	1,  // 11: api.meals.cookbook.v1alpha1.CookbookService.CreateCookbook:input_type -> api.meals.cookbook.v1alpha1.CreateCookbookRequest
+	2,  // 12: api.meals.cookbook.v1alpha1.CookbookService.ListCookbooks:input_type -> api.meals.cookbook.v1alpha1.ListCookbooksRequest
+	4,  // 13: api.meals.cookbook.v1alpha1.CookbookService.UpdateCookbook:input_type -> api.meals.cookbook.v1alpha1.UpdateCookbookRequest
+	5,  // 14: api.meals.cookbook.v1alpha1.CookbookService.DeleteCookbook:input_type -> api.meals.cookbook.v1alpha1.DeleteCookbookRequest
+	6,  // 15: api.meals.cookbook.v1alpha1.CookbookService.GetCookbook:input_type -> api.meals.cookbook.v1alpha1.GetCookbookRequest
+	0,  // 16: api.meals.cookbook.v1alpha1.CookbookService.CreateCookbook:output_type -> api.meals.cookbook.v1alpha1.Cookbook
+	3,  // 17: api.meals.cookbook.v1alpha1.CookbookService.ListCookbooks:output_type -> api.meals.cookbook.v1alpha1.ListCookbooksResponse
+	0,  // 18: api.meals.cookbook.v1alpha1.CookbookService.UpdateCookbook:output_type -> api.meals.cookbook.v1alpha1.Cookbook
+	0,  // 19: api.meals.cookbook.v1alpha1.CookbookService.DeleteCookbook:output_type -> api.meals.cookbook.v1alpha1.Cookbook
+	0,  // 20: api.meals.cookbook.v1alpha1.CookbookService.GetCookbook:output_type -> api.meals.cookbook.v1alpha1.Cookbook
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_cookbook_v1alpha1_cookbook_proto_init() }
+func file_api_meals_cookbook_v1alpha1_cookbook_proto_init() {
+	if File_api_meals_cookbook_v1alpha1_cookbook_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDesc), len(file_api_meals_cookbook_v1alpha1_cookbook_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_cookbook_v1alpha1_cookbook_proto_goTypes,
+		DependencyIndexes: file_api_meals_cookbook_v1alpha1_cookbook_proto_depIdxs,
+		MessageInfos:      file_api_meals_cookbook_v1alpha1_cookbook_proto_msgTypes,
+	}.Build()
+	File_api_meals_cookbook_v1alpha1_cookbook_proto = out.File
+	file_api_meals_cookbook_v1alpha1_cookbook_proto_goTypes = nil
+	file_api_meals_cookbook_v1alpha1_cookbook_proto_depIdxs = nil
+}
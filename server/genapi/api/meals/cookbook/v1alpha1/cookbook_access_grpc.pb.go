@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/cookbook/v1alpha1/cookbook_access.proto
+
+package cookbookv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	CookbookAccessService_CreateAccess_FullMethodName         = "/api.meals.cookbook.v1alpha1.CookbookAccessService/CreateAccess"
+	CookbookAccessService_DeleteAccess_FullMethodName         = "/api.meals.cookbook.v1alpha1.CookbookAccessService/DeleteAccess"
+	CookbookAccessService_GetAccess_FullMethodName            = "/api.meals.cookbook.v1alpha1.CookbookAccessService/GetAccess"
+	CookbookAccessService_ListAccesses_FullMethodName         = "/api.meals.cookbook.v1alpha1.CookbookAccessService/ListAccesses"
+	CookbookAccessService_UpdateAccess_FullMethodName         = "/api.meals.cookbook.v1alpha1.CookbookAccessService/UpdateAccess"
+	CookbookAccessService_AcceptCookbookAccess_FullMethodName = "/api.meals.cookbook.v1alpha1.CookbookAccessService/AcceptCookbookAccess"
+)
+
+// CookbookAccessServiceClient is the client API for CookbookAccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The cookbook access service
+type CookbookAccessServiceClient interface {
+	// Create an access to a cookbook
+	CreateAccess(ctx context.Context, in *CreateAccessRequest, opts ...grpc.CallOption) (*Access, error)
+	// Delete an access to a cookbook
+	DeleteAccess(ctx context.Context, in *DeleteAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get an access to a cookbook
+	GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*Access, error)
+	// List accesses to a cookbook
+	ListAccesses(ctx context.Context, in *ListAccessesRequest, opts ...grpc.CallOption) (*ListAccessesResponse, error)
+	// Update an access to a cookbook
+	UpdateAccess(ctx context.Context, in *UpdateAccessRequest, opts ...grpc.CallOption) (*Access, error)
+	// Accept a cookbook access
+	AcceptCookbookAccess(ctx context.Context, in *AcceptCookbookAccessRequest, opts ...grpc.CallOption) (*AcceptCookbookAccessResponse, error)
+}
+
+type cookbookAccessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCookbookAccessServiceClient(cc grpc.ClientConnInterface) CookbookAccessServiceClient {
+	return &cookbookAccessServiceClient{cc}
+}
+
+func (c *cookbookAccessServiceClient) CreateAccess(ctx context.Context, in *CreateAccessRequest, opts ...grpc.CallOption) (*Access, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Access)
+	err := c.cc.Invoke(ctx, CookbookAccessService_CreateAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookAccessServiceClient) DeleteAccess(ctx context.Context, in *DeleteAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CookbookAccessService_DeleteAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookAccessServiceClient) GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*Access, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Access)
+	err := c.cc.Invoke(ctx, CookbookAccessService_GetAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookAccessServiceClient) ListAccesses(ctx context.Context, in *ListAccessesRequest, opts ...grpc.CallOption) (*ListAccessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessesResponse)
+	err := c.cc.Invoke(ctx, CookbookAccessService_ListAccesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookAccessServiceClient) UpdateAccess(ctx context.Context, in *UpdateAccessRequest, opts ...grpc.CallOption) (*Access, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Access)
+	err := c.cc.Invoke(ctx, CookbookAccessService_UpdateAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookAccessServiceClient) AcceptCookbookAccess(ctx context.Context, in *AcceptCookbookAccessRequest, opts ...grpc.CallOption) (*AcceptCookbookAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCookbookAccessResponse)
+	err := c.cc.Invoke(ctx, CookbookAccessService_AcceptCookbookAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CookbookAccessServiceServer is the server API for CookbookAccessService service.
+// All implementations must embed UnimplementedCookbookAccessServiceServer
+// for forward compatibility.
+//
+// The cookbook access service
+type CookbookAccessServiceServer interface {
+	// Create an access to a cookbook
+	CreateAccess(context.Context, *CreateAccessRequest) (*Access, error)
+	// Delete an access to a cookbook
+	DeleteAccess(context.Context, *DeleteAccessRequest) (*emptypb.Empty, error)
+	// Get an access to a cookbook
+	GetAccess(context.Context, *GetAccessRequest) (*Access, error)
+	// List accesses to a cookbook
+	ListAccesses(context.Context, *ListAccessesRequest) (*ListAccessesResponse, error)
+	// Update an access to a cookbook
+	UpdateAccess(context.Context, *UpdateAccessRequest) (*Access, error)
+	// Accept a cookbook access
+	AcceptCookbookAccess(context.Context, *AcceptCookbookAccessRequest) (*AcceptCookbookAccessResponse, error)
+	mustEmbedUnimplementedCookbookAccessServiceServer()
+}
+
+// UnimplementedCookbookAccessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCookbookAccessServiceServer struct{}
+
+func (UnimplementedCookbookAccessServiceServer) CreateAccess(context.Context, *CreateAccessRequest) (*Access, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccess not implemented")
+}
+func (UnimplementedCookbookAccessServiceServer) DeleteAccess(context.Context, *DeleteAccessRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+}
+func (UnimplementedCookbookAccessServiceServer) GetAccess(context.Context, *GetAccessRequest) (*Access, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccess not implemented")
+}
+func (UnimplementedCookbookAccessServiceServer) ListAccesses(context.Context, *ListAccessesRequest) (*ListAccessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccesses not implemented")
+}
+func (UnimplementedCookbookAccessServiceServer) UpdateAccess(context.Context, *UpdateAccessRequest) (*Access, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccess not implemented")
+}
+func (UnimplementedCookbookAccessServiceServer) AcceptCookbookAccess(context.Context, *AcceptCookbookAccessRequest) (*AcceptCookbookAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCookbookAccess not implemented")
+}
+func (UnimplementedCookbookAccessServiceServer) mustEmbedUnimplementedCookbookAccessServiceServer() {}
+func (UnimplementedCookbookAccessServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeCookbookAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CookbookAccessServiceServer will
+// result in compilation errors.
+type UnsafeCookbookAccessServiceServer interface {
+	mustEmbedUnimplementedCookbookAccessServiceServer()
+}
+
+func RegisterCookbookAccessServiceServer(s grpc.ServiceRegistrar, srv CookbookAccessServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCookbookAccessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CookbookAccessService_ServiceDesc, srv)
+}
+
+func _CookbookAccessService_CreateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookAccessServiceServer).CreateAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookAccessService_CreateAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookAccessServiceServer).CreateAccess(ctx, req.(*CreateAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookAccessService_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookAccessServiceServer).DeleteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookAccessService_DeleteAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookAccessServiceServer).DeleteAccess(ctx, req.(*DeleteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookAccessService_GetAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookAccessServiceServer).GetAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookAccessService_GetAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookAccessServiceServer).GetAccess(ctx, req.(*GetAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookAccessService_ListAccesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookAccessServiceServer).ListAccesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookAccessService_ListAccesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookAccessServiceServer).ListAccesses(ctx, req.(*ListAccessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookAccessService_UpdateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookAccessServiceServer).UpdateAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookAccessService_UpdateAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookAccessServiceServer).UpdateAccess(ctx, req.(*UpdateAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookAccessService_AcceptCookbookAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCookbookAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookAccessServiceServer).AcceptCookbookAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookAccessService_AcceptCookbookAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookAccessServiceServer).AcceptCookbookAccess(ctx, req.(*AcceptCookbookAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CookbookAccessService_ServiceDesc is the grpc.ServiceDesc for CookbookAccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CookbookAccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.cookbook.v1alpha1.CookbookAccessService",
+	HandlerType: (*CookbookAccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccess",
+			Handler:    _CookbookAccessService_CreateAccess_Handler,
+		},
+		{
+			MethodName: "DeleteAccess",
+			Handler:    _CookbookAccessService_DeleteAccess_Handler,
+		},
+		{
+			MethodName: "GetAccess",
+			Handler:    _CookbookAccessService_GetAccess_Handler,
+		},
+		{
+			MethodName: "ListAccesses",
+			Handler:    _CookbookAccessService_ListAccesses_Handler,
+		},
+		{
+			MethodName: "UpdateAccess",
+			Handler:    _CookbookAccessService_UpdateAccess_Handler,
+		},
+		{
+			MethodName: "AcceptCookbookAccess",
+			Handler:    _CookbookAccessService_AcceptCookbookAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/cookbook/v1alpha1/cookbook_access.proto",
+}
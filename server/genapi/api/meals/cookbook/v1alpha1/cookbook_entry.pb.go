@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/cookbook/v1alpha1/cookbook_entry.proto
+
+package cookbookv1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a recipe in a cookbook
+type CookbookEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cookbook entry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the recipe in the cookbook
+	Recipe string `protobuf:"bytes,2,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// a note about the recipe, e.g. "double the gravy"
+	Note string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	// the position of the entry in the cookbook, starting at 0
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// whether the caller can read the recipe
+	Accessible bool `protobuf:"varint,5,opt,name=accessible,proto3" json:"accessible,omitempty"`
+	// the title of the recipe, empty when the recipe is not accessible
+	RecipeTitle string `protobuf:"bytes,6,opt,name=recipe_title,json=recipeTitle,proto3" json:"recipe_title,omitempty"`
+	// the image of the recipe, empty when the recipe is not accessible
+	RecipeImageUri string `protobuf:"bytes,7,opt,name=recipe_image_uri,json=recipeImageUri,proto3" json:"recipe_image_uri,omitempty"`
+	// the time the entry was added (UTC)
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CookbookEntry) Reset() {
+	*x = CookbookEntry{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookbookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookbookEntry) ProtoMessage() {}
+
+func (x *CookbookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookbookEntry.ProtoReflect.Descriptor instead.
+func (*CookbookEntry) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CookbookEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CookbookEntry) GetRecipe() string {
+	if x != nil {
+		return x.Recipe
+	}
+	return ""
+}
+
+func (x *CookbookEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CookbookEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CookbookEntry) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+func (x *CookbookEntry) GetRecipeTitle() string {
+	if x != nil {
+		return x.RecipeTitle
+	}
+	return ""
+}
+
+func (x *CookbookEntry) GetRecipeImageUri() string {
+	if x != nil {
+		return x.RecipeImageUri
+	}
+	return ""
+}
+
+func (x *CookbookEntry) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// the request to add a cookbook entry
+type AddCookbookEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent cookbook
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the entry to add
+	CookbookEntry *CookbookEntry `protobuf:"bytes,2,opt,name=cookbook_entry,json=cookbookEntry,proto3" json:"cookbook_entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCookbookEntryRequest) Reset() {
+	*x = AddCookbookEntryRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCookbookEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCookbookEntryRequest) ProtoMessage() {}
+
+func (x *AddCookbookEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCookbookEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddCookbookEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddCookbookEntryRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *AddCookbookEntryRequest) GetCookbookEntry() *CookbookEntry {
+	if x != nil {
+		return x.CookbookEntry
+	}
+	return nil
+}
+
+// the request to list cookbook entries
+type ListCookbookEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent cookbook
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookbookEntriesRequest) Reset() {
+	*x = ListCookbookEntriesRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookbookEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookbookEntriesRequest) ProtoMessage() {}
+
+func (x *ListCookbookEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookbookEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCookbookEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCookbookEntriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListCookbookEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCookbookEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list cookbook entries
+type ListCookbookEntriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cookbook entries, in order
+	CookbookEntries []*CookbookEntry `protobuf:"bytes,1,rep,name=cookbook_entries,json=cookbookEntries,proto3" json:"cookbook_entries,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookbookEntriesResponse) Reset() {
+	*x = ListCookbookEntriesResponse{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookbookEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookbookEntriesResponse) ProtoMessage() {}
+
+func (x *ListCookbookEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookbookEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCookbookEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCookbookEntriesResponse) GetCookbookEntries() []*CookbookEntry {
+	if x != nil {
+		return x.CookbookEntries
+	}
+	return nil
+}
+
+func (x *ListCookbookEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to get a cookbook entry
+type GetCookbookEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cookbook entry
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCookbookEntryRequest) Reset() {
+	*x = GetCookbookEntryRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCookbookEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCookbookEntryRequest) ProtoMessage() {}
+
+func (x *GetCookbookEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCookbookEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetCookbookEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCookbookEntryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to update a cookbook entry
+type UpdateCookbookEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cookbook entry to update
+	CookbookEntry *CookbookEntry `protobuf:"bytes,1,opt,name=cookbook_entry,json=cookbookEntry,proto3" json:"cookbook_entry,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCookbookEntryRequest) Reset() {
+	*x = UpdateCookbookEntryRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCookbookEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCookbookEntryRequest) ProtoMessage() {}
+
+func (x *UpdateCookbookEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCookbookEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCookbookEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCookbookEntryRequest) GetCookbookEntry() *CookbookEntry {
+	if x != nil {
+		return x.CookbookEntry
+	}
+	return nil
+}
+
+func (x *UpdateCookbookEntryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to remove a cookbook entry
+type RemoveCookbookEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cookbook entry
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCookbookEntryRequest) Reset() {
+	*x = RemoveCookbookEntryRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCookbookEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCookbookEntryRequest) ProtoMessage() {}
+
+func (x *RemoveCookbookEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCookbookEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCookbookEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveCookbookEntryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to reorder cookbook entries
+type ReorderCookbookEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent cookbook
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the names of all of the entries of the cookbook in their new order
+	CookbookEntries []string `protobuf:"bytes,2,rep,name=cookbook_entries,json=cookbookEntries,proto3" json:"cookbook_entries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReorderCookbookEntriesRequest) Reset() {
+	*x = ReorderCookbookEntriesRequest{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCookbookEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCookbookEntriesRequest) ProtoMessage() {}
+
+func (x *ReorderCookbookEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCookbookEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCookbookEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReorderCookbookEntriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ReorderCookbookEntriesRequest) GetCookbookEntries() []string {
+	if x != nil {
+		return x.CookbookEntries
+	}
+	return nil
+}
+
+// the response to reorder cookbook entries
+type ReorderCookbookEntriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cookbook entries, in their new order
+	CookbookEntries []*CookbookEntry `protobuf:"bytes,1,rep,name=cookbook_entries,json=cookbookEntries,proto3" json:"cookbook_entries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReorderCookbookEntriesResponse) Reset() {
+	*x = ReorderCookbookEntriesResponse{}
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCookbookEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCookbookEntriesResponse) ProtoMessage() {}
+
+func (x *ReorderCookbookEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCookbookEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCookbookEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReorderCookbookEntriesResponse) GetCookbookEntries() []*CookbookEntry {
+	if x != nil {
+		return x.CookbookEntries
+	}
+	return nil
+}
+
+var File_api_meals_cookbook_v1alpha1_cookbook_entry_proto protoreflect.FileDescriptor
+
+const file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDesc = "" +
+	"\n" +
+	"0api/meals/cookbook/v1alpha1/cookbook_entry.proto\x12\x1bapi.meals.cookbook.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xee\x03\n" +
+	"\rCookbookEntry\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12C\n" +
+	"\x06recipe\x18\x02 \x01(\tB+\xe0A\x02\xe0A\x05\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06recipe\x12\x17\n" +
+	"\x04note\x18\x03 \x01(\tB\x03\xe0A\x01R\x04note\x12\x1f\n" +
+	"\bposition\x18\x04 \x01(\x05B\x03\xe0A\x03R\bposition\x12#\n" +
+	"\n" +
+	"accessible\x18\x05 \x01(\bB\x03\xe0A\x03R\n" +
+	"accessible\x12&\n" +
+	"\frecipe_title\x18\x06 \x01(\tB\x03\xe0A\x03R\vrecipeTitle\x12-\n" +
+	"\x10recipe_image_uri\x18\a \x01(\tB\x03\xe0A\x03R\x0erecipeImageUri\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:\x86\x01\xeaA\x82\x01\n" +
+	")api.meals.cookbook.v1alpha1/CookbookEntry\x125cookbooks/{cookbook}/cookbookEntries/{cookbook_entry}*\x0fcookbookEntries2\rcookbookEntry\"\xb7\x01\n" +
+	"\x17AddCookbookEntryRequest\x12D\n" +
+	"\x06parent\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.cookbook.v1alpha1/CookbookR\x06parent\x12V\n" +
+	"\x0ecookbook_entry\x18\x02 \x01(\v2*.api.meals.cookbook.v1alpha1.CookbookEntryB\x03\xe0A\x02R\rcookbookEntry\"\xa8\x01\n" +
+	"\x1aListCookbookEntriesRequest\x12D\n" +
+	"\x06parent\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.cookbook.v1alpha1/CookbookR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x9c\x01\n" +
+	"\x1bListCookbookEntriesResponse\x12U\n" +
+	"\x10cookbook_entries\x18\x01 \x03(\v2*.api.meals.cookbook.v1alpha1.CookbookEntryR\x0fcookbookEntries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"`\n" +
+	"\x17GetCookbookEntryRequest\x12E\n" +
+	"\x04name\x18\x01 \x01(\tB1\xe0A\x02\xfaA+\n" +
+	")api.meals.cookbook.v1alpha1/CookbookEntryR\x04name\"\xb6\x01\n" +
+	"\x1aUpdateCookbookEntryRequest\x12V\n" +
+	"\x0ecookbook_entry\x18\x01 \x01(\v2*.api.meals.cookbook.v1alpha1.CookbookEntryB\x03\xe0A\x02R\rcookbookEntry\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"c\n" +
+	"\x1aRemoveCookbookEntryRequest\x12E\n" +
+	"\x04name\x18\x01 \x01(\tB1\xe0A\x02\xfaA+\n" +
+	")api.meals.cookbook.v1alpha1/CookbookEntryR\x04name\"\xc3\x01\n" +
+	"\x1dReorderCookbookEntriesRequest\x12D\n" +
+	"\x06parent\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.cookbook.v1alpha1/CookbookR\x06parent\x12\\\n" +
+	"\x10cookbook_entries\x18\x02 \x03(\tB1\xe0A\x02\xfaA+\n" +
+	")api.meals.cookbook.v1alpha1/CookbookEntryR\x0fcookbookEntries\"w\n" +
+	"\x1eReorderCookbookEntriesResponse\x12U\n" +
+	"\x10cookbook_entries\x18\x01 \x03(\v2*.api.meals.cookbook.v1alpha1.CookbookEntryR\x0fcookbookEntries2\xe8\x10\n" +
+	"\x14CookbookEntryService\x12\xe0\x02\n" +
+	"\x10AddCookbookEntry\x124.api.meals.cookbook.v1alpha1.AddCookbookEntryRequest\x1a*.api.meals.cookbook.v1alpha1.CookbookEntry\"\xe9\x01\x92A\x81\x01\n" +
+	"\x14CookbookEntryService\x12\x14Add a cookbook entry\x1aSAdds a recipe to the end of a cookbook. The caller must be able to read the recipe.\xdaA\x15parent,cookbook_entry\x82\xd3\xe4\x93\x02F:\x0ecookbook_entry\"4/meals/v1alpha1/{parent=cookbooks/*}/cookbookEntries\x12\xae\x03\n" +
+	"\x13ListCookbookEntries\x127.api.meals.cookbook.v1alpha1.ListCookbookEntriesRequest\x1a8.api.meals.cookbook.v1alpha1.ListCookbookEntriesResponse\"\xa3\x02\x92A\xda\x01\n" +
+	"\x14CookbookEntryService\x12\x15List cookbook entries\x1a\xaa\x01Retrieves a paginated list of the entries of a cookbook in order. Entries whose recipe the caller cannot read are marked as inaccessible and leave out the recipe details.\xdaA\x06parent\x82\xd3\xe4\x93\x026\x124/meals/v1alpha1/{parent=cookbooks/*}/cookbookEntries\x12\x9e\x02\n" +
+	"\x10GetCookbookEntry\x124.api.meals.cookbook.v1alpha1.GetCookbookEntryRequest\x1a*.api.meals.cookbook.v1alpha1.CookbookEntry\"\xa7\x01\x92Aa\n" +
+	"\x14CookbookEntryService\x12\x14Get a cookbook entry\x1a3Retrieves a single cookbook entry by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x026\x124/meals/v1alpha1/{name=cookbooks/*/cookbookEntries/*}\x12\xce\x02\n" +
+	"\x13UpdateCookbookEntry\x127.api.meals.cookbook.v1alpha1.UpdateCookbookEntryRequest\x1a*.api.meals.cookbook.v1alpha1.CookbookEntry\"\xd1\x01\x92AV\n" +
+	"\x14CookbookEntryService\x12\x17Update a cookbook entry\x1a%Updates the note of a cookbook entry.\xdaA\x1acookbook_entry,update_mask\x82\xd3\xe4\x93\x02U:\x0ecookbook_entry2C/meals/v1alpha1/{cookbook_entry.name=cookbooks/*/cookbookEntries/*}\x12\xb7\x02\n" +
+	"\x13RemoveCookbookEntry\x127.api.meals.cookbook.v1alpha1.RemoveCookbookEntryRequest\x1a*.api.meals.cookbook.v1alpha1.CookbookEntry\"\xba\x01\x92At\n" +
+	"\x14CookbookEntryService\x12\x17Remove a cookbook entry\x1aCRemoves an entry from a cookbook. The recipe itself is not deleted.\xdaA\x04name\x82\xd3\xe4\x93\x026*4/meals/v1alpha1/{name=cookbooks/*/cookbookEntries/*}\x12\x8f\x03\n" +
+	"\x16ReorderCookbookEntries\x12:.api.meals.cookbook.v1alpha1.ReorderCookbookEntriesRequest\x1a;.api.meals.cookbook.v1alpha1.ReorderCookbookEntriesResponse\"\xfb\x01\x92A\x96\x01\n" +
+	"\x14CookbookEntryService\x12\x18Reorder cookbook entries\x1adSets the order of the entries of a cookbook. Every entry of the cookbook must be given exactly once.\xdaA\x17parent,cookbook_entries\x82\xd3\xe4\x93\x02A:\x01*\"</meals/v1alpha1/{parent=cookbooks/*}/cookbookEntries:reorderB\xf5\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1fcom.api.meals.cookbook.v1alpha1B\x12CookbookEntryProtoP\x01ZTgithub.com/jcfug8/daylear/server/genapi/api/meals/cookbook/v1alpha1;cookbookv1alpha1\xa2\x02\x03AMC\xaa\x02\x1bApi.Meals.Cookbook.V1alpha1\xca\x02\x1bApi\\Meals\\Cookbook\\V1alpha1\xe2\x02'Api\\Meals\\Cookbook\\V1alpha1\\GPBMetadata\xea\x02\x1eApi::Meals::Cookbook::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescOnce sync.Once
+	file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescData []byte
+)
+
+func file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescGZIP() []byte {
+	file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescOnce.Do(func() {
+		file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDesc), len(file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDesc)))
+	})
+	return file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDescData
+}
+
+var file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_goTypes = []any{
+	(*CookbookEntry)(nil),                  // 0: api.meals.cookbook.v1alpha1.CookbookEntry
+	(*AddCookbookEntryRequest)(nil),        // 1: api.meals.cookbook.v1alpha1.AddCookbookEntryRequest
+	(*ListCookbookEntriesRequest)(nil),     // 2: api.meals.cookbook.v1alpha1.ListCookbookEntriesRequest
+	(*ListCookbookEntriesResponse)(nil),    // 3: api.meals.cookbook.v1alpha1.ListCookbookEntriesResponse
+	(*GetCookbookEntryRequest)(nil),        // 4: api.meals.cookbook.v1alpha1.GetCookbookEntryRequest
+	(*UpdateCookbookEntryRequest)(nil),     // 5: api.meals.cookbook.v1alpha1.UpdateCookbookEntryRequest
+	(*RemoveCookbookEntryRequest)(nil),     // 6: api.meals.cookbook.v1alpha1.RemoveCookbookEntryRequest
+	(*ReorderCookbookEntriesRequest)(nil),  // 7: api.meals.cookbook.v1alpha1.ReorderCookbookEntriesRequest
+	(*ReorderCookbookEntriesResponse)(nil), // 8: api.meals.cookbook.v1alpha1.ReorderCookbookEntriesResponse
+	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 10: google.protobuf.FieldMask
+}
+var file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_depIdxs = []int32{
+	9,  // 0: api.meals.cookbook.v1alpha1.CookbookEntry.create_time:type_name -> google.protobuf.Timestamp
+	0,  // 1: api.meals.cookbook.v1alpha1.AddCookbookEntryRequest.cookbook_entry:type_name -> api.meals.cookbook.v1alpha1.CookbookEntry
+	0,  // 2: api.meals.cookbook.v1alpha1.ListCookbookEntriesResponse.cookbook_entries:type_name -> api.meals.cookbook.v1alpha1.CookbookEntry
+	0,  // 3: api.meals.cookbook.v1alpha1.UpdateCookbookEntryRequest.cookbook_entry:type_name -> api.meals.cookbook.v1alpha1.CookbookEntry
+	10, // 4: api.meals.cookbook.v1alpha1.UpdateCookbookEntryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 5: api.meals.cookbook.v1alpha1.ReorderCookbookEntriesResponse.cookbook_entries:type_name -> api.meals.cookbook.v1alpha1.CookbookEntry
+	1,  // 6: api.meals.cookbook.v1alpha1.CookbookEntryService.AddCookbookEntry:input_type -> api.meals.cookbook.v1alpha1.AddCookbookEntryRequest
+	2,  // 7: api.meals.cookbook.v1alpha1.CookbookEntryService.ListCookbookEntries:input_type -> api.meals.cookbook.v1alpha1.ListCookbookEntriesRequest
+	4,  // 8: api.meals.cookbook.v1alpha1.CookbookEntryService.GetCookbookEntry:input_type -> api.meals.cookbook.v1alpha1.GetCookbookEntryRequest
+	5,  // 9: api.meals.cookbook.v1alpha1.CookbookEntryService.UpdateCookbookEntry:input_type -> api.meals.cookbook.v1alpha1.UpdateCookbookEntryRequest
+	6,  // 10: api.meals.cookbook.v1alpha1.CookbookEntryService.RemoveCookbookEntry:input_type -> api.meals.cookbook.v1alpha1.RemoveCookbookEntryRequest
+	7,  // 11: api.meals.cookbook.v1alpha1.CookbookEntryService.ReorderCookbookEntries:input_type -> api.meals.cookbook.v1alpha1.ReorderCookbookEntriesRequest
+	0,  // 12: api.meals.cookbook.v1alpha1.CookbookEntryService.AddCookbookEntry:output_type -> api.meals.cookbook.v1alpha1.CookbookEntry
+	3,  // 13: api.meals.cookbook.v1alpha1.CookbookEntryService.ListCookbookEntries:output_type -> api.meals.cookbook.v1alpha1.ListCookbookEntriesResponse
+	0,  // 14: api.meals.cookbook.v1alpha1.CookbookEntryService.GetCookbookEntry:output_type -> api.meals.cookbook.v1alpha1.CookbookEntry
+	0,  // 15: api.meals.cookbook.v1alpha1.CookbookEntryService.UpdateCookbookEntry:output_type -> api.meals.cookbook.v1alpha1.CookbookEntry
+	0,  // 16: api.meals.cookbook.v1alpha1.CookbookEntryService.RemoveCookbookEntry:output_type -> api.meals.cookbook.v1alpha1.CookbookEntry
+	8,  // 17: api.meals.cookbook.v1alpha1.CookbookEntryService.ReorderCookbookEntries:output_type -> api.meals.cookbook.v1alpha1.ReorderCookbookEntriesResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_init() }
+func file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_init() {
+	if File_api_meals_cookbook_v1alpha1_cookbook_entry_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDesc), len(file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_goTypes,
+		DependencyIndexes: file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_depIdxs,
+		MessageInfos:      file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_msgTypes,
+	}.Build()
+	File_api_meals_cookbook_v1alpha1_cookbook_entry_proto = out.File
+	file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_goTypes = nil
+	file_api_meals_cookbook_v1alpha1_cookbook_entry_proto_depIdxs = nil
+}
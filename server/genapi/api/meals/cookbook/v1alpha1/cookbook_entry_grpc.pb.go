@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/cookbook/v1alpha1/cookbook_entry.proto
+
+package cookbookv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	CookbookEntryService_AddCookbookEntry_FullMethodName       = "/api.meals.cookbook.v1alpha1.CookbookEntryService/AddCookbookEntry"
+	CookbookEntryService_ListCookbookEntries_FullMethodName    = "/api.meals.cookbook.v1alpha1.CookbookEntryService/ListCookbookEntries"
+	CookbookEntryService_GetCookbookEntry_FullMethodName       = "/api.meals.cookbook.v1alpha1.CookbookEntryService/GetCookbookEntry"
+	CookbookEntryService_UpdateCookbookEntry_FullMethodName    = "/api.meals.cookbook.v1alpha1.CookbookEntryService/UpdateCookbookEntry"
+	CookbookEntryService_RemoveCookbookEntry_FullMethodName    = "/api.meals.cookbook.v1alpha1.CookbookEntryService/RemoveCookbookEntry"
+	CookbookEntryService_ReorderCookbookEntries_FullMethodName = "/api.meals.cookbook.v1alpha1.CookbookEntryService/ReorderCookbookEntries"
+)
+
+// CookbookEntryServiceClient is the client API for CookbookEntryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the cookbook entry service
+type CookbookEntryServiceClient interface {
+	// add a recipe to a cookbook
+	AddCookbookEntry(ctx context.Context, in *AddCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error)
+	// list the entries of a cookbook
+	ListCookbookEntries(ctx context.Context, in *ListCookbookEntriesRequest, opts ...grpc.CallOption) (*ListCookbookEntriesResponse, error)
+	// get a cookbook entry
+	GetCookbookEntry(ctx context.Context, in *GetCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error)
+	// update the note of a cookbook entry
+	UpdateCookbookEntry(ctx context.Context, in *UpdateCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error)
+	// remove a recipe from a cookbook
+	RemoveCookbookEntry(ctx context.Context, in *RemoveCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error)
+	// reorder the entries of a cookbook
+	ReorderCookbookEntries(ctx context.Context, in *ReorderCookbookEntriesRequest, opts ...grpc.CallOption) (*ReorderCookbookEntriesResponse, error)
+}
+
+type cookbookEntryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCookbookEntryServiceClient(cc grpc.ClientConnInterface) CookbookEntryServiceClient {
+	return &cookbookEntryServiceClient{cc}
+}
+
+func (c *cookbookEntryServiceClient) AddCookbookEntry(ctx context.Context, in *AddCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookbookEntry)
+	err := c.cc.Invoke(ctx, CookbookEntryService_AddCookbookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookEntryServiceClient) ListCookbookEntries(ctx context.Context, in *ListCookbookEntriesRequest, opts ...grpc.CallOption) (*ListCookbookEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCookbookEntriesResponse)
+	err := c.cc.Invoke(ctx, CookbookEntryService_ListCookbookEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookEntryServiceClient) GetCookbookEntry(ctx context.Context, in *GetCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookbookEntry)
+	err := c.cc.Invoke(ctx, CookbookEntryService_GetCookbookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookEntryServiceClient) UpdateCookbookEntry(ctx context.Context, in *UpdateCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookbookEntry)
+	err := c.cc.Invoke(ctx, CookbookEntryService_UpdateCookbookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookEntryServiceClient) RemoveCookbookEntry(ctx context.Context, in *RemoveCookbookEntryRequest, opts ...grpc.CallOption) (*CookbookEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookbookEntry)
+	err := c.cc.Invoke(ctx, CookbookEntryService_RemoveCookbookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookEntryServiceClient) ReorderCookbookEntries(ctx context.Context, in *ReorderCookbookEntriesRequest, opts ...grpc.CallOption) (*ReorderCookbookEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderCookbookEntriesResponse)
+	err := c.cc.Invoke(ctx, CookbookEntryService_ReorderCookbookEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CookbookEntryServiceServer is the server API for CookbookEntryService service.
+// All implementations must embed UnimplementedCookbookEntryServiceServer
+// for forward compatibility.
+//
+// the cookbook entry service
+type CookbookEntryServiceServer interface {
+	// add a recipe to a cookbook
+	AddCookbookEntry(context.Context, *AddCookbookEntryRequest) (*CookbookEntry, error)
+	// list the entries of a cookbook
+	ListCookbookEntries(context.Context, *ListCookbookEntriesRequest) (*ListCookbookEntriesResponse, error)
+	// get a cookbook entry
+	GetCookbookEntry(context.Context, *GetCookbookEntryRequest) (*CookbookEntry, error)
+	// update the note of a cookbook entry
+	UpdateCookbookEntry(context.Context, *UpdateCookbookEntryRequest) (*CookbookEntry, error)
+	// remove a recipe from a cookbook
+	RemoveCookbookEntry(context.Context, *RemoveCookbookEntryRequest) (*CookbookEntry, error)
+	// reorder the entries of a cookbook
+	ReorderCookbookEntries(context.Context, *ReorderCookbookEntriesRequest) (*ReorderCookbookEntriesResponse, error)
+	mustEmbedUnimplementedCookbookEntryServiceServer()
+}
+
+// UnimplementedCookbookEntryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCookbookEntryServiceServer struct{}
+
+func (UnimplementedCookbookEntryServiceServer) AddCookbookEntry(context.Context, *AddCookbookEntryRequest) (*CookbookEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCookbookEntry not implemented")
+}
+func (UnimplementedCookbookEntryServiceServer) ListCookbookEntries(context.Context, *ListCookbookEntriesRequest) (*ListCookbookEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCookbookEntries not implemented")
+}
+func (UnimplementedCookbookEntryServiceServer) GetCookbookEntry(context.Context, *GetCookbookEntryRequest) (*CookbookEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCookbookEntry not implemented")
+}
+func (UnimplementedCookbookEntryServiceServer) UpdateCookbookEntry(context.Context, *UpdateCookbookEntryRequest) (*CookbookEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCookbookEntry not implemented")
+}
+func (UnimplementedCookbookEntryServiceServer) RemoveCookbookEntry(context.Context, *RemoveCookbookEntryRequest) (*CookbookEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCookbookEntry not implemented")
+}
+func (UnimplementedCookbookEntryServiceServer) ReorderCookbookEntries(context.Context, *ReorderCookbookEntriesRequest) (*ReorderCookbookEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCookbookEntries not implemented")
+}
+func (UnimplementedCookbookEntryServiceServer) mustEmbedUnimplementedCookbookEntryServiceServer() {}
+func (UnimplementedCookbookEntryServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeCookbookEntryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CookbookEntryServiceServer will
+// result in compilation errors.
+type UnsafeCookbookEntryServiceServer interface {
+	mustEmbedUnimplementedCookbookEntryServiceServer()
+}
+
+func RegisterCookbookEntryServiceServer(s grpc.ServiceRegistrar, srv CookbookEntryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCookbookEntryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CookbookEntryService_ServiceDesc, srv)
+}
+
+func _CookbookEntryService_AddCookbookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCookbookEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookEntryServiceServer).AddCookbookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookEntryService_AddCookbookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookEntryServiceServer).AddCookbookEntry(ctx, req.(*AddCookbookEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookEntryService_ListCookbookEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCookbookEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookEntryServiceServer).ListCookbookEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookEntryService_ListCookbookEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookEntryServiceServer).ListCookbookEntries(ctx, req.(*ListCookbookEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookEntryService_GetCookbookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCookbookEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookEntryServiceServer).GetCookbookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookEntryService_GetCookbookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookEntryServiceServer).GetCookbookEntry(ctx, req.(*GetCookbookEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookEntryService_UpdateCookbookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCookbookEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookEntryServiceServer).UpdateCookbookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookEntryService_UpdateCookbookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookEntryServiceServer).UpdateCookbookEntry(ctx, req.(*UpdateCookbookEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookEntryService_RemoveCookbookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCookbookEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookEntryServiceServer).RemoveCookbookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookEntryService_RemoveCookbookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookEntryServiceServer).RemoveCookbookEntry(ctx, req.(*RemoveCookbookEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookEntryService_ReorderCookbookEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCookbookEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookEntryServiceServer).ReorderCookbookEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookEntryService_ReorderCookbookEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookEntryServiceServer).ReorderCookbookEntries(ctx, req.(*ReorderCookbookEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CookbookEntryService_ServiceDesc is the grpc.ServiceDesc for CookbookEntryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CookbookEntryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.cookbook.v1alpha1.CookbookEntryService",
+	HandlerType: (*CookbookEntryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCookbookEntry",
+			Handler:    _CookbookEntryService_AddCookbookEntry_Handler,
+		},
+		{
+			MethodName: "ListCookbookEntries",
+			Handler:    _CookbookEntryService_ListCookbookEntries_Handler,
+		},
+		{
+			MethodName: "GetCookbookEntry",
+			Handler:    _CookbookEntryService_GetCookbookEntry_Handler,
+		},
+		{
+			MethodName: "UpdateCookbookEntry",
+			Handler:    _CookbookEntryService_UpdateCookbookEntry_Handler,
+		},
+		{
+			MethodName: "RemoveCookbookEntry",
+			Handler:    _CookbookEntryService_RemoveCookbookEntry_Handler,
+		},
+		{
+			MethodName: "ReorderCookbookEntries",
+			Handler:    _CookbookEntryService_ReorderCookbookEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/cookbook/v1alpha1/cookbook_entry.proto",
+}
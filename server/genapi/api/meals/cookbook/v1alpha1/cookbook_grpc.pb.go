@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/cookbook/v1alpha1/cookbook.proto
+
+package cookbookv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	CookbookService_CreateCookbook_FullMethodName = "/api.meals.cookbook.v1alpha1.CookbookService/CreateCookbook"
+	CookbookService_ListCookbooks_FullMethodName  = "/api.meals.cookbook.v1alpha1.CookbookService/ListCookbooks"
+	CookbookService_UpdateCookbook_FullMethodName = "/api.meals.cookbook.v1alpha1.CookbookService/UpdateCookbook"
+	CookbookService_DeleteCookbook_FullMethodName = "/api.meals.cookbook.v1alpha1.CookbookService/DeleteCookbook"
+	CookbookService_GetCookbook_FullMethodName    = "/api.meals.cookbook.v1alpha1.CookbookService/GetCookbook"
+)
+
+// CookbookServiceClient is the client API for CookbookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the cookbook service
+type CookbookServiceClient interface {
+	// create a cookbook
+	CreateCookbook(ctx context.Context, in *CreateCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error)
+	// list cookbooks
+	ListCookbooks(ctx context.Context, in *ListCookbooksRequest, opts ...grpc.CallOption) (*ListCookbooksResponse, error)
+	// update a cookbook
+	UpdateCookbook(ctx context.Context, in *UpdateCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error)
+	// delete a cookbook
+	DeleteCookbook(ctx context.Context, in *DeleteCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error)
+	// get a cookbook
+	GetCookbook(ctx context.Context, in *GetCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error)
+}
+
+type cookbookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCookbookServiceClient(cc grpc.ClientConnInterface) CookbookServiceClient {
+	return &cookbookServiceClient{cc}
+}
+
+func (c *cookbookServiceClient) CreateCookbook(ctx context.Context, in *CreateCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cookbook)
+	err := c.cc.Invoke(ctx, CookbookService_CreateCookbook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookServiceClient) ListCookbooks(ctx context.Context, in *ListCookbooksRequest, opts ...grpc.CallOption) (*ListCookbooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCookbooksResponse)
+	err := c.cc.Invoke(ctx, CookbookService_ListCookbooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookServiceClient) UpdateCookbook(ctx context.Context, in *UpdateCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cookbook)
+	err := c.cc.Invoke(ctx, CookbookService_UpdateCookbook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookServiceClient) DeleteCookbook(ctx context.Context, in *DeleteCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cookbook)
+	err := c.cc.Invoke(ctx, CookbookService_DeleteCookbook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cookbookServiceClient) GetCookbook(ctx context.Context, in *GetCookbookRequest, opts ...grpc.CallOption) (*Cookbook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cookbook)
+	err := c.cc.Invoke(ctx, CookbookService_GetCookbook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CookbookServiceServer is the server API for CookbookService service.
+// All implementations must embed UnimplementedCookbookServiceServer
+// for forward compatibility.
+//
+// the cookbook service
+type CookbookServiceServer interface {
+	// create a cookbook
+	CreateCookbook(context.Context, *CreateCookbookRequest) (*Cookbook, error)
+	// list cookbooks
+	ListCookbooks(context.Context, *ListCookbooksRequest) (*ListCookbooksResponse, error)
+	// update a cookbook
+	UpdateCookbook(context.Context, *UpdateCookbookRequest) (*Cookbook, error)
+	// delete a cookbook
+	DeleteCookbook(context.Context, *DeleteCookbookRequest) (*Cookbook, error)
+	// get a cookbook
+	GetCookbook(context.Context, *GetCookbookRequest) (*Cookbook, error)
+	mustEmbedUnimplementedCookbookServiceServer()
+}
+
+// UnimplementedCookbookServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCookbookServiceServer struct{}
+
+func (UnimplementedCookbookServiceServer) CreateCookbook(context.Context, *CreateCookbookRequest) (*Cookbook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCookbook not implemented")
+}
+func (UnimplementedCookbookServiceServer) ListCookbooks(context.Context, *ListCookbooksRequest) (*ListCookbooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCookbooks not implemented")
+}
+func (UnimplementedCookbookServiceServer) UpdateCookbook(context.Context, *UpdateCookbookRequest) (*Cookbook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCookbook not implemented")
+}
+func (UnimplementedCookbookServiceServer) DeleteCookbook(context.Context, *DeleteCookbookRequest) (*Cookbook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCookbook not implemented")
+}
+func (UnimplementedCookbookServiceServer) GetCookbook(context.Context, *GetCookbookRequest) (*Cookbook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCookbook not implemented")
+}
+func (UnimplementedCookbookServiceServer) mustEmbedUnimplementedCookbookServiceServer() {}
+func (UnimplementedCookbookServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCookbookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CookbookServiceServer will
+// result in compilation errors.
+type UnsafeCookbookServiceServer interface {
+	mustEmbedUnimplementedCookbookServiceServer()
+}
+
+func RegisterCookbookServiceServer(s grpc.ServiceRegistrar, srv CookbookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCookbookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CookbookService_ServiceDesc, srv)
+}
+
+func _CookbookService_CreateCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCookbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookServiceServer).CreateCookbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookService_CreateCookbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookServiceServer).CreateCookbook(ctx, req.(*CreateCookbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookService_ListCookbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCookbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookServiceServer).ListCookbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookService_ListCookbooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookServiceServer).ListCookbooks(ctx, req.(*ListCookbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookService_UpdateCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCookbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookServiceServer).UpdateCookbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookService_UpdateCookbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookServiceServer).UpdateCookbook(ctx, req.(*UpdateCookbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookService_DeleteCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCookbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookServiceServer).DeleteCookbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookService_DeleteCookbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookServiceServer).DeleteCookbook(ctx, req.(*DeleteCookbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CookbookService_GetCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCookbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CookbookServiceServer).GetCookbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CookbookService_GetCookbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CookbookServiceServer).GetCookbook(ctx, req.(*GetCookbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CookbookService_ServiceDesc is the grpc.ServiceDesc for CookbookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CookbookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.cookbook.v1alpha1.CookbookService",
+	HandlerType: (*CookbookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCookbook",
+			Handler:    _CookbookService_CreateCookbook_Handler,
+		},
+		{
+			MethodName: "ListCookbooks",
+			Handler:    _CookbookService_ListCookbooks_Handler,
+		},
+		{
+			MethodName: "UpdateCookbook",
+			Handler:    _CookbookService_UpdateCookbook_Handler,
+		},
+		{
+			MethodName: "DeleteCookbook",
+			Handler:    _CookbookService_DeleteCookbook_Handler,
+		},
+		{
+			MethodName: "GetCookbook",
+			Handler:    _CookbookService_GetCookbook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/cookbook/v1alpha1/cookbook.proto",
+}
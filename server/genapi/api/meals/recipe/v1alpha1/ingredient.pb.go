@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/ingredient.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a canonical ingredient that recipe ingredients are linked to
+type Ingredient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the ingredient
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the canonical title of the ingredient
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// other titles the ingredient is known by
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// the category of the ingredient (e.g., dairy, produce, etc.)
+	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// the density of the ingredient in grams per milliliter, used to convert between volume and weight
+	Density *float64 `protobuf:"fixed64,5,opt,name=density,proto3,oneof" json:"density,omitempty"`
+	// the time the ingredient was created (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the ingredient was last updated (UTC)
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ingredient) ProtoMessage() {}
+
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ingredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ingredient) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Ingredient) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Ingredient) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Ingredient) GetDensity() float64 {
+	if x != nil && x.Density != nil {
+		return *x.Density
+	}
+	return 0
+}
+
+func (x *Ingredient) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Ingredient) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// the request to get an ingredient
+type GetIngredientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the ingredient to get
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngredientRequest) Reset() {
+	*x = GetIngredientRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngredientRequest) ProtoMessage() {}
+
+func (x *GetIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngredientRequest.ProtoReflect.Descriptor instead.
+func (*GetIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetIngredientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to list ingredients
+type ListIngredientsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// returned page
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// used to specify the filter
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsRequest) Reset() {
+	*x = ListIngredientsRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsRequest) ProtoMessage() {}
+
+func (x *ListIngredientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsRequest.ProtoReflect.Descriptor instead.
+func (*ListIngredientsRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListIngredientsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIngredientsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListIngredientsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// the response to list ingredients
+type ListIngredientsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the ingredients
+	Ingredients []*Ingredient `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsResponse) Reset() {
+	*x = ListIngredientsResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsResponse) ProtoMessage() {}
+
+func (x *ListIngredientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsResponse.ProtoReflect.Descriptor instead.
+func (*ListIngredientsResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListIngredientsResponse) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *ListIngredientsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to autocomplete ingredients
+type AutocompleteIngredientsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the partial title to complete
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// the maximum number of ingredients to return
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteIngredientsRequest) Reset() {
+	*x = AutocompleteIngredientsRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteIngredientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteIngredientsRequest) ProtoMessage() {}
+
+func (x *AutocompleteIngredientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteIngredientsRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteIngredientsRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AutocompleteIngredientsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AutocompleteIngredientsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// the response to autocomplete ingredients
+type AutocompleteIngredientsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the matching ingredients, best matches first
+	Ingredients   []*Ingredient `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteIngredientsResponse) Reset() {
+	*x = AutocompleteIngredientsResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteIngredientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteIngredientsResponse) ProtoMessage() {}
+
+func (x *AutocompleteIngredientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteIngredientsResponse.ProtoReflect.Descriptor instead.
+func (*AutocompleteIngredientsResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AutocompleteIngredientsResponse) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+var File_api_meals_recipe_v1alpha1_ingredient_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_ingredient_proto_rawDesc = "" +
+	"\n" +
+	"*api/meals/recipe/v1alpha1/ingredient.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x92\x03\n" +
+	"\n" +
+	"Ingredient\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1d\n" +
+	"\aaliases\x18\x03 \x03(\tB\x03\xe0A\x01R\aaliases\x12\x1f\n" +
+	"\bcategory\x18\x04 \x01(\tB\x03\xe0A\x01R\bcategory\x12\"\n" +
+	"\adensity\x18\x05 \x01(\x01B\x03\xe0A\x01H\x00R\adensity\x88\x01\x01\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime:\\\xeaAY\n" +
+	"$api.meals.recipe.v1alpha1/Ingredient\x12\x18ingredients/{ingredient}*\vingredients2\n" +
+	"ingredientB\n" +
+	"\n" +
+	"\b_density\"X\n" +
+	"\x14GetIngredientRequest\x12@\n" +
+	"\x04name\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.recipe.v1alpha1/IngredientR\x04name\"{\n" +
+	"\x16ListIngredientsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\"\x8a\x01\n" +
+	"\x17ListIngredientsResponse\x12G\n" +
+	"\vingredients\x18\x01 \x03(\v2%.api.meals.recipe.v1alpha1.IngredientR\vingredients\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"]\n" +
+	"\x1eAutocompleteIngredientsRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\"j\n" +
+	"\x1fAutocompleteIngredientsResponse\x12G\n" +
+	"\vingredients\x18\x01 \x03(\v2%.api.meals.recipe.v1alpha1.IngredientR\vingredients2\x89\a\n" +
+	"\x11IngredientService\x12\x81\x02\n" +
+	"\rGetIngredient\x12/.api.meals.recipe.v1alpha1.GetIngredientRequest\x1a%.api.meals.recipe.v1alpha1.Ingredient\"\x97\x01\x92Aa\n" +
+	"\x11IngredientService\x12\x11Get an ingredient\x1a9Retrieves a single canonical ingredient by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02&\x12$/meals/v1alpha1/{name=ingredients/*}\x12\x9f\x02\n" +
+	"\x0fListIngredients\x121.api.meals.recipe.v1alpha1.ListIngredientsRequest\x1a2.api.meals.recipe.v1alpha1.ListIngredientsResponse\"\xa4\x01\x92A~\n" +
+	"\x11IngredientService\x12\x10List ingredients\x1aWRetrieves a paginated list of canonical ingredients. Supports filtering and pagination.\x82\xd3\xe4\x93\x02\x1d\x12\x1b/meals/v1alpha1/ingredients\x12\xcd\x02\n" +
+	"\x17AutocompleteIngredients\x129.api.meals.recipe.v1alpha1.AutocompleteIngredientsRequest\x1a:.api.meals.recipe.v1alpha1.AutocompleteIngredientsResponse\"\xba\x01\x92A\x7f\n" +
+	"\x11IngredientService\x12\x18Autocomplete ingredients\x1aPReturns canonical ingredients whose title or aliases start with the given query.\xdaA\x05query\x82\xd3\xe4\x93\x02*\x12(/meals/v1alpha1/ingredients:autocompleteB\xe4\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x0fIngredientProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_ingredient_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_ingredient_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_ingredient_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_meals_recipe_v1alpha1_ingredient_proto_goTypes = []any{
+	(*Ingredient)(nil),                      // 0: api.meals.recipe.v1alpha1.Ingredient
+	(*GetIngredientRequest)(nil),            // 1: api.meals.recipe.v1alpha1.GetIngredientRequest
+	(*ListIngredientsRequest)(nil),          // 2: api.meals.recipe.v1alpha1.ListIngredientsRequest
+	(*ListIngredientsResponse)(nil),         // 3: api.meals.recipe.v1alpha1.ListIngredientsResponse
+	(*AutocompleteIngredientsRequest)(nil),  // 4: api.meals.recipe.v1alpha1.AutocompleteIngredientsRequest
+	(*AutocompleteIngredientsResponse)(nil), // 5: api.meals.recipe.v1alpha1.AutocompleteIngredientsResponse
+	(*timestamppb.Timestamp)(nil),           // 6: google.protobuf.Timestamp
+}
+var file_api_meals_recipe_v1alpha1_ingredient_proto_depIdxs = []int32{
+	6, // 0: api.meals.recipe.v1alpha1.Ingredient.create_time:type_name -> google.protobuf.Timestamp
+	6, // 1: api.meals.recipe.v1alpha1.Ingredient.update_time:type_name -> google.protobuf.Timestamp
+	0, // 2: api.meals.recipe.v1alpha1.ListIngredientsResponse.ingredients:type_name -> api.meals.recipe.v1alpha1.Ingredient
+	0, // 3: api.meals.recipe.v1alpha1.AutocompleteIngredientsResponse.ingredients:type_name -> api.meals.recipe.v1alpha1.Ingredient
+	1, // 4: api.meals.recipe.v1alpha1.IngredientService.GetIngredient:input_type -> api.meals.recipe.v1alpha1.GetIngredientRequest
+	2, // 5: api.meals.recipe.v1alpha1.IngredientService.ListIngredients:input_type -> api.meals.recipe.v1alpha1.ListIngredientsRequest
+	4, // 6: api.meals.recipe.v1alpha1.IngredientService.AutocompleteIngredients:input_type -> api.meals.recipe.v1alpha1.AutocompleteIngredientsRequest
+	0, // 7: api.meals.recipe.v1alpha1.IngredientService.GetIngredient:output_type -> api.meals.recipe.v1alpha1.Ingredient
+	3, // 8: api.meals.recipe.v1alpha1.IngredientService.ListIngredients:output_type -> api.meals.recipe.v1alpha1.ListIngredientsResponse
+	5, // 9: api.meals.recipe.v1alpha1.IngredientService.AutocompleteIngredients:output_type -> api.meals.recipe.v1alpha1.AutocompleteIngredientsResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_ingredient_proto_init() }
+func file_api_meals_recipe_v1alpha1_ingredient_proto_init() {
+	if File_api_meals_recipe_v1alpha1_ingredient_proto != nil {
+		return
+	}
+	file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_ingredient_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_ingredient_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_ingredient_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_ingredient_proto_depIdxs,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_ingredient_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_ingredient_proto = out.File
+	file_api_meals_recipe_v1alpha1_ingredient_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_ingredient_proto_depIdxs = nil
+}
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/ingredient.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	IngredientService_GetIngredient_FullMethodName           = "/api.meals.recipe.v1alpha1.IngredientService/GetIngredient"
+	IngredientService_ListIngredients_FullMethodName         = "/api.meals.recipe.v1alpha1.IngredientService/ListIngredients"
+	IngredientService_AutocompleteIngredients_FullMethodName = "/api.meals.recipe.v1alpha1.IngredientService/AutocompleteIngredients"
+)
+
+// IngredientServiceClient is the client API for IngredientService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the ingredient service
+type IngredientServiceClient interface {
+	// get an ingredient
+	GetIngredient(ctx context.Context, in *GetIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error)
+	// list ingredients
+	ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error)
+	// autocomplete ingredients
+	AutocompleteIngredients(ctx context.Context, in *AutocompleteIngredientsRequest, opts ...grpc.CallOption) (*AutocompleteIngredientsResponse, error)
+}
+
+type ingredientServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIngredientServiceClient(cc grpc.ClientConnInterface) IngredientServiceClient {
+	return &ingredientServiceClient{cc}
+}
+
+func (c *ingredientServiceClient) GetIngredient(ctx context.Context, in *GetIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ingredient)
+	err := c.cc.Invoke(ctx, IngredientService_GetIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingredientServiceClient) ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIngredientsResponse)
+	err := c.cc.Invoke(ctx, IngredientService_ListIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingredientServiceClient) AutocompleteIngredients(ctx context.Context, in *AutocompleteIngredientsRequest, opts ...grpc.CallOption) (*AutocompleteIngredientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutocompleteIngredientsResponse)
+	err := c.cc.Invoke(ctx, IngredientService_AutocompleteIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IngredientServiceServer is the server API for IngredientService service.
+// All implementations must embed UnimplementedIngredientServiceServer
+// for forward compatibility.
+//
+// the ingredient service
+type IngredientServiceServer interface {
+	// get an ingredient
+	GetIngredient(context.Context, *GetIngredientRequest) (*Ingredient, error)
+	// list ingredients
+	ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error)
+	// autocomplete ingredients
+	AutocompleteIngredients(context.Context, *AutocompleteIngredientsRequest) (*AutocompleteIngredientsResponse, error)
+	mustEmbedUnimplementedIngredientServiceServer()
+}
+
+// UnimplementedIngredientServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIngredientServiceServer struct{}
+
+func (UnimplementedIngredientServiceServer) GetIngredient(context.Context, *GetIngredientRequest) (*Ingredient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIngredient not implemented")
+}
+func (UnimplementedIngredientServiceServer) ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIngredients not implemented")
+}
+func (UnimplementedIngredientServiceServer) AutocompleteIngredients(context.Context, *AutocompleteIngredientsRequest) (*AutocompleteIngredientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteIngredients not implemented")
+}
+func (UnimplementedIngredientServiceServer) mustEmbedUnimplementedIngredientServiceServer() {}
+func (UnimplementedIngredientServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeIngredientServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IngredientServiceServer will
+// result in compilation errors.
+type UnsafeIngredientServiceServer interface {
+	mustEmbedUnimplementedIngredientServiceServer()
+}
+
+func RegisterIngredientServiceServer(s grpc.ServiceRegistrar, srv IngredientServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIngredientServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IngredientService_ServiceDesc, srv)
+}
+
+func _IngredientService_GetIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientServiceServer).GetIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngredientService_GetIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientServiceServer).GetIngredient(ctx, req.(*GetIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngredientService_ListIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngredientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientServiceServer).ListIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngredientService_ListIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientServiceServer).ListIngredients(ctx, req.(*ListIngredientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngredientService_AutocompleteIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteIngredientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientServiceServer).AutocompleteIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngredientService_AutocompleteIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientServiceServer).AutocompleteIngredients(ctx, req.(*AutocompleteIngredientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IngredientService_ServiceDesc is the grpc.ServiceDesc for IngredientService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IngredientService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.IngredientService",
+	HandlerType: (*IngredientServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetIngredient",
+			Handler:    _IngredientService_GetIngredient_Handler,
+		},
+		{
+			MethodName: "ListIngredients",
+			Handler:    _IngredientService_ListIngredients_Handler,
+		},
+		{
+			MethodName: "AutocompleteIngredients",
+			Handler:    _IngredientService_AutocompleteIngredients_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/ingredient.proto",
+}
@@ -8,6 +8,7 @@ package recipev1alpha1
 
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	v1alpha1 "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
 	types "github.com/jcfug8/daylear/server/genapi/api/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -28,6 +29,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// the unit of a temperature
+type Recipe_TemperatureUnit int32
+
+const (
+	// the unit is unspecified
+	Recipe_TEMPERATURE_UNIT_UNSPECIFIED Recipe_TemperatureUnit = 0
+	// the temperature is in degrees fahrenheit
+	Recipe_TEMPERATURE_UNIT_FAHRENHEIT Recipe_TemperatureUnit = 1
+	// the temperature is in degrees celsius
+	Recipe_TEMPERATURE_UNIT_CELSIUS Recipe_TemperatureUnit = 2
+)
+
+// Enum value maps for Recipe_TemperatureUnit.
+var (
+	Recipe_TemperatureUnit_name = map[int32]string{
+		0: "TEMPERATURE_UNIT_UNSPECIFIED",
+		1: "TEMPERATURE_UNIT_FAHRENHEIT",
+		2: "TEMPERATURE_UNIT_CELSIUS",
+	}
+	Recipe_TemperatureUnit_value = map[string]int32{
+		"TEMPERATURE_UNIT_UNSPECIFIED": 0,
+		"TEMPERATURE_UNIT_FAHRENHEIT":  1,
+		"TEMPERATURE_UNIT_CELSIUS":     2,
+	}
+)
+
+func (x Recipe_TemperatureUnit) Enum() *Recipe_TemperatureUnit {
+	p := new(Recipe_TemperatureUnit)
+	*p = x
+	return p
+}
+
+func (x Recipe_TemperatureUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Recipe_TemperatureUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[0].Descriptor()
+}
+
+func (Recipe_TemperatureUnit) Type() protoreflect.EnumType {
+	return &file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[0]
+}
+
+func (x Recipe_TemperatureUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Recipe_TemperatureUnit.Descriptor instead.
+func (Recipe_TemperatureUnit) EnumDescriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // the type of measurement
 type Recipe_MeasurementType int32
 
@@ -89,11 +143,11 @@ func (x Recipe_MeasurementType) String() string {
 }
 
 func (Recipe_MeasurementType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[0].Descriptor()
+	return file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[1].Descriptor()
 }
 
 func (Recipe_MeasurementType) Type() protoreflect.EnumType {
-	return &file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[0]
+	return &file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[1]
 }
 
 func (x Recipe_MeasurementType) Number() protoreflect.EnumNumber {
@@ -102,7 +156,7 @@ func (x Recipe_MeasurementType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Recipe_MeasurementType.Descriptor instead.
 func (Recipe_MeasurementType) EnumDescriptor() ([]byte, []int) {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 0}
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 1}
 }
 
 // the conjunction of the measurement
@@ -146,11 +200,11 @@ func (x Recipe_Ingredient_MeasurementConjunction) String() string {
 }
 
 func (Recipe_Ingredient_MeasurementConjunction) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[1].Descriptor()
+	return file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[2].Descriptor()
 }
 
 func (Recipe_Ingredient_MeasurementConjunction) Type() protoreflect.EnumType {
-	return &file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[1]
+	return &file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[2]
 }
 
 func (x Recipe_Ingredient_MeasurementConjunction) Number() protoreflect.EnumNumber {
@@ -159,7 +213,64 @@ func (x Recipe_Ingredient_MeasurementConjunction) Number() protoreflect.EnumNumb
 
 // Deprecated: Use Recipe_Ingredient_MeasurementConjunction.Descriptor instead.
 func (Recipe_Ingredient_MeasurementConjunction) EnumDescriptor() ([]byte, []int) {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 2, 0}
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 4, 0}
+}
+
+// why recipes are considered duplicates
+type RecipeDuplicate_Reason int32
+
+const (
+	// the reason is unspecified
+	RecipeDuplicate_REASON_UNSPECIFIED RecipeDuplicate_Reason = 0
+	// the recipes cite the same url
+	RecipeDuplicate_REASON_CITATION RecipeDuplicate_Reason = 1
+	// the recipes have similar titles
+	RecipeDuplicate_REASON_TITLE RecipeDuplicate_Reason = 2
+	// most of the ingredients of the recipes are the same
+	RecipeDuplicate_REASON_INGREDIENTS RecipeDuplicate_Reason = 3
+)
+
+// Enum value maps for RecipeDuplicate_Reason.
+var (
+	RecipeDuplicate_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_CITATION",
+		2: "REASON_TITLE",
+		3: "REASON_INGREDIENTS",
+	}
+	RecipeDuplicate_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED": 0,
+		"REASON_CITATION":    1,
+		"REASON_TITLE":       2,
+		"REASON_INGREDIENTS": 3,
+	}
+)
+
+func (x RecipeDuplicate_Reason) Enum() *RecipeDuplicate_Reason {
+	p := new(RecipeDuplicate_Reason)
+	*p = x
+	return p
+}
+
+func (x RecipeDuplicate_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipeDuplicate_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[3].Descriptor()
+}
+
+func (RecipeDuplicate_Reason) Type() protoreflect.EnumType {
+	return &file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes[3]
+}
+
+func (x RecipeDuplicate_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipeDuplicate_Reason.Descriptor instead.
+func (RecipeDuplicate_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{11, 0}
 }
 
 // the main recipe object
@@ -202,7 +313,39 @@ type Recipe struct {
 	// the total duration for the recipe
 	TotalDuration *durationpb.Duration `protobuf:"bytes,20,opt,name=total_duration,json=totalDuration,proto3" json:"total_duration,omitempty"`
 	// favorited
-	Favorited     bool `protobuf:"varint,21,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	Favorited bool `protobuf:"varint,21,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	// the estimated nutrition facts per serving
+	Nutrition *Recipe_Nutrition `protobuf:"bytes,22,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	// the recipe this recipe was forked from
+	ForkedFrom string `protobuf:"bytes,23,opt,name=forked_from,json=forkedFrom,proto3" json:"forked_from,omitempty"`
+	// the number of recipes forked from this recipe
+	ForkCount int64 `protobuf:"varint,24,opt,name=fork_count,json=forkCount,proto3" json:"fork_count,omitempty"`
+	// the average rating of the recipe's reviews, 0 when there are none
+	AverageRating float64 `protobuf:"fixed64,25,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	// the number of reviews of the recipe
+	RatingCount int64 `protobuf:"varint,26,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	// the resized variants and placeholder of the recipe image
+	Images *types.ImageSet `protobuf:"bytes,27,opt,name=images,proto3" json:"images,omitempty"`
+	// the diets the recipe is suitable for, derived from the ingredients with the overrides applied.
+	// one of vegetarian, vegan, gluten_free or dairy_free
+	Diets []string `protobuf:"bytes,28,rep,name=diets,proto3" json:"diets,omitempty"`
+	// the allergens the recipe contains, derived from the ingredients with the overrides applied.
+	// one of peanut, tree_nut, dairy, egg, soy, wheat, shellfish, mollusc, fish, sesame, celery,
+	// mustard, lupin or sulphite
+	Allergens []string `protobuf:"bytes,29,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	// manual corrections to the derived diets and allergens
+	DietaryOverrides *Recipe_DietaryOverrides `protobuf:"bytes,30,opt,name=dietary_overrides,json=dietaryOverrides,proto3" json:"dietary_overrides,omitempty"`
+	// the dietary restrictions of the current user that the recipe does not meet
+	RestrictionConflicts []string `protobuf:"bytes,31,rep,name=restriction_conflicts,json=restrictionConflicts,proto3" json:"restriction_conflicts,omitempty"`
+	// the recipes of the same collection that are likely copies of this one.
+	// only set in the response of CreateRecipe
+	PossibleDuplicates []string `protobuf:"bytes,32,rep,name=possible_duplicates,json=possibleDuplicates,proto3" json:"possible_duplicates,omitempty"`
+	// the last time the current user cooked the recipe, unset when they never did
+	LastCookedTime *timestamppb.Timestamp `protobuf:"bytes,33,opt,name=last_cooked_time,json=lastCookedTime,proto3" json:"last_cooked_time,omitempty"`
+	// the number of times the current user cooked the recipe
+	TimesCooked int64 `protobuf:"varint,34,opt,name=times_cooked,json=timesCooked,proto3" json:"times_cooked,omitempty"`
+	// the private notes of the current user on the recipe, only returned by GetRecipe
+	PrivateNotes  []*RecipeNote `protobuf:"bytes,35,rep,name=private_notes,json=privateNotes,proto3" json:"private_notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -370,6 +513,104 @@ func (x *Recipe) GetFavorited() bool {
 	return false
 }
 
+func (x *Recipe) GetNutrition() *Recipe_Nutrition {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+func (x *Recipe) GetForkedFrom() string {
+	if x != nil {
+		return x.ForkedFrom
+	}
+	return ""
+}
+
+func (x *Recipe) GetForkCount() int64 {
+	if x != nil {
+		return x.ForkCount
+	}
+	return 0
+}
+
+func (x *Recipe) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *Recipe) GetRatingCount() int64 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Recipe) GetImages() *types.ImageSet {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Recipe) GetDiets() []string {
+	if x != nil {
+		return x.Diets
+	}
+	return nil
+}
+
+func (x *Recipe) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *Recipe) GetDietaryOverrides() *Recipe_DietaryOverrides {
+	if x != nil {
+		return x.DietaryOverrides
+	}
+	return nil
+}
+
+func (x *Recipe) GetRestrictionConflicts() []string {
+	if x != nil {
+		return x.RestrictionConflicts
+	}
+	return nil
+}
+
+func (x *Recipe) GetPossibleDuplicates() []string {
+	if x != nil {
+		return x.PossibleDuplicates
+	}
+	return nil
+}
+
+func (x *Recipe) GetLastCookedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCookedTime
+	}
+	return nil
+}
+
+func (x *Recipe) GetTimesCooked() int64 {
+	if x != nil {
+		return x.TimesCooked
+	}
+	return 0
+}
+
+func (x *Recipe) GetPrivateNotes() []*RecipeNote {
+	if x != nil {
+		return x.PrivateNotes
+	}
+	return nil
+}
+
 // the request to create a recipe
 type CreateRecipeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -441,10 +682,13 @@ type ListRecipesRequest struct {
 	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// used to specify the page token
 	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// used to specify the filter
+	// used to specify the filter, e.g.
+	// diets:"vegetarian" AND NOT allergens:"peanut"
 	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	// the parent of the recipes
-	Parent        string `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	Parent string `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	// used to specify the sort order, e.g. "rating desc" or "last_cooked_time"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -507,6 +751,13 @@ func (x *ListRecipesRequest) GetParent() string {
 	return ""
 }
 
+func (x *ListRecipesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 // the response to list recipes
 type ListRecipesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -713,7 +964,10 @@ func (x *GetRecipeRequest) GetName() string {
 type ScrapeRecipeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// the uri of the recipe
-	Uri           string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// the collection the recipe will be saved into, either users/{user} or
+	// circles/{circle}, used to look for duplicates. Defaults to the collection of the caller.
+	Parent        string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -755,11 +1009,20 @@ func (x *ScrapeRecipeRequest) GetUri() string {
 	return ""
 }
 
+func (x *ScrapeRecipeRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
 // the response to scrape a recipe from a url
 type ScrapeRecipeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// the recipe
-	Recipe        *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	Recipe *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// the recipes of the collection that are likely copies of the scraped recipe, most similar first
+	Duplicates    []*RecipeDuplicate `protobuf:"bytes,2,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -801,29 +1064,38 @@ func (x *ScrapeRecipeResponse) GetRecipe() *Recipe {
 	return nil
 }
 
-// the request to favorite a recipe
-type FavoriteRecipeRequest struct {
+func (x *ScrapeRecipeResponse) GetDuplicates() []*RecipeDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+// the request to start generating an image for a recipe
+type StartGenerateRecipeImageRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the name of the recipe to favorite
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// the name of the recipe
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the number of candidates to generate, defaults to 3 and at most 4
+	CandidateCount int32 `protobuf:"varint,2,opt,name=candidate_count,json=candidateCount,proto3" json:"candidate_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *FavoriteRecipeRequest) Reset() {
-	*x = FavoriteRecipeRequest{}
+func (x *StartGenerateRecipeImageRequest) Reset() {
+	*x = StartGenerateRecipeImageRequest{}
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FavoriteRecipeRequest) String() string {
+func (x *StartGenerateRecipeImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteRecipeRequest) ProtoMessage() {}
+func (*StartGenerateRecipeImageRequest) ProtoMessage() {}
 
-func (x *FavoriteRecipeRequest) ProtoReflect() protoreflect.Message {
+func (x *StartGenerateRecipeImageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,39 +1107,48 @@ func (x *FavoriteRecipeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteRecipeRequest.ProtoReflect.Descriptor instead.
-func (*FavoriteRecipeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartGenerateRecipeImageRequest.ProtoReflect.Descriptor instead.
+func (*StartGenerateRecipeImageRequest) Descriptor() ([]byte, []int) {
 	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *FavoriteRecipeRequest) GetName() string {
+func (x *StartGenerateRecipeImageRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// the response to favorite a recipe
-type FavoriteRecipeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *StartGenerateRecipeImageRequest) GetCandidateCount() int32 {
+	if x != nil {
+		return x.CandidateCount
+	}
+	return 0
+}
+
+// the response of an operation that generated images for a recipe
+type GenerateRecipeImageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the generated candidates, kept with the recipe until one is selected
+	Candidates    []*RecipeImageCandidate `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FavoriteRecipeResponse) Reset() {
-	*x = FavoriteRecipeResponse{}
+func (x *GenerateRecipeImageResponse) Reset() {
+	*x = GenerateRecipeImageResponse{}
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FavoriteRecipeResponse) String() string {
+func (x *GenerateRecipeImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteRecipeResponse) ProtoMessage() {}
+func (*GenerateRecipeImageResponse) ProtoMessage() {}
 
-func (x *FavoriteRecipeResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateRecipeImageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -879,34 +1160,45 @@ func (x *FavoriteRecipeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteRecipeResponse.ProtoReflect.Descriptor instead.
-func (*FavoriteRecipeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateRecipeImageResponse.ProtoReflect.Descriptor instead.
+func (*GenerateRecipeImageResponse) Descriptor() ([]byte, []int) {
 	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{10}
 }
 
-// the request to unfavorite a recipe
-type UnfavoriteRecipeRequest struct {
+func (x *GenerateRecipeImageResponse) GetCandidates() []*RecipeImageCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// a recipe that is likely a copy of another recipe
+type RecipeDuplicate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the name of the recipe to unfavorite
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the recipe
+	Recipe *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// how alike the recipes are, from 0 to 1
+	Similarity float64 `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	// what the recipes matched on
+	Reasons       []RecipeDuplicate_Reason `protobuf:"varint,3,rep,packed,name=reasons,proto3,enum=api.meals.recipe.v1alpha1.RecipeDuplicate_Reason" json:"reasons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfavoriteRecipeRequest) Reset() {
-	*x = UnfavoriteRecipeRequest{}
+func (x *RecipeDuplicate) Reset() {
+	*x = RecipeDuplicate{}
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfavoriteRecipeRequest) String() string {
+func (x *RecipeDuplicate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfavoriteRecipeRequest) ProtoMessage() {}
+func (*RecipeDuplicate) ProtoMessage() {}
 
-func (x *UnfavoriteRecipeRequest) ProtoReflect() protoreflect.Message {
+func (x *RecipeDuplicate) ProtoReflect() protoreflect.Message {
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -918,39 +1210,55 @@ func (x *UnfavoriteRecipeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfavoriteRecipeRequest.ProtoReflect.Descriptor instead.
-func (*UnfavoriteRecipeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecipeDuplicate.ProtoReflect.Descriptor instead.
+func (*RecipeDuplicate) Descriptor() ([]byte, []int) {
 	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UnfavoriteRecipeRequest) GetName() string {
+func (x *RecipeDuplicate) GetRecipe() *Recipe {
 	if x != nil {
-		return x.Name
+		return x.Recipe
 	}
-	return ""
+	return nil
 }
 
-// the response to unfavorite a recipe
-type UnfavoriteRecipeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *RecipeDuplicate) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *RecipeDuplicate) GetReasons() []RecipeDuplicate_Reason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// the request to favorite a recipe
+type FavoriteRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the recipe to favorite
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfavoriteRecipeResponse) Reset() {
-	*x = UnfavoriteRecipeResponse{}
+func (x *FavoriteRecipeRequest) Reset() {
+	*x = FavoriteRecipeRequest{}
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfavoriteRecipeResponse) String() string {
+func (x *FavoriteRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfavoriteRecipeResponse) ProtoMessage() {}
+func (*FavoriteRecipeRequest) ProtoMessage() {}
 
-func (x *UnfavoriteRecipeResponse) ProtoReflect() protoreflect.Message {
+func (x *FavoriteRecipeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -962,37 +1270,1030 @@ func (x *UnfavoriteRecipeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfavoriteRecipeResponse.ProtoReflect.Descriptor instead.
-func (*UnfavoriteRecipeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FavoriteRecipeRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteRecipeRequest) Descriptor() ([]byte, []int) {
 	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{12}
 }
 
-// the directions to make the recipe
-type Recipe_Direction struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the title of the step
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// the steps in the instruction
-	Steps         []string `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+func (x *FavoriteRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the response to favorite a recipe
+type FavoriteRecipeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Recipe_Direction) Reset() {
-	*x = Recipe_Direction{}
+func (x *FavoriteRecipeResponse) Reset() {
+	*x = FavoriteRecipeResponse{}
 	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Recipe_Direction) String() string {
+func (x *FavoriteRecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteRecipeResponse) ProtoMessage() {}
+
+func (x *FavoriteRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteRecipeResponse.ProtoReflect.Descriptor instead.
+func (*FavoriteRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{13}
+}
+
+// the request to unfavorite a recipe
+type UnfavoriteRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the recipe to unfavorite
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfavoriteRecipeRequest) Reset() {
+	*x = UnfavoriteRecipeRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfavoriteRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfavoriteRecipeRequest) ProtoMessage() {}
+
+func (x *UnfavoriteRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfavoriteRecipeRequest.ProtoReflect.Descriptor instead.
+func (*UnfavoriteRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnfavoriteRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the response to unfavorite a recipe
+type UnfavoriteRecipeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfavoriteRecipeResponse) Reset() {
+	*x = UnfavoriteRecipeResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfavoriteRecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfavoriteRecipeResponse) ProtoMessage() {}
+
+func (x *UnfavoriteRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfavoriteRecipeResponse.ProtoReflect.Descriptor instead.
+func (*UnfavoriteRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{15}
+}
+
+// the request to match recipes against the ingredients on hand
+type MatchRecipesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent of the recipes to match
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the titles of the ingredients on hand
+	Ingredients []string `protobuf:"bytes,2,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	// used to filter the recipes before matching, e.g.
+	// cuisines:"Italian" AND total_duration <= "45m"
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// leaves out recipes with a lower coverage percentage
+	MinimumCoverage float64 `protobuf:"fixed64,4,opt,name=minimum_coverage,json=minimumCoverage,proto3" json:"minimum_coverage,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchRecipesRequest) Reset() {
+	*x = MatchRecipesRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRecipesRequest) ProtoMessage() {}
+
+func (x *MatchRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRecipesRequest.ProtoReflect.Descriptor instead.
+func (*MatchRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MatchRecipesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *MatchRecipesRequest) GetIngredients() []string {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *MatchRecipesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *MatchRecipesRequest) GetMinimumCoverage() float64 {
+	if x != nil {
+		return x.MinimumCoverage
+	}
+	return 0
+}
+
+func (x *MatchRecipesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *MatchRecipesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to match recipes
+type MatchRecipesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the matches, best first
+	RecipeMatches []*MatchRecipesResponse_RecipeMatch `protobuf:"bytes,1,rep,name=recipe_matches,json=recipeMatches,proto3" json:"recipe_matches,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchRecipesResponse) Reset() {
+	*x = MatchRecipesResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRecipesResponse) ProtoMessage() {}
+
+func (x *MatchRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRecipesResponse.ProtoReflect.Descriptor instead.
+func (*MatchRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MatchRecipesResponse) GetRecipeMatches() []*MatchRecipesResponse_RecipeMatch {
+	if x != nil {
+		return x.RecipeMatches
+	}
+	return nil
+}
+
+func (x *MatchRecipesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to fork a recipe
+type ForkRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the recipe to fork
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the collection to fork the recipe into, either users/{user} or
+	// circles/{circle}. Defaults to the collection of the caller.
+	Parent        string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForkRecipeRequest) Reset() {
+	*x = ForkRecipeRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkRecipeRequest) ProtoMessage() {}
+
+func (x *ForkRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ForkRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ForkRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ForkRecipeRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// the request to find duplicate recipes
+type FindDuplicateRecipesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the collection to search, either users/{user} or circles/{circle}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateRecipesRequest) Reset() {
+	*x = FindDuplicateRecipesRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateRecipesRequest) ProtoMessage() {}
+
+func (x *FindDuplicateRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateRecipesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicateRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FindDuplicateRecipesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *FindDuplicateRecipesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindDuplicateRecipesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to find duplicate recipes
+type FindDuplicateRecipesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the groups of duplicates
+	DuplicateGroups []*FindDuplicateRecipesResponse_DuplicateGroup `protobuf:"bytes,1,rep,name=duplicate_groups,json=duplicateGroups,proto3" json:"duplicate_groups,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateRecipesResponse) Reset() {
+	*x = FindDuplicateRecipesResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateRecipesResponse) ProtoMessage() {}
+
+func (x *FindDuplicateRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateRecipesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicateRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FindDuplicateRecipesResponse) GetDuplicateGroups() []*FindDuplicateRecipesResponse_DuplicateGroup {
+	if x != nil {
+		return x.DuplicateGroups
+	}
+	return nil
+}
+
+func (x *FindDuplicateRecipesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to merge recipes
+type MergeRecipesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the recipe to keep
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the names of the recipes to merge into the kept recipe. they are deleted
+	// once their favorites, accesses and event links are moved
+	Recipes       []string `protobuf:"bytes,2,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeRecipesRequest) Reset() {
+	*x = MergeRecipesRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRecipesRequest) ProtoMessage() {}
+
+func (x *MergeRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRecipesRequest.ProtoReflect.Descriptor instead.
+func (*MergeRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MergeRecipesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MergeRecipesRequest) GetRecipes() []string {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+// manual corrections to the diets and allergens derived from the ingredients
+type Recipe_DietaryOverrides struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the diets the recipe is suitable for even though an ingredient is not
+	AddedDiets []string `protobuf:"bytes,1,rep,name=added_diets,json=addedDiets,proto3" json:"added_diets,omitempty"`
+	// the diets the recipe is not suitable for even though no ingredient breaks them
+	RemovedDiets []string `protobuf:"bytes,2,rep,name=removed_diets,json=removedDiets,proto3" json:"removed_diets,omitempty"`
+	// the allergens the recipe contains even though no ingredient was found to contain them
+	AddedAllergens []string `protobuf:"bytes,3,rep,name=added_allergens,json=addedAllergens,proto3" json:"added_allergens,omitempty"`
+	// the allergens the recipe does not contain even though an ingredient was found to contain them
+	RemovedAllergens []string `protobuf:"bytes,4,rep,name=removed_allergens,json=removedAllergens,proto3" json:"removed_allergens,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Recipe_DietaryOverrides) Reset() {
+	*x = Recipe_DietaryOverrides{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_DietaryOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe_DietaryOverrides) ProtoMessage() {}
+
+func (x *Recipe_DietaryOverrides) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe_DietaryOverrides.ProtoReflect.Descriptor instead.
+func (*Recipe_DietaryOverrides) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Recipe_DietaryOverrides) GetAddedDiets() []string {
+	if x != nil {
+		return x.AddedDiets
+	}
+	return nil
+}
+
+func (x *Recipe_DietaryOverrides) GetRemovedDiets() []string {
+	if x != nil {
+		return x.RemovedDiets
+	}
+	return nil
+}
+
+func (x *Recipe_DietaryOverrides) GetAddedAllergens() []string {
+	if x != nil {
+		return x.AddedAllergens
+	}
+	return nil
+}
+
+func (x *Recipe_DietaryOverrides) GetRemovedAllergens() []string {
+	if x != nil {
+		return x.RemovedAllergens
+	}
+	return nil
+}
+
+// the directions to make the recipe
+type Recipe_Direction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the title of the step
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the steps in the instruction
+	Steps []string `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	// the timers, temperatures and ingredients of the steps, in the same order as the steps.
+	// the details of a step are extracted from its text when they are left empty
+	StepDetails   []*Recipe_StepDetail `protobuf:"bytes,3,rep,name=step_details,json=stepDetails,proto3" json:"step_details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recipe_Direction) Reset() {
+	*x = Recipe_Direction{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_Direction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe_Direction) ProtoMessage() {}
+
+func (x *Recipe_Direction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe_Direction.ProtoReflect.Descriptor instead.
+func (*Recipe_Direction) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Recipe_Direction) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Recipe_Direction) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Recipe_Direction) GetStepDetails() []*Recipe_StepDetail {
+	if x != nil {
+		return x.StepDetails
+	}
+	return nil
+}
+
+// what a step of the directions calls for
+type Recipe_StepDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the durations mentioned in the step
+	Timers []*Recipe_StepDetail_Timer `protobuf:"bytes,1,rep,name=timers,proto3" json:"timers,omitempty"`
+	// the temperatures mentioned in the step
+	Temperatures []*Recipe_StepDetail_Temperature `protobuf:"bytes,2,rep,name=temperatures,proto3" json:"temperatures,omitempty"`
+	// the ingredients used in the step
+	Ingredients   []*Recipe_StepDetail_IngredientReference `protobuf:"bytes,3,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recipe_StepDetail) Reset() {
+	*x = Recipe_StepDetail{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_StepDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe_StepDetail) ProtoMessage() {}
+
+func (x *Recipe_StepDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe_StepDetail.ProtoReflect.Descriptor instead.
+func (*Recipe_StepDetail) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Recipe_StepDetail) GetTimers() []*Recipe_StepDetail_Timer {
+	if x != nil {
+		return x.Timers
+	}
+	return nil
+}
+
+func (x *Recipe_StepDetail) GetTemperatures() []*Recipe_StepDetail_Temperature {
+	if x != nil {
+		return x.Temperatures
+	}
+	return nil
+}
+
+func (x *Recipe_StepDetail) GetIngredients() []*Recipe_StepDetail_IngredientReference {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+// an ingredient group in a recipe
+type Recipe_IngredientGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the group
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the ingredients in the group
+	Ingredients   []*Recipe_Ingredient `protobuf:"bytes,2,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recipe_IngredientGroup) Reset() {
+	*x = Recipe_IngredientGroup{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_IngredientGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe_IngredientGroup) ProtoMessage() {}
+
+func (x *Recipe_IngredientGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe_IngredientGroup.ProtoReflect.Descriptor instead.
+func (*Recipe_IngredientGroup) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Recipe_IngredientGroup) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Recipe_IngredientGroup) GetIngredients() []*Recipe_Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+// an ingredient in a recipe
+type Recipe_Ingredient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the ingredient
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// wheter the ingredient is optional
+	Optional bool `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	// the quantity of the ingredient
+	MeasurementAmount float64 `protobuf:"fixed64,4,opt,name=measurement_amount,json=measurementAmount,proto3" json:"measurement_amount,omitempty"`
+	// the type of measurement
+	MeasurementType Recipe_MeasurementType `protobuf:"varint,5,opt,name=measurement_type,json=measurementType,proto3,enum=api.meals.recipe.v1alpha1.Recipe_MeasurementType" json:"measurement_type,omitempty"`
+	// measurment conjunction
+	MeasurementConjunction Recipe_Ingredient_MeasurementConjunction `protobuf:"varint,8,opt,name=measurement_conjunction,json=measurementConjunction,proto3,enum=api.meals.recipe.v1alpha1.Recipe_Ingredient_MeasurementConjunction" json:"measurement_conjunction,omitempty"`
+	// the second quantity of the ingredient
+	SecondMeasurementAmount float64 `protobuf:"fixed64,6,opt,name=second_measurement_amount,json=secondMeasurementAmount,proto3" json:"second_measurement_amount,omitempty"`
+	// the type of measurement for the second quantity
+	SecondMeasurementType Recipe_MeasurementType `protobuf:"varint,7,opt,name=second_measurement_type,json=secondMeasurementType,proto3,enum=api.meals.recipe.v1alpha1.Recipe_MeasurementType" json:"second_measurement_type,omitempty"`
+	// the canonical ingredient this ingredient is linked to
+	Ingredient    string `protobuf:"bytes,9,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recipe_Ingredient) Reset() {
+	*x = Recipe_Ingredient{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe_Ingredient) ProtoMessage() {}
+
+func (x *Recipe_Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe_Ingredient.ProtoReflect.Descriptor instead.
+func (*Recipe_Ingredient) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Recipe_Ingredient) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Recipe_Ingredient) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *Recipe_Ingredient) GetMeasurementAmount() float64 {
+	if x != nil {
+		return x.MeasurementAmount
+	}
+	return 0
+}
+
+func (x *Recipe_Ingredient) GetMeasurementType() Recipe_MeasurementType {
+	if x != nil {
+		return x.MeasurementType
+	}
+	return Recipe_MEASUREMENT_TYPE_UNSPECIFIED
+}
+
+func (x *Recipe_Ingredient) GetMeasurementConjunction() Recipe_Ingredient_MeasurementConjunction {
+	if x != nil {
+		return x.MeasurementConjunction
+	}
+	return Recipe_Ingredient_MEASUREMENT_CONJUNCTION_UNSPECIFIED
+}
+
+func (x *Recipe_Ingredient) GetSecondMeasurementAmount() float64 {
+	if x != nil {
+		return x.SecondMeasurementAmount
+	}
+	return 0
+}
+
+func (x *Recipe_Ingredient) GetSecondMeasurementType() Recipe_MeasurementType {
+	if x != nil {
+		return x.SecondMeasurementType
+	}
+	return Recipe_MEASUREMENT_TYPE_UNSPECIFIED
+}
+
+func (x *Recipe_Ingredient) GetIngredient() string {
+	if x != nil {
+		return x.Ingredient
+	}
+	return ""
+}
+
+// nutrition facts estimated from the ingredients
+type Recipe_Nutrition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the number of servings the totals were divided by, parsed from the yield amount
+	Servings float64 `protobuf:"fixed64,1,opt,name=servings,proto3" json:"servings,omitempty"`
+	// the calories (kcal) per serving
+	Calories float64 `protobuf:"fixed64,2,opt,name=calories,proto3" json:"calories,omitempty"`
+	// the protein in grams per serving
+	ProteinGrams float64 `protobuf:"fixed64,3,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	// the fat in grams per serving
+	FatGrams float64 `protobuf:"fixed64,4,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	// the carbohydrates in grams per serving
+	CarbohydrateGrams float64 `protobuf:"fixed64,5,opt,name=carbohydrate_grams,json=carbohydrateGrams,proto3" json:"carbohydrate_grams,omitempty"`
+	// the fiber in grams per serving
+	FiberGrams float64 `protobuf:"fixed64,6,opt,name=fiber_grams,json=fiberGrams,proto3" json:"fiber_grams,omitempty"`
+	// the sugar in grams per serving
+	SugarGrams float64 `protobuf:"fixed64,7,opt,name=sugar_grams,json=sugarGrams,proto3" json:"sugar_grams,omitempty"`
+	// the sodium in milligrams per serving
+	SodiumMilligrams float64 `protobuf:"fixed64,8,opt,name=sodium_milligrams,json=sodiumMilligrams,proto3" json:"sodium_milligrams,omitempty"`
+	// the titles of the ingredients that could not be matched or converted to grams
+	UnmatchedIngredients []string `protobuf:"bytes,9,rep,name=unmatched_ingredients,json=unmatchedIngredients,proto3" json:"unmatched_ingredients,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Recipe_Nutrition) Reset() {
+	*x = Recipe_Nutrition{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_Nutrition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe_Nutrition) ProtoMessage() {}
+
+func (x *Recipe_Nutrition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe_Nutrition.ProtoReflect.Descriptor instead.
+func (*Recipe_Nutrition) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *Recipe_Nutrition) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetCarbohydrateGrams() float64 {
+	if x != nil {
+		return x.CarbohydrateGrams
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetFiberGrams() float64 {
+	if x != nil {
+		return x.FiberGrams
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetSugarGrams() float64 {
+	if x != nil {
+		return x.SugarGrams
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetSodiumMilligrams() float64 {
+	if x != nil {
+		return x.SodiumMilligrams
+	}
+	return 0
+}
+
+func (x *Recipe_Nutrition) GetUnmatchedIngredients() []string {
+	if x != nil {
+		return x.UnmatchedIngredients
+	}
+	return nil
+}
+
+// the recipe access details
+type Recipe_RecipeAccess struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the recipe access
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the permission of the recipe
+	PermissionLevel types.PermissionLevel `protobuf:"varint,2,opt,name=permission_level,json=permissionLevel,proto3,enum=api.types.PermissionLevel" json:"permission_level,omitempty"`
+	// the access state of the user to the recipe
+	State types.AccessState `protobuf:"varint,3,opt,name=state,proto3,enum=api.types.AccessState" json:"state,omitempty"`
+	// the accept target of the recipe
+	AcceptTarget  types.AcceptTarget `protobuf:"varint,4,opt,name=accept_target,json=acceptTarget,proto3,enum=api.types.AcceptTarget" json:"accept_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recipe_RecipeAccess) Reset() {
+	*x = Recipe_RecipeAccess{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_RecipeAccess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Recipe_Direction) ProtoMessage() {}
+func (*Recipe_RecipeAccess) ProtoMessage() {}
 
-func (x *Recipe_Direction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[13]
+func (x *Recipe_RecipeAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,51 +2304,65 @@ func (x *Recipe_Direction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Recipe_Direction.ProtoReflect.Descriptor instead.
-func (*Recipe_Direction) Descriptor() ([]byte, []int) {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use Recipe_RecipeAccess.ProtoReflect.Descriptor instead.
+func (*Recipe_RecipeAccess) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 6}
 }
 
-func (x *Recipe_Direction) GetTitle() string {
+func (x *Recipe_RecipeAccess) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Recipe_Direction) GetSteps() []string {
+func (x *Recipe_RecipeAccess) GetPermissionLevel() types.PermissionLevel {
 	if x != nil {
-		return x.Steps
+		return x.PermissionLevel
 	}
-	return nil
+	return types.PermissionLevel(0)
 }
 
-// an ingredient group in a recipe
-type Recipe_IngredientGroup struct {
+func (x *Recipe_RecipeAccess) GetState() types.AccessState {
+	if x != nil {
+		return x.State
+	}
+	return types.AccessState(0)
+}
+
+func (x *Recipe_RecipeAccess) GetAcceptTarget() types.AcceptTarget {
+	if x != nil {
+		return x.AcceptTarget
+	}
+	return types.AcceptTarget(0)
+}
+
+// a duration mentioned in a step, the shortest duration is used for a range
+type Recipe_StepDetail_Timer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the name of the group
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// the ingredients in the group
-	Ingredients   []*Recipe_Ingredient `protobuf:"bytes,2,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	// the text the timer was found in, e.g. "20 minutes"
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// the duration of the timer
+	Duration      *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Recipe_IngredientGroup) Reset() {
-	*x = Recipe_IngredientGroup{}
-	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[14]
+func (x *Recipe_StepDetail_Timer) Reset() {
+	*x = Recipe_StepDetail_Timer{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Recipe_IngredientGroup) String() string {
+func (x *Recipe_StepDetail_Timer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Recipe_IngredientGroup) ProtoMessage() {}
+func (*Recipe_StepDetail_Timer) ProtoMessage() {}
 
-func (x *Recipe_IngredientGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[14]
+func (x *Recipe_StepDetail_Timer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,61 +2373,53 @@ func (x *Recipe_IngredientGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Recipe_IngredientGroup.ProtoReflect.Descriptor instead.
-func (*Recipe_IngredientGroup) Descriptor() ([]byte, []int) {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use Recipe_StepDetail_Timer.ProtoReflect.Descriptor instead.
+func (*Recipe_StepDetail_Timer) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 2, 0}
 }
 
-func (x *Recipe_IngredientGroup) GetTitle() string {
+func (x *Recipe_StepDetail_Timer) GetText() string {
 	if x != nil {
-		return x.Title
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Recipe_IngredientGroup) GetIngredients() []*Recipe_Ingredient {
+func (x *Recipe_StepDetail_Timer) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Ingredients
+		return x.Duration
 	}
 	return nil
 }
 
-// an ingredient in a recipe
-type Recipe_Ingredient struct {
+// a temperature mentioned in a step
+type Recipe_StepDetail_Temperature struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the name of the ingredient
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// wheter the ingredient is optional
-	Optional bool `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
-	// the quantity of the ingredient
-	MeasurementAmount float64 `protobuf:"fixed64,4,opt,name=measurement_amount,json=measurementAmount,proto3" json:"measurement_amount,omitempty"`
-	// the type of measurement
-	MeasurementType Recipe_MeasurementType `protobuf:"varint,5,opt,name=measurement_type,json=measurementType,proto3,enum=api.meals.recipe.v1alpha1.Recipe_MeasurementType" json:"measurement_type,omitempty"`
-	// measurment conjunction
-	MeasurementConjunction Recipe_Ingredient_MeasurementConjunction `protobuf:"varint,8,opt,name=measurement_conjunction,json=measurementConjunction,proto3,enum=api.meals.recipe.v1alpha1.Recipe_Ingredient_MeasurementConjunction" json:"measurement_conjunction,omitempty"`
-	// the second quantity of the ingredient
-	SecondMeasurementAmount float64 `protobuf:"fixed64,6,opt,name=second_measurement_amount,json=secondMeasurementAmount,proto3" json:"second_measurement_amount,omitempty"`
-	// the type of measurement for the second quantity
-	SecondMeasurementType Recipe_MeasurementType `protobuf:"varint,7,opt,name=second_measurement_type,json=secondMeasurementType,proto3,enum=api.meals.recipe.v1alpha1.Recipe_MeasurementType" json:"second_measurement_type,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// the text the temperature was found in, e.g. "350°F"
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// the value of the temperature
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// the unit of the temperature
+	Unit          Recipe_TemperatureUnit `protobuf:"varint,3,opt,name=unit,proto3,enum=api.meals.recipe.v1alpha1.Recipe_TemperatureUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Recipe_Ingredient) Reset() {
-	*x = Recipe_Ingredient{}
-	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[15]
+func (x *Recipe_StepDetail_Temperature) Reset() {
+	*x = Recipe_StepDetail_Temperature{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Recipe_Ingredient) String() string {
+func (x *Recipe_StepDetail_Temperature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Recipe_Ingredient) ProtoMessage() {}
+func (*Recipe_StepDetail_Temperature) ProtoMessage() {}
 
-func (x *Recipe_Ingredient) ProtoReflect() protoreflect.Message {
-	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[15]
+func (x *Recipe_StepDetail_Temperature) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,90 +2430,117 @@ func (x *Recipe_Ingredient) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Recipe_Ingredient.ProtoReflect.Descriptor instead.
-func (*Recipe_Ingredient) Descriptor() ([]byte, []int) {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use Recipe_StepDetail_Temperature.ProtoReflect.Descriptor instead.
+func (*Recipe_StepDetail_Temperature) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 2, 1}
 }
 
-func (x *Recipe_Ingredient) GetTitle() string {
+func (x *Recipe_StepDetail_Temperature) GetText() string {
 	if x != nil {
-		return x.Title
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Recipe_Ingredient) GetOptional() bool {
+func (x *Recipe_StepDetail_Temperature) GetValue() float64 {
 	if x != nil {
-		return x.Optional
+		return x.Value
 	}
-	return false
+	return 0
 }
 
-func (x *Recipe_Ingredient) GetMeasurementAmount() float64 {
+func (x *Recipe_StepDetail_Temperature) GetUnit() Recipe_TemperatureUnit {
 	if x != nil {
-		return x.MeasurementAmount
+		return x.Unit
 	}
-	return 0
+	return Recipe_TEMPERATURE_UNIT_UNSPECIFIED
 }
 
-func (x *Recipe_Ingredient) GetMeasurementType() Recipe_MeasurementType {
-	if x != nil {
-		return x.MeasurementType
-	}
-	return Recipe_MEASUREMENT_TYPE_UNSPECIFIED
+// a reference to an ingredient of the recipe
+type Recipe_StepDetail_IngredientReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the index of the ingredient group
+	GroupIndex int32 `protobuf:"varint,1,opt,name=group_index,json=groupIndex,proto3" json:"group_index,omitempty"`
+	// the index of the ingredient within the group
+	IngredientIndex int32 `protobuf:"varint,2,opt,name=ingredient_index,json=ingredientIndex,proto3" json:"ingredient_index,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Recipe_Ingredient) GetMeasurementConjunction() Recipe_Ingredient_MeasurementConjunction {
+func (x *Recipe_StepDetail_IngredientReference) Reset() {
+	*x = Recipe_StepDetail_IngredientReference{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe_StepDetail_IngredientReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe_StepDetail_IngredientReference) ProtoMessage() {}
+
+func (x *Recipe_StepDetail_IngredientReference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[31]
 	if x != nil {
-		return x.MeasurementConjunction
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Recipe_Ingredient_MEASUREMENT_CONJUNCTION_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Recipe_Ingredient) GetSecondMeasurementAmount() float64 {
+// Deprecated: Use Recipe_StepDetail_IngredientReference.ProtoReflect.Descriptor instead.
+func (*Recipe_StepDetail_IngredientReference) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 2, 2}
+}
+
+func (x *Recipe_StepDetail_IngredientReference) GetGroupIndex() int32 {
 	if x != nil {
-		return x.SecondMeasurementAmount
+		return x.GroupIndex
 	}
 	return 0
 }
 
-func (x *Recipe_Ingredient) GetSecondMeasurementType() Recipe_MeasurementType {
+func (x *Recipe_StepDetail_IngredientReference) GetIngredientIndex() int32 {
 	if x != nil {
-		return x.SecondMeasurementType
+		return x.IngredientIndex
 	}
-	return Recipe_MEASUREMENT_TYPE_UNSPECIFIED
+	return 0
 }
 
-// the recipe access details
-type Recipe_RecipeAccess struct {
+// a recipe and how much of it can be made
+type MatchRecipesResponse_RecipeMatch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the name of the recipe access
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// the permission of the recipe
-	PermissionLevel types.PermissionLevel `protobuf:"varint,2,opt,name=permission_level,json=permissionLevel,proto3,enum=api.types.PermissionLevel" json:"permission_level,omitempty"`
-	// the access state of the user to the recipe
-	State types.AccessState `protobuf:"varint,3,opt,name=state,proto3,enum=api.types.AccessState" json:"state,omitempty"`
-	// the accept target of the recipe
-	AcceptTarget  types.AcceptTarget `protobuf:"varint,4,opt,name=accept_target,json=acceptTarget,proto3,enum=api.types.AcceptTarget" json:"accept_target,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Recipe_RecipeAccess) Reset() {
-	*x = Recipe_RecipeAccess{}
-	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[16]
+	// the recipe
+	Recipe *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// the percentage of required, non-optional ingredients on hand
+	Coverage float64 `protobuf:"fixed64,2,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	// the required ingredients that are on hand
+	AvailableIngredients []string `protobuf:"bytes,3,rep,name=available_ingredients,json=availableIngredients,proto3" json:"available_ingredients,omitempty"`
+	// the required ingredients that are missing
+	MissingIngredients []string `protobuf:"bytes,4,rep,name=missing_ingredients,json=missingIngredients,proto3" json:"missing_ingredients,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MatchRecipesResponse_RecipeMatch) Reset() {
+	*x = MatchRecipesResponse_RecipeMatch{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Recipe_RecipeAccess) String() string {
+func (x *MatchRecipesResponse_RecipeMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Recipe_RecipeAccess) ProtoMessage() {}
+func (*MatchRecipesResponse_RecipeMatch) ProtoMessage() {}
 
-func (x *Recipe_RecipeAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[16]
+func (x *MatchRecipesResponse_RecipeMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,44 +2551,99 @@ func (x *Recipe_RecipeAccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Recipe_RecipeAccess.ProtoReflect.Descriptor instead.
-func (*Recipe_RecipeAccess) Descriptor() ([]byte, []int) {
-	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use MatchRecipesResponse_RecipeMatch.ProtoReflect.Descriptor instead.
+func (*MatchRecipesResponse_RecipeMatch) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *Recipe_RecipeAccess) GetName() string {
+func (x *MatchRecipesResponse_RecipeMatch) GetRecipe() *Recipe {
 	if x != nil {
-		return x.Name
+		return x.Recipe
 	}
-	return ""
+	return nil
 }
 
-func (x *Recipe_RecipeAccess) GetPermissionLevel() types.PermissionLevel {
+func (x *MatchRecipesResponse_RecipeMatch) GetCoverage() float64 {
 	if x != nil {
-		return x.PermissionLevel
+		return x.Coverage
 	}
-	return types.PermissionLevel(0)
+	return 0
 }
 
-func (x *Recipe_RecipeAccess) GetState() types.AccessState {
+func (x *MatchRecipesResponse_RecipeMatch) GetAvailableIngredients() []string {
 	if x != nil {
-		return x.State
+		return x.AvailableIngredients
 	}
-	return types.AccessState(0)
+	return nil
 }
 
-func (x *Recipe_RecipeAccess) GetAcceptTarget() types.AcceptTarget {
+func (x *MatchRecipesResponse_RecipeMatch) GetMissingIngredients() []string {
 	if x != nil {
-		return x.AcceptTarget
+		return x.MissingIngredients
 	}
-	return types.AcceptTarget(0)
+	return nil
+}
+
+// recipes that are likely copies of each other
+type FindDuplicateRecipesResponse_DuplicateGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the oldest recipe of the group, the one suggested to keep
+	Recipe *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// the other recipes of the group, most similar first
+	Duplicates    []*RecipeDuplicate `protobuf:"bytes,2,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateRecipesResponse_DuplicateGroup) Reset() {
+	*x = FindDuplicateRecipesResponse_DuplicateGroup{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateRecipesResponse_DuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateRecipesResponse_DuplicateGroup) ProtoMessage() {}
+
+func (x *FindDuplicateRecipesResponse_DuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateRecipesResponse_DuplicateGroup.ProtoReflect.Descriptor instead.
+func (*FindDuplicateRecipesResponse_DuplicateGroup) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *FindDuplicateRecipesResponse_DuplicateGroup) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *FindDuplicateRecipesResponse_DuplicateGroup) GetDuplicates() []*RecipeDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
 }
 
 var File_api_meals_recipe_v1alpha1_recipe_proto protoreflect.FileDescriptor
 
 const file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc = "" +
 	"\n" +
-	"&api/meals/recipe/v1alpha1/recipe.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa7\x14\n" +
+	"&api/meals/recipe/v1alpha1/recipe.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a\x19api/types/image_set.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a6api/meals/recipe/v1alpha1/recipe_image_candidate.proto\x1a+api/meals/recipe/v1alpha1/recipe_note.proto\x1a1api/operations/operation/v1alpha1/operation.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd6&\n" +
 	"\x06Recipe\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12%\n" +
@@ -1283,13 +2672,54 @@ const file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc = "" +
 	"updateTime\x12C\n" +
 	"\rprep_duration\x18\x13 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\fprepDuration\x12E\n" +
 	"\x0etotal_duration\x18\x14 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\rtotalDuration\x12!\n" +
-	"\tfavorited\x18\x15 \x01(\bB\x03\xe0A\x03R\tfavorited\x1aA\n" +
+	"\tfavorited\x18\x15 \x01(\bB\x03\xe0A\x03R\tfavorited\x12N\n" +
+	"\tnutrition\x18\x16 \x01(\v2+.api.meals.recipe.v1alpha1.Recipe.NutritionB\x03\xe0A\x03R\tnutrition\x12I\n" +
+	"\vforked_from\x18\x17 \x01(\tB(\xe0A\x03\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\n" +
+	"forkedFrom\x12\"\n" +
+	"\n" +
+	"fork_count\x18\x18 \x01(\x03B\x03\xe0A\x03R\tforkCount\x12*\n" +
+	"\x0eaverage_rating\x18\x19 \x01(\x01B\x03\xe0A\x03R\raverageRating\x12&\n" +
+	"\frating_count\x18\x1a \x01(\x03B\x03\xe0A\x03R\vratingCount\x120\n" +
+	"\x06images\x18\x1b \x01(\v2\x13.api.types.ImageSetB\x03\xe0A\x03R\x06images\x12\x19\n" +
+	"\x05diets\x18\x1c \x03(\tB\x03\xe0A\x03R\x05diets\x12!\n" +
+	"\tallergens\x18\x1d \x03(\tB\x03\xe0A\x03R\tallergens\x12d\n" +
+	"\x11dietary_overrides\x18\x1e \x01(\v22.api.meals.recipe.v1alpha1.Recipe.DietaryOverridesB\x03\xe0A\x01R\x10dietaryOverrides\x128\n" +
+	"\x15restriction_conflicts\x18\x1f \x03(\tB\x03\xe0A\x03R\x14restrictionConflicts\x12Y\n" +
+	"\x13possible_duplicates\x18  \x03(\tB(\xe0A\x03\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x12possibleDuplicates\x12I\n" +
+	"\x10last_cooked_time\x18! \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0elastCookedTime\x12&\n" +
+	"\ftimes_cooked\x18\" \x01(\x03B\x03\xe0A\x03R\vtimesCooked\x12O\n" +
+	"\rprivate_notes\x18# \x03(\v2%.api.meals.recipe.v1alpha1.RecipeNoteB\x03\xe0A\x03R\fprivateNotes\x1a\xc2\x01\n" +
+	"\x10DietaryOverrides\x12$\n" +
+	"\vadded_diets\x18\x01 \x03(\tB\x03\xe0A\x01R\n" +
+	"addedDiets\x12(\n" +
+	"\rremoved_diets\x18\x02 \x03(\tB\x03\xe0A\x01R\fremovedDiets\x12,\n" +
+	"\x0fadded_allergens\x18\x03 \x03(\tB\x03\xe0A\x01R\x0eaddedAllergens\x120\n" +
+	"\x11removed_allergens\x18\x04 \x03(\tB\x03\xe0A\x01R\x10removedAllergens\x1a\x97\x01\n" +
 	"\tDirection\x12\x19\n" +
 	"\x05title\x18\x01 \x01(\tB\x03\xe0A\x01R\x05title\x12\x19\n" +
-	"\x05steps\x18\x02 \x03(\tB\x03\xe0A\x02R\x05steps\x1a\x81\x01\n" +
+	"\x05steps\x18\x02 \x03(\tB\x03\xe0A\x02R\x05steps\x12T\n" +
+	"\fstep_details\x18\x03 \x03(\v2,.api.meals.recipe.v1alpha1.Recipe.StepDetailB\x03\xe0A\x01R\vstepDetails\x1a\x84\x05\n" +
+	"\n" +
+	"StepDetail\x12O\n" +
+	"\x06timers\x18\x01 \x03(\v22.api.meals.recipe.v1alpha1.Recipe.StepDetail.TimerB\x03\xe0A\x01R\x06timers\x12a\n" +
+	"\ftemperatures\x18\x02 \x03(\v28.api.meals.recipe.v1alpha1.Recipe.StepDetail.TemperatureB\x03\xe0A\x01R\ftemperatures\x12g\n" +
+	"\vingredients\x18\x03 \x03(\v2@.api.meals.recipe.v1alpha1.Recipe.StepDetail.IngredientReferenceB\x03\xe0A\x01R\vingredients\x1a\\\n" +
+	"\x05Timer\x12\x17\n" +
+	"\x04text\x18\x01 \x01(\tB\x03\xe0A\x01R\x04text\x12:\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x02R\bduration\x1a\x8d\x01\n" +
+	"\vTemperature\x12\x17\n" +
+	"\x04text\x18\x01 \x01(\tB\x03\xe0A\x01R\x04text\x12\x19\n" +
+	"\x05value\x18\x02 \x01(\x01B\x03\xe0A\x01R\x05value\x12J\n" +
+	"\x04unit\x18\x03 \x01(\x0e21.api.meals.recipe.v1alpha1.Recipe.TemperatureUnitB\x03\xe0A\x01R\x04unit\x1ak\n" +
+	"\x13IngredientReference\x12$\n" +
+	"\vgroup_index\x18\x01 \x01(\x05B\x03\xe0A\x01R\n" +
+	"groupIndex\x12.\n" +
+	"\x10ingredient_index\x18\x02 \x01(\x05B\x03\xe0A\x01R\x0fingredientIndex\x1a\x81\x01\n" +
 	"\x0fIngredientGroup\x12\x19\n" +
 	"\x05title\x18\x01 \x01(\tB\x03\xe0A\x01R\x05title\x12S\n" +
-	"\vingredients\x18\x02 \x03(\v2,.api.meals.recipe.v1alpha1.Recipe.IngredientB\x03\xe0A\x02R\vingredients\x1a\xb9\x05\n" +
+	"\vingredients\x18\x02 \x03(\v2,.api.meals.recipe.v1alpha1.Recipe.IngredientB\x03\xe0A\x02R\vingredients\x1a\x87\x06\n" +
 	"\n" +
 	"Ingredient\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1f\n" +
@@ -1298,17 +2728,37 @@ const file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc = "" +
 	"\x10measurement_type\x18\x05 \x01(\x0e21.api.meals.recipe.v1alpha1.Recipe.MeasurementTypeB\x03\xe0A\x01R\x0fmeasurementType\x12\x81\x01\n" +
 	"\x17measurement_conjunction\x18\b \x01(\x0e2C.api.meals.recipe.v1alpha1.Recipe.Ingredient.MeasurementConjunctionB\x03\xe0A\x01R\x16measurementConjunction\x12?\n" +
 	"\x19second_measurement_amount\x18\x06 \x01(\x01B\x03\xe0A\x01R\x17secondMeasurementAmount\x12n\n" +
-	"\x17second_measurement_type\x18\a \x01(\x0e21.api.meals.recipe.v1alpha1.Recipe.MeasurementTypeB\x03\xe0A\x01R\x15secondMeasurementType\"\xa2\x01\n" +
+	"\x17second_measurement_type\x18\a \x01(\x0e21.api.meals.recipe.v1alpha1.Recipe.MeasurementTypeB\x03\xe0A\x01R\x15secondMeasurementType\x12L\n" +
+	"\n" +
+	"ingredient\x18\t \x01(\tB,\xe0A\x03\xfaA&\n" +
+	"$api.meals.recipe.v1alpha1/IngredientR\n" +
+	"ingredient\"\xa2\x01\n" +
 	"\x16MeasurementConjunction\x12'\n" +
 	"#MEASUREMENT_CONJUNCTION_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bMEASUREMENT_CONJUNCTION_AND\x10\x01\x12\x1e\n" +
 	"\x1aMEASUREMENT_CONJUNCTION_TO\x10\x02\x12\x1e\n" +
-	"\x1aMEASUREMENT_CONJUNCTION_OR\x10\x03\x1a\xe9\x01\n" +
+	"\x1aMEASUREMENT_CONJUNCTION_OR\x10\x03\x1a\x85\x03\n" +
+	"\tNutrition\x12\x1f\n" +
+	"\bservings\x18\x01 \x01(\x01B\x03\xe0A\x03R\bservings\x12\x1f\n" +
+	"\bcalories\x18\x02 \x01(\x01B\x03\xe0A\x03R\bcalories\x12(\n" +
+	"\rprotein_grams\x18\x03 \x01(\x01B\x03\xe0A\x03R\fproteinGrams\x12 \n" +
+	"\tfat_grams\x18\x04 \x01(\x01B\x03\xe0A\x03R\bfatGrams\x122\n" +
+	"\x12carbohydrate_grams\x18\x05 \x01(\x01B\x03\xe0A\x03R\x11carbohydrateGrams\x12$\n" +
+	"\vfiber_grams\x18\x06 \x01(\x01B\x03\xe0A\x03R\n" +
+	"fiberGrams\x12$\n" +
+	"\vsugar_grams\x18\a \x01(\x01B\x03\xe0A\x03R\n" +
+	"sugarGrams\x120\n" +
+	"\x11sodium_milligrams\x18\b \x01(\x01B\x03\xe0A\x03R\x10sodiumMilligrams\x128\n" +
+	"\x15unmatched_ingredients\x18\t \x03(\tB\x03\xe0A\x03R\x14unmatchedIngredients\x1a\xe9\x01\n" +
 	"\fRecipeAccess\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12J\n" +
 	"\x10permission_level\x18\x02 \x01(\x0e2\x1a.api.types.PermissionLevelB\x03\xe0A\x03R\x0fpermissionLevel\x121\n" +
 	"\x05state\x18\x03 \x01(\x0e2\x16.api.types.AccessStateB\x03\xe0A\x03R\x05state\x12A\n" +
-	"\raccept_target\x18\x04 \x01(\x0e2\x17.api.types.AcceptTargetB\x03\xe0A\x03R\facceptTarget\"\x9d\x02\n" +
+	"\raccept_target\x18\x04 \x01(\x0e2\x17.api.types.AcceptTargetB\x03\xe0A\x03R\facceptTarget\"r\n" +
+	"\x0fTemperatureUnit\x12 \n" +
+	"\x1cTEMPERATURE_UNIT_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTEMPERATURE_UNIT_FAHRENHEIT\x10\x01\x12\x1c\n" +
+	"\x18TEMPERATURE_UNIT_CELSIUS\x10\x02\"\x9d\x02\n" +
 	"\x0fMeasurementType\x12 \n" +
 	"\x1cMEASUREMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bMEASUREMENT_TYPE_TABLESPOON\x10\x01\x12\x1d\n" +
@@ -1324,14 +2774,15 @@ const file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc = "" +
 	"\x06recipe\x18\x01 \x01(\v2!.api.meals.recipe.v1alpha1.RecipeB\x03\xe0A\x02R\x06recipe\x12 \n" +
 	"\trecipe_id\x18\x02 \x01(\tB\x03\xe0A\x02R\brecipeId\x12@\n" +
 	"\x06parent\x18\x03 \x01(\tB(\xe0A\x01\xfaA\"\n" +
-	" api.meals.circle.v1alpha1/CircleR\x06parent\"\xb9\x01\n" +
+	" api.meals.circle.v1alpha1/CircleR\x06parent\"\xd9\x01\n" +
 	"\x12ListRecipesRequest\x12 \n" +
 	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
 	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12@\n" +
 	"\x06parent\x18\x04 \x01(\tB(\xe0A\x01\xfaA\"\n" +
-	" api.meals.circle.v1alpha1/CircleR\x06parent\"z\n" +
+	" api.meals.circle.v1alpha1/CircleR\x06parent\x12\x1e\n" +
+	"\border_by\x18\x05 \x01(\tB\x03\xe0A\x01R\aorderBy\"z\n" +
 	"\x13ListRecipesResponse\x12;\n" +
 	"\arecipes\x18\x01 \x03(\v2!.api.meals.recipe.v1alpha1.RecipeR\arecipes\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x97\x01\n" +
@@ -1344,11 +2795,34 @@ const file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc = "" +
 	" api.meals.recipe.v1alpha1/RecipeR\x04name\"P\n" +
 	"\x10GetRecipeRequest\x12<\n" +
 	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
-	" api.meals.recipe.v1alpha1/RecipeR\x04name\",\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x04name\"I\n" +
 	"\x13ScrapeRecipeRequest\x12\x15\n" +
-	"\x03uri\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uri\"Q\n" +
+	"\x03uri\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uri\x12\x1b\n" +
+	"\x06parent\x18\x02 \x01(\tB\x03\xe0A\x01R\x06parent\"\x9d\x01\n" +
 	"\x14ScrapeRecipeResponse\x129\n" +
-	"\x06recipe\x18\x01 \x01(\v2!.api.meals.recipe.v1alpha1.RecipeR\x06recipe\"U\n" +
+	"\x06recipe\x18\x01 \x01(\v2!.api.meals.recipe.v1alpha1.RecipeR\x06recipe\x12J\n" +
+	"\n" +
+	"duplicates\x18\x02 \x03(\v2*.api.meals.recipe.v1alpha1.RecipeDuplicateR\n" +
+	"duplicates\"\x8d\x01\n" +
+	"\x1fStartGenerateRecipeImageRequest\x12<\n" +
+	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x04name\x12,\n" +
+	"\x0fcandidate_count\x18\x02 \x01(\x05B\x03\xe0A\x01R\x0ecandidateCount\"\x93\x01\n" +
+	"\x1bGenerateRecipeImageResponse\x12O\n" +
+	"\n" +
+	"candidates\x18\x03 \x03(\v2/.api.meals.recipe.v1alpha1.RecipeImageCandidateR\n" +
+	"candidatesJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\timage_uriR\fcontent_type\"\x9a\x02\n" +
+	"\x0fRecipeDuplicate\x129\n" +
+	"\x06recipe\x18\x01 \x01(\v2!.api.meals.recipe.v1alpha1.RecipeR\x06recipe\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\x12K\n" +
+	"\areasons\x18\x03 \x03(\x0e21.api.meals.recipe.v1alpha1.RecipeDuplicate.ReasonR\areasons\"_\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fREASON_CITATION\x10\x01\x12\x10\n" +
+	"\fREASON_TITLE\x10\x02\x12\x16\n" +
+	"\x12REASON_INGREDIENTS\x10\x03\"U\n" +
 	"\x15FavoriteRecipeRequest\x12<\n" +
 	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
 	" api.meals.recipe.v1alpha1/RecipeR\x04name\"\x18\n" +
@@ -1356,24 +2830,75 @@ const file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc = "" +
 	"\x17UnfavoriteRecipeRequest\x12<\n" +
 	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
 	" api.meals.recipe.v1alpha1/RecipeR\x04name\"\x1a\n" +
-	"\x18UnfavoriteRecipeResponse2\xfd\x12\n" +
+	"\x18UnfavoriteRecipeResponse\"\xec\x01\n" +
+	"\x13MatchRecipesRequest\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x01R\x06parent\x12%\n" +
+	"\vingredients\x18\x02 \x03(\tB\x03\xe0A\x01R\vingredients\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12.\n" +
+	"\x10minimum_coverage\x18\x04 \x01(\x01B\x03\xe0A\x01R\x0fminimumCoverage\x12 \n" +
+	"\tpage_size\x18\x05 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tB\x03\xe0A\x01R\tpageToken\"\xef\x02\n" +
+	"\x14MatchRecipesResponse\x12b\n" +
+	"\x0erecipe_matches\x18\x01 \x03(\v2;.api.meals.recipe.v1alpha1.MatchRecipesResponse.RecipeMatchR\rrecipeMatches\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x1a\xca\x01\n" +
+	"\vRecipeMatch\x129\n" +
+	"\x06recipe\x18\x01 \x01(\v2!.api.meals.recipe.v1alpha1.RecipeR\x06recipe\x12\x1a\n" +
+	"\bcoverage\x18\x02 \x01(\x01R\bcoverage\x123\n" +
+	"\x15available_ingredients\x18\x03 \x03(\tR\x14availableIngredients\x12/\n" +
+	"\x13missing_ingredients\x18\x04 \x03(\tR\x12missingIngredients\"n\n" +
+	"\x11ForkRecipeRequest\x12<\n" +
+	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x04name\x12\x1b\n" +
+	"\x06parent\x18\x02 \x01(\tB\x03\xe0A\x01R\x06parent\"\x80\x01\n" +
+	"\x1bFindDuplicateRecipesRequest\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\xd3\x02\n" +
+	"\x1cFindDuplicateRecipesResponse\x12q\n" +
+	"\x10duplicate_groups\x18\x01 \x03(\v2F.api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse.DuplicateGroupR\x0fduplicateGroups\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x1a\x97\x01\n" +
+	"\x0eDuplicateGroup\x129\n" +
+	"\x06recipe\x18\x01 \x01(\v2!.api.meals.recipe.v1alpha1.RecipeR\x06recipe\x12J\n" +
+	"\n" +
+	"duplicates\x18\x02 \x03(\v2*.api.meals.recipe.v1alpha1.RecipeDuplicateR\n" +
+	"duplicates\"\x97\x01\n" +
+	"\x13MergeRecipesRequest\x12<\n" +
+	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x04name\x12B\n" +
+	"\arecipes\x18\x02 \x03(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\arecipes2\xb9(\n" +
 	"\rRecipeService\x12\xe4\x02\n" +
 	"\fCreateRecipe\x12..api.meals.recipe.v1alpha1.CreateRecipeRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"\x80\x02\x92AQ\n" +
-	"\rRecipeService\x12\x0fCreate a recipe\x1a/Creates a new recipe with the provided details.\xdaA\x17parent,recipe,recipe_id\x82\xd3\xe4\x93\x02\x8b\x01:\x06recipeZ4:\x06recipe\"*/meals/v1alpha1/{parent=circles/*}/recipesZ2:\x06recipe\"(/meals/v1alpha1/{parent=users/*}/recipes\"\x17/meals/v1alpha1/recipes\x12\xdc\x02\n" +
-	"\vListRecipes\x12-.api.meals.recipe.v1alpha1.ListRecipesRequest\x1a..api.meals.recipe.v1alpha1.ListRecipesResponse\"\xed\x01\x92Ah\n" +
-	"\rRecipeService\x12\fList recipes\x1aIRetrieves a paginated list of recipes. Supports filtering and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02sZ,\x12*/meals/v1alpha1/{parent=circles/*}/recipesZ*\x12(/meals/v1alpha1/{parent=users/*}/recipes\x12\x17/meals/v1alpha1/recipes\x12\xff\x01\n" +
+	"\rRecipeService\x12\x0fCreate a recipe\x1a/Creates a new recipe with the provided details.\xdaA\x17parent,recipe,recipe_id\x82\xd3\xe4\x93\x02\x8b\x01:\x06recipeZ4:\x06recipe\"*/meals/v1alpha1/{parent=circles/*}/recipesZ2:\x06recipe\"(/meals/v1alpha1/{parent=users/*}/recipes\"\x17/meals/v1alpha1/recipes\x12\x81\x03\n" +
+	"\vListRecipes\x12-.api.meals.recipe.v1alpha1.ListRecipesRequest\x1a..api.meals.recipe.v1alpha1.ListRecipesResponse\"\x92\x02\x92A\x8c\x01\n" +
+	"\rRecipeService\x12\fList recipes\x1amRetrieves a paginated list of recipes. Supports filtering, ordering by rating or cook history and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02sZ,\x12*/meals/v1alpha1/{parent=circles/*}/recipesZ*\x12(/meals/v1alpha1/{parent=users/*}/recipes\x12\x17/meals/v1alpha1/recipes\x12\xff\x01\n" +
 	"\fUpdateRecipe\x12..api.meals.recipe.v1alpha1.UpdateRecipeRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"\x9b\x01\x92AL\n" +
 	"\rRecipeService\x12\x0fUpdate a recipe\x1a*Updates the details of an existing recipe.\xdaA\x12recipe,update_mask\x82\xd3\xe4\x93\x021:\x06recipe2'/meals/v1alpha1/{recipe.name=recipes/*}\x12\xd9\x01\n" +
 	"\fDeleteRecipe\x12..api.meals.recipe.v1alpha1.DeleteRecipeRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"v\x92AD\n" +
-	"\rRecipeService\x12\x0fDelete a recipe\x1a\"Deletes a recipe by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\"* /meals/v1alpha1/{name=recipes/*}\x12\xd9\x01\n" +
-	"\tGetRecipe\x12+.api.meals.recipe.v1alpha1.GetRecipeRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"|\x92AJ\n" +
-	"\rRecipeService\x12\fGet a recipe\x1a+Retrieves a single recipe by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /meals/v1alpha1/{name=recipes/*}\x12\xf3\x01\n" +
+	"\rRecipeService\x12\x0fDelete a recipe\x1a\"Deletes a recipe by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\"* /meals/v1alpha1/{name=recipes/*}\x12\x85\x02\n" +
+	"\tGetRecipe\x12+.api.meals.recipe.v1alpha1.GetRecipeRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"\xa7\x01\x92Au\n" +
+	"\rRecipeService\x12\fGet a recipe\x1aVRetrieves a single recipe by resource name, including the private notes of the caller.\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /meals/v1alpha1/{name=recipes/*}\x12\xf3\x01\n" +
 	"\fScrapeRecipe\x12..api.meals.recipe.v1alpha1.ScrapeRecipeRequest\x1a/.api.meals.recipe.v1alpha1.ScrapeRecipeResponse\"\x81\x01\x92AI\n" +
-	"\rRecipeService\x12\x1aScrape a recipe from a uri\x1a\x1cScrapes a recipe from a uri.\xdaA\x03uri\x82\xd3\xe4\x93\x02):\x01*\"$/meals/v1alpha1/recipes:scrapeRecipe\x12\xf1\x02\n" +
+	"\rRecipeService\x12\x1aScrape a recipe from a uri\x1a\x1cScrapes a recipe from a uri.\xdaA\x03uri\x82\xd3\xe4\x93\x02):\x01*\"$/meals/v1alpha1/recipes:scrapeRecipe\x12\xd8\x02\n" +
+	"\x11StartScrapeRecipe\x12..api.meals.recipe.v1alpha1.ScrapeRecipeRequest\x1a,.api.operations.operation.v1alpha1.Operation\"\xe4\x01\x92A\xa6\x01\n" +
+	"\rRecipeService\x12\"Start scraping a recipe from a uri\x1aqScrapes a recipe from a uri in the background. The returned operation has a ScrapeRecipeResponse once it is done.\xdaA\x03uri\x82\xd3\xe4\x93\x02.:\x01*\")/meals/v1alpha1/recipes:startScrapeRecipe\x12\xe9\x04\n" +
+	"\x18StartGenerateRecipeImage\x12:.api.meals.recipe.v1alpha1.StartGenerateRecipeImageRequest\x1a,.api.operations.operation.v1alpha1.Operation\"\xe2\x03\x92A\xd3\x02\n" +
+	"\rRecipeService\x12&Start generating an image for a recipe\x1a\x99\x02Generates candidate images for a recipe in the background. The returned operation has a GenerateRecipeImageResponse once it is done. The candidates are kept with the recipe until one is selected as its cover. Every candidate counts against the daily generation quota of the caller.\xdaA\x04name\x82\xd3\xe4\x93\x02~:\x01*ZC:\x01*\">/meals/v1alpha1/{name=circles/*/recipes/*}/image:startGenerate\"4/meals/v1alpha1/{name=recipes/*}/image:startGenerate\x12\xf1\x02\n" +
 	"\x0eFavoriteRecipe\x120.api.meals.recipe.v1alpha1.FavoriteRecipeRequest\x1a1.api.meals.recipe.v1alpha1.FavoriteRecipeResponse\"\xf9\x01\x92AH\n" +
 	"\rRecipeService\x12\x11Favorite a recipe\x1a$Favorites a recipe by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\xa0\x01:\x01*Z8:\x01*\"3/meals/v1alpha1/{name=circles/*/recipes/*}:favoriteZ6:\x01*\"1/meals/v1alpha1/{name=users/*/recipes/*}:favorite\")/meals/v1alpha1/{name=recipes/*}:favorite\x12\x81\x03\n" +
 	"\x10UnfavoriteRecipe\x122.api.meals.recipe.v1alpha1.UnfavoriteRecipeRequest\x1a3.api.meals.recipe.v1alpha1.UnfavoriteRecipeResponse\"\x83\x02\x92AL\n" +
-	"\rRecipeService\x12\x13Unfavorite a recipe\x1a&Unfavorites a recipe by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\xa6\x01:\x01*Z::\x01*\"5/meals/v1alpha1/{name=circles/*/recipes/*}:unfavoriteZ8:\x01*\"3/meals/v1alpha1/{name=users/*/recipes/*}:unfavorite\"+/meals/v1alpha1/{name=recipes/*}:unfavoriteB\xe0\x02\x92AXZD\n" +
+	"\rRecipeService\x12\x13Unfavorite a recipe\x1a&Unfavorites a recipe by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02\xa6\x01:\x01*Z::\x01*\"5/meals/v1alpha1/{name=circles/*/recipes/*}:unfavoriteZ8:\x01*\"3/meals/v1alpha1/{name=users/*/recipes/*}:unfavorite\"+/meals/v1alpha1/{name=recipes/*}:unfavorite\x12\xb8\x03\n" +
+	"\fMatchRecipes\x12..api.meals.recipe.v1alpha1.MatchRecipesRequest\x1a/.api.meals.recipe.v1alpha1.MatchRecipesResponse\"\xc6\x02\x92A\x98\x01\n" +
+	"\rRecipeService\x12\rMatch recipes\x1axRanks the accessible recipes by the percentage of their required ingredients that are on hand and lists what is missing.\xdaA\x12parent,ingredients\x82\xd3\xe4\x93\x02\x8e\x01:\x01*Z5:\x01*\"0/meals/v1alpha1/{parent=circles/*}/recipes:matchZ3:\x01*\"./meals/v1alpha1/{parent=users/*}/recipes:match\"\x1d/meals/v1alpha1/recipes:match\x12\x8b\x03\n" +
+	"\n" +
+	"ForkRecipe\x12,.api.meals.recipe.v1alpha1.ForkRecipeRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"\xab\x02\x92A\x7f\n" +
+	"\rRecipeService\x12\rFork a recipe\x1a_Copies a readable recipe into a user or circle collection and records where it was forked from.\xdaA\vname,parent\x82\xd3\xe4\x93\x02\x94\x01:\x01*Z4:\x01*\"//meals/v1alpha1/{name=circles/*/recipes/*}:forkZ2:\x01*\"-/meals/v1alpha1/{name=users/*/recipes/*}:fork\"%/meals/v1alpha1/{name=recipes/*}:fork\x12\xa2\x03\n" +
+	"\x14FindDuplicateRecipes\x126.api.meals.recipe.v1alpha1.FindDuplicateRecipesRequest\x1a7.api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse\"\x98\x02\x92A\x8f\x01\n" +
+	"\rRecipeService\x12\x16Find duplicate recipes\x1afGroups the recipes of a user or circle that cite the same url, or have similar titles and ingredients.\xdaA\x06parent\x82\xd3\xe4\x93\x02vZ9\x127/meals/v1alpha1/{parent=users/*}/recipes:findDuplicates\x129/meals/v1alpha1/{parent=circles/*}/recipes:findDuplicates\x12\xb3\x03\n" +
+	"\fMergeRecipes\x12..api.meals.recipe.v1alpha1.MergeRecipesRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"\xcf\x02\x92A\x9e\x01\n" +
+	"\rRecipeService\x12\rMerge recipes\x1a~Keeps a recipe and moves the favorites, accesses, event links and cook logs of the other recipes onto it before deleting them.\xdaA\fname,recipes\x82\xd3\xe4\x93\x02\x97\x01:\x01*Z5:\x01*\"0/meals/v1alpha1/{name=circles/*/recipes/*}:mergeZ3:\x01*\"./meals/v1alpha1/{name=users/*/recipes/*}:merge\"&/meals/v1alpha1/{name=recipes/*}:mergeB\xe0\x02\x92AXZD\n" +
 	"B\n" +
 	"\n" +
 	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
@@ -1394,79 +2919,134 @@ func file_api_meals_recipe_v1alpha1_recipe_proto_rawDescGZIP() []byte {
 	return file_api_meals_recipe_v1alpha1_recipe_proto_rawDescData
 }
 
-var file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_meals_recipe_v1alpha1_recipe_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_meals_recipe_v1alpha1_recipe_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_api_meals_recipe_v1alpha1_recipe_proto_goTypes = []any{
-	(Recipe_MeasurementType)(0),                   // 0: api.meals.recipe.v1alpha1.Recipe.MeasurementType
-	(Recipe_Ingredient_MeasurementConjunction)(0), // 1: api.meals.recipe.v1alpha1.Recipe.Ingredient.MeasurementConjunction
-	(*Recipe)(nil),                                // 2: api.meals.recipe.v1alpha1.Recipe
-	(*CreateRecipeRequest)(nil),                   // 3: api.meals.recipe.v1alpha1.CreateRecipeRequest
-	(*ListRecipesRequest)(nil),                    // 4: api.meals.recipe.v1alpha1.ListRecipesRequest
-	(*ListRecipesResponse)(nil),                   // 5: api.meals.recipe.v1alpha1.ListRecipesResponse
-	(*UpdateRecipeRequest)(nil),                   // 6: api.meals.recipe.v1alpha1.UpdateRecipeRequest
-	(*DeleteRecipeRequest)(nil),                   // 7: api.meals.recipe.v1alpha1.DeleteRecipeRequest
-	(*GetRecipeRequest)(nil),                      // 8: api.meals.recipe.v1alpha1.GetRecipeRequest
-	(*ScrapeRecipeRequest)(nil),                   // 9: api.meals.recipe.v1alpha1.ScrapeRecipeRequest
-	(*ScrapeRecipeResponse)(nil),                  // 10: api.meals.recipe.v1alpha1.ScrapeRecipeResponse
-	(*FavoriteRecipeRequest)(nil),                 // 11: api.meals.recipe.v1alpha1.FavoriteRecipeRequest
-	(*FavoriteRecipeResponse)(nil),                // 12: api.meals.recipe.v1alpha1.FavoriteRecipeResponse
-	(*UnfavoriteRecipeRequest)(nil),               // 13: api.meals.recipe.v1alpha1.UnfavoriteRecipeRequest
-	(*UnfavoriteRecipeResponse)(nil),              // 14: api.meals.recipe.v1alpha1.UnfavoriteRecipeResponse
-	(*Recipe_Direction)(nil),                      // 15: api.meals.recipe.v1alpha1.Recipe.Direction
-	(*Recipe_IngredientGroup)(nil),                // 16: api.meals.recipe.v1alpha1.Recipe.IngredientGroup
-	(*Recipe_Ingredient)(nil),                     // 17: api.meals.recipe.v1alpha1.Recipe.Ingredient
-	(*Recipe_RecipeAccess)(nil),                   // 18: api.meals.recipe.v1alpha1.Recipe.RecipeAccess
-	(types.VisibilityLevel)(0),                    // 19: api.types.VisibilityLevel
-	(*durationpb.Duration)(nil),                   // 20: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),                 // 21: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),                 // 22: google.protobuf.FieldMask
-	(types.PermissionLevel)(0),                    // 23: api.types.PermissionLevel
-	(types.AccessState)(0),                        // 24: api.types.AccessState
-	(types.AcceptTarget)(0),                       // 25: api.types.AcceptTarget
+	(Recipe_TemperatureUnit)(0),                         // 0: api.meals.recipe.v1alpha1.Recipe.TemperatureUnit
+	(Recipe_MeasurementType)(0),                         // 1: api.meals.recipe.v1alpha1.Recipe.MeasurementType
+	(Recipe_Ingredient_MeasurementConjunction)(0),       // 2: api.meals.recipe.v1alpha1.Recipe.Ingredient.MeasurementConjunction
+	(RecipeDuplicate_Reason)(0),                         // 3: api.meals.recipe.v1alpha1.RecipeDuplicate.Reason
+	(*Recipe)(nil),                                      // 4: api.meals.recipe.v1alpha1.Recipe
+	(*CreateRecipeRequest)(nil),                         // 5: api.meals.recipe.v1alpha1.CreateRecipeRequest
+	(*ListRecipesRequest)(nil),                          // 6: api.meals.recipe.v1alpha1.ListRecipesRequest
+	(*ListRecipesResponse)(nil),                         // 7: api.meals.recipe.v1alpha1.ListRecipesResponse
+	(*UpdateRecipeRequest)(nil),                         // 8: api.meals.recipe.v1alpha1.UpdateRecipeRequest
+	(*DeleteRecipeRequest)(nil),                         // 9: api.meals.recipe.v1alpha1.DeleteRecipeRequest
+	(*GetRecipeRequest)(nil),                            // 10: api.meals.recipe.v1alpha1.GetRecipeRequest
+	(*ScrapeRecipeRequest)(nil),                         // 11: api.meals.recipe.v1alpha1.ScrapeRecipeRequest
+	(*ScrapeRecipeResponse)(nil),                        // 12: api.meals.recipe.v1alpha1.ScrapeRecipeResponse
+	(*StartGenerateRecipeImageRequest)(nil),             // 13: api.meals.recipe.v1alpha1.StartGenerateRecipeImageRequest
+	(*GenerateRecipeImageResponse)(nil),                 // 14: api.meals.recipe.v1alpha1.GenerateRecipeImageResponse
+	(*RecipeDuplicate)(nil),                             // 15: api.meals.recipe.v1alpha1.RecipeDuplicate
+	(*FavoriteRecipeRequest)(nil),                       // 16: api.meals.recipe.v1alpha1.FavoriteRecipeRequest
+	(*FavoriteRecipeResponse)(nil),                      // 17: api.meals.recipe.v1alpha1.FavoriteRecipeResponse
+	(*UnfavoriteRecipeRequest)(nil),                     // 18: api.meals.recipe.v1alpha1.UnfavoriteRecipeRequest
+	(*UnfavoriteRecipeResponse)(nil),                    // 19: api.meals.recipe.v1alpha1.UnfavoriteRecipeResponse
+	(*MatchRecipesRequest)(nil),                         // 20: api.meals.recipe.v1alpha1.MatchRecipesRequest
+	(*MatchRecipesResponse)(nil),                        // 21: api.meals.recipe.v1alpha1.MatchRecipesResponse
+	(*ForkRecipeRequest)(nil),                           // 22: api.meals.recipe.v1alpha1.ForkRecipeRequest
+	(*FindDuplicateRecipesRequest)(nil),                 // 23: api.meals.recipe.v1alpha1.FindDuplicateRecipesRequest
+	(*FindDuplicateRecipesResponse)(nil),                // 24: api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse
+	(*MergeRecipesRequest)(nil),                         // 25: api.meals.recipe.v1alpha1.MergeRecipesRequest
+	(*Recipe_DietaryOverrides)(nil),                     // 26: api.meals.recipe.v1alpha1.Recipe.DietaryOverrides
+	(*Recipe_Direction)(nil),                            // 27: api.meals.recipe.v1alpha1.Recipe.Direction
+	(*Recipe_StepDetail)(nil),                           // 28: api.meals.recipe.v1alpha1.Recipe.StepDetail
+	(*Recipe_IngredientGroup)(nil),                      // 29: api.meals.recipe.v1alpha1.Recipe.IngredientGroup
+	(*Recipe_Ingredient)(nil),                           // 30: api.meals.recipe.v1alpha1.Recipe.Ingredient
+	(*Recipe_Nutrition)(nil),                            // 31: api.meals.recipe.v1alpha1.Recipe.Nutrition
+	(*Recipe_RecipeAccess)(nil),                         // 32: api.meals.recipe.v1alpha1.Recipe.RecipeAccess
+	(*Recipe_StepDetail_Timer)(nil),                     // 33: api.meals.recipe.v1alpha1.Recipe.StepDetail.Timer
+	(*Recipe_StepDetail_Temperature)(nil),               // 34: api.meals.recipe.v1alpha1.Recipe.StepDetail.Temperature
+	(*Recipe_StepDetail_IngredientReference)(nil),       // 35: api.meals.recipe.v1alpha1.Recipe.StepDetail.IngredientReference
+	(*MatchRecipesResponse_RecipeMatch)(nil),            // 36: api.meals.recipe.v1alpha1.MatchRecipesResponse.RecipeMatch
+	(*FindDuplicateRecipesResponse_DuplicateGroup)(nil), // 37: api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse.DuplicateGroup
+	(types.VisibilityLevel)(0),                          // 38: api.types.VisibilityLevel
+	(*durationpb.Duration)(nil),                         // 39: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                       // 40: google.protobuf.Timestamp
+	(*types.ImageSet)(nil),                              // 41: api.types.ImageSet
+	(*RecipeNote)(nil),                                  // 42: api.meals.recipe.v1alpha1.RecipeNote
+	(*fieldmaskpb.FieldMask)(nil),                       // 43: google.protobuf.FieldMask
+	(*RecipeImageCandidate)(nil),                        // 44: api.meals.recipe.v1alpha1.RecipeImageCandidate
+	(types.PermissionLevel)(0),                          // 45: api.types.PermissionLevel
+	(types.AccessState)(0),                              // 46: api.types.AccessState
+	(types.AcceptTarget)(0),                             // 47: api.types.AcceptTarget
+	(*v1alpha1.Operation)(nil),                          // 48: api.operations.operation.v1alpha1.Operation
 }
 var file_api_meals_recipe_v1alpha1_recipe_proto_depIdxs = []int32{
-	15, // 0: api.meals.recipe.v1alpha1.Recipe.directions:type_name -> api.meals.recipe.v1alpha1.Recipe.Direction
-	16, // 1: api.meals.recipe.v1alpha1.Recipe.ingredient_groups:type_name -> api.meals.recipe.v1alpha1.Recipe.IngredientGroup
-	19, // 2: api.meals.recipe.v1alpha1.Recipe.visibility:type_name -> api.types.VisibilityLevel
-	18, // 3: api.meals.recipe.v1alpha1.Recipe.recipe_access:type_name -> api.meals.recipe.v1alpha1.Recipe.RecipeAccess
-	20, // 4: api.meals.recipe.v1alpha1.Recipe.cook_duration:type_name -> google.protobuf.Duration
-	21, // 5: api.meals.recipe.v1alpha1.Recipe.create_time:type_name -> google.protobuf.Timestamp
-	21, // 6: api.meals.recipe.v1alpha1.Recipe.update_time:type_name -> google.protobuf.Timestamp
-	20, // 7: api.meals.recipe.v1alpha1.Recipe.prep_duration:type_name -> google.protobuf.Duration
-	20, // 8: api.meals.recipe.v1alpha1.Recipe.total_duration:type_name -> google.protobuf.Duration
-	2,  // 9: api.meals.recipe.v1alpha1.CreateRecipeRequest.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
-	2,  // 10: api.meals.recipe.v1alpha1.ListRecipesResponse.recipes:type_name -> api.meals.recipe.v1alpha1.Recipe
-	2,  // 11: api.meals.recipe.v1alpha1.UpdateRecipeRequest.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
-	22, // 12: api.meals.recipe.v1alpha1.UpdateRecipeRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 13: api.meals.recipe.v1alpha1.ScrapeRecipeResponse.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
-	17, // 14: api.meals.recipe.v1alpha1.Recipe.IngredientGroup.ingredients:type_name -> api.meals.recipe.v1alpha1.Recipe.Ingredient
-	0,  // 15: api.meals.recipe.v1alpha1.Recipe.Ingredient.measurement_type:type_name -> api.meals.recipe.v1alpha1.Recipe.MeasurementType
-	1,  // 16: api.meals.recipe.v1alpha1.Recipe.Ingredient.measurement_conjunction:type_name -> api.meals.recipe.v1alpha1.Recipe.Ingredient.MeasurementConjunction
-	0,  // 17: api.meals.recipe.v1alpha1.Recipe.Ingredient.second_measurement_type:type_name -> api.meals.recipe.v1alpha1.Recipe.MeasurementType
-	23, // 18: api.meals.recipe.v1alpha1.Recipe.RecipeAccess.permission_level:type_name -> api.types.PermissionLevel
-	24, // 19: api.meals.recipe.v1alpha1.Recipe.RecipeAccess.state:type_name -> api.types.AccessState
-	25, // 20: api.meals.recipe.v1alpha1.Recipe.RecipeAccess.accept_target:type_name -> api.types.AcceptTarget
-	3,  // 21: api.meals.recipe.v1alpha1.RecipeService.CreateRecipe:input_type -> api.meals.recipe.v1alpha1.CreateRecipeRequest
-	4,  // 22: api.meals.recipe.v1alpha1.RecipeService.ListRecipes:input_type -> api.meals.recipe.v1alpha1.ListRecipesRequest
-	6,  // 23: api.meals.recipe.v1alpha1.RecipeService.UpdateRecipe:input_type -> api.meals.recipe.v1alpha1.UpdateRecipeRequest
-	7,  // 24: api.meals.recipe.v1alpha1.RecipeService.DeleteRecipe:input_type -> api.meals.recipe.v1alpha1.DeleteRecipeRequest
-	8,  // 25: api.meals.recipe.v1alpha1.RecipeService.GetRecipe:input_type -> api.meals.recipe.v1alpha1.GetRecipeRequest
-	9,  // 26: api.meals.recipe.v1alpha1.RecipeService.ScrapeRecipe:input_type -> api.meals.recipe.v1alpha1.ScrapeRecipeRequest
-	11, // 27: api.meals.recipe.v1alpha1.RecipeService.FavoriteRecipe:input_type -> api.meals.recipe.v1alpha1.FavoriteRecipeRequest
-	13, // 28: api.meals.recipe.v1alpha1.RecipeService.UnfavoriteRecipe:input_type -> api.meals.recipe.v1alpha1.UnfavoriteRecipeRequest
-	2,  // 29: api.meals.recipe.v1alpha1.RecipeService.CreateRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
-	5,  // 30: api.meals.recipe.v1alpha1.RecipeService.ListRecipes:output_type -> api.meals.recipe.v1alpha1.ListRecipesResponse
-	2,  // 31: api.meals.recipe.v1alpha1.RecipeService.UpdateRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
-	2,  // 32: api.meals.recipe.v1alpha1.RecipeService.DeleteRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
-	2,  // 33: api.meals.recipe.v1alpha1.RecipeService.GetRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
-	10, // 34: api.meals.recipe.v1alpha1.RecipeService.ScrapeRecipe:output_type -> api.meals.recipe.v1alpha1.ScrapeRecipeResponse
-	12, // 35: api.meals.recipe.v1alpha1.RecipeService.FavoriteRecipe:output_type -> api.meals.recipe.v1alpha1.FavoriteRecipeResponse
-	14, // 36: api.meals.recipe.v1alpha1.RecipeService.UnfavoriteRecipe:output_type -> api.meals.recipe.v1alpha1.UnfavoriteRecipeResponse
-	29, // [29:37] is the sub-list for method output_type
-	21, // [21:29] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	27, // 0: api.meals.recipe.v1alpha1.Recipe.directions:type_name -> api.meals.recipe.v1alpha1.Recipe.Direction
+	29, // 1: api.meals.recipe.v1alpha1.Recipe.ingredient_groups:type_name -> api.meals.recipe.v1alpha1.Recipe.IngredientGroup
+	38, // 2: api.meals.recipe.v1alpha1.Recipe.visibility:type_name -> api.types.VisibilityLevel
+	32, // 3: api.meals.recipe.v1alpha1.Recipe.recipe_access:type_name -> api.meals.recipe.v1alpha1.Recipe.RecipeAccess
+	39, // 4: api.meals.recipe.v1alpha1.Recipe.cook_duration:type_name -> google.protobuf.Duration
+	40, // 5: api.meals.recipe.v1alpha1.Recipe.create_time:type_name -> google.protobuf.Timestamp
+	40, // 6: api.meals.recipe.v1alpha1.Recipe.update_time:type_name -> google.protobuf.Timestamp
+	39, // 7: api.meals.recipe.v1alpha1.Recipe.prep_duration:type_name -> google.protobuf.Duration
+	39, // 8: api.meals.recipe.v1alpha1.Recipe.total_duration:type_name -> google.protobuf.Duration
+	31, // 9: api.meals.recipe.v1alpha1.Recipe.nutrition:type_name -> api.meals.recipe.v1alpha1.Recipe.Nutrition
+	41, // 10: api.meals.recipe.v1alpha1.Recipe.images:type_name -> api.types.ImageSet
+	26, // 11: api.meals.recipe.v1alpha1.Recipe.dietary_overrides:type_name -> api.meals.recipe.v1alpha1.Recipe.DietaryOverrides
+	40, // 12: api.meals.recipe.v1alpha1.Recipe.last_cooked_time:type_name -> google.protobuf.Timestamp
+	42, // 13: api.meals.recipe.v1alpha1.Recipe.private_notes:type_name -> api.meals.recipe.v1alpha1.RecipeNote
+	4,  // 14: api.meals.recipe.v1alpha1.CreateRecipeRequest.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
+	4,  // 15: api.meals.recipe.v1alpha1.ListRecipesResponse.recipes:type_name -> api.meals.recipe.v1alpha1.Recipe
+	4,  // 16: api.meals.recipe.v1alpha1.UpdateRecipeRequest.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
+	43, // 17: api.meals.recipe.v1alpha1.UpdateRecipeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 18: api.meals.recipe.v1alpha1.ScrapeRecipeResponse.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
+	15, // 19: api.meals.recipe.v1alpha1.ScrapeRecipeResponse.duplicates:type_name -> api.meals.recipe.v1alpha1.RecipeDuplicate
+	44, // 20: api.meals.recipe.v1alpha1.GenerateRecipeImageResponse.candidates:type_name -> api.meals.recipe.v1alpha1.RecipeImageCandidate
+	4,  // 21: api.meals.recipe.v1alpha1.RecipeDuplicate.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
+	3,  // 22: api.meals.recipe.v1alpha1.RecipeDuplicate.reasons:type_name -> api.meals.recipe.v1alpha1.RecipeDuplicate.Reason
+	36, // 23: api.meals.recipe.v1alpha1.MatchRecipesResponse.recipe_matches:type_name -> api.meals.recipe.v1alpha1.MatchRecipesResponse.RecipeMatch
+	37, // 24: api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse.duplicate_groups:type_name -> api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse.DuplicateGroup
+	28, // 25: api.meals.recipe.v1alpha1.Recipe.Direction.step_details:type_name -> api.meals.recipe.v1alpha1.Recipe.StepDetail
+	33, // 26: api.meals.recipe.v1alpha1.Recipe.StepDetail.timers:type_name -> api.meals.recipe.v1alpha1.Recipe.StepDetail.Timer
+	34, // 27: api.meals.recipe.v1alpha1.Recipe.StepDetail.temperatures:type_name -> api.meals.recipe.v1alpha1.Recipe.StepDetail.Temperature
+	35, // 28: api.meals.recipe.v1alpha1.Recipe.StepDetail.ingredients:type_name -> api.meals.recipe.v1alpha1.Recipe.StepDetail.IngredientReference
+	30, // 29: api.meals.recipe.v1alpha1.Recipe.IngredientGroup.ingredients:type_name -> api.meals.recipe.v1alpha1.Recipe.Ingredient
+	1,  // 30: api.meals.recipe.v1alpha1.Recipe.Ingredient.measurement_type:type_name -> api.meals.recipe.v1alpha1.Recipe.MeasurementType
+	2,  // 31: api.meals.recipe.v1alpha1.Recipe.Ingredient.measurement_conjunction:type_name -> api.meals.recipe.v1alpha1.Recipe.Ingredient.MeasurementConjunction
+	1,  // 32: api.meals.recipe.v1alpha1.Recipe.Ingredient.second_measurement_type:type_name -> api.meals.recipe.v1alpha1.Recipe.MeasurementType
+	45, // 33: api.meals.recipe.v1alpha1.Recipe.RecipeAccess.permission_level:type_name -> api.types.PermissionLevel
+	46, // 34: api.meals.recipe.v1alpha1.Recipe.RecipeAccess.state:type_name -> api.types.AccessState
+	47, // 35: api.meals.recipe.v1alpha1.Recipe.RecipeAccess.accept_target:type_name -> api.types.AcceptTarget
+	39, // 36: api.meals.recipe.v1alpha1.Recipe.StepDetail.Timer.duration:type_name -> google.protobuf.Duration
+	0,  // 37: api.meals.recipe.v1alpha1.Recipe.StepDetail.Temperature.unit:type_name -> api.meals.recipe.v1alpha1.Recipe.TemperatureUnit
+	4,  // 38: api.meals.recipe.v1alpha1.MatchRecipesResponse.RecipeMatch.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
+	4,  // 39: api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse.DuplicateGroup.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
+	15, // 40: api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse.DuplicateGroup.duplicates:type_name -> api.meals.recipe.v1alpha1.RecipeDuplicate
+	5,  // 41: api.meals.recipe.v1alpha1.RecipeService.CreateRecipe:input_type -> api.meals.recipe.v1alpha1.CreateRecipeRequest
+	6,  // 42: api.meals.recipe.v1alpha1.RecipeService.ListRecipes:input_type -> api.meals.recipe.v1alpha1.ListRecipesRequest
+	8,  // 43: api.meals.recipe.v1alpha1.RecipeService.UpdateRecipe:input_type -> api.meals.recipe.v1alpha1.UpdateRecipeRequest
+	9,  // 44: api.meals.recipe.v1alpha1.RecipeService.DeleteRecipe:input_type -> api.meals.recipe.v1alpha1.DeleteRecipeRequest
+	10, // 45: api.meals.recipe.v1alpha1.RecipeService.GetRecipe:input_type -> api.meals.recipe.v1alpha1.GetRecipeRequest
+	11, // 46: api.meals.recipe.v1alpha1.RecipeService.ScrapeRecipe:input_type -> api.meals.recipe.v1alpha1.ScrapeRecipeRequest
+	11, // 47: api.meals.recipe.v1alpha1.RecipeService.StartScrapeRecipe:input_type -> api.meals.recipe.v1alpha1.ScrapeRecipeRequest
+	13, // 48: api.meals.recipe.v1alpha1.RecipeService.StartGenerateRecipeImage:input_type -> api.meals.recipe.v1alpha1.StartGenerateRecipeImageRequest
+	16, // 49: api.meals.recipe.v1alpha1.RecipeService.FavoriteRecipe:input_type -> api.meals.recipe.v1alpha1.FavoriteRecipeRequest
+	18, // 50: api.meals.recipe.v1alpha1.RecipeService.UnfavoriteRecipe:input_type -> api.meals.recipe.v1alpha1.UnfavoriteRecipeRequest
+	20, // 51: api.meals.recipe.v1alpha1.RecipeService.MatchRecipes:input_type -> api.meals.recipe.v1alpha1.MatchRecipesRequest
+	22, // 52: api.meals.recipe.v1alpha1.RecipeService.ForkRecipe:input_type -> api.meals.recipe.v1alpha1.ForkRecipeRequest
+	23, // 53: api.meals.recipe.v1alpha1.RecipeService.FindDuplicateRecipes:input_type -> api.meals.recipe.v1alpha1.FindDuplicateRecipesRequest
+	25, // 54: api.meals.recipe.v1alpha1.RecipeService.MergeRecipes:input_type -> api.meals.recipe.v1alpha1.MergeRecipesRequest
+	4,  // 55: api.meals.recipe.v1alpha1.RecipeService.CreateRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
+	7,  // 56: api.meals.recipe.v1alpha1.RecipeService.ListRecipes:output_type -> api.meals.recipe.v1alpha1.ListRecipesResponse
+	4,  // 57: api.meals.recipe.v1alpha1.RecipeService.UpdateRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
+	4,  // 58: api.meals.recipe.v1alpha1.RecipeService.DeleteRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
+	4,  // 59: api.meals.recipe.v1alpha1.RecipeService.GetRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
+	12, // 60: api.meals.recipe.v1alpha1.RecipeService.ScrapeRecipe:output_type -> api.meals.recipe.v1alpha1.ScrapeRecipeResponse
+	48, // 61: api.meals.recipe.v1alpha1.RecipeService.StartScrapeRecipe:output_type -> api.operations.operation.v1alpha1.Operation
+	48, // 62: api.meals.recipe.v1alpha1.RecipeService.StartGenerateRecipeImage:output_type -> api.operations.operation.v1alpha1.Operation
+	17, // 63: api.meals.recipe.v1alpha1.RecipeService.FavoriteRecipe:output_type -> api.meals.recipe.v1alpha1.FavoriteRecipeResponse
+	19, // 64: api.meals.recipe.v1alpha1.RecipeService.UnfavoriteRecipe:output_type -> api.meals.recipe.v1alpha1.UnfavoriteRecipeResponse
+	21, // 65: api.meals.recipe.v1alpha1.RecipeService.MatchRecipes:output_type -> api.meals.recipe.v1alpha1.MatchRecipesResponse
+	4,  // 66: api.meals.recipe.v1alpha1.RecipeService.ForkRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
+	24, // 67: api.meals.recipe.v1alpha1.RecipeService.FindDuplicateRecipes:output_type -> api.meals.recipe.v1alpha1.FindDuplicateRecipesResponse
+	4,  // 68: api.meals.recipe.v1alpha1.RecipeService.MergeRecipes:output_type -> api.meals.recipe.v1alpha1.Recipe
+	55, // [55:69] is the sub-list for method output_type
+	41, // [41:55] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_api_meals_recipe_v1alpha1_recipe_proto_init() }
@@ -1474,13 +3054,15 @@ func file_api_meals_recipe_v1alpha1_recipe_proto_init() {
 	if File_api_meals_recipe_v1alpha1_recipe_proto != nil {
 		return
 	}
+	file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_init()
+	file_api_meals_recipe_v1alpha1_recipe_note_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   17,
+			NumEnums:      4,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_cook_log.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	types "github.com/jcfug8/daylear/server/genapi/api/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a record of a user cooking a recipe
+type RecipeCookLog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cook log
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the user who cooked the recipe
+	Cook string `protobuf:"bytes,2,opt,name=cook,proto3" json:"cook,omitempty"`
+	// the time the recipe was cooked (UTC), defaults to now
+	CookTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cook_time,json=cookTime,proto3" json:"cook_time,omitempty"`
+	// the rating for this time, from 1 to 5, or 0 when not rated
+	Rating int32 `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	// the notes for next time, e.g. "too salty, halve the soy sauce"
+	Notes string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// the photos of the cooked recipe
+	Photos []*RecipeCookLog_Photo `protobuf:"bytes,6,rep,name=photos,proto3" json:"photos,omitempty"`
+	// the event recipe the cook log was created from, if any
+	EventRecipe string `protobuf:"bytes,7,opt,name=event_recipe,json=eventRecipe,proto3" json:"event_recipe,omitempty"`
+	// the time the cook log was created (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the cook log was last updated (UTC)
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeCookLog) Reset() {
+	*x = RecipeCookLog{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeCookLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeCookLog) ProtoMessage() {}
+
+func (x *RecipeCookLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeCookLog.ProtoReflect.Descriptor instead.
+func (*RecipeCookLog) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipeCookLog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeCookLog) GetCook() string {
+	if x != nil {
+		return x.Cook
+	}
+	return ""
+}
+
+func (x *RecipeCookLog) GetCookTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CookTime
+	}
+	return nil
+}
+
+func (x *RecipeCookLog) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RecipeCookLog) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *RecipeCookLog) GetPhotos() []*RecipeCookLog_Photo {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *RecipeCookLog) GetEventRecipe() string {
+	if x != nil {
+		return x.EventRecipe
+	}
+	return ""
+}
+
+func (x *RecipeCookLog) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RecipeCookLog) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// the request to create a recipe cook log
+type CreateRecipeCookLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe that was cooked
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the cook log to create
+	RecipeCookLog *RecipeCookLog `protobuf:"bytes,2,opt,name=recipe_cook_log,json=recipeCookLog,proto3" json:"recipe_cook_log,omitempty"`
+	// the pantry to take the ingredients of the recipe from, if any
+	Pantry        string `protobuf:"bytes,3,opt,name=pantry,proto3" json:"pantry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecipeCookLogRequest) Reset() {
+	*x = CreateRecipeCookLogRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecipeCookLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecipeCookLogRequest) ProtoMessage() {}
+
+func (x *CreateRecipeCookLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecipeCookLogRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecipeCookLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRecipeCookLogRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateRecipeCookLogRequest) GetRecipeCookLog() *RecipeCookLog {
+	if x != nil {
+		return x.RecipeCookLog
+	}
+	return nil
+}
+
+// the request to list recipe cook logs
+func (x *CreateRecipeCookLogRequest) GetPantry() string {
+	if x != nil {
+		return x.Pantry
+	}
+	return ""
+}
+
+type ListRecipeCookLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to list the cook logs of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeCookLogsRequest) Reset() {
+	*x = ListRecipeCookLogsRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeCookLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeCookLogsRequest) ProtoMessage() {}
+
+func (x *ListRecipeCookLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeCookLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeCookLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecipeCookLogsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRecipeCookLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecipeCookLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list recipe cook logs
+type ListRecipeCookLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cook logs
+	RecipeCookLogs []*RecipeCookLog `protobuf:"bytes,1,rep,name=recipe_cook_logs,json=recipeCookLogs,proto3" json:"recipe_cook_logs,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeCookLogsResponse) Reset() {
+	*x = ListRecipeCookLogsResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeCookLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeCookLogsResponse) ProtoMessage() {}
+
+func (x *ListRecipeCookLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeCookLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeCookLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecipeCookLogsResponse) GetRecipeCookLogs() []*RecipeCookLog {
+	if x != nil {
+		return x.RecipeCookLogs
+	}
+	return nil
+}
+
+func (x *ListRecipeCookLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to get a recipe cook log
+type GetRecipeCookLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cook log
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeCookLogRequest) Reset() {
+	*x = GetRecipeCookLogRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeCookLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeCookLogRequest) ProtoMessage() {}
+
+func (x *GetRecipeCookLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeCookLogRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeCookLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRecipeCookLogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to update a recipe cook log
+type UpdateRecipeCookLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the cook log to update
+	RecipeCookLog *RecipeCookLog `protobuf:"bytes,1,opt,name=recipe_cook_log,json=recipeCookLog,proto3" json:"recipe_cook_log,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecipeCookLogRequest) Reset() {
+	*x = UpdateRecipeCookLogRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecipeCookLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecipeCookLogRequest) ProtoMessage() {}
+
+func (x *UpdateRecipeCookLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecipeCookLogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeCookLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRecipeCookLogRequest) GetRecipeCookLog() *RecipeCookLog {
+	if x != nil {
+		return x.RecipeCookLog
+	}
+	return nil
+}
+
+func (x *UpdateRecipeCookLogRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to delete a recipe cook log
+type DeleteRecipeCookLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the cook log
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipeCookLogRequest) Reset() {
+	*x = DeleteRecipeCookLogRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipeCookLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipeCookLogRequest) ProtoMessage() {}
+
+func (x *DeleteRecipeCookLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipeCookLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecipeCookLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRecipeCookLogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// a photo of the cooked recipe
+type RecipeCookLog_Photo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the uri of the stored photo
+	ImageUri string `protobuf:"bytes,1,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	// the resized variants and placeholder of the photo
+	Images        *types.ImageSet `protobuf:"bytes,2,opt,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeCookLog_Photo) Reset() {
+	*x = RecipeCookLog_Photo{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeCookLog_Photo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeCookLog_Photo) ProtoMessage() {}
+
+func (x *RecipeCookLog_Photo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeCookLog_Photo.ProtoReflect.Descriptor instead.
+func (*RecipeCookLog_Photo) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *RecipeCookLog_Photo) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *RecipeCookLog_Photo) GetImages() *types.ImageSet {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+var File_api_meals_recipe_v1alpha1_recipe_cook_log_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDesc = "" +
+	"\n" +
+	"/api/meals/recipe/v1alpha1/recipe_cook_log.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x19api/types/image_set.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd1\x05\n" +
+	"\rRecipeCookLog\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x128\n" +
+	"\x04cook\x18\x02 \x01(\tB$\xe0A\x03\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\x04cook\x12<\n" +
+	"\tcook_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\bcookTime\x12\x1b\n" +
+	"\x06rating\x18\x04 \x01(\x05B\x03\xe0A\x01R\x06rating\x12\x19\n" +
+	"\x05notes\x18\x05 \x01(\tB\x03\xe0A\x01R\x05notes\x12K\n" +
+	"\x06photos\x18\x06 \x03(\v2..api.meals.recipe.v1alpha1.RecipeCookLog.PhotoB\x03\xe0A\x01R\x06photos\x12V\n" +
+	"\fevent_recipe\x18\a \x01(\tB3\xe0A\x03\xfaA-\n" +
+	"+api.calendars.calendar.v1alpha1/EventRecipeR\veventRecipe\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x1a[\n" +
+	"\x05Photo\x12 \n" +
+	"\timage_uri\x18\x01 \x01(\tB\x03\xe0A\x02R\bimageUri\x120\n" +
+	"\x06images\x18\x02 \x01(\v2\x13.api.types.ImageSetB\x03\xe0A\x03R\x06images:q\xeaAn\n" +
+	"'api.meals.recipe.v1alpha1/RecipeCookLog\x12$recipes/{recipe}/cookLogs/{cook_log}*\x0erecipeCookLogs2\rrecipeCookLog\"\xfa\x01\n" +
+	"\x1aCreateRecipeCookLogRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12U\n" +
+	"\x0frecipe_cook_log\x18\x02 \x01(\v2(.api.meals.recipe.v1alpha1.RecipeCookLogB\x03\xe0A\x02R\rrecipeCookLog\x12C\n" +
+	"\x06pantry\x18\x03 \x01(\tB+\xe0A\x01\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x06pantry\"\xa3\x01\n" +
+	"\x19ListRecipeCookLogsRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x98\x01\n" +
+	"\x1aListRecipeCookLogsResponse\x12R\n" +
+	"\x10recipe_cook_logs\x18\x01 \x03(\v2(.api.meals.recipe.v1alpha1.RecipeCookLogR\x0erecipeCookLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"^\n" +
+	"\x17GetRecipeCookLogRequest\x12C\n" +
+	"\x04name\x18\x01 \x01(\tB/\xe0A\x02\xfaA)\n" +
+	"'api.meals.recipe.v1alpha1/RecipeCookLogR\x04name\"\xb5\x01\n" +
+	"\x1aUpdateRecipeCookLogRequest\x12U\n" +
+	"\x0frecipe_cook_log\x18\x01 \x01(\v2(.api.meals.recipe.v1alpha1.RecipeCookLogB\x03\xe0A\x02R\rrecipeCookLog\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"a\n" +
+	"\x1aDeleteRecipeCookLogRequest\x12C\n" +
+	"\x04name\x18\x01 \x01(\tB/\xe0A\x02\xfaA)\n" +
+	"'api.meals.recipe.v1alpha1/RecipeCookLogR\x04name2\xf4\r\n" +
+	"\x14RecipeCookLogService\x12\x87\x03\n" +
+	"\x13CreateRecipeCookLog\x125.api.meals.recipe.v1alpha1.CreateRecipeCookLogRequest\x1a(.api.meals.recipe.v1alpha1.RecipeCookLog\"\x8e\x02\x92A\xad\x01\n" +
+	"\x14RecipeCookLogService\x12\x18Create a recipe cook log\x1a{Records that the caller cooked a recipe, with an optional rating and notes. Photos are uploaded to the cook log afterwards.\xdaA\x16parent,recipe_cook_log\x82\xd3\xe4\x93\x02>:\x0frecipe_cook_log\"+/meals/v1alpha1/{parent=recipes/*}/cookLogs\x12\xc7\x02\n" +
+	"\x12ListRecipeCookLogs\x124.api.meals.recipe.v1alpha1.ListRecipeCookLogsRequest\x1a5.api.meals.recipe.v1alpha1.ListRecipeCookLogsResponse\"\xc3\x01\x92A\x83\x01\n" +
+	"\x14RecipeCookLogService\x12\x15List recipe cook logs\x1aTRetrieves a paginated list of the cook logs of a recipe, most recently cooked first.\xdaA\x06parent\x82\xd3\xe4\x93\x02-\x12+/meals/v1alpha1/{parent=recipes/*}/cookLogs\x12\x93\x02\n" +
+	"\x10GetRecipeCookLog\x122.api.meals.recipe.v1alpha1.GetRecipeCookLogRequest\x1a(.api.meals.recipe.v1alpha1.RecipeCookLog\"\xa0\x01\x92Ac\n" +
+	"\x14RecipeCookLogService\x12\x15Get a recipe cook log\x1a4Retrieves a single recipe cook log by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02-\x12+/meals/v1alpha1/{name=recipes/*/cookLogs/*}\x12\xa9\x03\n" +
+	"\x13UpdateRecipeCookLog\x125.api.meals.recipe.v1alpha1.UpdateRecipeCookLogRequest\x1a(.api.meals.recipe.v1alpha1.RecipeCookLog\"\xb0\x02\x92A\xba\x01\n" +
+	"\x14RecipeCookLogService\x12\x18Update a recipe cook log\x1a\x87\x01Updates the cook time, rating, notes or photos of a cook log. Photos can only be removed this way. Only the cook can update a cook log.\xdaA\x1brecipe_cook_log,update_mask\x82\xd3\xe4\x93\x02N:\x0frecipe_cook_log2;/meals/v1alpha1/{recipe_cook_log.name=recipes/*/cookLogs/*}\x12\xc5\x02\n" +
+	"\x13DeleteRecipeCookLog\x125.api.meals.recipe.v1alpha1.DeleteRecipeCookLogRequest\x1a(.api.meals.recipe.v1alpha1.RecipeCookLog\"\xcc\x01\x92A\x8e\x01\n" +
+	"\x14RecipeCookLogService\x12\x18Delete a recipe cook log\x1a\\Deletes a cook log and its photos. The cook or an admin of the recipe can delete a cook log.\xdaA\x04name\x82\xd3\xe4\x93\x02-*+/meals/v1alpha1/{name=recipes/*/cookLogs/*}B\xe7\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x12RecipeCookLogProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_goTypes = []any{
+	(*RecipeCookLog)(nil),              // 0: api.meals.recipe.v1alpha1.RecipeCookLog
+	(*CreateRecipeCookLogRequest)(nil), // 1: api.meals.recipe.v1alpha1.CreateRecipeCookLogRequest
+	(*ListRecipeCookLogsRequest)(nil),  // 2: api.meals.recipe.v1alpha1.ListRecipeCookLogsRequest
+	(*ListRecipeCookLogsResponse)(nil), // 3: api.meals.recipe.v1alpha1.ListRecipeCookLogsResponse
+	(*GetRecipeCookLogRequest)(nil),    // 4: api.meals.recipe.v1alpha1.GetRecipeCookLogRequest
+	(*UpdateRecipeCookLogRequest)(nil), // 5: api.meals.recipe.v1alpha1.UpdateRecipeCookLogRequest
+	(*DeleteRecipeCookLogRequest)(nil), // 6: api.meals.recipe.v1alpha1.DeleteRecipeCookLogRequest
+	(*RecipeCookLog_Photo)(nil),        // 7: api.meals.recipe.v1alpha1.RecipeCookLog.Photo
+	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 9: google.protobuf.FieldMask
+	(*types.ImageSet)(nil),             // 10: api.types.ImageSet
+}
+var file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_depIdxs = []int32{
+	8,  // 0: api.meals.recipe.v1alpha1.RecipeCookLog.cook_time:type_name -> google.protobuf.Timestamp
+	7,  // 1: api.meals.recipe.v1alpha1.RecipeCookLog.photos:type_name -> api.meals.recipe.v1alpha1.RecipeCookLog.Photo
+	8,  // 2: api.meals.recipe.v1alpha1.RecipeCookLog.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 3: api.meals.recipe.v1alpha1.RecipeCookLog.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: api.meals.recipe.v1alpha1.CreateRecipeCookLogRequest.recipe_cook_log:type_name -> api.meals.recipe.v1alpha1.RecipeCookLog
+	0,  // 5: api.meals.recipe.v1alpha1.ListRecipeCookLogsResponse.recipe_cook_logs:type_name -> api.meals.recipe.v1alpha1.RecipeCookLog
+	0,  // 6: api.meals.recipe.v1alpha1.UpdateRecipeCookLogRequest.recipe_cook_log:type_name -> api.meals.recipe.v1alpha1.RecipeCookLog
+	9,  // 7: api.meals.recipe.v1alpha1.UpdateRecipeCookLogRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 8: api.meals.recipe.v1alpha1.RecipeCookLog.Photo.images:type_name -> api.types.ImageSet
+	1,  // 9: api.meals.recipe.v1alpha1.RecipeCookLogService.CreateRecipeCookLog:input_type -> api.meals.recipe.v1alpha1.CreateRecipeCookLogRequest
+	2,  // 10: api.meals.recipe.v1alpha1.RecipeCookLogService.ListRecipeCookLogs:input_type -> api.meals.recipe.v1alpha1.ListRecipeCookLogsRequest
+	4,  // 11: api.meals.recipe.v1alpha1.RecipeCookLogService.GetRecipeCookLog:input_type -> api.meals.recipe.v1alpha1.GetRecipeCookLogRequest
+	5,  // 12: api.meals.recipe.v1alpha1.RecipeCookLogService.UpdateRecipeCookLog:input_type -> api.meals.recipe.v1alpha1.UpdateRecipeCookLogRequest
+	6,  // 13: api.meals.recipe.v1alpha1.RecipeCookLogService.DeleteRecipeCookLog:input_type -> api.meals.recipe.v1alpha1.DeleteRecipeCookLogRequest
+	0,  // 14: api.meals.recipe.v1alpha1.RecipeCookLogService.CreateRecipeCookLog:output_type -> api.meals.recipe.v1alpha1.RecipeCookLog
+	3,  // 15: api.meals.recipe.v1alpha1.RecipeCookLogService.ListRecipeCookLogs:output_type -> api.meals.recipe.v1alpha1.ListRecipeCookLogsResponse
+	0,  // 16: api.meals.recipe.v1alpha1.RecipeCookLogService.GetRecipeCookLog:output_type -> api.meals.recipe.v1alpha1.RecipeCookLog
+	0,  // 17: api.meals.recipe.v1alpha1.RecipeCookLogService.UpdateRecipeCookLog:output_type -> api.meals.recipe.v1alpha1.RecipeCookLog
+	0,  // 18: api.meals.recipe.v1alpha1.RecipeCookLogService.DeleteRecipeCookLog:output_type -> api.meals.recipe.v1alpha1.RecipeCookLog
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_init() }
+func file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_init() {
+	if File_api_meals_recipe_v1alpha1_recipe_cook_log_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_depIdxs,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_recipe_cook_log_proto = out.File
+	file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_recipe_cook_log_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_cook_log.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecipeCookLogService_CreateRecipeCookLog_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeCookLogService/CreateRecipeCookLog"
+	RecipeCookLogService_ListRecipeCookLogs_FullMethodName  = "/api.meals.recipe.v1alpha1.RecipeCookLogService/ListRecipeCookLogs"
+	RecipeCookLogService_GetRecipeCookLog_FullMethodName    = "/api.meals.recipe.v1alpha1.RecipeCookLogService/GetRecipeCookLog"
+	RecipeCookLogService_UpdateRecipeCookLog_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeCookLogService/UpdateRecipeCookLog"
+	RecipeCookLogService_DeleteRecipeCookLog_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeCookLogService/DeleteRecipeCookLog"
+)
+
+// RecipeCookLogServiceClient is the client API for RecipeCookLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the recipe cook log service
+type RecipeCookLogServiceClient interface {
+	// record that a recipe was cooked
+	CreateRecipeCookLog(ctx context.Context, in *CreateRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error)
+	// list the cook logs of a recipe
+	ListRecipeCookLogs(ctx context.Context, in *ListRecipeCookLogsRequest, opts ...grpc.CallOption) (*ListRecipeCookLogsResponse, error)
+	// get a cook log of a recipe
+	GetRecipeCookLog(ctx context.Context, in *GetRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error)
+	// update a cook log of a recipe
+	UpdateRecipeCookLog(ctx context.Context, in *UpdateRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error)
+	// delete a cook log of a recipe
+	DeleteRecipeCookLog(ctx context.Context, in *DeleteRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error)
+}
+
+type recipeCookLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeCookLogServiceClient(cc grpc.ClientConnInterface) RecipeCookLogServiceClient {
+	return &recipeCookLogServiceClient{cc}
+}
+
+func (c *recipeCookLogServiceClient) CreateRecipeCookLog(ctx context.Context, in *CreateRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeCookLog)
+	err := c.cc.Invoke(ctx, RecipeCookLogService_CreateRecipeCookLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeCookLogServiceClient) ListRecipeCookLogs(ctx context.Context, in *ListRecipeCookLogsRequest, opts ...grpc.CallOption) (*ListRecipeCookLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeCookLogsResponse)
+	err := c.cc.Invoke(ctx, RecipeCookLogService_ListRecipeCookLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeCookLogServiceClient) GetRecipeCookLog(ctx context.Context, in *GetRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeCookLog)
+	err := c.cc.Invoke(ctx, RecipeCookLogService_GetRecipeCookLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeCookLogServiceClient) UpdateRecipeCookLog(ctx context.Context, in *UpdateRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeCookLog)
+	err := c.cc.Invoke(ctx, RecipeCookLogService_UpdateRecipeCookLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeCookLogServiceClient) DeleteRecipeCookLog(ctx context.Context, in *DeleteRecipeCookLogRequest, opts ...grpc.CallOption) (*RecipeCookLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeCookLog)
+	err := c.cc.Invoke(ctx, RecipeCookLogService_DeleteRecipeCookLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeCookLogServiceServer is the server API for RecipeCookLogService service.
+// All implementations must embed UnimplementedRecipeCookLogServiceServer
+// for forward compatibility.
+//
+// the recipe cook log service
+type RecipeCookLogServiceServer interface {
+	// record that a recipe was cooked
+	CreateRecipeCookLog(context.Context, *CreateRecipeCookLogRequest) (*RecipeCookLog, error)
+	// list the cook logs of a recipe
+	ListRecipeCookLogs(context.Context, *ListRecipeCookLogsRequest) (*ListRecipeCookLogsResponse, error)
+	// get a cook log of a recipe
+	GetRecipeCookLog(context.Context, *GetRecipeCookLogRequest) (*RecipeCookLog, error)
+	// update a cook log of a recipe
+	UpdateRecipeCookLog(context.Context, *UpdateRecipeCookLogRequest) (*RecipeCookLog, error)
+	// delete a cook log of a recipe
+	DeleteRecipeCookLog(context.Context, *DeleteRecipeCookLogRequest) (*RecipeCookLog, error)
+	mustEmbedUnimplementedRecipeCookLogServiceServer()
+}
+
+// UnimplementedRecipeCookLogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeCookLogServiceServer struct{}
+
+func (UnimplementedRecipeCookLogServiceServer) CreateRecipeCookLog(context.Context, *CreateRecipeCookLogRequest) (*RecipeCookLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipeCookLog not implemented")
+}
+func (UnimplementedRecipeCookLogServiceServer) ListRecipeCookLogs(context.Context, *ListRecipeCookLogsRequest) (*ListRecipeCookLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeCookLogs not implemented")
+}
+func (UnimplementedRecipeCookLogServiceServer) GetRecipeCookLog(context.Context, *GetRecipeCookLogRequest) (*RecipeCookLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeCookLog not implemented")
+}
+func (UnimplementedRecipeCookLogServiceServer) UpdateRecipeCookLog(context.Context, *UpdateRecipeCookLogRequest) (*RecipeCookLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipeCookLog not implemented")
+}
+func (UnimplementedRecipeCookLogServiceServer) DeleteRecipeCookLog(context.Context, *DeleteRecipeCookLogRequest) (*RecipeCookLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipeCookLog not implemented")
+}
+func (UnimplementedRecipeCookLogServiceServer) mustEmbedUnimplementedRecipeCookLogServiceServer() {}
+func (UnimplementedRecipeCookLogServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeRecipeCookLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeCookLogServiceServer will
+// result in compilation errors.
+type UnsafeRecipeCookLogServiceServer interface {
+	mustEmbedUnimplementedRecipeCookLogServiceServer()
+}
+
+func RegisterRecipeCookLogServiceServer(s grpc.ServiceRegistrar, srv RecipeCookLogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeCookLogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeCookLogService_ServiceDesc, srv)
+}
+
+func _RecipeCookLogService_CreateRecipeCookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecipeCookLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeCookLogServiceServer).CreateRecipeCookLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeCookLogService_CreateRecipeCookLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeCookLogServiceServer).CreateRecipeCookLog(ctx, req.(*CreateRecipeCookLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeCookLogService_ListRecipeCookLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeCookLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeCookLogServiceServer).ListRecipeCookLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeCookLogService_ListRecipeCookLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeCookLogServiceServer).ListRecipeCookLogs(ctx, req.(*ListRecipeCookLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeCookLogService_GetRecipeCookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeCookLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeCookLogServiceServer).GetRecipeCookLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeCookLogService_GetRecipeCookLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeCookLogServiceServer).GetRecipeCookLog(ctx, req.(*GetRecipeCookLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeCookLogService_UpdateRecipeCookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipeCookLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeCookLogServiceServer).UpdateRecipeCookLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeCookLogService_UpdateRecipeCookLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeCookLogServiceServer).UpdateRecipeCookLog(ctx, req.(*UpdateRecipeCookLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeCookLogService_DeleteRecipeCookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipeCookLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeCookLogServiceServer).DeleteRecipeCookLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeCookLogService_DeleteRecipeCookLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeCookLogServiceServer).DeleteRecipeCookLog(ctx, req.(*DeleteRecipeCookLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipeCookLogService_ServiceDesc is the grpc.ServiceDesc for RecipeCookLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeCookLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.RecipeCookLogService",
+	HandlerType: (*RecipeCookLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecipeCookLog",
+			Handler:    _RecipeCookLogService_CreateRecipeCookLog_Handler,
+		},
+		{
+			MethodName: "ListRecipeCookLogs",
+			Handler:    _RecipeCookLogService_ListRecipeCookLogs_Handler,
+		},
+		{
+			MethodName: "GetRecipeCookLog",
+			Handler:    _RecipeCookLogService_GetRecipeCookLog_Handler,
+		},
+		{
+			MethodName: "UpdateRecipeCookLog",
+			Handler:    _RecipeCookLogService_UpdateRecipeCookLog_Handler,
+		},
+		{
+			MethodName: "DeleteRecipeCookLog",
+			Handler:    _RecipeCookLogService_DeleteRecipeCookLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/recipe_cook_log.proto",
+}
@@ -8,6 +8,7 @@ package recipev1alpha1
 
 import (
 	context "context"
+	v1alpha1 "github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,14 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	RecipeService_CreateRecipe_FullMethodName     = "/api.meals.recipe.v1alpha1.RecipeService/CreateRecipe"
-	RecipeService_ListRecipes_FullMethodName      = "/api.meals.recipe.v1alpha1.RecipeService/ListRecipes"
-	RecipeService_UpdateRecipe_FullMethodName     = "/api.meals.recipe.v1alpha1.RecipeService/UpdateRecipe"
-	RecipeService_DeleteRecipe_FullMethodName     = "/api.meals.recipe.v1alpha1.RecipeService/DeleteRecipe"
-	RecipeService_GetRecipe_FullMethodName        = "/api.meals.recipe.v1alpha1.RecipeService/GetRecipe"
-	RecipeService_ScrapeRecipe_FullMethodName     = "/api.meals.recipe.v1alpha1.RecipeService/ScrapeRecipe"
-	RecipeService_FavoriteRecipe_FullMethodName   = "/api.meals.recipe.v1alpha1.RecipeService/FavoriteRecipe"
-	RecipeService_UnfavoriteRecipe_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeService/UnfavoriteRecipe"
+	RecipeService_CreateRecipe_FullMethodName             = "/api.meals.recipe.v1alpha1.RecipeService/CreateRecipe"
+	RecipeService_ListRecipes_FullMethodName              = "/api.meals.recipe.v1alpha1.RecipeService/ListRecipes"
+	RecipeService_UpdateRecipe_FullMethodName             = "/api.meals.recipe.v1alpha1.RecipeService/UpdateRecipe"
+	RecipeService_DeleteRecipe_FullMethodName             = "/api.meals.recipe.v1alpha1.RecipeService/DeleteRecipe"
+	RecipeService_GetRecipe_FullMethodName                = "/api.meals.recipe.v1alpha1.RecipeService/GetRecipe"
+	RecipeService_ScrapeRecipe_FullMethodName             = "/api.meals.recipe.v1alpha1.RecipeService/ScrapeRecipe"
+	RecipeService_StartScrapeRecipe_FullMethodName        = "/api.meals.recipe.v1alpha1.RecipeService/StartScrapeRecipe"
+	RecipeService_StartGenerateRecipeImage_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeService/StartGenerateRecipeImage"
+	RecipeService_FavoriteRecipe_FullMethodName           = "/api.meals.recipe.v1alpha1.RecipeService/FavoriteRecipe"
+	RecipeService_UnfavoriteRecipe_FullMethodName         = "/api.meals.recipe.v1alpha1.RecipeService/UnfavoriteRecipe"
+	RecipeService_MatchRecipes_FullMethodName             = "/api.meals.recipe.v1alpha1.RecipeService/MatchRecipes"
+	RecipeService_ForkRecipe_FullMethodName               = "/api.meals.recipe.v1alpha1.RecipeService/ForkRecipe"
+	RecipeService_FindDuplicateRecipes_FullMethodName     = "/api.meals.recipe.v1alpha1.RecipeService/FindDuplicateRecipes"
+	RecipeService_MergeRecipes_FullMethodName             = "/api.meals.recipe.v1alpha1.RecipeService/MergeRecipes"
 )
 
 // RecipeServiceClient is the client API for RecipeService service.
@@ -47,10 +54,22 @@ type RecipeServiceClient interface {
 	GetRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
 	// scrape and save a recipe from a uri
 	ScrapeRecipe(ctx context.Context, in *ScrapeRecipeRequest, opts ...grpc.CallOption) (*ScrapeRecipeResponse, error)
+	// start scraping a recipe
+	StartScrapeRecipe(ctx context.Context, in *ScrapeRecipeRequest, opts ...grpc.CallOption) (*v1alpha1.Operation, error)
+	// start generating an image for a recipe
+	StartGenerateRecipeImage(ctx context.Context, in *StartGenerateRecipeImageRequest, opts ...grpc.CallOption) (*v1alpha1.Operation, error)
 	// favorite a recipe
 	FavoriteRecipe(ctx context.Context, in *FavoriteRecipeRequest, opts ...grpc.CallOption) (*FavoriteRecipeResponse, error)
 	// unfavorite a recipe
 	UnfavoriteRecipe(ctx context.Context, in *UnfavoriteRecipeRequest, opts ...grpc.CallOption) (*UnfavoriteRecipeResponse, error)
+	// rank the recipes that can be made with the ingredients on hand
+	MatchRecipes(ctx context.Context, in *MatchRecipesRequest, opts ...grpc.CallOption) (*MatchRecipesResponse, error)
+	// fork a recipe into a user or circle collection
+	ForkRecipe(ctx context.Context, in *ForkRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	// find the recipes of a collection that are likely copies of each other
+	FindDuplicateRecipes(ctx context.Context, in *FindDuplicateRecipesRequest, opts ...grpc.CallOption) (*FindDuplicateRecipesResponse, error)
+	// merge duplicate recipes into one
+	MergeRecipes(ctx context.Context, in *MergeRecipesRequest, opts ...grpc.CallOption) (*Recipe, error)
 }
 
 type recipeServiceClient struct {
@@ -121,6 +140,26 @@ func (c *recipeServiceClient) ScrapeRecipe(ctx context.Context, in *ScrapeRecipe
 	return out, nil
 }
 
+func (c *recipeServiceClient) StartScrapeRecipe(ctx context.Context, in *ScrapeRecipeRequest, opts ...grpc.CallOption) (*v1alpha1.Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha1.Operation)
+	err := c.cc.Invoke(ctx, RecipeService_StartScrapeRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) StartGenerateRecipeImage(ctx context.Context, in *StartGenerateRecipeImageRequest, opts ...grpc.CallOption) (*v1alpha1.Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha1.Operation)
+	err := c.cc.Invoke(ctx, RecipeService_StartGenerateRecipeImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *recipeServiceClient) FavoriteRecipe(ctx context.Context, in *FavoriteRecipeRequest, opts ...grpc.CallOption) (*FavoriteRecipeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FavoriteRecipeResponse)
@@ -141,6 +180,46 @@ func (c *recipeServiceClient) UnfavoriteRecipe(ctx context.Context, in *Unfavori
 	return out, nil
 }
 
+func (c *recipeServiceClient) MatchRecipes(ctx context.Context, in *MatchRecipesRequest, opts ...grpc.CallOption) (*MatchRecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchRecipesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_MatchRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ForkRecipe(ctx context.Context, in *ForkRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_ForkRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) FindDuplicateRecipes(ctx context.Context, in *FindDuplicateRecipesRequest, opts ...grpc.CallOption) (*FindDuplicateRecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicateRecipesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_FindDuplicateRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) MergeRecipes(ctx context.Context, in *MergeRecipesRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_MergeRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RecipeServiceServer is the server API for RecipeService service.
 // All implementations must embed UnimplementedRecipeServiceServer
 // for forward compatibility.
@@ -159,10 +238,22 @@ type RecipeServiceServer interface {
 	GetRecipe(context.Context, *GetRecipeRequest) (*Recipe, error)
 	// scrape and save a recipe from a uri
 	ScrapeRecipe(context.Context, *ScrapeRecipeRequest) (*ScrapeRecipeResponse, error)
+	// start scraping a recipe
+	StartScrapeRecipe(context.Context, *ScrapeRecipeRequest) (*v1alpha1.Operation, error)
+	// start generating an image for a recipe
+	StartGenerateRecipeImage(context.Context, *StartGenerateRecipeImageRequest) (*v1alpha1.Operation, error)
 	// favorite a recipe
 	FavoriteRecipe(context.Context, *FavoriteRecipeRequest) (*FavoriteRecipeResponse, error)
 	// unfavorite a recipe
 	UnfavoriteRecipe(context.Context, *UnfavoriteRecipeRequest) (*UnfavoriteRecipeResponse, error)
+	// rank the recipes that can be made with the ingredients on hand
+	MatchRecipes(context.Context, *MatchRecipesRequest) (*MatchRecipesResponse, error)
+	// fork a recipe into a user or circle collection
+	ForkRecipe(context.Context, *ForkRecipeRequest) (*Recipe, error)
+	// find the recipes of a collection that are likely copies of each other
+	FindDuplicateRecipes(context.Context, *FindDuplicateRecipesRequest) (*FindDuplicateRecipesResponse, error)
+	// merge duplicate recipes into one
+	MergeRecipes(context.Context, *MergeRecipesRequest) (*Recipe, error)
 	mustEmbedUnimplementedRecipeServiceServer()
 }
 
@@ -191,12 +282,30 @@ func (UnimplementedRecipeServiceServer) GetRecipe(context.Context, *GetRecipeReq
 func (UnimplementedRecipeServiceServer) ScrapeRecipe(context.Context, *ScrapeRecipeRequest) (*ScrapeRecipeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScrapeRecipe not implemented")
 }
+func (UnimplementedRecipeServiceServer) StartScrapeRecipe(context.Context, *ScrapeRecipeRequest) (*v1alpha1.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartScrapeRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) StartGenerateRecipeImage(context.Context, *StartGenerateRecipeImageRequest) (*v1alpha1.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGenerateRecipeImage not implemented")
+}
 func (UnimplementedRecipeServiceServer) FavoriteRecipe(context.Context, *FavoriteRecipeRequest) (*FavoriteRecipeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FavoriteRecipe not implemented")
 }
 func (UnimplementedRecipeServiceServer) UnfavoriteRecipe(context.Context, *UnfavoriteRecipeRequest) (*UnfavoriteRecipeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfavoriteRecipe not implemented")
 }
+func (UnimplementedRecipeServiceServer) MatchRecipes(context.Context, *MatchRecipesRequest) (*MatchRecipesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) ForkRecipe(context.Context, *ForkRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) FindDuplicateRecipes(context.Context, *FindDuplicateRecipesRequest) (*FindDuplicateRecipesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicateRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) MergeRecipes(context.Context, *MergeRecipesRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeRecipes not implemented")
+}
 func (UnimplementedRecipeServiceServer) mustEmbedUnimplementedRecipeServiceServer() {}
 func (UnimplementedRecipeServiceServer) testEmbeddedByValue()                       {}
 
@@ -326,6 +435,42 @@ func _RecipeService_ScrapeRecipe_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RecipeService_StartScrapeRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrapeRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).StartScrapeRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_StartScrapeRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).StartScrapeRecipe(ctx, req.(*ScrapeRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_StartGenerateRecipeImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGenerateRecipeImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).StartGenerateRecipeImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_StartGenerateRecipeImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).StartGenerateRecipeImage(ctx, req.(*StartGenerateRecipeImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RecipeService_FavoriteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FavoriteRecipeRequest)
 	if err := dec(in); err != nil {
@@ -362,6 +507,78 @@ func _RecipeService_UnfavoriteRecipe_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RecipeService_MatchRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).MatchRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_MatchRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).MatchRecipes(ctx, req.(*MatchRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ForkRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ForkRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ForkRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ForkRecipe(ctx, req.(*ForkRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_FindDuplicateRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicateRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).FindDuplicateRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_FindDuplicateRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).FindDuplicateRecipes(ctx, req.(*FindDuplicateRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_MergeRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).MergeRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_MergeRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).MergeRecipes(ctx, req.(*MergeRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RecipeService_ServiceDesc is the grpc.ServiceDesc for RecipeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -393,6 +610,14 @@ var RecipeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ScrapeRecipe",
 			Handler:    _RecipeService_ScrapeRecipe_Handler,
 		},
+		{
+			MethodName: "StartScrapeRecipe",
+			Handler:    _RecipeService_StartScrapeRecipe_Handler,
+		},
+		{
+			MethodName: "StartGenerateRecipeImage",
+			Handler:    _RecipeService_StartGenerateRecipeImage_Handler,
+		},
 		{
 			MethodName: "FavoriteRecipe",
 			Handler:    _RecipeService_FavoriteRecipe_Handler,
@@ -401,6 +626,22 @@ var RecipeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfavoriteRecipe",
 			Handler:    _RecipeService_UnfavoriteRecipe_Handler,
 		},
+		{
+			MethodName: "MatchRecipes",
+			Handler:    _RecipeService_MatchRecipes_Handler,
+		},
+		{
+			MethodName: "ForkRecipe",
+			Handler:    _RecipeService_ForkRecipe_Handler,
+		},
+		{
+			MethodName: "FindDuplicateRecipes",
+			Handler:    _RecipeService_FindDuplicateRecipes_Handler,
+		},
+		{
+			MethodName: "MergeRecipes",
+			Handler:    _RecipeService_MergeRecipes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/meals/recipe/v1alpha1/recipe.proto",
@@ -0,0 +1,733 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_image.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	types "github.com/jcfug8/daylear/server/genapi/api/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// an image in the gallery of a recipe, or attached to one of its direction steps
+type RecipeImage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the image
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the uri of the stored image
+	ImageUri string `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	// whether the image is the cover image of the recipe
+	Cover bool `protobuf:"varint,3,opt,name=cover,proto3" json:"cover,omitempty"`
+	// the step the image is attached to, unset for gallery images
+	Step *RecipeImage_DirectionStep `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
+	// the time the image was uploaded (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the resized variants and placeholder of the image
+	Images        *types.ImageSet `protobuf:"bytes,6,opt,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeImage) Reset() {
+	*x = RecipeImage{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeImage) ProtoMessage() {}
+
+func (x *RecipeImage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeImage.ProtoReflect.Descriptor instead.
+func (*RecipeImage) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipeImage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeImage) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *RecipeImage) GetCover() bool {
+	if x != nil {
+		return x.Cover
+	}
+	return false
+}
+
+func (x *RecipeImage) GetStep() *RecipeImage_DirectionStep {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *RecipeImage) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RecipeImage) GetImages() *types.ImageSet {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// the request to list recipe images
+type ListRecipeImagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to list the images of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeImagesRequest) Reset() {
+	*x = ListRecipeImagesRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeImagesRequest) ProtoMessage() {}
+
+func (x *ListRecipeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRecipeImagesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRecipeImagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecipeImagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list recipe images
+type ListRecipeImagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the images
+	RecipeImages []*RecipeImage `protobuf:"bytes,1,rep,name=recipe_images,json=recipeImages,proto3" json:"recipe_images,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeImagesResponse) Reset() {
+	*x = ListRecipeImagesResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeImagesResponse) ProtoMessage() {}
+
+func (x *ListRecipeImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeImagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecipeImagesResponse) GetRecipeImages() []*RecipeImage {
+	if x != nil {
+		return x.RecipeImages
+	}
+	return nil
+}
+
+func (x *ListRecipeImagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to get a recipe image
+type GetRecipeImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the image
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeImageRequest) Reset() {
+	*x = GetRecipeImageRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeImageRequest) ProtoMessage() {}
+
+func (x *GetRecipeImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeImageRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRecipeImageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to update a recipe image
+type UpdateRecipeImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the image to update
+	RecipeImage *RecipeImage `protobuf:"bytes,1,opt,name=recipe_image,json=recipeImage,proto3" json:"recipe_image,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecipeImageRequest) Reset() {
+	*x = UpdateRecipeImageRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecipeImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecipeImageRequest) ProtoMessage() {}
+
+func (x *UpdateRecipeImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecipeImageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateRecipeImageRequest) GetRecipeImage() *RecipeImage {
+	if x != nil {
+		return x.RecipeImage
+	}
+	return nil
+}
+
+func (x *UpdateRecipeImageRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to delete a recipe image
+type DeleteRecipeImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the image
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipeImageRequest) Reset() {
+	*x = DeleteRecipeImageRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipeImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipeImageRequest) ProtoMessage() {}
+
+func (x *DeleteRecipeImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipeImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecipeImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteRecipeImageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to reorder recipe images
+type ReorderRecipeImagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to reorder the images of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the names of all the images of the recipe, in their new order
+	RecipeImages  []string `protobuf:"bytes,2,rep,name=recipe_images,json=recipeImages,proto3" json:"recipe_images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRecipeImagesRequest) Reset() {
+	*x = ReorderRecipeImagesRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRecipeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRecipeImagesRequest) ProtoMessage() {}
+
+func (x *ReorderRecipeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRecipeImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRecipeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReorderRecipeImagesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ReorderRecipeImagesRequest) GetRecipeImages() []string {
+	if x != nil {
+		return x.RecipeImages
+	}
+	return nil
+}
+
+// the response to reorder recipe images
+type ReorderRecipeImagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the images, in their new order
+	RecipeImages  []*RecipeImage `protobuf:"bytes,1,rep,name=recipe_images,json=recipeImages,proto3" json:"recipe_images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRecipeImagesResponse) Reset() {
+	*x = ReorderRecipeImagesResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRecipeImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRecipeImagesResponse) ProtoMessage() {}
+
+func (x *ReorderRecipeImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRecipeImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderRecipeImagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReorderRecipeImagesResponse) GetRecipeImages() []*RecipeImage {
+	if x != nil {
+		return x.RecipeImages
+	}
+	return nil
+}
+
+// the request to set the recipe cover image
+type SetRecipeCoverImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the image
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecipeCoverImageRequest) Reset() {
+	*x = SetRecipeCoverImageRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecipeCoverImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecipeCoverImageRequest) ProtoMessage() {}
+
+func (x *SetRecipeCoverImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecipeCoverImageRequest.ProtoReflect.Descriptor instead.
+func (*SetRecipeCoverImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetRecipeCoverImageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// a direction step of the recipe
+type RecipeImage_DirectionStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the index of the direction in the recipe directions
+	DirectionIndex int32 `protobuf:"varint,1,opt,name=direction_index,json=directionIndex,proto3" json:"direction_index,omitempty"`
+	// the index of the step in the direction steps
+	StepIndex     int32 `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeImage_DirectionStep) Reset() {
+	*x = RecipeImage_DirectionStep{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeImage_DirectionStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeImage_DirectionStep) ProtoMessage() {}
+
+func (x *RecipeImage_DirectionStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeImage_DirectionStep.ProtoReflect.Descriptor instead.
+func (*RecipeImage_DirectionStep) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *RecipeImage_DirectionStep) GetDirectionIndex() int32 {
+	if x != nil {
+		return x.DirectionIndex
+	}
+	return 0
+}
+
+func (x *RecipeImage_DirectionStep) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+var File_api_meals_recipe_v1alpha1_recipe_image_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDesc = "" +
+	"\n" +
+	",api/meals/recipe/v1alpha1/recipe_image.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x19api/types/image_set.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf1\x03\n" +
+	"\vRecipeImage\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12 \n" +
+	"\timage_uri\x18\x02 \x01(\tB\x03\xe0A\x03R\bimageUri\x12\x19\n" +
+	"\x05cover\x18\x03 \x01(\bB\x03\xe0A\x03R\x05cover\x12M\n" +
+	"\x04step\x18\x04 \x01(\v24.api.meals.recipe.v1alpha1.RecipeImage.DirectionStepB\x03\xe0A\x01R\x04step\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x120\n" +
+	"\x06images\x18\x06 \x01(\v2\x13.api.types.ImageSetB\x03\xe0A\x03R\x06images\x1aa\n" +
+	"\rDirectionStep\x12,\n" +
+	"\x0fdirection_index\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0edirectionIndex\x12\"\n" +
+	"\n" +
+	"step_index\x18\x02 \x01(\x05B\x03\xe0A\x02R\tstepIndex:f\xeaAc\n" +
+	"%api.meals.recipe.v1alpha1/RecipeImage\x12\x1frecipes/{recipe}/images/{image}*\frecipeImages2\vrecipeImage\"\xa1\x01\n" +
+	"\x17ListRecipeImagesRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8f\x01\n" +
+	"\x18ListRecipeImagesResponse\x12K\n" +
+	"\rrecipe_images\x18\x01 \x03(\v2&.api.meals.recipe.v1alpha1.RecipeImageR\frecipeImages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"Z\n" +
+	"\x15GetRecipeImageRequest\x12A\n" +
+	"\x04name\x18\x01 \x01(\tB-\xe0A\x02\xfaA'\n" +
+	"%api.meals.recipe.v1alpha1/RecipeImageR\x04name\"\xac\x01\n" +
+	"\x18UpdateRecipeImageRequest\x12N\n" +
+	"\frecipe_image\x18\x01 \x01(\v2&.api.meals.recipe.v1alpha1.RecipeImageB\x03\xe0A\x02R\vrecipeImage\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"]\n" +
+	"\x18DeleteRecipeImageRequest\x12A\n" +
+	"\x04name\x18\x01 \x01(\tB-\xe0A\x02\xfaA'\n" +
+	"%api.meals.recipe.v1alpha1/RecipeImageR\x04name\"\xb2\x01\n" +
+	"\x1aReorderRecipeImagesRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12R\n" +
+	"\rrecipe_images\x18\x02 \x03(\tB-\xe0A\x02\xfaA'\n" +
+	"%api.meals.recipe.v1alpha1/RecipeImageR\frecipeImages\"j\n" +
+	"\x1bReorderRecipeImagesResponse\x12K\n" +
+	"\rrecipe_images\x18\x01 \x03(\v2&.api.meals.recipe.v1alpha1.RecipeImageR\frecipeImages\"_\n" +
+	"\x1aSetRecipeCoverImageRequest\x12A\n" +
+	"\x04name\x18\x01 \x01(\tB-\xe0A\x02\xfaA'\n" +
+	"%api.meals.recipe.v1alpha1/RecipeImageR\x04name2\x85\x10\n" +
+	"\x12RecipeImageService\x12\xf4\x02\n" +
+	"\x10ListRecipeImages\x122.api.meals.recipe.v1alpha1.ListRecipeImagesRequest\x1a3.api.meals.recipe.v1alpha1.ListRecipeImagesResponse\"\xf6\x01\x92A\xb8\x01\n" +
+	"\x12RecipeImageService\x12\x12List recipe images\x1a\x8d\x01Retrieves a paginated list of the gallery and step images of a recipe, in their display order. Images are uploaded through the files service.\xdaA\x06parent\x82\xd3\xe4\x93\x02+\x12)/meals/v1alpha1/{parent=recipes/*}/images\x12\x83\x02\n" +
+	"\x0eGetRecipeImage\x120.api.meals.recipe.v1alpha1.GetRecipeImageRequest\x1a&.api.meals.recipe.v1alpha1.RecipeImage\"\x96\x01\x92A[\n" +
+	"\x12RecipeImageService\x12\x12Get a recipe image\x1a1Retrieves a single recipe image by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02+\x12)/meals/v1alpha1/{name=recipes/*/images/*}\x12\xeb\x02\n" +
+	"\x11UpdateRecipeImage\x123.api.meals.recipe.v1alpha1.UpdateRecipeImageRequest\x1a&.api.meals.recipe.v1alpha1.RecipeImage\"\xf8\x01\x92A\x8d\x01\n" +
+	"\x12RecipeImageService\x12\x15Update a recipe image\x1a`Attaches an image to a direction step, or moves it back to the gallery when the step is cleared.\xdaA\x18recipe_image,update_mask\x82\xd3\xe4\x93\x02F:\frecipe_image26/meals/v1alpha1/{recipe_image.name=recipes/*/images/*}\x12\xcb\x02\n" +
+	"\x11DeleteRecipeImage\x123.api.meals.recipe.v1alpha1.DeleteRecipeImageRequest\x1a&.api.meals.recipe.v1alpha1.RecipeImage\"\xd8\x01\x92A\x9c\x01\n" +
+	"\x12RecipeImageService\x12\x15Delete a recipe image\x1aoDeletes an image and its stored file. When the cover image is deleted the next gallery image becomes the cover.\xdaA\x04name\x82\xd3\xe4\x93\x02+*)/meals/v1alpha1/{name=recipes/*/images/*}\x12\xeb\x02\n" +
+	"\x13ReorderRecipeImages\x125.api.meals.recipe.v1alpha1.ReorderRecipeImagesRequest\x1a6.api.meals.recipe.v1alpha1.ReorderRecipeImagesResponse\"\xe4\x01\x92A\x8d\x01\n" +
+	"\x12RecipeImageService\x12\x15Reorder recipe images\x1a`Sets the display order of the images of a recipe. Every image of the recipe must be listed once.\xdaA\x14parent,recipe_images\x82\xd3\xe4\x93\x026:\x01*\"1/meals/v1alpha1/{parent=recipes/*}/images:reorder\x12\xc7\x02\n" +
+	"\x13SetRecipeCoverImage\x125.api.meals.recipe.v1alpha1.SetRecipeCoverImageRequest\x1a&.api.meals.recipe.v1alpha1.RecipeImage\"\xd0\x01\x92A\x88\x01\n" +
+	"\x12RecipeImageService\x12\x1aSet the recipe cover image\x1aVMakes the image the cover image of its recipe, which is shown as the recipe image_uri.\xdaA\x04name\x82\xd3\xe4\x93\x027:\x01*\"2/meals/v1alpha1/{name=recipes/*/images/*}:setCoverB\xe5\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x10RecipeImageProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_meals_recipe_v1alpha1_recipe_image_proto_goTypes = []any{
+	(*RecipeImage)(nil),                 // 0: api.meals.recipe.v1alpha1.RecipeImage
+	(*ListRecipeImagesRequest)(nil),     // 1: api.meals.recipe.v1alpha1.ListRecipeImagesRequest
+	(*ListRecipeImagesResponse)(nil),    // 2: api.meals.recipe.v1alpha1.ListRecipeImagesResponse
+	(*GetRecipeImageRequest)(nil),       // 3: api.meals.recipe.v1alpha1.GetRecipeImageRequest
+	(*UpdateRecipeImageRequest)(nil),    // 4: api.meals.recipe.v1alpha1.UpdateRecipeImageRequest
+	(*DeleteRecipeImageRequest)(nil),    // 5: api.meals.recipe.v1alpha1.DeleteRecipeImageRequest
+	(*ReorderRecipeImagesRequest)(nil),  // 6: api.meals.recipe.v1alpha1.ReorderRecipeImagesRequest
+	(*ReorderRecipeImagesResponse)(nil), // 7: api.meals.recipe.v1alpha1.ReorderRecipeImagesResponse
+	(*SetRecipeCoverImageRequest)(nil),  // 8: api.meals.recipe.v1alpha1.SetRecipeCoverImageRequest
+	(*RecipeImage_DirectionStep)(nil),   // 9: api.meals.recipe.v1alpha1.RecipeImage.DirectionStep
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+	(*types.ImageSet)(nil),              // 11: api.types.ImageSet
+	(*fieldmaskpb.FieldMask)(nil),       // 12: google.protobuf.FieldMask
+}
+var file_api_meals_recipe_v1alpha1_recipe_image_proto_depIdxs = []int32{
+	9,  // 0: api.meals.recipe.v1alpha1.RecipeImage.step:type_name -> api.meals.recipe.v1alpha1.RecipeImage.DirectionStep
+	10, // 1: api.meals.recipe.v1alpha1.RecipeImage.create_time:type_name -> google.protobuf.Timestamp
+	11, // 2: api.meals.recipe.v1alpha1.RecipeImage.images:type_name -> api.types.ImageSet
+	0,  // 3: api.meals.recipe.v1alpha1.ListRecipeImagesResponse.recipe_images:type_name -> api.meals.recipe.v1alpha1.RecipeImage
+	0,  // 4: api.meals.recipe.v1alpha1.UpdateRecipeImageRequest.recipe_image:type_name -> api.meals.recipe.v1alpha1.RecipeImage
+	12, // 5: api.meals.recipe.v1alpha1.UpdateRecipeImageRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: api.meals.recipe.v1alpha1.ReorderRecipeImagesResponse.recipe_images:type_name -> api.meals.recipe.v1alpha1.RecipeImage
+	1,  // 7: api.meals.recipe.v1alpha1.RecipeImageService.ListRecipeImages:input_type -> api.meals.recipe.v1alpha1.ListRecipeImagesRequest
+	3,  // 8: api.meals.recipe.v1alpha1.RecipeImageService.GetRecipeImage:input_type -> api.meals.recipe.v1alpha1.GetRecipeImageRequest
+	4,  // 9: api.meals.recipe.v1alpha1.RecipeImageService.UpdateRecipeImage:input_type -> api.meals.recipe.v1alpha1.UpdateRecipeImageRequest
+	5,  // 10: api.meals.recipe.v1alpha1.RecipeImageService.DeleteRecipeImage:input_type -> api.meals.recipe.v1alpha1.DeleteRecipeImageRequest
+	6,  // 11: api.meals.recipe.v1alpha1.RecipeImageService.ReorderRecipeImages:input_type -> api.meals.recipe.v1alpha1.ReorderRecipeImagesRequest
+	8,  // 12: api.meals.recipe.v1alpha1.RecipeImageService.SetRecipeCoverImage:input_type -> api.meals.recipe.v1alpha1.SetRecipeCoverImageRequest
+	2,  // 13: api.meals.recipe.v1alpha1.RecipeImageService.ListRecipeImages:output_type -> api.meals.recipe.v1alpha1.ListRecipeImagesResponse
+	0,  // 14: api.meals.recipe.v1alpha1.RecipeImageService.GetRecipeImage:output_type -> api.meals.recipe.v1alpha1.RecipeImage
+	0,  // 15: api.meals.recipe.v1alpha1.RecipeImageService.UpdateRecipeImage:output_type -> api.meals.recipe.v1alpha1.RecipeImage
+	0,  // 16: api.meals.recipe.v1alpha1.RecipeImageService.DeleteRecipeImage:output_type -> api.meals.recipe.v1alpha1.RecipeImage
+	7,  // 17: api.meals.recipe.v1alpha1.RecipeImageService.ReorderRecipeImages:output_type -> api.meals.recipe.v1alpha1.ReorderRecipeImagesResponse
+	0,  // 18: api.meals.recipe.v1alpha1.RecipeImageService.SetRecipeCoverImage:output_type -> api.meals.recipe.v1alpha1.RecipeImage
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_recipe_image_proto_init() }
+func file_api_meals_recipe_v1alpha1_recipe_image_proto_init() {
+	if File_api_meals_recipe_v1alpha1_recipe_image_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_image_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_recipe_image_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_recipe_image_proto_depIdxs,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_recipe_image_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_recipe_image_proto = out.File
+	file_api_meals_recipe_v1alpha1_recipe_image_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_recipe_image_proto_depIdxs = nil
+}
@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_image_candidate.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	types "github.com/jcfug8/daylear/server/genapi/api/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a generated image waiting to be selected as the cover of a recipe
+type RecipeImageCandidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the candidate
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the uri of the generated image
+	ImageUri string `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	// the user who generated the candidate
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// the time the candidate was generated (UTC)
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeImageCandidate) Reset() {
+	*x = RecipeImageCandidate{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeImageCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeImageCandidate) ProtoMessage() {}
+
+func (x *RecipeImageCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeImageCandidate.ProtoReflect.Descriptor instead.
+func (*RecipeImageCandidate) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipeImageCandidate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeImageCandidate) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *RecipeImageCandidate) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *RecipeImageCandidate) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// the request to list recipe image candidates
+type ListRecipeImageCandidatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to list the candidates of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeImageCandidatesRequest) Reset() {
+	*x = ListRecipeImageCandidatesRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeImageCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeImageCandidatesRequest) ProtoMessage() {}
+
+func (x *ListRecipeImageCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeImageCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeImageCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRecipeImageCandidatesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRecipeImageCandidatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecipeImageCandidatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list recipe image candidates
+type ListRecipeImageCandidatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the candidates
+	RecipeImageCandidates []*RecipeImageCandidate `protobuf:"bytes,1,rep,name=recipe_image_candidates,json=recipeImageCandidates,proto3" json:"recipe_image_candidates,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeImageCandidatesResponse) Reset() {
+	*x = ListRecipeImageCandidatesResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeImageCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeImageCandidatesResponse) ProtoMessage() {}
+
+func (x *ListRecipeImageCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeImageCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeImageCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecipeImageCandidatesResponse) GetRecipeImageCandidates() []*RecipeImageCandidate {
+	if x != nil {
+		return x.RecipeImageCandidates
+	}
+	return nil
+}
+
+func (x *ListRecipeImageCandidatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to get a recipe image candidate
+type GetRecipeImageCandidateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the candidate
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeImageCandidateRequest) Reset() {
+	*x = GetRecipeImageCandidateRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeImageCandidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeImageCandidateRequest) ProtoMessage() {}
+
+func (x *GetRecipeImageCandidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeImageCandidateRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeImageCandidateRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRecipeImageCandidateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to delete a recipe image candidate
+type DeleteRecipeImageCandidateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the candidate
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipeImageCandidateRequest) Reset() {
+	*x = DeleteRecipeImageCandidateRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipeImageCandidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipeImageCandidateRequest) ProtoMessage() {}
+
+func (x *DeleteRecipeImageCandidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipeImageCandidateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecipeImageCandidateRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRecipeImageCandidateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to select a recipe image candidate
+type SelectRecipeImageCandidateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the candidate
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectRecipeImageCandidateRequest) Reset() {
+	*x = SelectRecipeImageCandidateRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectRecipeImageCandidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectRecipeImageCandidateRequest) ProtoMessage() {}
+
+func (x *SelectRecipeImageCandidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectRecipeImageCandidateRequest.ProtoReflect.Descriptor instead.
+func (*SelectRecipeImageCandidateRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SelectRecipeImageCandidateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the response to select a recipe image candidate
+type SelectRecipeImageCandidateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the uri of the new cover image of the recipe
+	ImageUri string `protobuf:"bytes,1,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	// the resized variants and placeholder of the new cover image
+	Images        *types.ImageSet `protobuf:"bytes,2,opt,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectRecipeImageCandidateResponse) Reset() {
+	*x = SelectRecipeImageCandidateResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectRecipeImageCandidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectRecipeImageCandidateResponse) ProtoMessage() {}
+
+func (x *SelectRecipeImageCandidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectRecipeImageCandidateResponse.ProtoReflect.Descriptor instead.
+func (*SelectRecipeImageCandidateResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SelectRecipeImageCandidateResponse) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *SelectRecipeImageCandidateResponse) GetImages() *types.ImageSet {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+var File_api_meals_recipe_v1alpha1_recipe_image_candidate_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDesc = "" +
+	"\n" +
+	"6api/meals/recipe/v1alpha1/recipe_image_candidate.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x19api/types/image_set.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe5\x02\n" +
+	"\x14RecipeImageCandidate\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12 \n" +
+	"\timage_uri\x18\x02 \x01(\tB\x03\xe0A\x03R\bimageUri\x12>\n" +
+	"\acreator\x18\x03 \x01(\tB$\xe0A\x03\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\acreator\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:\x8f\x01\xeaA\x8b\x01\n" +
+	".api.meals.recipe.v1alpha1/RecipeImageCandidate\x12,recipes/{recipe}/imageCandidates/{candidate}*\x15recipeImageCandidates2\x14recipeImageCandidate\"\xaa\x01\n" +
+	" ListRecipeImageCandidatesRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\xb4\x01\n" +
+	"!ListRecipeImageCandidatesResponse\x12g\n" +
+	"\x17recipe_image_candidates\x18\x01 \x03(\v2/.api.meals.recipe.v1alpha1.RecipeImageCandidateR\x15recipeImageCandidates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"l\n" +
+	"\x1eGetRecipeImageCandidateRequest\x12J\n" +
+	"\x04name\x18\x01 \x01(\tB6\xe0A\x02\xfaA0\n" +
+	".api.meals.recipe.v1alpha1/RecipeImageCandidateR\x04name\"o\n" +
+	"!DeleteRecipeImageCandidateRequest\x12J\n" +
+	"\x04name\x18\x01 \x01(\tB6\xe0A\x02\xfaA0\n" +
+	".api.meals.recipe.v1alpha1/RecipeImageCandidateR\x04name\"o\n" +
+	"!SelectRecipeImageCandidateRequest\x12J\n" +
+	"\x04name\x18\x01 \x01(\tB6\xe0A\x02\xfaA0\n" +
+	".api.meals.recipe.v1alpha1/RecipeImageCandidateR\x04name\"n\n" +
+	"\"SelectRecipeImageCandidateResponse\x12\x1b\n" +
+	"\timage_uri\x18\x01 \x01(\tR\bimageUri\x12+\n" +
+	"\x06images\x18\x02 \x01(\v2\x13.api.types.ImageSetR\x06images2\xf6\v\n" +
+	"\x1bRecipeImageCandidateService\x12\x8a\x03\n" +
+	"\x19ListRecipeImageCandidates\x12;.api.meals.recipe.v1alpha1.ListRecipeImageCandidatesRequest\x1a<.api.meals.recipe.v1alpha1.ListRecipeImageCandidatesResponse\"\xf1\x01\x92A\xaa\x01\n" +
+	"\x1bRecipeImageCandidateService\x12\x1cList recipe image candidates\x1amRetrieves a paginated list of the generated images of a recipe that are waiting to be selected, newest first.\xdaA\x06parent\x82\xd3\xe4\x93\x024\x122/meals/v1alpha1/{parent=recipes/*}/imageCandidates\x12\xc4\x02\n" +
+	"\x17GetRecipeImageCandidate\x129.api.meals.recipe.v1alpha1.GetRecipeImageCandidateRequest\x1a/.api.meals.recipe.v1alpha1.RecipeImageCandidate\"\xbc\x01\x92Ax\n" +
+	"\x1bRecipeImageCandidateService\x12\x1cGet a recipe image candidate\x1a;Retrieves a single recipe image candidate by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x024\x122/meals/v1alpha1/{name=recipes/*/imageCandidates/*}\x12\xc2\x02\n" +
+	"\x1aDeleteRecipeImageCandidate\x12<.api.meals.recipe.v1alpha1.DeleteRecipeImageCandidateRequest\x1a/.api.meals.recipe.v1alpha1.RecipeImageCandidate\"\xb4\x01\x92Ap\n" +
+	"\x1bRecipeImageCandidateService\x12\x1fDelete a recipe image candidate\x1a0Discards a generated image and deletes its file.\xdaA\x04name\x82\xd3\xe4\x93\x024*2/meals/v1alpha1/{name=recipes/*/imageCandidates/*}\x12\xbd\x03\n" +
+	"\x1aSelectRecipeImageCandidate\x12<.api.meals.recipe.v1alpha1.SelectRecipeImageCandidateRequest\x1a=.api.meals.recipe.v1alpha1.SelectRecipeImageCandidateResponse\"\xa1\x02\x92A\xd2\x01\n" +
+	"\x1bRecipeImageCandidateService\x12\x1fSelect a recipe image candidate\x1a\x91\x01Resizes the generated image and makes it the cover image of its recipe, like an uploaded image. The other candidates of the recipe are discarded.\xdaA\x04name\x82\xd3\xe4\x93\x02>:\x01*\"9/meals/v1alpha1/{name=recipes/*/imageCandidates/*}:selectB\xee\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x19RecipeImageCandidateProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_goTypes = []any{
+	(*RecipeImageCandidate)(nil),               // 0: api.meals.recipe.v1alpha1.RecipeImageCandidate
+	(*ListRecipeImageCandidatesRequest)(nil),   // 1: api.meals.recipe.v1alpha1.ListRecipeImageCandidatesRequest
+	(*ListRecipeImageCandidatesResponse)(nil),  // 2: api.meals.recipe.v1alpha1.ListRecipeImageCandidatesResponse
+	(*GetRecipeImageCandidateRequest)(nil),     // 3: api.meals.recipe.v1alpha1.GetRecipeImageCandidateRequest
+	(*DeleteRecipeImageCandidateRequest)(nil),  // 4: api.meals.recipe.v1alpha1.DeleteRecipeImageCandidateRequest
+	(*SelectRecipeImageCandidateRequest)(nil),  // 5: api.meals.recipe.v1alpha1.SelectRecipeImageCandidateRequest
+	(*SelectRecipeImageCandidateResponse)(nil), // 6: api.meals.recipe.v1alpha1.SelectRecipeImageCandidateResponse
+	(*timestamppb.Timestamp)(nil),              // 7: google.protobuf.Timestamp
+	(*types.ImageSet)(nil),                     // 8: api.types.ImageSet
+}
+var file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_depIdxs = []int32{
+	7, // 0: api.meals.recipe.v1alpha1.RecipeImageCandidate.create_time:type_name -> google.protobuf.Timestamp
+	0, // 1: api.meals.recipe.v1alpha1.ListRecipeImageCandidatesResponse.recipe_image_candidates:type_name -> api.meals.recipe.v1alpha1.RecipeImageCandidate
+	8, // 2: api.meals.recipe.v1alpha1.SelectRecipeImageCandidateResponse.images:type_name -> api.types.ImageSet
+	1, // 3: api.meals.recipe.v1alpha1.RecipeImageCandidateService.ListRecipeImageCandidates:input_type -> api.meals.recipe.v1alpha1.ListRecipeImageCandidatesRequest
+	3, // 4: api.meals.recipe.v1alpha1.RecipeImageCandidateService.GetRecipeImageCandidate:input_type -> api.meals.recipe.v1alpha1.GetRecipeImageCandidateRequest
+	4, // 5: api.meals.recipe.v1alpha1.RecipeImageCandidateService.DeleteRecipeImageCandidate:input_type -> api.meals.recipe.v1alpha1.DeleteRecipeImageCandidateRequest
+	5, // 6: api.meals.recipe.v1alpha1.RecipeImageCandidateService.SelectRecipeImageCandidate:input_type -> api.meals.recipe.v1alpha1.SelectRecipeImageCandidateRequest
+	2, // 7: api.meals.recipe.v1alpha1.RecipeImageCandidateService.ListRecipeImageCandidates:output_type -> api.meals.recipe.v1alpha1.ListRecipeImageCandidatesResponse
+	0, // 8: api.meals.recipe.v1alpha1.RecipeImageCandidateService.GetRecipeImageCandidate:output_type -> api.meals.recipe.v1alpha1.RecipeImageCandidate
+	0, // 9: api.meals.recipe.v1alpha1.RecipeImageCandidateService.DeleteRecipeImageCandidate:output_type -> api.meals.recipe.v1alpha1.RecipeImageCandidate
+	6, // 10: api.meals.recipe.v1alpha1.RecipeImageCandidateService.SelectRecipeImageCandidate:output_type -> api.meals.recipe.v1alpha1.SelectRecipeImageCandidateResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_init() }
+func file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_init() {
+	if File_api_meals_recipe_v1alpha1_recipe_image_candidate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_depIdxs,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_recipe_image_candidate_proto = out.File
+	file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_recipe_image_candidate_proto_depIdxs = nil
+}
@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_image_candidate.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecipeImageCandidateService_ListRecipeImageCandidates_FullMethodName  = "/api.meals.recipe.v1alpha1.RecipeImageCandidateService/ListRecipeImageCandidates"
+	RecipeImageCandidateService_GetRecipeImageCandidate_FullMethodName    = "/api.meals.recipe.v1alpha1.RecipeImageCandidateService/GetRecipeImageCandidate"
+	RecipeImageCandidateService_DeleteRecipeImageCandidate_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeImageCandidateService/DeleteRecipeImageCandidate"
+	RecipeImageCandidateService_SelectRecipeImageCandidate_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeImageCandidateService/SelectRecipeImageCandidate"
+)
+
+// RecipeImageCandidateServiceClient is the client API for RecipeImageCandidateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the recipe image candidate service
+type RecipeImageCandidateServiceClient interface {
+	// list the generated image candidates of a recipe
+	ListRecipeImageCandidates(ctx context.Context, in *ListRecipeImageCandidatesRequest, opts ...grpc.CallOption) (*ListRecipeImageCandidatesResponse, error)
+	// get a generated image candidate of a recipe
+	GetRecipeImageCandidate(ctx context.Context, in *GetRecipeImageCandidateRequest, opts ...grpc.CallOption) (*RecipeImageCandidate, error)
+	// discard a generated image candidate of a recipe
+	DeleteRecipeImageCandidate(ctx context.Context, in *DeleteRecipeImageCandidateRequest, opts ...grpc.CallOption) (*RecipeImageCandidate, error)
+	// select a generated image candidate as the cover of its recipe
+	SelectRecipeImageCandidate(ctx context.Context, in *SelectRecipeImageCandidateRequest, opts ...grpc.CallOption) (*SelectRecipeImageCandidateResponse, error)
+}
+
+type recipeImageCandidateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeImageCandidateServiceClient(cc grpc.ClientConnInterface) RecipeImageCandidateServiceClient {
+	return &recipeImageCandidateServiceClient{cc}
+}
+
+func (c *recipeImageCandidateServiceClient) ListRecipeImageCandidates(ctx context.Context, in *ListRecipeImageCandidatesRequest, opts ...grpc.CallOption) (*ListRecipeImageCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeImageCandidatesResponse)
+	err := c.cc.Invoke(ctx, RecipeImageCandidateService_ListRecipeImageCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageCandidateServiceClient) GetRecipeImageCandidate(ctx context.Context, in *GetRecipeImageCandidateRequest, opts ...grpc.CallOption) (*RecipeImageCandidate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeImageCandidate)
+	err := c.cc.Invoke(ctx, RecipeImageCandidateService_GetRecipeImageCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageCandidateServiceClient) DeleteRecipeImageCandidate(ctx context.Context, in *DeleteRecipeImageCandidateRequest, opts ...grpc.CallOption) (*RecipeImageCandidate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeImageCandidate)
+	err := c.cc.Invoke(ctx, RecipeImageCandidateService_DeleteRecipeImageCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageCandidateServiceClient) SelectRecipeImageCandidate(ctx context.Context, in *SelectRecipeImageCandidateRequest, opts ...grpc.CallOption) (*SelectRecipeImageCandidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectRecipeImageCandidateResponse)
+	err := c.cc.Invoke(ctx, RecipeImageCandidateService_SelectRecipeImageCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeImageCandidateServiceServer is the server API for RecipeImageCandidateService service.
+// All implementations must embed UnimplementedRecipeImageCandidateServiceServer
+// for forward compatibility.
+//
+// the recipe image candidate service
+type RecipeImageCandidateServiceServer interface {
+	// list the generated image candidates of a recipe
+	ListRecipeImageCandidates(context.Context, *ListRecipeImageCandidatesRequest) (*ListRecipeImageCandidatesResponse, error)
+	// get a generated image candidate of a recipe
+	GetRecipeImageCandidate(context.Context, *GetRecipeImageCandidateRequest) (*RecipeImageCandidate, error)
+	// discard a generated image candidate of a recipe
+	DeleteRecipeImageCandidate(context.Context, *DeleteRecipeImageCandidateRequest) (*RecipeImageCandidate, error)
+	// select a generated image candidate as the cover of its recipe
+	SelectRecipeImageCandidate(context.Context, *SelectRecipeImageCandidateRequest) (*SelectRecipeImageCandidateResponse, error)
+	mustEmbedUnimplementedRecipeImageCandidateServiceServer()
+}
+
+// UnimplementedRecipeImageCandidateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeImageCandidateServiceServer struct{}
+
+func (UnimplementedRecipeImageCandidateServiceServer) ListRecipeImageCandidates(context.Context, *ListRecipeImageCandidatesRequest) (*ListRecipeImageCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeImageCandidates not implemented")
+}
+func (UnimplementedRecipeImageCandidateServiceServer) GetRecipeImageCandidate(context.Context, *GetRecipeImageCandidateRequest) (*RecipeImageCandidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeImageCandidate not implemented")
+}
+func (UnimplementedRecipeImageCandidateServiceServer) DeleteRecipeImageCandidate(context.Context, *DeleteRecipeImageCandidateRequest) (*RecipeImageCandidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipeImageCandidate not implemented")
+}
+func (UnimplementedRecipeImageCandidateServiceServer) SelectRecipeImageCandidate(context.Context, *SelectRecipeImageCandidateRequest) (*SelectRecipeImageCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectRecipeImageCandidate not implemented")
+}
+func (UnimplementedRecipeImageCandidateServiceServer) mustEmbedUnimplementedRecipeImageCandidateServiceServer() {
+}
+func (UnimplementedRecipeImageCandidateServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRecipeImageCandidateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeImageCandidateServiceServer will
+// result in compilation errors.
+type UnsafeRecipeImageCandidateServiceServer interface {
+	mustEmbedUnimplementedRecipeImageCandidateServiceServer()
+}
+
+func RegisterRecipeImageCandidateServiceServer(s grpc.ServiceRegistrar, srv RecipeImageCandidateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeImageCandidateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeImageCandidateService_ServiceDesc, srv)
+}
+
+func _RecipeImageCandidateService_ListRecipeImageCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeImageCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageCandidateServiceServer).ListRecipeImageCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageCandidateService_ListRecipeImageCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageCandidateServiceServer).ListRecipeImageCandidates(ctx, req.(*ListRecipeImageCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageCandidateService_GetRecipeImageCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeImageCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageCandidateServiceServer).GetRecipeImageCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageCandidateService_GetRecipeImageCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageCandidateServiceServer).GetRecipeImageCandidate(ctx, req.(*GetRecipeImageCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageCandidateService_DeleteRecipeImageCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipeImageCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageCandidateServiceServer).DeleteRecipeImageCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageCandidateService_DeleteRecipeImageCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageCandidateServiceServer).DeleteRecipeImageCandidate(ctx, req.(*DeleteRecipeImageCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageCandidateService_SelectRecipeImageCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectRecipeImageCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageCandidateServiceServer).SelectRecipeImageCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageCandidateService_SelectRecipeImageCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageCandidateServiceServer).SelectRecipeImageCandidate(ctx, req.(*SelectRecipeImageCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipeImageCandidateService_ServiceDesc is the grpc.ServiceDesc for RecipeImageCandidateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeImageCandidateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.RecipeImageCandidateService",
+	HandlerType: (*RecipeImageCandidateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRecipeImageCandidates",
+			Handler:    _RecipeImageCandidateService_ListRecipeImageCandidates_Handler,
+		},
+		{
+			MethodName: "GetRecipeImageCandidate",
+			Handler:    _RecipeImageCandidateService_GetRecipeImageCandidate_Handler,
+		},
+		{
+			MethodName: "DeleteRecipeImageCandidate",
+			Handler:    _RecipeImageCandidateService_DeleteRecipeImageCandidate_Handler,
+		},
+		{
+			MethodName: "SelectRecipeImageCandidate",
+			Handler:    _RecipeImageCandidateService_SelectRecipeImageCandidate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/recipe_image_candidate.proto",
+}
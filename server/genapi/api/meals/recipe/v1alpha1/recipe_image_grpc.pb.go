@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_image.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecipeImageService_ListRecipeImages_FullMethodName    = "/api.meals.recipe.v1alpha1.RecipeImageService/ListRecipeImages"
+	RecipeImageService_GetRecipeImage_FullMethodName      = "/api.meals.recipe.v1alpha1.RecipeImageService/GetRecipeImage"
+	RecipeImageService_UpdateRecipeImage_FullMethodName   = "/api.meals.recipe.v1alpha1.RecipeImageService/UpdateRecipeImage"
+	RecipeImageService_DeleteRecipeImage_FullMethodName   = "/api.meals.recipe.v1alpha1.RecipeImageService/DeleteRecipeImage"
+	RecipeImageService_ReorderRecipeImages_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeImageService/ReorderRecipeImages"
+	RecipeImageService_SetRecipeCoverImage_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeImageService/SetRecipeCoverImage"
+)
+
+// RecipeImageServiceClient is the client API for RecipeImageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the recipe image service
+type RecipeImageServiceClient interface {
+	// list the images of a recipe
+	ListRecipeImages(ctx context.Context, in *ListRecipeImagesRequest, opts ...grpc.CallOption) (*ListRecipeImagesResponse, error)
+	// get an image of a recipe
+	GetRecipeImage(ctx context.Context, in *GetRecipeImageRequest, opts ...grpc.CallOption) (*RecipeImage, error)
+	// update an image of a recipe
+	UpdateRecipeImage(ctx context.Context, in *UpdateRecipeImageRequest, opts ...grpc.CallOption) (*RecipeImage, error)
+	// delete an image of a recipe
+	DeleteRecipeImage(ctx context.Context, in *DeleteRecipeImageRequest, opts ...grpc.CallOption) (*RecipeImage, error)
+	// reorder the images of a recipe
+	ReorderRecipeImages(ctx context.Context, in *ReorderRecipeImagesRequest, opts ...grpc.CallOption) (*ReorderRecipeImagesResponse, error)
+	// make an image the cover image of its recipe
+	SetRecipeCoverImage(ctx context.Context, in *SetRecipeCoverImageRequest, opts ...grpc.CallOption) (*RecipeImage, error)
+}
+
+type recipeImageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeImageServiceClient(cc grpc.ClientConnInterface) RecipeImageServiceClient {
+	return &recipeImageServiceClient{cc}
+}
+
+func (c *recipeImageServiceClient) ListRecipeImages(ctx context.Context, in *ListRecipeImagesRequest, opts ...grpc.CallOption) (*ListRecipeImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeImagesResponse)
+	err := c.cc.Invoke(ctx, RecipeImageService_ListRecipeImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageServiceClient) GetRecipeImage(ctx context.Context, in *GetRecipeImageRequest, opts ...grpc.CallOption) (*RecipeImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeImage)
+	err := c.cc.Invoke(ctx, RecipeImageService_GetRecipeImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageServiceClient) UpdateRecipeImage(ctx context.Context, in *UpdateRecipeImageRequest, opts ...grpc.CallOption) (*RecipeImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeImage)
+	err := c.cc.Invoke(ctx, RecipeImageService_UpdateRecipeImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageServiceClient) DeleteRecipeImage(ctx context.Context, in *DeleteRecipeImageRequest, opts ...grpc.CallOption) (*RecipeImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeImage)
+	err := c.cc.Invoke(ctx, RecipeImageService_DeleteRecipeImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageServiceClient) ReorderRecipeImages(ctx context.Context, in *ReorderRecipeImagesRequest, opts ...grpc.CallOption) (*ReorderRecipeImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderRecipeImagesResponse)
+	err := c.cc.Invoke(ctx, RecipeImageService_ReorderRecipeImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImageServiceClient) SetRecipeCoverImage(ctx context.Context, in *SetRecipeCoverImageRequest, opts ...grpc.CallOption) (*RecipeImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeImage)
+	err := c.cc.Invoke(ctx, RecipeImageService_SetRecipeCoverImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeImageServiceServer is the server API for RecipeImageService service.
+// All implementations must embed UnimplementedRecipeImageServiceServer
+// for forward compatibility.
+//
+// the recipe image service
+type RecipeImageServiceServer interface {
+	// list the images of a recipe
+	ListRecipeImages(context.Context, *ListRecipeImagesRequest) (*ListRecipeImagesResponse, error)
+	// get an image of a recipe
+	GetRecipeImage(context.Context, *GetRecipeImageRequest) (*RecipeImage, error)
+	// update an image of a recipe
+	UpdateRecipeImage(context.Context, *UpdateRecipeImageRequest) (*RecipeImage, error)
+	// delete an image of a recipe
+	DeleteRecipeImage(context.Context, *DeleteRecipeImageRequest) (*RecipeImage, error)
+	// reorder the images of a recipe
+	ReorderRecipeImages(context.Context, *ReorderRecipeImagesRequest) (*ReorderRecipeImagesResponse, error)
+	// make an image the cover image of its recipe
+	SetRecipeCoverImage(context.Context, *SetRecipeCoverImageRequest) (*RecipeImage, error)
+	mustEmbedUnimplementedRecipeImageServiceServer()
+}
+
+// UnimplementedRecipeImageServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeImageServiceServer struct{}
+
+func (UnimplementedRecipeImageServiceServer) ListRecipeImages(context.Context, *ListRecipeImagesRequest) (*ListRecipeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeImages not implemented")
+}
+func (UnimplementedRecipeImageServiceServer) GetRecipeImage(context.Context, *GetRecipeImageRequest) (*RecipeImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeImage not implemented")
+}
+func (UnimplementedRecipeImageServiceServer) UpdateRecipeImage(context.Context, *UpdateRecipeImageRequest) (*RecipeImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipeImage not implemented")
+}
+func (UnimplementedRecipeImageServiceServer) DeleteRecipeImage(context.Context, *DeleteRecipeImageRequest) (*RecipeImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipeImage not implemented")
+}
+func (UnimplementedRecipeImageServiceServer) ReorderRecipeImages(context.Context, *ReorderRecipeImagesRequest) (*ReorderRecipeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderRecipeImages not implemented")
+}
+func (UnimplementedRecipeImageServiceServer) SetRecipeCoverImage(context.Context, *SetRecipeCoverImageRequest) (*RecipeImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecipeCoverImage not implemented")
+}
+func (UnimplementedRecipeImageServiceServer) mustEmbedUnimplementedRecipeImageServiceServer() {}
+func (UnimplementedRecipeImageServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeRecipeImageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeImageServiceServer will
+// result in compilation errors.
+type UnsafeRecipeImageServiceServer interface {
+	mustEmbedUnimplementedRecipeImageServiceServer()
+}
+
+func RegisterRecipeImageServiceServer(s grpc.ServiceRegistrar, srv RecipeImageServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeImageServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeImageService_ServiceDesc, srv)
+}
+
+func _RecipeImageService_ListRecipeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageServiceServer).ListRecipeImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageService_ListRecipeImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageServiceServer).ListRecipeImages(ctx, req.(*ListRecipeImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageService_GetRecipeImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageServiceServer).GetRecipeImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageService_GetRecipeImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageServiceServer).GetRecipeImage(ctx, req.(*GetRecipeImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageService_UpdateRecipeImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipeImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageServiceServer).UpdateRecipeImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageService_UpdateRecipeImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageServiceServer).UpdateRecipeImage(ctx, req.(*UpdateRecipeImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageService_DeleteRecipeImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipeImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageServiceServer).DeleteRecipeImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageService_DeleteRecipeImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageServiceServer).DeleteRecipeImage(ctx, req.(*DeleteRecipeImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageService_ReorderRecipeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRecipeImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageServiceServer).ReorderRecipeImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageService_ReorderRecipeImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageServiceServer).ReorderRecipeImages(ctx, req.(*ReorderRecipeImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImageService_SetRecipeCoverImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecipeCoverImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImageServiceServer).SetRecipeCoverImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImageService_SetRecipeCoverImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImageServiceServer).SetRecipeCoverImage(ctx, req.(*SetRecipeCoverImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipeImageService_ServiceDesc is the grpc.ServiceDesc for RecipeImageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeImageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.RecipeImageService",
+	HandlerType: (*RecipeImageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRecipeImages",
+			Handler:    _RecipeImageService_ListRecipeImages_Handler,
+		},
+		{
+			MethodName: "GetRecipeImage",
+			Handler:    _RecipeImageService_GetRecipeImage_Handler,
+		},
+		{
+			MethodName: "UpdateRecipeImage",
+			Handler:    _RecipeImageService_UpdateRecipeImage_Handler,
+		},
+		{
+			MethodName: "DeleteRecipeImage",
+			Handler:    _RecipeImageService_DeleteRecipeImage_Handler,
+		},
+		{
+			MethodName: "ReorderRecipeImages",
+			Handler:    _RecipeImageService_ReorderRecipeImages_Handler,
+		},
+		{
+			MethodName: "SetRecipeCoverImage",
+			Handler:    _RecipeImageService_SetRecipeCoverImage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/recipe_image.proto",
+}
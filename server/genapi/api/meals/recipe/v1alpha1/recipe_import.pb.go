@@ -0,0 +1,673 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_import.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// the recipe manager an import file was exported from
+type RecipeImport_Source int32
+
+const (
+	// the source is unspecified
+	RecipeImport_SOURCE_UNSPECIFIED RecipeImport_Source = 0
+	// a Paprika .paprikarecipes archive
+	RecipeImport_SOURCE_PAPRIKA RecipeImport_Source = 1
+	// a Mealie JSON export
+	RecipeImport_SOURCE_MEALIE RecipeImport_Source = 2
+	// a Meal-Master text file
+	RecipeImport_SOURCE_MEALMASTER RecipeImport_Source = 3
+	// a CSV file with a header row naming the columns title, description,
+	// ingredients, directions, yield, prep_time, cook_time, total_time,
+	// categories, cuisines, cooking_method, source and image_url
+	RecipeImport_SOURCE_CSV RecipeImport_Source = 4
+)
+
+// Enum value maps for RecipeImport_Source.
+var (
+	RecipeImport_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_PAPRIKA",
+		2: "SOURCE_MEALIE",
+		3: "SOURCE_MEALMASTER",
+		4: "SOURCE_CSV",
+	}
+	RecipeImport_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED": 0,
+		"SOURCE_PAPRIKA":     1,
+		"SOURCE_MEALIE":      2,
+		"SOURCE_MEALMASTER":  3,
+		"SOURCE_CSV":         4,
+	}
+)
+
+func (x RecipeImport_Source) Enum() *RecipeImport_Source {
+	p := new(RecipeImport_Source)
+	*p = x
+	return p
+}
+
+func (x RecipeImport_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipeImport_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes[0].Descriptor()
+}
+
+func (RecipeImport_Source) Type() protoreflect.EnumType {
+	return &file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes[0]
+}
+
+func (x RecipeImport_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipeImport_Source.Descriptor instead.
+func (RecipeImport_Source) EnumDescriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// the state of an import
+type RecipeImport_State int32
+
+const (
+	// the state is unspecified
+	RecipeImport_STATE_UNSPECIFIED RecipeImport_State = 0
+	// the import is running
+	RecipeImport_STATE_RUNNING RecipeImport_State = 1
+	// the import finished, individual recipes may still have failed
+	RecipeImport_STATE_SUCCEEDED RecipeImport_State = 2
+	// the file could not be imported
+	RecipeImport_STATE_FAILED RecipeImport_State = 3
+)
+
+// Enum value maps for RecipeImport_State.
+var (
+	RecipeImport_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_RUNNING",
+		2: "STATE_SUCCEEDED",
+		3: "STATE_FAILED",
+	}
+	RecipeImport_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_RUNNING":     1,
+		"STATE_SUCCEEDED":   2,
+		"STATE_FAILED":      3,
+	}
+)
+
+func (x RecipeImport_State) Enum() *RecipeImport_State {
+	p := new(RecipeImport_State)
+	*p = x
+	return p
+}
+
+func (x RecipeImport_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipeImport_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes[1].Descriptor()
+}
+
+func (RecipeImport_State) Type() protoreflect.EnumType {
+	return &file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes[1]
+}
+
+func (x RecipeImport_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipeImport_State.Descriptor instead.
+func (RecipeImport_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// the outcome of importing a recipe
+type RecipeImport_Outcome int32
+
+const (
+	// the status is unspecified
+	RecipeImport_OUTCOME_UNSPECIFIED RecipeImport_Outcome = 0
+	// the recipe was created
+	RecipeImport_OUTCOME_CREATED RecipeImport_Outcome = 1
+	// the recipe was skipped because it is likely a copy of a recipe the user
+	// already has, one that cites the same url or has a similar title and ingredients
+	RecipeImport_OUTCOME_DUPLICATE RecipeImport_Outcome = 2
+	// the recipe could not be imported
+	RecipeImport_OUTCOME_FAILED RecipeImport_Outcome = 3
+)
+
+// Enum value maps for RecipeImport_Outcome.
+var (
+	RecipeImport_Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_CREATED",
+		2: "OUTCOME_DUPLICATE",
+		3: "OUTCOME_FAILED",
+	}
+	RecipeImport_Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"OUTCOME_CREATED":     1,
+		"OUTCOME_DUPLICATE":   2,
+		"OUTCOME_FAILED":      3,
+	}
+)
+
+func (x RecipeImport_Outcome) Enum() *RecipeImport_Outcome {
+	p := new(RecipeImport_Outcome)
+	*p = x
+	return p
+}
+
+func (x RecipeImport_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipeImport_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes[2].Descriptor()
+}
+
+func (RecipeImport_Outcome) Type() protoreflect.EnumType {
+	return &file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes[2]
+}
+
+func (x RecipeImport_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipeImport_Outcome.Descriptor instead.
+func (RecipeImport_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{0, 2}
+}
+
+// an import of recipes from the export file of another recipe manager
+type RecipeImport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the import
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the recipe manager the file was exported from
+	Source RecipeImport_Source `protobuf:"varint,2,opt,name=source,proto3,enum=api.meals.recipe.v1alpha1.RecipeImport_Source" json:"source,omitempty"`
+	// the state of the import
+	State RecipeImport_State `protobuf:"varint,3,opt,name=state,proto3,enum=api.meals.recipe.v1alpha1.RecipeImport_State" json:"state,omitempty"`
+	// why the import failed, set when the file as a whole could not be read
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// the outcome of every recipe in the file
+	Results []*RecipeImport_Result `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	// the time the import was started (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the import finished (UTC)
+	CompleteTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeImport) Reset() {
+	*x = RecipeImport{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeImport) ProtoMessage() {}
+
+func (x *RecipeImport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeImport.ProtoReflect.Descriptor instead.
+func (*RecipeImport) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipeImport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeImport) GetSource() RecipeImport_Source {
+	if x != nil {
+		return x.Source
+	}
+	return RecipeImport_SOURCE_UNSPECIFIED
+}
+
+func (x *RecipeImport) GetState() RecipeImport_State {
+	if x != nil {
+		return x.State
+	}
+	return RecipeImport_STATE_UNSPECIFIED
+}
+
+func (x *RecipeImport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RecipeImport) GetResults() []*RecipeImport_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *RecipeImport) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RecipeImport) GetCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return nil
+}
+
+// the request to get a recipe import
+type GetRecipeImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the import
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeImportRequest) Reset() {
+	*x = GetRecipeImportRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeImportRequest) ProtoMessage() {}
+
+func (x *GetRecipeImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeImportRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeImportRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRecipeImportRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to list recipe imports
+type ListRecipeImportsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the user to list the imports of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeImportsRequest) Reset() {
+	*x = ListRecipeImportsRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeImportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeImportsRequest) ProtoMessage() {}
+
+func (x *ListRecipeImportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeImportsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeImportsRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecipeImportsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRecipeImportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecipeImportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list recipe imports
+type ListRecipeImportsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the imports, without their results
+	RecipeImports []*RecipeImport `protobuf:"bytes,1,rep,name=recipe_imports,json=recipeImports,proto3" json:"recipe_imports,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeImportsResponse) Reset() {
+	*x = ListRecipeImportsResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeImportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeImportsResponse) ProtoMessage() {}
+
+func (x *ListRecipeImportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeImportsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeImportsResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecipeImportsResponse) GetRecipeImports() []*RecipeImport {
+	if x != nil {
+		return x.RecipeImports
+	}
+	return nil
+}
+
+func (x *ListRecipeImportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the outcome of importing a recipe
+type RecipeImport_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the title of the recipe in the file
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the outcome of the recipe
+	Outcome RecipeImport_Outcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=api.meals.recipe.v1alpha1.RecipeImport_Outcome" json:"outcome,omitempty"`
+	// the recipe that was created, or the existing recipe for duplicates
+	Recipe string `protobuf:"bytes,3,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// why the recipe failed to import
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeImport_Result) Reset() {
+	*x = RecipeImport_Result{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeImport_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeImport_Result) ProtoMessage() {}
+
+func (x *RecipeImport_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeImport_Result.ProtoReflect.Descriptor instead.
+func (*RecipeImport_Result) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *RecipeImport_Result) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RecipeImport_Result) GetOutcome() RecipeImport_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return RecipeImport_OUTCOME_UNSPECIFIED
+}
+
+func (x *RecipeImport_Result) GetRecipe() string {
+	if x != nil {
+		return x.Recipe
+	}
+	return ""
+}
+
+func (x *RecipeImport_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_api_meals_recipe_v1alpha1_recipe_import_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDesc = "" +
+	"\n" +
+	"-api/meals/recipe/v1alpha1/recipe_import.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa7\b\n" +
+	"\fRecipeImport\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12K\n" +
+	"\x06source\x18\x02 \x01(\x0e2..api.meals.recipe.v1alpha1.RecipeImport.SourceB\x03\xe0A\x03R\x06source\x12H\n" +
+	"\x05state\x18\x03 \x01(\x0e2-.api.meals.recipe.v1alpha1.RecipeImport.StateB\x03\xe0A\x03R\x05state\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tB\x03\xe0A\x03R\x05error\x12M\n" +
+	"\aresults\x18\x05 \x03(\v2..api.meals.recipe.v1alpha1.RecipeImport.ResultB\x03\xe0A\x03R\aresults\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12D\n" +
+	"\rcomplete_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\fcompleteTime\x1a\xd0\x01\n" +
+	"\x06Result\x12\x19\n" +
+	"\x05title\x18\x01 \x01(\tB\x03\xe0A\x03R\x05title\x12N\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2/.api.meals.recipe.v1alpha1.RecipeImport.OutcomeB\x03\xe0A\x03R\aoutcome\x12@\n" +
+	"\x06recipe\x18\x03 \x01(\tB(\xe0A\x03\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06recipe\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tB\x03\xe0A\x03R\x05error\"n\n" +
+	"\x06Source\x12\x16\n" +
+	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSOURCE_PAPRIKA\x10\x01\x12\x11\n" +
+	"\rSOURCE_MEALIE\x10\x02\x12\x15\n" +
+	"\x11SOURCE_MEALMASTER\x10\x03\x12\x0e\n" +
+	"\n" +
+	"SOURCE_CSV\x10\x04\"X\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATE_RUNNING\x10\x01\x12\x13\n" +
+	"\x0fSTATE_SUCCEEDED\x10\x02\x12\x10\n" +
+	"\fSTATE_FAILED\x10\x03\"b\n" +
+	"\aOutcome\x12\x17\n" +
+	"\x13OUTCOME_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fOUTCOME_CREATED\x10\x01\x12\x15\n" +
+	"\x11OUTCOME_DUPLICATE\x10\x02\x12\x12\n" +
+	"\x0eOUTCOME_FAILED\x10\x03:t\xeaAq\n" +
+	"&api.meals.recipe.v1alpha1/RecipeImport\x12*users/{user}/recipeImports/{recipe_import}*\rrecipeImports2\frecipeImport\"\\\n" +
+	"\x16GetRecipeImportRequest\x12B\n" +
+	"\x04name\x18\x01 \x01(\tB.\xe0A\x02\xfaA(\n" +
+	"&api.meals.recipe.v1alpha1/RecipeImportR\x04name\"\x9e\x01\n" +
+	"\x18ListRecipeImportsRequest\x12<\n" +
+	"\x06parent\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x93\x01\n" +
+	"\x19ListRecipeImportsResponse\x12N\n" +
+	"\x0erecipe_imports\x18\x01 \x03(\v2'.api.meals.recipe.v1alpha1.RecipeImportR\rrecipeImports\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x85\x05\n" +
+	"\x13RecipeImportService\x12\xb2\x02\n" +
+	"\x0fGetRecipeImport\x121.api.meals.recipe.v1alpha1.GetRecipeImportRequest\x1a'.api.meals.recipe.v1alpha1.RecipeImport\"\xc2\x01\x92A\x81\x01\n" +
+	"\x13RecipeImportService\x12\x13Get a recipe import\x1aURetrieves a recipe import, including the report of every recipe in the imported file.\xdaA\x04name\x82\xd3\xe4\x93\x020\x12./meals/v1alpha1/{name=users/*/recipeImports/*}\x12\xb8\x02\n" +
+	"\x11ListRecipeImports\x123.api.meals.recipe.v1alpha1.ListRecipeImportsRequest\x1a4.api.meals.recipe.v1alpha1.ListRecipeImportsResponse\"\xb7\x01\x92Au\n" +
+	"\x13RecipeImportService\x12\x13List recipe imports\x1aIRetrieves a paginated list of the recipe imports of a user, newest first.\xdaA\x06parent\x82\xd3\xe4\x93\x020\x12./meals/v1alpha1/{parent=users/*}/recipeImportsB\xe6\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x11RecipeImportProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_meals_recipe_v1alpha1_recipe_import_proto_goTypes = []any{
+	(RecipeImport_Source)(0),          // 0: api.meals.recipe.v1alpha1.RecipeImport.Source
+	(RecipeImport_State)(0),           // 1: api.meals.recipe.v1alpha1.RecipeImport.State
+	(RecipeImport_Outcome)(0),         // 2: api.meals.recipe.v1alpha1.RecipeImport.Outcome
+	(*RecipeImport)(nil),              // 3: api.meals.recipe.v1alpha1.RecipeImport
+	(*GetRecipeImportRequest)(nil),    // 4: api.meals.recipe.v1alpha1.GetRecipeImportRequest
+	(*ListRecipeImportsRequest)(nil),  // 5: api.meals.recipe.v1alpha1.ListRecipeImportsRequest
+	(*ListRecipeImportsResponse)(nil), // 6: api.meals.recipe.v1alpha1.ListRecipeImportsResponse
+	(*RecipeImport_Result)(nil),       // 7: api.meals.recipe.v1alpha1.RecipeImport.Result
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_api_meals_recipe_v1alpha1_recipe_import_proto_depIdxs = []int32{
+	0, // 0: api.meals.recipe.v1alpha1.RecipeImport.source:type_name -> api.meals.recipe.v1alpha1.RecipeImport.Source
+	1, // 1: api.meals.recipe.v1alpha1.RecipeImport.state:type_name -> api.meals.recipe.v1alpha1.RecipeImport.State
+	7, // 2: api.meals.recipe.v1alpha1.RecipeImport.results:type_name -> api.meals.recipe.v1alpha1.RecipeImport.Result
+	8, // 3: api.meals.recipe.v1alpha1.RecipeImport.create_time:type_name -> google.protobuf.Timestamp
+	8, // 4: api.meals.recipe.v1alpha1.RecipeImport.complete_time:type_name -> google.protobuf.Timestamp
+	3, // 5: api.meals.recipe.v1alpha1.ListRecipeImportsResponse.recipe_imports:type_name -> api.meals.recipe.v1alpha1.RecipeImport
+	2, // 6: api.meals.recipe.v1alpha1.RecipeImport.Result.outcome:type_name -> api.meals.recipe.v1alpha1.RecipeImport.Outcome
+	4, // 7: api.meals.recipe.v1alpha1.RecipeImportService.GetRecipeImport:input_type -> api.meals.recipe.v1alpha1.GetRecipeImportRequest
+	5, // 8: api.meals.recipe.v1alpha1.RecipeImportService.ListRecipeImports:input_type -> api.meals.recipe.v1alpha1.ListRecipeImportsRequest
+	3, // 9: api.meals.recipe.v1alpha1.RecipeImportService.GetRecipeImport:output_type -> api.meals.recipe.v1alpha1.RecipeImport
+	6, // 10: api.meals.recipe.v1alpha1.RecipeImportService.ListRecipeImports:output_type -> api.meals.recipe.v1alpha1.ListRecipeImportsResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_recipe_import_proto_init() }
+func file_api_meals_recipe_v1alpha1_recipe_import_proto_init() {
+	if File_api_meals_recipe_v1alpha1_recipe_import_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_import_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_recipe_import_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_recipe_import_proto_depIdxs,
+		EnumInfos:         file_api_meals_recipe_v1alpha1_recipe_import_proto_enumTypes,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_recipe_import_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_recipe_import_proto = out.File
+	file_api_meals_recipe_v1alpha1_recipe_import_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_recipe_import_proto_depIdxs = nil
+}
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_import.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecipeImportService_GetRecipeImport_FullMethodName   = "/api.meals.recipe.v1alpha1.RecipeImportService/GetRecipeImport"
+	RecipeImportService_ListRecipeImports_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeImportService/ListRecipeImports"
+)
+
+// RecipeImportServiceClient is the client API for RecipeImportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the recipe import service. Imports are started by uploading an export file
+// to the files service at POST /files/meals/v1alpha1/recipes:import?source=
+// and run in the background; this service reports on their progress.
+type RecipeImportServiceClient interface {
+	// get a recipe import
+	GetRecipeImport(ctx context.Context, in *GetRecipeImportRequest, opts ...grpc.CallOption) (*RecipeImport, error)
+	// list recipe imports
+	ListRecipeImports(ctx context.Context, in *ListRecipeImportsRequest, opts ...grpc.CallOption) (*ListRecipeImportsResponse, error)
+}
+
+type recipeImportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeImportServiceClient(cc grpc.ClientConnInterface) RecipeImportServiceClient {
+	return &recipeImportServiceClient{cc}
+}
+
+func (c *recipeImportServiceClient) GetRecipeImport(ctx context.Context, in *GetRecipeImportRequest, opts ...grpc.CallOption) (*RecipeImport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeImport)
+	err := c.cc.Invoke(ctx, RecipeImportService_GetRecipeImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeImportServiceClient) ListRecipeImports(ctx context.Context, in *ListRecipeImportsRequest, opts ...grpc.CallOption) (*ListRecipeImportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeImportsResponse)
+	err := c.cc.Invoke(ctx, RecipeImportService_ListRecipeImports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeImportServiceServer is the server API for RecipeImportService service.
+// All implementations must embed UnimplementedRecipeImportServiceServer
+// for forward compatibility.
+//
+// the recipe import service. Imports are started by uploading an export file
+// to the files service at POST /files/meals/v1alpha1/recipes:import?source=
+// and run in the background; this service reports on their progress.
+type RecipeImportServiceServer interface {
+	// get a recipe import
+	GetRecipeImport(context.Context, *GetRecipeImportRequest) (*RecipeImport, error)
+	// list recipe imports
+	ListRecipeImports(context.Context, *ListRecipeImportsRequest) (*ListRecipeImportsResponse, error)
+	mustEmbedUnimplementedRecipeImportServiceServer()
+}
+
+// UnimplementedRecipeImportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeImportServiceServer struct{}
+
+func (UnimplementedRecipeImportServiceServer) GetRecipeImport(context.Context, *GetRecipeImportRequest) (*RecipeImport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeImport not implemented")
+}
+func (UnimplementedRecipeImportServiceServer) ListRecipeImports(context.Context, *ListRecipeImportsRequest) (*ListRecipeImportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeImports not implemented")
+}
+func (UnimplementedRecipeImportServiceServer) mustEmbedUnimplementedRecipeImportServiceServer() {}
+func (UnimplementedRecipeImportServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRecipeImportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeImportServiceServer will
+// result in compilation errors.
+type UnsafeRecipeImportServiceServer interface {
+	mustEmbedUnimplementedRecipeImportServiceServer()
+}
+
+func RegisterRecipeImportServiceServer(s grpc.ServiceRegistrar, srv RecipeImportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeImportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeImportService_ServiceDesc, srv)
+}
+
+func _RecipeImportService_GetRecipeImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImportServiceServer).GetRecipeImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImportService_GetRecipeImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImportServiceServer).GetRecipeImport(ctx, req.(*GetRecipeImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeImportService_ListRecipeImports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeImportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeImportServiceServer).ListRecipeImports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeImportService_ListRecipeImports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeImportServiceServer).ListRecipeImports(ctx, req.(*ListRecipeImportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipeImportService_ServiceDesc is the grpc.ServiceDesc for RecipeImportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeImportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.RecipeImportService",
+	HandlerType: (*RecipeImportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRecipeImport",
+			Handler:    _RecipeImportService_GetRecipeImport_Handler,
+		},
+		{
+			MethodName: "ListRecipeImports",
+			Handler:    _RecipeImportService_ListRecipeImports_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/recipe_import.proto",
+}
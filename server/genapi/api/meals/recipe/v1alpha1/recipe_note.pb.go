@@ -0,0 +1,696 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_note.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a private note of a user on a recipe, only visible to its author
+type RecipeNote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the note
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the text of the note, e.g. "use 375°F in our oven"
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// the ingredient the note is attached to, unset for notes on the whole recipe
+	Ingredient *RecipeNote_Ingredient `protobuf:"bytes,3,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	// the step the note is attached to, unset for notes on the whole recipe
+	Step *RecipeNote_DirectionStep `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
+	// the time the note was created (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the note was last updated (UTC)
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeNote) Reset() {
+	*x = RecipeNote{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeNote) ProtoMessage() {}
+
+func (x *RecipeNote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeNote.ProtoReflect.Descriptor instead.
+func (*RecipeNote) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipeNote) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeNote) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RecipeNote) GetIngredient() *RecipeNote_Ingredient {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *RecipeNote) GetStep() *RecipeNote_DirectionStep {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *RecipeNote) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RecipeNote) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// the request to create a recipe note
+type CreateRecipeNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to attach the note to
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the note to create
+	RecipeNote    *RecipeNote `protobuf:"bytes,2,opt,name=recipe_note,json=recipeNote,proto3" json:"recipe_note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecipeNoteRequest) Reset() {
+	*x = CreateRecipeNoteRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecipeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecipeNoteRequest) ProtoMessage() {}
+
+func (x *CreateRecipeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecipeNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecipeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRecipeNoteRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateRecipeNoteRequest) GetRecipeNote() *RecipeNote {
+	if x != nil {
+		return x.RecipeNote
+	}
+	return nil
+}
+
+// the request to list recipe notes
+type ListRecipeNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to list the notes of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeNotesRequest) Reset() {
+	*x = ListRecipeNotesRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeNotesRequest) ProtoMessage() {}
+
+func (x *ListRecipeNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecipeNotesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRecipeNotesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecipeNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list recipe notes
+type ListRecipeNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the notes
+	RecipeNotes []*RecipeNote `protobuf:"bytes,1,rep,name=recipe_notes,json=recipeNotes,proto3" json:"recipe_notes,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeNotesResponse) Reset() {
+	*x = ListRecipeNotesResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeNotesResponse) ProtoMessage() {}
+
+func (x *ListRecipeNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecipeNotesResponse) GetRecipeNotes() []*RecipeNote {
+	if x != nil {
+		return x.RecipeNotes
+	}
+	return nil
+}
+
+func (x *ListRecipeNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to get a recipe note
+type GetRecipeNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the note
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeNoteRequest) Reset() {
+	*x = GetRecipeNoteRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeNoteRequest) ProtoMessage() {}
+
+func (x *GetRecipeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeNoteRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRecipeNoteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to update a recipe note
+type UpdateRecipeNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the note to update
+	RecipeNote *RecipeNote `protobuf:"bytes,1,opt,name=recipe_note,json=recipeNote,proto3" json:"recipe_note,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecipeNoteRequest) Reset() {
+	*x = UpdateRecipeNoteRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecipeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecipeNoteRequest) ProtoMessage() {}
+
+func (x *UpdateRecipeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecipeNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRecipeNoteRequest) GetRecipeNote() *RecipeNote {
+	if x != nil {
+		return x.RecipeNote
+	}
+	return nil
+}
+
+func (x *UpdateRecipeNoteRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to delete a recipe note
+type DeleteRecipeNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the note
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipeNoteRequest) Reset() {
+	*x = DeleteRecipeNoteRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipeNoteRequest) ProtoMessage() {}
+
+func (x *DeleteRecipeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipeNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecipeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRecipeNoteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// an ingredient of the recipe
+type RecipeNote_Ingredient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the index of the ingredient group
+	GroupIndex int32 `protobuf:"varint,1,opt,name=group_index,json=groupIndex,proto3" json:"group_index,omitempty"`
+	// the index of the ingredient within the group
+	IngredientIndex int32 `protobuf:"varint,2,opt,name=ingredient_index,json=ingredientIndex,proto3" json:"ingredient_index,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecipeNote_Ingredient) Reset() {
+	*x = RecipeNote_Ingredient{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeNote_Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeNote_Ingredient) ProtoMessage() {}
+
+func (x *RecipeNote_Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeNote_Ingredient.ProtoReflect.Descriptor instead.
+func (*RecipeNote_Ingredient) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *RecipeNote_Ingredient) GetGroupIndex() int32 {
+	if x != nil {
+		return x.GroupIndex
+	}
+	return 0
+}
+
+func (x *RecipeNote_Ingredient) GetIngredientIndex() int32 {
+	if x != nil {
+		return x.IngredientIndex
+	}
+	return 0
+}
+
+// a direction step of the recipe
+type RecipeNote_DirectionStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the index of the direction in the recipe directions
+	DirectionIndex int32 `protobuf:"varint,1,opt,name=direction_index,json=directionIndex,proto3" json:"direction_index,omitempty"`
+	// the index of the step in the direction steps
+	StepIndex     int32 `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeNote_DirectionStep) Reset() {
+	*x = RecipeNote_DirectionStep{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeNote_DirectionStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeNote_DirectionStep) ProtoMessage() {}
+
+func (x *RecipeNote_DirectionStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeNote_DirectionStep.ProtoReflect.Descriptor instead.
+func (*RecipeNote_DirectionStep) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *RecipeNote_DirectionStep) GetDirectionIndex() int32 {
+	if x != nil {
+		return x.DirectionIndex
+	}
+	return 0
+}
+
+func (x *RecipeNote_DirectionStep) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+var File_api_meals_recipe_v1alpha1_recipe_note_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDesc = "" +
+	"\n" +
+	"+api/meals/recipe/v1alpha1/recipe_note.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x91\x05\n" +
+	"\n" +
+	"RecipeNote\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x17\n" +
+	"\x04text\x18\x02 \x01(\tB\x03\xe0A\x02R\x04text\x12U\n" +
+	"\n" +
+	"ingredient\x18\x03 \x01(\v20.api.meals.recipe.v1alpha1.RecipeNote.IngredientB\x03\xe0A\x01R\n" +
+	"ingredient\x12L\n" +
+	"\x04step\x18\x04 \x01(\v23.api.meals.recipe.v1alpha1.RecipeNote.DirectionStepB\x03\xe0A\x01R\x04step\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x1ab\n" +
+	"\n" +
+	"Ingredient\x12$\n" +
+	"\vgroup_index\x18\x01 \x01(\x05B\x03\xe0A\x02R\n" +
+	"groupIndex\x12.\n" +
+	"\x10ingredient_index\x18\x02 \x01(\x05B\x03\xe0A\x02R\x0fingredientIndex\x1aa\n" +
+	"\rDirectionStep\x12,\n" +
+	"\x0fdirection_index\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0edirectionIndex\x12\"\n" +
+	"\n" +
+	"step_index\x18\x02 \x01(\x05B\x03\xe0A\x02R\tstepIndex:a\xeaA^\n" +
+	"$api.meals.recipe.v1alpha1/RecipeNote\x12\x1drecipes/{recipe}/notes/{note}*\vrecipeNotes2\n" +
+	"recipeNote\"\xa8\x01\n" +
+	"\x17CreateRecipeNoteRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12K\n" +
+	"\vrecipe_note\x18\x02 \x01(\v2%.api.meals.recipe.v1alpha1.RecipeNoteB\x03\xe0A\x02R\n" +
+	"recipeNote\"\xa0\x01\n" +
+	"\x16ListRecipeNotesRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8b\x01\n" +
+	"\x17ListRecipeNotesResponse\x12H\n" +
+	"\frecipe_notes\x18\x01 \x03(\v2%.api.meals.recipe.v1alpha1.RecipeNoteR\vrecipeNotes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"X\n" +
+	"\x14GetRecipeNoteRequest\x12@\n" +
+	"\x04name\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.recipe.v1alpha1/RecipeNoteR\x04name\"\xa8\x01\n" +
+	"\x17UpdateRecipeNoteRequest\x12K\n" +
+	"\vrecipe_note\x18\x01 \x01(\v2%.api.meals.recipe.v1alpha1.RecipeNoteB\x03\xe0A\x02R\n" +
+	"recipeNote\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"[\n" +
+	"\x17DeleteRecipeNoteRequest\x12@\n" +
+	"\x04name\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$api.meals.recipe.v1alpha1/RecipeNoteR\x04name2\xfe\v\n" +
+	"\x11RecipeNoteService\x12\xea\x02\n" +
+	"\x10CreateRecipeNote\x122.api.meals.recipe.v1alpha1.CreateRecipeNoteRequest\x1a%.api.meals.recipe.v1alpha1.RecipeNote\"\xfa\x01\x92A\xa4\x01\n" +
+	"\x11RecipeNoteService\x12\x14Create a recipe note\x1ayCreates a private note of the caller on a recipe they can read, optionally attached to an ingredient or a direction step.\xdaA\x12parent,recipe_note\x82\xd3\xe4\x93\x027:\vrecipe_note\"(/meals/v1alpha1/{parent=recipes/*}/notes\x12\xa9\x02\n" +
+	"\x0fListRecipeNotes\x121.api.meals.recipe.v1alpha1.ListRecipeNotesRequest\x1a2.api.meals.recipe.v1alpha1.ListRecipeNotesResponse\"\xae\x01\x92Ar\n" +
+	"\x11RecipeNoteService\x12\x11List recipe notes\x1aJRetrieves a paginated list of the private notes of the caller on a recipe.\xdaA\x06parent\x82\xd3\xe4\x93\x02*\x12(/meals/v1alpha1/{parent=recipes/*}/notes\x12\x8b\x02\n" +
+	"\rGetRecipeNote\x12/.api.meals.recipe.v1alpha1.GetRecipeNoteRequest\x1a%.api.meals.recipe.v1alpha1.RecipeNote\"\xa1\x01\x92Ag\n" +
+	"\x11RecipeNoteService\x12\x11Get a recipe note\x1a?Retrieves a single private note of the caller by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02*\x12(/meals/v1alpha1/{name=recipes/*/notes/*}\x12\xc4\x02\n" +
+	"\x10UpdateRecipeNote\x122.api.meals.recipe.v1alpha1.UpdateRecipeNoteRequest\x1a%.api.meals.recipe.v1alpha1.RecipeNote\"\xd4\x01\x92An\n" +
+	"\x11RecipeNoteService\x12\x14Update a recipe note\x1aCUpdates the text or the attachment of a private note of the caller.\xdaA\x17recipe_note,update_mask\x82\xd3\xe4\x93\x02C:\vrecipe_note24/meals/v1alpha1/{recipe_note.name=recipes/*/notes/*}\x12\xfa\x01\n" +
+	"\x10DeleteRecipeNote\x122.api.meals.recipe.v1alpha1.DeleteRecipeNoteRequest\x1a%.api.meals.recipe.v1alpha1.RecipeNote\"\x8a\x01\x92AP\n" +
+	"\x11RecipeNoteService\x12\x14Delete a recipe note\x1a%Deletes a private note of the caller.\xdaA\x04name\x82\xd3\xe4\x93\x02**(/meals/v1alpha1/{name=recipes/*/notes/*}B\xe4\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x0fRecipeNoteProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_meals_recipe_v1alpha1_recipe_note_proto_goTypes = []any{
+	(*RecipeNote)(nil),               // 0: api.meals.recipe.v1alpha1.RecipeNote
+	(*CreateRecipeNoteRequest)(nil),  // 1: api.meals.recipe.v1alpha1.CreateRecipeNoteRequest
+	(*ListRecipeNotesRequest)(nil),   // 2: api.meals.recipe.v1alpha1.ListRecipeNotesRequest
+	(*ListRecipeNotesResponse)(nil),  // 3: api.meals.recipe.v1alpha1.ListRecipeNotesResponse
+	(*GetRecipeNoteRequest)(nil),     // 4: api.meals.recipe.v1alpha1.GetRecipeNoteRequest
+	(*UpdateRecipeNoteRequest)(nil),  // 5: api.meals.recipe.v1alpha1.UpdateRecipeNoteRequest
+	(*DeleteRecipeNoteRequest)(nil),  // 6: api.meals.recipe.v1alpha1.DeleteRecipeNoteRequest
+	(*RecipeNote_Ingredient)(nil),    // 7: api.meals.recipe.v1alpha1.RecipeNote.Ingredient
+	(*RecipeNote_DirectionStep)(nil), // 8: api.meals.recipe.v1alpha1.RecipeNote.DirectionStep
+	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),    // 10: google.protobuf.FieldMask
+}
+var file_api_meals_recipe_v1alpha1_recipe_note_proto_depIdxs = []int32{
+	7,  // 0: api.meals.recipe.v1alpha1.RecipeNote.ingredient:type_name -> api.meals.recipe.v1alpha1.RecipeNote.Ingredient
+	8,  // 1: api.meals.recipe.v1alpha1.RecipeNote.step:type_name -> api.meals.recipe.v1alpha1.RecipeNote.DirectionStep
+	9,  // 2: api.meals.recipe.v1alpha1.RecipeNote.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 3: api.meals.recipe.v1alpha1.RecipeNote.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: api.meals.recipe.v1alpha1.CreateRecipeNoteRequest.recipe_note:type_name -> api.meals.recipe.v1alpha1.RecipeNote
+	0,  // 5: api.meals.recipe.v1alpha1.ListRecipeNotesResponse.recipe_notes:type_name -> api.meals.recipe.v1alpha1.RecipeNote
+	0,  // 6: api.meals.recipe.v1alpha1.UpdateRecipeNoteRequest.recipe_note:type_name -> api.meals.recipe.v1alpha1.RecipeNote
+	10, // 7: api.meals.recipe.v1alpha1.UpdateRecipeNoteRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: api.meals.recipe.v1alpha1.RecipeNoteService.CreateRecipeNote:input_type -> api.meals.recipe.v1alpha1.CreateRecipeNoteRequest
+	2,  // 9: api.meals.recipe.v1alpha1.RecipeNoteService.ListRecipeNotes:input_type -> api.meals.recipe.v1alpha1.ListRecipeNotesRequest
+	4,  // 10: api.meals.recipe.v1alpha1.RecipeNoteService.GetRecipeNote:input_type -> api.meals.recipe.v1alpha1.GetRecipeNoteRequest
+	5,  // 11: api.meals.recipe.v1alpha1.RecipeNoteService.UpdateRecipeNote:input_type -> api.meals.recipe.v1alpha1.UpdateRecipeNoteRequest
+	6,  // 12: api.meals.recipe.v1alpha1.RecipeNoteService.DeleteRecipeNote:input_type -> api.meals.recipe.v1alpha1.DeleteRecipeNoteRequest
+	0,  // 13: api.meals.recipe.v1alpha1.RecipeNoteService.CreateRecipeNote:output_type -> api.meals.recipe.v1alpha1.RecipeNote
+	3,  // 14: api.meals.recipe.v1alpha1.RecipeNoteService.ListRecipeNotes:output_type -> api.meals.recipe.v1alpha1.ListRecipeNotesResponse
+	0,  // 15: api.meals.recipe.v1alpha1.RecipeNoteService.GetRecipeNote:output_type -> api.meals.recipe.v1alpha1.RecipeNote
+	0,  // 16: api.meals.recipe.v1alpha1.RecipeNoteService.UpdateRecipeNote:output_type -> api.meals.recipe.v1alpha1.RecipeNote
+	0,  // 17: api.meals.recipe.v1alpha1.RecipeNoteService.DeleteRecipeNote:output_type -> api.meals.recipe.v1alpha1.RecipeNote
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_recipe_note_proto_init() }
+func file_api_meals_recipe_v1alpha1_recipe_note_proto_init() {
+	if File_api_meals_recipe_v1alpha1_recipe_note_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_note_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_recipe_note_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_recipe_note_proto_depIdxs,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_recipe_note_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_recipe_note_proto = out.File
+	file_api_meals_recipe_v1alpha1_recipe_note_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_recipe_note_proto_depIdxs = nil
+}
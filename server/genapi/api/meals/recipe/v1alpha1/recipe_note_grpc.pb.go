@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_note.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecipeNoteService_CreateRecipeNote_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeNoteService/CreateRecipeNote"
+	RecipeNoteService_ListRecipeNotes_FullMethodName  = "/api.meals.recipe.v1alpha1.RecipeNoteService/ListRecipeNotes"
+	RecipeNoteService_GetRecipeNote_FullMethodName    = "/api.meals.recipe.v1alpha1.RecipeNoteService/GetRecipeNote"
+	RecipeNoteService_UpdateRecipeNote_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeNoteService/UpdateRecipeNote"
+	RecipeNoteService_DeleteRecipeNote_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeNoteService/DeleteRecipeNote"
+)
+
+// RecipeNoteServiceClient is the client API for RecipeNoteService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the recipe note service
+type RecipeNoteServiceClient interface {
+	// create a private note on a recipe
+	CreateRecipeNote(ctx context.Context, in *CreateRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error)
+	// list the private notes of the caller on a recipe
+	ListRecipeNotes(ctx context.Context, in *ListRecipeNotesRequest, opts ...grpc.CallOption) (*ListRecipeNotesResponse, error)
+	// get a private note of the caller
+	GetRecipeNote(ctx context.Context, in *GetRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error)
+	// update a private note of the caller
+	UpdateRecipeNote(ctx context.Context, in *UpdateRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error)
+	// delete a private note of the caller
+	DeleteRecipeNote(ctx context.Context, in *DeleteRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error)
+}
+
+type recipeNoteServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeNoteServiceClient(cc grpc.ClientConnInterface) RecipeNoteServiceClient {
+	return &recipeNoteServiceClient{cc}
+}
+
+func (c *recipeNoteServiceClient) CreateRecipeNote(ctx context.Context, in *CreateRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeNote)
+	err := c.cc.Invoke(ctx, RecipeNoteService_CreateRecipeNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeNoteServiceClient) ListRecipeNotes(ctx context.Context, in *ListRecipeNotesRequest, opts ...grpc.CallOption) (*ListRecipeNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeNotesResponse)
+	err := c.cc.Invoke(ctx, RecipeNoteService_ListRecipeNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeNoteServiceClient) GetRecipeNote(ctx context.Context, in *GetRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeNote)
+	err := c.cc.Invoke(ctx, RecipeNoteService_GetRecipeNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeNoteServiceClient) UpdateRecipeNote(ctx context.Context, in *UpdateRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeNote)
+	err := c.cc.Invoke(ctx, RecipeNoteService_UpdateRecipeNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeNoteServiceClient) DeleteRecipeNote(ctx context.Context, in *DeleteRecipeNoteRequest, opts ...grpc.CallOption) (*RecipeNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeNote)
+	err := c.cc.Invoke(ctx, RecipeNoteService_DeleteRecipeNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeNoteServiceServer is the server API for RecipeNoteService service.
+// All implementations must embed UnimplementedRecipeNoteServiceServer
+// for forward compatibility.
+//
+// the recipe note service
+type RecipeNoteServiceServer interface {
+	// create a private note on a recipe
+	CreateRecipeNote(context.Context, *CreateRecipeNoteRequest) (*RecipeNote, error)
+	// list the private notes of the caller on a recipe
+	ListRecipeNotes(context.Context, *ListRecipeNotesRequest) (*ListRecipeNotesResponse, error)
+	// get a private note of the caller
+	GetRecipeNote(context.Context, *GetRecipeNoteRequest) (*RecipeNote, error)
+	// update a private note of the caller
+	UpdateRecipeNote(context.Context, *UpdateRecipeNoteRequest) (*RecipeNote, error)
+	// delete a private note of the caller
+	DeleteRecipeNote(context.Context, *DeleteRecipeNoteRequest) (*RecipeNote, error)
+	mustEmbedUnimplementedRecipeNoteServiceServer()
+}
+
+// UnimplementedRecipeNoteServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeNoteServiceServer struct{}
+
+func (UnimplementedRecipeNoteServiceServer) CreateRecipeNote(context.Context, *CreateRecipeNoteRequest) (*RecipeNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipeNote not implemented")
+}
+func (UnimplementedRecipeNoteServiceServer) ListRecipeNotes(context.Context, *ListRecipeNotesRequest) (*ListRecipeNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeNotes not implemented")
+}
+func (UnimplementedRecipeNoteServiceServer) GetRecipeNote(context.Context, *GetRecipeNoteRequest) (*RecipeNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeNote not implemented")
+}
+func (UnimplementedRecipeNoteServiceServer) UpdateRecipeNote(context.Context, *UpdateRecipeNoteRequest) (*RecipeNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipeNote not implemented")
+}
+func (UnimplementedRecipeNoteServiceServer) DeleteRecipeNote(context.Context, *DeleteRecipeNoteRequest) (*RecipeNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipeNote not implemented")
+}
+func (UnimplementedRecipeNoteServiceServer) mustEmbedUnimplementedRecipeNoteServiceServer() {}
+func (UnimplementedRecipeNoteServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeRecipeNoteServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeNoteServiceServer will
+// result in compilation errors.
+type UnsafeRecipeNoteServiceServer interface {
+	mustEmbedUnimplementedRecipeNoteServiceServer()
+}
+
+func RegisterRecipeNoteServiceServer(s grpc.ServiceRegistrar, srv RecipeNoteServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeNoteServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeNoteService_ServiceDesc, srv)
+}
+
+func _RecipeNoteService_CreateRecipeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecipeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeNoteServiceServer).CreateRecipeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeNoteService_CreateRecipeNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeNoteServiceServer).CreateRecipeNote(ctx, req.(*CreateRecipeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeNoteService_ListRecipeNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeNoteServiceServer).ListRecipeNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeNoteService_ListRecipeNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeNoteServiceServer).ListRecipeNotes(ctx, req.(*ListRecipeNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeNoteService_GetRecipeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeNoteServiceServer).GetRecipeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeNoteService_GetRecipeNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeNoteServiceServer).GetRecipeNote(ctx, req.(*GetRecipeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeNoteService_UpdateRecipeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeNoteServiceServer).UpdateRecipeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeNoteService_UpdateRecipeNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeNoteServiceServer).UpdateRecipeNote(ctx, req.(*UpdateRecipeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeNoteService_DeleteRecipeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeNoteServiceServer).DeleteRecipeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeNoteService_DeleteRecipeNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeNoteServiceServer).DeleteRecipeNote(ctx, req.(*DeleteRecipeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipeNoteService_ServiceDesc is the grpc.ServiceDesc for RecipeNoteService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeNoteService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.RecipeNoteService",
+	HandlerType: (*RecipeNoteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecipeNote",
+			Handler:    _RecipeNoteService_CreateRecipeNote_Handler,
+		},
+		{
+			MethodName: "ListRecipeNotes",
+			Handler:    _RecipeNoteService_ListRecipeNotes_Handler,
+		},
+		{
+			MethodName: "GetRecipeNote",
+			Handler:    _RecipeNoteService_GetRecipeNote_Handler,
+		},
+		{
+			MethodName: "UpdateRecipeNote",
+			Handler:    _RecipeNoteService_UpdateRecipeNote_Handler,
+		},
+		{
+			MethodName: "DeleteRecipeNote",
+			Handler:    _RecipeNoteService_DeleteRecipeNote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/recipe_note.proto",
+}
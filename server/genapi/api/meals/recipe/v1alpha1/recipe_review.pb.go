@@ -0,0 +1,568 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_review.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a user's rating and review of a recipe
+type RecipeReview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the review
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the user who wrote the review
+	Author string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	// the rating, from 1 to 5
+	Rating int32 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// the text of the review
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// the time the review was created (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the review was last updated (UTC)
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeReview) Reset() {
+	*x = RecipeReview{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeReview) ProtoMessage() {}
+
+func (x *RecipeReview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeReview.ProtoReflect.Descriptor instead.
+func (*RecipeReview) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipeReview) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeReview) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *RecipeReview) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RecipeReview) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RecipeReview) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RecipeReview) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// the request to create a recipe review
+type CreateRecipeReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to review
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the review to create
+	RecipeReview  *RecipeReview `protobuf:"bytes,2,opt,name=recipe_review,json=recipeReview,proto3" json:"recipe_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecipeReviewRequest) Reset() {
+	*x = CreateRecipeReviewRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecipeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecipeReviewRequest) ProtoMessage() {}
+
+func (x *CreateRecipeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecipeReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecipeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRecipeReviewRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateRecipeReviewRequest) GetRecipeReview() *RecipeReview {
+	if x != nil {
+		return x.RecipeReview
+	}
+	return nil
+}
+
+// the request to list recipe reviews
+type ListRecipeReviewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to list the reviews of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeReviewsRequest) Reset() {
+	*x = ListRecipeReviewsRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeReviewsRequest) ProtoMessage() {}
+
+func (x *ListRecipeReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecipeReviewsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRecipeReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecipeReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list recipe reviews
+type ListRecipeReviewsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the reviews
+	RecipeReviews []*RecipeReview `protobuf:"bytes,1,rep,name=recipe_reviews,json=recipeReviews,proto3" json:"recipe_reviews,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeReviewsResponse) Reset() {
+	*x = ListRecipeReviewsResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeReviewsResponse) ProtoMessage() {}
+
+func (x *ListRecipeReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecipeReviewsResponse) GetRecipeReviews() []*RecipeReview {
+	if x != nil {
+		return x.RecipeReviews
+	}
+	return nil
+}
+
+func (x *ListRecipeReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to get a recipe review
+type GetRecipeReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the review
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeReviewRequest) Reset() {
+	*x = GetRecipeReviewRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeReviewRequest) ProtoMessage() {}
+
+func (x *GetRecipeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRecipeReviewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to update a recipe review
+type UpdateRecipeReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the review to update
+	RecipeReview *RecipeReview `protobuf:"bytes,1,opt,name=recipe_review,json=recipeReview,proto3" json:"recipe_review,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecipeReviewRequest) Reset() {
+	*x = UpdateRecipeReviewRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecipeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecipeReviewRequest) ProtoMessage() {}
+
+func (x *UpdateRecipeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecipeReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRecipeReviewRequest) GetRecipeReview() *RecipeReview {
+	if x != nil {
+		return x.RecipeReview
+	}
+	return nil
+}
+
+func (x *UpdateRecipeReviewRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to delete a recipe review
+type DeleteRecipeReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the review
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipeReviewRequest) Reset() {
+	*x = DeleteRecipeReviewRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipeReviewRequest) ProtoMessage() {}
+
+func (x *DeleteRecipeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipeReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecipeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRecipeReviewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_meals_recipe_v1alpha1_recipe_review_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDesc = "" +
+	"\n" +
+	"-api/meals/recipe/v1alpha1/recipe_review.proto\x12\x19api.meals.recipe.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x8c\x03\n" +
+	"\fRecipeReview\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12<\n" +
+	"\x06author\x18\x02 \x01(\tB$\xe0A\x03\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\x06author\x12\x1b\n" +
+	"\x06rating\x18\x03 \x01(\x05B\x03\xe0A\x02R\x06rating\x12\x17\n" +
+	"\x04text\x18\x04 \x01(\tB\x03\xe0A\x01R\x04text\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime:k\xeaAh\n" +
+	"&api.meals.recipe.v1alpha1/RecipeReview\x12!recipes/{recipe}/reviews/{review}*\rrecipeReviews2\frecipeReview\"\xb0\x01\n" +
+	"\x19CreateRecipeReviewRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12Q\n" +
+	"\rrecipe_review\x18\x02 \x01(\v2'.api.meals.recipe.v1alpha1.RecipeReviewB\x03\xe0A\x02R\frecipeReview\"\xa2\x01\n" +
+	"\x18ListRecipeReviewsRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x93\x01\n" +
+	"\x19ListRecipeReviewsResponse\x12N\n" +
+	"\x0erecipe_reviews\x18\x01 \x03(\v2'.api.meals.recipe.v1alpha1.RecipeReviewR\rrecipeReviews\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\\\n" +
+	"\x16GetRecipeReviewRequest\x12B\n" +
+	"\x04name\x18\x01 \x01(\tB.\xe0A\x02\xfaA(\n" +
+	"&api.meals.recipe.v1alpha1/RecipeReviewR\x04name\"\xb0\x01\n" +
+	"\x19UpdateRecipeReviewRequest\x12Q\n" +
+	"\rrecipe_review\x18\x01 \x01(\v2'.api.meals.recipe.v1alpha1.RecipeReviewB\x03\xe0A\x02R\frecipeReview\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"_\n" +
+	"\x19DeleteRecipeReviewRequest\x12B\n" +
+	"\x04name\x18\x01 \x01(\tB.\xe0A\x02\xfaA(\n" +
+	"&api.meals.recipe.v1alpha1/RecipeReviewR\x04name2\xc5\f\n" +
+	"\x13RecipeReviewService\x12\xdd\x02\n" +
+	"\x12CreateRecipeReview\x124.api.meals.recipe.v1alpha1.CreateRecipeReviewRequest\x1a'.api.meals.recipe.v1alpha1.RecipeReview\"\xe7\x01\x92A\x8b\x01\n" +
+	"\x13RecipeReviewService\x12\x16Create a recipe review\x1a\\Rates a recipe from 1 to 5 with an optional text review. Each user can review a recipe once.\xdaA\x14parent,recipe_review\x82\xd3\xe4\x93\x02;:\rrecipe_review\"*/meals/v1alpha1/{parent=recipes/*}/reviews\x12\xaf\x02\n" +
+	"\x11ListRecipeReviews\x123.api.meals.recipe.v1alpha1.ListRecipeReviewsRequest\x1a4.api.meals.recipe.v1alpha1.ListRecipeReviewsResponse\"\xae\x01\x92Ap\n" +
+	"\x13RecipeReviewService\x12\x13List recipe reviews\x1aDRetrieves a paginated list of the reviews of a recipe, newest first.\xdaA\x06parent\x82\xd3\xe4\x93\x02,\x12*/meals/v1alpha1/{parent=recipes/*}/reviews\x12\x8a\x02\n" +
+	"\x0fGetRecipeReview\x121.api.meals.recipe.v1alpha1.GetRecipeReviewRequest\x1a'.api.meals.recipe.v1alpha1.RecipeReview\"\x9a\x01\x92A^\n" +
+	"\x13RecipeReviewService\x12\x13Get a recipe review\x1a2Retrieves a single recipe review by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02,\x12*/meals/v1alpha1/{name=recipes/*/reviews/*}\x12\xdf\x02\n" +
+	"\x12UpdateRecipeReview\x124.api.meals.recipe.v1alpha1.UpdateRecipeReviewRequest\x1a'.api.meals.recipe.v1alpha1.RecipeReview\"\xe9\x01\x92A{\n" +
+	"\x13RecipeReviewService\x12\x16Update a recipe review\x1aLUpdates the rating or text of a review. Only the author can update a review.\xdaA\x19recipe_review,update_mask\x82\xd3\xe4\x93\x02I:\rrecipe_review28/meals/v1alpha1/{recipe_review.name=recipes/*/reviews/*}\x12\xac\x02\n" +
+	"\x12DeleteRecipeReview\x124.api.meals.recipe.v1alpha1.DeleteRecipeReviewRequest\x1a'.api.meals.recipe.v1alpha1.RecipeReview\"\xb6\x01\x92Az\n" +
+	"\x13RecipeReviewService\x12\x16Delete a recipe review\x1aKDeletes a review. The author or an admin of the recipe can delete a review.\xdaA\x04name\x82\xd3\xe4\x93\x02,**/meals/v1alpha1/{name=recipes/*/reviews/*}B\xe6\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x11RecipeReviewProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_meals_recipe_v1alpha1_recipe_review_proto_goTypes = []any{
+	(*RecipeReview)(nil),              // 0: api.meals.recipe.v1alpha1.RecipeReview
+	(*CreateRecipeReviewRequest)(nil), // 1: api.meals.recipe.v1alpha1.CreateRecipeReviewRequest
+	(*ListRecipeReviewsRequest)(nil),  // 2: api.meals.recipe.v1alpha1.ListRecipeReviewsRequest
+	(*ListRecipeReviewsResponse)(nil), // 3: api.meals.recipe.v1alpha1.ListRecipeReviewsResponse
+	(*GetRecipeReviewRequest)(nil),    // 4: api.meals.recipe.v1alpha1.GetRecipeReviewRequest
+	(*UpdateRecipeReviewRequest)(nil), // 5: api.meals.recipe.v1alpha1.UpdateRecipeReviewRequest
+	(*DeleteRecipeReviewRequest)(nil), // 6: api.meals.recipe.v1alpha1.DeleteRecipeReviewRequest
+	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),     // 8: google.protobuf.FieldMask
+}
+var file_api_meals_recipe_v1alpha1_recipe_review_proto_depIdxs = []int32{
+	7,  // 0: api.meals.recipe.v1alpha1.RecipeReview.create_time:type_name -> google.protobuf.Timestamp
+	7,  // 1: api.meals.recipe.v1alpha1.RecipeReview.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: api.meals.recipe.v1alpha1.CreateRecipeReviewRequest.recipe_review:type_name -> api.meals.recipe.v1alpha1.RecipeReview
+	0,  // 3: api.meals.recipe.v1alpha1.ListRecipeReviewsResponse.recipe_reviews:type_name -> api.meals.recipe.v1alpha1.RecipeReview
+	0,  // 4: api.meals.recipe.v1alpha1.UpdateRecipeReviewRequest.recipe_review:type_name -> api.meals.recipe.v1alpha1.RecipeReview
+	8,  // 5: api.meals.recipe.v1alpha1.UpdateRecipeReviewRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 6: api.meals.recipe.v1alpha1.RecipeReviewService.CreateRecipeReview:input_type -> api.meals.recipe.v1alpha1.CreateRecipeReviewRequest
+	2,  // 7: api.meals.recipe.v1alpha1.RecipeReviewService.ListRecipeReviews:input_type -> api.meals.recipe.v1alpha1.ListRecipeReviewsRequest
+	4,  // 8: api.meals.recipe.v1alpha1.RecipeReviewService.GetRecipeReview:input_type -> api.meals.recipe.v1alpha1.GetRecipeReviewRequest
+	5,  // 9: api.meals.recipe.v1alpha1.RecipeReviewService.UpdateRecipeReview:input_type -> api.meals.recipe.v1alpha1.UpdateRecipeReviewRequest
+	6,  // 10: api.meals.recipe.v1alpha1.RecipeReviewService.DeleteRecipeReview:input_type -> api.meals.recipe.v1alpha1.DeleteRecipeReviewRequest
+	0,  // 11: api.meals.recipe.v1alpha1.RecipeReviewService.CreateRecipeReview:output_type -> api.meals.recipe.v1alpha1.RecipeReview
+	3,  // 12: api.meals.recipe.v1alpha1.RecipeReviewService.ListRecipeReviews:output_type -> api.meals.recipe.v1alpha1.ListRecipeReviewsResponse
+	0,  // 13: api.meals.recipe.v1alpha1.RecipeReviewService.GetRecipeReview:output_type -> api.meals.recipe.v1alpha1.RecipeReview
+	0,  // 14: api.meals.recipe.v1alpha1.RecipeReviewService.UpdateRecipeReview:output_type -> api.meals.recipe.v1alpha1.RecipeReview
+	0,  // 15: api.meals.recipe.v1alpha1.RecipeReviewService.DeleteRecipeReview:output_type -> api.meals.recipe.v1alpha1.RecipeReview
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_recipe_review_proto_init() }
+func file_api_meals_recipe_v1alpha1_recipe_review_proto_init() {
+	if File_api_meals_recipe_v1alpha1_recipe_review_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_recipe_review_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_recipe_review_proto_depIdxs,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_recipe_review_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_recipe_review_proto = out.File
+	file_api_meals_recipe_v1alpha1_recipe_review_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_recipe_review_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_review.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecipeReviewService_CreateRecipeReview_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeReviewService/CreateRecipeReview"
+	RecipeReviewService_ListRecipeReviews_FullMethodName  = "/api.meals.recipe.v1alpha1.RecipeReviewService/ListRecipeReviews"
+	RecipeReviewService_GetRecipeReview_FullMethodName    = "/api.meals.recipe.v1alpha1.RecipeReviewService/GetRecipeReview"
+	RecipeReviewService_UpdateRecipeReview_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeReviewService/UpdateRecipeReview"
+	RecipeReviewService_DeleteRecipeReview_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeReviewService/DeleteRecipeReview"
+)
+
+// RecipeReviewServiceClient is the client API for RecipeReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the recipe review service
+type RecipeReviewServiceClient interface {
+	// create a review of a recipe
+	CreateRecipeReview(ctx context.Context, in *CreateRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error)
+	// list the reviews of a recipe
+	ListRecipeReviews(ctx context.Context, in *ListRecipeReviewsRequest, opts ...grpc.CallOption) (*ListRecipeReviewsResponse, error)
+	// get a review of a recipe
+	GetRecipeReview(ctx context.Context, in *GetRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error)
+	// update a review of a recipe
+	UpdateRecipeReview(ctx context.Context, in *UpdateRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error)
+	// delete a review of a recipe
+	DeleteRecipeReview(ctx context.Context, in *DeleteRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error)
+}
+
+type recipeReviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeReviewServiceClient(cc grpc.ClientConnInterface) RecipeReviewServiceClient {
+	return &recipeReviewServiceClient{cc}
+}
+
+func (c *recipeReviewServiceClient) CreateRecipeReview(ctx context.Context, in *CreateRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeReview)
+	err := c.cc.Invoke(ctx, RecipeReviewService_CreateRecipeReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeReviewServiceClient) ListRecipeReviews(ctx context.Context, in *ListRecipeReviewsRequest, opts ...grpc.CallOption) (*ListRecipeReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeReviewsResponse)
+	err := c.cc.Invoke(ctx, RecipeReviewService_ListRecipeReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeReviewServiceClient) GetRecipeReview(ctx context.Context, in *GetRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeReview)
+	err := c.cc.Invoke(ctx, RecipeReviewService_GetRecipeReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeReviewServiceClient) UpdateRecipeReview(ctx context.Context, in *UpdateRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeReview)
+	err := c.cc.Invoke(ctx, RecipeReviewService_UpdateRecipeReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeReviewServiceClient) DeleteRecipeReview(ctx context.Context, in *DeleteRecipeReviewRequest, opts ...grpc.CallOption) (*RecipeReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeReview)
+	err := c.cc.Invoke(ctx, RecipeReviewService_DeleteRecipeReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeReviewServiceServer is the server API for RecipeReviewService service.
+// All implementations must embed UnimplementedRecipeReviewServiceServer
+// for forward compatibility.
+//
+// the recipe review service
+type RecipeReviewServiceServer interface {
+	// create a review of a recipe
+	CreateRecipeReview(context.Context, *CreateRecipeReviewRequest) (*RecipeReview, error)
+	// list the reviews of a recipe
+	ListRecipeReviews(context.Context, *ListRecipeReviewsRequest) (*ListRecipeReviewsResponse, error)
+	// get a review of a recipe
+	GetRecipeReview(context.Context, *GetRecipeReviewRequest) (*RecipeReview, error)
+	// update a review of a recipe
+	UpdateRecipeReview(context.Context, *UpdateRecipeReviewRequest) (*RecipeReview, error)
+	// delete a review of a recipe
+	DeleteRecipeReview(context.Context, *DeleteRecipeReviewRequest) (*RecipeReview, error)
+	mustEmbedUnimplementedRecipeReviewServiceServer()
+}
+
+// UnimplementedRecipeReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeReviewServiceServer struct{}
+
+func (UnimplementedRecipeReviewServiceServer) CreateRecipeReview(context.Context, *CreateRecipeReviewRequest) (*RecipeReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipeReview not implemented")
+}
+func (UnimplementedRecipeReviewServiceServer) ListRecipeReviews(context.Context, *ListRecipeReviewsRequest) (*ListRecipeReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeReviews not implemented")
+}
+func (UnimplementedRecipeReviewServiceServer) GetRecipeReview(context.Context, *GetRecipeReviewRequest) (*RecipeReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeReview not implemented")
+}
+func (UnimplementedRecipeReviewServiceServer) UpdateRecipeReview(context.Context, *UpdateRecipeReviewRequest) (*RecipeReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipeReview not implemented")
+}
+func (UnimplementedRecipeReviewServiceServer) DeleteRecipeReview(context.Context, *DeleteRecipeReviewRequest) (*RecipeReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipeReview not implemented")
+}
+func (UnimplementedRecipeReviewServiceServer) mustEmbedUnimplementedRecipeReviewServiceServer() {}
+func (UnimplementedRecipeReviewServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRecipeReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeReviewServiceServer will
+// result in compilation errors.
+type UnsafeRecipeReviewServiceServer interface {
+	mustEmbedUnimplementedRecipeReviewServiceServer()
+}
+
+func RegisterRecipeReviewServiceServer(s grpc.ServiceRegistrar, srv RecipeReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeReviewService_ServiceDesc, srv)
+}
+
+func _RecipeReviewService_CreateRecipeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecipeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeReviewServiceServer).CreateRecipeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeReviewService_CreateRecipeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeReviewServiceServer).CreateRecipeReview(ctx, req.(*CreateRecipeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeReviewService_ListRecipeReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeReviewServiceServer).ListRecipeReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeReviewService_ListRecipeReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeReviewServiceServer).ListRecipeReviews(ctx, req.(*ListRecipeReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeReviewService_GetRecipeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeReviewServiceServer).GetRecipeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeReviewService_GetRecipeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeReviewServiceServer).GetRecipeReview(ctx, req.(*GetRecipeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeReviewService_UpdateRecipeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeReviewServiceServer).UpdateRecipeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeReviewService_UpdateRecipeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeReviewServiceServer).UpdateRecipeReview(ctx, req.(*UpdateRecipeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeReviewService_DeleteRecipeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeReviewServiceServer).DeleteRecipeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeReviewService_DeleteRecipeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeReviewServiceServer).DeleteRecipeReview(ctx, req.(*DeleteRecipeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipeReviewService_ServiceDesc is the grpc.ServiceDesc for RecipeReviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeReviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.RecipeReviewService",
+	HandlerType: (*RecipeReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecipeReview",
+			Handler:    _RecipeReviewService_CreateRecipeReview_Handler,
+		},
+		{
+			MethodName: "ListRecipeReviews",
+			Handler:    _RecipeReviewService_ListRecipeReviews_Handler,
+		},
+		{
+			MethodName: "GetRecipeReview",
+			Handler:    _RecipeReviewService_GetRecipeReview_Handler,
+		},
+		{
+			MethodName: "UpdateRecipeReview",
+			Handler:    _RecipeReviewService_UpdateRecipeReview_Handler,
+		},
+		{
+			MethodName: "DeleteRecipeReview",
+			Handler:    _RecipeReviewService_DeleteRecipeReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/recipe_review.proto",
+}
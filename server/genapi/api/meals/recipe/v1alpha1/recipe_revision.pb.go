@@ -0,0 +1,832 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_revision.proto
+
+package recipev1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// the type of a change
+type RecipeRevisionDiff_ChangeType int32
+
+const (
+	// the change type is unspecified
+	RecipeRevisionDiff_CHANGE_TYPE_UNSPECIFIED RecipeRevisionDiff_ChangeType = 0
+	// the item was added
+	RecipeRevisionDiff_CHANGE_TYPE_ADDED RecipeRevisionDiff_ChangeType = 1
+	// the item was removed
+	RecipeRevisionDiff_CHANGE_TYPE_REMOVED RecipeRevisionDiff_ChangeType = 2
+	// the item was modified
+	RecipeRevisionDiff_CHANGE_TYPE_MODIFIED RecipeRevisionDiff_ChangeType = 3
+)
+
+// Enum value maps for RecipeRevisionDiff_ChangeType.
+var (
+	RecipeRevisionDiff_ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_MODIFIED",
+	}
+	RecipeRevisionDiff_ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_ADDED":       1,
+		"CHANGE_TYPE_REMOVED":     2,
+		"CHANGE_TYPE_MODIFIED":    3,
+	}
+)
+
+func (x RecipeRevisionDiff_ChangeType) Enum() *RecipeRevisionDiff_ChangeType {
+	p := new(RecipeRevisionDiff_ChangeType)
+	*p = x
+	return p
+}
+
+func (x RecipeRevisionDiff_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipeRevisionDiff_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_enumTypes[0].Descriptor()
+}
+
+func (RecipeRevisionDiff_ChangeType) Type() protoreflect.EnumType {
+	return &file_api_meals_recipe_v1alpha1_recipe_revision_proto_enumTypes[0]
+}
+
+func (x RecipeRevisionDiff_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipeRevisionDiff_ChangeType.Descriptor instead.
+func (RecipeRevisionDiff_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// an immutable snapshot of a recipe taken when it was created or updated
+type RecipeRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the revision
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the user who made the change
+	Editor string `protobuf:"bytes,2,opt,name=editor,proto3" json:"editor,omitempty"`
+	// the fields that were changed, empty for the initial revision
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// the recipe as it was after the change
+	Recipe *Recipe `protobuf:"bytes,4,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// the time the revision was created (UTC)
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeRevision) Reset() {
+	*x = RecipeRevision{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeRevision) ProtoMessage() {}
+
+func (x *RecipeRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeRevision.ProtoReflect.Descriptor instead.
+func (*RecipeRevision) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipeRevision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeRevision) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *RecipeRevision) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *RecipeRevision) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *RecipeRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// the differences between two revisions of a recipe
+type RecipeRevisionDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the revision that is compared against
+	BaseRevision string `protobuf:"bytes,1,opt,name=base_revision,json=baseRevision,proto3" json:"base_revision,omitempty"`
+	// the revision that is compared
+	TargetRevision string `protobuf:"bytes,2,opt,name=target_revision,json=targetRevision,proto3" json:"target_revision,omitempty"`
+	// the recipe fields that differ
+	ChangedFields []string `protobuf:"bytes,3,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	// the ingredients that were added, removed or modified
+	IngredientChanges []*RecipeRevisionDiff_IngredientChange `protobuf:"bytes,4,rep,name=ingredient_changes,json=ingredientChanges,proto3" json:"ingredient_changes,omitempty"`
+	// the direction steps that were added, removed or modified
+	DirectionChanges []*RecipeRevisionDiff_DirectionChange `protobuf:"bytes,5,rep,name=direction_changes,json=directionChanges,proto3" json:"direction_changes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecipeRevisionDiff) Reset() {
+	*x = RecipeRevisionDiff{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeRevisionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeRevisionDiff) ProtoMessage() {}
+
+func (x *RecipeRevisionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeRevisionDiff.ProtoReflect.Descriptor instead.
+func (*RecipeRevisionDiff) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecipeRevisionDiff) GetBaseRevision() string {
+	if x != nil {
+		return x.BaseRevision
+	}
+	return ""
+}
+
+func (x *RecipeRevisionDiff) GetTargetRevision() string {
+	if x != nil {
+		return x.TargetRevision
+	}
+	return ""
+}
+
+func (x *RecipeRevisionDiff) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *RecipeRevisionDiff) GetIngredientChanges() []*RecipeRevisionDiff_IngredientChange {
+	if x != nil {
+		return x.IngredientChanges
+	}
+	return nil
+}
+
+func (x *RecipeRevisionDiff) GetDirectionChanges() []*RecipeRevisionDiff_DirectionChange {
+	if x != nil {
+		return x.DirectionChanges
+	}
+	return nil
+}
+
+// the request to list recipe revisions
+type ListRecipeRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the recipe to list the revisions of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeRevisionsRequest) Reset() {
+	*x = ListRecipeRevisionsRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRecipeRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecipeRevisionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRecipeRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecipeRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list recipe revisions
+type ListRecipeRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the revisions, without the recipe snapshot
+	RecipeRevisions []*RecipeRevision `protobuf:"bytes,1,rep,name=recipe_revisions,json=recipeRevisions,proto3" json:"recipe_revisions,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeRevisionsResponse) Reset() {
+	*x = ListRecipeRevisionsResponse{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRecipeRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecipeRevisionsResponse) GetRecipeRevisions() []*RecipeRevision {
+	if x != nil {
+		return x.RecipeRevisions
+	}
+	return nil
+}
+
+func (x *ListRecipeRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to get a recipe revision
+type GetRecipeRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the revision
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeRevisionRequest) Reset() {
+	*x = GetRecipeRevisionRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeRevisionRequest) ProtoMessage() {}
+
+func (x *GetRecipeRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRecipeRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to diff recipe revisions
+type DiffRecipeRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the revision to compare
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the revision to compare against, defaults to the revision before it
+	BaseRevision  string `protobuf:"bytes,2,opt,name=base_revision,json=baseRevision,proto3" json:"base_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRecipeRevisionsRequest) Reset() {
+	*x = DiffRecipeRevisionsRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRecipeRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRecipeRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffRecipeRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRecipeRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRecipeRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DiffRecipeRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffRecipeRevisionsRequest) GetBaseRevision() string {
+	if x != nil {
+		return x.BaseRevision
+	}
+	return ""
+}
+
+// the request to revert a recipe
+type RevertRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the recipe
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the revision to restore
+	Revision      string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertRecipeRequest) Reset() {
+	*x = RevertRecipeRequest{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertRecipeRequest) ProtoMessage() {}
+
+func (x *RevertRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertRecipeRequest.ProtoReflect.Descriptor instead.
+func (*RevertRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevertRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RevertRecipeRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+// a change to an ingredient
+type RecipeRevisionDiff_IngredientChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the type of change
+	ChangeType RecipeRevisionDiff_ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=api.meals.recipe.v1alpha1.RecipeRevisionDiff_ChangeType" json:"change_type,omitempty"`
+	// the title of the ingredient group the ingredient is in
+	GroupTitle string `protobuf:"bytes,2,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
+	// the ingredient in the base revision, unset when added
+	Before *Recipe_Ingredient `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	// the ingredient in the target revision, unset when removed
+	After         *Recipe_Ingredient `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeRevisionDiff_IngredientChange) Reset() {
+	*x = RecipeRevisionDiff_IngredientChange{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeRevisionDiff_IngredientChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeRevisionDiff_IngredientChange) ProtoMessage() {}
+
+func (x *RecipeRevisionDiff_IngredientChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeRevisionDiff_IngredientChange.ProtoReflect.Descriptor instead.
+func (*RecipeRevisionDiff_IngredientChange) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *RecipeRevisionDiff_IngredientChange) GetChangeType() RecipeRevisionDiff_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return RecipeRevisionDiff_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *RecipeRevisionDiff_IngredientChange) GetGroupTitle() string {
+	if x != nil {
+		return x.GroupTitle
+	}
+	return ""
+}
+
+func (x *RecipeRevisionDiff_IngredientChange) GetBefore() *Recipe_Ingredient {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *RecipeRevisionDiff_IngredientChange) GetAfter() *Recipe_Ingredient {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// a change to a direction step
+type RecipeRevisionDiff_DirectionChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the type of change
+	ChangeType RecipeRevisionDiff_ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=api.meals.recipe.v1alpha1.RecipeRevisionDiff_ChangeType" json:"change_type,omitempty"`
+	// the title of the direction the step is in
+	DirectionTitle string `protobuf:"bytes,2,opt,name=direction_title,json=directionTitle,proto3" json:"direction_title,omitempty"`
+	// the step in the base revision, empty when added
+	Before string `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	// the step in the target revision, empty when removed
+	After         string `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeRevisionDiff_DirectionChange) Reset() {
+	*x = RecipeRevisionDiff_DirectionChange{}
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeRevisionDiff_DirectionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeRevisionDiff_DirectionChange) ProtoMessage() {}
+
+func (x *RecipeRevisionDiff_DirectionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeRevisionDiff_DirectionChange.ProtoReflect.Descriptor instead.
+func (*RecipeRevisionDiff_DirectionChange) Descriptor() ([]byte, []int) {
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *RecipeRevisionDiff_DirectionChange) GetChangeType() RecipeRevisionDiff_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return RecipeRevisionDiff_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *RecipeRevisionDiff_DirectionChange) GetDirectionTitle() string {
+	if x != nil {
+		return x.DirectionTitle
+	}
+	return ""
+}
+
+func (x *RecipeRevisionDiff_DirectionChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *RecipeRevisionDiff_DirectionChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+var File_api_meals_recipe_v1alpha1_recipe_revision_proto protoreflect.FileDescriptor
+
+const file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDesc = "" +
+	"\n" +
+	"/api/meals/recipe/v1alpha1/recipe_revision.proto\x12\x19api.meals.recipe.v1alpha1\x1a&api/meals/recipe/v1alpha1/recipe.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa2\x03\n" +
+	"\x0eRecipeRevision\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12<\n" +
+	"\x06editor\x18\x02 \x01(\tB$\xe0A\x03\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\x06editor\x12@\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x03R\n" +
+	"updateMask\x12>\n" +
+	"\x06recipe\x18\x04 \x01(\v2!.api.meals.recipe.v1alpha1.RecipeB\x03\xe0A\x03R\x06recipe\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:u\xeaAr\n" +
+	"(api.meals.recipe.v1alpha1/RecipeRevision\x12%recipes/{recipe}/revisions/{revision}*\x0frecipeRevisions2\x0erecipeRevision\"\xd5\b\n" +
+	"\x12RecipeRevisionDiff\x12U\n" +
+	"\rbase_revision\x18\x01 \x01(\tB0\xe0A\x03\xfaA*\n" +
+	"(api.meals.recipe.v1alpha1/RecipeRevisionR\fbaseRevision\x12Y\n" +
+	"\x0ftarget_revision\x18\x02 \x01(\tB0\xe0A\x03\xfaA*\n" +
+	"(api.meals.recipe.v1alpha1/RecipeRevisionR\x0etargetRevision\x12*\n" +
+	"\x0echanged_fields\x18\x03 \x03(\tB\x03\xe0A\x03R\rchangedFields\x12r\n" +
+	"\x12ingredient_changes\x18\x04 \x03(\v2>.api.meals.recipe.v1alpha1.RecipeRevisionDiff.IngredientChangeB\x03\xe0A\x03R\x11ingredientChanges\x12o\n" +
+	"\x11direction_changes\x18\x05 \x03(\v2=.api.meals.recipe.v1alpha1.RecipeRevisionDiff.DirectionChangeB\x03\xe0A\x03R\x10directionChanges\x1a\xac\x02\n" +
+	"\x10IngredientChange\x12^\n" +
+	"\vchange_type\x18\x01 \x01(\x0e28.api.meals.recipe.v1alpha1.RecipeRevisionDiff.ChangeTypeB\x03\xe0A\x03R\n" +
+	"changeType\x12$\n" +
+	"\vgroup_title\x18\x02 \x01(\tB\x03\xe0A\x03R\n" +
+	"groupTitle\x12I\n" +
+	"\x06before\x18\x03 \x01(\v2,.api.meals.recipe.v1alpha1.Recipe.IngredientB\x03\xe0A\x03R\x06before\x12G\n" +
+	"\x05after\x18\x04 \x01(\v2,.api.meals.recipe.v1alpha1.Recipe.IngredientB\x03\xe0A\x03R\x05after\x1a\xd7\x01\n" +
+	"\x0fDirectionChange\x12^\n" +
+	"\vchange_type\x18\x01 \x01(\x0e28.api.meals.recipe.v1alpha1.RecipeRevisionDiff.ChangeTypeB\x03\xe0A\x03R\n" +
+	"changeType\x12,\n" +
+	"\x0fdirection_title\x18\x02 \x01(\tB\x03\xe0A\x03R\x0edirectionTitle\x12\x1b\n" +
+	"\x06before\x18\x03 \x01(\tB\x03\xe0A\x03R\x06before\x12\x19\n" +
+	"\x05after\x18\x04 \x01(\tB\x03\xe0A\x03R\x05after\"s\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CHANGE_TYPE_ADDED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_REMOVED\x10\x02\x12\x18\n" +
+	"\x14CHANGE_TYPE_MODIFIED\x10\x03\"\xa4\x01\n" +
+	"\x1aListRecipeRevisionsRequest\x12@\n" +
+	"\x06parent\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x9b\x01\n" +
+	"\x1bListRecipeRevisionsResponse\x12T\n" +
+	"\x10recipe_revisions\x18\x01 \x03(\v2).api.meals.recipe.v1alpha1.RecipeRevisionR\x0frecipeRevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"`\n" +
+	"\x18GetRecipeRevisionRequest\x12D\n" +
+	"\x04name\x18\x01 \x01(\tB0\xe0A\x02\xfaA*\n" +
+	"(api.meals.recipe.v1alpha1/RecipeRevisionR\x04name\"\xb9\x01\n" +
+	"\x1aDiffRecipeRevisionsRequest\x12D\n" +
+	"\x04name\x18\x01 \x01(\tB0\xe0A\x02\xfaA*\n" +
+	"(api.meals.recipe.v1alpha1/RecipeRevisionR\x04name\x12U\n" +
+	"\rbase_revision\x18\x02 \x01(\tB0\xe0A\x01\xfaA*\n" +
+	"(api.meals.recipe.v1alpha1/RecipeRevisionR\fbaseRevision\"\xa1\x01\n" +
+	"\x13RevertRecipeRequest\x12<\n" +
+	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x04name\x12L\n" +
+	"\brevision\x18\x02 \x01(\tB0\xe0A\x02\xfaA*\n" +
+	"(api.meals.recipe.v1alpha1/RecipeRevisionR\brevision2\xb1\n" +
+	"\n" +
+	"\x15RecipeRevisionService\x12\xbd\x02\n" +
+	"\x13ListRecipeRevisions\x125.api.meals.recipe.v1alpha1.ListRecipeRevisionsRequest\x1a6.api.meals.recipe.v1alpha1.ListRecipeRevisionsResponse\"\xb6\x01\x92Av\n" +
+	"\x15RecipeRevisionService\x12\x15List recipe revisions\x1aFRetrieves a paginated list of the revisions of a recipe, newest first.\xdaA\x06parent\x82\xd3\xe4\x93\x02.\x12,/meals/v1alpha1/{parent=recipes/*}/revisions\x12\xab\x02\n" +
+	"\x11GetRecipeRevision\x123.api.meals.recipe.v1alpha1.GetRecipeRevisionRequest\x1a).api.meals.recipe.v1alpha1.RecipeRevision\"\xb5\x01\x92Aw\n" +
+	"\x15RecipeRevisionService\x12\x15Get a recipe revision\x1aGRetrieves a single recipe revision, including the full recipe snapshot.\xdaA\x04name\x82\xd3\xe4\x93\x02.\x12,/meals/v1alpha1/{name=recipes/*/revisions/*}\x12\xf8\x02\n" +
+	"\x13DiffRecipeRevisions\x125.api.meals.recipe.v1alpha1.DiffRecipeRevisionsRequest\x1a-.api.meals.recipe.v1alpha1.RecipeRevisionDiff\"\xfa\x01\x92A\xa8\x01\n" +
+	"\x15RecipeRevisionService\x12\x15Diff recipe revisions\x1axCompares a recipe revision with another revision, or with the revision before it, at the ingredient and direction level.\xdaA\x12name,base_revision\x82\xd3\xe4\x93\x023\x121/meals/v1alpha1/{name=recipes/*/revisions/*}:diff\x12\xae\x02\n" +
+	"\fRevertRecipe\x12..api.meals.recipe.v1alpha1.RevertRecipeRequest\x1a!.api.meals.recipe.v1alpha1.Recipe\"\xca\x01\x92A\x84\x01\n" +
+	"\x15RecipeRevisionService\x12\x0fRevert a recipe\x1aZRestores the recipe to the snapshot of a revision. The revert is stored as a new revision.\xdaA\rname,revision\x82\xd3\xe4\x93\x02,:\x01*\"'/meals/v1alpha1/{name=recipes/*}:revertB\xe8\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"\x1dcom.api.meals.recipe.v1alpha1B\x13RecipeRevisionProtoP\x01ZPgithub.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1;recipev1alpha1\xa2\x02\x03AMR\xaa\x02\x19Api.Meals.Recipe.V1alpha1\xca\x02\x19Api\\Meals\\Recipe\\V1alpha1\xe2\x02%Api\\Meals\\Recipe\\V1alpha1\\GPBMetadata\xea\x02\x1cApi::Meals::Recipe::V1alpha1b\x06proto3"
+
+var (
+	file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescOnce sync.Once
+	file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescData []byte
+)
+
+func file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescGZIP() []byte {
+	file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescOnce.Do(func() {
+		file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDesc)))
+	})
+	return file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDescData
+}
+
+var file_api_meals_recipe_v1alpha1_recipe_revision_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_meals_recipe_v1alpha1_recipe_revision_proto_goTypes = []any{
+	(RecipeRevisionDiff_ChangeType)(0),          // 0: api.meals.recipe.v1alpha1.RecipeRevisionDiff.ChangeType
+	(*RecipeRevision)(nil),                      // 1: api.meals.recipe.v1alpha1.RecipeRevision
+	(*RecipeRevisionDiff)(nil),                  // 2: api.meals.recipe.v1alpha1.RecipeRevisionDiff
+	(*ListRecipeRevisionsRequest)(nil),          // 3: api.meals.recipe.v1alpha1.ListRecipeRevisionsRequest
+	(*ListRecipeRevisionsResponse)(nil),         // 4: api.meals.recipe.v1alpha1.ListRecipeRevisionsResponse
+	(*GetRecipeRevisionRequest)(nil),            // 5: api.meals.recipe.v1alpha1.GetRecipeRevisionRequest
+	(*DiffRecipeRevisionsRequest)(nil),          // 6: api.meals.recipe.v1alpha1.DiffRecipeRevisionsRequest
+	(*RevertRecipeRequest)(nil),                 // 7: api.meals.recipe.v1alpha1.RevertRecipeRequest
+	(*RecipeRevisionDiff_IngredientChange)(nil), // 8: api.meals.recipe.v1alpha1.RecipeRevisionDiff.IngredientChange
+	(*RecipeRevisionDiff_DirectionChange)(nil),  // 9: api.meals.recipe.v1alpha1.RecipeRevisionDiff.DirectionChange
+	(*fieldmaskpb.FieldMask)(nil),               // 10: google.protobuf.FieldMask
+	(*Recipe)(nil),                              // 11: api.meals.recipe.v1alpha1.Recipe
+	(*timestamppb.Timestamp)(nil),               // 12: google.protobuf.Timestamp
+	(*Recipe_Ingredient)(nil),                   // 13: api.meals.recipe.v1alpha1.Recipe.Ingredient
+}
+var file_api_meals_recipe_v1alpha1_recipe_revision_proto_depIdxs = []int32{
+	10, // 0: api.meals.recipe.v1alpha1.RecipeRevision.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 1: api.meals.recipe.v1alpha1.RecipeRevision.recipe:type_name -> api.meals.recipe.v1alpha1.Recipe
+	12, // 2: api.meals.recipe.v1alpha1.RecipeRevision.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 3: api.meals.recipe.v1alpha1.RecipeRevisionDiff.ingredient_changes:type_name -> api.meals.recipe.v1alpha1.RecipeRevisionDiff.IngredientChange
+	9,  // 4: api.meals.recipe.v1alpha1.RecipeRevisionDiff.direction_changes:type_name -> api.meals.recipe.v1alpha1.RecipeRevisionDiff.DirectionChange
+	1,  // 5: api.meals.recipe.v1alpha1.ListRecipeRevisionsResponse.recipe_revisions:type_name -> api.meals.recipe.v1alpha1.RecipeRevision
+	0,  // 6: api.meals.recipe.v1alpha1.RecipeRevisionDiff.IngredientChange.change_type:type_name -> api.meals.recipe.v1alpha1.RecipeRevisionDiff.ChangeType
+	13, // 7: api.meals.recipe.v1alpha1.RecipeRevisionDiff.IngredientChange.before:type_name -> api.meals.recipe.v1alpha1.Recipe.Ingredient
+	13, // 8: api.meals.recipe.v1alpha1.RecipeRevisionDiff.IngredientChange.after:type_name -> api.meals.recipe.v1alpha1.Recipe.Ingredient
+	0,  // 9: api.meals.recipe.v1alpha1.RecipeRevisionDiff.DirectionChange.change_type:type_name -> api.meals.recipe.v1alpha1.RecipeRevisionDiff.ChangeType
+	3,  // 10: api.meals.recipe.v1alpha1.RecipeRevisionService.ListRecipeRevisions:input_type -> api.meals.recipe.v1alpha1.ListRecipeRevisionsRequest
+	5,  // 11: api.meals.recipe.v1alpha1.RecipeRevisionService.GetRecipeRevision:input_type -> api.meals.recipe.v1alpha1.GetRecipeRevisionRequest
+	6,  // 12: api.meals.recipe.v1alpha1.RecipeRevisionService.DiffRecipeRevisions:input_type -> api.meals.recipe.v1alpha1.DiffRecipeRevisionsRequest
+	7,  // 13: api.meals.recipe.v1alpha1.RecipeRevisionService.RevertRecipe:input_type -> api.meals.recipe.v1alpha1.RevertRecipeRequest
+	4,  // 14: api.meals.recipe.v1alpha1.RecipeRevisionService.ListRecipeRevisions:output_type -> api.meals.recipe.v1alpha1.ListRecipeRevisionsResponse
+	1,  // 15: api.meals.recipe.v1alpha1.RecipeRevisionService.GetRecipeRevision:output_type -> api.meals.recipe.v1alpha1.RecipeRevision
+	2,  // 16: api.meals.recipe.v1alpha1.RecipeRevisionService.DiffRecipeRevisions:output_type -> api.meals.recipe.v1alpha1.RecipeRevisionDiff
+	11, // 17: api.meals.recipe.v1alpha1.RecipeRevisionService.RevertRecipe:output_type -> api.meals.recipe.v1alpha1.Recipe
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_api_meals_recipe_v1alpha1_recipe_revision_proto_init() }
+func file_api_meals_recipe_v1alpha1_recipe_revision_proto_init() {
+	if File_api_meals_recipe_v1alpha1_recipe_revision_proto != nil {
+		return
+	}
+	file_api_meals_recipe_v1alpha1_recipe_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDesc), len(file_api_meals_recipe_v1alpha1_recipe_revision_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_meals_recipe_v1alpha1_recipe_revision_proto_goTypes,
+		DependencyIndexes: file_api_meals_recipe_v1alpha1_recipe_revision_proto_depIdxs,
+		EnumInfos:         file_api_meals_recipe_v1alpha1_recipe_revision_proto_enumTypes,
+		MessageInfos:      file_api_meals_recipe_v1alpha1_recipe_revision_proto_msgTypes,
+	}.Build()
+	File_api_meals_recipe_v1alpha1_recipe_revision_proto = out.File
+	file_api_meals_recipe_v1alpha1_recipe_revision_proto_goTypes = nil
+	file_api_meals_recipe_v1alpha1_recipe_revision_proto_depIdxs = nil
+}
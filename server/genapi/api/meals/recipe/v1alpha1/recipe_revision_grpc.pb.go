@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/meals/recipe/v1alpha1/recipe_revision.proto
+
+package recipev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecipeRevisionService_ListRecipeRevisions_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeRevisionService/ListRecipeRevisions"
+	RecipeRevisionService_GetRecipeRevision_FullMethodName   = "/api.meals.recipe.v1alpha1.RecipeRevisionService/GetRecipeRevision"
+	RecipeRevisionService_DiffRecipeRevisions_FullMethodName = "/api.meals.recipe.v1alpha1.RecipeRevisionService/DiffRecipeRevisions"
+	RecipeRevisionService_RevertRecipe_FullMethodName        = "/api.meals.recipe.v1alpha1.RecipeRevisionService/RevertRecipe"
+)
+
+// RecipeRevisionServiceClient is the client API for RecipeRevisionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the recipe revision service
+type RecipeRevisionServiceClient interface {
+	// list the revisions of a recipe
+	ListRecipeRevisions(ctx context.Context, in *ListRecipeRevisionsRequest, opts ...grpc.CallOption) (*ListRecipeRevisionsResponse, error)
+	// get a revision of a recipe
+	GetRecipeRevision(ctx context.Context, in *GetRecipeRevisionRequest, opts ...grpc.CallOption) (*RecipeRevision, error)
+	// diff two revisions of a recipe
+	DiffRecipeRevisions(ctx context.Context, in *DiffRecipeRevisionsRequest, opts ...grpc.CallOption) (*RecipeRevisionDiff, error)
+	// revert a recipe to a revision
+	RevertRecipe(ctx context.Context, in *RevertRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+}
+
+type recipeRevisionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeRevisionServiceClient(cc grpc.ClientConnInterface) RecipeRevisionServiceClient {
+	return &recipeRevisionServiceClient{cc}
+}
+
+func (c *recipeRevisionServiceClient) ListRecipeRevisions(ctx context.Context, in *ListRecipeRevisionsRequest, opts ...grpc.CallOption) (*ListRecipeRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeRevisionsResponse)
+	err := c.cc.Invoke(ctx, RecipeRevisionService_ListRecipeRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeRevisionServiceClient) GetRecipeRevision(ctx context.Context, in *GetRecipeRevisionRequest, opts ...grpc.CallOption) (*RecipeRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeRevision)
+	err := c.cc.Invoke(ctx, RecipeRevisionService_GetRecipeRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeRevisionServiceClient) DiffRecipeRevisions(ctx context.Context, in *DiffRecipeRevisionsRequest, opts ...grpc.CallOption) (*RecipeRevisionDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeRevisionDiff)
+	err := c.cc.Invoke(ctx, RecipeRevisionService_DiffRecipeRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeRevisionServiceClient) RevertRecipe(ctx context.Context, in *RevertRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeRevisionService_RevertRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeRevisionServiceServer is the server API for RecipeRevisionService service.
+// All implementations must embed UnimplementedRecipeRevisionServiceServer
+// for forward compatibility.
+//
+// the recipe revision service
+type RecipeRevisionServiceServer interface {
+	// list the revisions of a recipe
+	ListRecipeRevisions(context.Context, *ListRecipeRevisionsRequest) (*ListRecipeRevisionsResponse, error)
+	// get a revision of a recipe
+	GetRecipeRevision(context.Context, *GetRecipeRevisionRequest) (*RecipeRevision, error)
+	// diff two revisions of a recipe
+	DiffRecipeRevisions(context.Context, *DiffRecipeRevisionsRequest) (*RecipeRevisionDiff, error)
+	// revert a recipe to a revision
+	RevertRecipe(context.Context, *RevertRecipeRequest) (*Recipe, error)
+	mustEmbedUnimplementedRecipeRevisionServiceServer()
+}
+
+// UnimplementedRecipeRevisionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeRevisionServiceServer struct{}
+
+func (UnimplementedRecipeRevisionServiceServer) ListRecipeRevisions(context.Context, *ListRecipeRevisionsRequest) (*ListRecipeRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeRevisions not implemented")
+}
+func (UnimplementedRecipeRevisionServiceServer) GetRecipeRevision(context.Context, *GetRecipeRevisionRequest) (*RecipeRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeRevision not implemented")
+}
+func (UnimplementedRecipeRevisionServiceServer) DiffRecipeRevisions(context.Context, *DiffRecipeRevisionsRequest) (*RecipeRevisionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRecipeRevisions not implemented")
+}
+func (UnimplementedRecipeRevisionServiceServer) RevertRecipe(context.Context, *RevertRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertRecipe not implemented")
+}
+func (UnimplementedRecipeRevisionServiceServer) mustEmbedUnimplementedRecipeRevisionServiceServer() {}
+func (UnimplementedRecipeRevisionServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeRecipeRevisionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeRevisionServiceServer will
+// result in compilation errors.
+type UnsafeRecipeRevisionServiceServer interface {
+	mustEmbedUnimplementedRecipeRevisionServiceServer()
+}
+
+func RegisterRecipeRevisionServiceServer(s grpc.ServiceRegistrar, srv RecipeRevisionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeRevisionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeRevisionService_ServiceDesc, srv)
+}
+
+func _RecipeRevisionService_ListRecipeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeRevisionServiceServer).ListRecipeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeRevisionService_ListRecipeRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeRevisionServiceServer).ListRecipeRevisions(ctx, req.(*ListRecipeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeRevisionService_GetRecipeRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeRevisionServiceServer).GetRecipeRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeRevisionService_GetRecipeRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeRevisionServiceServer).GetRecipeRevision(ctx, req.(*GetRecipeRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeRevisionService_DiffRecipeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRecipeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeRevisionServiceServer).DiffRecipeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeRevisionService_DiffRecipeRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeRevisionServiceServer).DiffRecipeRevisions(ctx, req.(*DiffRecipeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeRevisionService_RevertRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeRevisionServiceServer).RevertRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeRevisionService_RevertRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeRevisionServiceServer).RevertRecipe(ctx, req.(*RevertRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipeRevisionService_ServiceDesc is the grpc.ServiceDesc for RecipeRevisionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeRevisionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.meals.recipe.v1alpha1.RecipeRevisionService",
+	HandlerType: (*RecipeRevisionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRecipeRevisions",
+			Handler:    _RecipeRevisionService_ListRecipeRevisions_Handler,
+		},
+		{
+			MethodName: "GetRecipeRevision",
+			Handler:    _RecipeRevisionService_GetRecipeRevision_Handler,
+		},
+		{
+			MethodName: "DiffRecipeRevisions",
+			Handler:    _RecipeRevisionService_DiffRecipeRevisions_Handler,
+		},
+		{
+			MethodName: "RevertRecipe",
+			Handler:    _RecipeRevisionService_RevertRecipe_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/meals/recipe/v1alpha1/recipe_revision.proto",
+}
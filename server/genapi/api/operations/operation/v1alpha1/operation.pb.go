@@ -0,0 +1,855 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/operations/operation/v1alpha1/operation.proto
+
+package operationv1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// the work an operation does
+type OperationMetadata_Kind int32
+
+const (
+	// the kind is unspecified
+	OperationMetadata_KIND_UNSPECIFIED OperationMetadata_Kind = 0
+	// scrape a recipe from a web page
+	OperationMetadata_KIND_SCRAPE_RECIPE OperationMetadata_Kind = 1
+	// read a recipe from photos
+	OperationMetadata_KIND_OCR_RECIPE OperationMetadata_Kind = 2
+	// generate an image for a recipe
+	OperationMetadata_KIND_GENERATE_RECIPE_IMAGE OperationMetadata_Kind = 3
+	// import recipes from the export file of another recipe manager
+	OperationMetadata_KIND_IMPORT_RECIPES OperationMetadata_Kind = 4
+)
+
+// Enum value maps for OperationMetadata_Kind.
+var (
+	OperationMetadata_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_SCRAPE_RECIPE",
+		2: "KIND_OCR_RECIPE",
+		3: "KIND_GENERATE_RECIPE_IMAGE",
+		4: "KIND_IMPORT_RECIPES",
+	}
+	OperationMetadata_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED":           0,
+		"KIND_SCRAPE_RECIPE":         1,
+		"KIND_OCR_RECIPE":            2,
+		"KIND_GENERATE_RECIPE_IMAGE": 3,
+		"KIND_IMPORT_RECIPES":        4,
+	}
+)
+
+func (x OperationMetadata_Kind) Enum() *OperationMetadata_Kind {
+	p := new(OperationMetadata_Kind)
+	*p = x
+	return p
+}
+
+func (x OperationMetadata_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationMetadata_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_operations_operation_v1alpha1_operation_proto_enumTypes[0].Descriptor()
+}
+
+func (OperationMetadata_Kind) Type() protoreflect.EnumType {
+	return &file_api_operations_operation_v1alpha1_operation_proto_enumTypes[0]
+}
+
+func (x OperationMetadata_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationMetadata_Kind.Descriptor instead.
+func (OperationMetadata_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// the state of an operation
+type OperationMetadata_State int32
+
+const (
+	// the state is unspecified
+	OperationMetadata_STATE_UNSPECIFIED OperationMetadata_State = 0
+	// the operation is waiting for a worker
+	OperationMetadata_STATE_PENDING OperationMetadata_State = 1
+	// the operation is running
+	OperationMetadata_STATE_RUNNING OperationMetadata_State = 2
+	// the operation succeeded
+	OperationMetadata_STATE_SUCCEEDED OperationMetadata_State = 3
+	// the operation failed
+	OperationMetadata_STATE_FAILED OperationMetadata_State = 4
+	// the operation was cancelled
+	OperationMetadata_STATE_CANCELLED OperationMetadata_State = 5
+)
+
+// Enum value maps for OperationMetadata_State.
+var (
+	OperationMetadata_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_PENDING",
+		2: "STATE_RUNNING",
+		3: "STATE_SUCCEEDED",
+		4: "STATE_FAILED",
+		5: "STATE_CANCELLED",
+	}
+	OperationMetadata_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_PENDING":     1,
+		"STATE_RUNNING":     2,
+		"STATE_SUCCEEDED":   3,
+		"STATE_FAILED":      4,
+		"STATE_CANCELLED":   5,
+	}
+)
+
+func (x OperationMetadata_State) Enum() *OperationMetadata_State {
+	p := new(OperationMetadata_State)
+	*p = x
+	return p
+}
+
+func (x OperationMetadata_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationMetadata_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_operations_operation_v1alpha1_operation_proto_enumTypes[1].Descriptor()
+}
+
+func (OperationMetadata_State) Type() protoreflect.EnumType {
+	return &file_api_operations_operation_v1alpha1_operation_proto_enumTypes[1]
+}
+
+func (x OperationMetadata_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationMetadata_State.Descriptor instead.
+func (OperationMetadata_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{1, 1}
+}
+
+// a slow piece of work that runs in the background
+type Operation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the operation
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// what the operation does and how far it got
+	Metadata *OperationMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// whether the operation is done, either with a response or an error
+	Done bool `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	// the outcome of the operation, set when it is done
+	//
+	// Types that are valid to be assigned to Result:
+	//
+	//	*Operation_Error
+	//	*Operation_Response
+	Result        isOperation_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Operation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Operation) GetMetadata() *OperationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Operation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *Operation) GetResult() isOperation_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Operation) GetError() *status.Status {
+	if x != nil {
+		if x, ok := x.Result.(*Operation_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *Operation) GetResponse() *anypb.Any {
+	if x != nil {
+		if x, ok := x.Result.(*Operation_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+type isOperation_Result interface {
+	isOperation_Result()
+}
+
+type Operation_Error struct {
+	// why the operation failed or that it was cancelled
+	Error *status.Status `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+type Operation_Response struct {
+	// the response of the operation: an api.meals.recipe.v1alpha1.ScrapeRecipeResponse
+	// for KIND_SCRAPE_RECIPE and KIND_OCR_RECIPE, an
+	// api.meals.recipe.v1alpha1.GenerateRecipeImageResponse for KIND_GENERATE_RECIPE_IMAGE,
+	// and none for KIND_IMPORT_RECIPES, whose report is kept on the recipe import
+	Response *anypb.Any `protobuf:"bytes,5,opt,name=response,proto3,oneof"`
+}
+
+func (*Operation_Error) isOperation_Result() {}
+
+func (*Operation_Response) isOperation_Result() {}
+
+// what an operation does and how far it got
+type OperationMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the work the operation does
+	Kind OperationMetadata_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=api.operations.operation.v1alpha1.OperationMetadata_Kind" json:"kind,omitempty"`
+	// the state of the operation
+	State OperationMetadata_State `protobuf:"varint,2,opt,name=state,proto3,enum=api.operations.operation.v1alpha1.OperationMetadata_State" json:"state,omitempty"`
+	// what the operation works on: the uri a recipe is scraped from or the
+	// recipe an image is generated for
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// the time the operation was started (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the operation last changed (UTC)
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// the time the operation was done (UTC)
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// the time the operation and its result will be deleted (UTC)
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationMetadata) Reset() {
+	*x = OperationMetadata{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationMetadata) ProtoMessage() {}
+
+func (x *OperationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationMetadata.ProtoReflect.Descriptor instead.
+func (*OperationMetadata) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OperationMetadata) GetKind() OperationMetadata_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return OperationMetadata_KIND_UNSPECIFIED
+}
+
+func (x *OperationMetadata) GetState() OperationMetadata_State {
+	if x != nil {
+		return x.State
+	}
+	return OperationMetadata_STATE_UNSPECIFIED
+}
+
+func (x *OperationMetadata) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *OperationMetadata) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *OperationMetadata) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *OperationMetadata) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *OperationMetadata) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// the request to get an operation
+type GetOperationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the operation
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOperationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to list operations
+type ListOperationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the user to list the operations of
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOperationsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOperationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list operations
+type ListOperationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the operations
+	Operations []*Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse) ProtoMessage() {}
+
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOperationsResponse) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *ListOperationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to cancel an operation
+type CancelOperationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the operation
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOperationRequest) Reset() {
+	*x = CancelOperationRequest{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationRequest) ProtoMessage() {}
+
+func (x *CancelOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelOperationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to delete an operation
+type DeleteOperationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the operation
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOperationRequest) Reset() {
+	*x = DeleteOperationRequest{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOperationRequest) ProtoMessage() {}
+
+func (x *DeleteOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOperationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteOperationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to wait for an operation
+type WaitOperationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the operation
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// how long to wait at most, defaults to and is capped at one minute
+	Timeout       *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitOperationRequest) Reset() {
+	*x = WaitOperationRequest{}
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitOperationRequest) ProtoMessage() {}
+
+func (x *WaitOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_operations_operation_v1alpha1_operation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitOperationRequest.ProtoReflect.Descriptor instead.
+func (*WaitOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WaitOperationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WaitOperationRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+var File_api_operations_operation_v1alpha1_operation_proto protoreflect.FileDescriptor
+
+const file_api_operations_operation_v1alpha1_operation_proto_rawDesc = "" +
+	"\n" +
+	"1api/operations/operation/v1alpha1/operation.proto\x12!api.operations.operation.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xec\x02\n" +
+	"\tOperation\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12U\n" +
+	"\bmetadata\x18\x02 \x01(\v24.api.operations.operation.v1alpha1.OperationMetadataB\x03\xe0A\x03R\bmetadata\x12\x17\n" +
+	"\x04done\x18\x03 \x01(\bB\x03\xe0A\x03R\x04done\x12*\n" +
+	"\x05error\x18\x04 \x01(\v2\x12.google.rpc.StatusH\x00R\x05error\x122\n" +
+	"\bresponse\x18\x05 \x01(\v2\x14.google.protobuf.AnyH\x00R\bresponse:l\xeaAi\n" +
+	"+api.operations.operation.v1alpha1/Operation\x12#users/{user}/operations/{operation}*\n" +
+	"operations2\toperationB\b\n" +
+	"\x06result\"\xe5\x05\n" +
+	"\x11OperationMetadata\x12R\n" +
+	"\x04kind\x18\x01 \x01(\x0e29.api.operations.operation.v1alpha1.OperationMetadata.KindB\x03\xe0A\x03R\x04kind\x12U\n" +
+	"\x05state\x18\x02 \x01(\x0e2:.api.operations.operation.v1alpha1.OperationMetadata.StateB\x03\xe0A\x03R\x05state\x12\x1b\n" +
+	"\x06target\x18\x03 \x01(\tB\x03\xe0A\x03R\x06target\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12:\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\aendTime\x12@\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"expireTime\"\x82\x01\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12KIND_SCRAPE_RECIPE\x10\x01\x12\x13\n" +
+	"\x0fKIND_OCR_RECIPE\x10\x02\x12\x1e\n" +
+	"\x1aKIND_GENERATE_RECIPE_IMAGE\x10\x03\x12\x17\n" +
+	"\x13KIND_IMPORT_RECIPES\x10\x04\"\x80\x01\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATE_PENDING\x10\x01\x12\x11\n" +
+	"\rSTATE_RUNNING\x10\x02\x12\x13\n" +
+	"\x0fSTATE_SUCCEEDED\x10\x03\x12\x10\n" +
+	"\fSTATE_FAILED\x10\x04\x12\x13\n" +
+	"\x0fSTATE_CANCELLED\x10\x05\"^\n" +
+	"\x13GetOperationRequest\x12G\n" +
+	"\x04name\x18\x01 \x01(\tB3\xe0A\x02\xfaA-\n" +
+	"+api.operations.operation.v1alpha1/OperationR\x04name\"\x9b\x01\n" +
+	"\x15ListOperationsRequest\x12<\n" +
+	"\x06parent\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1capi.users.user.v1alpha1/UserR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8e\x01\n" +
+	"\x16ListOperationsResponse\x12L\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2,.api.operations.operation.v1alpha1.OperationR\n" +
+	"operations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"a\n" +
+	"\x16CancelOperationRequest\x12G\n" +
+	"\x04name\x18\x01 \x01(\tB3\xe0A\x02\xfaA-\n" +
+	"+api.operations.operation.v1alpha1/OperationR\x04name\"a\n" +
+	"\x16DeleteOperationRequest\x12G\n" +
+	"\x04name\x18\x01 \x01(\tB3\xe0A\x02\xfaA-\n" +
+	"+api.operations.operation.v1alpha1/OperationR\x04name\"\x99\x01\n" +
+	"\x14WaitOperationRequest\x12G\n" +
+	"\x04name\x18\x01 \x01(\tB3\xe0A\x02\xfaA-\n" +
+	"+api.operations.operation.v1alpha1/OperationR\x04name\x128\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\atimeout2\xa6\r\n" +
+	"\x10OperationService\x12\xb0\x02\n" +
+	"\fGetOperation\x126.api.operations.operation.v1alpha1.GetOperationRequest\x1a,.api.operations.operation.v1alpha1.Operation\"\xb9\x01\x92Aw\n" +
+	"\x10OperationService\x12\x10Get an operation\x1aQRetrieves the latest state of an operation, including its result once it is done.\xdaA\x04name\x82\xd3\xe4\x93\x022\x120/operations/v1alpha1/{name=users/*/operations/*}\x12\xb6\x02\n" +
+	"\x0eListOperations\x128.api.operations.operation.v1alpha1.ListOperationsRequest\x1a9.api.operations.operation.v1alpha1.ListOperationsResponse\"\xae\x01\x92Aj\n" +
+	"\x10OperationService\x12\x0fList operations\x1aERetrieves a paginated list of the operations of a user, newest first.\xdaA\x06parent\x82\xd3\xe4\x93\x022\x120/operations/v1alpha1/{parent=users/*}/operations\x12\x86\x03\n" +
+	"\x0fCancelOperation\x129.api.operations.operation.v1alpha1.CancelOperationRequest\x1a,.api.operations.operation.v1alpha1.Operation\"\x89\x02\x92A\xbc\x01\n" +
+	"\x10OperationService\x12\x13Cancel an operation\x1a\x92\x01Stops an operation that is not done yet. The operation is done with a CANCELLED error. Cancelling an operation that is already done has no effect.\xdaA\x04name\x82\xd3\xe4\x93\x02<:\x01*\"7/operations/v1alpha1/{name=users/*/operations/*}:cancel\x12\xa4\x02\n" +
+	"\x0fDeleteOperation\x129.api.operations.operation.v1alpha1.DeleteOperationRequest\x1a\x16.google.protobuf.Empty\"\xbd\x01\x92A{\n" +
+	"\x10OperationService\x12\x13Delete an operation\x1aRDeletes an operation and its result. An operation that is not done yet is stopped.\xdaA\x04name\x82\xd3\xe4\x93\x022*0/operations/v1alpha1/{name=users/*/operations/*}\x12\xf5\x02\n" +
+	"\rWaitOperation\x127.api.operations.operation.v1alpha1.WaitOperationRequest\x1a,.api.operations.operation.v1alpha1.Operation\"\xfc\x01\x92A\xa9\x01\n" +
+	"\x10OperationService\x12\x15Wait for an operation\x1a~Waits until an operation is done or the timeout has passed, and returns its latest state. The timeout is capped at one minute.\xdaA\fname,timeout\x82\xd3\xe4\x93\x02::\x01*\"5/operations/v1alpha1/{name=users/*/operations/*}:waitB\x96\x03\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	"%com.api.operations.operation.v1alpha1B\x0eOperationProtoP\x01Z[github.com/jcfug8/daylear/server/genapi/api/operations/operation/v1alpha1;operationv1alpha1\xa2\x02\x03AOO\xaa\x02!Api.Operations.Operation.V1alpha1\xca\x02!Api\\Operations\\Operation\\V1alpha1\xe2\x02-Api\\Operations\\Operation\\V1alpha1\\GPBMetadata\xea\x02$Api::Operations::Operation::V1alpha1b\x06proto3"
+
+var (
+	file_api_operations_operation_v1alpha1_operation_proto_rawDescOnce sync.Once
+	file_api_operations_operation_v1alpha1_operation_proto_rawDescData []byte
+)
+
+func file_api_operations_operation_v1alpha1_operation_proto_rawDescGZIP() []byte {
+	file_api_operations_operation_v1alpha1_operation_proto_rawDescOnce.Do(func() {
+		file_api_operations_operation_v1alpha1_operation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_operations_operation_v1alpha1_operation_proto_rawDesc), len(file_api_operations_operation_v1alpha1_operation_proto_rawDesc)))
+	})
+	return file_api_operations_operation_v1alpha1_operation_proto_rawDescData
+}
+
+var file_api_operations_operation_v1alpha1_operation_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_operations_operation_v1alpha1_operation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_operations_operation_v1alpha1_operation_proto_goTypes = []any{
+	(OperationMetadata_Kind)(0),    // 0: api.operations.operation.v1alpha1.OperationMetadata.Kind
+	(OperationMetadata_State)(0),   // 1: api.operations.operation.v1alpha1.OperationMetadata.State
+	(*Operation)(nil),              // 2: api.operations.operation.v1alpha1.Operation
+	(*OperationMetadata)(nil),      // 3: api.operations.operation.v1alpha1.OperationMetadata
+	(*GetOperationRequest)(nil),    // 4: api.operations.operation.v1alpha1.GetOperationRequest
+	(*ListOperationsRequest)(nil),  // 5: api.operations.operation.v1alpha1.ListOperationsRequest
+	(*ListOperationsResponse)(nil), // 6: api.operations.operation.v1alpha1.ListOperationsResponse
+	(*CancelOperationRequest)(nil), // 7: api.operations.operation.v1alpha1.CancelOperationRequest
+	(*DeleteOperationRequest)(nil), // 8: api.operations.operation.v1alpha1.DeleteOperationRequest
+	(*WaitOperationRequest)(nil),   // 9: api.operations.operation.v1alpha1.WaitOperationRequest
+	(*status.Status)(nil),          // 10: google.rpc.Status
+	(*anypb.Any)(nil),              // 11: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 13: google.protobuf.Duration
+	(*emptypb.Empty)(nil),          // 14: google.protobuf.Empty
+}
+var file_api_operations_operation_v1alpha1_operation_proto_depIdxs = []int32{
+	3,  // 0: api.operations.operation.v1alpha1.Operation.metadata:type_name -> api.operations.operation.v1alpha1.OperationMetadata
+	10, // 1: api.operations.operation.v1alpha1.Operation.error:type_name -> google.rpc.Status
+	11, // 2: api.operations.operation.v1alpha1.Operation.response:type_name -> google.protobuf.Any
+	0,  // 3: api.operations.operation.v1alpha1.OperationMetadata.kind:type_name -> api.operations.operation.v1alpha1.OperationMetadata.Kind
+	1,  // 4: api.operations.operation.v1alpha1.OperationMetadata.state:type_name -> api.operations.operation.v1alpha1.OperationMetadata.State
+	12, // 5: api.operations.operation.v1alpha1.OperationMetadata.create_time:type_name -> google.protobuf.Timestamp
+	12, // 6: api.operations.operation.v1alpha1.OperationMetadata.update_time:type_name -> google.protobuf.Timestamp
+	12, // 7: api.operations.operation.v1alpha1.OperationMetadata.end_time:type_name -> google.protobuf.Timestamp
+	12, // 8: api.operations.operation.v1alpha1.OperationMetadata.expire_time:type_name -> google.protobuf.Timestamp
+	2,  // 9: api.operations.operation.v1alpha1.ListOperationsResponse.operations:type_name -> api.operations.operation.v1alpha1.Operation
+	13, // 10: api.operations.operation.v1alpha1.WaitOperationRequest.timeout:type_name -> google.protobuf.Duration
+	4,  // 11: api.operations.operation.v1alpha1.OperationService.GetOperation:input_type -> api.operations.operation.v1alpha1.GetOperationRequest
+	5,  // 12: api.operations.operation.v1alpha1.OperationService.ListOperations:input_type -> api.operations.operation.v1alpha1.ListOperationsRequest
+	7,  // 13: api.operations.operation.v1alpha1.OperationService.CancelOperation:input_type -> api.operations.operation.v1alpha1.CancelOperationRequest
+	8,  // 14: api.operations.operation.v1alpha1.OperationService.DeleteOperation:input_type -> api.operations.operation.v1alpha1.DeleteOperationRequest
+	9,  // 15: api.operations.operation.v1alpha1.OperationService.WaitOperation:input_type -> api.operations.operation.v1alpha1.WaitOperationRequest
+	2,  // 16: api.operations.operation.v1alpha1.OperationService.GetOperation:output_type -> api.operations.operation.v1alpha1.Operation
+	6,  // 17: api.operations.operation.v1alpha1.OperationService.ListOperations:output_type -> api.operations.operation.v1alpha1.ListOperationsResponse
+	2,  // 18: api.operations.operation.v1alpha1.OperationService.CancelOperation:output_type -> api.operations.operation.v1alpha1.Operation
+	14, // 19: api.operations.operation.v1alpha1.OperationService.DeleteOperation:output_type -> google.protobuf.Empty
+	2,  // 20: api.operations.operation.v1alpha1.OperationService.WaitOperation:output_type -> api.operations.operation.v1alpha1.Operation
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_api_operations_operation_v1alpha1_operation_proto_init() }
+func file_api_operations_operation_v1alpha1_operation_proto_init() {
+	if File_api_operations_operation_v1alpha1_operation_proto != nil {
+		return
+	}
+	file_api_operations_operation_v1alpha1_operation_proto_msgTypes[0].OneofWrappers = []any{
+		(*Operation_Error)(nil),
+		(*Operation_Response)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_operations_operation_v1alpha1_operation_proto_rawDesc), len(file_api_operations_operation_v1alpha1_operation_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_operations_operation_v1alpha1_operation_proto_goTypes,
+		DependencyIndexes: file_api_operations_operation_v1alpha1_operation_proto_depIdxs,
+		EnumInfos:         file_api_operations_operation_v1alpha1_operation_proto_enumTypes,
+		MessageInfos:      file_api_operations_operation_v1alpha1_operation_proto_msgTypes,
+	}.Build()
+	File_api_operations_operation_v1alpha1_operation_proto = out.File
+	file_api_operations_operation_v1alpha1_operation_proto_goTypes = nil
+	file_api_operations_operation_v1alpha1_operation_proto_depIdxs = nil
+}
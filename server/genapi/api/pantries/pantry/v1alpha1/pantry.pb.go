@@ -0,0 +1,929 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/pantries/pantry/v1alpha1/pantry.proto
+
+package pantryv1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	types "github.com/jcfug8/daylear/server/genapi/api/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// the main pantry object
+type Pantry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the title of the pantry
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the pantry
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// the visibility of the pantry
+	Visibility types.VisibilityLevel `protobuf:"varint,4,opt,name=visibility,proto3,enum=api.types.VisibilityLevel" json:"visibility,omitempty"`
+	// the access details for the current user/circle
+	PantryAccess *Pantry_PantryAccess `protobuf:"bytes,5,opt,name=pantry_access,json=pantryAccess,proto3" json:"pantry_access,omitempty"`
+	// the time the pantry was created (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the pantry was last updated (UTC)
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pantry) Reset() {
+	*x = Pantry{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pantry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pantry) ProtoMessage() {}
+
+func (x *Pantry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pantry.ProtoReflect.Descriptor instead.
+func (*Pantry) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pantry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pantry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Pantry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Pantry) GetVisibility() types.VisibilityLevel {
+	if x != nil {
+		return x.Visibility
+	}
+	return types.VisibilityLevel(0)
+}
+
+func (x *Pantry) GetPantryAccess() *Pantry_PantryAccess {
+	if x != nil {
+		return x.PantryAccess
+	}
+	return nil
+}
+
+func (x *Pantry) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Pantry) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// the request to create a pantry
+type CreatePantryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the pantry to create
+	Pantry *Pantry `protobuf:"bytes,1,opt,name=pantry,proto3" json:"pantry,omitempty"`
+	// the parent of the pantry
+	Parent        string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePantryRequest) Reset() {
+	*x = CreatePantryRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePantryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePantryRequest) ProtoMessage() {}
+
+func (x *CreatePantryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePantryRequest.ProtoReflect.Descriptor instead.
+func (*CreatePantryRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePantryRequest) GetPantry() *Pantry {
+	if x != nil {
+		return x.Pantry
+	}
+	return nil
+}
+
+func (x *CreatePantryRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// the request to list pantries
+type ListPantriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// returned page
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// used to specify the filter
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// the parent of the pantries
+	Parent        string `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPantriesRequest) Reset() {
+	*x = ListPantriesRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPantriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPantriesRequest) ProtoMessage() {}
+
+func (x *ListPantriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPantriesRequest.ProtoReflect.Descriptor instead.
+func (*ListPantriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPantriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPantriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPantriesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListPantriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// the response to list pantries
+type ListPantriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the pantries
+	Pantries []*Pantry `protobuf:"bytes,1,rep,name=pantries,proto3" json:"pantries,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPantriesResponse) Reset() {
+	*x = ListPantriesResponse{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPantriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPantriesResponse) ProtoMessage() {}
+
+func (x *ListPantriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPantriesResponse.ProtoReflect.Descriptor instead.
+func (*ListPantriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPantriesResponse) GetPantries() []*Pantry {
+	if x != nil {
+		return x.Pantries
+	}
+	return nil
+}
+
+func (x *ListPantriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to update a pantry
+type UpdatePantryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the pantry to update
+	Pantry *Pantry `protobuf:"bytes,1,opt,name=pantry,proto3" json:"pantry,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePantryRequest) Reset() {
+	*x = UpdatePantryRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePantryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePantryRequest) ProtoMessage() {}
+
+func (x *UpdatePantryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePantryRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePantryRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePantryRequest) GetPantry() *Pantry {
+	if x != nil {
+		return x.Pantry
+	}
+	return nil
+}
+
+func (x *UpdatePantryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to delete a pantry
+type DeletePantryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePantryRequest) Reset() {
+	*x = DeletePantryRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePantryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePantryRequest) ProtoMessage() {}
+
+func (x *DeletePantryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePantryRequest.ProtoReflect.Descriptor instead.
+func (*DeletePantryRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePantryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to get a pantry
+type GetPantryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPantryRequest) Reset() {
+	*x = GetPantryRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPantryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPantryRequest) ProtoMessage() {}
+
+func (x *GetPantryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPantryRequest.ProtoReflect.Descriptor instead.
+func (*GetPantryRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPantryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to consume the ingredients of a recipe
+type ConsumeRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the name of the cooked recipe
+	Recipe string `protobuf:"bytes,2,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// the number of servings cooked, the recipe yield is used when not set
+	Servings      float64 `protobuf:"fixed64,3,opt,name=servings,proto3" json:"servings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeRecipeRequest) Reset() {
+	*x = ConsumeRecipeRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeRecipeRequest) ProtoMessage() {}
+
+func (x *ConsumeRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConsumeRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConsumeRecipeRequest) GetRecipe() string {
+	if x != nil {
+		return x.Recipe
+	}
+	return ""
+}
+
+func (x *ConsumeRecipeRequest) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+// the response to consume the ingredients of a recipe
+type ConsumeRecipeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the pantry items that were decremented
+	PantryItems []*PantryItem `protobuf:"bytes,1,rep,name=pantry_items,json=pantryItems,proto3" json:"pantry_items,omitempty"`
+	// the titles of the recipe ingredients that were not found in the pantry
+	MissingIngredients []string `protobuf:"bytes,2,rep,name=missing_ingredients,json=missingIngredients,proto3" json:"missing_ingredients,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ConsumeRecipeResponse) Reset() {
+	*x = ConsumeRecipeResponse{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeRecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeRecipeResponse) ProtoMessage() {}
+
+func (x *ConsumeRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeRecipeResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConsumeRecipeResponse) GetPantryItems() []*PantryItem {
+	if x != nil {
+		return x.PantryItems
+	}
+	return nil
+}
+
+func (x *ConsumeRecipeResponse) GetMissingIngredients() []string {
+	if x != nil {
+		return x.MissingIngredients
+	}
+	return nil
+}
+
+// the request to restock a pantry
+type RestockPantryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the names of the bought grocery list items
+	ListItems     []string `protobuf:"bytes,2,rep,name=list_items,json=listItems,proto3" json:"list_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockPantryRequest) Reset() {
+	*x = RestockPantryRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockPantryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockPantryRequest) ProtoMessage() {}
+
+func (x *RestockPantryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockPantryRequest.ProtoReflect.Descriptor instead.
+func (*RestockPantryRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestockPantryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestockPantryRequest) GetListItems() []string {
+	if x != nil {
+		return x.ListItems
+	}
+	return nil
+}
+
+// the response to restock a pantry
+type RestockPantryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the pantry items that were created or incremented
+	PantryItems   []*PantryItem `protobuf:"bytes,1,rep,name=pantry_items,json=pantryItems,proto3" json:"pantry_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockPantryResponse) Reset() {
+	*x = RestockPantryResponse{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockPantryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockPantryResponse) ProtoMessage() {}
+
+func (x *RestockPantryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockPantryResponse.ProtoReflect.Descriptor instead.
+func (*RestockPantryResponse) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestockPantryResponse) GetPantryItems() []*PantryItem {
+	if x != nil {
+		return x.PantryItems
+	}
+	return nil
+}
+
+// the pantry access details
+type Pantry_PantryAccess struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry access
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the permission of the pantry
+	PermissionLevel types.PermissionLevel `protobuf:"varint,2,opt,name=permission_level,json=permissionLevel,proto3,enum=api.types.PermissionLevel" json:"permission_level,omitempty"`
+	// the access state of the user to the pantry
+	State types.AccessState `protobuf:"varint,3,opt,name=state,proto3,enum=api.types.AccessState" json:"state,omitempty"`
+	// the accept target of the pantry
+	AcceptTarget  types.AcceptTarget `protobuf:"varint,4,opt,name=accept_target,json=acceptTarget,proto3,enum=api.types.AcceptTarget" json:"accept_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pantry_PantryAccess) Reset() {
+	*x = Pantry_PantryAccess{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pantry_PantryAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pantry_PantryAccess) ProtoMessage() {}
+
+func (x *Pantry_PantryAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pantry_PantryAccess.ProtoReflect.Descriptor instead.
+func (*Pantry_PantryAccess) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Pantry_PantryAccess) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pantry_PantryAccess) GetPermissionLevel() types.PermissionLevel {
+	if x != nil {
+		return x.PermissionLevel
+	}
+	return types.PermissionLevel(0)
+}
+
+func (x *Pantry_PantryAccess) GetState() types.AccessState {
+	if x != nil {
+		return x.State
+	}
+	return types.AccessState(0)
+}
+
+func (x *Pantry_PantryAccess) GetAcceptTarget() types.AcceptTarget {
+	if x != nil {
+		return x.AcceptTarget
+	}
+	return types.AcceptTarget(0)
+}
+
+var File_api_pantries_pantry_v1alpha1_pantry_proto protoreflect.FileDescriptor
+
+const file_api_pantries_pantry_v1alpha1_pantry_proto_rawDesc = "" +
+	"\n" +
+	")api/pantries/pantry/v1alpha1/pantry.proto\x12\x1capi.pantries.pantry.v1alpha1\x1a.api/pantries/pantry/v1alpha1/pantry_item.proto\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x86\x06\n" +
+	"\x06Pantry\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x01R\vdescription\x12?\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x1a.api.types.VisibilityLevelB\x03\xe0A\x02R\n" +
+	"visibility\x12[\n" +
+	"\rpantry_access\x18\x05 \x01(\v21.api.pantries.pantry.v1alpha1.Pantry.PantryAccessB\x03\xe0A\x03R\fpantryAccess\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x1a\xe9\x01\n" +
+	"\fPantryAccess\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12J\n" +
+	"\x10permission_level\x18\x02 \x01(\x0e2\x1a.api.types.PermissionLevelB\x03\xe0A\x03R\x0fpermissionLevel\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x16.api.types.AccessStateB\x03\xe0A\x03R\x05state\x12A\n" +
+	"\raccept_target\x18\x04 \x01(\x0e2\x17.api.types.AcceptTargetB\x03\xe0A\x03R\facceptTarget:\x92\x01\xeaA\x8e\x01\n" +
+	"#api.pantries.pantry.v1alpha1/Pantry\x12\x11pantries/{pantry}\x12\"circles/{circle}/pantries/{pantry}\x12\x1eusers/{user}/pantries/{pantry}*\bpantries2\x06pantry\"\x9d\x01\n" +
+	"\x13CreatePantryRequest\x12A\n" +
+	"\x06pantry\x18\x01 \x01(\v2$.api.pantries.pantry.v1alpha1.PantryB\x03\xe0A\x02R\x06pantry\x12C\n" +
+	"\x06parent\x18\x02 \x01(\tB+\xe0A\x01\xfaA%\x12#api.pantries.pantry.v1alpha1/PantryR\x06parent\"\xbd\x01\n" +
+	"\x13ListPantriesRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12C\n" +
+	"\x06parent\x18\x04 \x01(\tB+\xe0A\x01\xfaA%\x12#api.pantries.pantry.v1alpha1/PantryR\x06parent\"\x80\x01\n" +
+	"\x14ListPantriesResponse\x12@\n" +
+	"\bpantries\x18\x01 \x03(\v2$.api.pantries.pantry.v1alpha1.PantryR\bpantries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9a\x01\n" +
+	"\x13UpdatePantryRequest\x12A\n" +
+	"\x06pantry\x18\x01 \x01(\v2$.api.pantries.pantry.v1alpha1.PantryB\x03\xe0A\x02R\x06pantry\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"V\n" +
+	"\x13DeletePantryRequest\x12?\n" +
+	"\x04name\x18\x01 \x01(\tB+\xe0A\x02\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x04name\"S\n" +
+	"\x10GetPantryRequest\x12?\n" +
+	"\x04name\x18\x01 \x01(\tB+\xe0A\x02\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x04name\"\xba\x01\n" +
+	"\x14ConsumeRecipeRequest\x12?\n" +
+	"\x04name\x18\x01 \x01(\tB+\xe0A\x02\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x04name\x12@\n" +
+	"\x06recipe\x18\x02 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.meals.recipe.v1alpha1/RecipeR\x06recipe\x12\x1f\n" +
+	"\bservings\x18\x03 \x01(\x01B\x03\xe0A\x01R\bservings\"\x95\x01\n" +
+	"\x15ConsumeRecipeResponse\x12K\n" +
+	"\fpantry_items\x18\x01 \x03(\v2(.api.pantries.pantry.v1alpha1.PantryItemR\vpantryItems\x12/\n" +
+	"\x13missing_ingredients\x18\x02 \x03(\tR\x12missingIngredients\"\xa0\x01\n" +
+	"\x14RestockPantryRequest\x12?\n" +
+	"\x04name\x18\x01 \x01(\tB+\xe0A\x02\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x04name\x12G\n" +
+	"\n" +
+	"list_items\x18\x02 \x03(\tB(\xe0A\x02\xfaA\"\n" +
+	" api.lists.list.v1alpha1/ListItemR\tlistItems\"d\n" +
+	"\x15RestockPantryResponse\x12K\n" +
+	"\fpantry_items\x18\x01 \x03(\v2(.api.pantries.pantry.v1alpha1.PantryItemR\vpantryItems2\xed\x11\n" +
+	"\rPantryService\x12\xec\x02\n" +
+	"\fCreatePantry\x121.api.pantries.pantry.v1alpha1.CreatePantryRequest\x1a$.api.pantries.pantry.v1alpha1.Pantry\"\x82\x02\x92AQ\n" +
+	"\rPantryService\x12\x0fCreate a pantry\x1a/Creates a new pantry with the provided details.\xdaA\rparent,pantry\x82\xd3\xe4\x93\x02\x97\x01:\x06pantryZ8:\x06pantry\"./pantries/v1alpha1/{parent=circles/*}/pantriesZ6:\x06pantry\",/pantries/v1alpha1/{parent=users/*}/pantries\"\x1b/pantries/v1alpha1/pantries\x12\xf3\x02\n" +
+	"\fListPantries\x121.api.pantries.pantry.v1alpha1.ListPantriesRequest\x1a2.api.pantries.pantry.v1alpha1.ListPantriesResponse\"\xfb\x01\x92Aj\n" +
+	"\rPantryService\x12\rList pantries\x1aJRetrieves a paginated list of pantries. Supports filtering and pagination.\xdaA\x06parent\x82\xd3\xe4\x93\x02\x7fZ0\x12./pantries/v1alpha1/{parent=circles/*}/pantriesZ.\x12,/pantries/v1alpha1/{parent=users/*}/pantries\x12\x1b/pantries/v1alpha1/pantries\x12\x89\x02\n" +
+	"\fUpdatePantry\x121.api.pantries.pantry.v1alpha1.UpdatePantryRequest\x1a$.api.pantries.pantry.v1alpha1.Pantry\"\x9f\x01\x92AL\n" +
+	"\rPantryService\x12\x0fUpdate a pantry\x1a*Updates the details of an existing pantry.\xdaA\x12pantry,update_mask\x82\xd3\xe4\x93\x025:\x06pantry2+/pantries/v1alpha1/{pantry.name=pantries/*}\x12\xf9\x01\n" +
+	"\fDeletePantry\x121.api.pantries.pantry.v1alpha1.DeletePantryRequest\x1a$.api.pantries.pantry.v1alpha1.Pantry\"\x8f\x01\x92AY\n" +
+	"\rPantryService\x12\x0fDelete a pantry\x1a7Deletes a pantry and all of its items by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02&*$/pantries/v1alpha1/{name=pantries/*}\x12\xe4\x01\n" +
+	"\tGetPantry\x12..api.pantries.pantry.v1alpha1.GetPantryRequest\x1a$.api.pantries.pantry.v1alpha1.Pantry\"\x80\x01\x92AJ\n" +
+	"\rPantryService\x12\fGet a pantry\x1a+Retrieves a single pantry by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x02&\x12$/pantries/v1alpha1/{name=pantries/*}\x12\xa0\x03\n" +
+	"\rConsumeRecipe\x122.api.pantries.pantry.v1alpha1.ConsumeRecipeRequest\x1a3.api.pantries.pantry.v1alpha1.ConsumeRecipeResponse\"\xa5\x02\x92A\xd6\x01\n" +
+	"\rPantryService\x12\x10Consume a recipe\x1a\xb2\x01Decrements the pantry items used by the ingredients of a cooked recipe. Amounts are converted between units of the same kind; items that run out are kept with a quantity of zero.\xdaA\vname,recipe\x82\xd3\xe4\x93\x027:\x01*\"2/pantries/v1alpha1/{name=pantries/*}:consumeRecipe\x12\xe4\x02\n" +
+	"\rRestockPantry\x122.api.pantries.pantry.v1alpha1.RestockPantryRequest\x1a3.api.pantries.pantry.v1alpha1.RestockPantryResponse\"\xe9\x01\x92A\x9c\x01\n" +
+	"\rPantryService\x12\x10Restock a pantry\x1ayIncrements the pantry items matching the given grocery list items, creating new items for anything not yet in the pantry.\xdaA\x0fname,list_items\x82\xd3\xe4\x93\x021:\x01*\",/pantries/v1alpha1/{name=pantries/*}:restockB\xf2\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	" com.api.pantries.pantry.v1alpha1B\vPantryProtoP\x01ZSgithub.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1;pantryv1alpha1\xa2\x02\x03APP\xaa\x02\x1cApi.Pantries.Pantry.V1alpha1\xca\x02\x1cApi\\Pantries\\Pantry\\V1alpha1\xe2\x02(Api\\Pantries\\Pantry\\V1alpha1\\GPBMetadata\xea\x02\x1fApi::Pantries::Pantry::V1alpha1b\x06proto3"
+
+var (
+	file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescOnce sync.Once
+	file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescData []byte
+)
+
+func file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescGZIP() []byte {
+	file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescOnce.Do(func() {
+		file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_pantries_pantry_v1alpha1_pantry_proto_rawDesc), len(file_api_pantries_pantry_v1alpha1_pantry_proto_rawDesc)))
+	})
+	return file_api_pantries_pantry_v1alpha1_pantry_proto_rawDescData
+}
+
+var file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_pantries_pantry_v1alpha1_pantry_proto_goTypes = []any{
+	(*Pantry)(nil),                // 0: api.pantries.pantry.v1alpha1.Pantry
+	(*CreatePantryRequest)(nil),   // 1: api.pantries.pantry.v1alpha1.CreatePantryRequest
+	(*ListPantriesRequest)(nil),   // 2: api.pantries.pantry.v1alpha1.ListPantriesRequest
+	(*ListPantriesResponse)(nil),  // 3: api.pantries.pantry.v1alpha1.ListPantriesResponse
+	(*UpdatePantryRequest)(nil),   // 4: api.pantries.pantry.v1alpha1.UpdatePantryRequest
+	(*DeletePantryRequest)(nil),   // 5: api.pantries.pantry.v1alpha1.DeletePantryRequest
+	(*GetPantryRequest)(nil),      // 6: api.pantries.pantry.v1alpha1.GetPantryRequest
+	(*ConsumeRecipeRequest)(nil),  // 7: api.pantries.pantry.v1alpha1.ConsumeRecipeRequest
+	(*ConsumeRecipeResponse)(nil), // 8: api.pantries.pantry.v1alpha1.ConsumeRecipeResponse
+	(*RestockPantryRequest)(nil),  // 9: api.pantries.pantry.v1alpha1.RestockPantryRequest
+	(*RestockPantryResponse)(nil), // 10: api.pantries.pantry.v1alpha1.RestockPantryResponse
+	(*Pantry_PantryAccess)(nil),   // 11: api.pantries.pantry.v1alpha1.Pantry.PantryAccess
+	(types.VisibilityLevel)(0),    // 12: api.types.VisibilityLevel
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 14: google.protobuf.FieldMask
+	(*PantryItem)(nil),            // 15: api.pantries.pantry.v1alpha1.PantryItem
+	(types.PermissionLevel)(0),    // 16: api.types.PermissionLevel
+	(types.AccessState)(0),        // 17: api.types.AccessState
+	(types.AcceptTarget)(0),       // 18: api.types.AcceptTarget
+}
+var file_api_pantries_pantry_v1alpha1_pantry_proto_depIdxs = []int32{
+	12, // 0: api.pantries.pantry.v1alpha1.Pantry.visibility:type_name -> api.types.VisibilityLevel
+	11, // 1: api.pantries.pantry.v1alpha1.Pantry.pantry_access:type_name -> api.pantries.pantry.v1alpha1.Pantry.PantryAccess
+	13, // 2: api.pantries.pantry.v1alpha1.Pantry.create_time:type_name -> google.protobuf.Timestamp
+	13, // 3: api.pantries.pantry.v1alpha1.Pantry.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: api.pantries.pantry.v1alpha1.CreatePantryRequest.pantry:type_name -> api.pantries.pantry.v1alpha1.Pantry
+	0,  // 5: api.pantries.pantry.v1alpha1.ListPantriesResponse.pantries:type_name -> api.pantries.pantry.v1alpha1.Pantry
+	0,  // 6: api.pantries.pantry.v1alpha1.UpdatePantryRequest.pantry:type_name -> api.pantries.pantry.v1alpha1.Pantry
+	14, // 7: api.pantries.pantry.v1alpha1.UpdatePantryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	15, // 8: api.pantries.pantry.v1alpha1.ConsumeRecipeResponse.pantry_items:type_name -> api.pantries.pantry.v1alpha1.PantryItem
+	15, // 9: api.pantries.pantry.v1alpha1.RestockPantryResponse.pantry_items:type_name -> api.pantries.pantry.v1alpha1.PantryItem
+	16, // 10: api.pantries.pantry.v1alpha1.Pantry.PantryAccess.permission_level:type_name -> api.types.PermissionLevel
+	17, // 11: api.pantries.pantry.v1alpha1.Pantry.PantryAccess.state:type_name -> api.types.AccessState
+	18, // 12: api.pantries.pantry.v1alpha1.Pantry.PantryAccess.accept_target:type_name -> api.types.AcceptTarget
+	1,  // 13: api.pantries.pantry.v1alpha1.PantryService.CreatePantry:input_type -> api.pantries.pantry.v1alpha1.CreatePantryRequest
+	2,  // 14: api.pantries.pantry.v1alpha1.PantryService.ListPantries:input_type -> api.pantries.pantry.v1alpha1.ListPantriesRequest
+	4,  // 15: api.pantries.pantry.v1alpha1.PantryService.UpdatePantry:input_type -> api.pantries.pantry.v1alpha1.UpdatePantryRequest
+	5,  // 16: api.pantries.pantry.v1alpha1.PantryService.DeletePantry:input_type -> api.pantries.pantry.v1alpha1.DeletePantryRequest
+	6,  // 17: api.pantries.pantry.v1alpha1.PantryService.GetPantry:input_type -> api.pantries.pantry.v1alpha1.GetPantryRequest
+	7,  // 18: api.pantries.pantry.v1alpha1.PantryService.ConsumeRecipe:input_type -> api.pantries.pantry.v1alpha1.ConsumeRecipeRequest
+	9,  // 19: api.pantries.pantry.v1alpha1.PantryService.RestockPantry:input_type -> api.pantries.pantry.v1alpha1.RestockPantryRequest
+	0,  // 20: api.pantries.pantry.v1alpha1.PantryService.CreatePantry:output_type -> api.pantries.pantry.v1alpha1.Pantry
+	3,  // 21: api.pantries.pantry.v1alpha1.PantryService.ListPantries:output_type -> api.pantries.pantry.v1alpha1.ListPantriesResponse
+	0,  // 22: api.pantries.pantry.v1alpha1.PantryService.UpdatePantry:output_type -> api.pantries.pantry.v1alpha1.Pantry
+	0,  // 23: api.pantries.pantry.v1alpha1.PantryService.DeletePantry:output_type -> api.pantries.pantry.v1alpha1.Pantry
+	0,  // 24: api.pantries.pantry.v1alpha1.PantryService.GetPantry:output_type -> api.pantries.pantry.v1alpha1.Pantry
+	8,  // 25: api.pantries.pantry.v1alpha1.PantryService.ConsumeRecipe:output_type -> api.pantries.pantry.v1alpha1.ConsumeRecipeResponse
+	10, // 26: api.pantries.pantry.v1alpha1.PantryService.RestockPantry:output_type -> api.pantries.pantry.v1alpha1.RestockPantryResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_api_pantries_pantry_v1alpha1_pantry_proto_init() }
+func file_api_pantries_pantry_v1alpha1_pantry_proto_init() {
+	if File_api_pantries_pantry_v1alpha1_pantry_proto != nil {
+		return
+	}
+	file_api_pantries_pantry_v1alpha1_pantry_item_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_pantries_pantry_v1alpha1_pantry_proto_rawDesc), len(file_api_pantries_pantry_v1alpha1_pantry_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_pantries_pantry_v1alpha1_pantry_proto_goTypes,
+		DependencyIndexes: file_api_pantries_pantry_v1alpha1_pantry_proto_depIdxs,
+		MessageInfos:      file_api_pantries_pantry_v1alpha1_pantry_proto_msgTypes,
+	}.Build()
+	File_api_pantries_pantry_v1alpha1_pantry_proto = out.File
+	file_api_pantries_pantry_v1alpha1_pantry_proto_goTypes = nil
+	file_api_pantries_pantry_v1alpha1_pantry_proto_depIdxs = nil
+}
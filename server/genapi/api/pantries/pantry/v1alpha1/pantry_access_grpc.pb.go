@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/pantries/pantry/v1alpha1/pantry_access.proto
+
+package pantryv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	PantryAccessService_CreateAccess_FullMethodName       = "/api.pantries.pantry.v1alpha1.PantryAccessService/CreateAccess"
+	PantryAccessService_DeleteAccess_FullMethodName       = "/api.pantries.pantry.v1alpha1.PantryAccessService/DeleteAccess"
+	PantryAccessService_GetAccess_FullMethodName          = "/api.pantries.pantry.v1alpha1.PantryAccessService/GetAccess"
+	PantryAccessService_ListAccesses_FullMethodName       = "/api.pantries.pantry.v1alpha1.PantryAccessService/ListAccesses"
+	PantryAccessService_UpdateAccess_FullMethodName       = "/api.pantries.pantry.v1alpha1.PantryAccessService/UpdateAccess"
+	PantryAccessService_AcceptPantryAccess_FullMethodName = "/api.pantries.pantry.v1alpha1.PantryAccessService/AcceptPantryAccess"
+)
+
+// PantryAccessServiceClient is the client API for PantryAccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The pantry access service
+type PantryAccessServiceClient interface {
+	// Create an access to a pantry
+	CreateAccess(ctx context.Context, in *CreateAccessRequest, opts ...grpc.CallOption) (*Access, error)
+	// Delete an access to a pantry
+	DeleteAccess(ctx context.Context, in *DeleteAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get an access to a pantry
+	GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*Access, error)
+	// List accesses to a pantry
+	ListAccesses(ctx context.Context, in *ListAccessesRequest, opts ...grpc.CallOption) (*ListAccessesResponse, error)
+	// Update an access to a pantry
+	UpdateAccess(ctx context.Context, in *UpdateAccessRequest, opts ...grpc.CallOption) (*Access, error)
+	// Accept a pantry access
+	AcceptPantryAccess(ctx context.Context, in *AcceptPantryAccessRequest, opts ...grpc.CallOption) (*AcceptPantryAccessResponse, error)
+}
+
+type pantryAccessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPantryAccessServiceClient(cc grpc.ClientConnInterface) PantryAccessServiceClient {
+	return &pantryAccessServiceClient{cc}
+}
+
+func (c *pantryAccessServiceClient) CreateAccess(ctx context.Context, in *CreateAccessRequest, opts ...grpc.CallOption) (*Access, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Access)
+	err := c.cc.Invoke(ctx, PantryAccessService_CreateAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryAccessServiceClient) DeleteAccess(ctx context.Context, in *DeleteAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PantryAccessService_DeleteAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryAccessServiceClient) GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*Access, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Access)
+	err := c.cc.Invoke(ctx, PantryAccessService_GetAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryAccessServiceClient) ListAccesses(ctx context.Context, in *ListAccessesRequest, opts ...grpc.CallOption) (*ListAccessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessesResponse)
+	err := c.cc.Invoke(ctx, PantryAccessService_ListAccesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryAccessServiceClient) UpdateAccess(ctx context.Context, in *UpdateAccessRequest, opts ...grpc.CallOption) (*Access, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Access)
+	err := c.cc.Invoke(ctx, PantryAccessService_UpdateAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryAccessServiceClient) AcceptPantryAccess(ctx context.Context, in *AcceptPantryAccessRequest, opts ...grpc.CallOption) (*AcceptPantryAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptPantryAccessResponse)
+	err := c.cc.Invoke(ctx, PantryAccessService_AcceptPantryAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PantryAccessServiceServer is the server API for PantryAccessService service.
+// All implementations must embed UnimplementedPantryAccessServiceServer
+// for forward compatibility.
+//
+// The pantry access service
+type PantryAccessServiceServer interface {
+	// Create an access to a pantry
+	CreateAccess(context.Context, *CreateAccessRequest) (*Access, error)
+	// Delete an access to a pantry
+	DeleteAccess(context.Context, *DeleteAccessRequest) (*emptypb.Empty, error)
+	// Get an access to a pantry
+	GetAccess(context.Context, *GetAccessRequest) (*Access, error)
+	// List accesses to a pantry
+	ListAccesses(context.Context, *ListAccessesRequest) (*ListAccessesResponse, error)
+	// Update an access to a pantry
+	UpdateAccess(context.Context, *UpdateAccessRequest) (*Access, error)
+	// Accept a pantry access
+	AcceptPantryAccess(context.Context, *AcceptPantryAccessRequest) (*AcceptPantryAccessResponse, error)
+	mustEmbedUnimplementedPantryAccessServiceServer()
+}
+
+// UnimplementedPantryAccessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPantryAccessServiceServer struct{}
+
+func (UnimplementedPantryAccessServiceServer) CreateAccess(context.Context, *CreateAccessRequest) (*Access, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccess not implemented")
+}
+func (UnimplementedPantryAccessServiceServer) DeleteAccess(context.Context, *DeleteAccessRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+}
+func (UnimplementedPantryAccessServiceServer) GetAccess(context.Context, *GetAccessRequest) (*Access, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccess not implemented")
+}
+func (UnimplementedPantryAccessServiceServer) ListAccesses(context.Context, *ListAccessesRequest) (*ListAccessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccesses not implemented")
+}
+func (UnimplementedPantryAccessServiceServer) UpdateAccess(context.Context, *UpdateAccessRequest) (*Access, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccess not implemented")
+}
+func (UnimplementedPantryAccessServiceServer) AcceptPantryAccess(context.Context, *AcceptPantryAccessRequest) (*AcceptPantryAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPantryAccess not implemented")
+}
+func (UnimplementedPantryAccessServiceServer) mustEmbedUnimplementedPantryAccessServiceServer() {}
+func (UnimplementedPantryAccessServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafePantryAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PantryAccessServiceServer will
+// result in compilation errors.
+type UnsafePantryAccessServiceServer interface {
+	mustEmbedUnimplementedPantryAccessServiceServer()
+}
+
+func RegisterPantryAccessServiceServer(s grpc.ServiceRegistrar, srv PantryAccessServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPantryAccessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PantryAccessService_ServiceDesc, srv)
+}
+
+func _PantryAccessService_CreateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryAccessServiceServer).CreateAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryAccessService_CreateAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryAccessServiceServer).CreateAccess(ctx, req.(*CreateAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryAccessService_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryAccessServiceServer).DeleteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryAccessService_DeleteAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryAccessServiceServer).DeleteAccess(ctx, req.(*DeleteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryAccessService_GetAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryAccessServiceServer).GetAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryAccessService_GetAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryAccessServiceServer).GetAccess(ctx, req.(*GetAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryAccessService_ListAccesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryAccessServiceServer).ListAccesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryAccessService_ListAccesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryAccessServiceServer).ListAccesses(ctx, req.(*ListAccessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryAccessService_UpdateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryAccessServiceServer).UpdateAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryAccessService_UpdateAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryAccessServiceServer).UpdateAccess(ctx, req.(*UpdateAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryAccessService_AcceptPantryAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPantryAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryAccessServiceServer).AcceptPantryAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryAccessService_AcceptPantryAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryAccessServiceServer).AcceptPantryAccess(ctx, req.(*AcceptPantryAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PantryAccessService_ServiceDesc is the grpc.ServiceDesc for PantryAccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PantryAccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.pantries.pantry.v1alpha1.PantryAccessService",
+	HandlerType: (*PantryAccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccess",
+			Handler:    _PantryAccessService_CreateAccess_Handler,
+		},
+		{
+			MethodName: "DeleteAccess",
+			Handler:    _PantryAccessService_DeleteAccess_Handler,
+		},
+		{
+			MethodName: "GetAccess",
+			Handler:    _PantryAccessService_GetAccess_Handler,
+		},
+		{
+			MethodName: "ListAccesses",
+			Handler:    _PantryAccessService_ListAccesses_Handler,
+		},
+		{
+			MethodName: "UpdateAccess",
+			Handler:    _PantryAccessService_UpdateAccess_Handler,
+		},
+		{
+			MethodName: "AcceptPantryAccess",
+			Handler:    _PantryAccessService_AcceptPantryAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pantries/pantry/v1alpha1/pantry_access.proto",
+}
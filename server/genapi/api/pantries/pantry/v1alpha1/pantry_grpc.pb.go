@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/pantries/pantry/v1alpha1/pantry.proto
+
+package pantryv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	PantryService_CreatePantry_FullMethodName  = "/api.pantries.pantry.v1alpha1.PantryService/CreatePantry"
+	PantryService_ListPantries_FullMethodName  = "/api.pantries.pantry.v1alpha1.PantryService/ListPantries"
+	PantryService_UpdatePantry_FullMethodName  = "/api.pantries.pantry.v1alpha1.PantryService/UpdatePantry"
+	PantryService_DeletePantry_FullMethodName  = "/api.pantries.pantry.v1alpha1.PantryService/DeletePantry"
+	PantryService_GetPantry_FullMethodName     = "/api.pantries.pantry.v1alpha1.PantryService/GetPantry"
+	PantryService_ConsumeRecipe_FullMethodName = "/api.pantries.pantry.v1alpha1.PantryService/ConsumeRecipe"
+	PantryService_RestockPantry_FullMethodName = "/api.pantries.pantry.v1alpha1.PantryService/RestockPantry"
+)
+
+// PantryServiceClient is the client API for PantryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the pantry service
+type PantryServiceClient interface {
+	// create a pantry
+	CreatePantry(ctx context.Context, in *CreatePantryRequest, opts ...grpc.CallOption) (*Pantry, error)
+	// list pantries
+	ListPantries(ctx context.Context, in *ListPantriesRequest, opts ...grpc.CallOption) (*ListPantriesResponse, error)
+	// update a pantry
+	UpdatePantry(ctx context.Context, in *UpdatePantryRequest, opts ...grpc.CallOption) (*Pantry, error)
+	// delete a pantry
+	DeletePantry(ctx context.Context, in *DeletePantryRequest, opts ...grpc.CallOption) (*Pantry, error)
+	// get a pantry
+	GetPantry(ctx context.Context, in *GetPantryRequest, opts ...grpc.CallOption) (*Pantry, error)
+	// use up the ingredients of a cooked recipe
+	ConsumeRecipe(ctx context.Context, in *ConsumeRecipeRequest, opts ...grpc.CallOption) (*ConsumeRecipeResponse, error)
+	// restock the pantry from bought grocery list items
+	RestockPantry(ctx context.Context, in *RestockPantryRequest, opts ...grpc.CallOption) (*RestockPantryResponse, error)
+}
+
+type pantryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPantryServiceClient(cc grpc.ClientConnInterface) PantryServiceClient {
+	return &pantryServiceClient{cc}
+}
+
+func (c *pantryServiceClient) CreatePantry(ctx context.Context, in *CreatePantryRequest, opts ...grpc.CallOption) (*Pantry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pantry)
+	err := c.cc.Invoke(ctx, PantryService_CreatePantry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryServiceClient) ListPantries(ctx context.Context, in *ListPantriesRequest, opts ...grpc.CallOption) (*ListPantriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPantriesResponse)
+	err := c.cc.Invoke(ctx, PantryService_ListPantries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryServiceClient) UpdatePantry(ctx context.Context, in *UpdatePantryRequest, opts ...grpc.CallOption) (*Pantry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pantry)
+	err := c.cc.Invoke(ctx, PantryService_UpdatePantry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryServiceClient) DeletePantry(ctx context.Context, in *DeletePantryRequest, opts ...grpc.CallOption) (*Pantry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pantry)
+	err := c.cc.Invoke(ctx, PantryService_DeletePantry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryServiceClient) GetPantry(ctx context.Context, in *GetPantryRequest, opts ...grpc.CallOption) (*Pantry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pantry)
+	err := c.cc.Invoke(ctx, PantryService_GetPantry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryServiceClient) ConsumeRecipe(ctx context.Context, in *ConsumeRecipeRequest, opts ...grpc.CallOption) (*ConsumeRecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeRecipeResponse)
+	err := c.cc.Invoke(ctx, PantryService_ConsumeRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryServiceClient) RestockPantry(ctx context.Context, in *RestockPantryRequest, opts ...grpc.CallOption) (*RestockPantryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestockPantryResponse)
+	err := c.cc.Invoke(ctx, PantryService_RestockPantry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PantryServiceServer is the server API for PantryService service.
+// All implementations must embed UnimplementedPantryServiceServer
+// for forward compatibility.
+//
+// the pantry service
+type PantryServiceServer interface {
+	// create a pantry
+	CreatePantry(context.Context, *CreatePantryRequest) (*Pantry, error)
+	// list pantries
+	ListPantries(context.Context, *ListPantriesRequest) (*ListPantriesResponse, error)
+	// update a pantry
+	UpdatePantry(context.Context, *UpdatePantryRequest) (*Pantry, error)
+	// delete a pantry
+	DeletePantry(context.Context, *DeletePantryRequest) (*Pantry, error)
+	// get a pantry
+	GetPantry(context.Context, *GetPantryRequest) (*Pantry, error)
+	// use up the ingredients of a cooked recipe
+	ConsumeRecipe(context.Context, *ConsumeRecipeRequest) (*ConsumeRecipeResponse, error)
+	// restock the pantry from bought grocery list items
+	RestockPantry(context.Context, *RestockPantryRequest) (*RestockPantryResponse, error)
+	mustEmbedUnimplementedPantryServiceServer()
+}
+
+// UnimplementedPantryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPantryServiceServer struct{}
+
+func (UnimplementedPantryServiceServer) CreatePantry(context.Context, *CreatePantryRequest) (*Pantry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePantry not implemented")
+}
+func (UnimplementedPantryServiceServer) ListPantries(context.Context, *ListPantriesRequest) (*ListPantriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPantries not implemented")
+}
+func (UnimplementedPantryServiceServer) UpdatePantry(context.Context, *UpdatePantryRequest) (*Pantry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePantry not implemented")
+}
+func (UnimplementedPantryServiceServer) DeletePantry(context.Context, *DeletePantryRequest) (*Pantry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePantry not implemented")
+}
+func (UnimplementedPantryServiceServer) GetPantry(context.Context, *GetPantryRequest) (*Pantry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPantry not implemented")
+}
+func (UnimplementedPantryServiceServer) ConsumeRecipe(context.Context, *ConsumeRecipeRequest) (*ConsumeRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeRecipe not implemented")
+}
+func (UnimplementedPantryServiceServer) RestockPantry(context.Context, *RestockPantryRequest) (*RestockPantryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestockPantry not implemented")
+}
+func (UnimplementedPantryServiceServer) mustEmbedUnimplementedPantryServiceServer() {}
+func (UnimplementedPantryServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePantryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PantryServiceServer will
+// result in compilation errors.
+type UnsafePantryServiceServer interface {
+	mustEmbedUnimplementedPantryServiceServer()
+}
+
+func RegisterPantryServiceServer(s grpc.ServiceRegistrar, srv PantryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPantryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PantryService_ServiceDesc, srv)
+}
+
+func _PantryService_CreatePantry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePantryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServiceServer).CreatePantry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryService_CreatePantry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServiceServer).CreatePantry(ctx, req.(*CreatePantryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryService_ListPantries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPantriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServiceServer).ListPantries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryService_ListPantries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServiceServer).ListPantries(ctx, req.(*ListPantriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryService_UpdatePantry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePantryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServiceServer).UpdatePantry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryService_UpdatePantry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServiceServer).UpdatePantry(ctx, req.(*UpdatePantryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryService_DeletePantry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePantryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServiceServer).DeletePantry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryService_DeletePantry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServiceServer).DeletePantry(ctx, req.(*DeletePantryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryService_GetPantry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPantryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServiceServer).GetPantry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryService_GetPantry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServiceServer).GetPantry(ctx, req.(*GetPantryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryService_ConsumeRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServiceServer).ConsumeRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryService_ConsumeRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServiceServer).ConsumeRecipe(ctx, req.(*ConsumeRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryService_RestockPantry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestockPantryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServiceServer).RestockPantry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryService_RestockPantry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServiceServer).RestockPantry(ctx, req.(*RestockPantryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PantryService_ServiceDesc is the grpc.ServiceDesc for PantryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PantryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.pantries.pantry.v1alpha1.PantryService",
+	HandlerType: (*PantryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePantry",
+			Handler:    _PantryService_CreatePantry_Handler,
+		},
+		{
+			MethodName: "ListPantries",
+			Handler:    _PantryService_ListPantries_Handler,
+		},
+		{
+			MethodName: "UpdatePantry",
+			Handler:    _PantryService_UpdatePantry_Handler,
+		},
+		{
+			MethodName: "DeletePantry",
+			Handler:    _PantryService_DeletePantry_Handler,
+		},
+		{
+			MethodName: "GetPantry",
+			Handler:    _PantryService_GetPantry_Handler,
+		},
+		{
+			MethodName: "ConsumeRecipe",
+			Handler:    _PantryService_ConsumeRecipe_Handler,
+		},
+		{
+			MethodName: "RestockPantry",
+			Handler:    _PantryService_RestockPantry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pantries/pantry/v1alpha1/pantry.proto",
+}
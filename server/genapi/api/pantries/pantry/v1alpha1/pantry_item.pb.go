@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/pantries/pantry/v1alpha1/pantry_item.proto
+
+package pantryv1alpha1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	v1alpha1 "github.com/jcfug8/daylear/server/genapi/api/meals/recipe/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// where a pantry item is stored
+type PantryItem_Location int32
+
+const (
+	// the location is not specified
+	PantryItem_LOCATION_UNSPECIFIED PantryItem_Location = 0
+	// the item is kept in the fridge
+	PantryItem_LOCATION_FRIDGE PantryItem_Location = 1
+	// the item is kept in the freezer
+	PantryItem_LOCATION_FREEZER PantryItem_Location = 2
+	// the item is kept in the pantry cupboard
+	PantryItem_LOCATION_PANTRY PantryItem_Location = 3
+)
+
+// Enum value maps for PantryItem_Location.
+var (
+	PantryItem_Location_name = map[int32]string{
+		0: "LOCATION_UNSPECIFIED",
+		1: "LOCATION_FRIDGE",
+		2: "LOCATION_FREEZER",
+		3: "LOCATION_PANTRY",
+	}
+	PantryItem_Location_value = map[string]int32{
+		"LOCATION_UNSPECIFIED": 0,
+		"LOCATION_FRIDGE":      1,
+		"LOCATION_FREEZER":     2,
+		"LOCATION_PANTRY":      3,
+	}
+)
+
+func (x PantryItem_Location) Enum() *PantryItem_Location {
+	p := new(PantryItem_Location)
+	*p = x
+	return p
+}
+
+func (x PantryItem_Location) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PantryItem_Location) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_enumTypes[0].Descriptor()
+}
+
+func (PantryItem_Location) Type() protoreflect.EnumType {
+	return &file_api_pantries_pantry_v1alpha1_pantry_item_proto_enumTypes[0]
+}
+
+func (x PantryItem_Location) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PantryItem_Location.Descriptor instead.
+func (PantryItem_Location) EnumDescriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// an item stocked in a pantry
+type PantryItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry item
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the title of the pantry item, e.g. "flour"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// the quantity on hand, in the unit of the item
+	Quantity float64 `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the unit of the quantity, unspecified for a count
+	Unit v1alpha1.Recipe_MeasurementType `protobuf:"varint,4,opt,name=unit,proto3,enum=api.meals.recipe.v1alpha1.Recipe_MeasurementType" json:"unit,omitempty"`
+	// where the item is stored
+	Location PantryItem_Location `protobuf:"varint,5,opt,name=location,proto3,enum=api.pantries.pantry.v1alpha1.PantryItem_Location" json:"location,omitempty"`
+	// the time the item was bought (UTC)
+	PurchaseTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=purchase_time,json=purchaseTime,proto3" json:"purchase_time,omitempty"`
+	// the time the item expires (UTC)
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// the time the pantry item was created (UTC)
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time the pantry item was last updated (UTC)
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PantryItem) Reset() {
+	*x = PantryItem{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PantryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItem) ProtoMessage() {}
+
+func (x *PantryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItem.ProtoReflect.Descriptor instead.
+func (*PantryItem) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PantryItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PantryItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PantryItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PantryItem) GetUnit() v1alpha1.Recipe_MeasurementType {
+	if x != nil {
+		return x.Unit
+	}
+	return v1alpha1.Recipe_MeasurementType(0)
+}
+
+func (x *PantryItem) GetLocation() PantryItem_Location {
+	if x != nil {
+		return x.Location
+	}
+	return PantryItem_LOCATION_UNSPECIFIED
+}
+
+func (x *PantryItem) GetPurchaseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurchaseTime
+	}
+	return nil
+}
+
+func (x *PantryItem) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *PantryItem) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *PantryItem) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// the request to create a pantry item
+type CreatePantryItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent pantry
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the pantry item to create
+	PantryItem    *PantryItem `protobuf:"bytes,2,opt,name=pantry_item,json=pantryItem,proto3" json:"pantry_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePantryItemRequest) Reset() {
+	*x = CreatePantryItemRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePantryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePantryItemRequest) ProtoMessage() {}
+
+func (x *CreatePantryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePantryItemRequest.ProtoReflect.Descriptor instead.
+func (*CreatePantryItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePantryItemRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreatePantryItemRequest) GetPantryItem() *PantryItem {
+	if x != nil {
+		return x.PantryItem
+	}
+	return nil
+}
+
+// the request to list pantry items
+type ListPantryItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent pantry
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// used to specify the filter
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPantryItemsRequest) Reset() {
+	*x = ListPantryItemsRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPantryItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPantryItemsRequest) ProtoMessage() {}
+
+func (x *ListPantryItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPantryItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListPantryItemsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPantryItemsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListPantryItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPantryItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPantryItemsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// the response to list pantry items
+type ListPantryItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the pantry items
+	PantryItems []*PantryItem `protobuf:"bytes,1,rep,name=pantry_items,json=pantryItems,proto3" json:"pantry_items,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPantryItemsResponse) Reset() {
+	*x = ListPantryItemsResponse{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPantryItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPantryItemsResponse) ProtoMessage() {}
+
+func (x *ListPantryItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPantryItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListPantryItemsResponse) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPantryItemsResponse) GetPantryItems() []*PantryItem {
+	if x != nil {
+		return x.PantryItems
+	}
+	return nil
+}
+
+func (x *ListPantryItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to list the pantry items that expire soon
+type ListExpiringPantryItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent pantry
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// how far ahead to look, defaults to three days
+	ExpireWithin *durationpb.Duration `protobuf:"bytes,2,opt,name=expire_within,json=expireWithin,proto3" json:"expire_within,omitempty"`
+	// returned page
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// used to specify the page token
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringPantryItemsRequest) Reset() {
+	*x = ListExpiringPantryItemsRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringPantryItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringPantryItemsRequest) ProtoMessage() {}
+
+func (x *ListExpiringPantryItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringPantryItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringPantryItemsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListExpiringPantryItemsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListExpiringPantryItemsRequest) GetExpireWithin() *durationpb.Duration {
+	if x != nil {
+		return x.ExpireWithin
+	}
+	return nil
+}
+
+func (x *ListExpiringPantryItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExpiringPantryItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// the response to list the pantry items that expire soon
+type ListExpiringPantryItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the expiring pantry items, soonest first
+	PantryItems []*PantryItem `protobuf:"bytes,1,rep,name=pantry_items,json=pantryItems,proto3" json:"pantry_items,omitempty"`
+	// the next page token
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringPantryItemsResponse) Reset() {
+	*x = ListExpiringPantryItemsResponse{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringPantryItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringPantryItemsResponse) ProtoMessage() {}
+
+func (x *ListExpiringPantryItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringPantryItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiringPantryItemsResponse) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListExpiringPantryItemsResponse) GetPantryItems() []*PantryItem {
+	if x != nil {
+		return x.PantryItems
+	}
+	return nil
+}
+
+func (x *ListExpiringPantryItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// the request to update a pantry item
+type UpdatePantryItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the pantry item to update
+	PantryItem *PantryItem `protobuf:"bytes,1,opt,name=pantry_item,json=pantryItem,proto3" json:"pantry_item,omitempty"`
+	// the fields to update
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePantryItemRequest) Reset() {
+	*x = UpdatePantryItemRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePantryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePantryItemRequest) ProtoMessage() {}
+
+func (x *UpdatePantryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePantryItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePantryItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdatePantryItemRequest) GetPantryItem() *PantryItem {
+	if x != nil {
+		return x.PantryItem
+	}
+	return nil
+}
+
+func (x *UpdatePantryItemRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// the request to delete a pantry item
+type DeletePantryItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry item
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePantryItemRequest) Reset() {
+	*x = DeletePantryItemRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePantryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePantryItemRequest) ProtoMessage() {}
+
+func (x *DeletePantryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePantryItemRequest.ProtoReflect.Descriptor instead.
+func (*DeletePantryItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletePantryItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// the request to get a pantry item
+type GetPantryItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the name of the pantry item
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPantryItemRequest) Reset() {
+	*x = GetPantryItemRequest{}
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPantryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPantryItemRequest) ProtoMessage() {}
+
+func (x *GetPantryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPantryItemRequest.ProtoReflect.Descriptor instead.
+func (*GetPantryItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPantryItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_pantries_pantry_v1alpha1_pantry_item_proto protoreflect.FileDescriptor
+
+const file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDesc = "" +
+	"\n" +
+	".api/pantries/pantry/v1alpha1/pantry_item.proto\x12\x1capi.pantries.pantry.v1alpha1\x1a&api/meals/recipe/v1alpha1/recipe.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe7\x05\n" +
+	"\n" +
+	"PantryItem\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1f\n" +
+	"\bquantity\x18\x03 \x01(\x01B\x03\xe0A\x01R\bquantity\x12J\n" +
+	"\x04unit\x18\x04 \x01(\x0e21.api.meals.recipe.v1alpha1.Recipe.MeasurementTypeB\x03\xe0A\x01R\x04unit\x12R\n" +
+	"\blocation\x18\x05 \x01(\x0e21.api.pantries.pantry.v1alpha1.PantryItem.LocationB\x03\xe0A\x01R\blocation\x12D\n" +
+	"\rpurchase_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\fpurchaseTime\x12@\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"d\n" +
+	"\bLocation\x12\x18\n" +
+	"\x14LOCATION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fLOCATION_FRIDGE\x10\x01\x12\x14\n" +
+	"\x10LOCATION_FREEZER\x10\x02\x12\x13\n" +
+	"\x0fLOCATION_PANTRY\x10\x03:r\xeaAo\n" +
+	"'api.pantries.pantry.v1alpha1/PantryItem\x12+pantries/{pantry}/pantryItems/{pantry_item}*\vpantryItems2\n" +
+	"pantryItem\"\xae\x01\n" +
+	"\x17CreatePantryItemRequest\x12C\n" +
+	"\x06parent\x18\x01 \x01(\tB+\xe0A\x02\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x06parent\x12N\n" +
+	"\vpantry_item\x18\x02 \x01(\v2(.api.pantries.pantry.v1alpha1.PantryItemB\x03\xe0A\x02R\n" +
+	"pantryItem\"\xc0\x01\n" +
+	"\x16ListPantryItemsRequest\x12C\n" +
+	"\x06parent\x18\x01 \x01(\tB+\xe0A\x02\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x04 \x01(\tB\x03\xe0A\x01R\x06filter\"\x8e\x01\n" +
+	"\x17ListPantryItemsResponse\x12K\n" +
+	"\fpantry_items\x18\x01 \x03(\v2(.api.pantries.pantry.v1alpha1.PantryItemR\vpantryItems\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf0\x01\n" +
+	"\x1eListExpiringPantryItemsRequest\x12C\n" +
+	"\x06parent\x18\x01 \x01(\tB+\xe0A\x02\xfaA%\n" +
+	"#api.pantries.pantry.v1alpha1/PantryR\x06parent\x12C\n" +
+	"\rexpire_within\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\fexpireWithin\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x96\x01\n" +
+	"\x1fListExpiringPantryItemsResponse\x12K\n" +
+	"\fpantry_items\x18\x01 \x03(\v2(.api.pantries.pantry.v1alpha1.PantryItemR\vpantryItems\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xab\x01\n" +
+	"\x17UpdatePantryItemRequest\x12N\n" +
+	"\vpantry_item\x18\x01 \x01(\v2(.api.pantries.pantry.v1alpha1.PantryItemB\x03\xe0A\x02R\n" +
+	"pantryItem\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"^\n" +
+	"\x17DeletePantryItemRequest\x12C\n" +
+	"\x04name\x18\x01 \x01(\tB/\xe0A\x02\xfaA)\n" +
+	"'api.pantries.pantry.v1alpha1/PantryItemR\x04name\"[\n" +
+	"\x14GetPantryItemRequest\x12C\n" +
+	"\x04name\x18\x01 \x01(\tB/\xe0A\x02\xfaA)\n" +
+	"'api.pantries.pantry.v1alpha1/PantryItemR\x04name2\x98\x0f\n" +
+	"\x11PantryItemService\x12\x9c\x02\n" +
+	"\x10CreatePantryItem\x125.api.pantries.pantry.v1alpha1.CreatePantryItemRequest\x1a(.api.pantries.pantry.v1alpha1.PantryItem\"\xa6\x01\x92AG\n" +
+	"\x11PantryItemService\x12\x14Create a pantry item\x1a\x1cAdds a new item to a pantry.\xdaA\x12parent,pantry_item\x82\xd3\xe4\x93\x02A:\vpantry_item\"2/pantries/v1alpha1/{parent=pantries/*}/pantryItems\x12\xce\x02\n" +
+	"\x0fListPantryItems\x124.api.pantries.pantry.v1alpha1.ListPantryItemsRequest\x1a5.api.pantries.pantry.v1alpha1.ListPantryItemsResponse\"\xcd\x01\x92A\x86\x01\n" +
+	"\x11PantryItemService\x12\x11List pantry items\x1a^Retrieves a paginated list of the items in a pantry. Supports filtering on title and location.\xdaA\x06parent\x82\xd3\xe4\x93\x024\x122/pantries/v1alpha1/{parent=pantries/*}/pantryItems\x12\xa9\x03\n" +
+	"\x17ListExpiringPantryItems\x12<.api.pantries.pantry.v1alpha1.ListExpiringPantryItemsRequest\x1a=.api.pantries.pantry.v1alpha1.ListExpiringPantryItemsResponse\"\x90\x02\x92A\xb2\x01\n" +
+	"\x11PantryItemService\x12\x1aList expiring pantry items\x1a\x80\x01Retrieves the items in a pantry that expire within the given duration, including items that have already expired, soonest first.\xdaA\x14parent,expire_within\x82\xd3\xe4\x93\x02=\x12;/pantries/v1alpha1/{parent=pantries/*}/pantryItems:expiring\x12\xc0\x02\n" +
+	"\x10UpdatePantryItem\x125.api.pantries.pantry.v1alpha1.UpdatePantryItemRequest\x1a(.api.pantries.pantry.v1alpha1.PantryItem\"\xca\x01\x92AZ\n" +
+	"\x11PantryItemService\x12\x14Update a pantry item\x1a/Updates the details of an existing pantry item.\xdaA\x17pantry_item,update_mask\x82\xd3\xe4\x93\x02M:\vpantry_item2>/pantries/v1alpha1/{pantry_item.name=pantries/*/pantryItems/*}\x12\x94\x02\n" +
+	"\x10DeletePantryItem\x125.api.pantries.pantry.v1alpha1.DeletePantryItemRequest\x1a(.api.pantries.pantry.v1alpha1.PantryItem\"\x9e\x01\x92AZ\n" +
+	"\x11PantryItemService\x12\x14Delete a pantry item\x1a/Removes an item from a pantry by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x024*2/pantries/v1alpha1/{name=pantries/*/pantryItems/*}\x12\x8c\x02\n" +
+	"\rGetPantryItem\x122.api.pantries.pantry.v1alpha1.GetPantryItemRequest\x1a(.api.pantries.pantry.v1alpha1.PantryItem\"\x9c\x01\x92AX\n" +
+	"\x11PantryItemService\x12\x11Get a pantry item\x1a0Retrieves a single pantry item by resource name.\xdaA\x04name\x82\xd3\xe4\x93\x024\x122/pantries/v1alpha1/{name=pantries/*/pantryItems/*}B\xf6\x02\x92AXZD\n" +
+	"B\n" +
+	"\n" +
+	"BearerAuth\x124\b\x02\x12\x1fBearer token for authentication\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\n" +
+	" com.api.pantries.pantry.v1alpha1B\x0fPantryItemProtoP\x01ZSgithub.com/jcfug8/daylear/server/genapi/api/pantries/pantry/v1alpha1;pantryv1alpha1\xa2\x02\x03APP\xaa\x02\x1cApi.Pantries.Pantry.V1alpha1\xca\x02\x1cApi\\Pantries\\Pantry\\V1alpha1\xe2\x02(Api\\Pantries\\Pantry\\V1alpha1\\GPBMetadata\xea\x02\x1fApi::Pantries::Pantry::V1alpha1b\x06proto3"
+
+var (
+	file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescOnce sync.Once
+	file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescData []byte
+)
+
+func file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescGZIP() []byte {
+	file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescOnce.Do(func() {
+		file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDesc), len(file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDesc)))
+	})
+	return file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDescData
+}
+
+var file_api_pantries_pantry_v1alpha1_pantry_item_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_pantries_pantry_v1alpha1_pantry_item_proto_goTypes = []any{
+	(PantryItem_Location)(0),                // 0: api.pantries.pantry.v1alpha1.PantryItem.Location
+	(*PantryItem)(nil),                      // 1: api.pantries.pantry.v1alpha1.PantryItem
+	(*CreatePantryItemRequest)(nil),         // 2: api.pantries.pantry.v1alpha1.CreatePantryItemRequest
+	(*ListPantryItemsRequest)(nil),          // 3: api.pantries.pantry.v1alpha1.ListPantryItemsRequest
+	(*ListPantryItemsResponse)(nil),         // 4: api.pantries.pantry.v1alpha1.ListPantryItemsResponse
+	(*ListExpiringPantryItemsRequest)(nil),  // 5: api.pantries.pantry.v1alpha1.ListExpiringPantryItemsRequest
+	(*ListExpiringPantryItemsResponse)(nil), // 6: api.pantries.pantry.v1alpha1.ListExpiringPantryItemsResponse
+	(*UpdatePantryItemRequest)(nil),         // 7: api.pantries.pantry.v1alpha1.UpdatePantryItemRequest
+	(*DeletePantryItemRequest)(nil),         // 8: api.pantries.pantry.v1alpha1.DeletePantryItemRequest
+	(*GetPantryItemRequest)(nil),            // 9: api.pantries.pantry.v1alpha1.GetPantryItemRequest
+	(v1alpha1.Recipe_MeasurementType)(0),    // 10: api.meals.recipe.v1alpha1.Recipe.MeasurementType
+	(*timestamppb.Timestamp)(nil),           // 11: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 12: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),           // 13: google.protobuf.FieldMask
+}
+var file_api_pantries_pantry_v1alpha1_pantry_item_proto_depIdxs = []int32{
+	10, // 0: api.pantries.pantry.v1alpha1.PantryItem.unit:type_name -> api.meals.recipe.v1alpha1.Recipe.MeasurementType
+	0,  // 1: api.pantries.pantry.v1alpha1.PantryItem.location:type_name -> api.pantries.pantry.v1alpha1.PantryItem.Location
+	11, // 2: api.pantries.pantry.v1alpha1.PantryItem.purchase_time:type_name -> google.protobuf.Timestamp
+	11, // 3: api.pantries.pantry.v1alpha1.PantryItem.expire_time:type_name -> google.protobuf.Timestamp
+	11, // 4: api.pantries.pantry.v1alpha1.PantryItem.create_time:type_name -> google.protobuf.Timestamp
+	11, // 5: api.pantries.pantry.v1alpha1.PantryItem.update_time:type_name -> google.protobuf.Timestamp
+	1,  // 6: api.pantries.pantry.v1alpha1.CreatePantryItemRequest.pantry_item:type_name -> api.pantries.pantry.v1alpha1.PantryItem
+	1,  // 7: api.pantries.pantry.v1alpha1.ListPantryItemsResponse.pantry_items:type_name -> api.pantries.pantry.v1alpha1.PantryItem
+	12, // 8: api.pantries.pantry.v1alpha1.ListExpiringPantryItemsRequest.expire_within:type_name -> google.protobuf.Duration
+	1,  // 9: api.pantries.pantry.v1alpha1.ListExpiringPantryItemsResponse.pantry_items:type_name -> api.pantries.pantry.v1alpha1.PantryItem
+	1,  // 10: api.pantries.pantry.v1alpha1.UpdatePantryItemRequest.pantry_item:type_name -> api.pantries.pantry.v1alpha1.PantryItem
+	13, // 11: api.pantries.pantry.v1alpha1.UpdatePantryItemRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 12: api.pantries.pantry.v1alpha1.PantryItemService.CreatePantryItem:input_type -> api.pantries.pantry.v1alpha1.CreatePantryItemRequest
+	3,  // 13: api.pantries.pantry.v1alpha1.PantryItemService.ListPantryItems:input_type -> api.pantries.pantry.v1alpha1.ListPantryItemsRequest
+	5,  // 14: api.pantries.pantry.v1alpha1.PantryItemService.ListExpiringPantryItems:input_type -> api.pantries.pantry.v1alpha1.ListExpiringPantryItemsRequest
+	7,  // 15: api.pantries.pantry.v1alpha1.PantryItemService.UpdatePantryItem:input_type -> api.pantries.pantry.v1alpha1.UpdatePantryItemRequest
+	8,  // 16: api.pantries.pantry.v1alpha1.PantryItemService.DeletePantryItem:input_type -> api.pantries.pantry.v1alpha1.DeletePantryItemRequest
+	9,  // 17: api.pantries.pantry.v1alpha1.PantryItemService.GetPantryItem:input_type -> api.pantries.pantry.v1alpha1.GetPantryItemRequest
+	1,  // 18: api.pantries.pantry.v1alpha1.PantryItemService.CreatePantryItem:output_type -> api.pantries.pantry.v1alpha1.PantryItem
+	4,  // 19: api.pantries.pantry.v1alpha1.PantryItemService.ListPantryItems:output_type -> api.pantries.pantry.v1alpha1.ListPantryItemsResponse
+	6,  // 20: api.pantries.pantry.v1alpha1.PantryItemService.ListExpiringPantryItems:output_type -> api.pantries.pantry.v1alpha1.ListExpiringPantryItemsResponse
+	1,  // 21: api.pantries.pantry.v1alpha1.PantryItemService.UpdatePantryItem:output_type -> api.pantries.pantry.v1alpha1.PantryItem
+	1,  // 22: api.pantries.pantry.v1alpha1.PantryItemService.DeletePantryItem:output_type -> api.pantries.pantry.v1alpha1.PantryItem
+	1,  // 23: api.pantries.pantry.v1alpha1.PantryItemService.GetPantryItem:output_type -> api.pantries.pantry.v1alpha1.PantryItem
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_api_pantries_pantry_v1alpha1_pantry_item_proto_init() }
+func file_api_pantries_pantry_v1alpha1_pantry_item_proto_init() {
+	if File_api_pantries_pantry_v1alpha1_pantry_item_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDesc), len(file_api_pantries_pantry_v1alpha1_pantry_item_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_pantries_pantry_v1alpha1_pantry_item_proto_goTypes,
+		DependencyIndexes: file_api_pantries_pantry_v1alpha1_pantry_item_proto_depIdxs,
+		EnumInfos:         file_api_pantries_pantry_v1alpha1_pantry_item_proto_enumTypes,
+		MessageInfos:      file_api_pantries_pantry_v1alpha1_pantry_item_proto_msgTypes,
+	}.Build()
+	File_api_pantries_pantry_v1alpha1_pantry_item_proto = out.File
+	file_api_pantries_pantry_v1alpha1_pantry_item_proto_goTypes = nil
+	file_api_pantries_pantry_v1alpha1_pantry_item_proto_depIdxs = nil
+}
@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/pantries/pantry/v1alpha1/pantry_item.proto
+
+package pantryv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	PantryItemService_CreatePantryItem_FullMethodName        = "/api.pantries.pantry.v1alpha1.PantryItemService/CreatePantryItem"
+	PantryItemService_ListPantryItems_FullMethodName         = "/api.pantries.pantry.v1alpha1.PantryItemService/ListPantryItems"
+	PantryItemService_ListExpiringPantryItems_FullMethodName = "/api.pantries.pantry.v1alpha1.PantryItemService/ListExpiringPantryItems"
+	PantryItemService_UpdatePantryItem_FullMethodName        = "/api.pantries.pantry.v1alpha1.PantryItemService/UpdatePantryItem"
+	PantryItemService_DeletePantryItem_FullMethodName        = "/api.pantries.pantry.v1alpha1.PantryItemService/DeletePantryItem"
+	PantryItemService_GetPantryItem_FullMethodName           = "/api.pantries.pantry.v1alpha1.PantryItemService/GetPantryItem"
+)
+
+// PantryItemServiceClient is the client API for PantryItemService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the pantry item service
+type PantryItemServiceClient interface {
+	// create a pantry item
+	CreatePantryItem(ctx context.Context, in *CreatePantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error)
+	// list pantry items
+	ListPantryItems(ctx context.Context, in *ListPantryItemsRequest, opts ...grpc.CallOption) (*ListPantryItemsResponse, error)
+	// list the pantry items that expire soon
+	ListExpiringPantryItems(ctx context.Context, in *ListExpiringPantryItemsRequest, opts ...grpc.CallOption) (*ListExpiringPantryItemsResponse, error)
+	// update a pantry item
+	UpdatePantryItem(ctx context.Context, in *UpdatePantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error)
+	// delete a pantry item
+	DeletePantryItem(ctx context.Context, in *DeletePantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error)
+	// get a pantry item
+	GetPantryItem(ctx context.Context, in *GetPantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error)
+}
+
+type pantryItemServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPantryItemServiceClient(cc grpc.ClientConnInterface) PantryItemServiceClient {
+	return &pantryItemServiceClient{cc}
+}
+
+func (c *pantryItemServiceClient) CreatePantryItem(ctx context.Context, in *CreatePantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PantryItem)
+	err := c.cc.Invoke(ctx, PantryItemService_CreatePantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryItemServiceClient) ListPantryItems(ctx context.Context, in *ListPantryItemsRequest, opts ...grpc.CallOption) (*ListPantryItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPantryItemsResponse)
+	err := c.cc.Invoke(ctx, PantryItemService_ListPantryItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryItemServiceClient) ListExpiringPantryItems(ctx context.Context, in *ListExpiringPantryItemsRequest, opts ...grpc.CallOption) (*ListExpiringPantryItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiringPantryItemsResponse)
+	err := c.cc.Invoke(ctx, PantryItemService_ListExpiringPantryItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryItemServiceClient) UpdatePantryItem(ctx context.Context, in *UpdatePantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PantryItem)
+	err := c.cc.Invoke(ctx, PantryItemService_UpdatePantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryItemServiceClient) DeletePantryItem(ctx context.Context, in *DeletePantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PantryItem)
+	err := c.cc.Invoke(ctx, PantryItemService_DeletePantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryItemServiceClient) GetPantryItem(ctx context.Context, in *GetPantryItemRequest, opts ...grpc.CallOption) (*PantryItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PantryItem)
+	err := c.cc.Invoke(ctx, PantryItemService_GetPantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PantryItemServiceServer is the server API for PantryItemService service.
+// All implementations must embed UnimplementedPantryItemServiceServer
+// for forward compatibility.
+//
+// the pantry item service
+type PantryItemServiceServer interface {
+	// create a pantry item
+	CreatePantryItem(context.Context, *CreatePantryItemRequest) (*PantryItem, error)
+	// list pantry items
+	ListPantryItems(context.Context, *ListPantryItemsRequest) (*ListPantryItemsResponse, error)
+	// list the pantry items that expire soon
+	ListExpiringPantryItems(context.Context, *ListExpiringPantryItemsRequest) (*ListExpiringPantryItemsResponse, error)
+	// update a pantry item
+	UpdatePantryItem(context.Context, *UpdatePantryItemRequest) (*PantryItem, error)
+	// delete a pantry item
+	DeletePantryItem(context.Context, *DeletePantryItemRequest) (*PantryItem, error)
+	// get a pantry item
+	GetPantryItem(context.Context, *GetPantryItemRequest) (*PantryItem, error)
+	mustEmbedUnimplementedPantryItemServiceServer()
+}
+
+// UnimplementedPantryItemServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPantryItemServiceServer struct{}
+
+func (UnimplementedPantryItemServiceServer) CreatePantryItem(context.Context, *CreatePantryItemRequest) (*PantryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePantryItem not implemented")
+}
+func (UnimplementedPantryItemServiceServer) ListPantryItems(context.Context, *ListPantryItemsRequest) (*ListPantryItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPantryItems not implemented")
+}
+func (UnimplementedPantryItemServiceServer) ListExpiringPantryItems(context.Context, *ListExpiringPantryItemsRequest) (*ListExpiringPantryItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringPantryItems not implemented")
+}
+func (UnimplementedPantryItemServiceServer) UpdatePantryItem(context.Context, *UpdatePantryItemRequest) (*PantryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePantryItem not implemented")
+}
+func (UnimplementedPantryItemServiceServer) DeletePantryItem(context.Context, *DeletePantryItemRequest) (*PantryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePantryItem not implemented")
+}
+func (UnimplementedPantryItemServiceServer) GetPantryItem(context.Context, *GetPantryItemRequest) (*PantryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPantryItem not implemented")
+}
+func (UnimplementedPantryItemServiceServer) mustEmbedUnimplementedPantryItemServiceServer() {}
+func (UnimplementedPantryItemServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePantryItemServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PantryItemServiceServer will
+// result in compilation errors.
+type UnsafePantryItemServiceServer interface {
+	mustEmbedUnimplementedPantryItemServiceServer()
+}
+
+func RegisterPantryItemServiceServer(s grpc.ServiceRegistrar, srv PantryItemServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPantryItemServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PantryItemService_ServiceDesc, srv)
+}
+
+func _PantryItemService_CreatePantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryItemServiceServer).CreatePantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryItemService_CreatePantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryItemServiceServer).CreatePantryItem(ctx, req.(*CreatePantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryItemService_ListPantryItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPantryItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryItemServiceServer).ListPantryItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryItemService_ListPantryItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryItemServiceServer).ListPantryItems(ctx, req.(*ListPantryItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryItemService_ListExpiringPantryItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringPantryItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryItemServiceServer).ListExpiringPantryItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryItemService_ListExpiringPantryItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryItemServiceServer).ListExpiringPantryItems(ctx, req.(*ListExpiringPantryItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryItemService_UpdatePantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryItemServiceServer).UpdatePantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryItemService_UpdatePantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryItemServiceServer).UpdatePantryItem(ctx, req.(*UpdatePantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryItemService_DeletePantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryItemServiceServer).DeletePantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryItemService_DeletePantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryItemServiceServer).DeletePantryItem(ctx, req.(*DeletePantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PantryItemService_GetPantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryItemServiceServer).GetPantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PantryItemService_GetPantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryItemServiceServer).GetPantryItem(ctx, req.(*GetPantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PantryItemService_ServiceDesc is the grpc.ServiceDesc for PantryItemService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PantryItemService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.pantries.pantry.v1alpha1.PantryItemService",
+	HandlerType: (*PantryItemServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePantryItem",
+			Handler:    _PantryItemService_CreatePantryItem_Handler,
+		},
+		{
+			MethodName: "ListPantryItems",
+			Handler:    _PantryItemService_ListPantryItems_Handler,
+		},
+		{
+			MethodName: "ListExpiringPantryItems",
+			Handler:    _PantryItemService_ListExpiringPantryItems_Handler,
+		},
+		{
+			MethodName: "UpdatePantryItem",
+			Handler:    _PantryItemService_UpdatePantryItem_Handler,
+		},
+		{
+			MethodName: "DeletePantryItem",
+			Handler:    _PantryItemService_DeletePantryItem_Handler,
+		},
+		{
+			MethodName: "GetPantryItem",
+			Handler:    _PantryItemService_GetPantryItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pantries/pantry/v1alpha1/pantry_item.proto",
+}
@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/types/image_set.proto
+
+package types
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// the sizes of the variants
+type ImageSet_Size int32
+
+const (
+	// the size is not specified
+	ImageSet_SIZE_UNSPECIFIED ImageSet_Size = 0
+	// fits within 200x200 pixels, for lists
+	ImageSet_SIZE_THUMBNAIL ImageSet_Size = 1
+	// fits within 600x600 pixels, for cards
+	ImageSet_SIZE_MEDIUM ImageSet_Size = 2
+	// fits within 1000x1000 pixels, for detail pages
+	ImageSet_SIZE_LARGE ImageSet_Size = 3
+)
+
+// Enum value maps for ImageSet_Size.
+var (
+	ImageSet_Size_name = map[int32]string{
+		0: "SIZE_UNSPECIFIED",
+		1: "SIZE_THUMBNAIL",
+		2: "SIZE_MEDIUM",
+		3: "SIZE_LARGE",
+	}
+	ImageSet_Size_value = map[string]int32{
+		"SIZE_UNSPECIFIED": 0,
+		"SIZE_THUMBNAIL":   1,
+		"SIZE_MEDIUM":      2,
+		"SIZE_LARGE":       3,
+	}
+)
+
+func (x ImageSet_Size) Enum() *ImageSet_Size {
+	p := new(ImageSet_Size)
+	*p = x
+	return p
+}
+
+func (x ImageSet_Size) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageSet_Size) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_image_set_proto_enumTypes[0].Descriptor()
+}
+
+func (ImageSet_Size) Type() protoreflect.EnumType {
+	return &file_api_types_image_set_proto_enumTypes[0]
+}
+
+func (x ImageSet_Size) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageSet_Size.Descriptor instead.
+func (ImageSet_Size) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_image_set_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// the resized and re-encoded variants of an uploaded image
+type ImageSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the BlurHash of the image, to show as a placeholder while a variant loads
+	Placeholder string `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	// the width of the uploaded image in pixels
+	Width int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	// the height of the uploaded image in pixels
+	Height int32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// the variants of the image, in every size and format that was generated
+	Variants      []*ImageSet_Variant `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageSet) Reset() {
+	*x = ImageSet{}
+	mi := &file_api_types_image_set_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageSet) ProtoMessage() {}
+
+func (x *ImageSet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_image_set_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageSet.ProtoReflect.Descriptor instead.
+func (*ImageSet) Descriptor() ([]byte, []int) {
+	return file_api_types_image_set_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImageSet) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *ImageSet) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageSet) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageSet) GetVariants() []*ImageSet_Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// a stored variant of the image
+type ImageSet_Variant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the size of the variant
+	Size ImageSet_Size `protobuf:"varint,1,opt,name=size,proto3,enum=api.types.ImageSet_Size" json:"size,omitempty"`
+	// the content type of the variant, such as image/webp
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// the width of the variant in pixels
+	Width int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	// the height of the variant in pixels
+	Height int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// the uri of the variant
+	Uri           string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageSet_Variant) Reset() {
+	*x = ImageSet_Variant{}
+	mi := &file_api_types_image_set_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageSet_Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageSet_Variant) ProtoMessage() {}
+
+func (x *ImageSet_Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_image_set_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageSet_Variant.ProtoReflect.Descriptor instead.
+func (*ImageSet_Variant) Descriptor() ([]byte, []int) {
+	return file_api_types_image_set_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ImageSet_Variant) GetSize() ImageSet_Size {
+	if x != nil {
+		return x.Size
+	}
+	return ImageSet_SIZE_UNSPECIFIED
+}
+
+func (x *ImageSet_Variant) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImageSet_Variant) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageSet_Variant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageSet_Variant) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+var File_api_types_image_set_proto protoreflect.FileDescriptor
+
+const file_api_types_image_set_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/types/image_set.proto\x12\tapi.types\x1a\x1fgoogle/api/field_behavior.proto\"\xb0\x03\n" +
+	"\bImageSet\x12%\n" +
+	"\vplaceholder\x18\x01 \x01(\tB\x03\xe0A\x03R\vplaceholder\x12\x19\n" +
+	"\x05width\x18\x02 \x01(\x05B\x03\xe0A\x03R\x05width\x12\x1b\n" +
+	"\x06height\x18\x03 \x01(\x05B\x03\xe0A\x03R\x06height\x12<\n" +
+	"\bvariants\x18\x04 \x03(\v2\x1b.api.types.ImageSet.VariantB\x03\xe0A\x03R\bvariants\x1a\xb3\x01\n" +
+	"\aVariant\x121\n" +
+	"\x04size\x18\x01 \x01(\x0e2\x18.api.types.ImageSet.SizeB\x03\xe0A\x03R\x04size\x12&\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\x03\xe0A\x03R\vcontentType\x12\x19\n" +
+	"\x05width\x18\x03 \x01(\x05B\x03\xe0A\x03R\x05width\x12\x1b\n" +
+	"\x06height\x18\x04 \x01(\x05B\x03\xe0A\x03R\x06height\x12\x15\n" +
+	"\x03uri\x18\x05 \x01(\tB\x03\xe0A\x03R\x03uri\"Q\n" +
+	"\x04Size\x12\x14\n" +
+	"\x10SIZE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSIZE_THUMBNAIL\x10\x01\x12\x0f\n" +
+	"\vSIZE_MEDIUM\x10\x02\x12\x0e\n" +
+	"\n" +
+	"SIZE_LARGE\x10\x03B\x96\x01\n" +
+	"\rcom.api.typesB\rImageSetProtoP\x01Z1github.com/jcfug8/daylear/server/genapi/api/types\xa2\x02\x03ATX\xaa\x02\tApi.Types\xca\x02\tApi\\Types\xe2\x02\x15Api\\Types\\GPBMetadata\xea\x02\n" +
+	"Api::Typesb\x06proto3"
+
+var (
+	file_api_types_image_set_proto_rawDescOnce sync.Once
+	file_api_types_image_set_proto_rawDescData []byte
+)
+
+func file_api_types_image_set_proto_rawDescGZIP() []byte {
+	file_api_types_image_set_proto_rawDescOnce.Do(func() {
+		file_api_types_image_set_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_types_image_set_proto_rawDesc), len(file_api_types_image_set_proto_rawDesc)))
+	})
+	return file_api_types_image_set_proto_rawDescData
+}
+
+var file_api_types_image_set_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_types_image_set_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_types_image_set_proto_goTypes = []any{
+	(ImageSet_Size)(0),       // 0: api.types.ImageSet.Size
+	(*ImageSet)(nil),         // 1: api.types.ImageSet
+	(*ImageSet_Variant)(nil), // 2: api.types.ImageSet.Variant
+}
+var file_api_types_image_set_proto_depIdxs = []int32{
+	2, // 0: api.types.ImageSet.variants:type_name -> api.types.ImageSet.Variant
+	0, // 1: api.types.ImageSet.Variant.size:type_name -> api.types.ImageSet.Size
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_types_image_set_proto_init() }
+func file_api_types_image_set_proto_init() {
+	if File_api_types_image_set_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_types_image_set_proto_rawDesc), len(file_api_types_image_set_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_types_image_set_proto_goTypes,
+		DependencyIndexes: file_api_types_image_set_proto_depIdxs,
+		EnumInfos:         file_api_types_image_set_proto_enumTypes,
+		MessageInfos:      file_api_types_image_set_proto_msgTypes,
+	}.Build()
+	File_api_types_image_set_proto = out.File
+	file_api_types_image_set_proto_goTypes = nil
+	file_api_types_image_set_proto_depIdxs = nil
+}
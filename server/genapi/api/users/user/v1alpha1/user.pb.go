@@ -42,6 +42,10 @@ type User struct {
 	Bio string `protobuf:"bytes,9,opt,name=bio,proto3" json:"bio,omitempty"`
 	// whether the current user has favorited this user
 	Favorited bool `protobuf:"varint,10,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	// the resized variants and placeholder of the user image
+	Images *types.ImageSet `protobuf:"bytes,11,opt,name=images,proto3" json:"images,omitempty"`
+	// the diets the user follows and the allergens they avoid, only visible to the user
+	DietaryRestrictions *User_DietaryRestrictions `protobuf:"bytes,12,opt,name=dietary_restrictions,json=dietaryRestrictions,proto3" json:"dietary_restrictions,omitempty"`
 	// the user access details
 	Access        *User_Access `protobuf:"bytes,7,opt,name=access,proto3" json:"access,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -127,6 +131,20 @@ func (x *User) GetFavorited() bool {
 	return false
 }
 
+func (x *User) GetImages() *types.ImageSet {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *User) GetDietaryRestrictions() *User_DietaryRestrictions {
+	if x != nil {
+		return x.DietaryRestrictions
+	}
+	return nil
+}
+
 func (x *User) GetAccess() *User_Access {
 	if x != nil {
 		return x.Access
@@ -529,6 +547,61 @@ func (*UnfavoriteUserResponse) Descriptor() ([]byte, []int) {
 	return file_api_users_user_v1alpha1_user_proto_rawDescGZIP(), []int{8}
 }
 
+// the diets a user follows and the allergens they avoid
+type User_DietaryRestrictions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the diets the user follows, one of vegetarian, vegan, gluten_free or dairy_free
+	Diets []string `protobuf:"bytes,1,rep,name=diets,proto3" json:"diets,omitempty"`
+	// the allergens the user avoids, e.g. peanut or tree_nut
+	Allergens     []string `protobuf:"bytes,2,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User_DietaryRestrictions) Reset() {
+	*x = User_DietaryRestrictions{}
+	mi := &file_api_users_user_v1alpha1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User_DietaryRestrictions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User_DietaryRestrictions) ProtoMessage() {}
+
+func (x *User_DietaryRestrictions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_users_user_v1alpha1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User_DietaryRestrictions.ProtoReflect.Descriptor instead.
+func (*User_DietaryRestrictions) Descriptor() ([]byte, []int) {
+	return file_api_users_user_v1alpha1_user_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *User_DietaryRestrictions) GetDiets() []string {
+	if x != nil {
+		return x.Diets
+	}
+	return nil
+}
+
+func (x *User_DietaryRestrictions) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
 // the user access details
 type User_Access struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -548,7 +621,7 @@ type User_Access struct {
 
 func (x *User_Access) Reset() {
 	*x = User_Access{}
-	mi := &file_api_users_user_v1alpha1_user_proto_msgTypes[9]
+	mi := &file_api_users_user_v1alpha1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -560,7 +633,7 @@ func (x *User_Access) String() string {
 func (*User_Access) ProtoMessage() {}
 
 func (x *User_Access) ProtoReflect() protoreflect.Message {
-	mi := &file_api_users_user_v1alpha1_user_proto_msgTypes[9]
+	mi := &file_api_users_user_v1alpha1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,7 +646,7 @@ func (x *User_Access) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User_Access.ProtoReflect.Descriptor instead.
 func (*User_Access) Descriptor() ([]byte, []int) {
-	return file_api_users_user_v1alpha1_user_proto_rawDescGZIP(), []int{0, 0}
+	return file_api_users_user_v1alpha1_user_proto_rawDescGZIP(), []int{0, 1}
 }
 
 func (x *User_Access) GetName() string {
@@ -615,7 +688,7 @@ var File_api_users_user_v1alpha1_user_proto protoreflect.FileDescriptor
 
 const file_api_users_user_v1alpha1_user_proto_rawDesc = "" +
 	"\n" +
-	"\"api/users/user/v1alpha1/user.proto\x12\x17api.users.user.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xaa\x05\n" +
+	"\"api/users/user/v1alpha1/user.proto\x12\x17api.users.user.v1alpha1\x1a\x1dapi/types/accept_target.proto\x1a\x1capi/types/access_state.proto\x1a\x19api/types/image_set.proto\x1a api/types/permission_level.proto\x1a api/types/visibility_level.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9c\a\n" +
 	"\x04User\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1f\n" +
 	"\busername\x18\x03 \x01(\tB\x03\xe0A\x01R\busername\x12\"\n" +
@@ -626,8 +699,13 @@ const file_api_users_user_v1alpha1_user_proto_rawDesc = "" +
 	"\timage_uri\x18\b \x01(\tB\x03\xe0A\x01R\bimageUri\x12\x15\n" +
 	"\x03bio\x18\t \x01(\tB\x03\xe0A\x01R\x03bio\x12!\n" +
 	"\tfavorited\x18\n" +
-	" \x01(\bB\x03\xe0A\x03R\tfavorited\x12A\n" +
-	"\x06access\x18\a \x01(\v2$.api.users.user.v1alpha1.User.AccessB\x03\xe0A\x03R\x06access\x1a\x86\x02\n" +
+	" \x01(\bB\x03\xe0A\x03R\tfavorited\x120\n" +
+	"\x06images\x18\v \x01(\v2\x13.api.types.ImageSetB\x03\xe0A\x03R\x06images\x12i\n" +
+	"\x14dietary_restrictions\x18\f \x01(\v21.api.users.user.v1alpha1.User.DietaryRestrictionsB\x03\xe0A\x01R\x13dietaryRestrictions\x12A\n" +
+	"\x06access\x18\a \x01(\v2$.api.users.user.v1alpha1.User.AccessB\x03\xe0A\x03R\x06access\x1aS\n" +
+	"\x13DietaryRestrictions\x12\x19\n" +
+	"\x05diets\x18\x01 \x03(\tB\x03\xe0A\x01R\x05diets\x12!\n" +
+	"\tallergens\x18\x02 \x03(\tB\x03\xe0A\x01R\tallergens\x1a\x86\x02\n" +
 	"\x06Access\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12!\n" +
 	"\trequester\x18\x02 \x01(\tB\x03\xe0A\x03R\trequester\x12J\n" +
@@ -695,46 +773,50 @@ func file_api_users_user_v1alpha1_user_proto_rawDescGZIP() []byte {
 	return file_api_users_user_v1alpha1_user_proto_rawDescData
 }
 
-var file_api_users_user_v1alpha1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_users_user_v1alpha1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_api_users_user_v1alpha1_user_proto_goTypes = []any{
-	(*User)(nil),                   // 0: api.users.user.v1alpha1.User
-	(*GetUserRequest)(nil),         // 1: api.users.user.v1alpha1.GetUserRequest
-	(*ListUsersRequest)(nil),       // 2: api.users.user.v1alpha1.ListUsersRequest
-	(*ListUsersResponse)(nil),      // 3: api.users.user.v1alpha1.ListUsersResponse
-	(*UpdateUserRequest)(nil),      // 4: api.users.user.v1alpha1.UpdateUserRequest
-	(*FavoriteUserRequest)(nil),    // 5: api.users.user.v1alpha1.FavoriteUserRequest
-	(*FavoriteUserResponse)(nil),   // 6: api.users.user.v1alpha1.FavoriteUserResponse
-	(*UnfavoriteUserRequest)(nil),  // 7: api.users.user.v1alpha1.UnfavoriteUserRequest
-	(*UnfavoriteUserResponse)(nil), // 8: api.users.user.v1alpha1.UnfavoriteUserResponse
-	(*User_Access)(nil),            // 9: api.users.user.v1alpha1.User.Access
-	(*fieldmaskpb.FieldMask)(nil),  // 10: google.protobuf.FieldMask
-	(types.PermissionLevel)(0),     // 11: api.types.PermissionLevel
-	(types.AccessState)(0),         // 12: api.types.AccessState
-	(types.AcceptTarget)(0),        // 13: api.types.AcceptTarget
+	(*User)(nil),                     // 0: api.users.user.v1alpha1.User
+	(*GetUserRequest)(nil),           // 1: api.users.user.v1alpha1.GetUserRequest
+	(*ListUsersRequest)(nil),         // 2: api.users.user.v1alpha1.ListUsersRequest
+	(*ListUsersResponse)(nil),        // 3: api.users.user.v1alpha1.ListUsersResponse
+	(*UpdateUserRequest)(nil),        // 4: api.users.user.v1alpha1.UpdateUserRequest
+	(*FavoriteUserRequest)(nil),      // 5: api.users.user.v1alpha1.FavoriteUserRequest
+	(*FavoriteUserResponse)(nil),     // 6: api.users.user.v1alpha1.FavoriteUserResponse
+	(*UnfavoriteUserRequest)(nil),    // 7: api.users.user.v1alpha1.UnfavoriteUserRequest
+	(*UnfavoriteUserResponse)(nil),   // 8: api.users.user.v1alpha1.UnfavoriteUserResponse
+	(*User_DietaryRestrictions)(nil), // 9: api.users.user.v1alpha1.User.DietaryRestrictions
+	(*User_Access)(nil),              // 10: api.users.user.v1alpha1.User.Access
+	(*types.ImageSet)(nil),           // 11: api.types.ImageSet
+	(*fieldmaskpb.FieldMask)(nil),    // 12: google.protobuf.FieldMask
+	(types.PermissionLevel)(0),       // 13: api.types.PermissionLevel
+	(types.AccessState)(0),           // 14: api.types.AccessState
+	(types.AcceptTarget)(0),          // 15: api.types.AcceptTarget
 }
 var file_api_users_user_v1alpha1_user_proto_depIdxs = []int32{
-	9,  // 0: api.users.user.v1alpha1.User.access:type_name -> api.users.user.v1alpha1.User.Access
-	0,  // 1: api.users.user.v1alpha1.ListUsersResponse.users:type_name -> api.users.user.v1alpha1.User
-	0,  // 2: api.users.user.v1alpha1.UpdateUserRequest.user:type_name -> api.users.user.v1alpha1.User
-	10, // 3: api.users.user.v1alpha1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 4: api.users.user.v1alpha1.User.Access.permission_level:type_name -> api.types.PermissionLevel
-	12, // 5: api.users.user.v1alpha1.User.Access.state:type_name -> api.types.AccessState
-	13, // 6: api.users.user.v1alpha1.User.Access.accept_target:type_name -> api.types.AcceptTarget
-	1,  // 7: api.users.user.v1alpha1.UserService.GetUser:input_type -> api.users.user.v1alpha1.GetUserRequest
-	2,  // 8: api.users.user.v1alpha1.UserService.ListUsers:input_type -> api.users.user.v1alpha1.ListUsersRequest
-	4,  // 9: api.users.user.v1alpha1.UserService.UpdateUser:input_type -> api.users.user.v1alpha1.UpdateUserRequest
-	5,  // 10: api.users.user.v1alpha1.UserService.FavoriteUser:input_type -> api.users.user.v1alpha1.FavoriteUserRequest
-	7,  // 11: api.users.user.v1alpha1.UserService.UnfavoriteUser:input_type -> api.users.user.v1alpha1.UnfavoriteUserRequest
-	0,  // 12: api.users.user.v1alpha1.UserService.GetUser:output_type -> api.users.user.v1alpha1.User
-	3,  // 13: api.users.user.v1alpha1.UserService.ListUsers:output_type -> api.users.user.v1alpha1.ListUsersResponse
-	0,  // 14: api.users.user.v1alpha1.UserService.UpdateUser:output_type -> api.users.user.v1alpha1.User
-	6,  // 15: api.users.user.v1alpha1.UserService.FavoriteUser:output_type -> api.users.user.v1alpha1.FavoriteUserResponse
-	8,  // 16: api.users.user.v1alpha1.UserService.UnfavoriteUser:output_type -> api.users.user.v1alpha1.UnfavoriteUserResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	11, // 0: api.users.user.v1alpha1.User.images:type_name -> api.types.ImageSet
+	9,  // 1: api.users.user.v1alpha1.User.dietary_restrictions:type_name -> api.users.user.v1alpha1.User.DietaryRestrictions
+	10, // 2: api.users.user.v1alpha1.User.access:type_name -> api.users.user.v1alpha1.User.Access
+	0,  // 3: api.users.user.v1alpha1.ListUsersResponse.users:type_name -> api.users.user.v1alpha1.User
+	0,  // 4: api.users.user.v1alpha1.UpdateUserRequest.user:type_name -> api.users.user.v1alpha1.User
+	12, // 5: api.users.user.v1alpha1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	13, // 6: api.users.user.v1alpha1.User.Access.permission_level:type_name -> api.types.PermissionLevel
+	14, // 7: api.users.user.v1alpha1.User.Access.state:type_name -> api.types.AccessState
+	15, // 8: api.users.user.v1alpha1.User.Access.accept_target:type_name -> api.types.AcceptTarget
+	1,  // 9: api.users.user.v1alpha1.UserService.GetUser:input_type -> api.users.user.v1alpha1.GetUserRequest
+	2,  // 10: api.users.user.v1alpha1.UserService.ListUsers:input_type -> api.users.user.v1alpha1.ListUsersRequest
+	4,  // 11: api.users.user.v1alpha1.UserService.UpdateUser:input_type -> api.users.user.v1alpha1.UpdateUserRequest
+	5,  // 12: api.users.user.v1alpha1.UserService.FavoriteUser:input_type -> api.users.user.v1alpha1.FavoriteUserRequest
+	7,  // 13: api.users.user.v1alpha1.UserService.UnfavoriteUser:input_type -> api.users.user.v1alpha1.UnfavoriteUserRequest
+	0,  // 14: api.users.user.v1alpha1.UserService.GetUser:output_type -> api.users.user.v1alpha1.User
+	3,  // 15: api.users.user.v1alpha1.UserService.ListUsers:output_type -> api.users.user.v1alpha1.ListUsersResponse
+	0,  // 16: api.users.user.v1alpha1.UserService.UpdateUser:output_type -> api.users.user.v1alpha1.User
+	6,  // 17: api.users.user.v1alpha1.UserService.FavoriteUser:output_type -> api.users.user.v1alpha1.FavoriteUserResponse
+	8,  // 18: api.users.user.v1alpha1.UserService.UnfavoriteUser:output_type -> api.users.user.v1alpha1.UnfavoriteUserResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_users_user_v1alpha1_user_proto_init() }
@@ -748,7 +830,7 @@ func file_api_users_user_v1alpha1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_users_user_v1alpha1_user_proto_rawDesc), len(file_api_users_user_v1alpha1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
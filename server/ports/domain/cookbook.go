@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type cookbookDomain interface {
+	CreateCookbook(ctx context.Context, authAccount model.AuthAccount, cookbook model.Cookbook) (model.Cookbook, error)
+	DeleteCookbook(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookParent, id model.CookbookId) (model.Cookbook, error)
+	GetCookbook(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookParent, id model.CookbookId, fields []string) (model.Cookbook, error)
+	ListCookbooks(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.Cookbook, error)
+	UpdateCookbook(ctx context.Context, authAccount model.AuthAccount, cookbook model.Cookbook, fields []string) (model.Cookbook, error)
+
+	CreateCookbookAccess(ctx context.Context, authAccount model.AuthAccount, access model.CookbookAccess) (model.CookbookAccess, error)
+	DeleteCookbookAccess(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, id model.CookbookAccessId) error
+	GetCookbookAccess(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, id model.CookbookAccessId, fields []string) (model.CookbookAccess, error)
+	ListCookbookAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) ([]model.CookbookAccess, error)
+	UpdateCookbookAccess(ctx context.Context, authAccount model.AuthAccount, access model.CookbookAccess, fields []string) (model.CookbookAccess, error)
+
+	AcceptCookbookAccess(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, id model.CookbookAccessId) (model.CookbookAccess, error)
+}
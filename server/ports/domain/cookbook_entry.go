@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type cookbookEntryDomain interface {
+	AddCookbookEntry(ctx context.Context, authAccount model.AuthAccount, entry model.CookbookEntry) (model.CookbookEntry, error)
+	GetCookbookEntry(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, id model.CookbookEntryId) (model.CookbookEntry, error)
+	ListCookbookEntries(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, pageSize int32, offset int64) ([]model.CookbookEntry, error)
+	UpdateCookbookEntry(ctx context.Context, authAccount model.AuthAccount, entry model.CookbookEntry, fields []string) (model.CookbookEntry, error)
+	RemoveCookbookEntry(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, id model.CookbookEntryId) (model.CookbookEntry, error)
+	// ReorderCookbookEntries sets the order of the entries of a cookbook. Every entry must be given exactly once.
+	ReorderCookbookEntries(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookEntryParent, ids []model.CookbookEntryId) ([]model.CookbookEntry, error)
+}
@@ -12,4 +12,20 @@ type Domain interface {
 	accessKeyDomain
 	listDomain
 	listItemDomain
+	listItemCompletionDomain
+	ingredientDomain
+	recipeRevisionDomain
+	recipeReviewDomain
+	recipeCookLogDomain
+	recipeNoteDomain
+	recipeExportDomain
+	recipeImportDomain
+	recipeImageDomain
+	recipeImageCandidateDomain
+	imageDomain
+	pantryDomain
+	pantryItemDomain
+	cookbookDomain
+	cookbookEntryDomain
+	operationDomain
 }
@@ -23,3 +23,9 @@ type ErrPermissionDenied struct{ Msg string }
 
 // Error returns the error message.
 func (e ErrPermissionDenied) Error() string { return e.Msg }
+
+// ErrResourceExhausted is an error that indicates that a quota of the user has been used up.
+type ErrResourceExhausted struct{ Msg string }
+
+// Error returns the error message.
+func (e ErrResourceExhausted) Error() string { return e.Msg }
@@ -11,4 +11,7 @@ type eventRecipeDomain interface {
 	DeleteEventRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.EventRecipeParent, id model.EventRecipeId) (model.EventRecipe, error)
 	GetEventRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.EventRecipeParent, id model.EventRecipeId, fields []string) (model.EventRecipe, error)
 	ListEventRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.EventRecipeParent, pageSize int32, offset int64, filter string, fields []string) ([]model.EventRecipe, error)
+	// MarkEventRecipeDone marks the recipe of an event as done and, when a
+	// cook log is given, creates it for the caller.
+	MarkEventRecipeDone(ctx context.Context, authAccount model.AuthAccount, parent model.EventRecipeParent, id model.EventRecipeId, cookLog *model.RecipeCookLog) (model.EventRecipe, *model.RecipeCookLog, error)
 }
@@ -0,0 +1,11 @@
+package domain
+
+import (
+	"context"
+)
+
+type imageDomain interface {
+	// BackfillImageSets generates the variants of stored images uploaded before
+	// variants were generated. It is run by operators, without an auth account.
+	BackfillImageSets(ctx context.Context) (updated int, failed int, err error)
+}
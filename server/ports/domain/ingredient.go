@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type ingredientDomain interface {
+	GetIngredient(ctx context.Context, authAccount model.AuthAccount, id model.IngredientId, fields []string) (model.Ingredient, error)
+	ListIngredients(ctx context.Context, authAccount model.AuthAccount, pageSize int32, offset int64, filter string, fields []string) ([]model.Ingredient, error)
+	AutocompleteIngredients(ctx context.Context, authAccount model.AuthAccount, query string, pageSize int32) ([]model.Ingredient, error)
+}
@@ -20,5 +20,7 @@ type listDomain interface {
 	GetListAccess(ctx context.Context, authAccount model.AuthAccount, parent model.ListAccessParent, id model.ListAccessId, fields []string) (model.ListAccess, error)
 	ListListAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.ListAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) ([]model.ListAccess, error)
 	UpdateListAccess(ctx context.Context, authAccount model.AuthAccount, access model.ListAccess, fields []string) (model.ListAccess, error)
+	GenerateGroceryList(ctx context.Context, authAccount model.AuthAccount, request model.GroceryListRequest) (model.List, []model.ListItem, error)
+
 	AcceptListAccess(ctx context.Context, authAccount model.AuthAccount, parent model.ListAccessParent, id model.ListAccessId) (model.ListAccess, error)
 }
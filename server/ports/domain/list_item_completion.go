@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type listItemCompletionDomain interface {
+	CreateListItemCompletion(ctx context.Context, authAccount model.AuthAccount, completion model.ListItemCompletion, pantryId model.PantryId) (model.ListItemCompletion, error)
+	DeleteListItemCompletion(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemCompletionParent, id model.ListItemCompletionId) (model.ListItemCompletion, error)
+	GetListItemCompletion(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemCompletionParent, id model.ListItemCompletionId, fields []string) (model.ListItemCompletion, error)
+	ListListItemCompletions(ctx context.Context, authAccount model.AuthAccount, parent model.ListItemCompletionParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.ListItemCompletion, error)
+	UpdateListItemCompletion(ctx context.Context, authAccount model.AuthAccount, completion model.ListItemCompletion, fields []string) (model.ListItemCompletion, error)
+}
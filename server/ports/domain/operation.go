@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type operationDomain interface {
+	// StartScrapeRecipe starts scraping a recipe from a web page, see
+	// ScrapeRecipe.
+	StartScrapeRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, uri string) (model.Operation, error)
+	// StartOCRRecipe starts reading a recipe from photos, see OCRRecipe.
+	StartOCRRecipe(ctx context.Context, authAccount model.AuthAccount, imageReaders []io.Reader) (model.Operation, error)
+	// StartGenerateRecipeImage starts generating candidate images for a
+	// recipe. It fails when the daily generation quota of the caller would be
+	// exceeded.
+	StartGenerateRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, candidateCount int32) (model.Operation, error)
+
+	GetOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId) (model.Operation, error)
+	ListOperations(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, pageSize int32, offset int64) ([]model.Operation, error)
+	CancelOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId) (model.Operation, error)
+	DeleteOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId) error
+	// WaitOperation waits until the operation is done or the timeout has
+	// passed, and returns its latest state. A zero timeout waits as long as
+	// the context allows.
+	WaitOperation(ctx context.Context, authAccount model.AuthAccount, parent model.OperationParent, id model.OperationId, timeout time.Duration) (model.Operation, error)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type pantryDomain interface {
+	CreatePantry(ctx context.Context, authAccount model.AuthAccount, pantry model.Pantry) (model.Pantry, error)
+	DeletePantry(ctx context.Context, authAccount model.AuthAccount, parent model.PantryParent, id model.PantryId) (model.Pantry, error)
+	GetPantry(ctx context.Context, authAccount model.AuthAccount, parent model.PantryParent, id model.PantryId, fields []string) (model.Pantry, error)
+	ListPantries(ctx context.Context, authAccount model.AuthAccount, parent model.PantryParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.Pantry, error)
+	UpdatePantry(ctx context.Context, authAccount model.AuthAccount, pantry model.Pantry, fields []string) (model.Pantry, error)
+
+	// ConsumeRecipe decrements the pantry items used by the ingredients of a cooked recipe.
+	ConsumeRecipe(ctx context.Context, authAccount model.AuthAccount, id model.PantryId, recipeId model.RecipeId, servings float64) (model.PantryConsumption, error)
+	// RestockPantry increments the pantry items matching the given bought grocery list items.
+	RestockPantry(ctx context.Context, authAccount model.AuthAccount, id model.PantryId, listItems []model.ListItem) ([]model.PantryItem, error)
+
+	CreatePantryAccess(ctx context.Context, authAccount model.AuthAccount, access model.PantryAccess) (model.PantryAccess, error)
+	DeletePantryAccess(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, id model.PantryAccessId) error
+	GetPantryAccess(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, id model.PantryAccessId, fields []string) (model.PantryAccess, error)
+	ListPantryAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) ([]model.PantryAccess, error)
+	UpdatePantryAccess(ctx context.Context, authAccount model.AuthAccount, access model.PantryAccess, fields []string) (model.PantryAccess, error)
+
+	AcceptPantryAccess(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, id model.PantryAccessId) (model.PantryAccess, error)
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type pantryItemDomain interface {
+	CreatePantryItem(ctx context.Context, authAccount model.AuthAccount, pantryItem model.PantryItem) (model.PantryItem, error)
+	DeletePantryItem(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, id model.PantryItemId) (model.PantryItem, error)
+	GetPantryItem(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, id model.PantryItemId, fields []string) (model.PantryItem, error)
+	ListPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.PantryItem, error)
+	ListExpiringPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, expireWithin time.Duration, pageSize int32, pageOffset int32, fields []string) ([]model.PantryItem, error)
+	UpdatePantryItem(ctx context.Context, authAccount model.AuthAccount, pantryItem model.PantryItem, fields []string) (model.PantryItem, error)
+}
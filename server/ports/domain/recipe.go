@@ -12,12 +12,22 @@ type recipeDomain interface {
 	CreateRecipe(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe) (model.Recipe, error)
 	DeleteRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId) (model.Recipe, error)
 	GetRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, fields []string) (model.Recipe, error)
-	ListRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, pageSize int32, offset int64, filter string, fields []string) ([]model.Recipe, error)
+	ListRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, pageSize int32, offset int64, filter string, orderBy string, fields []string) ([]model.Recipe, error)
 	UpdateRecipe(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe, fields []string) (model.Recipe, error)
 	FavoriteRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId) error
 	UnfavoriteRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId) error
+	MatchRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, request model.RecipeMatchRequest, pageSize int32, offset int64) ([]model.RecipeMatch, error)
+	ForkRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId) (model.Recipe, error)
+	// FindDuplicateRecipes groups the recipes of a collection that are likely
+	// copies of each other.
+	FindDuplicateRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, pageSize int32, offset int64) ([]model.RecipeDuplicateGroup, error)
+	// MergeRecipes keeps a recipe and moves the favorites, accesses and event
+	// links of the merged recipes onto it before deleting them.
+	MergeRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, mergedIds []model.RecipeId) (model.Recipe, error)
 
-	ScrapeRecipe(ctx context.Context, authAccount model.AuthAccount, uri string) (model.Recipe, error)
+	// ScrapeRecipe scrapes a recipe and finds the recipes of the parent
+	// collection it is likely a copy of.
+	ScrapeRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, uri string) (model.Recipe, []model.RecipeDuplicate, error)
 	OCRRecipe(ctx context.Context, authAccount model.AuthAccount, imageReaders []io.Reader) (model.Recipe, error)
 
 	UploadRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, imageReader io.Reader) (imageURI string, err error)
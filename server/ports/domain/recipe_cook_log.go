@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+	"io"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type recipeCookLogDomain interface {
+	CreateRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, cookLog model.RecipeCookLog, pantryId model.PantryId) (model.RecipeCookLog, error)
+	GetRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, id model.RecipeCookLogId, fields []string) (model.RecipeCookLog, error)
+	ListRecipeCookLogs(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, pageSize int32, offset int64, fields []string) ([]model.RecipeCookLog, error)
+	UpdateRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, cookLog model.RecipeCookLog, fields []string) (model.RecipeCookLog, error)
+	DeleteRecipeCookLog(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, id model.RecipeCookLogId) (model.RecipeCookLog, error)
+	AddRecipeCookLogPhoto(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeCookLogParent, id model.RecipeCookLogId, imageReader io.Reader) (model.RecipeCookLog, error)
+}
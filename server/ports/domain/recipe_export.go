@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/file"
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeexport"
+)
+
+type recipeExportDomain interface {
+	ExportRecipe(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeParent, id model.RecipeId, format recipeexport.Format) (file.File, error)
+	ExportRecipes(ctx context.Context, authAccount model.AuthAccount, format recipeexport.Format) (file.File, error)
+}
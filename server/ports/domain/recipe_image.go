@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"io"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type recipeImageDomain interface {
+	CreateRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, step *model.RecipeDirectionStep, imageReader io.Reader) (model.RecipeImage, error)
+	GetRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, id model.RecipeImageId, fields []string) (model.RecipeImage, error)
+	ListRecipeImages(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImage, error)
+	UpdateRecipeImage(ctx context.Context, authAccount model.AuthAccount, image model.RecipeImage, fields []string) (model.RecipeImage, error)
+	DeleteRecipeImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, id model.RecipeImageId) (model.RecipeImage, error)
+	ReorderRecipeImages(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, ids []model.RecipeImageId) ([]model.RecipeImage, error)
+	SetRecipeCoverImage(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageParent, id model.RecipeImageId) (model.RecipeImage, error)
+}
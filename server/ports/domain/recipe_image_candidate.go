@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type recipeImageCandidateDomain interface {
+	GetRecipeImageCandidate(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId, fields []string) (model.RecipeImageCandidate, error)
+	ListRecipeImageCandidates(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImageCandidate, error)
+	DeleteRecipeImageCandidate(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId) (model.RecipeImageCandidate, error)
+	// SelectRecipeImageCandidate makes a candidate the cover of its recipe
+	// and discards the other candidates. The recipe is returned with its new
+	// cover image.
+	SelectRecipeImageCandidate(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId) (model.Recipe, error)
+}
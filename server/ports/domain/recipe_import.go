@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+	"github.com/jcfug8/daylear/server/core/recipeimport"
+)
+
+type recipeImportDomain interface {
+	StartRecipeImport(ctx context.Context, authAccount model.AuthAccount, source recipeimport.Source, data []byte) (model.RecipeImport, error)
+	GetRecipeImport(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImportParent, id model.RecipeImportId, fields []string) (model.RecipeImport, error)
+	ListRecipeImports(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeImportParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImport, error)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type recipeNoteDomain interface {
+	CreateRecipeNote(ctx context.Context, authAccount model.AuthAccount, note model.RecipeNote) (model.RecipeNote, error)
+	GetRecipeNote(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeNoteParent, id model.RecipeNoteId, fields []string) (model.RecipeNote, error)
+	ListRecipeNotes(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeNoteParent, pageSize int32, offset int64, fields []string) ([]model.RecipeNote, error)
+	UpdateRecipeNote(ctx context.Context, authAccount model.AuthAccount, note model.RecipeNote, fields []string) (model.RecipeNote, error)
+	DeleteRecipeNote(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeNoteParent, id model.RecipeNoteId) (model.RecipeNote, error)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type recipeReviewDomain interface {
+	CreateRecipeReview(ctx context.Context, authAccount model.AuthAccount, review model.RecipeReview) (model.RecipeReview, error)
+	GetRecipeReview(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeReviewParent, id model.RecipeReviewId, fields []string) (model.RecipeReview, error)
+	ListRecipeReviews(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeReviewParent, pageSize int32, offset int64, fields []string) ([]model.RecipeReview, error)
+	UpdateRecipeReview(ctx context.Context, authAccount model.AuthAccount, review model.RecipeReview, fields []string) (model.RecipeReview, error)
+	DeleteRecipeReview(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeReviewParent, id model.RecipeReviewId) (model.RecipeReview, error)
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+
+	model "github.com/jcfug8/daylear/server/core/model"
+)
+
+type recipeRevisionDomain interface {
+	GetRecipeRevision(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeRevisionParent, id model.RecipeRevisionId, fields []string) (model.RecipeRevision, error)
+	ListRecipeRevisions(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeRevisionParent, pageSize int32, offset int64, fields []string) ([]model.RecipeRevision, error)
+	DiffRecipeRevisions(ctx context.Context, authAccount model.AuthAccount, parent model.RecipeRevisionParent, id model.RecipeRevisionId, baseId model.RecipeRevisionId) (base model.RecipeRevision, target model.RecipeRevision, diff model.RecipeDiff, err error)
+	RevertRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, revisionId model.RecipeRevisionId) (model.Recipe, error)
+}
@@ -15,6 +15,11 @@ type Image interface {
 	Convert(ctx context.Context, format string) error
 	Resize(ctx context.Context, width int, height int) (err error)
 	GetDimensions(ctx context.Context) (width int, height int, err error)
+	// GetFormat returns the lowercase format of the image, such as jpeg or png.
+	GetFormat() string
+	// Clone returns a copy of the image that can be changed and removed
+	// independently of the original.
+	Clone(ctx context.Context) (Image, error)
 	Remove(ctx context.Context) error
 	GetFile() (file.File, error)
 }
@@ -15,6 +15,21 @@ type Client interface {
 	eventRecipeClient
 	accessKeyClient
 	listItemClient
+	listItemCompletionClient
+	ingredientClient
+	recipeRevisionClient
+	recipeReviewClient
+	recipeCookLogClient
+	recipeNoteClient
+	recipeImageCandidateClient
+	recipeImportClient
+	operationClient
+	recipeImageClient
+	imageSetClient
+	pantryClient
+	pantryItemClient
+	cookbookClient
+	cookbookEntryClient
 
 	Begin(context.Context) (TxClient, error)
 	Migrate() error
@@ -31,6 +46,21 @@ type TxClient interface {
 	eventRecipeClient
 	accessKeyClient
 	listItemClient
+	listItemCompletionClient
+	ingredientClient
+	recipeRevisionClient
+	recipeReviewClient
+	recipeCookLogClient
+	recipeNoteClient
+	recipeImageCandidateClient
+	recipeImportClient
+	operationClient
+	recipeImageClient
+	imageSetClient
+	pantryClient
+	pantryItemClient
+	cookbookClient
+	cookbookEntryClient
 
 	Commit() error
 	Rollback()
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with the cookbook in the database.
+type cookbookClient interface {
+	CreateCookbook(ctx context.Context, authAccount model.AuthAccount, cookbook model.Cookbook) (model.Cookbook, error)
+	DeleteCookbook(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId) (model.Cookbook, error)
+	GetCookbook(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId, fields []string) (model.Cookbook, error)
+	ListCookbooks(ctx context.Context, authAccount model.AuthAccount, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.Cookbook, error)
+	UpdateCookbook(ctx context.Context, authAccount model.AuthAccount, cookbook model.Cookbook, fields []string) (model.Cookbook, error)
+
+	FindStandardUserCookbookAccess(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId) (model.CookbookAccess, error)
+	FindDelegatedCircleCookbookAccess(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId) (model.CookbookAccess, model.CircleAccess, error)
+	FindDelegatedUserCookbookAccess(ctx context.Context, authAccount model.AuthAccount, id model.CookbookId) (model.CookbookAccess, model.UserAccess, error)
+
+	CreateCookbookAccess(ctx context.Context, access model.CookbookAccess, fields []string) (model.CookbookAccess, error)
+	DeleteCookbookAccess(ctx context.Context, parent model.CookbookAccessParent, id model.CookbookAccessId) error
+	BulkDeleteCookbookAccess(ctx context.Context, parent model.CookbookAccessParent) error
+	GetCookbookAccess(ctx context.Context, parent model.CookbookAccessParent, id model.CookbookAccessId, fields []string) (model.CookbookAccess, error)
+	ListCookbookAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.CookbookAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) ([]model.CookbookAccess, error)
+	UpdateCookbookAccess(ctx context.Context, access model.CookbookAccess, fields []string) (model.CookbookAccess, error)
+}
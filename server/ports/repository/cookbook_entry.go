@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with cookbook entries in the database.
+type cookbookEntryClient interface {
+	CreateCookbookEntry(ctx context.Context, entry model.CookbookEntry) (model.CookbookEntry, error)
+	GetCookbookEntry(ctx context.Context, parent model.CookbookEntryParent, id model.CookbookEntryId, fields []string) (model.CookbookEntry, error)
+	// ListCookbookEntries lists the entries of a cookbook, in order.
+	ListCookbookEntries(ctx context.Context, parent model.CookbookEntryParent, pageSize int32, offset int64, fields []string) ([]model.CookbookEntry, error)
+	UpdateCookbookEntry(ctx context.Context, entry model.CookbookEntry, fields []string) (model.CookbookEntry, error)
+	DeleteCookbookEntry(ctx context.Context, parent model.CookbookEntryParent, id model.CookbookEntryId) (model.CookbookEntry, error)
+	BulkDeleteCookbookEntries(ctx context.Context, parent model.CookbookEntryParent) error
+}
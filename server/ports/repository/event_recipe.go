@@ -10,6 +10,10 @@ type eventRecipeClient interface {
 	CreateEventRecipe(ctx context.Context, eventRecipe model.EventRecipe, fields []string) (model.EventRecipe, error)
 	DeleteEventRecipe(ctx context.Context, id model.EventRecipeId) (model.EventRecipe, error)
 	BulkDeleteEventRecipes(ctx context.Context, eventId model.EventId) error
+	// MoveEventRecipes links the events of one recipe to another recipe. Links
+	// to events already linked to the other recipe are left in place.
+	MoveEventRecipes(ctx context.Context, from model.RecipeId, to model.RecipeId) error
+	UpdateEventRecipe(ctx context.Context, eventRecipe model.EventRecipe, fields []string) (model.EventRecipe, error)
 	GetEventRecipe(ctx context.Context, authAccount model.AuthAccount, id model.EventRecipeId, fields []string) (model.EventRecipe, error)
 	ListEventRecipes(ctx context.Context, authAccount model.AuthAccount, parent model.EventRecipeParent, pageSize int32, offset int64, filter string, fields []string) ([]model.EventRecipe, error)
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// imageSetClient defines how to find and fill in the image sets of stored
+// images, independent of the resource they belong to.
+type imageSetClient interface {
+	// ListImageSetOwners lists, by ascending id after afterId, the resources of
+	// a kind that have an image but no image set.
+	ListImageSetOwners(ctx context.Context, kind model.ImageSetOwnerKind, afterId int64, pageSize int32) ([]model.ImageSetOwner, error)
+	// UpdateImageSet sets the image set of a resource.
+	UpdateImageSet(ctx context.Context, owner model.ImageSetOwner) error
+}
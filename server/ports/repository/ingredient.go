@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with the ingredient catalog in the database.
+type ingredientClient interface {
+	CreateIngredient(ctx context.Context, ingredient model.Ingredient, fields []string) (model.Ingredient, error)
+	GetIngredient(ctx context.Context, id model.IngredientId, fields []string) (model.Ingredient, error)
+	// ListIngredients lists the curated catalog ingredients and the ones
+	// created by the viewer.
+	ListIngredients(ctx context.Context, viewerId model.UserId, pageSize int32, offset int64, filter string, fields []string) ([]model.Ingredient, error)
+
+	// FindIngredientCandidates returns the catalog ingredients visible to the
+	// viewer whose title or aliases share at least one word with the given
+	// normalized title.
+	FindIngredientCandidates(ctx context.Context, viewerId model.UserId, normalizedTitle string) ([]model.Ingredient, error)
+	// AutocompleteIngredients returns the catalog ingredients visible to the
+	// viewer whose title or aliases start with the given query.
+	AutocompleteIngredients(ctx context.Context, viewerId model.UserId, query string, limit int32) ([]model.Ingredient, error)
+
+	// Recipe ingredient link methods
+	SetRecipeIngredients(ctx context.Context, id model.RecipeId, ingredientIds []model.IngredientId) error
+	BulkDeleteRecipeIngredients(ctx context.Context, id model.RecipeId) error
+}
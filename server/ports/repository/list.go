@@ -24,6 +24,7 @@ type listClient interface {
 	GetListAccess(ctx context.Context, parent model.ListAccessParent, id model.ListAccessId, fields []string) (model.ListAccess, error)
 	ListListAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.ListAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) ([]model.ListAccess, error)
 	UpdateListAccess(ctx context.Context, access model.ListAccess, fields []string) (model.ListAccess, error)
+	ListListCircleMemberIds(ctx context.Context, id model.ListId) ([]model.UserId, error)
 
 	// Favoriting methods
 	CreateListFavorite(ctx context.Context, authAccount model.AuthAccount, id model.ListId) error
@@ -17,4 +17,7 @@ type listItemClient interface {
 
 	// Bulk operations
 	BulkDeleteListItems(ctx context.Context, parent model.ListItemParent) error
+
+	// Assignments
+	ListAssignedListIds(ctx context.Context, userId model.UserId) ([]model.ListId, error)
 }
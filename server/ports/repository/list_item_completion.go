@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// listItemCompletionClient defines how to interact with list item completions
+// in the database.
+type listItemCompletionClient interface {
+	CreateListItemCompletion(ctx context.Context, completion model.ListItemCompletion) (model.ListItemCompletion, error)
+	DeleteListItemCompletion(ctx context.Context, parent model.ListItemCompletionParent, id model.ListItemCompletionId) (model.ListItemCompletion, error)
+	GetListItemCompletion(ctx context.Context, parent model.ListItemCompletionParent, id model.ListItemCompletionId, fields []string) (model.ListItemCompletion, error)
+	ListListItemCompletions(ctx context.Context, parent model.ListItemCompletionParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.ListItemCompletion, error)
+	UpdateListItemCompletion(ctx context.Context, completion model.ListItemCompletion, fields []string) (model.ListItemCompletion, error)
+
+	// BulkDeleteListItemCompletions deletes the completions of a list item, or
+	// of every item of the list when the list item id is not set.
+	BulkDeleteListItemCompletions(ctx context.Context, parent model.ListItemCompletionParent) error
+	// GetLastListItemCompleteTimes gets the time each of the given items was
+	// last completed. Items that were never completed are left out.
+	GetLastListItemCompleteTimes(ctx context.Context, parent model.ListItemParent, ids []model.ListItemId) (map[model.ListItemId]time.Time, error)
+}
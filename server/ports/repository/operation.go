@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with operations in the database.
+type operationClient interface {
+	CreateOperation(ctx context.Context, operation model.Operation) (model.Operation, error)
+	GetOperation(ctx context.Context, parent model.OperationParent, id model.OperationId, fields []string) (model.Operation, error)
+	// ListOperations lists the operations of a user, newest first.
+	ListOperations(ctx context.Context, parent model.OperationParent, pageSize int32, offset int64, fields []string) ([]model.Operation, error)
+	// UpdateOperation updates an operation that has not finished yet. When
+	// the operation has a LeaseOwner it is only updated while that owner
+	// holds its lease. It returns ErrNotFound when the operation has finished
+	// in the meantime, e.g. because it was cancelled, or the lease was lost.
+	UpdateOperation(ctx context.Context, operation model.Operation, fields []string) (model.Operation, error)
+	DeleteOperation(ctx context.Context, parent model.OperationParent, id model.OperationId) (model.Operation, error)
+	// LockOperations locks the operations of a user until the transaction
+	// ends, so only one caller at a time checks and starts them.
+	LockOperations(ctx context.Context, parent model.OperationParent) error
+	// CountActiveOperations counts the operations of a kind of a user that
+	// are pending or running.
+	CountActiveOperations(ctx context.Context, parent model.OperationParent, kind model.OperationKind) (int64, error)
+	// ClaimOperation marks the oldest pending operation as running under a
+	// lease held by owner until leaseExpireTime, and returns it. It returns
+	// ErrNotFound when no operation is pending.
+	ClaimOperation(ctx context.Context, owner string, leaseExpireTime time.Time) (model.Operation, error)
+	// RenewOperationLease extends the lease of owner on a running operation
+	// until leaseExpireTime. It returns ErrNotFound when the operation is no
+	// longer running under that lease, e.g. because it was cancelled.
+	RenewOperationLease(ctx context.Context, id model.OperationId, owner string, leaseExpireTime time.Time) error
+	// RequeueExpiredOperations marks the running operations whose lease
+	// expired before the given time as pending again, for when the process
+	// running them has stopped, and returns how many were requeued.
+	RequeueExpiredOperations(ctx context.Context, before time.Time) (int64, error)
+	// DeleteExpiredOperations deletes the operations that expired before the
+	// given time and returns them.
+	DeleteExpiredOperations(ctx context.Context, before time.Time) ([]model.Operation, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with the pantry in the database.
+type pantryClient interface {
+	CreatePantry(ctx context.Context, authAccount model.AuthAccount, pantry model.Pantry) (model.Pantry, error)
+	DeletePantry(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) (model.Pantry, error)
+	GetPantry(ctx context.Context, authAccount model.AuthAccount, id model.PantryId, fields []string) (model.Pantry, error)
+	ListPantries(ctx context.Context, authAccount model.AuthAccount, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.Pantry, error)
+	UpdatePantry(ctx context.Context, authAccount model.AuthAccount, pantry model.Pantry, fields []string) (model.Pantry, error)
+
+	FindStandardUserPantryAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) (model.PantryAccess, error)
+	FindDelegatedCirclePantryAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) (model.PantryAccess, model.CircleAccess, error)
+	FindDelegatedUserPantryAccess(ctx context.Context, authAccount model.AuthAccount, id model.PantryId) (model.PantryAccess, model.UserAccess, error)
+
+	CreatePantryAccess(ctx context.Context, access model.PantryAccess, fields []string) (model.PantryAccess, error)
+	DeletePantryAccess(ctx context.Context, parent model.PantryAccessParent, id model.PantryAccessId) error
+	BulkDeletePantryAccess(ctx context.Context, parent model.PantryAccessParent) error
+	GetPantryAccess(ctx context.Context, parent model.PantryAccessParent, id model.PantryAccessId, fields []string) (model.PantryAccess, error)
+	ListPantryAccesses(ctx context.Context, authAccount model.AuthAccount, parent model.PantryAccessParent, pageSize int32, pageOffset int64, filter string, fields []string) ([]model.PantryAccess, error)
+	UpdatePantryAccess(ctx context.Context, access model.PantryAccess, fields []string) (model.PantryAccess, error)
+}
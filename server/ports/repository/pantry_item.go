@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with pantry items in the database.
+type pantryItemClient interface {
+	// Basic CRUD operations
+	CreatePantryItem(ctx context.Context, authAccount model.AuthAccount, pantryItem model.PantryItem) (model.PantryItem, error)
+	DeletePantryItem(ctx context.Context, authAccount model.AuthAccount, id model.PantryItemId) (model.PantryItem, error)
+	GetPantryItem(ctx context.Context, authAccount model.AuthAccount, id model.PantryItemId, fields []string) (model.PantryItem, error)
+	ListPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, pageSize int32, pageOffset int32, filter string, fields []string) ([]model.PantryItem, error)
+	UpdatePantryItem(ctx context.Context, authAccount model.AuthAccount, pantryItem model.PantryItem, fields []string) (model.PantryItem, error)
+
+	// ListExpiringPantryItems lists the items that expire at or before the given time, soonest first.
+	ListExpiringPantryItems(ctx context.Context, authAccount model.AuthAccount, parent model.PantryItemParent, before time.Time, pageSize int32, pageOffset int32, fields []string) ([]model.PantryItem, error)
+
+	// Bulk operations
+	BulkDeletePantryItems(ctx context.Context, parent model.PantryItemParent) error
+}
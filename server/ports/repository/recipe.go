@@ -11,7 +11,7 @@ type recipeClient interface {
 	CreateRecipe(ctx context.Context, recipe model.Recipe, fields []string) (model.Recipe, error)
 	DeleteRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.Recipe, error)
 	GetRecipe(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId, fields []string) (model.Recipe, error)
-	ListRecipes(ctx context.Context, authAccount model.AuthAccount, pageSize int32, offset int64, filter string, fields []string) ([]model.Recipe, error)
+	ListRecipes(ctx context.Context, authAccount model.AuthAccount, pageSize int32, offset int64, filter string, orderBy string, fields []string) ([]model.Recipe, error)
 	UpdateRecipe(ctx context.Context, authAccount model.AuthAccount, recipe model.Recipe, fields []string) (model.Recipe, error)
 
 	FindStandardUserRecipeAccess(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) (model.RecipeAccess, error)
@@ -29,4 +29,9 @@ type recipeClient interface {
 	CreateRecipeFavorite(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) error
 	DeleteRecipeFavorite(ctx context.Context, authAccount model.AuthAccount, id model.RecipeId) error
 	BulkDeleteRecipeFavorites(ctx context.Context, id model.RecipeId) error
+
+	// Merging methods, the rows that would duplicate a row of the target
+	// recipe are left on the source recipe
+	MoveRecipeFavorites(ctx context.Context, from model.RecipeId, to model.RecipeId) error
+	MoveRecipeAccesses(ctx context.Context, from model.RecipeId, to model.RecipeId) error
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with recipe cook logs in the database.
+type recipeCookLogClient interface {
+	CreateRecipeCookLog(ctx context.Context, cookLog model.RecipeCookLog) (model.RecipeCookLog, error)
+	GetRecipeCookLog(ctx context.Context, parent model.RecipeCookLogParent, id model.RecipeCookLogId, fields []string) (model.RecipeCookLog, error)
+	// ListRecipeCookLogs lists the cook logs of a recipe, most recently cooked first.
+	ListRecipeCookLogs(ctx context.Context, parent model.RecipeCookLogParent, pageSize int32, offset int64, fields []string) ([]model.RecipeCookLog, error)
+	UpdateRecipeCookLog(ctx context.Context, cookLog model.RecipeCookLog, fields []string) (model.RecipeCookLog, error)
+	DeleteRecipeCookLog(ctx context.Context, parent model.RecipeCookLogParent, id model.RecipeCookLogId) (model.RecipeCookLog, error)
+	// BulkDeleteRecipeCookLogs deletes the cook logs of a recipe and returns them.
+	BulkDeleteRecipeCookLogs(ctx context.Context, parent model.RecipeCookLogParent) ([]model.RecipeCookLog, error)
+	MoveRecipeCookLogs(ctx context.Context, from model.RecipeId, to model.RecipeId) error
+}
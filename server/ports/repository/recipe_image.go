@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with recipe images in the database.
+type recipeImageClient interface {
+	CreateRecipeImage(ctx context.Context, image model.RecipeImage) (model.RecipeImage, error)
+	GetRecipeImage(ctx context.Context, parent model.RecipeImageParent, id model.RecipeImageId, fields []string) (model.RecipeImage, error)
+	// ListRecipeImages lists the images of a recipe in their display order.
+	ListRecipeImages(ctx context.Context, parent model.RecipeImageParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImage, error)
+	UpdateRecipeImage(ctx context.Context, image model.RecipeImage, fields []string) (model.RecipeImage, error)
+	DeleteRecipeImage(ctx context.Context, parent model.RecipeImageParent, id model.RecipeImageId) (model.RecipeImage, error)
+	// BulkDeleteRecipeImages deletes all images of a recipe and returns them.
+	BulkDeleteRecipeImages(ctx context.Context, parent model.RecipeImageParent) ([]model.RecipeImage, error)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with recipe image candidates and image
+// generations in the database.
+type recipeImageCandidateClient interface {
+	CreateRecipeImageCandidate(ctx context.Context, candidate model.RecipeImageCandidate) (model.RecipeImageCandidate, error)
+	GetRecipeImageCandidate(ctx context.Context, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId, fields []string) (model.RecipeImageCandidate, error)
+	ListRecipeImageCandidates(ctx context.Context, parent model.RecipeImageCandidateParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImageCandidate, error)
+	DeleteRecipeImageCandidate(ctx context.Context, parent model.RecipeImageCandidateParent, id model.RecipeImageCandidateId) (model.RecipeImageCandidate, error)
+	// BulkDeleteRecipeImageCandidates deletes the candidates of a recipe and
+	// returns them so their files can be deleted.
+	BulkDeleteRecipeImageCandidates(ctx context.Context, parent model.RecipeImageCandidateParent) ([]model.RecipeImageCandidate, error)
+	// DeleteExpiredRecipeImageCandidates deletes the candidates created before
+	// the given time and returns them so their files can be deleted.
+	DeleteExpiredRecipeImageCandidates(ctx context.Context, before time.Time) ([]model.RecipeImageCandidate, error)
+
+	// LockRecipeImageGenerations locks the image generations of a user until
+	// the transaction ends. It must be called in a transaction.
+	LockRecipeImageGenerations(ctx context.Context, userId model.UserId) error
+	CreateRecipeImageGeneration(ctx context.Context, generation model.RecipeImageGeneration) (model.RecipeImageGeneration, error)
+	// DeleteRecipeImageGeneration deletes a generation, refunding its images.
+	DeleteRecipeImageGeneration(ctx context.Context, id model.RecipeImageGenerationId) error
+	// CountRecipeImageGenerations counts the images generated for a user
+	// since the given time.
+	CountRecipeImageGenerations(ctx context.Context, userId model.UserId, since time.Time) (int64, error)
+	// DeleteRecipeImageGenerations deletes the generations recorded before
+	// the given time.
+	DeleteRecipeImageGenerations(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with recipe imports in the database.
+type recipeImportClient interface {
+	CreateRecipeImport(ctx context.Context, recipeImport model.RecipeImport) (model.RecipeImport, error)
+	GetRecipeImport(ctx context.Context, parent model.RecipeImportParent, id model.RecipeImportId, fields []string) (model.RecipeImport, error)
+	// ListRecipeImports lists the recipe imports of a user, newest first.
+	ListRecipeImports(ctx context.Context, parent model.RecipeImportParent, pageSize int32, offset int64, fields []string) ([]model.RecipeImport, error)
+	UpdateRecipeImport(ctx context.Context, recipeImport model.RecipeImport, fields []string) (model.RecipeImport, error)
+}
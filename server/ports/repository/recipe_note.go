@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with recipe notes in the database. Notes
+// are always read and written on behalf of their author.
+type recipeNoteClient interface {
+	CreateRecipeNote(ctx context.Context, note model.RecipeNote) (model.RecipeNote, error)
+	GetRecipeNote(ctx context.Context, authorId model.UserId, parent model.RecipeNoteParent, id model.RecipeNoteId, fields []string) (model.RecipeNote, error)
+	ListRecipeNotes(ctx context.Context, authorId model.UserId, parent model.RecipeNoteParent, pageSize int32, offset int64, fields []string) ([]model.RecipeNote, error)
+	UpdateRecipeNote(ctx context.Context, note model.RecipeNote, fields []string) (model.RecipeNote, error)
+	DeleteRecipeNote(ctx context.Context, authorId model.UserId, parent model.RecipeNoteParent, id model.RecipeNoteId) (model.RecipeNote, error)
+	// BulkDeleteRecipeNotes deletes the notes on a recipe, only those of the
+	// author when authorId is set.
+	BulkDeleteRecipeNotes(ctx context.Context, parent model.RecipeNoteParent, authorId model.UserId) error
+	// ListRecipeNoteAuthors lists the users who have notes on a recipe.
+	ListRecipeNoteAuthors(ctx context.Context, parent model.RecipeNoteParent) ([]model.UserId, error)
+	// ListRecipeNoteRecipes lists the recipes an author has notes on.
+	ListRecipeNoteRecipes(ctx context.Context, authorId model.UserId) ([]model.RecipeId, error)
+	// MoveRecipeNotes moves the notes on one recipe to another and detaches
+	// them from their ingredients and steps.
+	MoveRecipeNotes(ctx context.Context, from model.RecipeId, to model.RecipeId) error
+}
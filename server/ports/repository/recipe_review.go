@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with recipe reviews in the database.
+type recipeReviewClient interface {
+	CreateRecipeReview(ctx context.Context, review model.RecipeReview) (model.RecipeReview, error)
+	GetRecipeReview(ctx context.Context, parent model.RecipeReviewParent, id model.RecipeReviewId, fields []string) (model.RecipeReview, error)
+	// ListRecipeReviews lists the reviews of a recipe, newest first.
+	ListRecipeReviews(ctx context.Context, parent model.RecipeReviewParent, pageSize int32, offset int64, fields []string) ([]model.RecipeReview, error)
+	UpdateRecipeReview(ctx context.Context, review model.RecipeReview, fields []string) (model.RecipeReview, error)
+	DeleteRecipeReview(ctx context.Context, parent model.RecipeReviewParent, id model.RecipeReviewId) (model.RecipeReview, error)
+	BulkDeleteRecipeReviews(ctx context.Context, parent model.RecipeReviewParent) error
+}
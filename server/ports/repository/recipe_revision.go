@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jcfug8/daylear/server/core/model"
+)
+
+// Client defines how to interact with recipe revisions in the database.
+type recipeRevisionClient interface {
+	CreateRecipeRevision(ctx context.Context, revision model.RecipeRevision) (model.RecipeRevision, error)
+	GetRecipeRevision(ctx context.Context, parent model.RecipeRevisionParent, id model.RecipeRevisionId, fields []string) (model.RecipeRevision, error)
+	// ListRecipeRevisions lists the revisions of a recipe, newest first.
+	ListRecipeRevisions(ctx context.Context, parent model.RecipeRevisionParent, pageSize int32, offset int64, fields []string) ([]model.RecipeRevision, error)
+	// GetPreviousRecipeRevision gets the revision created before the given one.
+	GetPreviousRecipeRevision(ctx context.Context, parent model.RecipeRevisionParent, id model.RecipeRevisionId, fields []string) (model.RecipeRevision, error)
+	BulkDeleteRecipeRevisions(ctx context.Context, parent model.RecipeRevisionParent) error
+}